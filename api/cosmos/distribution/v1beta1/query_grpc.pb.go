@@ -27,6 +27,7 @@ const (
 	Query_DelegationRewards_FullMethodName           = "/cosmos.distribution.v1beta1.Query/DelegationRewards"
 	Query_DelegationTotalRewards_FullMethodName      = "/cosmos.distribution.v1beta1.Query/DelegationTotalRewards"
 	Query_DelegatorValidators_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegatorValidators"
+	Query_AutoCompoundDelegations_FullMethodName     = "/cosmos.distribution.v1beta1.Query/AutoCompoundDelegations"
 	Query_DelegatorWithdrawAddress_FullMethodName    = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress"
 	Query_CommunityPool_FullMethodName               = "/cosmos.distribution.v1beta1.Query/CommunityPool"
 )
@@ -52,6 +53,9 @@ type QueryClient interface {
 	DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error)
 	// DelegatorValidators queries the validators of a delegator.
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
+	// AutoCompoundDelegations queries the auto-compounded delegations of a
+	// delegator.
+	AutoCompoundDelegations(ctx context.Context, in *QueryAutoCompoundDelegationsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundDelegationsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
@@ -138,6 +142,15 @@ func (c *queryClient) DelegatorValidators(ctx context.Context, in *QueryDelegato
 	return out, nil
 }
 
+func (c *queryClient) AutoCompoundDelegations(ctx context.Context, in *QueryAutoCompoundDelegationsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundDelegationsResponse, error) {
+	out := new(QueryAutoCompoundDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_AutoCompoundDelegations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error) {
 	out := new(QueryDelegatorWithdrawAddressResponse)
 	err := c.cc.Invoke(ctx, Query_DelegatorWithdrawAddress_FullMethodName, in, out, opts...)
@@ -177,6 +190,9 @@ type QueryServer interface {
 	DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error)
 	// DelegatorValidators queries the validators of a delegator.
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
+	// AutoCompoundDelegations queries the auto-compounded delegations of a
+	// delegator.
+	AutoCompoundDelegations(context.Context, *QueryAutoCompoundDelegationsRequest) (*QueryAutoCompoundDelegationsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
@@ -212,6 +228,9 @@ func (UnimplementedQueryServer) DelegationTotalRewards(context.Context, *QueryDe
 func (UnimplementedQueryServer) DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorValidators not implemented")
 }
+func (UnimplementedQueryServer) AutoCompoundDelegations(context.Context, *QueryAutoCompoundDelegationsRequest) (*QueryAutoCompoundDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompoundDelegations not implemented")
+}
 func (UnimplementedQueryServer) DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
@@ -375,6 +394,24 @@ func _Query_DelegatorValidators_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AutoCompoundDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompoundDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AutoCompoundDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompoundDelegations(ctx, req.(*QueryAutoCompoundDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDelegatorWithdrawAddressRequest)
 	if err := dec(in); err != nil {
@@ -450,6 +487,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorValidators",
 			Handler:    _Query_DelegatorValidators_Handler,
 		},
+		{
+			MethodName: "AutoCompoundDelegations",
+			Handler:    _Query_AutoCompoundDelegations_Handler,
+		},
 		{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
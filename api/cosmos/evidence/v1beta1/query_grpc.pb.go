@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Evidence_FullMethodName    = "/cosmos.evidence.v1beta1.Query/Evidence"
-	Query_AllEvidence_FullMethodName = "/cosmos.evidence.v1beta1.Query/AllEvidence"
+	Query_Evidence_FullMethodName            = "/cosmos.evidence.v1beta1.Query/Evidence"
+	Query_AllEvidence_FullMethodName         = "/cosmos.evidence.v1beta1.Query/AllEvidence"
+	Query_EvidenceByType_FullMethodName      = "/cosmos.evidence.v1beta1.Query/EvidenceByType"
+	Query_EvidenceByValidator_FullMethodName = "/cosmos.evidence.v1beta1.Query/EvidenceByValidator"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +33,10 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// EvidenceByType queries all evidence of the given type.
+	EvidenceByType(ctx context.Context, in *QueryEvidenceByTypeRequest, opts ...grpc.CallOption) (*QueryEvidenceByTypeResponse, error)
+	// EvidenceByValidator queries all evidence against the given validator.
+	EvidenceByValidator(ctx context.Context, in *QueryEvidenceByValidatorRequest, opts ...grpc.CallOption) (*QueryEvidenceByValidatorResponse, error)
 }
 
 type queryClient struct {
@@ -59,6 +65,24 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) EvidenceByType(ctx context.Context, in *QueryEvidenceByTypeRequest, opts ...grpc.CallOption) (*QueryEvidenceByTypeResponse, error) {
+	out := new(QueryEvidenceByTypeResponse)
+	err := c.cc.Invoke(ctx, Query_EvidenceByType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EvidenceByValidator(ctx context.Context, in *QueryEvidenceByValidatorRequest, opts ...grpc.CallOption) (*QueryEvidenceByValidatorResponse, error) {
+	out := new(QueryEvidenceByValidatorResponse)
+	err := c.cc.Invoke(ctx, Query_EvidenceByValidator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -67,6 +91,10 @@ type QueryServer interface {
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// EvidenceByType queries all evidence of the given type.
+	EvidenceByType(context.Context, *QueryEvidenceByTypeRequest) (*QueryEvidenceByTypeResponse, error)
+	// EvidenceByValidator queries all evidence against the given validator.
+	EvidenceByValidator(context.Context, *QueryEvidenceByValidatorRequest) (*QueryEvidenceByValidatorResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -80,6 +108,12 @@ func (UnimplementedQueryServer) Evidence(context.Context, *QueryEvidenceRequest)
 func (UnimplementedQueryServer) AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (UnimplementedQueryServer) EvidenceByType(context.Context, *QueryEvidenceByTypeRequest) (*QueryEvidenceByTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvidenceByType not implemented")
+}
+func (UnimplementedQueryServer) EvidenceByValidator(context.Context, *QueryEvidenceByValidatorRequest) (*QueryEvidenceByValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvidenceByValidator not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +163,42 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EvidenceByType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvidenceByTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EvidenceByType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EvidenceByType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EvidenceByType(ctx, req.(*QueryEvidenceByTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EvidenceByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvidenceByValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EvidenceByValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EvidenceByValidator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EvidenceByValidator(ctx, req.(*QueryEvidenceByValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +214,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "EvidenceByType",
+			Handler:    _Query_EvidenceByType_Handler,
+		},
+		{
+			MethodName: "EvidenceByValidator",
+			Handler:    _Query_EvidenceByValidator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -21,15 +21,21 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Constitution_FullMethodName = "/cosmos.gov.v1.Query/Constitution"
-	Query_Proposal_FullMethodName     = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName    = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName         = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName        = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName       = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName      = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName     = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName  = "/cosmos.gov.v1.Query/TallyResult"
+	Query_Constitution_FullMethodName              = "/cosmos.gov.v1.Query/Constitution"
+	Query_Proposal_FullMethodName                  = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName                 = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName                      = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName                     = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName                    = "/cosmos.gov.v1.Query/Params"
+	Query_EffectiveParams_FullMethodName           = "/cosmos.gov.v1.Query/EffectiveParams"
+	Query_ProposalParams_FullMethodName            = "/cosmos.gov.v1.Query/ProposalParams"
+	Query_Deposit_FullMethodName                   = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName                  = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName               = "/cosmos.gov.v1.Query/TallyResult"
+	Query_MultipleChoiceTallyResult_FullMethodName = "/cosmos.gov.v1.Query/MultipleChoiceTallyResult"
+	Query_GovernanceDelegation_FullMethodName      = "/cosmos.gov.v1.Query/GovernanceDelegation"
+	Query_Governor_FullMethodName                  = "/cosmos.gov.v1.Query/Governor"
+	Query_Governors_FullMethodName                 = "/cosmos.gov.v1.Query/Governors"
 )
 
 // QueryClient is the client API for Query service.
@@ -48,12 +54,28 @@ type QueryClient interface {
 	Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error)
 	// Params queries all parameters of the gov module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// EffectiveParams queries the params which apply to proposals containing
+	// the given message types, taking message based params into account.
+	EffectiveParams(ctx context.Context, in *QueryEffectiveParamsRequest, opts ...grpc.CallOption) (*QueryEffectiveParamsResponse, error)
+	// ProposalParams queries the params which apply to a proposal, taking
+	// message based params into account.
+	ProposalParams(ctx context.Context, in *QueryProposalParamsRequest, opts ...grpc.CallOption) (*QueryProposalParamsResponse, error)
 	// Deposit queries single deposit information based proposalID, depositAddr.
 	Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error)
 	// Deposits queries all deposits of a single proposal.
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// MultipleChoiceTallyResult queries the tally of each option of a multiple
+	// choice proposal.
+	MultipleChoiceTallyResult(ctx context.Context, in *QueryMultipleChoiceTallyResultRequest, opts ...grpc.CallOption) (*QueryMultipleChoiceTallyResultResponse, error)
+	// GovernanceDelegation queries the governor to which an account delegated
+	// its governance voting power.
+	GovernanceDelegation(ctx context.Context, in *QueryGovernanceDelegationRequest, opts ...grpc.CallOption) (*QueryGovernanceDelegationResponse, error)
+	// Governor queries a governor and the voting power delegated to it.
+	Governor(ctx context.Context, in *QueryGovernorRequest, opts ...grpc.CallOption) (*QueryGovernorResponse, error)
+	// Governors queries all the governors and the voting power delegated to them.
+	Governors(ctx context.Context, in *QueryGovernorsRequest, opts ...grpc.CallOption) (*QueryGovernorsResponse, error)
 }
 
 type queryClient struct {
@@ -118,6 +140,24 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) EffectiveParams(ctx context.Context, in *QueryEffectiveParamsRequest, opts ...grpc.CallOption) (*QueryEffectiveParamsResponse, error) {
+	out := new(QueryEffectiveParamsResponse)
+	err := c.cc.Invoke(ctx, Query_EffectiveParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalParams(ctx context.Context, in *QueryProposalParamsRequest, opts ...grpc.CallOption) (*QueryProposalParamsResponse, error) {
+	out := new(QueryProposalParamsResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error) {
 	out := new(QueryDepositResponse)
 	err := c.cc.Invoke(ctx, Query_Deposit_FullMethodName, in, out, opts...)
@@ -145,6 +185,42 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) MultipleChoiceTallyResult(ctx context.Context, in *QueryMultipleChoiceTallyResultRequest, opts ...grpc.CallOption) (*QueryMultipleChoiceTallyResultResponse, error) {
+	out := new(QueryMultipleChoiceTallyResultResponse)
+	err := c.cc.Invoke(ctx, Query_MultipleChoiceTallyResult_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GovernanceDelegation(ctx context.Context, in *QueryGovernanceDelegationRequest, opts ...grpc.CallOption) (*QueryGovernanceDelegationResponse, error) {
+	out := new(QueryGovernanceDelegationResponse)
+	err := c.cc.Invoke(ctx, Query_GovernanceDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Governor(ctx context.Context, in *QueryGovernorRequest, opts ...grpc.CallOption) (*QueryGovernorResponse, error) {
+	out := new(QueryGovernorResponse)
+	err := c.cc.Invoke(ctx, Query_Governor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Governors(ctx context.Context, in *QueryGovernorsRequest, opts ...grpc.CallOption) (*QueryGovernorsResponse, error) {
+	out := new(QueryGovernorsResponse)
+	err := c.cc.Invoke(ctx, Query_Governors_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -161,12 +237,28 @@ type QueryServer interface {
 	Votes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error)
 	// Params queries all parameters of the gov module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// EffectiveParams queries the params which apply to proposals containing
+	// the given message types, taking message based params into account.
+	EffectiveParams(context.Context, *QueryEffectiveParamsRequest) (*QueryEffectiveParamsResponse, error)
+	// ProposalParams queries the params which apply to a proposal, taking
+	// message based params into account.
+	ProposalParams(context.Context, *QueryProposalParamsRequest) (*QueryProposalParamsResponse, error)
 	// Deposit queries single deposit information based proposalID, depositAddr.
 	Deposit(context.Context, *QueryDepositRequest) (*QueryDepositResponse, error)
 	// Deposits queries all deposits of a single proposal.
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// MultipleChoiceTallyResult queries the tally of each option of a multiple
+	// choice proposal.
+	MultipleChoiceTallyResult(context.Context, *QueryMultipleChoiceTallyResultRequest) (*QueryMultipleChoiceTallyResultResponse, error)
+	// GovernanceDelegation queries the governor to which an account delegated
+	// its governance voting power.
+	GovernanceDelegation(context.Context, *QueryGovernanceDelegationRequest) (*QueryGovernanceDelegationResponse, error)
+	// Governor queries a governor and the voting power delegated to it.
+	Governor(context.Context, *QueryGovernorRequest) (*QueryGovernorResponse, error)
+	// Governors queries all the governors and the voting power delegated to them.
+	Governors(context.Context, *QueryGovernorsRequest) (*QueryGovernorsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -192,6 +284,12 @@ func (UnimplementedQueryServer) Votes(context.Context, *QueryVotesRequest) (*Que
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) EffectiveParams(context.Context, *QueryEffectiveParamsRequest) (*QueryEffectiveParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveParams not implemented")
+}
+func (UnimplementedQueryServer) ProposalParams(context.Context, *QueryProposalParamsRequest) (*QueryProposalParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalParams not implemented")
+}
 func (UnimplementedQueryServer) Deposit(context.Context, *QueryDepositRequest) (*QueryDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
@@ -201,6 +299,18 @@ func (UnimplementedQueryServer) Deposits(context.Context, *QueryDepositsRequest)
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) MultipleChoiceTallyResult(context.Context, *QueryMultipleChoiceTallyResultRequest) (*QueryMultipleChoiceTallyResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultipleChoiceTallyResult not implemented")
+}
+func (UnimplementedQueryServer) GovernanceDelegation(context.Context, *QueryGovernanceDelegationRequest) (*QueryGovernanceDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovernanceDelegation not implemented")
+}
+func (UnimplementedQueryServer) Governor(context.Context, *QueryGovernorRequest) (*QueryGovernorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Governor not implemented")
+}
+func (UnimplementedQueryServer) Governors(context.Context, *QueryGovernorsRequest) (*QueryGovernorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Governors not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -322,6 +432,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EffectiveParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EffectiveParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveParams(ctx, req.(*QueryEffectiveParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalParams(ctx, req.(*QueryProposalParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDepositRequest)
 	if err := dec(in); err != nil {
@@ -376,6 +522,78 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MultipleChoiceTallyResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMultipleChoiceTallyResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MultipleChoiceTallyResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MultipleChoiceTallyResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MultipleChoiceTallyResult(ctx, req.(*QueryMultipleChoiceTallyResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GovernanceDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernanceDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GovernanceDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GovernanceDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GovernanceDelegation(ctx, req.(*QueryGovernanceDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Governor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Governor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Governor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Governor(ctx, req.(*QueryGovernorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Governors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Governors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Governors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Governors(ctx, req.(*QueryGovernorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -407,6 +625,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "EffectiveParams",
+			Handler:    _Query_EffectiveParams_Handler,
+		},
+		{
+			MethodName: "ProposalParams",
+			Handler:    _Query_ProposalParams_Handler,
+		},
 		{
 			MethodName: "Deposit",
 			Handler:    _Query_Deposit_Handler,
@@ -419,6 +645,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "MultipleChoiceTallyResult",
+			Handler:    _Query_MultipleChoiceTallyResult_Handler,
+		},
+		{
+			MethodName: "GovernanceDelegation",
+			Handler:    _Query_GovernanceDelegation_Handler,
+		},
+		{
+			MethodName: "Governor",
+			Handler:    _Query_Governor_Handler,
+		},
+		{
+			MethodName: "Governors",
+			Handler:    _Query_Governors_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
@@ -21,20 +21,22 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_GroupInfo_FullMethodName              = "/cosmos.group.v1.Query/GroupInfo"
-	Query_GroupPolicyInfo_FullMethodName        = "/cosmos.group.v1.Query/GroupPolicyInfo"
-	Query_GroupMembers_FullMethodName           = "/cosmos.group.v1.Query/GroupMembers"
-	Query_GroupsByAdmin_FullMethodName          = "/cosmos.group.v1.Query/GroupsByAdmin"
-	Query_GroupPoliciesByGroup_FullMethodName   = "/cosmos.group.v1.Query/GroupPoliciesByGroup"
-	Query_GroupPoliciesByAdmin_FullMethodName   = "/cosmos.group.v1.Query/GroupPoliciesByAdmin"
-	Query_Proposal_FullMethodName               = "/cosmos.group.v1.Query/Proposal"
-	Query_ProposalsByGroupPolicy_FullMethodName = "/cosmos.group.v1.Query/ProposalsByGroupPolicy"
-	Query_VoteByProposalVoter_FullMethodName    = "/cosmos.group.v1.Query/VoteByProposalVoter"
-	Query_VotesByProposal_FullMethodName        = "/cosmos.group.v1.Query/VotesByProposal"
-	Query_VotesByVoter_FullMethodName           = "/cosmos.group.v1.Query/VotesByVoter"
-	Query_GroupsByMember_FullMethodName         = "/cosmos.group.v1.Query/GroupsByMember"
-	Query_TallyResult_FullMethodName            = "/cosmos.group.v1.Query/TallyResult"
-	Query_Groups_FullMethodName                 = "/cosmos.group.v1.Query/Groups"
+	Query_GroupInfo_FullMethodName                     = "/cosmos.group.v1.Query/GroupInfo"
+	Query_GroupPolicyInfo_FullMethodName               = "/cosmos.group.v1.Query/GroupPolicyInfo"
+	Query_GroupMembers_FullMethodName                  = "/cosmos.group.v1.Query/GroupMembers"
+	Query_GroupsByAdmin_FullMethodName                 = "/cosmos.group.v1.Query/GroupsByAdmin"
+	Query_GroupPoliciesByGroup_FullMethodName          = "/cosmos.group.v1.Query/GroupPoliciesByGroup"
+	Query_GroupPoliciesByAdmin_FullMethodName          = "/cosmos.group.v1.Query/GroupPoliciesByAdmin"
+	Query_Proposal_FullMethodName                      = "/cosmos.group.v1.Query/Proposal"
+	Query_ProposalsByGroupPolicy_FullMethodName        = "/cosmos.group.v1.Query/ProposalsByGroupPolicy"
+	Query_VoteByProposalVoter_FullMethodName           = "/cosmos.group.v1.Query/VoteByProposalVoter"
+	Query_VotesByProposal_FullMethodName               = "/cosmos.group.v1.Query/VotesByProposal"
+	Query_VotesByVoter_FullMethodName                  = "/cosmos.group.v1.Query/VotesByVoter"
+	Query_GroupsByMember_FullMethodName                = "/cosmos.group.v1.Query/GroupsByMember"
+	Query_TallyResult_FullMethodName                   = "/cosmos.group.v1.Query/TallyResult"
+	Query_Groups_FullMethodName                        = "/cosmos.group.v1.Query/Groups"
+	Query_QueuedExecution_FullMethodName               = "/cosmos.group.v1.Query/QueuedExecution"
+	Query_QueuedExecutionsByGroupPolicy_FullMethodName = "/cosmos.group.v1.Query/QueuedExecutionsByGroupPolicy"
 )
 
 // QueryClient is the client API for Query service.
@@ -75,6 +77,12 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// QueuedExecution queries an accepted proposal queued for execution at the
+	// end of its timelock.
+	QueuedExecution(ctx context.Context, in *QueryQueuedExecutionRequest, opts ...grpc.CallOption) (*QueryQueuedExecutionResponse, error)
+	// QueuedExecutionsByGroupPolicy queries the accepted proposals of a group
+	// policy queued for execution at the end of their timelock.
+	QueuedExecutionsByGroupPolicy(ctx context.Context, in *QueryQueuedExecutionsByGroupPolicyRequest, opts ...grpc.CallOption) (*QueryQueuedExecutionsByGroupPolicyResponse, error)
 }
 
 type queryClient struct {
@@ -211,6 +219,24 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) QueuedExecution(ctx context.Context, in *QueryQueuedExecutionRequest, opts ...grpc.CallOption) (*QueryQueuedExecutionResponse, error) {
+	out := new(QueryQueuedExecutionResponse)
+	err := c.cc.Invoke(ctx, Query_QueuedExecution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedExecutionsByGroupPolicy(ctx context.Context, in *QueryQueuedExecutionsByGroupPolicyRequest, opts ...grpc.CallOption) (*QueryQueuedExecutionsByGroupPolicyResponse, error) {
+	out := new(QueryQueuedExecutionsByGroupPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_QueuedExecutionsByGroupPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -249,6 +275,12 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// QueuedExecution queries an accepted proposal queued for execution at the
+	// end of its timelock.
+	QueuedExecution(context.Context, *QueryQueuedExecutionRequest) (*QueryQueuedExecutionResponse, error)
+	// QueuedExecutionsByGroupPolicy queries the accepted proposals of a group
+	// policy queued for execution at the end of their timelock.
+	QueuedExecutionsByGroupPolicy(context.Context, *QueryQueuedExecutionsByGroupPolicyRequest) (*QueryQueuedExecutionsByGroupPolicyResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -298,6 +330,12 @@ func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRe
 func (UnimplementedQueryServer) Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (UnimplementedQueryServer) QueuedExecution(context.Context, *QueryQueuedExecutionRequest) (*QueryQueuedExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedExecution not implemented")
+}
+func (UnimplementedQueryServer) QueuedExecutionsByGroupPolicy(context.Context, *QueryQueuedExecutionsByGroupPolicyRequest) (*QueryQueuedExecutionsByGroupPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedExecutionsByGroupPolicy not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -563,6 +601,42 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueuedExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueuedExecution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedExecution(ctx, req.(*QueryQueuedExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedExecutionsByGroupPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedExecutionsByGroupPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedExecutionsByGroupPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueuedExecutionsByGroupPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedExecutionsByGroupPolicy(ctx, req.(*QueryQueuedExecutionsByGroupPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -626,6 +700,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "QueuedExecution",
+			Handler:    _Query_QueuedExecution_Handler,
+		},
+		{
+			MethodName: "QueuedExecutionsByGroupPolicy",
+			Handler:    _Query_QueuedExecutionsByGroupPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -24,6 +24,8 @@ const (
 	Query_UpgradedConsensusState_FullMethodName = "/cosmos.upgrade.v1beta1.Query/UpgradedConsensusState"
 	Query_ModuleVersions_FullMethodName         = "/cosmos.upgrade.v1beta1.Query/ModuleVersions"
 	Query_Authority_FullMethodName              = "/cosmos.upgrade.v1beta1.Query/Authority"
+	Query_BinaryStatus_FullMethodName           = "/cosmos.upgrade.v1beta1.Query/BinaryStatus"
+	Query_SignalTally_FullMethodName            = "/cosmos.upgrade.v1beta1.Query/SignalTally"
 )
 
 // QueryClient is the client API for Query service.
@@ -50,6 +52,13 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// BinaryStatus queries the status of the binary of the current upgrade plan
+	// pre-downloaded by the queried node. The result is local to the node and
+	// not part of the consensus state.
+	BinaryStatus(ctx context.Context, in *QueryBinaryStatusRequest, opts ...grpc.CallOption) (*QueryBinaryStatusResponse, error)
+	// SignalTally queries the tally of the validators which signalled their
+	// readiness for the current upgrade plan.
+	SignalTally(ctx context.Context, in *QuerySignalTallyRequest, opts ...grpc.CallOption) (*QuerySignalTallyResponse, error)
 }
 
 type queryClient struct {
@@ -106,6 +115,24 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) BinaryStatus(ctx context.Context, in *QueryBinaryStatusRequest, opts ...grpc.CallOption) (*QueryBinaryStatusResponse, error) {
+	out := new(QueryBinaryStatusResponse)
+	err := c.cc.Invoke(ctx, Query_BinaryStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SignalTally(ctx context.Context, in *QuerySignalTallyRequest, opts ...grpc.CallOption) (*QuerySignalTallyResponse, error) {
+	out := new(QuerySignalTallyResponse)
+	err := c.cc.Invoke(ctx, Query_SignalTally_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -130,6 +157,13 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// BinaryStatus queries the status of the binary of the current upgrade plan
+	// pre-downloaded by the queried node. The result is local to the node and
+	// not part of the consensus state.
+	BinaryStatus(context.Context, *QueryBinaryStatusRequest) (*QueryBinaryStatusResponse, error)
+	// SignalTally queries the tally of the validators which signalled their
+	// readiness for the current upgrade plan.
+	SignalTally(context.Context, *QuerySignalTallyRequest) (*QuerySignalTallyResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -152,6 +186,12 @@ func (UnimplementedQueryServer) ModuleVersions(context.Context, *QueryModuleVers
 func (UnimplementedQueryServer) Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (UnimplementedQueryServer) BinaryStatus(context.Context, *QueryBinaryStatusRequest) (*QueryBinaryStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BinaryStatus not implemented")
+}
+func (UnimplementedQueryServer) SignalTally(context.Context, *QuerySignalTallyRequest) (*QuerySignalTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalTally not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -255,6 +295,42 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BinaryStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBinaryStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BinaryStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BinaryStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BinaryStatus(ctx, req.(*QueryBinaryStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignalTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignalTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignalTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SignalTally_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignalTally(ctx, req.(*QuerySignalTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -282,6 +358,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "BinaryStatus",
+			Handler:    _Query_BinaryStatus_Handler,
+		},
+		{
+			MethodName: "SignalTally",
+			Handler:    _Query_SignalTally_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -669,6 +669,13 @@ func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.Respons
 			WithHeaderHash(req.Hash)
 	}
 
+	preBlockEvents, err := app.preBlock(req)
+	if err != nil {
+		return nil, err
+	}
+
+	events = append(events, preBlockEvents...)
+
 	beginBlock := app.beginBlock(req)
 	events = append(events, beginBlock.Events...)
 
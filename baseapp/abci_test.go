@@ -190,6 +190,38 @@ func TestABCI_FinalizeBlock_WithInitialHeight(t *testing.T) {
 	require.Equal(t, int64(3), app.LastBlockHeight())
 }
 
+func TestABCI_FinalizeBlock_PreBlocker(t *testing.T) {
+	name := t.Name()
+	db := dbm.NewMemDB()
+	app := baseapp.NewBaseApp(name, log.NewTestLogger(t), db, nil)
+
+	var preBlockHash []byte
+	app.SetPreBlocker(func(ctx sdk.Context, req *abci.RequestFinalizeBlock) error {
+		preBlockHash = req.Hash
+		ctx.EventManager().EmitEvent(sdk.NewEvent("pre_block"))
+		return nil
+	})
+
+	app.InitChain(&abci.RequestInitChain{})
+
+	hash := []byte("hash")
+	res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Hash: hash})
+	require.NoError(t, err)
+	require.Equal(t, hash, preBlockHash)
+	require.Len(t, res.Events, 1)
+	require.Equal(t, "pre_block", res.Events[0].Type)
+	require.Equal(t, "mode", res.Events[0].Attributes[0].Key)
+	require.Equal(t, "PreBlock", res.Events[0].Attributes[0].Value)
+
+	// an error in the PreBlocker fails the block
+	app.SetPreBlocker(func(sdk.Context, *abci.RequestFinalizeBlock) error {
+		return errors.New("pre block failed")
+	})
+
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+	require.ErrorContains(t, err, "pre block failed")
+}
+
 func TestABCI_GRPCQuery(t *testing.T) {
 	grpcQueryOpt := func(bapp *baseapp.BaseApp) {
 		testdata.RegisterQueryServer(
@@ -66,7 +66,7 @@ func ValidateVoteExtensions(
 		return buf.Bytes(), nil
 	}
 
-	var sumVP math.Int
+	sumVP := math.ZeroInt()
 	for _, vote := range extCommit.Votes {
 		if !extsEnabled {
 			if len(vote.VoteExtension) > 0 {
@@ -79,6 +79,11 @@ func ValidateVoteExtensions(
 			continue
 		}
 
+		// only commit votes include a vote extension
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+
 		if len(vote.ExtensionSignature) == 0 {
 			return fmt.Errorf("vote extensions enabled; received empty vote extension signature at height %d", currentHeight)
 		}
@@ -74,6 +74,7 @@ type BaseApp struct {
 	postHandler sdk.PostHandler // post handler, optional, e.g. for tips
 
 	initChainer        sdk.InitChainer                // ABCI InitChain handler
+	preBlocker         sdk.PreBlocker                 // logic to run before BeginBlock
 	beginBlocker       sdk.BeginBlocker               // (legacy ABCI) BeginBlock handler
 	endBlocker         sdk.EndBlocker                 // (legacy ABCI) EndBlock handler
 	processProposal    sdk.ProcessProposalHandler     // ABCI ProcessProposal handler
@@ -679,6 +680,29 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 	return ctx.WithMultiStore(msCache), msCache
 }
 
+func (app *BaseApp) preBlock(req *abci.RequestFinalizeBlock) ([]abci.Event, error) {
+	var events []abci.Event
+
+	if app.preBlocker != nil {
+		ctx := app.finalizeBlockState.ctx.WithEventManager(sdk.NewEventManager())
+		if err := app.preBlocker(ctx, req); err != nil {
+			return nil, err
+		}
+
+		events = ctx.EventManager().ABCIEvents()
+		for i, event := range events {
+			events[i].Attributes = append(
+				event.Attributes,
+				abci.EventAttribute{Key: "mode", Value: "PreBlock"},
+			)
+		}
+
+		events = sdk.MarkEventsToIndex(events, app.indexEvents)
+	}
+
+	return events, nil
+}
+
 func (app *BaseApp) beginBlock(req *abci.RequestFinalizeBlock) sdk.BeginBlock {
 	var (
 		resp sdk.BeginBlock
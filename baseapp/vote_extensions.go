@@ -0,0 +1,270 @@
+package baseapp
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VoteExtensionModule defines the vote extension handlers of a module
+// registered in a VoteExtensionMux. The handlers only see the vote extension of
+// the module, never the vote extensions of the other modules.
+type VoteExtensionModule interface {
+	// ExtendVoteHandler returns the handler computing the vote extension of the
+	// module. An empty vote extension is not included.
+	ExtendVoteHandler() sdk.ExtendVoteHandler
+
+	// VerifyVoteExtensionHandler returns the handler verifying a non-empty vote
+	// extension of the module.
+	VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler
+
+	// PreBlock processes the extended commit info injected in the block, the
+	// vote extension of each vote being replaced by the vote extension of the
+	// module, empty if the validator did not include any.
+	PreBlock(ctx sdk.Context, extCommit abci.ExtendedCommitInfo) error
+}
+
+// VoteExtensionMux multiplexes the vote extensions of several modules in the
+// vote extension of the validators, encoded as a ModuleVoteExtensions.
+//
+// The proposer of a block injects the extended commit info of the previous
+// height, once, as the first transaction of its proposal, which is verified by
+// the other validators in ProcessProposal. Finally, the PreBlocker passes the
+// injected vote extensions of each module to its PreBlock.
+type VoteExtensionMux struct {
+	valStore ValidatorStore
+	names    []string
+	modules  map[string]VoteExtensionModule
+}
+
+// NewVoteExtensionMux creates a new VoteExtensionMux. The ValidatorStore is used
+// to verify the signatures of the injected vote extensions.
+func NewVoteExtensionMux(valStore ValidatorStore) *VoteExtensionMux {
+	return &VoteExtensionMux{
+		valStore: valStore,
+		modules:  make(map[string]VoteExtensionModule),
+	}
+}
+
+// Register registers the vote extension handlers of a module under the given
+// name. It panics if a module is already registered with the same name.
+func (m *VoteExtensionMux) Register(name string, module VoteExtensionModule) {
+	if _, ok := m.modules[name]; ok {
+		panic(fmt.Sprintf("vote extension module %s already registered", name))
+	}
+
+	m.names = append(m.names, name)
+	m.modules[name] = module
+}
+
+// ExtendVoteHandler returns an ExtendVoteHandler including the non-empty vote
+// extensions of the registered modules, in their registration order.
+func (m *VoteExtensionMux) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		var ves sdk.ModuleVoteExtensions
+		for _, name := range m.names {
+			res, err := m.modules[name].ExtendVoteHandler()(ctx, req)
+			if err != nil {
+				return nil, fmt.Errorf("failed to extend vote of module %s: %w", name, err)
+			}
+
+			if len(res.VoteExtension) == 0 {
+				continue
+			}
+
+			ves.Extensions = append(ves.Extensions, sdk.ModuleVoteExtension{Module: name, VoteExtension: res.VoteExtension})
+		}
+
+		if len(ves.Extensions) == 0 {
+			return &abci.ResponseExtendVote{}, nil
+		}
+
+		bz, err := ves.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns a VerifyVoteExtensionHandler rejecting the
+// vote extensions which cannot be decoded, include the vote extension of an
+// unknown module or of the same module twice, or are rejected by the handler of
+// any module.
+func (m *VoteExtensionMux) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if len(req.VoteExtension) == 0 {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+		}
+
+		ves, err := m.decodeVoteExtension(req.VoteExtension)
+		if err != nil {
+			ctx.Logger().Info("rejected vote extension", "validator", fmt.Sprintf("%X", req.ValidatorAddress), "err", err)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		for _, name := range m.names {
+			ve, ok := ves[name]
+			if !ok {
+				continue
+			}
+
+			moduleReq := *req
+			moduleReq.VoteExtension = ve
+
+			res, err := m.modules[name].VerifyVoteExtensionHandler()(ctx, &moduleReq)
+			if err != nil {
+				return nil, fmt.Errorf("failed to verify vote extension of module %s: %w", name, err)
+			}
+
+			if res.Status != abci.ResponseVerifyVoteExtension_ACCEPT {
+				return res, nil
+			}
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+// PrepareProposalHandler returns a PrepareProposalHandler injecting the extended
+// commit info of the previous height as the first transaction of the proposal,
+// the remaining transactions being selected by the given handler.
+func (m *VoteExtensionMux) PrepareProposalHandler(next sdk.PrepareProposalHandler) sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return next(ctx, req)
+		}
+
+		bz, err := req.LocalLastCommit.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		nextReq := *req
+		nextReq.MaxTxBytes -= int64(len(bz))
+
+		res, err := next(ctx, &nextReq)
+		if err != nil {
+			return nil, err
+		}
+
+		return &abci.ResponsePrepareProposal{Txs: append([][]byte{bz}, res.Txs...)}, nil
+	}
+}
+
+// ProcessProposalHandler returns a ProcessProposalHandler verifying the extended
+// commit info injected as the first transaction of the proposal, the remaining
+// transactions being processed by the given handler.
+func (m *VoteExtensionMux) ProcessProposalHandler(next sdk.ProcessProposalHandler) sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return next(ctx, req)
+		}
+
+		if len(req.Txs) == 0 {
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		var extCommit abci.ExtendedCommitInfo
+		if err := extCommit.Unmarshal(req.Txs[0]); err != nil {
+			ctx.Logger().Error("failed to decode injected extended commit info", "err", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		if err := ValidateVoteExtensions(ctx, m.valStore, req.Height, ctx.ChainID(), extCommit); err != nil {
+			ctx.Logger().Error("invalid injected vote extensions", "err", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		nextReq := *req
+		nextReq.Txs = req.Txs[1:]
+
+		return next(ctx, &nextReq)
+	}
+}
+
+// PreBlocker returns a PreBlocker passing the vote extensions injected in the
+// block to the registered modules, in their registration order. Vote extensions
+// which cannot be decoded are passed as empty vote extensions.
+func (m *VoteExtensionMux) PreBlocker() sdk.PreBlocker {
+	return func(ctx sdk.Context, req *abci.RequestFinalizeBlock) error {
+		if !voteExtensionsEnabled(ctx, req.Height) || len(req.Txs) == 0 {
+			return nil
+		}
+
+		var extCommit abci.ExtendedCommitInfo
+		if err := extCommit.Unmarshal(req.Txs[0]); err != nil {
+			return err
+		}
+
+		votes := make([]map[string][]byte, len(extCommit.Votes))
+		for i, vote := range extCommit.Votes {
+			if len(vote.VoteExtension) == 0 {
+				continue
+			}
+
+			ves, err := m.decodeVoteExtension(vote.VoteExtension)
+			if err != nil {
+				ctx.Logger().Info("ignored vote extension", "validator", fmt.Sprintf("%X", vote.Validator.Address), "err", err)
+				continue
+			}
+
+			votes[i] = ves
+		}
+
+		for _, name := range m.names {
+			moduleCommit := abci.ExtendedCommitInfo{
+				Round: extCommit.Round,
+				Votes: make([]abci.ExtendedVoteInfo, len(extCommit.Votes)),
+			}
+			for i, vote := range extCommit.Votes {
+				vote.VoteExtension = votes[i][name]
+				moduleCommit.Votes[i] = vote
+			}
+
+			if err := m.modules[name].PreBlock(ctx, moduleCommit); err != nil {
+				return fmt.Errorf("failed to process vote extensions of module %s: %w", name, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+// decodeVoteExtension decodes the given vote extension and returns the vote
+// extensions it includes by module name.
+func (m *VoteExtensionMux) decodeVoteExtension(bz []byte) (map[string][]byte, error) {
+	var ves sdk.ModuleVoteExtensions
+	if err := ves.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	res := make(map[string][]byte, len(ves.Extensions))
+	for _, ve := range ves.Extensions {
+		if _, ok := m.modules[ve.Module]; !ok {
+			return nil, fmt.Errorf("unknown vote extension module %s", ve.Module)
+		}
+
+		if _, ok := res[ve.Module]; ok {
+			return nil, fmt.Errorf("duplicate vote extension of module %s", ve.Module)
+		}
+
+		if len(ve.VoteExtension) == 0 {
+			return nil, fmt.Errorf("empty vote extension of module %s", ve.Module)
+		}
+
+		res[ve.Module] = ve.VoteExtension
+	}
+
+	return res, nil
+}
+
+// voteExtensionsEnabled returns true if the vote extensions of the previous
+// height are available at the given height.
+func voteExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	return cp.Abci != nil && cp.Abci.VoteExtensionsEnableHeight > 0 && height > cp.Abci.VoteExtensionsEnableHeight
+}
@@ -0,0 +1,256 @@
+package baseapp_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type voteExtValidatorStore struct{}
+
+func (voteExtValidatorStore) GetValidatorByConsAddr(sdk.Context, cryptotypes.Address) (baseapp.Validator, error) {
+	return nil, nil
+}
+
+func (voteExtValidatorStore) TotalBondedTokens(sdk.Context) math.Int {
+	return math.NewInt(100)
+}
+
+// voteExtModule extends the votes with a fixed vote extension, rejects the vote
+// extensions different from "invalid" and records the processed ones.
+type voteExtModule struct {
+	extension []byte
+	processed [][]byte
+}
+
+func (m *voteExtModule) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(sdk.Context, *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		return &abci.ResponseExtendVote{VoteExtension: m.extension}, nil
+	}
+}
+
+func (m *voteExtModule) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(_ sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if bytes.Equal(req.VoteExtension, []byte("invalid")) {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+func (m *voteExtModule) PreBlock(_ sdk.Context, extCommit abci.ExtendedCommitInfo) error {
+	for _, vote := range extCommit.Votes {
+		m.processed = append(m.processed, vote.VoteExtension)
+	}
+
+	return nil
+}
+
+func moduleVoteExtensions(t *testing.T, extensions ...sdk.ModuleVoteExtension) []byte {
+	t.Helper()
+
+	bz, err := (&sdk.ModuleVoteExtensions{Extensions: extensions}).Marshal()
+	require.NoError(t, err)
+
+	return bz
+}
+
+func newVoteExtensionContext() sdk.Context {
+	return sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger()).WithConsensusParams(cmtproto.ConsensusParams{
+		Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 2},
+	})
+}
+
+func TestVoteExtensionMux_ExtendVote(t *testing.T) {
+	ctx := newVoteExtensionContext()
+
+	mux := baseapp.NewVoteExtensionMux(voteExtValidatorStore{})
+	mux.Register("upgrade", &voteExtModule{extension: []byte("signal")})
+	mux.Register("empty", &voteExtModule{})
+	mux.Register("evidence", &voteExtModule{extension: []byte("evidence")})
+
+	require.Panics(t, func() { mux.Register("upgrade", &voteExtModule{}) })
+
+	// the empty vote extensions are not included
+	res, err := mux.ExtendVoteHandler()(ctx, &abci.RequestExtendVote{})
+	require.NoError(t, err)
+	require.Equal(t, moduleVoteExtensions(t,
+		sdk.ModuleVoteExtension{Module: "upgrade", VoteExtension: []byte("signal")},
+		sdk.ModuleVoteExtension{Module: "evidence", VoteExtension: []byte("evidence")},
+	), res.VoteExtension)
+
+	// no vote extension without any module vote extension
+	mux = baseapp.NewVoteExtensionMux(voteExtValidatorStore{})
+	mux.Register("empty", &voteExtModule{})
+
+	res, err = mux.ExtendVoteHandler()(ctx, &abci.RequestExtendVote{})
+	require.NoError(t, err)
+	require.Empty(t, res.VoteExtension)
+}
+
+func TestVoteExtensionMux_VerifyVoteExtension(t *testing.T) {
+	ctx := newVoteExtensionContext()
+
+	mux := baseapp.NewVoteExtensionMux(voteExtValidatorStore{})
+	mux.Register("upgrade", &voteExtModule{})
+	mux.Register("evidence", &voteExtModule{})
+
+	testCases := []struct {
+		name      string
+		extension []byte
+		expStatus abci.ResponseVerifyVoteExtension_VerifyStatus
+	}{
+		{"empty vote extension", nil, abci.ResponseVerifyVoteExtension_ACCEPT},
+		{
+			"valid vote extensions",
+			moduleVoteExtensions(t,
+				sdk.ModuleVoteExtension{Module: "upgrade", VoteExtension: []byte("signal")},
+				sdk.ModuleVoteExtension{Module: "evidence", VoteExtension: []byte("evidence")},
+			),
+			abci.ResponseVerifyVoteExtension_ACCEPT,
+		},
+		{"invalid encoding", []byte("invalid"), abci.ResponseVerifyVoteExtension_REJECT},
+		{
+			"unknown module",
+			moduleVoteExtensions(t, sdk.ModuleVoteExtension{Module: "unknown", VoteExtension: []byte("signal")}),
+			abci.ResponseVerifyVoteExtension_REJECT,
+		},
+		{
+			"duplicate module",
+			moduleVoteExtensions(t,
+				sdk.ModuleVoteExtension{Module: "upgrade", VoteExtension: []byte("signal")},
+				sdk.ModuleVoteExtension{Module: "upgrade", VoteExtension: []byte("signal")},
+			),
+			abci.ResponseVerifyVoteExtension_REJECT,
+		},
+		{
+			"empty module vote extension",
+			moduleVoteExtensions(t, sdk.ModuleVoteExtension{Module: "upgrade"}),
+			abci.ResponseVerifyVoteExtension_REJECT,
+		},
+		{
+			"rejected by module",
+			moduleVoteExtensions(t,
+				sdk.ModuleVoteExtension{Module: "upgrade", VoteExtension: []byte("signal")},
+				sdk.ModuleVoteExtension{Module: "evidence", VoteExtension: []byte("invalid")},
+			),
+			abci.ResponseVerifyVoteExtension_REJECT,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := mux.VerifyVoteExtensionHandler()(ctx, &abci.RequestVerifyVoteExtension{VoteExtension: tc.extension})
+			require.NoError(t, err)
+			require.Equal(t, tc.expStatus, res.Status)
+		})
+	}
+}
+
+func TestVoteExtensionMux_ProposalHandlers(t *testing.T) {
+	ctx := newVoteExtensionContext()
+
+	mux := baseapp.NewVoteExtensionMux(voteExtValidatorStore{})
+	mux.Register("upgrade", &voteExtModule{})
+	mux.Register("evidence", &voteExtModule{})
+
+	extCommit := abci.ExtendedCommitInfo{
+		Votes: []abci.ExtendedVoteInfo{{
+			Validator:     abci.Validator{Address: []byte("validator"), Power: 100},
+			VoteExtension: moduleVoteExtensions(t, sdk.ModuleVoteExtension{Module: "upgrade", VoteExtension: []byte("signal")}),
+			BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+		}},
+	}
+	bz, err := extCommit.Marshal()
+	require.NoError(t, err)
+
+	var nextReq *abci.RequestPrepareProposal
+	prepare := mux.PrepareProposalHandler(func(_ sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		nextReq = req
+		return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
+	})
+
+	// vote extensions are not available before the enable height
+	prepareRes, err := prepare(ctx, &abci.RequestPrepareProposal{Height: 2, MaxTxBytes: 1000, Txs: [][]byte{[]byte("tx")}, LocalLastCommit: extCommit})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("tx")}, prepareRes.Txs)
+
+	// the extended commit info is injected once for all the modules
+	prepareRes, err = prepare(ctx, &abci.RequestPrepareProposal{Height: 3, MaxTxBytes: 1000, Txs: [][]byte{[]byte("tx")}, LocalLastCommit: extCommit})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{bz, []byte("tx")}, prepareRes.Txs)
+	require.Equal(t, int64(1000-len(bz)), nextReq.MaxTxBytes)
+
+	process := mux.ProcessProposalHandler(func(sdk.Context, *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	})
+
+	testCases := []struct {
+		name      string
+		height    int64
+		txs       [][]byte
+		expStatus abci.ResponseProcessProposal_ProposalStatus
+	}{
+		{"vote extensions not available", 2, [][]byte{[]byte("tx")}, abci.ResponseProcessProposal_ACCEPT},
+		{"missing extended commit info", 3, nil, abci.ResponseProcessProposal_REJECT},
+		{"invalid extended commit info", 3, [][]byte{[]byte("invalid")}, abci.ResponseProcessProposal_REJECT},
+		{"unverified vote extensions", 3, [][]byte{bz}, abci.ResponseProcessProposal_REJECT},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := process(ctx, &abci.RequestProcessProposal{Height: tc.height, Txs: tc.txs})
+			require.NoError(t, err)
+			require.Equal(t, tc.expStatus, res.Status)
+		})
+	}
+}
+
+func TestVoteExtensionMux_PreBlocker(t *testing.T) {
+	ctx := newVoteExtensionContext()
+
+	upgrade, evidence := &voteExtModule{}, &voteExtModule{}
+	mux := baseapp.NewVoteExtensionMux(voteExtValidatorStore{})
+	mux.Register("upgrade", upgrade)
+	mux.Register("evidence", evidence)
+
+	extCommit := abci.ExtendedCommitInfo{
+		Votes: []abci.ExtendedVoteInfo{
+			{
+				VoteExtension: moduleVoteExtensions(t,
+					sdk.ModuleVoteExtension{Module: "upgrade", VoteExtension: []byte("signal")},
+					sdk.ModuleVoteExtension{Module: "evidence", VoteExtension: []byte("evidence")},
+				),
+				BlockIdFlag: cmtproto.BlockIDFlagCommit,
+			},
+			{
+				VoteExtension: moduleVoteExtensions(t, sdk.ModuleVoteExtension{Module: "evidence", VoteExtension: []byte("other")}),
+				BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+			},
+			{VoteExtension: []byte("invalid"), BlockIdFlag: cmtproto.BlockIDFlagCommit},
+		},
+	}
+	bz, err := extCommit.Marshal()
+	require.NoError(t, err)
+
+	// vote extensions are not available before the enable height
+	require.NoError(t, mux.PreBlocker()(ctx, &abci.RequestFinalizeBlock{Height: 2, Txs: [][]byte{bz}}))
+	require.Empty(t, upgrade.processed)
+	require.Empty(t, evidence.processed)
+
+	// each module only sees its own vote extensions
+	require.NoError(t, mux.PreBlocker()(ctx, &abci.RequestFinalizeBlock{Height: 3, Txs: [][]byte{bz}}))
+	require.Equal(t, [][]byte{[]byte("signal"), nil, nil}, upgrade.processed)
+	require.Equal(t, [][]byte{[]byte("evidence"), []byte("other"), nil}, evidence.processed)
+}
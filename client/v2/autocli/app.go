@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // AppOptions are autocli options for an app. These options can be built via depinject based on an app config. Ex:
@@ -64,6 +65,9 @@ func (appOptions AppOptions) EnhanceRootCommand(rootCmd *cobra.Command) error {
 		},
 		AddQueryConnFlags: flags.AddQueryFlagsToCmd,
 		AddTxConnFlags:    flags.AddTxFlagsToCmd,
+		GenerateOrBroadcastTx: func(cmd *cobra.Command, msg protoreflect.Message) error {
+			return GenerateOrBroadcastTxCLI(cmd, appOptions.AddressCodec, msg)
+		},
 	}
 
 	return appOptions.EnhanceRootCommandWithBuilder(rootCmd, builder)
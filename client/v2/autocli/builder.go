@@ -3,6 +3,7 @@ package autocli
 import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"cosmossdk.io/client/v2/autocli/flag"
 )
@@ -19,4 +20,16 @@ type Builder struct {
 	AddQueryConnFlags func(*cobra.Command)
 
 	AddTxConnFlags func(*cobra.Command)
+
+	// GenerateOrBroadcastTx specifies how msg commands build a transaction
+	// from the message provided by the user and generate, sign or broadcast
+	// it. If it is nil, msg commands output the JSON representation of the
+	// message instead. See GenerateOrBroadcastTxCLI for the default
+	// implementation.
+	GenerateOrBroadcastTx func(cmd *cobra.Command, msg protoreflect.Message) error
+
+	// Prompter specifies how commands run with the --interactive flag ask the
+	// user for the fields of the message. If it is nil, the user is prompted
+	// on the terminal.
+	Prompter flag.Prompter
 }
@@ -50,9 +50,36 @@ func (b *Builder) buildMethodCommandCommon(descriptor protoreflect.MethodDescrip
 		return nil, err
 	}
 
-	cmd.Args = binder.CobraArgs
+	cmd.Args = func(cmd *cobra.Command, args []string) error {
+		// in interactive mode all the fields are prompted for instead
+		if interactive, _ := cmd.Flags().GetBool(flagInteractive); interactive {
+			return cobra.NoArgs(cmd, args)
+		}
+
+		return binder.CobraArgs(cmd, args)
+	}
 
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		interactive, err := cmd.Flags().GetBool(flagInteractive)
+		if err != nil {
+			return err
+		}
+
+		if interactive {
+			input := inputType.New()
+			ok, err := b.promptMessage(cmd, input)
+			if err != nil {
+				return err
+			}
+
+			if !ok {
+				_, err = fmt.Fprintln(cmd.ErrOrStderr(), "canceled")
+				return err
+			}
+
+			return exec(cmd, input)
+		}
+
 		input, err := binder.BuildMessage(args)
 		if err != nil {
 			return err
@@ -61,6 +88,8 @@ func (b *Builder) buildMethodCommandCommon(descriptor protoreflect.MethodDescrip
 		return exec(cmd, input)
 	}
 
+	cmd.Flags().Bool(flagInteractive, false, "Prompt for the fields of the request instead of reading them from arguments and flags")
+
 	return cmd, nil
 }
 
@@ -88,12 +88,20 @@ func (f fieldBinding) bind(msg protoreflect.Message) error {
 	if err != nil {
 		return err
 	}
-	kind := f.field.Kind()
-	if !(field.IsList() ||
-		field.IsMap() ||
-		kind == protoreflect.MessageKind ||
-		kind == protoreflect.GroupKind) {
-		msg.Set(f.field, val)
+	// composite values are built on top of a new field value which is not
+	// attached to msg so they need to be set explicitly
+	switch {
+	case !val.IsValid():
+	case field.IsList():
+		if val.List().Len() > 0 {
+			msg.Set(field, val)
+		}
+	case field.IsMap():
+		if val.Map().Len() > 0 {
+			msg.Set(field, val)
+		}
+	default:
+		msg.Set(field, val)
 	}
 	return nil
 }
@@ -0,0 +1,149 @@
+package flag
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"cosmossdk.io/client/v2/internal/util"
+)
+
+// Prompter asks the user for the values of a message when it is built
+// interactively.
+type Prompter interface {
+	// Prompt asks the user for a value with the given label. Inputs are only
+	// accepted once validate returns no error for them. An empty input leaves
+	// the value unset.
+	Prompt(label string, validate func(string) error) (string, error)
+
+	// Confirm asks the user a yes or no question.
+	Confirm(label string) (bool, error)
+}
+
+// PromptMessage walks the fields of msg and asks the user for their values
+// using prompter. Inputs are parsed and validated the same way as the
+// corresponding flags so that, for instance, addresses are validated with the
+// address codec and enums must be one of their defined values. Nested
+// messages which don't have a custom flag type are prompted field by field and
+// repeated and map fields are prompted until the user enters an empty value.
+func (b *Builder) PromptMessage(ctx context.Context, prompter Prompter, msg protoreflect.Message) error {
+	b.init()
+	return b.promptMessage(ctx, prompter, msg, "")
+}
+
+func (b *Builder) promptMessage(ctx context.Context, prompter Prompter, msg protoreflect.Message, prefix string) error {
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+
+		// only one field of a oneof can be set
+		if oneof := field.ContainingOneof(); oneof != nil && msg.WhichOneof(oneof) != nil {
+			continue
+		}
+
+		label := prefix + util.DescriptorKebabName(field)
+		if err := b.promptField(ctx, prompter, msg, field, label); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *Builder) promptField(ctx context.Context, prompter Prompter, msg protoreflect.Message, field protoreflect.FieldDescriptor, label string) error {
+	if b.isNestedMessage(field) {
+		if field.IsList() {
+			list := msg.Mutable(field).List()
+			for {
+				ok, err := prompter.Confirm(fmt.Sprintf("Add %s[%d]", label, list.Len()))
+				if err != nil || !ok {
+					return err
+				}
+
+				elem := list.NewElement()
+				if err := b.promptMessage(ctx, prompter, elem.Message(), fmt.Sprintf("%s[%d].", label, list.Len())); err != nil {
+					return err
+				}
+				list.Append(elem)
+			}
+		}
+
+		ok, err := prompter.Confirm(fmt.Sprintf("Set %s", label))
+		if err != nil || !ok {
+			return err
+		}
+
+		return b.promptMessage(ctx, prompter, msg.Mutable(field).Message(), label+".")
+	}
+
+	// inputs are parsed with the same value the field would be bound to as a flag
+	flagSet, name, hasValue, err := b.promptFlagSet(ctx, field)
+	if err != nil {
+		return err
+	}
+
+	label = fmt.Sprintf("%s (%s)", label, flagSet.Lookup(name).Value.Type())
+	repeated := field.IsList() || field.IsMap()
+	if repeated {
+		label += ", empty to finish"
+	}
+
+	validate := func(input string) error {
+		if input == "" {
+			return nil
+		}
+
+		// use a separate flag set so that rejected inputs are not appended
+		// to the value of repeated fields
+		flagSet, name, _, err := b.promptFlagSet(ctx, field)
+		if err != nil {
+			return err
+		}
+
+		return flagSet.Set(name, input)
+	}
+
+	for {
+		input, err := prompter.Prompt(label, validate)
+		if err != nil {
+			return err
+		}
+
+		if input == "" {
+			break
+		}
+
+		if err := flagSet.Set(name, input); err != nil {
+			return err
+		}
+
+		if !repeated {
+			break
+		}
+	}
+
+	if !flagSet.Changed(name) {
+		return nil
+	}
+
+	return fieldBinding{hasValue: hasValue, field: field}.bind(msg)
+}
+
+func (b *Builder) promptFlagSet(ctx context.Context, field protoreflect.FieldDescriptor) (*pflag.FlagSet, string, HasValue, error) {
+	flagSet := pflag.NewFlagSet("prompt", pflag.ContinueOnError)
+	name, hasValue, err := b.addFieldFlag(ctx, flagSet, field, nil, namingOptions{})
+	return flagSet, name, hasValue, err
+}
+
+// isNestedMessage returns true if field is a message, or a list of messages,
+// which doesn't have a custom flag type and is thus prompted field by field.
+func (b *Builder) isNestedMessage(field protoreflect.FieldDescriptor) bool {
+	if field.IsMap() || field.Kind() != protoreflect.MessageKind {
+		return false
+	}
+
+	_, ok := b.messageFlagTypes[field.Message().FullName()]
+	return !ok
+}
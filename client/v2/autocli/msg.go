@@ -2,11 +2,19 @@ package autocli
 
 import (
 	"fmt"
+	"reflect"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
+	"cosmossdk.io/core/address"
 	"github.com/cockroachdb/errors"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
 )
@@ -89,7 +97,8 @@ func (b *Builder) AddMsgServiceCommands(cmd *cobra.Command, cmdDescriptor *autoc
 	return nil
 }
 
-// BuildMsgMethodCommand returns a command that outputs the JSON representation of the message.
+// BuildMsgMethodCommand returns a command that generates, signs or broadcasts a transaction containing the message
+// using Builder.GenerateOrBroadcastTx or, if it is nil, outputs the JSON representation of the message.
 func (b *Builder) BuildMsgMethodCommand(descriptor protoreflect.MethodDescriptor, options *autocliv1.RpcCommandOptions) (*cobra.Command, error) {
 	jsonMarshalOptions := protojson.MarshalOptions{
 		Indent:          "  ",
@@ -100,6 +109,10 @@ func (b *Builder) BuildMsgMethodCommand(descriptor protoreflect.MethodDescriptor
 	}
 
 	cmd, err := b.buildMethodCommandCommon(descriptor, options, func(cmd *cobra.Command, input protoreflect.Message) error {
+		if b.GenerateOrBroadcastTx != nil {
+			return b.GenerateOrBroadcastTx(cmd, input)
+		}
+
 		bz, err := jsonMarshalOptions.Marshal(input.Interface())
 		if err != nil {
 			return err
@@ -108,9 +121,93 @@ func (b *Builder) BuildMsgMethodCommand(descriptor protoreflect.MethodDescriptor
 		return b.outOrStdoutFormat(cmd, bz)
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
 	if b.AddTxConnFlags != nil {
 		b.AddTxConnFlags(cmd)
 	}
 
-	return cmd, err
+	return cmd, nil
+}
+
+// GenerateOrBroadcastTxCLI builds a transaction containing msg from the client
+// context and tx flags of cmd and then, as with the hand-written tx commands,
+// either prints it unsigned (--generate-only), prints the aux signer data
+// (--aux, for SIGN_MODE_DIRECT_AUX) or signs and broadcasts it. Offline
+// signing is supported with the --offline, --account-number and --sequence
+// flags and the generated transactions can be signed by multisig members with
+// the tx sign, tx multisign and tx broadcast commands. If the first signer
+// field of msg is an unset string field, it is populated with the address of
+// the --from account.
+func GenerateOrBroadcastTxCLI(cmd *cobra.Command, addressCodec address.Codec, msg protoreflect.Message) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := setSigner(clientCtx, addressCodec, msg); err != nil {
+		return err
+	}
+
+	sdkMsg, err := toSDKMsg(msg)
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), sdkMsg)
+}
+
+// setSigner populates the first signer field of msg with the from address of
+// the client context if it is an unset string field.
+func setSigner(clientCtx client.Context, addressCodec address.Codec, msg protoreflect.Message) error {
+	signers, ok := proto.GetExtension(msg.Descriptor().Options(), msgv1.E_Signer).([]string)
+	if !ok || len(signers) == 0 {
+		return nil
+	}
+
+	field := msg.Descriptor().Fields().ByName(protoreflect.Name(signers[0]))
+	if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() || msg.Has(field) {
+		return nil
+	}
+
+	from := clientCtx.GetFromAddress()
+	if from.Empty() {
+		return nil
+	}
+
+	addr, err := addressCodec.BytesToString(from)
+	if err != nil {
+		return err
+	}
+
+	msg.Set(field, protoreflect.ValueOfString(addr))
+	return nil
+}
+
+// toSDKMsg converts msg to the gogoproto type registered for the same message
+// name which is what the SDK tx builders expect.
+func toSDKMsg(msg protoreflect.Message) (sdk.Msg, error) {
+	name := string(msg.Descriptor().FullName())
+	typ := gogoproto.MessageType(name)
+	if typ == nil {
+		return nil, errors.Errorf("can't find gogoproto type for %s", name)
+	}
+
+	sdkMsg, ok := reflect.New(typ.Elem()).Interface().(sdk.Msg)
+	if !ok {
+		return nil, errors.Errorf("%s is not an sdk.Msg", name)
+	}
+
+	bz, err := proto.Marshal(msg.Interface())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := gogoproto.Unmarshal(bz, sdkMsg); err != nil {
+		return nil, err
+	}
+
+	return sdkMsg, nil
 }
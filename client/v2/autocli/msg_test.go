@@ -1,16 +1,24 @@
 package autocli
 
 import (
+	"bytes"
 	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"gotest.tools/v3/assert"
 	"gotest.tools/v3/golden"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	"github.com/cosmos/cosmos-sdk/client"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"cosmossdk.io/client/v2/autocli/flag"
 	"cosmossdk.io/client/v2/internal/testpb"
 )
 
@@ -361,3 +369,40 @@ func TestEnhanceMessageCommand(t *testing.T) {
 	err = b.enhanceCommandCommon(cmd, appOptions, customCommands, enhanceMsg)
 	assert.NilError(t, err)
 }
+
+func TestMsgGenerateOrBroadcastTx(t *testing.T) {
+	var called protoreflect.Message
+	cmd, err := (&Builder{
+		Builder: flag.Builder{AddressCodec: addresscodec.NewBech32Codec("cosmos")},
+		GenerateOrBroadcastTx: func(_ *cobra.Command, msg protoreflect.Message) error {
+			called = msg
+			return nil
+		},
+	}).BuildMsgMethodCommand(testpb.File_testpb_msg_proto.Services().Get(0).Methods().ByName("Send"), testCmdMsgDesc.RpcCommandOptions[0])
+	assert.NilError(t, err)
+
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"5", "6", "1foo"})
+	assert.NilError(t, cmd.Execute())
+	assert.Equal(t, out.Len(), 0)
+	assert.Equal(t, called.Interface().(*testpb.MsgRequest).GetPositional1(), int32(5))
+}
+
+func TestSetSigner(t *testing.T) {
+	ac := addresscodec.NewBech32Codec("cosmos")
+	from := sdk.AccAddress("from")
+	clientCtx := client.Context{}.WithFromAddress(from)
+
+	msg := &bankv1beta1.MsgSend{ToAddress: "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk"}
+	assert.NilError(t, setSigner(clientCtx, ac, msg.ProtoReflect()))
+	assert.Equal(t, msg.FromAddress, from.String())
+
+	// an explicitly provided signer is not overwritten
+	msg.FromAddress = "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk"
+	assert.NilError(t, setSigner(clientCtx, ac, msg.ProtoReflect()))
+	assert.Equal(t, msg.FromAddress, "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk")
+
+	_, err := toSDKMsg((&testpb.MsgRequest{}).ProtoReflect())
+	assert.ErrorContains(t, err, "can't find gogoproto type")
+}
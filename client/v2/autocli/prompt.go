@@ -0,0 +1,72 @@
+package autocli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"cosmossdk.io/client/v2/autocli/flag"
+)
+
+const flagInteractive = "interactive"
+
+// promptMessage asks the user for the fields of msg with the builder's
+// prompter, shows the resulting message as JSON and asks the user to confirm
+// it. It returns false if the user didn't confirm the message.
+func (b *Builder) promptMessage(cmd *cobra.Command, msg protoreflect.Message) (bool, error) {
+	prompter := b.Prompter
+	if prompter == nil {
+		prompter = promptuiPrompter{}
+	}
+
+	if err := b.PromptMessage(cmd.Context(), prompter, msg); err != nil {
+		return false, err
+	}
+
+	bz, err := protojson.MarshalOptions{
+		Indent:        "  ",
+		UseProtoNames: true,
+		Resolver:      b.TypeResolver,
+	}.Marshal(msg.Interface())
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := fmt.Fprintln(cmd.ErrOrStderr(), string(bz)); err != nil {
+		return false, err
+	}
+
+	return prompter.Confirm(fmt.Sprintf("Confirm %s", msg.Descriptor().Name()))
+}
+
+// promptuiPrompter prompts the user on the terminal.
+type promptuiPrompter struct{}
+
+var _ flag.Prompter = promptuiPrompter{}
+
+func (promptuiPrompter) Prompt(label string, validate func(string) error) (string, error) {
+	prompt := promptui.Prompt{
+		Label:    label,
+		Validate: validate,
+	}
+
+	return prompt.Run()
+}
+
+func (promptuiPrompter) Confirm(label string) (bool, error) {
+	prompt := promptui.Prompt{
+		Label:     label,
+		IsConfirm: true,
+	}
+
+	_, err := prompt.Run()
+	if errors.Is(err, promptui.ErrAbort) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
@@ -0,0 +1,116 @@
+package autocli
+
+import (
+	"strings"
+	"testing"
+
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/testing/protocmp"
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/client/v2/internal/testpb"
+)
+
+// testPrompter answers prompts by field name and records the inputs rejected
+// by validation.
+type testPrompter struct {
+	inputs   map[string][]string
+	confirms map[string][]bool
+	invalid  []string
+}
+
+func (p *testPrompter) Prompt(label string, validate func(string) error) (string, error) {
+	name, _, _ := strings.Cut(label, " ")
+	for {
+		inputs := p.inputs[name]
+		if len(inputs) == 0 {
+			return "", nil
+		}
+
+		input := inputs[0]
+		p.inputs[name] = inputs[1:]
+		if err := validate(input); err != nil {
+			p.invalid = append(p.invalid, input)
+			continue
+		}
+
+		return input, nil
+	}
+}
+
+func (p *testPrompter) Confirm(label string) (bool, error) {
+	confirms := p.confirms[label]
+	if len(confirms) == 0 {
+		return false, nil
+	}
+
+	p.confirms[label] = confirms[1:]
+	return confirms[0], nil
+}
+
+func buildWithPrompter(prompter *testPrompter) func(string, *Builder) (*cobra.Command, error) {
+	return func(moduleName string, b *Builder) (*cobra.Command, error) {
+		b.Prompter = prompter
+		return buildModuleQueryCommand(moduleName, b)
+	}
+}
+
+func TestInteractive(t *testing.T) {
+	prompter := &testPrompter{
+		inputs: map[string][]string{
+			"u32":                  {"abc", "5"},
+			"an-enum":              {"three", "two"},
+			"a-coin":               {"100foo"},
+			"an-address":           {"cosmos1", "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk"},
+			"a-message.baz":        {"-3"},
+			"strings":              {"abc", "def"},
+			"some-messages[0].bar": {"xyz"},
+			"positional3-varargs":  {"1foo", "2bar"},
+			"map-string-uint32":    {"a=1", "b=2"},
+		},
+		confirms: map[string][]bool{
+			"Set a-message":        {true},
+			"Add some-messages[0]": {true},
+			"Confirm EchoRequest":  {true},
+		},
+	}
+
+	conn := testExecCommon(t, buildWithPrompter(prompter), "echo", "--interactive")
+	assert.Assert(t, strings.Contains(conn.errorOut.String(), "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk"))
+	assert.DeepEqual(t, prompter.invalid, []string{"abc", "three", "cosmos1"})
+	assert.DeepEqual(t, conn.lastRequest, &testpb.EchoRequest{
+		U32:       5,
+		AnEnum:    testpb.Enum_ENUM_TWO,
+		ACoin:     &basev1beta1.Coin{Denom: "foo", Amount: "100"},
+		AnAddress: "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk",
+		AMessage:  &testpb.AMessage{Baz: -3},
+		Strings:   []string{"abc", "def"},
+		SomeMessages: []*testpb.AMessage{
+			{Bar: "xyz"},
+		},
+		Positional3Varargs: []*basev1beta1.Coin{
+			{Denom: "foo", Amount: "1"},
+			{Denom: "bar", Amount: "2"},
+		},
+		MapStringUint32: map[string]uint32{"a": 1, "b": 2},
+	}, protocmp.Transform())
+}
+
+func TestInteractiveCanceled(t *testing.T) {
+	prompter := &testPrompter{
+		inputs:   map[string][]string{"u32": {"5"}},
+		confirms: map[string][]bool{"Confirm EchoRequest": {false}},
+	}
+
+	conn := testExecCommon(t, buildWithPrompter(prompter), "echo", "--interactive")
+	assert.Assert(t, conn.lastRequest == nil)
+	assert.Assert(t, strings.Contains(conn.errorOut.String(), "u32"))
+	assert.Assert(t, strings.HasSuffix(conn.errorOut.String(), "canceled\n"))
+}
+
+func TestInteractivePositionalArgs(t *testing.T) {
+	conn := testExecCommon(t, buildWithPrompter(&testPrompter{}), "echo", "1", "abc", "--interactive")
+	assert.Assert(t, conn.lastRequest == nil)
+	assert.Assert(t, strings.Contains(conn.errorOut.String(), "unknown command"))
+}
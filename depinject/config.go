@@ -46,10 +46,11 @@ func provide(ctr *container, key *moduleKey, providers []interface{}) error {
 		if err != nil {
 			return errors.WithStack(err)
 		}
-		_, err = ctr.addNode(&rc, key)
+		node, err := ctr.addNode(&rc, key)
 		if err != nil {
 			return errors.WithStack(err)
 		}
+		ctr.providers = append(ctr.providers, registeredProvider{provider: &rc, key: key, node: node})
 	}
 	return nil
 }
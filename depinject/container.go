@@ -16,6 +16,9 @@ type container struct {
 	resolvers         map[string]resolver
 	interfaceBindings map[string]interfaceBinding
 	invokers          []invoker
+	providers         []registeredProvider
+	implicitBindings  []implicitBinding
+	usedBindings      map[string]bool
 
 	moduleKeyContext *ModuleKeyContext
 
@@ -50,6 +53,7 @@ func newContainer(cfg *debugConfig) *container {
 		resolvers:         map[string]resolver{},
 		moduleKeyContext:  &ModuleKeyContext{},
 		interfaceBindings: map[string]interfaceBinding{},
+		usedBindings:      map[string]bool{},
 		callerStack:       nil,
 		callerMap:         map[Location]bool{},
 	}
@@ -164,6 +168,7 @@ func (c *container) getResolver(typ reflect.Type, key *moduleKey) (resolver, err
 			res, _ = c.resolverByType(resolverType)
 			c.logf("Implicitly registering resolver %v for interface type %v", resolverType, typ)
 			c.addResolver(typ, res)
+			c.implicitBindings = append(c.implicitBindings, implicitBinding{interfaceType: typ, implType: resolverType})
 		} else if len(matches) > 1 {
 			return nil, newErrMultipleImplicitInterfaceBindings(typ, matches)
 		}
@@ -177,17 +182,21 @@ func (c *container) getExplicitResolver(typ reflect.Type, key *moduleKey) (resol
 	var found bool
 
 	// module scoped binding takes precedence
-	pref, found = c.interfaceBindings[bindingKeyFromType(typ, key)]
+	bindingKey := bindingKeyFromType(typ, key)
+	pref, found = c.interfaceBindings[bindingKey]
 
 	// fallback to global scope binding
 	if !found {
-		pref, found = c.interfaceBindings[bindingKeyFromType(typ, nil)]
+		bindingKey = bindingKeyFromType(typ, nil)
+		pref, found = c.interfaceBindings[bindingKey]
 	}
 
 	if !found {
 		return nil, nil
 	}
 
+	c.usedBindings[bindingKey] = true
+
 	if pref.resolver != nil {
 		return pref.resolver, nil
 	}
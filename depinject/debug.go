@@ -200,6 +200,9 @@ type debugConfig struct {
 	visualizers   []func(string)
 	logVisualizer bool
 
+	// inspection
+	inspectors []func(ContainerInfo)
+
 	// extra processing
 	onError   DebugOption
 	onSuccess DebugOption
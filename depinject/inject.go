@@ -78,5 +78,10 @@ func doInject(cfg *debugConfig, loc Location, debugOpt DebugOption, config Confi
 	}
 	cfg.dedentLogger()
 
-	return ctr.build(loc, outputs...)
+	if err := ctr.build(loc, outputs...); err != nil {
+		return err
+	}
+
+	ctr.inspect()
+	return nil
 }
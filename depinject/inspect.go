@@ -0,0 +1,253 @@
+package depinject
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// ContainerInfo describes how a container was resolved. It is produced by the
+// Inspector debug option after the container has been built successfully.
+type ContainerInfo struct {
+	// Providers lists all the providers registered with Provide and
+	// ProvideInModule in registration order.
+	Providers []ProviderInfo `json:"providers"`
+
+	// ModuleKeys lists the names of all the module keys created by the
+	// container in sorted order.
+	ModuleKeys []string `json:"module_keys"`
+
+	// InterfaceBindings lists the explicit bindings registered with
+	// BindInterface and BindInterfaceInModule as well as the implicit
+	// bindings created when an interface has a single implementation.
+	InterfaceBindings []InterfaceBindingInfo `json:"interface_bindings"`
+
+	// Invokers lists the invokers in the order in which they were called.
+	Invokers []InvokerInfo `json:"invokers"`
+
+	// Warnings lists unused providers, unused explicit bindings and ambiguous
+	// implicit bindings.
+	Warnings []string `json:"warnings"`
+
+	// DOT is the rendering of the container in the Graphviz DOT format, as
+	// passed to Visualizer.
+	DOT string `json:"-"`
+}
+
+// ProviderInfo describes a provider registered with the container.
+type ProviderInfo struct {
+	// Location is the fully qualified name of the provider function.
+	Location string `json:"location"`
+
+	// Module is the name of the module the provider was registered in with
+	// ProvideInModule, if any.
+	Module string `json:"module,omitempty"`
+
+	// ModuleScoped is true if the provider depends on ModuleKey and is thus
+	// called once per module which depends on it.
+	ModuleScoped bool `json:"module_scoped,omitempty"`
+
+	// Inputs are the types of the provider's input parameters.
+	Inputs []string `json:"inputs"`
+
+	// Outputs are the types of the provider's output parameters.
+	Outputs []string `json:"outputs"`
+
+	// Called is true if the provider was called while building the container.
+	Called bool `json:"called"`
+}
+
+// InterfaceBindingInfo describes an interface binding.
+type InterfaceBindingInfo struct {
+	// Interface is the fully qualified name of the interface type.
+	Interface string `json:"interface"`
+
+	// Implementation is the fully qualified name of the implementation type.
+	Implementation string `json:"implementation"`
+
+	// Module is the name of the module the binding is scoped to, if any.
+	Module string `json:"module,omitempty"`
+
+	// Explicit is true if the binding was registered with BindInterface
+	// or BindInterfaceInModule.
+	Explicit bool `json:"explicit"`
+
+	// Used is true if the binding was used to resolve a dependency.
+	Used bool `json:"used"`
+}
+
+// InvokerInfo describes an invoker registered with the container.
+type InvokerInfo struct {
+	// Location is the fully qualified name of the invoker function.
+	Location string `json:"location"`
+
+	// Module is the name of the module the invoker was registered in with
+	// InvokeInModule, if any.
+	Module string `json:"module,omitempty"`
+}
+
+// JSON returns an indented JSON rendering of the container info.
+func (i ContainerInfo) JSON() ([]byte, error) {
+	return json.MarshalIndent(i, "", "  ")
+}
+
+// Inspector creates an option which provides an inspector function which
+// will receive a description of the container's providers, module keys,
+// interface bindings and invokers whenever the container is built
+// successfully. It is not called if the container fails to build.
+func Inspector(inspector func(ContainerInfo)) DebugOption {
+	return debugOption(func(c *debugConfig) error {
+		c.inspectors = append(c.inspectors, inspector)
+		return nil
+	})
+}
+
+type registeredProvider struct {
+	provider *providerDescriptor
+	key      *moduleKey
+	node     interface{}
+}
+
+type implicitBinding struct {
+	interfaceType reflect.Type
+	implType      reflect.Type
+}
+
+func (c *container) inspect() {
+	if len(c.inspectors) == 0 {
+		return
+	}
+
+	info := c.containerInfo()
+	for _, inspector := range c.inspectors {
+		inspector(info)
+	}
+}
+
+func (c *container) containerInfo() ContainerInfo {
+	info := ContainerInfo{
+		Providers:         []ProviderInfo{},
+		ModuleKeys:        []string{},
+		InterfaceBindings: []InterfaceBindingInfo{},
+		Invokers:          []InvokerInfo{},
+		Warnings:          []string{},
+		DOT:               c.graph.String(),
+	}
+
+	for _, p := range c.providers {
+		pi := ProviderInfo{
+			Location: p.provider.Location.Name(),
+			Module:   moduleKeyName(p.key),
+			Inputs:   []string{},
+			Outputs:  []string{},
+		}
+
+		for _, in := range p.provider.Inputs {
+			pi.Inputs = append(pi.Inputs, moreUsefulTypeString(in.Type))
+		}
+
+		for _, out := range p.provider.Outputs {
+			pi.Outputs = append(pi.Outputs, moreUsefulTypeString(out.Type))
+		}
+
+		switch node := p.node.(type) {
+		case *simpleProvider:
+			pi.Called = node.called
+		case *moduleDepProvider:
+			pi.ModuleScoped = true
+			pi.Called = len(node.calledForModule) > 0
+		}
+
+		if !pi.Called {
+			info.Warnings = append(info.Warnings, fmt.Sprintf("provider %s is never called", pi.Location))
+		}
+
+		info.Providers = append(info.Providers, pi)
+	}
+
+	for name := range c.moduleKeyContext.moduleKeys {
+		info.ModuleKeys = append(info.ModuleKeys, name)
+	}
+	sort.Strings(info.ModuleKeys)
+
+	var bindingKeys []string
+	for key := range c.interfaceBindings {
+		bindingKeys = append(bindingKeys, key)
+	}
+	sort.Strings(bindingKeys)
+
+	for _, key := range bindingKeys {
+		binding := c.interfaceBindings[key]
+		bi := InterfaceBindingInfo{
+			Interface:      binding.interfaceName,
+			Implementation: binding.implTypeName,
+			Module:         moduleKeyName(binding.moduleKey),
+			Explicit:       true,
+			Used:           c.usedBindings[key],
+		}
+
+		if !bi.Used {
+			info.Warnings = append(info.Warnings, fmt.Sprintf("binding of %s to %s%s is never used",
+				bi.Interface, bi.Implementation, inModule(bi.Module)))
+		}
+
+		info.InterfaceBindings = append(info.InterfaceBindings, bi)
+	}
+
+	for _, binding := range c.implicitBindings {
+		info.InterfaceBindings = append(info.InterfaceBindings, InterfaceBindingInfo{
+			Interface:      fullyQualifiedTypeName(binding.interfaceType),
+			Implementation: fullyQualifiedTypeName(binding.implType),
+			Used:           true,
+		})
+
+		// an interface may have been bound implicitly before other
+		// implementations were registered, in which case the binding
+		// depends on the order in which providers were registered
+		if impls := c.implementations(binding.interfaceType); len(impls) > 1 {
+			info.Warnings = append(info.Warnings, fmt.Sprintf(
+				"interface %s is implicitly bound to %s but is also implemented by %v, consider using BindInterface",
+				fullyQualifiedTypeName(binding.interfaceType), fullyQualifiedTypeName(binding.implType), impls))
+		}
+	}
+
+	for _, inv := range c.invokers {
+		info.Invokers = append(info.Invokers, InvokerInfo{
+			Location: inv.fn.Location.Name(),
+			Module:   moduleKeyName(inv.modKey),
+		})
+	}
+
+	return info
+}
+
+// implementations returns the sorted names of the concrete types provided to
+// the container which implement the interface typ.
+func (c *container) implementations(typ reflect.Type) []string {
+	seen := map[string]bool{}
+	var impls []string
+	for _, r := range c.resolvers {
+		name := fullyQualifiedTypeName(r.getType())
+		if r.getType().Kind() != reflect.Interface && r.getType().Implements(typ) && !seen[name] {
+			seen[name] = true
+			impls = append(impls, name)
+		}
+	}
+	sort.Strings(impls)
+	return impls
+}
+
+func moduleKeyName(key *moduleKey) string {
+	if key == nil {
+		return ""
+	}
+	return key.name
+}
+
+func inModule(moduleName string) string {
+	if moduleName == "" {
+		return ""
+	}
+	return fmt.Sprintf(" in module %s", moduleName)
+}
@@ -0,0 +1,95 @@
+package depinject_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/depinject"
+)
+
+func ProvideUnusedKeeper() KeeperD { return KeeperD{} }
+
+func InvokeNothing(KeeperA) {}
+
+func TestInspector(t *testing.T) {
+	var info depinject.ContainerInfo
+	var dotGraph string
+	var b KeeperB
+	var ducks []DuckWrapper
+	require.NoError(t, depinject.InjectDebug(
+		depinject.DebugOptions(
+			depinject.Inspector(func(i depinject.ContainerInfo) { info = i }),
+			depinject.Visualizer(func(g string) { dotGraph = g }),
+		),
+		depinject.Configs(
+			scenarioConfig,
+			depinject.Provide(
+				ProvideMallard,
+				// Duck is implicitly bound to Mallard here because Canvasback
+				// hasn't been provided yet
+				ProvideDuckWrapper,
+				ProvideCanvasback,
+				ProvideUnusedKeeper,
+			),
+			depinject.BindInterfaceInModule("c",
+				"cosmossdk.io/depinject_test/depinject_test.Duck",
+				"cosmossdk.io/depinject_test/depinject_test.Marbled"),
+			depinject.InvokeInModule("a", InvokeNothing),
+		),
+		&b,
+		&ducks,
+	))
+
+	require.Equal(t, dotGraph, info.DOT)
+	require.Equal(t, []string{"a", "b", "runtime"}, info.ModuleKeys)
+
+	require.Len(t, info.Providers, 8)
+	require.Equal(t, "cosmossdk.io/depinject_test.ProvideMsgClientA", info.Providers[0].Location)
+	require.True(t, info.Providers[0].ModuleScoped)
+	require.True(t, info.Providers[0].Called)
+	require.Equal(t, "runtime", info.Providers[1].Module)
+	require.Equal(t, []string{"cosmossdk.io/depinject.ModuleKey"}, info.Providers[1].Inputs)
+	require.Equal(t, []string{"cosmossdk.io/depinject_test.KVStoreKey"}, info.Providers[1].Outputs)
+	require.Equal(t, "cosmossdk.io/depinject_test.ProvideUnusedKeeper", info.Providers[7].Location)
+	require.False(t, info.Providers[7].Called)
+
+	require.Equal(t, []depinject.InterfaceBindingInfo{
+		{
+			Interface:      "cosmossdk.io/depinject_test/depinject_test.Duck",
+			Implementation: "cosmossdk.io/depinject_test/depinject_test.Marbled",
+			Module:         "c",
+			Explicit:       true,
+		},
+		{
+			Interface:      "cosmossdk.io/depinject_test/depinject_test.Duck",
+			Implementation: "cosmossdk.io/depinject_test/depinject_test.Mallard",
+			Used:           true,
+		},
+	}, info.InterfaceBindings)
+
+	require.Equal(t, []depinject.InvokerInfo{
+		{Location: "cosmossdk.io/depinject_test.InvokeNothing", Module: "a"},
+	}, info.Invokers)
+
+	require.Equal(t, []string{
+		"provider cosmossdk.io/depinject_test.ProvideCanvasback is never called",
+		"provider cosmossdk.io/depinject_test.ProvideUnusedKeeper is never called",
+		"binding of cosmossdk.io/depinject_test/depinject_test.Duck to cosmossdk.io/depinject_test/depinject_test.Marbled in module c is never used",
+		"interface cosmossdk.io/depinject_test/depinject_test.Duck is implicitly bound to cosmossdk.io/depinject_test/depinject_test.Mallard " +
+			"but is also implemented by [cosmossdk.io/depinject_test/depinject_test.Canvasback cosmossdk.io/depinject_test/depinject_test.Mallard], consider using BindInterface",
+	}, info.Warnings)
+
+	bz, err := info.JSON()
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"module_keys": [`)
+
+	// inspectors aren't called when the container fails to build
+	info = depinject.ContainerInfo{}
+	require.Error(t, depinject.InjectDebug(
+		depinject.Inspector(func(i depinject.ContainerInfo) { info = i }),
+		depinject.Configs(),
+		&b,
+	))
+	require.Nil(t, info.Providers)
+}
@@ -26,6 +26,18 @@ func ORMPluginRunner(p *protogen.Plugin) error {
 			continue
 		}
 
+		if hasQueryServices(f) {
+			gen := p.NewGeneratedFile(fmt.Sprintf("%s.cosmos_orm.go", f.GeneratedFilenamePrefix), f.GoImportPath)
+			queryServerGen{
+				GeneratedFile: &generator.GeneratedFile{
+					GeneratedFile: gen,
+					LocalPackages: map[string]bool{},
+				},
+				file: f,
+			}.gen()
+			continue
+		}
+
 		if !hasTables(f) {
 			continue
 		}
@@ -201,6 +201,10 @@ func (g queryProtoGen) genTableRPCMethods(msg *protogen.Message, desc *ormv1.Tab
 
 	g.msgs.F("// pagination specifies optional pagination parameters.")
 	g.msgs.F("cosmos.base.query.v1beta1.PageRequest pagination = 3;")
+	g.msgs.F("// filter is an optional filter expression evaluated against each value in the query results.")
+	g.msgs.F("// Comparisons between fields and literal values can be combined with &&, || and !,")
+	g.msgs.F("// ex. `amount > 10 && denom != \"foo\"`. See the ormquery package for the full syntax.")
+	g.msgs.F("string filter = 4;")
 	g.msgs.F("")
 	g.msgs.F("// RangeQuery specifies the from/to index keys for a range query.")
 	g.msgs.F("message RangeQuery {")
@@ -0,0 +1,110 @@
+package codegen
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-proto/generator"
+	"github.com/iancoleman/strcase"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	ormv1 "cosmossdk.io/api/cosmos/orm/v1"
+)
+
+const ormQueryPkg = protogen.GoImportPath("cosmossdk.io/orm/model/ormquery")
+
+// queryServerGen generates implementations of the query services generated
+// by protoc-gen-go-cosmos-orm-proto which delegate to an ormquery.Server.
+type queryServerGen struct {
+	*generator.GeneratedFile
+	file *protogen.File
+}
+
+func (g queryServerGen) gen() {
+	g.P("// Code generated by protoc-gen-go-cosmos-orm. DO NOT EDIT.")
+	g.P()
+	g.P("package ", g.file.GoPackageName)
+	for _, svc := range g.file.Services {
+		if !isQueryService(svc) {
+			continue
+		}
+
+		g.genService(svc)
+	}
+}
+
+func (g queryServerGen) genService(svc *protogen.Service) {
+	serverName := svc.GoName + "Server"
+	structName := strcase.ToLowerCamel(serverName)
+	g.P("type ", structName, " struct {")
+	g.P("Unimplemented", serverName)
+	g.P("server *", ormQueryPkg.Ident("Server"))
+	g.P("}")
+	g.P()
+	g.P("var _ ", serverName, " = ", structName, "{}")
+	g.P()
+	g.P("// New", serverName, " returns a ", serverName, " which queries the tables of the provided")
+	g.P("// schema with an ", ormQueryPkg.Ident("Server"), " using the provided options.")
+	g.P("func New", serverName, "(schema ", ormTablePkg.Ident("Schema"), ", options ", ormQueryPkg.Ident("Options"), ") ", serverName, " {")
+	g.P("return ", structName, "{server: ", ormQueryPkg.Ident("NewServer"), "(schema, options)}")
+	g.P("}")
+	g.P()
+	for _, method := range svc.Methods {
+		serverMethod := "Get"
+		if strings.HasPrefix(method.GoName, "List") {
+			serverMethod = "List"
+		}
+
+		g.P("func (s ", structName, ") ", method.GoName, "(ctx ", contextPkg.Ident("Context"), ", req *", method.Input.GoIdent, ") (*", method.Output.GoIdent, ", error) {")
+		g.P("res := &", method.Output.GoIdent, "{}")
+		g.P("if err := s.server.", serverMethod, "(ctx, req, res); err != nil {")
+		g.P("return nil, err")
+		g.P("}")
+		g.P("return res, nil")
+		g.P("}")
+		g.P()
+	}
+}
+
+// hasQueryServices returns true if the file defines a query service generated
+// by protoc-gen-go-cosmos-orm-proto.
+func hasQueryServices(file *protogen.File) bool {
+	for _, svc := range file.Services {
+		if isQueryService(svc) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isQueryService returns true if the service looks like a query service
+// generated by protoc-gen-go-cosmos-orm-proto, i.e. all of its methods are
+// Get or List methods returning values of table or singleton types.
+func isQueryService(svc *protogen.Service) bool {
+	if !strings.HasSuffix(svc.GoName, "QueryService") || len(svc.Methods) == 0 {
+		return false
+	}
+
+	for _, method := range svc.Methods {
+		var valueField protoreflect.FieldDescriptor
+		switch {
+		case strings.HasPrefix(method.GoName, "Get"):
+			valueField = method.Output.Desc.Fields().ByName("value")
+		case strings.HasPrefix(method.GoName, "List"):
+			valueField = method.Output.Desc.Fields().ByName("values")
+		}
+
+		if valueField == nil || valueField.Message() == nil || !isTableMessage(valueField.Message()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isTableMessage(desc protoreflect.MessageDescriptor) bool {
+	return proto.GetExtension(desc.Options(), ormv1.E_Table).(*ormv1.TableDescriptor) != nil ||
+		proto.GetExtension(desc.Options(), ormv1.E_Singleton).(*ormv1.SingletonDescriptor) != nil
+}
@@ -0,0 +1,48 @@
+package ormdb
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	ormv1 "cosmossdk.io/api/cosmos/orm/v1"
+
+	"cosmossdk.io/orm/model/ormtable"
+	"cosmossdk.io/orm/types/ormerrors"
+)
+
+// TableMigration describes the secondary index migration of a single table.
+type TableMigration struct {
+	// Message is an instance of the table's message type.
+	Message proto.Message
+
+	// OldTableDescriptor is the table descriptor which was used to write the
+	// table's existing entries, generally copied from the previous version of
+	// the table's proto file.
+	OldTableDescriptor *ormv1.TableDescriptor
+}
+
+// NewMigrationHandler returns a migration handler which migrates the secondary
+// indexes of the provided tables using ormtable.MigrateIndexes. It can be
+// registered as an in-place store migration with the module configurator:
+//
+//	h := ormdb.NewMigrationHandler(db, migrations...)
+//	cfg.RegisterMigration(moduleName, 1, func(ctx sdk.Context) error {
+//	  return h(ctx)
+//	})
+func NewMigrationHandler(db ModuleDB, migrations ...TableMigration) func(context.Context) error {
+	return func(ctx context.Context) error {
+		for _, migration := range migrations {
+			table := db.GetTable(migration.Message)
+			if table == nil {
+				return ormerrors.TableNotFound.Wrapf("%s", migration.Message.ProtoReflect().Descriptor().FullName())
+			}
+
+			if _, err := ormtable.MigrateIndexes(ctx, table, migration.OldTableDescriptor); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
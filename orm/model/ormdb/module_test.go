@@ -10,6 +10,7 @@ import (
 
 	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
 	ormmodulev1alpha1 "cosmossdk.io/api/cosmos/orm/module/v1alpha1"
+	ormv1 "cosmossdk.io/api/cosmos/orm/v1"
 	ormv1alpha1 "cosmossdk.io/api/cosmos/orm/v1alpha1"
 	"cosmossdk.io/core/appconfig"
 	"cosmossdk.io/core/appmodule"
@@ -414,3 +415,37 @@ func TestAppConfigModule(t *testing.T) {
 
 	runSimpleBankTests(t, k, context.Background())
 }
+
+func TestMigrationHandler(t *testing.T) {
+	db, err := ormdb.NewModuleDB(TestBankSchema, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+	ctx := ormtable.WrapContextDefault(ormtest.NewMemoryBackend())
+
+	k, err := NewKeeper(db)
+	assert.NilError(t, err)
+	assert.NilError(t, k.Mint(ctx, "bob", "foo", 10))
+	assert.NilError(t, k.Mint(ctx, "sally", "foo", 20))
+
+	// the denom index is rebuilt from the primary key
+	oldDesc := &ormv1.TableDescriptor{
+		Id:         1,
+		PrimaryKey: &ormv1.PrimaryKeyDescriptor{Fields: "address,denom"},
+	}
+	handler := ormdb.NewMigrationHandler(db, ormdb.TableMigration{
+		Message:            &testpb.Balance{},
+		OldTableDescriptor: oldDesc,
+	})
+	assert.NilError(t, handler(ctx))
+
+	balances, err := k.(keeper).store.BalanceTable().List(ctx, testpb.BalanceDenomIndexKey{}.WithDenom("foo"))
+	assert.NilError(t, err)
+	n := 0
+	for balances.Next() {
+		n++
+	}
+	balances.Close()
+	assert.Equal(t, n, 2)
+
+	oldDesc.PrimaryKey.Fields = "address"
+	assert.ErrorIs(t, handler(ctx), ormerrors.IncompatibleSchemaChange)
+}
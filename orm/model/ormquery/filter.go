@@ -0,0 +1,624 @@
+package ormquery
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"cosmossdk.io/orm/types/ormerrors"
+)
+
+// Filter is a compiled filter expression which can be evaluated against
+// messages of a single type.
+//
+// Filter expressions are composed of comparisons between a field path and a
+// literal value, combined with the boolean operators &&, || and ! and grouped
+// with parentheses. Ex:
+//
+//	denom == "foo" && (amount > 10 || !(address == "bar"))
+//
+// Field paths can refer to fields of nested messages using dots,
+// ex. msg.foo. The supported comparison operators are ==, !=, <, <=, >
+// and >=. Literal values are written as follows:
+//   - strings are double-quoted and support the escape sequences of Go strings
+//   - integers and floating point numbers are written as is
+//   - bools are written as true or false
+//   - enums are written as either the name or the number of the enum value
+//   - bytes are written as base64 strings
+//   - google.protobuf.Timestamp values are written as RFC 3339 strings
+//   - google.protobuf.Duration values are written as strings accepted by
+//     time.ParseDuration
+//
+// Only singular scalar fields and timestamp and duration fields can be
+// compared.
+type Filter struct {
+	root filterNode
+	size int
+}
+
+// ParseFilter parses the filter expression for messages of the provided type.
+func ParseFilter(desc protoreflect.MessageDescriptor, expr string) (*Filter, error) {
+	p := &filterParser{desc: desc, lexer: &filterLexer{input: expr}}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.tok.kind != tokenEOF {
+		return nil, p.errorf("unexpected %s", p.tok)
+	}
+
+	return &Filter{root: root, size: p.size}, nil
+}
+
+// Eval evaluates the filter against the message and returns whether the
+// message matches the filter. Comparisons are evaluated with short-circuiting
+// and the number of comparisons performed is returned as cost so that callers
+// can meter evaluation.
+func (f *Filter) Eval(message protoreflect.Message) (matches bool, cost uint64) {
+	return f.root.eval(message)
+}
+
+// Size returns the number of comparisons in the filter expression.
+func (f *Filter) Size() int {
+	return f.size
+}
+
+type filterNode interface {
+	eval(message protoreflect.Message) (bool, uint64)
+}
+
+type andNode struct{ left, right filterNode }
+
+func (n andNode) eval(message protoreflect.Message) (bool, uint64) {
+	res, cost := n.left.eval(message)
+	if !res {
+		return false, cost
+	}
+
+	res, rightCost := n.right.eval(message)
+	return res, cost + rightCost
+}
+
+type orNode struct{ left, right filterNode }
+
+func (n orNode) eval(message protoreflect.Message) (bool, uint64) {
+	res, cost := n.left.eval(message)
+	if res {
+		return true, cost
+	}
+
+	res, rightCost := n.right.eval(message)
+	return res, cost + rightCost
+}
+
+type notNode struct{ expr filterNode }
+
+func (n notNode) eval(message protoreflect.Message) (bool, uint64) {
+	res, cost := n.expr.eval(message)
+	return !res, cost
+}
+
+type compareNode struct {
+	path  []protoreflect.FieldDescriptor
+	op    tokenKind
+	value protoreflect.Value
+}
+
+func (n compareNode) eval(message protoreflect.Message) (bool, uint64) {
+	for _, field := range n.path[:len(n.path)-1] {
+		message = message.Get(field).Message()
+	}
+
+	field := n.path[len(n.path)-1]
+	cmp := compareValues(field, message.Get(field), n.value)
+	switch n.op {
+	case tokenEq:
+		return cmp == 0, 1
+	case tokenNeq:
+		return cmp != 0, 1
+	case tokenLt:
+		return cmp < 0, 1
+	case tokenLte:
+		return cmp <= 0, 1
+	case tokenGt:
+		return cmp > 0, 1
+	case tokenGte:
+		return cmp >= 0, 1
+	default:
+		panic(fmt.Sprintf("unexpected comparison operator %s", n.op))
+	}
+}
+
+func compareValues(field protoreflect.FieldDescriptor, v1, v2 protoreflect.Value) int {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		b1, b2 := v1.Bool(), v2.Bool()
+		switch {
+		case b1 == b2:
+			return 0
+		case b1:
+			return 1
+		default:
+			return -1
+		}
+	case protoreflect.StringKind:
+		return strings.Compare(v1.String(), v2.String())
+	case protoreflect.BytesKind:
+		return bytes.Compare(v1.Bytes(), v2.Bytes())
+	case protoreflect.EnumKind:
+		return compareOrdered(v1.Enum(), v2.Enum())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return compareOrdered(v1.Int(), v2.Int())
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return compareOrdered(v1.Uint(), v2.Uint())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return compareOrdered(v1.Float(), v2.Float())
+	case protoreflect.MessageKind:
+		m1, m2 := v1.Message(), v2.Message()
+		s1, n1 := timeParts(m1)
+		s2, n2 := timeParts(m2)
+		if c := compareOrdered(s1, s2); c != 0 {
+			return c
+		}
+		return compareOrdered(n1, n2)
+	default:
+		panic(fmt.Sprintf("unexpected field kind %s", field.Kind()))
+	}
+}
+
+func compareOrdered[T int64 | uint64 | float64 | protoreflect.EnumNumber](x, y T) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// timeParts returns the seconds and nanos fields of a timestamp or duration.
+func timeParts(message protoreflect.Message) (seconds, nanos int64) {
+	fields := message.Descriptor().Fields()
+	return message.Get(fields.ByName("seconds")).Int(), message.Get(fields.ByName("nanos")).Int()
+}
+
+var (
+	timestampFullName = (&timestamppb.Timestamp{}).ProtoReflect().Descriptor().FullName()
+	durationFullName  = (&durationpb.Duration{}).ProtoReflect().Descriptor().FullName()
+)
+
+type filterParser struct {
+	desc  protoreflect.MessageDescriptor
+	lexer *filterLexer
+	tok   token
+	size  int
+}
+
+func (p *filterParser) advance() error {
+	tok, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+	return nil
+}
+
+func (p *filterParser) errorf(format string, args ...interface{}) error {
+	return ormerrors.InvalidFilter.Wrapf("at position %d: %s", p.tok.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *filterParser) parseOr() (filterNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.tok.kind == tokenOr {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = orNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.tok.kind == tokenAnd {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = andNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (filterNode, error) {
+	switch p.tok.kind {
+	case tokenNot:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return notNode{expr: expr}, nil
+	case tokenLParen:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.tok.kind != tokenRParen {
+			return nil, p.errorf("expected ), got %s", p.tok)
+		}
+
+		return expr, p.advance()
+	default:
+		return p.parseComparison()
+	}
+}
+
+func (p *filterParser) parseComparison() (filterNode, error) {
+	if p.tok.kind != tokenIdent {
+		return nil, p.errorf("expected field name, got %s", p.tok)
+	}
+
+	path, err := p.resolvePath(p.tok.text)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	op := p.tok.kind
+	switch op {
+	case tokenEq, tokenNeq, tokenLt, tokenLte, tokenGt, tokenGte:
+	default:
+		return nil, p.errorf("expected comparison operator, got %s", p.tok)
+	}
+
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	value, err := p.parseLiteral(path[len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	p.size++
+	return compareNode{path: path, op: op, value: value}, nil
+}
+
+func (p *filterParser) resolvePath(text string) ([]protoreflect.FieldDescriptor, error) {
+	desc := p.desc
+	var path []protoreflect.FieldDescriptor
+	names := strings.Split(text, ".")
+	for i, name := range names {
+		if desc == nil {
+			return nil, p.errorf("%s is not a message field", strings.Join(names[:i], "."))
+		}
+
+		field := desc.Fields().ByName(protoreflect.Name(name))
+		if field == nil {
+			return nil, p.errorf("%s: %s", ormerrors.FieldNotFound.Error(), strings.Join(names[:i+1], "."))
+		}
+
+		if field.IsList() || field.IsMap() {
+			return nil, p.errorf("can't filter on repeated field %s", field.FullName())
+		}
+
+		path = append(path, field)
+		desc = nil
+		if field.Kind() == protoreflect.MessageKind && i < len(names)-1 {
+			desc = field.Message()
+		}
+	}
+
+	last := path[len(path)-1]
+	if last.Kind() == protoreflect.GroupKind ||
+		(last.Kind() == protoreflect.MessageKind &&
+			last.Message().FullName() != timestampFullName &&
+			last.Message().FullName() != durationFullName) {
+		return nil, p.errorf("can't filter on message field %s", last.FullName())
+	}
+
+	return path, nil
+}
+
+func (p *filterParser) parseLiteral(field protoreflect.FieldDescriptor) (protoreflect.Value, error) {
+	tok := p.tok
+	invalid := func() (protoreflect.Value, error) {
+		return protoreflect.Value{}, p.errorf("invalid value %s for field %s of kind %s", tok, field.FullName(), field.Kind())
+	}
+
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		if tok.kind != tokenIdent || (tok.text != "true" && tok.text != "false") {
+			return invalid()
+		}
+		return protoreflect.ValueOfBool(tok.text == "true"), nil
+	case protoreflect.StringKind:
+		if tok.kind != tokenString {
+			return invalid()
+		}
+		return protoreflect.ValueOfString(tok.text), nil
+	case protoreflect.BytesKind:
+		if tok.kind != tokenString {
+			return invalid()
+		}
+		bz, err := base64.StdEncoding.DecodeString(tok.text)
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfBytes(bz), nil
+	case protoreflect.EnumKind:
+		switch tok.kind {
+		case tokenIdent:
+			enumValue := field.Enum().Values().ByName(protoreflect.Name(tok.text))
+			if enumValue == nil {
+				return invalid()
+			}
+			return protoreflect.ValueOfEnum(enumValue.Number()), nil
+		case tokenNumber:
+			x, err := strconv.ParseInt(tok.text, 10, 32)
+			if err != nil {
+				return invalid()
+			}
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(x)), nil
+		default:
+			return invalid()
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if tok.kind != tokenNumber {
+			return invalid()
+		}
+		x, err := strconv.ParseInt(tok.text, 10, 32)
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfInt32(int32(x)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if tok.kind != tokenNumber {
+			return invalid()
+		}
+		x, err := strconv.ParseInt(tok.text, 10, 64)
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfInt64(x), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		if tok.kind != tokenNumber {
+			return invalid()
+		}
+		x, err := strconv.ParseUint(tok.text, 10, 32)
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfUint32(uint32(x)), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if tok.kind != tokenNumber {
+			return invalid()
+		}
+		x, err := strconv.ParseUint(tok.text, 10, 64)
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfUint64(x), nil
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		if tok.kind != tokenNumber {
+			return invalid()
+		}
+		x, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil || math.IsNaN(x) {
+			return invalid()
+		}
+		return protoreflect.ValueOfFloat64(x), nil
+	case protoreflect.MessageKind:
+		if tok.kind != tokenString {
+			return invalid()
+		}
+		if field.Message().FullName() == timestampFullName {
+			t, err := time.Parse(time.RFC3339Nano, tok.text)
+			if err != nil {
+				return invalid()
+			}
+			return protoreflect.ValueOfMessage(timestamppb.New(t).ProtoReflect()), nil
+		}
+		d, err := time.ParseDuration(tok.text)
+		if err != nil {
+			return invalid()
+		}
+		return protoreflect.ValueOfMessage(durationpb.New(d).ProtoReflect()), nil
+	default:
+		return invalid()
+	}
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenLParen
+	tokenRParen
+	tokenEq
+	tokenNeq
+	tokenLt
+	tokenLte
+	tokenGt
+	tokenGte
+)
+
+var tokenKindNames = map[tokenKind]string{
+	tokenEOF:    "end of input",
+	tokenAnd:    "&&",
+	tokenOr:     "||",
+	tokenNot:    "!",
+	tokenLParen: "(",
+	tokenRParen: ")",
+	tokenEq:     "==",
+	tokenNeq:    "!=",
+	tokenLt:     "<",
+	tokenLte:    "<=",
+	tokenGt:     ">",
+	tokenGte:    ">=",
+}
+
+func (k tokenKind) String() string {
+	return tokenKindNames[k]
+}
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenIdent, tokenNumber:
+		return t.text
+	case tokenString:
+		return strconv.Quote(t.text)
+	default:
+		return t.kind.String()
+	}
+}
+
+type filterLexer struct {
+	input string
+	pos   int
+}
+
+var operators = []struct {
+	text string
+	kind tokenKind
+}{
+	// two character operators must come first so that they take precedence
+	{"&&", tokenAnd},
+	{"||", tokenOr},
+	{"==", tokenEq},
+	{"!=", tokenNeq},
+	{"<=", tokenLte},
+	{">=", tokenGte},
+	{"!", tokenNot},
+	{"(", tokenLParen},
+	{")", tokenRParen},
+	{"<", tokenLt},
+	{">", tokenGt},
+}
+
+func (l *filterLexer) next() (token, error) {
+	for l.pos < len(l.input) && unicode.IsSpace(rune(l.input[l.pos])) {
+		l.pos++
+	}
+
+	start := l.pos
+	if l.pos >= len(l.input) {
+		return token{kind: tokenEOF, pos: start}, nil
+	}
+
+	rest := l.input[l.pos:]
+	for _, op := range operators {
+		if strings.HasPrefix(rest, op.text) {
+			l.pos += len(op.text)
+			return token{kind: op.kind, text: op.text, pos: start}, nil
+		}
+	}
+
+	c := rest[0]
+	switch {
+	case c == '"':
+		end := 1
+		for ; end < len(rest); end++ {
+			if rest[end] == '\\' {
+				end++
+				continue
+			}
+			if rest[end] == '"' {
+				break
+			}
+		}
+		if end >= len(rest) {
+			return token{}, ormerrors.InvalidFilter.Wrapf("at position %d: unterminated string", start)
+		}
+		str, err := strconv.Unquote(rest[:end+1])
+		if err != nil {
+			return token{}, ormerrors.InvalidFilter.Wrapf("at position %d: invalid string %s", start, rest[:end+1])
+		}
+		l.pos += end + 1
+		return token{kind: tokenString, text: str, pos: start}, nil
+	case c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9'):
+		end := 1
+		for end < len(rest) && strings.IndexByte("0123456789.eE+-", rest[end]) >= 0 {
+			end++
+		}
+		l.pos += end
+		return token{kind: tokenNumber, text: rest[:end], pos: start}, nil
+	case c == '_' || unicode.IsLetter(rune(c)):
+		end := 1
+		for end < len(rest) && (rest[end] == '_' || rest[end] == '.' ||
+			unicode.IsLetter(rune(rest[end])) || unicode.IsDigit(rune(rest[end]))) {
+			end++
+		}
+		l.pos += end
+		return token{kind: tokenIdent, text: rest[:end], pos: start}, nil
+	default:
+		return token{}, ormerrors.InvalidFilter.Wrapf("at position %d: unexpected character %q", start, c)
+	}
+}
@@ -0,0 +1,93 @@
+package ormquery_test
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/orm/internal/testpb"
+	"cosmossdk.io/orm/model/ormquery"
+	"cosmossdk.io/orm/types/ormerrors"
+)
+
+func TestFilter(t *testing.T) {
+	msg := &testpb.ExampleTable{
+		U32: 4,
+		U64: 10,
+		Str: "abc",
+		Bz:  []byte{1, 2, 3},
+		Ts:  timestamppb.New(time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)),
+		Dur: durationpb.New(time.Minute),
+		I32: -3,
+		I64: -10,
+		B:   true,
+		E:   testpb.Enum_ENUM_TWO,
+		Msg: &testpb.ExampleTable_ExampleMessage{Foo: "bar", Bar: 7},
+	}
+
+	cases := []struct {
+		expr    string
+		matches bool
+		cost    uint64
+	}{
+		{`u32 == 4`, true, 1},
+		{`u32 != 4`, false, 1},
+		{`u64 > 9 && u64 < 11`, true, 2},
+		{`u64 > 10 || u64 <= 9`, false, 2},
+		{`str == "abc"`, true, 1},
+		{`str >= "abd"`, false, 1},
+		{`bz == "AQID"`, true, 1},
+		{`ts > "2022-01-01T00:00:00Z"`, true, 1},
+		{`ts < "2022-01-01T00:00:00Z"`, false, 1},
+		{`dur == "1m"`, true, 1},
+		{`i32 < 0 && i64 == -10`, true, 2},
+		{`b == true`, true, 1},
+		{`e == ENUM_TWO`, true, 1},
+		{`e > 1`, true, 1},
+		{`msg.foo == "bar" && msg.bar >= 7`, true, 2},
+		{`!(u32 == 4)`, false, 1},
+		{`u32 == 5 && str == "abc"`, false, 1},
+		{`u32 == 4 || str == "xyz"`, true, 1},
+		{`(u32 == 5 || u32 == 4) && !(b == false)`, true, 3},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.expr, func(t *testing.T) {
+			filter, err := ormquery.ParseFilter(msg.ProtoReflect().Descriptor(), tc.expr)
+			assert.NilError(t, err)
+			matches, cost := filter.Eval(msg.ProtoReflect())
+			assert.Equal(t, tc.matches, matches)
+			assert.Equal(t, tc.cost, cost)
+		})
+	}
+}
+
+func TestFilterErrors(t *testing.T) {
+	desc := (&testpb.ExampleTable{}).ProtoReflect().Descriptor()
+	for _, expr := range []string{
+		``,
+		`u32`,
+		`u32 ==`,
+		`u32 == "a"`,
+		`u32 == -1`,
+		`i32 == 3000000000`,
+		`foo == 1`,
+		`msg == "a"`,
+		`msg.baz == 1`,
+		`repeated == 1`,
+		`e == ENUM_SIX`,
+		`str == "abc`,
+		`(u32 == 1`,
+		`u32 == 1 u32 == 2`,
+		`u32 = 1`,
+		`ts > "yesterday"`,
+	} {
+		t.Run(expr, func(t *testing.T) {
+			_, err := ormquery.ParseFilter(desc, expr)
+			assert.ErrorIs(t, err, ormerrors.InvalidFilter)
+		})
+	}
+}
@@ -0,0 +1,365 @@
+// Package ormquery implements the query services generated by
+// protoc-gen-go-cosmos-orm-proto for ORM tables and singletons.
+//
+// The generated services expose get queries by primary key and unique
+// indexes, prefix and range list queries against any index with pagination,
+// and an optional filter expression (see Filter) which is evaluated
+// server-side against each entry and metered with a gas limit.
+package ormquery
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	queryv1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+
+	"cosmossdk.io/orm/model/ormlist"
+	"cosmossdk.io/orm/model/ormtable"
+	"cosmossdk.io/orm/types/ormerrors"
+)
+
+const (
+	// DefaultGasLimit is the default gas limit for a single list query.
+	DefaultGasLimit uint64 = 1_000_000
+
+	// DefaultGasPerEntry is the default gas consumed for each entry read from an index.
+	DefaultGasPerEntry uint64 = 10
+
+	// DefaultGasPerComparison is the default gas consumed for each comparison
+	// performed while evaluating a filter expression.
+	DefaultGasPerComparison uint64 = 1
+
+	// DefaultLimit is the default page size for list queries which don't
+	// specify a limit.
+	DefaultLimit uint64 = 100
+)
+
+// Options are options for a query Server. Zero values are replaced with
+// the defaults defined in this package.
+type Options struct {
+	// GasLimit is the maximum amount of gas a single list query can consume
+	// before it is aborted with ormerrors.FilterGasLimitExceeded.
+	GasLimit uint64
+
+	// GasPerEntry is the gas consumed for each entry read from an index,
+	// regardless of whether it matches the filter.
+	GasPerEntry uint64
+
+	// GasPerComparison is the gas consumed for each comparison performed
+	// while evaluating a filter expression.
+	GasPerComparison uint64
+
+	// DefaultLimit is the page size used when a list query doesn't specify one.
+	DefaultLimit uint64
+
+	// MaxLimit is an optional cap on the page size of list queries.
+	MaxLimit uint64
+}
+
+// Server implements the get and list queries of the query services generated
+// by protoc-gen-go-cosmos-orm-proto against the tables of an ORM schema.
+// Request and response types are handled using protobuf reflection so that
+// a single Server can back the services generated for all files of a schema.
+type Server struct {
+	schema  ormtable.Schema
+	options Options
+}
+
+// NewServer returns a new query Server for the tables in the provided schema.
+func NewServer(schema ormtable.Schema, options Options) *Server {
+	if options.GasLimit == 0 {
+		options.GasLimit = DefaultGasLimit
+	}
+	if options.GasPerEntry == 0 {
+		options.GasPerEntry = DefaultGasPerEntry
+	}
+	if options.GasPerComparison == 0 {
+		options.GasPerComparison = DefaultGasPerComparison
+	}
+	if options.DefaultLimit == 0 {
+		options.DefaultLimit = DefaultLimit
+	}
+
+	return &Server{schema: schema, options: options}
+}
+
+// Get handles a Get request for a table or singleton. The response type must
+// have a value field of the table's message type. For tables, the request
+// fields are used as the values of the primary key or unique index with the
+// same field names. For singletons, the request must not have any fields.
+func (s *Server) Get(ctx context.Context, req, res proto.Message) error {
+	resMsg := res.ProtoReflect()
+	valueField := resMsg.Descriptor().Fields().ByName("value")
+	if valueField == nil || valueField.Message() == nil {
+		return ormerrors.InvalidQuery.Wrapf("%s is missing a message value field", resMsg.Descriptor().FullName())
+	}
+
+	value := resMsg.Mutable(valueField).Message()
+	table := s.schema.GetTable(value.Interface())
+	if table == nil {
+		return ormerrors.TableNotFound.Wrapf("%s", valueField.Message().FullName())
+	}
+
+	reqMsg := req.ProtoReflect()
+	reqFields := reqMsg.Descriptor().Fields()
+	if reqFields.Len() == 0 {
+		// singletons always return a value, defaulting to the empty message
+		_, err := table.Get(ctx, value.Interface())
+		return err
+	}
+
+	names := make([]string, reqFields.Len())
+	keyValues := make([]interface{}, reqFields.Len())
+	for i := 0; i < reqFields.Len(); i++ {
+		field := reqFields.Get(i)
+		names[i] = string(field.Name())
+		keyValues[i] = reqMsg.Get(field).Interface()
+	}
+
+	index := table.GetUniqueIndex(strings.Join(names, ","))
+	if index == nil {
+		return ormerrors.CantFindIndex.Wrapf("unique index %s on table %s", strings.Join(names, ","), valueField.Message().FullName())
+	}
+
+	found, err := index.Get(ctx, value.Interface(), keyValues...)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		resMsg.Clear(valueField)
+		return ormerrors.NotFound.Wrapf("%s", valueField.Message().FullName())
+	}
+
+	return nil
+}
+
+// List handles a List request for a table. The request must define
+// prefix_query and range_query index key fields, an optional pagination
+// field and an optional filter field. The response must have a repeated
+// values field of the table's message type and a pagination field.
+func (s *Server) List(ctx context.Context, req, res proto.Message) error {
+	reqMsg, resMsg := req.ProtoReflect(), res.ProtoReflect()
+	reqFields, resFields := reqMsg.Descriptor().Fields(), resMsg.Descriptor().Fields()
+
+	valuesField := resFields.ByName("values")
+	if valuesField == nil || !valuesField.IsList() || valuesField.Message() == nil {
+		return ormerrors.InvalidQuery.Wrapf("%s is missing a repeated message values field", resMsg.Descriptor().FullName())
+	}
+
+	values := resMsg.Mutable(valuesField).List()
+	table := s.schema.GetTable(values.NewElement().Message().Interface())
+	if table == nil {
+		return ormerrors.TableNotFound.Wrapf("%s", valuesField.Message().FullName())
+	}
+
+	var filter *Filter
+	if filterField := reqFields.ByName("filter"); filterField != nil {
+		if expr := reqMsg.Get(filterField).String(); expr != "" {
+			var err error
+			filter, err = ParseFilter(table.MessageType().Descriptor(), expr)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	pageReq := &queryv1beta1.PageRequest{}
+	if paginationField := reqFields.ByName("pagination"); paginationField != nil && reqMsg.Has(paginationField) {
+		var ok bool
+		pageReq, ok = reqMsg.Get(paginationField).Message().Interface().(*queryv1beta1.PageRequest)
+		if !ok {
+			return ormerrors.InvalidQuery.Wrapf("unexpected pagination type %s", paginationField.Message().FullName())
+		}
+	}
+
+	if len(pageReq.Key) != 0 && pageReq.Offset != 0 {
+		return ormerrors.InvalidQuery.Wrap("only one of pagination key and offset can be set")
+	}
+
+	var opts []ormlist.Option
+	if pageReq.Reverse {
+		opts = append(opts, ormlist.Reverse())
+	}
+	if len(pageReq.Key) != 0 {
+		opts = append(opts, ormlist.Cursor(pageReq.Key))
+	}
+
+	it, err := s.iterator(ctx, table, reqMsg, opts)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = s.options.DefaultLimit
+	}
+	if s.options.MaxLimit != 0 && limit > s.options.MaxLimit {
+		limit = s.options.MaxLimit
+	}
+
+	// as with other SDK queries, count_total is ignored when a key is set
+	countTotal := pageReq.CountTotal && len(pageReq.Key) == 0
+
+	gas := &gasTracker{limit: s.options.GasLimit}
+	pageRes := &queryv1beta1.PageResponse{}
+	var matched uint64
+	var lastCursor ormlist.CursorT
+	for it.Next() {
+		if err := gas.consume(s.options.GasPerEntry); err != nil {
+			return err
+		}
+
+		msg, err := it.GetMessage()
+		if err != nil {
+			return err
+		}
+
+		if filter != nil {
+			ok, cost := filter.Eval(msg.ProtoReflect())
+			if err := gas.consume(cost * s.options.GasPerComparison); err != nil {
+				return err
+			}
+
+			if !ok {
+				continue
+			}
+		}
+
+		matched++
+		if matched <= pageReq.Offset {
+			continue
+		}
+
+		if uint64(values.Len()) < limit {
+			values.Append(protoreflect.ValueOfMessage(msg.ProtoReflect()))
+			lastCursor = it.Cursor()
+			continue
+		}
+
+		// we have found a matching entry beyond the current page
+		if pageRes.NextKey == nil {
+			pageRes.NextKey = lastCursor
+		}
+
+		if !countTotal {
+			break
+		}
+	}
+
+	if countTotal {
+		pageRes.Total = matched
+	}
+
+	if paginationField := resFields.ByName("pagination"); paginationField != nil {
+		resMsg.Set(paginationField, protoreflect.ValueOfMessage(pageRes.ProtoReflect()))
+	}
+
+	return nil
+}
+
+func (s *Server) iterator(ctx context.Context, table ormtable.Table, reqMsg protoreflect.Message, opts []ormlist.Option) (ormtable.Iterator, error) {
+	fields := reqMsg.Descriptor().Fields()
+	if prefixField := fields.ByName("prefix_query"); prefixField != nil && reqMsg.Has(prefixField) {
+		index, prefix, err := decodeIndexKey(table, reqMsg.Get(prefixField).Message())
+		if err != nil {
+			return nil, err
+		}
+
+		return index.List(ctx, prefix, opts...)
+	}
+
+	if rangeField := fields.ByName("range_query"); rangeField != nil && reqMsg.Has(rangeField) {
+		rangeQuery := reqMsg.Get(rangeField).Message()
+		rangeFields := rangeQuery.Descriptor().Fields()
+		fromField, toField := rangeFields.ByName("from"), rangeFields.ByName("to")
+		if !rangeQuery.Has(fromField) {
+			return nil, ormerrors.InvalidQuery.Wrap("range query is missing from")
+		}
+
+		index, from, err := decodeIndexKey(table, rangeQuery.Get(fromField).Message())
+		if err != nil {
+			return nil, err
+		}
+
+		var to []interface{}
+		if rangeQuery.Has(toField) {
+			var toIndex ormtable.Index
+			toIndex, to, err = decodeIndexKey(table, rangeQuery.Get(toField).Message())
+			if err != nil {
+				return nil, err
+			}
+
+			if toIndex.Fields() != index.Fields() {
+				return nil, ormerrors.InvalidQuery.Wrap("range query from and to must use the same index")
+			}
+		}
+
+		return index.ListRange(ctx, from, to, opts...)
+	}
+
+	// with no query specified we iterate over the whole primary key
+	return table.List(ctx, nil, opts...)
+}
+
+// decodeIndexKey decodes an IndexKey message into the index it refers to and
+// the values of its set fields. IndexKey messages have a single oneof whose
+// field numbers are the index IDs plus one so that the primary key is
+// field number 1. Values are read in index field order until the first
+// unset field.
+func decodeIndexKey(table ormtable.Table, key protoreflect.Message) (ormtable.Index, []interface{}, error) {
+	oneofs := key.Descriptor().Oneofs()
+	if oneofs.Len() != 1 {
+		return nil, nil, ormerrors.InvalidQuery.Wrapf("%s is not an index key", key.Descriptor().FullName())
+	}
+
+	which := key.WhichOneof(oneofs.Get(0))
+	if which == nil {
+		return nil, nil, ormerrors.InvalidQuery.Wrap("missing index key")
+	}
+
+	index := table.GetIndexByID(uint32(which.Number()) - 1)
+	if index == nil {
+		return nil, nil, ormerrors.CantFindIndex.Wrapf("index id %d", which.Number()-1)
+	}
+
+	keyMsg := key.Get(which).Message()
+	keyFields := keyMsg.Descriptor().Fields()
+	var values []interface{}
+	done := false
+	for _, name := range strings.Split(index.Fields(), ",") {
+		field := keyFields.ByName(protoreflect.Name(name))
+		if field == nil {
+			break
+		}
+
+		if !keyMsg.Has(field) {
+			done = true
+			continue
+		}
+
+		if done {
+			return nil, nil, ormerrors.InvalidQuery.Wrapf("index key field %s is set after an unset field", name)
+		}
+
+		values = append(values, keyMsg.Get(field).Interface())
+	}
+
+	return index, values, nil
+}
+
+type gasTracker struct {
+	limit, used uint64
+}
+
+func (g *gasTracker) consume(amount uint64) error {
+	g.used += amount
+	if g.used > g.limit {
+		return ormerrors.FilterGasLimitExceeded.Wrapf("used %d, limit %d", g.used, g.limit)
+	}
+	return nil
+}
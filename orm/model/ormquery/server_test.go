@@ -0,0 +1,178 @@
+package ormquery_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	queryv1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	ormv1alpha1 "cosmossdk.io/api/cosmos/orm/v1alpha1"
+
+	"cosmossdk.io/orm/internal/testkv"
+	"cosmossdk.io/orm/internal/testpb"
+	"cosmossdk.io/orm/model/ormdb"
+	"cosmossdk.io/orm/model/ormquery"
+	"cosmossdk.io/orm/model/ormtable"
+	"cosmossdk.io/orm/types/ormerrors"
+)
+
+var testBankSchema = &ormv1alpha1.ModuleSchemaDescriptor{
+	SchemaFile: []*ormv1alpha1.ModuleSchemaDescriptor_FileEntry{
+		{
+			Id:            1,
+			ProtoFileName: testpb.File_testpb_bank_proto.Path(),
+		},
+	},
+}
+
+func setupBank(t *testing.T, options ormquery.Options) (context.Context, testpb.BankQueryServiceServer) {
+	t.Helper()
+	db, err := ormdb.NewModuleDB(testBankSchema, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+	store, err := testpb.NewBankStore(db)
+	assert.NilError(t, err)
+
+	ctx := ormtable.WrapContextDefault(testkv.NewSplitMemBackend())
+	for i := 0; i < 10; i++ {
+		addr := fmt.Sprintf("addr%d", i)
+		assert.NilError(t, store.BalanceTable().Insert(ctx, &testpb.Balance{Address: addr, Denom: "foo", Amount: uint64(i * 10)}))
+		assert.NilError(t, store.BalanceTable().Insert(ctx, &testpb.Balance{Address: addr, Denom: "bar", Amount: uint64(i)}))
+	}
+	assert.NilError(t, store.SupplyTable().Insert(ctx, &testpb.Supply{Denom: "foo", Amount: 450}))
+
+	return ctx, testpb.NewBankQueryServiceServer(db, options)
+}
+
+func denomKey(denom string) *testpb.ListBalanceRequest_IndexKey {
+	return &testpb.ListBalanceRequest_IndexKey{
+		Key: &testpb.ListBalanceRequest_IndexKey_Denom_{
+			Denom: &testpb.ListBalanceRequest_IndexKey_Denom{Denom: &denom},
+		},
+	}
+}
+
+func TestGet(t *testing.T) {
+	ctx, srv := setupBank(t, ormquery.Options{})
+
+	res, err := srv.GetBalance(ctx, &testpb.GetBalanceRequest{Address: "addr3", Denom: "foo"})
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(30), res.Value.Amount)
+
+	_, err = srv.GetBalance(ctx, &testpb.GetBalanceRequest{Address: "addr3", Denom: "baz"})
+	assert.Assert(t, ormerrors.IsNotFound(err))
+
+	supply, err := srv.GetSupply(ctx, &testpb.GetSupplyRequest{Denom: "foo"})
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(450), supply.Value.Amount)
+}
+
+func TestListPrefix(t *testing.T) {
+	ctx, srv := setupBank(t, ormquery.Options{})
+
+	res, err := srv.ListBalance(ctx, &testpb.ListBalanceRequest{
+		Query: &testpb.ListBalanceRequest_PrefixQuery{PrefixQuery: denomKey("bar")},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 10, len(res.Values))
+	for _, balance := range res.Values {
+		assert.Equal(t, "bar", balance.Denom)
+	}
+	assert.Assert(t, res.Pagination.NextKey == nil)
+
+	// no query iterates over the primary key
+	res, err = srv.ListBalance(ctx, &testpb.ListBalanceRequest{})
+	assert.NilError(t, err)
+	assert.Equal(t, 20, len(res.Values))
+	assert.Equal(t, "addr0", res.Values[0].Address)
+}
+
+func TestListRange(t *testing.T) {
+	ctx, srv := setupBank(t, ormquery.Options{})
+
+	from, to := "addr2", "addr4"
+	res, err := srv.ListBalance(ctx, &testpb.ListBalanceRequest{
+		Query: &testpb.ListBalanceRequest_RangeQuery_{RangeQuery: &testpb.ListBalanceRequest_RangeQuery{
+			From: &testpb.ListBalanceRequest_IndexKey{Key: &testpb.ListBalanceRequest_IndexKey_AddressDenom_{
+				AddressDenom: &testpb.ListBalanceRequest_IndexKey_AddressDenom{Address: &from},
+			}},
+			To: &testpb.ListBalanceRequest_IndexKey{Key: &testpb.ListBalanceRequest_IndexKey_AddressDenom_{
+				AddressDenom: &testpb.ListBalanceRequest_IndexKey_AddressDenom{Address: &to},
+			}},
+		}},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 6, len(res.Values))
+	assert.Equal(t, "addr2", res.Values[0].Address)
+	assert.Equal(t, "addr4", res.Values[5].Address)
+
+	// from and to must use the same index
+	_, err = srv.ListBalance(ctx, &testpb.ListBalanceRequest{
+		Query: &testpb.ListBalanceRequest_RangeQuery_{RangeQuery: &testpb.ListBalanceRequest_RangeQuery{
+			From: &testpb.ListBalanceRequest_IndexKey{Key: &testpb.ListBalanceRequest_IndexKey_AddressDenom_{
+				AddressDenom: &testpb.ListBalanceRequest_IndexKey_AddressDenom{Address: &from},
+			}},
+			To: denomKey("foo"),
+		}},
+	})
+	assert.ErrorIs(t, err, ormerrors.InvalidQuery)
+}
+
+func TestListFilterPagination(t *testing.T) {
+	ctx, srv := setupBank(t, ormquery.Options{})
+
+	req := &testpb.ListBalanceRequest{
+		Query:      &testpb.ListBalanceRequest_PrefixQuery{PrefixQuery: denomKey("foo")},
+		Filter:     "amount >= 20 && address != \"addr5\"",
+		Pagination: &queryv1beta1.PageRequest{Limit: 3, CountTotal: true},
+	}
+
+	var amounts []uint64
+	for {
+		res, err := srv.ListBalance(ctx, req)
+		assert.NilError(t, err)
+		if req.Pagination.Key == nil {
+			assert.Equal(t, uint64(7), res.Pagination.Total)
+		}
+		for _, balance := range res.Values {
+			amounts = append(amounts, balance.Amount)
+		}
+
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+	assert.DeepEqual(t, []uint64{20, 30, 40, 60, 70, 80, 90}, amounts)
+
+	// offset skips matching entries
+	res, err := srv.ListBalance(ctx, &testpb.ListBalanceRequest{
+		Filter:     "amount > 50",
+		Pagination: &queryv1beta1.PageRequest{Offset: 2, Limit: 1, Reverse: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Values))
+	assert.Equal(t, uint64(70), res.Values[0].Amount)
+	assert.Assert(t, res.Pagination.NextKey != nil)
+
+	_, err = srv.ListBalance(ctx, &testpb.ListBalanceRequest{Filter: "amount > \"foo\""})
+	assert.ErrorIs(t, err, ormerrors.InvalidFilter)
+}
+
+func TestListGasLimit(t *testing.T) {
+	ctx, srv := setupBank(t, ormquery.Options{GasLimit: 150, GasPerEntry: 10})
+
+	// a filter which never matches still has to scan the whole index
+	_, err := srv.ListBalance(ctx, &testpb.ListBalanceRequest{Filter: "amount > 1000"})
+	assert.ErrorIs(t, err, ormerrors.FilterGasLimitExceeded)
+
+	res, err := srv.ListBalance(ctx, &testpb.ListBalanceRequest{Pagination: &queryv1beta1.PageRequest{Limit: 5}})
+	assert.NilError(t, err)
+	assert.Equal(t, 5, len(res.Values))
+
+	ctx, srv = setupBank(t, ormquery.Options{MaxLimit: 4})
+	res, err = srv.ListBalance(ctx, &testpb.ListBalanceRequest{Pagination: &queryv1beta1.PageRequest{Limit: 10}})
+	assert.NilError(t, err)
+	assert.Equal(t, 4, len(res.Values))
+}
@@ -141,6 +141,7 @@ func Build(options Options) (Table, error) {
 	prefix = encodeutil.AppendVarUInt32(prefix, tableID)
 	table.tablePrefix = prefix
 	table.tableID = tableID
+	table.tableDesc = tableDesc
 
 	if tableDesc.PrimaryKey == nil {
 		return nil, ormerrors.MissingPrimaryKey.Wrap(string(messageDescriptor.FullName()))
@@ -0,0 +1,225 @@
+package ormtable
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	ormv1 "cosmossdk.io/api/cosmos/orm/v1"
+
+	"cosmossdk.io/orm/encoding/encodeutil"
+	"cosmossdk.io/orm/internal/fieldnames"
+	"cosmossdk.io/orm/model/ormlist"
+	"cosmossdk.io/orm/types/kv"
+	"cosmossdk.io/orm/types/ormerrors"
+)
+
+// migrationBatchSize is the number of entries which are read from the store
+// before writes are performed when migrating indexes. Reads and writes are
+// never interleaved so that iterators are not invalidated by writes.
+const migrationBatchSize = 100
+
+// TableDiff describes the differences between two versions of a table
+// descriptor.
+type TableDiff struct {
+	// AddedIndexes are the secondary indexes which need to be built. Indexes
+	// whose fields or uniqueness have changed are reported as both removed
+	// and added.
+	AddedIndexes []*ormv1.SecondaryIndexDescriptor
+
+	// RemovedIndexes are the secondary indexes whose entries need to be deleted.
+	RemovedIndexes []*ormv1.SecondaryIndexDescriptor
+
+	// Incompatible lists changes which can't be migrated in place, such as
+	// changes to the table ID or primary key.
+	Incompatible []error
+}
+
+// IsCompatible returns true if the table can be migrated in place.
+func (d TableDiff) IsCompatible() bool {
+	return len(d.Incompatible) == 0
+}
+
+// IsEmpty returns true if no migration is needed.
+func (d TableDiff) IsEmpty() bool {
+	return len(d.AddedIndexes) == 0 && len(d.RemovedIndexes) == 0 && len(d.Incompatible) == 0
+}
+
+// DiffTableDescriptors compares the old and new versions of a table descriptor.
+func DiffTableDescriptors(oldDesc, newDesc *ormv1.TableDescriptor) TableDiff {
+	var diff TableDiff
+	if oldDesc.Id != newDesc.Id {
+		diff.Incompatible = append(diff.Incompatible,
+			fmt.Errorf("table id changed from %d to %d", oldDesc.Id, newDesc.Id))
+	}
+
+	oldPK, newPK := oldDesc.PrimaryKey, newDesc.PrimaryKey
+	switch {
+	case oldPK == nil || newPK == nil:
+		diff.Incompatible = append(diff.Incompatible, ormerrors.MissingPrimaryKey)
+	case fieldnames.CommaSeparatedFieldNames(oldPK.Fields) != fieldnames.CommaSeparatedFieldNames(newPK.Fields):
+		diff.Incompatible = append(diff.Incompatible,
+			fmt.Errorf("primary key changed from %q to %q", oldPK.Fields, newPK.Fields))
+	case oldPK.AutoIncrement != newPK.AutoIncrement:
+		diff.Incompatible = append(diff.Incompatible,
+			fmt.Errorf("primary key auto-increment changed from %t to %t", oldPK.AutoIncrement, newPK.AutoIncrement))
+	}
+
+	oldIndexes := map[uint32]*ormv1.SecondaryIndexDescriptor{}
+	for _, idx := range oldDesc.Index {
+		oldIndexes[idx.Id] = idx
+	}
+
+	newIndexes := map[uint32]bool{}
+	for _, idx := range newDesc.Index {
+		newIndexes[idx.Id] = true
+		oldIdx, ok := oldIndexes[idx.Id]
+		if !ok {
+			diff.AddedIndexes = append(diff.AddedIndexes, idx)
+			continue
+		}
+
+		if fieldnames.CommaSeparatedFieldNames(oldIdx.Fields) != fieldnames.CommaSeparatedFieldNames(idx.Fields) ||
+			oldIdx.Unique != idx.Unique {
+			diff.RemovedIndexes = append(diff.RemovedIndexes, oldIdx)
+			diff.AddedIndexes = append(diff.AddedIndexes, idx)
+		}
+	}
+
+	for _, idx := range oldDesc.Index {
+		if !newIndexes[idx.Id] {
+			diff.RemovedIndexes = append(diff.RemovedIndexes, idx)
+		}
+	}
+
+	return diff
+}
+
+// MigrateIndexes migrates the secondary indexes of table from oldDesc, the
+// table descriptor used when its entries were written, to its current
+// descriptor. Entries of removed indexes are deleted and added indexes are
+// rebuilt in place from the primary key. If the descriptors have changes
+// which can't be migrated in place, an error wrapping
+// ormerrors.IncompatibleSchemaChange is returned and the store is left
+// unchanged. Unique key violations encountered while building a unique index
+// are returned as errors wrapping ormerrors.UniqueKeyViolation.
+func MigrateIndexes(ctx context.Context, table Table, oldDesc *ormv1.TableDescriptor) (TableDiff, error) {
+	var impl *tableImpl
+	switch t := table.(type) {
+	case *tableImpl:
+		impl = t
+	case *autoIncrementTable:
+		impl = t.tableImpl
+	default:
+		return TableDiff{}, ormerrors.UnsupportedOperation.Wrapf("can't migrate indexes of %T", table)
+	}
+
+	diff := DiffTableDescriptors(oldDesc, impl.tableDesc)
+	if !diff.IsCompatible() {
+		return diff, ormerrors.IncompatibleSchemaChange.Wrapf("table %s: %v", impl.MessageType().Descriptor().FullName(), diff.Incompatible)
+	}
+
+	if diff.IsEmpty() {
+		return diff, nil
+	}
+
+	backend, err := impl.getWriteBackend(ctx)
+	if err != nil {
+		return diff, err
+	}
+
+	for _, idx := range diff.RemovedIndexes {
+		err = deletePrefix(backend.IndexStore(), encodeutil.AppendVarUInt32(impl.tablePrefix, idx.Id))
+		if err != nil {
+			return diff, err
+		}
+	}
+
+	var indexers []indexer
+	for _, idx := range diff.AddedIndexes {
+		indexers = append(indexers, impl.indexesByID[idx.Id].(indexer))
+	}
+
+	var cursor ormlist.CursorT
+	for {
+		msgs, nextCursor, err := readBatch(ctx, impl, cursor)
+		if err != nil {
+			return diff, err
+		}
+
+		for _, msg := range msgs {
+			for _, idx := range indexers {
+				if err := idx.onInsert(backend.IndexStore(), msg.ProtoReflect()); err != nil {
+					return diff, err
+				}
+			}
+		}
+
+		if nextCursor == nil {
+			return diff, nil
+		}
+		cursor = nextCursor
+	}
+}
+
+// readBatch reads up to migrationBatchSize entries from the table starting
+// after cursor and returns the cursor of the last entry read if there may be
+// more entries.
+func readBatch(ctx context.Context, table *tableImpl, cursor ormlist.CursorT) ([]proto.Message, ormlist.CursorT, error) {
+	var opts []ormlist.Option
+	if cursor != nil {
+		opts = append(opts, ormlist.Cursor(cursor))
+	}
+
+	it, err := table.List(ctx, nil, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	var msgs []proto.Message
+	for len(msgs) < migrationBatchSize && it.Next() {
+		msg, err := it.GetMessage()
+		if err != nil {
+			return nil, nil, err
+		}
+		msgs = append(msgs, msg)
+	}
+
+	if len(msgs) < migrationBatchSize {
+		return msgs, nil, nil
+	}
+
+	return msgs, it.Cursor(), nil
+}
+
+// deletePrefix deletes all the keys in the store with the provided prefix.
+func deletePrefix(store kv.Store, prefix []byte) error {
+	for {
+		it, err := store.Iterator(prefix, prefixEndBytes(prefix))
+		if err != nil {
+			return err
+		}
+
+		var keys [][]byte
+		for ; len(keys) < migrationBatchSize && it.Valid(); it.Next() {
+			keys = append(keys, append([]byte{}, it.Key()...))
+		}
+
+		err = it.Close()
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			if err := store.Delete(key); err != nil {
+				return err
+			}
+		}
+
+		if len(keys) < migrationBatchSize {
+			return nil
+		}
+	}
+}
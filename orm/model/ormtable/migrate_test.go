@@ -0,0 +1,159 @@
+package ormtable_test
+
+import (
+	"bytes"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"gotest.tools/v3/assert"
+
+	ormv1 "cosmossdk.io/api/cosmos/orm/v1"
+
+	"cosmossdk.io/orm/encoding/encodeutil"
+	"cosmossdk.io/orm/internal/testkv"
+	"cosmossdk.io/orm/internal/testpb"
+	"cosmossdk.io/orm/model/ormtable"
+	"cosmossdk.io/orm/types/ormerrors"
+)
+
+func tableDescriptor(msg proto.Message) *ormv1.TableDescriptor {
+	desc := proto.GetExtension(msg.ProtoReflect().Descriptor().Options(), ormv1.E_Table).(*ormv1.TableDescriptor)
+	return proto.Clone(desc).(*ormv1.TableDescriptor)
+}
+
+func TestDiffTableDescriptors(t *testing.T) {
+	newDesc := tableDescriptor(&testpb.ExampleTable{})
+
+	diff := ormtable.DiffTableDescriptors(newDesc, newDesc)
+	assert.Assert(t, diff.IsEmpty())
+
+	oldDesc := tableDescriptor(&testpb.ExampleTable{})
+	oldDesc.Index = []*ormv1.SecondaryIndexDescriptor{
+		{Id: 1, Fields: "u64,str", Unique: false},
+		{Id: 3, Fields: "bz,str"},
+		{Id: 10, Fields: "i32"},
+	}
+	diff = ormtable.DiffTableDescriptors(oldDesc, newDesc)
+	assert.Assert(t, diff.IsCompatible())
+	assert.Equal(t, len(diff.AddedIndexes), 2)
+	assert.Equal(t, diff.AddedIndexes[0].Id, uint32(1))
+	assert.Equal(t, diff.AddedIndexes[1].Id, uint32(2))
+	assert.Equal(t, len(diff.RemovedIndexes), 2)
+	assert.Equal(t, diff.RemovedIndexes[0].Id, uint32(1))
+	assert.Equal(t, diff.RemovedIndexes[1].Id, uint32(10))
+
+	oldDesc = tableDescriptor(&testpb.ExampleTable{})
+	oldDesc.Id = 2
+	oldDesc.PrimaryKey.Fields = "u32,i64"
+	diff = ormtable.DiffTableDescriptors(oldDesc, newDesc)
+	assert.Assert(t, !diff.IsCompatible())
+	assert.Equal(t, len(diff.Incompatible), 2)
+}
+
+func TestMigrateIndexes(t *testing.T) {
+	oldDesc := tableDescriptor(&testpb.ExampleTable{})
+	oldDesc.Index = []*ormv1.SecondaryIndexDescriptor{
+		{Id: 1, Fields: "u64,str", Unique: true},
+		{Id: 3, Fields: "bz,str"},
+		{Id: 10, Fields: "i32"},
+	}
+
+	oldTable, err := ormtable.Build(ormtable.Options{
+		MessageType:     (&testpb.ExampleTable{}).ProtoReflect().Type(),
+		TableDescriptor: oldDesc,
+	})
+	assert.NilError(t, err)
+
+	newTable, err := ormtable.Build(ormtable.Options{
+		MessageType: (&testpb.ExampleTable{}).ProtoReflect().Type(),
+	})
+	assert.NilError(t, err)
+
+	backend := testkv.NewSplitMemBackend()
+	ctx := ormtable.WrapContextDefault(backend)
+
+	// insert more entries than a single migration batch
+	n := 250
+	for i := 0; i < n; i++ {
+		assert.NilError(t, oldTable.Insert(ctx, &testpb.ExampleTable{
+			U32: uint32(i),
+			U64: uint64(i),
+			I32: int32(i % 7),
+			Str: "abc",
+		}))
+	}
+
+	removedPrefix := encodeutil.AppendVarUInt32(encodeutil.AppendVarUInt32(nil, oldDesc.Id), 10)
+	assert.Assert(t, hasPrefix(t, backend, removedPrefix))
+
+	diff, err := ormtable.MigrateIndexes(ctx, newTable, oldDesc)
+	assert.NilError(t, err)
+	assert.Equal(t, len(diff.AddedIndexes), 1)
+	assert.Equal(t, len(diff.RemovedIndexes), 1)
+
+	// the new index has an entry for every row
+	it, err := newTable.GetIndex("str,u32").List(ctx, []interface{}{"abc"})
+	assert.NilError(t, err)
+	count := 0
+	for it.Next() {
+		msg, err := it.GetMessage()
+		assert.NilError(t, err)
+		assert.Equal(t, msg.(*testpb.ExampleTable).U32, uint32(count))
+		count++
+	}
+	it.Close()
+	assert.Equal(t, count, n)
+
+	// the removed index has no entries left
+	assert.Assert(t, !hasPrefix(t, backend, removedPrefix))
+
+	// migrating again is a no-op
+	diff, err = ormtable.MigrateIndexes(ctx, newTable, tableDescriptor(&testpb.ExampleTable{}))
+	assert.NilError(t, err)
+	assert.Assert(t, diff.IsEmpty())
+}
+
+func TestMigrateIndexesUniqueViolation(t *testing.T) {
+	oldDesc := tableDescriptor(&testpb.ExampleAutoIncrementTable{})
+	oldDesc.Index = nil
+
+	oldTable, err := ormtable.Build(ormtable.Options{
+		MessageType:     (&testpb.ExampleAutoIncrementTable{}).ProtoReflect().Type(),
+		TableDescriptor: oldDesc,
+	})
+	assert.NilError(t, err)
+
+	newTable, err := ormtable.Build(ormtable.Options{
+		MessageType: (&testpb.ExampleAutoIncrementTable{}).ProtoReflect().Type(),
+	})
+	assert.NilError(t, err)
+
+	ctx := ormtable.WrapContextDefault(testkv.NewSplitMemBackend())
+	assert.NilError(t, oldTable.Insert(ctx, &testpb.ExampleAutoIncrementTable{X: "foo"}))
+	assert.NilError(t, oldTable.Insert(ctx, &testpb.ExampleAutoIncrementTable{X: "foo"}))
+
+	_, err = ormtable.MigrateIndexes(ctx, newTable, oldDesc)
+	assert.ErrorIs(t, err, ormerrors.UniqueKeyViolation)
+}
+
+func TestMigrateIndexesIncompatible(t *testing.T) {
+	oldDesc := tableDescriptor(&testpb.ExampleTable{})
+	oldDesc.PrimaryKey.Fields = "u32,i64"
+
+	table, err := ormtable.Build(ormtable.Options{
+		MessageType: (&testpb.ExampleTable{}).ProtoReflect().Type(),
+	})
+	assert.NilError(t, err)
+
+	ctx := ormtable.WrapContextDefault(testkv.NewSplitMemBackend())
+	_, err = ormtable.MigrateIndexes(ctx, table, oldDesc)
+	assert.ErrorIs(t, err, ormerrors.IncompatibleSchemaChange)
+}
+
+func hasPrefix(t *testing.T, backend ormtable.Backend, prefix []byte) bool {
+	t.Helper()
+	it, err := backend.IndexStoreReader().Iterator(prefix, nil)
+	assert.NilError(t, err)
+	defer it.Close()
+	return it.Valid() && bytes.HasPrefix(it.Key(), prefix)
+}
@@ -11,6 +11,8 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 
+	ormv1 "cosmossdk.io/api/cosmos/orm/v1"
+
 	"cosmossdk.io/orm/encoding/encodeutil"
 	"cosmossdk.io/orm/encoding/ormkv"
 	"cosmossdk.io/orm/internal/fieldnames"
@@ -27,6 +29,7 @@ type tableImpl struct {
 	entryCodecsByID       map[uint32]ormkv.EntryCodec
 	tablePrefix           []byte
 	tableID               uint32
+	tableDesc             *ormv1.TableDescriptor
 	typeResolver          TypeResolver
 	customJSONValidator   func(message proto.Message) error
 }
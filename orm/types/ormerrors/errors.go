@@ -45,4 +45,8 @@ var (
 	AlreadyExists                 = errors.RegisterWithGRPCCode(codespace, 31, codes.AlreadyExists, "already exists")
 	ConstraintViolation           = errors.RegisterWithGRPCCode(codespace, 32, codes.FailedPrecondition, "failed precondition")
 	NoTableDescriptor             = errors.New(codespace, 33, "no table descriptor found")
+	InvalidFilter                 = errors.RegisterWithGRPCCode(codespace, 34, codes.InvalidArgument, "invalid filter")
+	FilterGasLimitExceeded        = errors.RegisterWithGRPCCode(codespace, 35, codes.ResourceExhausted, "filter gas limit exceeded")
+	InvalidQuery                  = errors.RegisterWithGRPCCode(codespace, 36, codes.InvalidArgument, "invalid query")
+	IncompatibleSchemaChange      = errors.New(codespace, 37, "incompatible schema change")
 )
@@ -16,6 +16,7 @@ import (
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	nftkeeper "cosmossdk.io/x/nft/keeper"
+	tokenfactorykeeper "cosmossdk.io/x/tokenfactory/keeper"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -83,6 +84,7 @@ type SimApp struct {
 	NFTKeeper             nftkeeper.Keeper
 	ConsensusParamsKeeper consensuskeeper.Keeper
 	CircuitBreakerKeeper  circuitkeeper.Keeper
+	TokenFactoryKeeper    tokenfactorykeeper.Keeper
 
 	// simulation manager
 	sm *module.SimulationManager
@@ -168,6 +170,7 @@ func NewSimApp(
 		&app.NFTKeeper,
 		&app.ConsensusParamsKeeper,
 		&app.CircuitBreakerKeeper,
+		&app.TokenFactoryKeeper,
 	); err != nil {
 		panic(err)
 	}
@@ -296,6 +299,12 @@ func (app *SimApp) kvStoreKeys() map[string]*storetypes.KVStoreKey {
 	return keys
 }
 
+// GetUpgradeKeeper returns the upgrade keeper, implementing the
+// upgradecli.DryRunApplication interface.
+func (app *SimApp) GetUpgradeKeeper() *upgradekeeper.Keeper {
+	return app.UpgradeKeeper
+}
+
 // GetSubspace returns a param subspace for a given module name.
 //
 // NOTE: This is solely to be used for testing purposes.
@@ -0,0 +1,85 @@
+//go:build !app_v1
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	"cosmossdk.io/simapp"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagWiringFormat = "format"
+
+	wiringFormatJSON = "json"
+	wiringFormatDOT  = "dot"
+)
+
+// AppWiringCmd returns a command which resolves the simapp dependency injection
+// container and prints its providers, module keys, interface bindings and
+// invokers as JSON or the container graph in the Graphviz DOT format.
+func AppWiringCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "app-wiring",
+		Short: "Inspect how the app is wired together by dependency injection",
+		Long: `Resolve the app's dependency injection container without starting the app and print
+the resolved providers, module keys, interface bindings and invoker order as JSON, or the
+container graph in the Graphviz DOT format. Unused providers and ambiguous interface bindings
+are reported as warnings.`,
+		Example: fmt.Sprintf(`$ %s debug app-wiring
+$ %s debug app-wiring --format dot | dot -Tsvg > app.svg`, version.AppName, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			format, err := cmd.Flags().GetString(flagWiringFormat)
+			if err != nil {
+				return err
+			}
+
+			if format != wiringFormatJSON && format != wiringFormatDOT {
+				return fmt.Errorf("unsupported format %q, expected %s or %s", format, wiringFormatJSON, wiringFormatDOT)
+			}
+
+			var info depinject.ContainerInfo
+			var appBuilder *runtime.AppBuilder
+			if err := depinject.InjectDebug(
+				depinject.Inspector(func(i depinject.ContainerInfo) { info = i }),
+				depinject.Configs(
+					simapp.AppConfig,
+					depinject.Supply(server.GetServerContextFromCmd(cmd).Viper, log.NewNopLogger()),
+				),
+				&appBuilder,
+			); err != nil {
+				return err
+			}
+
+			if format == wiringFormatDOT {
+				for _, warning := range info.Warnings {
+					cmd.PrintErrln("WARNING:", warning)
+				}
+
+				cmd.Println(info.DOT)
+				return nil
+			}
+
+			bz, err := info.JSON()
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagWiringFormat, wiringFormatJSON, "Output format (json|dot)")
+
+	return cmd
+}
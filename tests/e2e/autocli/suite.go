@@ -0,0 +1,323 @@
+package autocli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	"cosmossdk.io/client/v2/autocli"
+	"cosmossdk.io/client/v2/autocli/flag"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authclitestutil "github.com/cosmos/cosmos-sdk/x/auth/client/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type E2ETestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+}
+
+func NewE2ETestSuite(cfg network.Config) *E2ETestSuite {
+	return &E2ETestSuite{cfg: cfg}
+}
+
+func (s *E2ETestSuite) SetupSuite() {
+	s.T().Log("setting up e2e test suite")
+
+	var err error
+	s.network, err = network.New(s.T(), s.T().TempDir(), s.cfg)
+	s.Require().NoError(err)
+
+	val := s.network.Validators[0]
+	kb := val.ClientCtx.Keyring
+	account1, _, err := kb.NewMnemonic("newAccount1", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err)
+
+	account2, _, err := kb.NewMnemonic("newAccount2", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err)
+
+	pub1, err := account1.GetPubKey()
+	s.Require().NoError(err)
+	pub2, err := account2.GetPubKey()
+	s.Require().NoError(err)
+
+	multi := kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{pub1, pub2})
+	multiRecord, err := kb.SaveMultisig("multi", multi)
+	s.Require().NoError(err)
+
+	// fund the accounts used by the tests
+	addr1, err := account1.GetAddress()
+	s.Require().NoError(err)
+	multiAddr, err := multiRecord.GetAddress()
+	s.Require().NoError(err)
+	for _, addr := range []sdk.AccAddress{addr1, multiAddr} {
+		_, err = s.execSend(val.ClientCtx, val.Moniker, addr, sdk.NewInt64Coin(s.cfg.BondDenom, 1000),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewInt64Coin(s.cfg.BondDenom, 10)),
+		)
+		s.Require().NoError(err)
+		s.Require().NoError(s.network.WaitForNextBlock())
+	}
+}
+
+func (s *E2ETestSuite) TearDownSuite() {
+	s.T().Log("tearing down e2e test suite")
+	s.network.Cleanup()
+}
+
+// sendCmd returns an autocli generated command for bank's MsgSend which
+// populates the sender from the --from flag.
+func (s *E2ETestSuite) sendCmd() *cobra.Command {
+	ac := address.NewBech32Codec("cosmos")
+	b := &autocli.Builder{
+		Builder: flag.Builder{
+			AddressCodec: ac,
+		},
+		GetClientConn: func(cmd *cobra.Command) (grpc.ClientConnInterface, error) {
+			return client.GetClientQueryContext(cmd)
+		},
+		AddTxConnFlags: flags.AddTxFlagsToCmd,
+		GenerateOrBroadcastTx: func(cmd *cobra.Command, msg protoreflect.Message) error {
+			return autocli.GenerateOrBroadcastTxCLI(cmd, ac, msg)
+		},
+	}
+
+	method := bankv1beta1.File_cosmos_bank_v1beta1_tx_proto.Services().ByName("Msg").Methods().ByName("Send")
+	cmd, err := b.BuildMsgMethodCommand(method, &autocliv1.RpcCommandOptions{
+		Use: "send [to_address] [amount]",
+		PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+			{ProtoField: "to_address"},
+			{ProtoField: "amount", Varargs: true},
+		},
+	})
+	s.Require().NoError(err)
+	return cmd
+}
+
+func (s *E2ETestSuite) execSend(clientCtx client.Context, from string, to sdk.AccAddress, amount sdk.Coin, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := append([]string{to.String(), amount.String(), fmt.Sprintf("--%s=%s", flags.FlagFrom, from)}, extraArgs...)
+	return clitestutil.ExecTestCLICmd(clientCtx, s.sendCmd(), args)
+}
+
+func (s *E2ETestSuite) getBalance(clientCtx client.Context, addr sdk.AccAddress) math.Int {
+	resp, err := clitestutil.QueryBalancesExec(clientCtx, addr, address.NewBech32Codec("cosmos"))
+	s.Require().NoError(err)
+
+	var balRes banktypes.QueryAllBalancesResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(resp.Bytes(), &balRes))
+	return balRes.Balances.AmountOf(s.cfg.BondDenom)
+}
+
+func (s *E2ETestSuite) TestGenerateOnlyOfflineSignBroadcast() {
+	val := s.network.Validators[0]
+	to := sdk.AccAddress("autocli_recipient___")
+	amount := sdk.NewInt64Coin(s.cfg.BondDenom, 10)
+
+	// generate an unsigned tx, the sender is populated from --from
+	generated, err := s.execSend(val.ClientCtx, val.Address.String(), to, amount,
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewInt64Coin(s.cfg.BondDenom, 10)),
+	)
+	s.Require().NoError(err)
+
+	stdTx, err := val.ClientCtx.TxConfig.TxJSONDecoder()(generated.Bytes())
+	s.Require().NoError(err)
+	s.Require().Len(stdTx.GetMsgs(), 1)
+	s.Require().Equal(&banktypes.MsgSend{
+		FromAddress: val.Address.String(),
+		ToAddress:   to.String(),
+		Amount:      sdk.NewCoins(amount),
+	}, stdTx.GetMsgs()[0])
+
+	unsignedFile := testutil.WriteToNewTempFile(s.T(), generated.String())
+	defer unsignedFile.Close()
+
+	// sign offline with an explicit account number and sequence
+	accNum, seq, err := val.ClientCtx.AccountRetriever.GetAccountNumberSequence(val.ClientCtx, val.Address)
+	s.Require().NoError(err)
+	signed, err := authclitestutil.TxSignExec(val.ClientCtx, val.Address, unsignedFile.Name(),
+		fmt.Sprintf("--%s", flags.FlagOffline),
+		fmt.Sprintf("--%s=%d", flags.FlagAccountNumber, accNum),
+		fmt.Sprintf("--%s=%d", flags.FlagSequence, seq),
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, val.ClientCtx.ChainID),
+	)
+	s.Require().NoError(err)
+
+	signedFile := testutil.WriteToNewTempFile(s.T(), signed.String())
+	defer signedFile.Close()
+
+	clientCtx := val.ClientCtx
+	clientCtx.BroadcastMode = flags.BroadcastSync
+	res, err := authclitestutil.TxBroadcastExec(clientCtx, signedFile.Name())
+	s.Require().NoError(err)
+	s.requireTxSuccess(clientCtx, res)
+
+	s.Require().NoError(s.network.WaitForNextBlock())
+	s.Require().Equal(amount.Amount, s.getBalance(clientCtx, to))
+}
+
+func (s *E2ETestSuite) TestOfflineAccountAndSequenceFlags() {
+	val := s.network.Validators[0]
+
+	accNum, seq, err := val.ClientCtx.AccountRetriever.GetAccountNumberSequence(val.ClientCtx, val.Address)
+	s.Require().NoError(err)
+
+	// an offline signature uses the account number and sequence given by flags
+	// instead of querying the account
+	res, err := s.execSend(val.ClientCtx, val.Moniker, sdk.AccAddress("autocli_offline_____"), sdk.NewInt64Coin(s.cfg.BondDenom, 10),
+		fmt.Sprintf("--%s", flags.FlagOffline),
+		fmt.Sprintf("--%s=true", flags.FlagAux),
+		fmt.Sprintf("--%s=%s", flags.FlagSignMode, flags.SignModeDirectAux),
+		fmt.Sprintf("--%s=%d", flags.FlagAccountNumber, accNum+100),
+		fmt.Sprintf("--%s=%d", flags.FlagSequence, seq+100),
+	)
+	s.Require().NoError(err)
+
+	var data tx.AuxSignerData
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(res.Bytes(), &data))
+	s.Require().Equal(val.Address.String(), data.Address)
+	s.Require().Equal(accNum+100, data.SignDoc.AccountNumber)
+	s.Require().Equal(seq+100, data.SignDoc.Sequence)
+
+	// offline mode without an account number and sequence can't sign
+	_, err = s.execSend(val.ClientCtx, val.Moniker, sdk.AccAddress("autocli_offline_____"), sdk.NewInt64Coin(s.cfg.BondDenom, 10),
+		fmt.Sprintf("--%s", flags.FlagOffline),
+		fmt.Sprintf("--%s=true", flags.FlagAux),
+		fmt.Sprintf("--%s=%s", flags.FlagSignMode, flags.SignModeDirectAux),
+	)
+	s.Require().Error(err)
+}
+
+func (s *E2ETestSuite) TestMultisig() {
+	val := s.network.Validators[0]
+	kb := val.ClientCtx.Keyring
+
+	multiRecord, err := kb.Key("multi")
+	s.Require().NoError(err)
+	multiAddr, err := multiRecord.GetAddress()
+	s.Require().NoError(err)
+
+	account1, err := kb.Key("newAccount1")
+	s.Require().NoError(err)
+	addr1, err := account1.GetAddress()
+	s.Require().NoError(err)
+
+	account2, err := kb.Key("newAccount2")
+	s.Require().NoError(err)
+	addr2, err := account2.GetAddress()
+	s.Require().NoError(err)
+
+	to := sdk.AccAddress("autocli_multisig____")
+	amount := sdk.NewInt64Coin(s.cfg.BondDenom, 5)
+
+	generated, err := s.execSend(val.ClientCtx, multiAddr.String(), to, amount,
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewInt64Coin(s.cfg.BondDenom, 10)),
+	)
+	s.Require().NoError(err)
+
+	unsignedFile := testutil.WriteToNewTempFile(s.T(), generated.String())
+	defer unsignedFile.Close()
+
+	// each member creates a partial signature
+	var sigFiles []string
+	for _, member := range []sdk.AccAddress{addr1, addr2} {
+		sig, err := authclitestutil.TxSignExec(val.ClientCtx, member, unsignedFile.Name(), "--multisig", multiAddr.String())
+		s.Require().NoError(err)
+
+		sigFile := testutil.WriteToNewTempFile(s.T(), sig.String())
+		defer sigFile.Close()
+		sigFiles = append(sigFiles, sigFile.Name())
+	}
+
+	signed, err := authclitestutil.TxMultiSignExec(val.ClientCtx, multiRecord.Name, unsignedFile.Name(), sigFiles...)
+	s.Require().NoError(err)
+
+	signedFile := testutil.WriteToNewTempFile(s.T(), signed.String())
+	defer signedFile.Close()
+
+	_, err = authclitestutil.TxValidateSignaturesExec(val.ClientCtx, signedFile.Name())
+	s.Require().NoError(err)
+
+	clientCtx := val.ClientCtx
+	clientCtx.BroadcastMode = flags.BroadcastSync
+	res, err := authclitestutil.TxBroadcastExec(clientCtx, signedFile.Name())
+	s.Require().NoError(err)
+	s.requireTxSuccess(clientCtx, res)
+
+	s.Require().NoError(s.network.WaitForNextBlock())
+	s.Require().Equal(amount.Amount, s.getBalance(clientCtx, to))
+}
+
+func (s *E2ETestSuite) TestAuxSigner() {
+	val := s.network.Validators[0]
+
+	account1, err := val.ClientCtx.Keyring.Key("newAccount1")
+	s.Require().NoError(err)
+	addr1, err := account1.GetAddress()
+	s.Require().NoError(err)
+
+	// SIGN_MODE_DIRECT_AUX requires --aux
+	_, err = s.execSend(val.ClientCtx, account1.Name, val.Address, sdk.NewInt64Coin(s.cfg.BondDenom, 10),
+		fmt.Sprintf("--%s=%s", flags.FlagSignMode, flags.SignModeDirectAux),
+	)
+	s.Require().Error(err)
+
+	to := sdk.AccAddress("autocli_aux_________")
+	amount := sdk.NewInt64Coin(s.cfg.BondDenom, 7)
+	auxSignerData, err := s.execSend(val.ClientCtx, account1.Name, to, amount,
+		fmt.Sprintf("--%s=%s", flags.FlagSignMode, flags.SignModeDirectAux),
+		fmt.Sprintf("--%s=true", flags.FlagAux),
+	)
+	s.Require().NoError(err)
+
+	var data tx.AuxSignerData
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(auxSignerData.Bytes(), &data))
+	s.Require().Equal(addr1.String(), data.Address)
+	s.Require().NotEmpty(data.Sig)
+
+	auxFile := testutil.WriteToNewTempFile(s.T(), auxSignerData.String())
+	defer auxFile.Close()
+
+	// the validator pays the fees and broadcasts the tx
+	clientCtx := val.ClientCtx
+	clientCtx.BroadcastMode = flags.BroadcastSync
+	res, err := authclitestutil.TxAuxToFeeExec(clientCtx, auxFile.Name(),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewInt64Coin(s.cfg.BondDenom, 10)),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+	)
+	s.Require().NoError(err)
+	s.requireTxSuccess(clientCtx, res)
+
+	s.Require().NoError(s.network.WaitForNextBlock())
+	s.Require().Equal(amount.Amount, s.getBalance(clientCtx, to))
+}
+
+func (s *E2ETestSuite) requireTxSuccess(clientCtx client.Context, res testutil.BufferWriter) {
+	var txRes sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(res.Bytes(), &txRes))
+	s.Require().Equal(uint32(0), txRes.Code, strings.TrimSpace(txRes.RawLog))
+}
@@ -31,7 +31,10 @@ require (
 	pgregory.net/rapid v0.6.2
 )
 
-require google.golang.org/grpc v1.55.0
+require (
+	cosmossdk.io/client/v2 v2.0.0-20230309163709-87da587416ba
+	google.golang.org/grpc v1.55.0
+)
 
 require (
 	cloud.google.com/go v0.110.0 // indirect
@@ -39,9 +42,9 @@ require (
 	cloud.google.com/go/compute/metadata v0.2.3 // indirect
 	cloud.google.com/go/iam v0.13.0 // indirect
 	cloud.google.com/go/storage v1.30.0 // indirect
-	cosmossdk.io/client/v2 v2.0.0-20230309163709-87da587416ba // indirect
 	cosmossdk.io/collections v0.1.0 // indirect
 	cosmossdk.io/x/circuit v0.0.0-20230220112800-f69b9ff58fbe // indirect
+	cosmossdk.io/x/tokenfactory v0.0.0-00010101000000-000000000000 // indirect
 	filippo.io/edwards25519 v1.0.0 // indirect
 	github.com/99designs/go-keychain v0.0.0-20191008050251-8e49817e8af4 // indirect
 	github.com/99designs/keyring v1.2.1 // indirect
@@ -194,12 +197,16 @@ require (
 // It must be in sync with SimApp temporary replaces
 replace (
 	// TODO tag all extracted modules after SDK refactor
+	cosmossdk.io/api => ../api
+	cosmossdk.io/client/v2 => ../client/v2
 	cosmossdk.io/collections => ../collections
 	cosmossdk.io/core => ../core
+	cosmossdk.io/depinject => ../depinject
 	cosmossdk.io/x/circuit => ../x/circuit
 	cosmossdk.io/x/evidence => ../x/evidence
 	cosmossdk.io/x/feegrant => ../x/feegrant
 	cosmossdk.io/x/nft => ../x/nft
+	cosmossdk.io/x/tokenfactory => ../x/tokenfactory
 	cosmossdk.io/x/tx => ../x/tx
 	cosmossdk.io/x/upgrade => ../x/upgrade
 )
@@ -0,0 +1,272 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// initAutoCompoundFixture creates a validator with the given delegators,
+// allocates rewards to it and moves to the next block.
+func initAutoCompoundFixture(t *testing.T, delAddrs ...sdk.AccAddress) *fixture {
+	t.Helper()
+	f := initFixture(t)
+	f.stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(f.distrKeeper.Hooks()))
+
+	assert.NilError(t, f.distrKeeper.Params.Set(f.sdkCtx, distrtypes.DefaultParams()))
+	assert.NilError(t, f.distrKeeper.FeePool.Set(f.sdkCtx, distrtypes.InitialFeePool()))
+	assert.NilError(t, f.stakingKeeper.SetParams(f.sdkCtx, stakingtypes.DefaultParams()))
+
+	tokens := f.stakingKeeper.TokensFromConsensusPower(f.sdkCtx, 100)
+	for _, addr := range append([]sdk.AccAddress{sdk.AccAddress(f.valAddr)}, delAddrs...) {
+		coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tokens))
+		assert.NilError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, coins))
+		assert.NilError(t, f.bankKeeper.SendCoinsFromModuleToAccount(f.sdkCtx, distrtypes.ModuleName, addr, coins))
+	}
+
+	tstaking := stakingtestutil.NewHelper(t, f.sdkCtx, f.stakingKeeper)
+	tstaking.Commission = stakingtestutil.ZeroCommission()
+	tstaking.CreateValidator(f.valAddr, valConsPk0, tokens, true)
+	for _, addr := range delAddrs {
+		tstaking.Delegate(addr, f.valAddr, tokens)
+	}
+
+	// allocate rewards to the validator and fund the distribution module
+	// account accordingly
+	rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tokens))
+	assert.NilError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, rewards))
+	validator := f.stakingKeeper.Validator(f.sdkCtx, f.valAddr)
+	assert.NilError(t, f.distrKeeper.AllocateTokensToValidator(f.sdkCtx, validator, sdk.NewDecCoinsFromCoins(rewards...)))
+
+	// no rewards are owed to delegations started at the current height
+	f.sdkCtx = f.sdkCtx.WithBlockHeight(f.sdkCtx.BlockHeight() + 1)
+
+	return f
+}
+
+func countEvents(ctx sdk.Context, eventType string) int {
+	count := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			count++
+		}
+	}
+	return count
+}
+
+func TestAutoCompound(t *testing.T) {
+	t.Parallel()
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	f := initAutoCompoundFixture(t, delAddr)
+	ctx := f.sdkCtx.WithEventManager(sdk.NewEventManager())
+
+	assert.NilError(t, f.distrKeeper.SetAutoCompound(ctx, delAddr, f.valAddr, true))
+	validators, err := f.distrKeeper.GetAutoCompoundValidators(ctx, delAddr)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []sdk.ValAddress{f.valAddr}, validators)
+
+	delegation, found := f.stakingKeeper.GetDelegation(ctx, delAddr, f.valAddr)
+	assert.Assert(t, found)
+	initShares := delegation.Shares
+	initBalance := f.bankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom)
+
+	assert.NilError(t, f.distrKeeper.AutoCompound(ctx))
+
+	// the rewards are delegated, the liquid balance is unchanged
+	delegation, found = f.stakingKeeper.GetDelegation(ctx, delAddr, f.valAddr)
+	assert.Assert(t, found)
+	assert.Assert(t, delegation.Shares.GT(initShares))
+	assert.DeepEqual(t, initBalance, f.bankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom))
+	assert.Equal(t, 1, countEvents(ctx, distrtypes.EventTypeAutoCompound))
+
+	// removing the delegation stops the auto-compounding
+	_, _, err = f.stakingKeeper.Undelegate(ctx, delAddr, f.valAddr, delegation.Shares)
+	assert.NilError(t, err)
+	validators, err = f.distrKeeper.GetAutoCompoundValidators(ctx, delAddr)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(validators))
+}
+
+func TestAutoCompoundFallback(t *testing.T) {
+	t.Parallel()
+	delAddr := sdk.AccAddress(PKS[1].Address())
+
+	testCases := []struct {
+		name  string
+		setup func(f *fixture, ctx sdk.Context)
+	}{
+		{
+			name: "jailed validator",
+			setup: func(f *fixture, ctx sdk.Context) {
+				validator, _ := f.stakingKeeper.GetValidator(ctx, f.valAddr)
+				validator.Jailed = true
+				f.stakingKeeper.SetValidator(ctx, validator)
+			},
+		},
+		{
+			name: "withdraw address set",
+			setup: func(f *fixture, ctx sdk.Context) {
+				assert.NilError(t, f.distrKeeper.SetWithdrawAddr(ctx, delAddr, sdk.AccAddress(PKS[2].Address())))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f := initAutoCompoundFixture(t, delAddr)
+			ctx := f.sdkCtx.WithEventManager(sdk.NewEventManager())
+			assert.NilError(t, f.distrKeeper.SetAutoCompound(ctx, delAddr, f.valAddr, true))
+			tc.setup(f, ctx)
+
+			delegation, _ := f.stakingKeeper.GetDelegation(ctx, delAddr, f.valAddr)
+			assert.NilError(t, f.distrKeeper.AutoCompound(ctx))
+
+			// the rewards are withdrawn but not delegated
+			newDelegation, _ := f.stakingKeeper.GetDelegation(ctx, delAddr, f.valAddr)
+			assert.Assert(t, newDelegation.Shares.Equal(delegation.Shares))
+			assert.Equal(t, 1, countEvents(ctx, distrtypes.EventTypeWithdrawRewards))
+			assert.Equal(t, 1, countEvents(ctx, distrtypes.EventTypeAutoCompoundFailed))
+			assert.Equal(t, 0, countEvents(ctx, distrtypes.EventTypeAutoCompound))
+		})
+	}
+}
+
+func TestAutoCompoundError(t *testing.T) {
+	t.Parallel()
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	f := initAutoCompoundFixture(t, delAddr)
+	ctx := f.sdkCtx.WithEventManager(sdk.NewEventManager())
+	assert.NilError(t, f.distrKeeper.SetAutoCompound(ctx, delAddr, f.valAddr, true))
+
+	// drain the distribution module account so that the rewards cannot be
+	// withdrawn
+	distrAddr := f.accountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	balance := f.bankKeeper.GetAllBalances(ctx, distrAddr)
+	assert.NilError(t, f.bankKeeper.SendCoinsFromModuleToAccount(ctx, distrtypes.ModuleName, sdk.AccAddress(PKS[2].Address()), balance))
+
+	delegation, _ := f.stakingKeeper.GetDelegation(ctx, delAddr, f.valAddr)
+	initBalance := f.bankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom)
+
+	// the failure is reported without halting the chain, and the delegation is
+	// left untouched
+	assert.NilError(t, f.distrKeeper.AutoCompound(ctx))
+	newDelegation, _ := f.stakingKeeper.GetDelegation(ctx, delAddr, f.valAddr)
+	assert.Assert(t, newDelegation.Shares.Equal(delegation.Shares))
+	assert.DeepEqual(t, initBalance, f.bankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom))
+	assert.Equal(t, 1, countEvents(ctx, distrtypes.EventTypeAutoCompoundFailed))
+	assert.Equal(t, 0, countEvents(ctx, distrtypes.EventTypeWithdrawRewards))
+
+	// the delegation is still processed by the next calls
+	cursor, err := f.distrKeeper.AutoCompoundCursor.Get(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, cursor.K1().Equals(delAddr))
+}
+
+func TestAutoCompoundMinReward(t *testing.T) {
+	t.Parallel()
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	f := initAutoCompoundFixture(t, delAddr)
+	ctx := f.sdkCtx.WithEventManager(sdk.NewEventManager())
+
+	params := distrtypes.DefaultParams()
+	params.AutoCompoundMinReward = f.stakingKeeper.TokensFromConsensusPower(ctx, 1000)
+	assert.NilError(t, f.distrKeeper.Params.Set(ctx, params))
+	assert.NilError(t, f.distrKeeper.SetAutoCompound(ctx, delAddr, f.valAddr, true))
+
+	assert.NilError(t, f.distrKeeper.AutoCompound(ctx))
+	assert.Equal(t, 0, countEvents(ctx, distrtypes.EventTypeWithdrawRewards))
+	assert.Equal(t, 0, countEvents(ctx, distrtypes.EventTypeAutoCompound))
+}
+
+func TestAutoCompoundRoundRobin(t *testing.T) {
+	t.Parallel()
+	delAddrs := []sdk.AccAddress{sdk.AccAddress(PKS[1].Address()), sdk.AccAddress(PKS[2].Address())}
+	f := initAutoCompoundFixture(t, delAddrs...)
+
+	params := distrtypes.DefaultParams()
+	params.AutoCompoundMinReward = math.ZeroInt()
+	params.AutoCompoundMaxPerBlock = 1
+	assert.NilError(t, f.distrKeeper.Params.Set(f.sdkCtx, params))
+	for _, delAddr := range delAddrs {
+		assert.NilError(t, f.distrKeeper.SetAutoCompound(f.sdkCtx, delAddr, f.valAddr, true))
+	}
+
+	// a single delegation is processed per call, resuming after the cursor
+	processed := make(map[string]int)
+	for i := 0; i < 4; i++ {
+		assert.NilError(t, f.distrKeeper.AutoCompound(f.sdkCtx))
+		cursor, err := f.distrKeeper.AutoCompoundCursor.Get(f.sdkCtx)
+		assert.NilError(t, err)
+		processed[cursor.K1().String()]++
+
+		// allocate new rewards for the next call
+		rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000000)))
+		assert.NilError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, rewards))
+		validator := f.stakingKeeper.Validator(f.sdkCtx, f.valAddr)
+		assert.NilError(t, f.distrKeeper.AllocateTokensToValidator(f.sdkCtx, validator, sdk.NewDecCoinsFromCoins(rewards...)))
+	}
+
+	assert.Equal(t, 2, processed[delAddrs[0].String()])
+	assert.Equal(t, 2, processed[delAddrs[1].String()])
+}
+
+func TestMsgSetAutoCompound(t *testing.T) {
+	t.Parallel()
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	f := initAutoCompoundFixture(t, delAddr)
+
+	testCases := []struct {
+		name      string
+		msg       *distrtypes.MsgSetAutoCompound
+		expErrMsg string
+	}{
+		{
+			name:      "no delegation",
+			msg:       distrtypes.NewMsgSetAutoCompound(sdk.AccAddress(PKS[2].Address()), f.valAddr, true),
+			expErrMsg: "delegation does not exist",
+		},
+		{
+			name:      "no validator",
+			msg:       distrtypes.NewMsgSetAutoCompound(delAddr, sdk.ValAddress(PKS[2].Address()), true),
+			expErrMsg: "validator does not exist",
+		},
+		{
+			name: "enable",
+			msg:  distrtypes.NewMsgSetAutoCompound(delAddr, f.valAddr, true),
+		},
+		{
+			name: "disable",
+			msg:  distrtypes.NewMsgSetAutoCompound(delAddr, f.valAddr, false),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := f.app.RunMsg(tc.msg)
+			if tc.expErrMsg != "" {
+				assert.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			assert.NilError(t, err)
+
+			res, err := distrkeeper.NewQuerier(f.distrKeeper).AutoCompoundDelegations(
+				f.sdkCtx, &distrtypes.QueryAutoCompoundDelegationsRequest{DelegatorAddress: tc.msg.DelegatorAddress},
+			)
+			assert.NilError(t, err)
+			if tc.msg.Enabled {
+				assert.DeepEqual(t, []string{tc.msg.ValidatorAddress}, res.Validators)
+			} else {
+				assert.Equal(t, 0, len(res.Validators))
+			}
+		})
+	}
+}
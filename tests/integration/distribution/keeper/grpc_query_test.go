@@ -45,10 +45,11 @@ func TestGRPCParams(t *testing.T) {
 			name: "valid request",
 			malleate: func() {
 				params = types.Params{
-					CommunityTax:        sdk.NewDecWithPrec(3, 1),
-					BaseProposerReward:  math.LegacyZeroDec(),
-					BonusProposerReward: math.LegacyZeroDec(),
-					WithdrawAddrEnabled: true,
+					CommunityTax:          sdk.NewDecWithPrec(3, 1),
+					BaseProposerReward:    math.LegacyZeroDec(),
+					BonusProposerReward:   math.LegacyZeroDec(),
+					WithdrawAddrEnabled:   true,
+					AutoCompoundMinReward: math.ZeroInt(),
 				}
 
 				assert.NilError(t, f.distrKeeper.Params.Set(f.sdkCtx, params))
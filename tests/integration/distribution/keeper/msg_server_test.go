@@ -124,6 +124,7 @@ func initFixture(t testing.TB) *fixture {
 	integrationApp := integration.NewIntegrationApp(ctx, logger, keys, cdc, authModule, bankModule, stakingModule, distrModule)
 
 	sdkCtx := sdk.UnwrapSDKContext(integrationApp.Context())
+	require.NoError(t, distrKeeper.Params.Set(sdkCtx, distrtypes.DefaultParams()))
 
 	// Register MsgServer and QueryServer
 	distrtypes.RegisterMsgServer(integrationApp.MsgServiceRouter(), distrkeeper.NewMsgServerImpl(distrKeeper))
@@ -734,10 +735,12 @@ func TestMsgUpdateParams(t *testing.T) {
 			msg: &distrtypes.MsgUpdateParams{
 				Authority: f.distrKeeper.GetAuthority(),
 				Params: distrtypes.Params{
-					CommunityTax:        communityTax,
-					BaseProposerReward:  math.LegacyZeroDec(),
-					BonusProposerReward: math.LegacyZeroDec(),
-					WithdrawAddrEnabled: withdrawAddrEnabled,
+					CommunityTax:            communityTax,
+					BaseProposerReward:      math.LegacyZeroDec(),
+					BonusProposerReward:     math.LegacyZeroDec(),
+					WithdrawAddrEnabled:     withdrawAddrEnabled,
+					AutoCompoundMinReward:   distrtypes.DefaultAutoCompoundMinReward,
+					AutoCompoundMaxPerBlock: distrtypes.DefaultAutoCompoundMaxPerBlock,
 				},
 			},
 			expErr: false,
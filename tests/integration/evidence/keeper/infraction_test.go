@@ -9,6 +9,7 @@ import (
 
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/evidence"
 	"cosmossdk.io/x/evidence/exported"
@@ -286,6 +287,171 @@ func TestHandleDoubleSign_TooOld(t *testing.T) {
 	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())) == false)
 }
 
+func TestHandleDoubleSign_JailWithoutTombstone(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Now().UTC())
+	populateValidators(t, f)
+
+	params, err := f.slashingKeeper.GetParams(ctx)
+	assert.NilError(t, err)
+	params.DoubleSignJailDuration = time.Hour
+	assert.NilError(t, f.slashingKeeper.SetParams(ctx, params))
+
+	power := int64(100)
+	operatorAddr, val := valAddresses[0], pubkeys[0]
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	tstaking.CreateValidatorWithValPower(operatorAddr, val, power, true)
+
+	_, err = f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+
+	consAddr := sdk.ConsAddress(val.Address())
+	assert.NilError(t, f.slashingKeeper.AddPubkey(ctx, val))
+	info := slashingtypes.NewValidatorSigningInfo(consAddr, ctx.BlockHeight(), int64(0), time.Unix(0, 0), false, int64(0))
+	f.slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info)
+
+	oldTokens := f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+
+	nci := NewCometInfo(abci.RequestFinalizeBlock{
+		Misbehavior: []abci.Misbehavior{{
+			Validator: abci.Validator{Address: val.Address(), Power: power},
+			Type:      abci.MisbehaviorType_DUPLICATE_VOTE,
+			Time:      ctx.BlockTime(),
+			Height:    1,
+		}},
+	})
+	assert.NilError(t, f.evidenceKeeper.BeginBlocker(ctx.WithCometInfo(nci)))
+
+	// should be jailed for the double sign jail duration but not tombstoned
+	assert.Assert(t, f.stakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	assert.Assert(t, !f.slashingKeeper.IsTombstoned(ctx, consAddr))
+
+	info, found := f.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	assert.Assert(t, found)
+	assert.Assert(t, info.JailedUntil.Equal(ctx.BlockTime().Add(time.Hour)))
+
+	newTokens := f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	assert.Assert(t, newTokens.LT(oldTokens))
+
+	// another equivocation committed during the same bonding period is ignored
+	nci = NewCometInfo(abci.RequestFinalizeBlock{
+		Misbehavior: []abci.Misbehavior{{
+			Validator: abci.Validator{Address: val.Address(), Power: power},
+			Type:      abci.MisbehaviorType_DUPLICATE_VOTE,
+			Time:      ctx.BlockTime(),
+			Height:    2,
+		}},
+	})
+	ctx = ctx.WithBlockHeight(2)
+	assert.NilError(t, f.evidenceKeeper.BeginBlocker(ctx.WithCometInfo(nci)))
+	assert.Assert(t, f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens().Equal(newTokens))
+
+	// the validator can unjail once the jail duration elapsed
+	assert.Error(t, f.slashingKeeper.Unjail(ctx, operatorAddr), slashingtypes.ErrValidatorJailed.Error())
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	assert.NilError(t, f.slashingKeeper.Unjail(ctx, operatorAddr))
+}
+
+func TestHandleDoubleSign_CorrelatedPenalty(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Now().UTC())
+	populateValidators(t, f)
+
+	params, err := f.slashingKeeper.GetParams(ctx)
+	assert.NilError(t, err)
+	params.CorrelatedSlashWindow = 10
+	params.CorrelatedSlashMultiplier = sdkmath.LegacyNewDecWithPrec(3, 1)
+	assert.NilError(t, f.slashingKeeper.SetParams(ctx, params))
+
+	power := int64(100)
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	for i, operatorAddr := range valAddresses {
+		tstaking.CreateValidatorWithValPower(operatorAddr, pubkeys[i], power, true)
+
+		consAddr := sdk.ConsAddress(pubkeys[i].Address())
+		assert.NilError(t, f.slashingKeeper.AddPubkey(ctx, pubkeys[i]))
+		info := slashingtypes.NewValidatorSigningInfo(consAddr, ctx.BlockHeight(), int64(0), time.Unix(0, 0), false, int64(0))
+		f.slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info)
+	}
+
+	_, err = f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+
+	tokens := f.stakingKeeper.TokensFromConsensusPower(ctx, power)
+
+	// the first validator is slashed 0.3 * 100 / 300 = 10% and the second one
+	// 0.3 * 200 / 300 = 20% as the first equivocation is within the window
+	nci := NewCometInfo(abci.RequestFinalizeBlock{
+		Misbehavior: []abci.Misbehavior{
+			{
+				Validator: abci.Validator{Address: pubkeys[0].Address(), Power: power},
+				Type:      abci.MisbehaviorType_DUPLICATE_VOTE,
+				Time:      ctx.BlockTime(),
+				Height:    1,
+			},
+			{
+				Validator: abci.Validator{Address: pubkeys[1].Address(), Power: power},
+				Type:      abci.MisbehaviorType_DUPLICATE_VOTE,
+				Time:      ctx.BlockTime(),
+				Height:    1,
+			},
+		},
+	})
+	assert.NilError(t, f.evidenceKeeper.BeginBlocker(ctx.WithCometInfo(nci)))
+
+	assert.DeepEqual(t, tokens.MulRaw(9).QuoRaw(10), f.stakingKeeper.Validator(ctx, valAddresses[0]).GetTokens())
+	assert.DeepEqual(t, tokens.MulRaw(8).QuoRaw(10), f.stakingKeeper.Validator(ctx, valAddresses[1]).GetTokens())
+	assert.DeepEqual(t, tokens, f.stakingKeeper.Validator(ctx, valAddresses[2]).GetTokens())
+}
+
+func TestHandleLightClientAttack(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Now().UTC())
+	populateValidators(t, f)
+
+	power := int64(100)
+	operatorAddr, val := valAddresses[0], pubkeys[0]
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+	tstaking.CreateValidatorWithValPower(operatorAddr, val, power, true)
+
+	_, err := f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+
+	consAddr := sdk.ConsAddress(val.Address())
+	assert.NilError(t, f.slashingKeeper.AddPubkey(ctx, val))
+	info := slashingtypes.NewValidatorSigningInfo(consAddr, ctx.BlockHeight(), int64(0), time.Unix(0, 0), false, int64(0))
+	f.slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info)
+
+	oldTokens := f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+
+	nci := NewCometInfo(abci.RequestFinalizeBlock{
+		Misbehavior: []abci.Misbehavior{{
+			Validator:        abci.Validator{Address: val.Address(), Power: power},
+			Type:             abci.MisbehaviorType_LIGHT_CLIENT_ATTACK,
+			Time:             ctx.BlockTime(),
+			Height:           1,
+			TotalVotingPower: power,
+		}},
+	})
+	assert.NilError(t, f.evidenceKeeper.BeginBlocker(ctx.WithCometInfo(nci)))
+
+	// should be slashed, jailed and tombstoned as for an equivocation
+	assert.Assert(t, f.stakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, consAddr))
+	assert.Assert(t, f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens().LT(oldTokens))
+
+	// the evidence is stored as a light client attack
+	res, err := keeper.NewQuerier(f.evidenceKeeper).EvidenceByType(ctx, &evidencetypes.QueryEvidenceByTypeRequest{Route: evidencetypes.RouteLightClientAttack})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Evidence))
+}
+
 func populateValidators(t assert.TestingT, f *fixture) {
 	// add accounts and set total supply
 	totalSupplyAmt := initAmt.MulRaw(int64(len(valAddresses)))
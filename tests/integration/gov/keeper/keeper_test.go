@@ -110,8 +110,11 @@ func initFixture(t testing.TB) *fixture {
 		distrKeeper,
 		router,
 		types.DefaultConfig(),
+		nil,
 		authority.String(),
 	)
+	stakingKeeper.SetHooks(govKeeper.StakingHooks())
+
 	err := govKeeper.ProposalID.Set(newCtx, 1)
 	assert.NilError(t, err)
 	govRouter := v1beta1.NewRouter()
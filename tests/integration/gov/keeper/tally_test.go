@@ -5,6 +5,8 @@ import (
 
 	"gotest.tools/v3/assert"
 
+	"cosmossdk.io/math"
+
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -20,7 +22,7 @@ func TestTallyNoOneVotes(t *testing.T) {
 	createValidators(t, f, []int64{5, 5, 5})
 
 	tp := TestProposal
-	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), v1.ProposalTypeStandard)
 	assert.NilError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -47,7 +49,7 @@ func TestTallyNoQuorum(t *testing.T) {
 	addrs := simtestutil.AddTestAddrsIncremental(f.bankKeeper, f.stakingKeeper, ctx, 1, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], v1.ProposalTypeStandard)
 	assert.NilError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -73,7 +75,7 @@ func TestTallyOnlyValidatorsAllYes(t *testing.T) {
 	addrs, _ := createValidators(t, f, []int64{5, 5, 5})
 	tp := TestProposal
 
-	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], v1.ProposalTypeStandard)
 	assert.NilError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -102,7 +104,7 @@ func TestTallyOnlyValidators51No(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, f, []int64{5, 6, 0})
 
 	tp := TestProposal
-	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false)
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], v1.ProposalTypeStandard)
 	assert.NilError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -129,7 +131,7 @@ func TestTallyOnlyValidators51Yes(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, f, []int64{5, 6, 0})
 
 	tp := TestProposal
-	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false)
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], v1.ProposalTypeStandard)
 	assert.NilError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -157,7 +159,7 @@ func TestTallyOnlyValidatorsVetoed(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, f, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false)
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], v1.ProposalTypeStandard)
 	assert.NilError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -186,7 +188,7 @@ func TestTallyOnlyValidatorsAbstainPasses(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, f, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false)
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], v1.ProposalTypeStandard)
 	assert.NilError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -215,7 +217,7 @@ func TestTallyOnlyValidatorsAbstainFails(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, f, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false)
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], v1.ProposalTypeStandard)
 	assert.NilError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -245,7 +247,7 @@ func TestTallyOnlyValidatorsNonVoter(t *testing.T) {
 	valAccAddr1, valAccAddr2 := valAccAddrs[0], valAccAddrs[1]
 
 	tp := TestProposal
-	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false)
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], v1.ProposalTypeStandard)
 	assert.NilError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -282,7 +284,7 @@ func TestTallyDelgatorOverride(t *testing.T) {
 	f.stakingKeeper.EndBlocker(ctx)
 
 	tp := TestProposal
-	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], v1.ProposalTypeStandard)
 	assert.NilError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -321,7 +323,7 @@ func TestTallyDelgatorInherit(t *testing.T) {
 	f.stakingKeeper.EndBlocker(ctx)
 
 	tp := TestProposal
-	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], v1.ProposalTypeStandard)
 	assert.NilError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -363,7 +365,7 @@ func TestTallyDelgatorMultipleOverride(t *testing.T) {
 	f.stakingKeeper.EndBlocker(ctx)
 
 	tp := TestProposal
-	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], v1.ProposalTypeStandard)
 	assert.NilError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -408,7 +410,7 @@ func TestTallyDelgatorMultipleInherit(t *testing.T) {
 	f.stakingKeeper.EndBlocker(ctx)
 
 	tp := TestProposal
-	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], v1.ProposalTypeStandard)
 	assert.NilError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -454,7 +456,7 @@ func TestTallyJailedValidator(t *testing.T) {
 	f.stakingKeeper.Jail(ctx, sdk.ConsAddress(consAddr.Bytes()))
 
 	tp := TestProposal
-	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], v1.ProposalTypeStandard)
 	assert.NilError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -490,7 +492,7 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 	assert.NilError(t, err)
 
 	tp := TestProposal
-	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], v1.ProposalTypeStandard)
 	assert.NilError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -515,3 +517,102 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	assert.Assert(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallyGovernorDelegation(t *testing.T) {
+	testCases := []struct {
+		name          string
+		delegatorVote v1.VoteOption
+		delegateLater bool
+		expPass       bool
+		expYes        int64
+		expNo         int64
+	}{
+		{
+			name:    "governor votes with the delegated power",
+			expPass: false,
+			expYes:  7,
+			expNo:   41,
+		},
+		{
+			name:          "governor votes with the power delegated after the governor delegation",
+			delegateLater: true,
+			expPass:       false,
+			expYes:        7,
+			expNo:         41,
+		},
+		{
+			name:          "delegator overrides the governor",
+			delegatorVote: v1.OptionYes,
+			expPass:       true,
+			expYes:        37,
+			expNo:         11,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := initFixture(t)
+
+			ctx := f.ctx
+
+			params, err := f.govKeeper.Params.Get(ctx)
+			assert.NilError(t, err)
+			params.GovernorDelegationEnabled = true
+			assert.NilError(t, f.govKeeper.Params.Set(ctx, params))
+
+			addrs, vals := createValidators(t, f, []int64{5, 6, 7})
+
+			delTokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 30)
+			val3, found := f.stakingKeeper.GetValidator(ctx, vals[2])
+			assert.Assert(t, found)
+
+			// addrs[4] has no stake and only votes with the power delegated by addrs[3],
+			// whose delegation shares are tracked when the delegation changes later
+			if tc.delegateLater {
+				assert.NilError(t, f.govKeeper.DelegateGovernor(ctx, addrs[3], addrs[4]))
+			}
+
+			_, err = f.stakingKeeper.Delegate(ctx, addrs[3], delTokens, stakingtypes.Unbonded, val3, true)
+			assert.NilError(t, err)
+
+			f.stakingKeeper.EndBlocker(ctx)
+
+			if !tc.delegateLater {
+				assert.NilError(t, f.govKeeper.DelegateGovernor(ctx, addrs[3], addrs[4]))
+			}
+
+			tp := TestProposal
+			proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], v1.ProposalTypeStandard)
+			assert.NilError(t, err)
+			proposalID := proposal.Id
+			proposal.Status = v1.StatusVotingPeriod
+			f.govKeeper.SetProposal(ctx, proposal)
+
+			assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+			assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+			assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+			assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[4], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+			if tc.delegatorVote != v1.OptionEmpty {
+				assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[3], v1.NewNonSplitVoteOption(tc.delegatorVote), ""))
+			}
+
+			proposal, err = f.govKeeper.Proposals.Get(ctx, proposalID)
+			assert.NilError(t, err)
+			passes, burnDeposits, tallyResults, _ := f.govKeeper.Tally(ctx, proposal)
+
+			assert.Equal(t, tc.expPass, passes)
+			assert.Assert(t, burnDeposits == false)
+
+			expectedTallyResult := v1.NewTallyResult(
+				f.stakingKeeper.TokensFromConsensusPower(ctx, tc.expYes),
+				math.ZeroInt(),
+				f.stakingKeeper.TokensFromConsensusPower(ctx, tc.expNo),
+				math.ZeroInt(),
+			)
+			assert.Assert(t, tallyResults.Equals(expectedTallyResult))
+		})
+	}
+}
@@ -1,10 +1,12 @@
 package gov_test
 
 import (
+	"context"
 	"testing"
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"gotest.tools/v3/assert"
 
 	"github.com/cosmos/cosmos-sdk/testutil/configurator"
@@ -12,7 +14,9 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	_ "github.com/cosmos/cosmos-sdk/x/mint"
 )
 
@@ -39,3 +43,36 @@ func TestItCreatesModuleAccountOnInitBlock(t *testing.T) {
 	acc := accountKeeper.GetAccount(ctx, authtypes.NewModuleAddress(types.ModuleName))
 	assert.Assert(t, acc != nil)
 }
+
+func TestItUsesProvidedTallyFn(t *testing.T) {
+	var tallied []uint64
+	tallyFn := func(_ context.Context, _ keeper.Keeper, proposal v1.Proposal) (map[v1.VoteOption]math.LegacyDec, []math.LegacyDec, math.LegacyDec, math.LegacyDec, error) {
+		tallied = append(tallied, proposal.Id)
+		return map[v1.VoteOption]math.LegacyDec{v1.OptionYes: math.LegacyOneDec()}, nil, math.LegacyOneDec(), math.LegacyOneDec(), nil
+	}
+
+	var govKeeper *keeper.Keeper
+	app, err := simtestutil.SetupAtGenesis(
+		depinject.Configs(
+			configurator.NewAppConfig(
+				configurator.ParamsModule(),
+				configurator.AuthModule(),
+				configurator.StakingModule(),
+				configurator.BankModule(),
+				configurator.GovModule(),
+				configurator.DistributionModule(),
+				configurator.ConsensusModule(),
+			),
+			depinject.Supply(log.NewNopLogger(), keeper.TallyFn(tallyFn)),
+		),
+		&govKeeper,
+	)
+	assert.NilError(t, err)
+
+	ctx := app.BaseApp.NewContext(false)
+	passes, _, tallyResults, err := govKeeper.Tally(ctx, v1.Proposal{Id: 7})
+	assert.NilError(t, err)
+	assert.Assert(t, passes)
+	assert.DeepEqual(t, []uint64{7}, tallied)
+	assert.Equal(t, "1", tallyResults.YesCount)
+}
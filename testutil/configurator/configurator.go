@@ -119,6 +119,7 @@ func AuthModule() ModuleOption {
 					{Account: "mint", Permissions: []string{"minter"}},
 					{Account: "bonded_tokens_pool", Permissions: []string{"burner", "staking"}},
 					{Account: "not_bonded_tokens_pool", Permissions: []string{"burner", "staking"}},
+					{Account: "epoch_escrow_pool", Permissions: []string{"staking"}},
 					{Account: "gov", Permissions: []string{"burner"}},
 					{Account: "nft"},
 				},
@@ -30,6 +30,11 @@ type ExtendVoteHandler func(Context, *abci.RequestExtendVote) (*abci.ResponseExt
 // pre-commit vote extension.
 type VerifyVoteExtensionHandler func(Context, *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error)
 
+// PreBlocker defines a function type alias for executing application business
+// logic before BeginBlock, with access to the raw block, e.g. to process vote
+// extensions injected in the block by the proposer.
+type PreBlocker func(Context, *abci.RequestFinalizeBlock) error
+
 // BeginBlocker defines a function type alias for executing application
 // business logic before transactions are executed.
 //
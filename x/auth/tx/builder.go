@@ -338,6 +338,10 @@ func (w *wrapper) SetFeePayer(feePayer sdk.AccAddress) {
 
 	// set authInfoBz to nil because the cached authInfoBz no longer matches tx.AuthInfo
 	w.authInfoBz = nil
+
+	// reset signers and msgsV2 because the fee payer is a required signer
+	w.signers = nil
+	w.msgsV2 = nil
 }
 
 func (w *wrapper) SetFeeGranter(feeGranter sdk.AccAddress) {
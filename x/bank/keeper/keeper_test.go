@@ -613,6 +613,47 @@ func (suite *KeeperTestSuite) TestSendCoins() {
 	require.Equal(newBarCoin(25), coins[0], "expected only bar coins in the account balance, got: %v", coins)
 }
 
+// blockingHooks are bank hooks rejecting the transfers to the blocked address.
+type blockingHooks struct {
+	blocked sdk.AccAddress
+}
+
+func (h blockingHooks) BeforeSend(_ context.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) error {
+	if toAddr.Equals(h.blocked) {
+		return fmt.Errorf("%s is not allowed to receive funds", toAddr)
+	}
+
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestSendCoinsHooks() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	acc0 := authtypes.NewBaseAccountWithAddress(accAddrs[0])
+	suite.mockFundAccount(accAddrs[0])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+
+	// the hooks are shared by the copies of the keeper
+	bankKeeper := suite.bankKeeper
+	bankKeeper.SetHooks(banktypes.NewMultiBankHooks(blockingHooks{blocked: accAddrs[2]}))
+	require.Panics(func() { suite.bankKeeper.SetHooks(blockingHooks{}) })
+
+	sendAmt := sdk.NewCoins(newFooCoin(50), newBarCoin(25))
+	require.ErrorContains(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[2], sendAmt), "not allowed to receive funds")
+	require.Equal(balances, suite.bankKeeper.GetAllBalances(ctx, accAddrs[0]))
+
+	suite.mockSendCoins(ctx, acc0, accAddrs[1])
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sendAmt))
+	require.Equal(sendAmt, suite.bankKeeper.GetAllBalances(ctx, accAddrs[1]))
+
+	// the transfers from module accounts are not subject to the hooks
+	suite.mockFundAccount(accAddrs[2])
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[2], sendAmt))
+	require.Equal(sendAmt, suite.bankKeeper.GetAllBalances(ctx, accAddrs[2]))
+}
+
 func (suite *KeeperTestSuite) TestSendCoins_Invalid_SendLockedCoins() {
 	balances := sdk.NewCoins(newFooCoin(50))
 
@@ -63,6 +63,10 @@ type BaseSendKeeper struct {
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
+
+	// hooks is a pointer so that the hooks set on the keeper are shared by all
+	// its copies.
+	hooks *types.BankHooks
 }
 
 func NewBaseSendKeeper(
@@ -85,7 +89,31 @@ func NewBaseSendKeeper(
 		blockedAddrs:   blockedAddrs,
 		authority:      authority,
 		logger:         logger,
+		hooks:          new(types.BankHooks),
+	}
+}
+
+// SetHooks sets the bank hooks. It panics if the hooks are already set.
+func (k BaseSendKeeper) SetHooks(bh types.BankHooks) {
+	if *k.hooks != nil {
+		panic("cannot set bank hooks twice")
+	}
+
+	*k.hooks = bh
+}
+
+// beforeSend calls the BeforeSend hook, if any. The hook is called once with
+// the whole amount, so that a single rejected denom aborts the transfer of all
+// the coins. It is only called for the transfers initiated by accounts, i.e.
+// SendCoins and InputOutputCoins, and not for the transfers from module
+// accounts, e.g. reward withdrawals, which a restricted denom would otherwise
+// block for all the other denoms.
+func (k BaseSendKeeper) beforeSend(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.hooks == nil || *k.hooks == nil {
+		return nil
 	}
+
+	return (*k.hooks).BeforeSend(ctx, fromAddr, toAddr, amt)
 }
 
 // GetAuthority returns the x/bank module's authority.
@@ -149,6 +177,10 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 			return err
 		}
 
+		if err := k.beforeSend(ctx, inAddress, outAddress, out.Coins); err != nil {
+			return err
+		}
+
 		if err := k.addCoins(ctx, outAddress, out.Coins); err != nil {
 			return err
 		}
@@ -178,6 +210,16 @@ func (k BaseSendKeeper) InputOutputCoins(ctx context.Context, input types.Input,
 // SendCoins transfers amt coins from a sending account to a receiving account.
 // An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.beforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
+	return k.sendCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// sendCoins transfers amt coins from a sending account to a receiving account
+// without calling the BeforeSend hook.
+func (k BaseSendKeeper) sendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
 	err := k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	modulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
@@ -14,6 +15,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
 
 	corestore "cosmossdk.io/core/store"
 
@@ -208,6 +210,7 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 func init() {
 	appmodule.Register(&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetBankHooks),
 	)
 }
 
@@ -266,3 +269,26 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	return ModuleOutputs{BankKeeper: bankKeeper, Module: m}
 }
+
+// InvokeSetBankHooks sets the bank hooks provided by the modules, in the
+// alphabetical order of their names.
+func InvokeSetBankHooks(
+	keeper keeper.BaseKeeper,
+	bankHooks map[string]types.BankHooksWrapper,
+) error {
+	// all arguments to invokers are optional
+	if len(bankHooks) == 0 {
+		return nil
+	}
+
+	modNames := maps.Keys(bankHooks)
+	sort.Strings(modNames)
+
+	var multiHooks types.MultiBankHooks
+	for _, modName := range modNames {
+		multiHooks = append(multiHooks, bankHooks[modName])
+	}
+
+	keeper.SetHooks(multiHooks)
+	return nil
+}
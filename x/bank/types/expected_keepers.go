@@ -32,3 +32,15 @@ type AccountKeeper interface {
 	SetModuleAccount(ctx context.Context, macc sdk.ModuleAccountI)
 	GetModulePermissions() map[string]types.PermissionsForAddress
 }
+
+// BankHooks event hooks for the bank module. They are not called for the
+// transfers from module accounts.
+type BankHooks interface {
+	BeforeSend(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error // Must be called before coins are sent, the transfer of all the coins is aborted on error
+}
+
+// BankHooksWrapper is a wrapper for modules to inject BankHooks using depinject.
+type BankHooksWrapper struct{ BankHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (BankHooksWrapper) IsOnePerModuleType() {}
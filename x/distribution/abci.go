@@ -31,3 +31,10 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
 	return nil
 }
+
+// EndBlocker auto-compounds the rewards of the delegations which opted in.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	return k.AutoCompound(ctx)
+}
@@ -32,6 +32,7 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		GetCmdQueryValidatorCommission(),
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(ac),
+		GetCmdQueryAutoCompoundDelegations(ac),
 		GetCmdQueryCommunityPool(),
 	)
 
@@ -331,6 +332,52 @@ $ %s query distribution rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1ggh
 	return cmd
 }
 
+// GetCmdQueryAutoCompoundDelegations returns the command for fetching the
+// validators of the auto-compounded delegations of a delegator.
+func GetCmdQueryAutoCompoundDelegations(ac address.Codec) *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "auto-compound [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the validators of the auto-compounded delegations of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validators of the delegations of a delegator whose rewards are auto-compounded.
+
+Example:
+$ %s query distribution auto-compound %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = ac.StringToBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AutoCompoundDelegations(
+				cmd.Context(),
+				&types.QueryAutoCompoundDelegationsRequest{DelegatorAddress: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryCommunityPool returns the command for fetching community pool info.
 func GetCmdQueryCommunityPool() *cobra.Command {
 	cmd := &cobra.Command{
@@ -87,14 +87,17 @@ func (s *CLITestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
-			`{"community_tax":"0","base_proposer_reward":"0","bonus_proposer_reward":"0","withdraw_addr_enabled":false}`,
+			`{"community_tax":"0","base_proposer_reward":"0","bonus_proposer_reward":"0","withdraw_addr_enabled":false,"redistribute_forfeited_rewards":false,"auto_compound_min_reward":"0","auto_compound_max_per_block":0}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", flags.FlagOutput)},
-			`base_proposer_reward: "0"
+			`auto_compound_max_per_block: 0
+auto_compound_min_reward: "0"
+base_proposer_reward: "0"
 bonus_proposer_reward: "0"
 community_tax: "0"
+redistribute_forfeited_rewards: false
 withdraw_addr_enabled: false`,
 		},
 	}
@@ -50,20 +50,64 @@ func (k Keeper) AllocateTokens(ctx context.Context, totalPreviousPower int64, bo
 	voteMultiplier := math.LegacyOneDec().Sub(communityTax)
 	feeMultiplier := feesCollected.MulDecTruncate(voteMultiplier)
 
-	// allocate tokens proportionally to voting power
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// weight the voting power of every validator by its allocation weight,
+	// the rewards forfeited by the validators with a weight lower than one are
+	// either redistributed to the other validators or left to the community
+	// pool
+	weightedPowers := make([]math.LegacyDec, len(bondedVotes))
+	weights := make([]math.LegacyDec, len(bondedVotes))
+	totalPower := math.LegacyNewDec(totalPreviousPower)
+	for i, vote := range bondedVotes {
+		weight, err := k.allocationWeight(ctx, vote)
+		if err != nil {
+			return err
+		}
+
+		power := math.LegacyNewDec(vote.Validator.Power)
+		weights[i] = weight
+		weightedPowers[i] = power.Mul(weight)
+		if params.RedistributeForfeitedRewards {
+			totalPower = totalPower.Sub(power.Sub(weightedPowers[i]))
+		}
+	}
+
+	if !totalPower.IsPositive() {
+		feePool.CommunityPool = feePool.CommunityPool.Add(feesCollected...)
+		return k.FeePool.Set(ctx, feePool)
+	}
+
+	// allocate tokens proportionally to the weighted voting power
 	//
 	// TODO: Consider parallelizing later
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/pull/3099#discussion_r246276376
-	for _, vote := range bondedVotes {
+	for i, vote := range bondedVotes {
 		validator := k.stakingKeeper.ValidatorByConsAddr(sdkCtx, vote.Validator.Address)
 
 		// TODO: Consider micro-slashing for missing votes.
 		//
 		// Ref: https://github.com/cosmos/cosmos-sdk/issues/2525#issuecomment-430838701
-		powerFraction := math.LegacyNewDec(vote.Validator.Power).QuoTruncate(math.LegacyNewDec(totalPreviousPower))
+		powerFraction := weightedPowers[i].QuoTruncate(totalPower)
 		reward := feeMultiplier.MulDecTruncate(powerFraction)
 
+		if weights[i].LT(math.LegacyOneDec()) {
+			forfeitedPower := math.LegacyNewDec(vote.Validator.Power).Sub(weightedPowers[i])
+			forfeited := feeMultiplier.MulDecTruncate(forfeitedPower.QuoTruncate(math.LegacyNewDec(totalPreviousPower)))
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeForfeitedRewards,
+					sdk.NewAttribute(sdk.AttributeKeyAmount, forfeited.String()),
+					sdk.NewAttribute(types.AttributeKeyValidator, validator.GetOperator().String()),
+					sdk.NewAttribute(types.AttributeKeyWeight, weights[i].String()),
+				),
+			)
+		}
+
 		err := k.AllocateTokensToValidator(ctx, validator, reward)
 		if err != nil {
 			return err
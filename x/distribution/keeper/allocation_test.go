@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 
 	storetypes "cosmossdk.io/store/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -330,3 +332,235 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, val2OutstandingRewards.Rewards.IsValid())
 }
+
+func TestAllocateTokensWithAllocationWeight(t *testing.T) {
+	testCases := []struct {
+		name               string
+		weightFn           keeper.AllocationWeightFn
+		redistribute       bool
+		expErr             error
+		expVal0Rewards     sdk.DecCoins
+		expVal1Rewards     sdk.DecCoins
+		expCommunityPool   sdk.DecCoins
+		expForfeitedEvents int
+	}{
+		{
+			name:             "voting power only",
+			weightFn:         keeper.VotingPowerAllocationWeight,
+			expVal0Rewards:   sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(49)}},
+			expVal1Rewards:   sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(49)}},
+			expCommunityPool: sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(2)}},
+		},
+		{
+			name:               "forfeited rewards sent to the community pool",
+			weightFn:           keeper.CommitParticipationAllocationWeight,
+			expVal1Rewards:     sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(49)}},
+			expCommunityPool:   sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(51)}},
+			expForfeitedEvents: 1,
+		},
+		{
+			name:               "forfeited rewards redistributed",
+			weightFn:           keeper.CommitParticipationAllocationWeight,
+			redistribute:       true,
+			expVal1Rewards:     sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(98)}},
+			expCommunityPool:   sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(2)}},
+			expForfeitedEvents: 1,
+		},
+		{
+			name: "all rewards forfeited",
+			weightFn: func(context.Context, keeper.Keeper, abci.VoteInfo) (math.LegacyDec, error) {
+				return math.LegacyZeroDec(), nil
+			},
+			redistribute:       true,
+			expCommunityPool:   sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(100)}},
+			expForfeitedEvents: 0,
+		},
+		{
+			name: "invalid weight",
+			weightFn: func(context.Context, keeper.Keeper, abci.VoteInfo) (math.LegacyDec, error) {
+				return math.LegacyNewDec(2), nil
+			},
+			expErr: disttypes.ErrInvalidAllocationWeight,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+			distrKeeper.SetAllocationWeightFn(tc.weightFn)
+
+			params := disttypes.DefaultParams()
+			params.RedistributeForfeitedRewards = tc.redistribute
+			require.NoError(t, distrKeeper.Params.Set(ctx, params))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			// create two validators with 0% commission
+			val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+			require.NoError(t, err)
+			val0.Commission = stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+			stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0).AnyTimes()
+
+			val1, err := distrtestutil.CreateValidator(valConsPk1, math.NewInt(100))
+			require.NoError(t, err)
+			val1.Commission = stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+			stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk1)).Return(val1).AnyTimes()
+
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+			// the first validator did not sign the last block
+			votes := []abci.VoteInfo{
+				{
+					Validator:   abci.Validator{Address: valConsPk0.Address(), Power: 100},
+					BlockIdFlag: cmtproto.BlockIDFlagAbsent,
+				},
+				{
+					Validator:   abci.Validator{Address: valConsPk1.Address(), Power: 100},
+					BlockIdFlag: cmtproto.BlockIDFlagCommit,
+				},
+			}
+
+			err = distrKeeper.AllocateTokens(ctx, 200, votes)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			val0Rewards, err := distrKeeper.GetValidatorOutstandingRewards(ctx, sdk.ValAddress(valConsAddr0))
+			require.NoError(t, err)
+			require.Equal(t, tc.expVal0Rewards, val0Rewards.Rewards)
+
+			val1Rewards, err := distrKeeper.GetValidatorOutstandingRewards(ctx, sdk.ValAddress(valConsAddr1))
+			require.NoError(t, err)
+			require.Equal(t, tc.expVal1Rewards, val1Rewards.Rewards)
+
+			feePool, err := distrKeeper.FeePool.Get(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expCommunityPool, feePool.CommunityPool)
+
+			forfeitedEvents := 0
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == disttypes.EventTypeForfeitedRewards {
+					forfeitedEvents++
+				}
+			}
+			require.Equal(t, tc.expForfeitedEvents, forfeitedEvents)
+		})
+	}
+}
+
+func TestAllocationInvariant(t *testing.T) {
+	testCases := []struct {
+		name      string
+		weightFn  keeper.AllocationWeightFn
+		expBroken bool
+	}{
+		{
+			name:     "voting power only",
+			weightFn: keeper.VotingPowerAllocationWeight,
+		},
+		{
+			name: "half of the rewards forfeited",
+			weightFn: func(context.Context, keeper.Keeper, abci.VoteInfo) (math.LegacyDec, error) {
+				return math.LegacyNewDecWithPrec(5, 1), nil
+			},
+		},
+		{
+			name: "invalid weight",
+			weightFn: func(context.Context, keeper.Keeper, abci.VoteInfo) (math.LegacyDec, error) {
+				return math.LegacyNewDec(2), nil
+			},
+			expBroken: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			storeService := runtime.NewKVStoreService(key)
+			testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+			encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+			ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+
+			bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+			stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+			accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+			feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+			accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+			accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+
+			distrKeeper := keeper.NewKeeper(
+				encCfg.Codec,
+				storeService,
+				accountKeeper,
+				bankKeeper,
+				stakingKeeper,
+				"fee_collector",
+				authtypes.NewModuleAddress("gov").String(),
+			)
+			distrKeeper.SetAllocationWeightFn(tc.weightFn)
+
+			require.NoError(t, distrKeeper.Params.Set(ctx, disttypes.DefaultParams()))
+			require.NoError(t, distrKeeper.FeePool.Set(ctx, disttypes.InitialFeePool()))
+
+			// create two bonded validators with 0% commission
+			var validators []stakingtypes.Validator
+			for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1} {
+				val, err := distrtestutil.CreateValidator(pk, sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction))
+				require.NoError(t, err)
+				val.Status = stakingtypes.Bonded
+				val.Commission = stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec())
+				stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val).AnyTimes()
+				validators = append(validators, val)
+			}
+			stakingKeeper.EXPECT().IterateValidators(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ sdk.Context, fn func(int64, stakingtypes.ValidatorI) bool) {
+					for i, val := range validators {
+						if fn(int64(i), val) {
+							return
+						}
+					}
+				},
+			)
+
+			fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(101)))
+			bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees).AnyTimes()
+			bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees).AnyTimes()
+
+			msg, broken := keeper.AllocationInvariant(distrKeeper)(ctx)
+			require.Equal(t, tc.expBroken, broken, msg)
+
+			// the allocation is never committed
+			feePool, err := distrKeeper.FeePool.Get(ctx)
+			require.NoError(t, err)
+			require.True(t, feePool.CommunityPool.IsZero())
+		})
+	}
+}
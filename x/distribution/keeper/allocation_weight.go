@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// AllocationWeightFn returns the weight, between zero and one, applied to the
+// rewards allocated to the validator of a vote of the last commit. The share
+// of the rewards forfeited by a validator with a weight lower than one is
+// either redistributed to the other validators or sent to the community pool,
+// depending on the RedistributeForfeitedRewards param.
+type AllocationWeightFn func(ctx context.Context, k Keeper, vote abci.VoteInfo) (math.LegacyDec, error)
+
+// VotingPowerAllocationWeight is the default AllocationWeightFn. Every
+// validator gets a weight of one, hence the rewards are allocated
+// proportionally to the voting power only.
+func VotingPowerAllocationWeight(_ context.Context, _ Keeper, _ abci.VoteInfo) (math.LegacyDec, error) {
+	return math.LegacyOneDec(), nil
+}
+
+// CommitParticipationAllocationWeight is an AllocationWeightFn which gives a
+// weight of zero to the validators which did not sign the last block.
+func CommitParticipationAllocationWeight(_ context.Context, _ Keeper, vote abci.VoteInfo) (math.LegacyDec, error) {
+	if vote.BlockIdFlag == cmtproto.BlockIDFlagAbsent {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return math.LegacyOneDec(), nil
+}
+
+// NewUptimeAllocationWeight returns an AllocationWeightFn which weights the
+// rewards of validators by the fraction of the blocks they signed within the
+// slashing signed blocks window.
+func NewUptimeAllocationWeight(sk types.SlashingKeeper) AllocationWeightFn {
+	return func(ctx context.Context, _ Keeper, vote abci.VoteInfo) (math.LegacyDec, error) {
+		signInfo, err := sk.GetValidatorSigningInfo(ctx, vote.Validator.Address)
+		if errors.Is(err, slashingtypes.ErrNoSigningInfoFound) {
+			return math.LegacyOneDec(), nil
+		} else if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		window, err := sk.SignedBlocksWindow(ctx)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		if window <= 0 {
+			return math.LegacyOneDec(), nil
+		}
+
+		missed := math.MinInt(math.NewInt(signInfo.MissedBlocksCounter), math.NewInt(window))
+		return math.LegacyOneDec().Sub(math.LegacyNewDecFromInt(missed).QuoInt64(window)), nil
+	}
+}
+
+// allocationWeight returns the allocation weight of the validator of a vote
+// and checks it is between zero and one.
+func (k Keeper) allocationWeight(ctx context.Context, vote abci.VoteInfo) (math.LegacyDec, error) {
+	weight, err := k.allocationWeightFn(ctx, k, vote)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if weight.IsNil() || weight.IsNegative() || weight.GT(math.LegacyOneDec()) {
+		return math.LegacyDec{}, types.ErrInvalidAllocationWeight.Wrapf("weight must be between 0 and 1: %s", weight)
+	}
+
+	return weight, nil
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestUptimeAllocationWeight(t *testing.T) {
+	testCases := []struct {
+		name      string
+		signInfo  *slashingtypes.ValidatorSigningInfo
+		window    int64
+		expWeight math.LegacyDec
+	}{
+		{
+			name:      "no signing info",
+			window:    100,
+			expWeight: math.LegacyOneDec(),
+		},
+		{
+			name:      "no missed blocks",
+			signInfo:  &slashingtypes.ValidatorSigningInfo{},
+			window:    100,
+			expWeight: math.LegacyOneDec(),
+		},
+		{
+			name:      "missed blocks",
+			signInfo:  &slashingtypes.ValidatorSigningInfo{MissedBlocksCounter: 25},
+			window:    100,
+			expWeight: math.LegacyNewDecWithPrec(75, 2),
+		},
+		{
+			name:      "missed blocks above the window",
+			signInfo:  &slashingtypes.ValidatorSigningInfo{MissedBlocksCounter: 150},
+			window:    100,
+			expWeight: math.LegacyZeroDec(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			key := storetypes.NewKVStoreKey(disttypes.StoreKey)
+			ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+			slashingKeeper := distrtestutil.NewMockSlashingKeeper(ctrl)
+			if tc.signInfo == nil {
+				slashingKeeper.EXPECT().GetValidatorSigningInfo(gomock.Any(), valConsAddr0).Return(slashingtypes.ValidatorSigningInfo{}, slashingtypes.ErrNoSigningInfoFound)
+			} else {
+				slashingKeeper.EXPECT().GetValidatorSigningInfo(gomock.Any(), valConsAddr0).Return(*tc.signInfo, nil)
+				slashingKeeper.EXPECT().SignedBlocksWindow(gomock.Any()).Return(tc.window, nil)
+			}
+
+			vote := abci.VoteInfo{Validator: abci.Validator{Address: valConsAddr0, Power: 100}}
+			weight, err := keeper.NewUptimeAllocationWeight(slashingKeeper)(ctx, keeper.Keeper{}, vote)
+			require.NoError(t, err)
+			require.True(t, tc.expWeight.Equal(weight), "expected %s, got %s", tc.expWeight, weight)
+		})
+	}
+}
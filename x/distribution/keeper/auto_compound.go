@@ -0,0 +1,258 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetAutoCompound enables or disables the auto-compounding of the rewards of a
+// delegation.
+func (k Keeper) SetAutoCompound(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) error {
+	key := collections.Join(delAddr, valAddr)
+	if !enabled {
+		return k.AutoCompoundDelegations.Remove(ctx, key)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.AutoCompoundMaxPerBlock == 0 {
+		return types.ErrAutoCompoundDisabled
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if k.stakingKeeper.Validator(sdkCtx, valAddr) == nil {
+		return types.ErrNoValidatorExists
+	}
+
+	if k.stakingKeeper.Delegation(sdkCtx, delAddr, valAddr) == nil {
+		return types.ErrNoDelegationExists
+	}
+
+	return k.AutoCompoundDelegations.Set(ctx, key)
+}
+
+// GetAutoCompoundValidators returns the validators of the auto-compounded
+// delegations of a delegator.
+func (k Keeper) GetAutoCompoundValidators(ctx context.Context, delAddr sdk.AccAddress) ([]sdk.ValAddress, error) {
+	iter, err := k.AutoCompoundDelegations.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delAddr))
+	if errors.Is(err, collections.ErrInvalidIterator) {
+		return []sdk.ValAddress{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]sdk.ValAddress, 0, len(keys))
+	for _, key := range keys {
+		validators = append(validators, key.K2())
+	}
+
+	return validators, nil
+}
+
+// AutoCompound withdraws and re-delegates the rewards of at most
+// AutoCompoundMaxPerBlock auto-compounded delegations. The delegations are
+// processed in a round-robin fashion, starting after the delegation processed
+// last by the previous call. A delegation which fails to be auto-compounded is
+// left untouched and skipped.
+func (k Keeper) AutoCompound(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.AutoCompoundMaxPerBlock == 0 {
+		return nil
+	}
+
+	keys, err := k.nextAutoCompoundDelegations(ctx, params.AutoCompoundMaxPerBlock)
+	if err != nil {
+		return err
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, key := range keys {
+		delAddr, valAddr := key.K1(), key.K2()
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := k.autoCompoundDelegation(cacheCtx, delAddr, valAddr, params.AutoCompoundMinReward); err != nil {
+			k.Logger(ctx).Error("failed to auto-compound delegation rewards", "delegator", delAddr, "validator", valAddr, "err", err)
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeAutoCompoundFailed,
+					sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+				),
+			)
+			continue
+		}
+		write()
+	}
+
+	return k.AutoCompoundCursor.Set(ctx, keys[len(keys)-1])
+}
+
+// nextAutoCompoundDelegations returns at most limit auto-compounded
+// delegations following the cursor, wrapping around the end of the set.
+func (k Keeper) nextAutoCompoundDelegations(ctx context.Context, limit uint32) ([]collections.Pair[sdk.AccAddress, sdk.ValAddress], error) {
+	var keys []collections.Pair[sdk.AccAddress, sdk.ValAddress]
+	collect := func(ranger collections.Ranger[collections.Pair[sdk.AccAddress, sdk.ValAddress]]) error {
+		iter, err := k.AutoCompoundDelegations.Iterate(ctx, ranger)
+		if errors.Is(err, collections.ErrInvalidIterator) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		defer iter.Close()
+
+		for ; iter.Valid() && uint32(len(keys)) < limit; iter.Next() {
+			key, err := iter.Key()
+			if err != nil {
+				return err
+			}
+			keys = append(keys, key)
+		}
+
+		return nil
+	}
+
+	cursor, err := k.AutoCompoundCursor.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		if err := collect(nil); err != nil {
+			return nil, err
+		}
+		return keys, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := collect(new(collections.Range[collections.Pair[sdk.AccAddress, sdk.ValAddress]]).StartExclusive(cursor)); err != nil {
+		return nil, err
+	}
+
+	if err := collect(new(collections.Range[collections.Pair[sdk.AccAddress, sdk.ValAddress]]).EndInclusive(cursor)); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// autoCompoundDelegation withdraws the rewards of a delegation and
+// re-delegates them to its validator if they are above the min reward. The
+// rewards are left withdrawn if they cannot be re-delegated, e.g. because the
+// validator is jailed.
+func (k Keeper) autoCompoundDelegation(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, minReward math.Int) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	val := k.stakingKeeper.Validator(sdkCtx, valAddr)
+	del := k.stakingKeeper.Delegation(sdkCtx, delAddr, valAddr)
+	if val == nil || del == nil {
+		return k.AutoCompoundDelegations.Remove(ctx, collections.Join(delAddr, valAddr))
+	}
+
+	// estimate the rewards without side effects
+	cacheCtx, _ := sdkCtx.CacheContext()
+	endingPeriod, err := k.IncrementValidatorPeriod(cacheCtx, val)
+	if err != nil {
+		return err
+	}
+
+	rewards, err := k.CalculateDelegationRewards(cacheCtx, val, del, endingPeriod)
+	if err != nil {
+		return err
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(sdkCtx)
+	amount := rewards.AmountOf(bondDenom).TruncateInt()
+	if !amount.IsPositive() || amount.LT(minReward) {
+		return nil
+	}
+
+	withdrawn, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	amount = withdrawn.AmountOf(bondDenom)
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	if reason := k.redelegateRewards(sdkCtx, delAddr, valAddr, amount); reason != "" {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoCompoundFailed,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyReason, reason),
+			),
+		)
+		return nil
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+
+	return nil
+}
+
+// redelegateRewards delegates the withdrawn rewards of a delegator to the
+// validator, and returns the reason why they could not be delegated if any.
+func (k Keeper) redelegateRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int) string {
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return err.Error()
+	}
+
+	// the rewards are only held by the delegator if it did not set another
+	// withdraw address
+	if !withdrawAddr.Equals(delAddr) {
+		return "rewards withdrawn to another address"
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorExists.Error()
+	}
+
+	if validator.IsJailed() {
+		return stakingtypes.ErrValidatorJailed.Error()
+	}
+
+	if err := k.stakingKeeper.CheckDelegationCaps(ctx, validator, delAddr, amount, stakingtypes.Unbonded); err != nil {
+		return err.Error()
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if _, err := k.stakingKeeper.Delegate(cacheCtx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return err.Error()
+	}
+	write()
+
+	return ""
+}
@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
@@ -113,6 +116,21 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 	}
 
+	for _, acd := range data.AutoCompoundDelegations {
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(acd.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		valAddr, err := sdk.ValAddressFromBech32(acd.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.AutoCompoundDelegations.Set(ctx, collections.Join(sdk.AccAddress(delegatorAddress), valAddr))
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
 
@@ -228,5 +246,17 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	autoCompound := make([]types.AutoCompoundDelegation, 0)
+	err = k.AutoCompoundDelegations.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress]) (stop bool, err error) {
+		autoCompound = append(autoCompound, types.AutoCompoundDelegation{
+			DelegatorAddress: key.K1().String(),
+			ValidatorAddress: key.K2().String(),
+		})
+		return false, nil
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, autoCompound)
 }
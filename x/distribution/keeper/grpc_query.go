@@ -333,6 +333,34 @@ func (k Querier) DelegatorWithdrawAddress(c context.Context, req *types.QueryDel
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: withdrawAddr.String()}, nil
 }
 
+// AutoCompoundDelegations queries the validators of the auto-compounded
+// delegations of a delegator
+func (k Querier) AutoCompoundDelegations(c context.Context, req *types.QueryAutoCompoundDelegationsRequest) (*types.QueryAutoCompoundDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validators, err := k.GetAutoCompoundValidators(c, delAdr)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryAutoCompoundDelegationsResponse{Validators: make([]string, 0, len(validators))}
+	for _, valAddr := range validators {
+		res.Validators = append(res.Validators, valAddr.String())
+	}
+
+	return res, nil
+}
+
 // CommunityPool queries the community pool coins
 func (k Querier) CommunityPool(c context.Context, req *types.QueryCommunityPoolRequest) (*types.QueryCommunityPoolResponse, error) {
 	pool, err := k.FeePool.Get(c)
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -158,10 +159,22 @@ func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _
 	return nil
 }
 
-func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
-	return nil
+// stop auto-compounding the rewards of the delegation
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.AutoCompoundDelegations.Remove(ctx, collections.Join(delAddr, valAddr))
 }
 
 func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
 	return nil
 }
+
+// withdraw the rewards of a tokenize share record to its owner before it is removed
+func (h Hooks) BeforeTokenizeShareRecordRemoved(ctx sdk.Context, recordID uint64) error {
+	record, found := h.k.stakingKeeper.GetTokenizeShareRecord(ctx, recordID)
+	if !found {
+		return stakingtypes.ErrNoTokenizeShareRecord
+	}
+
+	_, err := h.k.withdrawTokenizeShareRecordReward(ctx, record)
+	return err
+}
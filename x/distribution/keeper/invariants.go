@@ -3,6 +3,9 @@ package keeper
 import (
 	"fmt"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -18,6 +21,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		ReferenceCountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "module-account",
 		ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "allocation",
+		AllocationInvariant(k))
 }
 
 // AllInvariants runs all invariants of the distribution module
@@ -35,7 +40,11 @@ func AllInvariants(k Keeper) sdk.Invariant {
 		if stop {
 			return res, stop
 		}
-		return ModuleAccountInvariant(k)(ctx)
+		res, stop = ModuleAccountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return AllocationInvariant(k)(ctx)
 	}
 }
 
@@ -166,3 +175,74 @@ func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 		), broken
 	}
 }
+
+// AllocationInvariant checks that allocating the collected fees to the bonded
+// validators, weighted by their allocation weight, conserves the fees across
+// the validator outstanding rewards and the community pool
+func AllocationInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		// allocate on a branch of the state, the result is never committed
+		cacheCtx, _ := ctx.CacheContext()
+
+		var (
+			bondedVotes        []abci.VoteInfo
+			totalPreviousPower int64
+		)
+		k.stakingKeeper.IterateValidators(cacheCtx, func(_ int64, val stakingtypes.ValidatorI) (stop bool) {
+			if !val.IsBonded() {
+				return false
+			}
+
+			consAddr, err := val.GetConsAddr()
+			if err != nil {
+				panic(err)
+			}
+
+			power := val.GetConsensusPower(sdk.DefaultPowerReduction)
+			bondedVotes = append(bondedVotes, abci.VoteInfo{
+				Validator: abci.Validator{
+					Address: consAddr,
+					Power:   power,
+				},
+				BlockIdFlag: cmtproto.BlockIDFlagCommit,
+			})
+			totalPreviousPower += power
+			return false
+		})
+
+		feeCollector := k.authKeeper.GetModuleAccount(cacheCtx, k.feeCollectorName)
+		feesCollected := sdk.NewDecCoinsFromCoins(k.bankKeeper.GetAllBalances(cacheCtx, feeCollector.GetAddress())...)
+
+		before := k.distributedCoins(cacheCtx)
+		if err := k.AllocateTokens(cacheCtx, totalPreviousPower, bondedVotes); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "allocation",
+				fmt.Sprintf("\tfailed to allocate the collected fees: %v\n", err)), true
+		}
+		allocated := k.distributedCoins(cacheCtx).Sub(before)
+
+		broken := !allocated.Equal(feesCollected)
+		return sdk.FormatInvariant(types.ModuleName, "allocation",
+			fmt.Sprintf("\tcollected fees:  %s\n"+
+				"\tallocated fees:  %s\n",
+				feesCollected, allocated,
+			),
+		), broken
+	}
+}
+
+// distributedCoins returns the sum of the validator outstanding rewards and
+// the community pool
+func (k Keeper) distributedCoins(ctx sdk.Context) sdk.DecCoins {
+	var coins sdk.DecCoins
+	k.IterateValidatorOutstandingRewards(ctx, func(_ sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool) {
+		coins = coins.Add(rewards.Rewards...)
+		return false
+	})
+
+	feePool, err := k.FeePool.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return coins.Add(feePool.CommunityPool...)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -22,6 +23,10 @@ type Keeper struct {
 	authKeeper    types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
+
+	// allocationWeightFn weights the rewards allocated to each validator
+	allocationWeightFn AllocationWeightFn
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -29,6 +34,10 @@ type Keeper struct {
 	Schema  collections.Schema
 	Params  collections.Item[types.Params]
 	FeePool collections.Item[types.FeePool]
+	// AutoCompoundDelegations key: delAddr+valAddr | the delegations whose rewards are auto-compounded
+	AutoCompoundDelegations collections.KeySet[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
+	// AutoCompoundCursor is the last delegation auto-compounded in EndBlock
+	AutoCompoundCursor collections.Item[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
@@ -46,15 +55,24 @@ func NewKeeper(
 
 	sb := collections.NewSchemaBuilder(storeService)
 	k := Keeper{
-		storeService:     storeService,
-		cdc:              cdc,
-		authKeeper:       ak,
-		bankKeeper:       bk,
-		stakingKeeper:    sk,
-		feeCollectorName: feeCollectorName,
-		authority:        authority,
-		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		FeePool:          collections.NewItem(sb, types.FeePoolKey, "fee_pool", codec.CollValue[types.FeePool](cdc)),
+		storeService:       storeService,
+		cdc:                cdc,
+		authKeeper:         ak,
+		bankKeeper:         bk,
+		stakingKeeper:      sk,
+		allocationWeightFn: VotingPowerAllocationWeight,
+		feeCollectorName:   feeCollectorName,
+		authority:          authority,
+		Params:             collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		FeePool:            collections.NewItem(sb, types.FeePoolKey, "fee_pool", codec.CollValue[types.FeePool](cdc)),
+		AutoCompoundDelegations: collections.NewKeySet(
+			sb, types.AutoCompoundDelegationsPrefix, "auto_compound_delegations",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey),
+		),
+		AutoCompoundCursor: collections.NewItem(
+			sb, types.AutoCompoundCursorKey, "auto_compound_cursor",
+			collcodec.KeyToValueCodec(collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey)),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -65,6 +83,17 @@ func NewKeeper(
 	return k
 }
 
+// SetAllocationWeightFn sets the function weighting the rewards allocated to
+// each validator, VotingPowerAllocationWeight being used if it is nil. It must
+// be called before the keeper is passed to the modules and keepers using it.
+func (k *Keeper) SetAllocationWeightFn(allocationWeightFn AllocationWeightFn) {
+	if allocationWeightFn == nil {
+		allocationWeightFn = VotingPowerAllocationWeight
+	}
+
+	k.allocationWeightFn = allocationWeightFn
+}
+
 // GetAuthority returns the x/distribution module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// withdrawTokenizeShareRecordReward withdraws the rewards accrued by the
+// delegation of a tokenize share record and sends them, along with any
+// rewards already withdrawn to the module account of the record, to the
+// owner of the record.
+func (k Keeper) withdrawTokenizeShareRecordReward(ctx sdk.Context, record stakingtypes.TokenizeShareRecord) (sdk.Coins, error) {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleAddr := record.GetModuleAddress()
+
+	if k.stakingKeeper.Delegation(ctx, moduleAddr, valAddr) != nil {
+		if _, err := k.WithdrawDelegationRewards(ctx, moduleAddr, valAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	rewards := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+	if rewards.IsZero() {
+		return rewards, nil
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, moduleAddr, owner, rewards); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawTokenizeShareReward,
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, record.Owner),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+		),
+	)
+
+	return rewards, nil
+}
+
+// WithdrawTokenizeShareRecordReward withdraws the rewards of all the tokenize
+// share records owned by the given address.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx context.Context, ownerAddr sdk.AccAddress) (sdk.Coins, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	records := k.stakingKeeper.GetTokenizeShareRecordsByOwner(sdkCtx, ownerAddr)
+	if len(records) == 0 {
+		return nil, stakingtypes.ErrNoTokenizeShareRecord
+	}
+
+	totalRewards := sdk.NewCoins()
+	for _, record := range records {
+		rewards, err := k.withdrawTokenizeShareRecordReward(sdkCtx, record)
+		if err != nil {
+			return nil, err
+		}
+
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	return totalRewards, nil
+}
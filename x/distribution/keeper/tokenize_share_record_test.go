@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+	addrs := simtestutil.CreateIncrementalAccounts(2)
+	owner, valAddr := addrs[0], sdk.ValAddress(addrs[1])
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	record := stakingtypes.TokenizeShareRecord{
+		Id:            1,
+		Owner:         owner.String(),
+		ModuleAccount: stakingtypes.TokenizeShareRecordModuleAccountName(1),
+		Validator:     valAddr.String(),
+	}
+	moduleAddr := record.GetModuleAddress()
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	// no records owned
+	stakingKeeper.EXPECT().GetTokenizeShareRecordsByOwner(gomock.Any(), addrs[1]).Return(nil)
+	_, err := distrKeeper.WithdrawTokenizeShareRecordReward(ctx, addrs[1])
+	require.ErrorIs(t, err, stakingtypes.ErrNoTokenizeShareRecord)
+
+	// the rewards held by the module account of the record are sent to its owner
+	stakingKeeper.EXPECT().GetTokenizeShareRecordsByOwner(gomock.Any(), owner).Return([]stakingtypes.TokenizeShareRecord{record})
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), moduleAddr, valAddr).Return(nil)
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), moduleAddr).Return(rewards)
+	bankKeeper.EXPECT().SendCoins(gomock.Any(), moduleAddr, owner, rewards).Return(nil)
+
+	res, err := distrKeeper.WithdrawTokenizeShareRecordReward(ctx, owner)
+	require.NoError(t, err)
+	require.Equal(t, rewards, res)
+
+	// the rewards are withdrawn to the owner before the record is removed
+	stakingKeeper.EXPECT().GetTokenizeShareRecord(gomock.Any(), uint64(1)).Return(record, true)
+	stakingKeeper.EXPECT().Delegation(gomock.Any(), moduleAddr, valAddr).Return(nil)
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), moduleAddr).Return(rewards)
+	bankKeeper.EXPECT().SendCoins(gomock.Any(), moduleAddr, owner, rewards).Return(nil)
+
+	require.NoError(t, distrKeeper.Hooks().BeforeTokenizeShareRecordRemoved(ctx, 1))
+
+	stakingKeeper.EXPECT().GetTokenizeShareRecord(gomock.Any(), uint64(2)).Return(stakingtypes.TokenizeShareRecord{}, false)
+	require.ErrorIs(t, distrKeeper.Hooks().BeforeTokenizeShareRecordRemoved(ctx, 2), stakingtypes.ErrNoTokenizeShareRecord)
+}
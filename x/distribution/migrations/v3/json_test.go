@@ -41,6 +41,7 @@ func TestMigrateJSON(t *testing.T) {
 	require.NoError(t, err)
 
 	expected := `{
+	"auto_compound_delegations": [],
 	"delegator_starting_infos": [],
 	"delegator_withdraw_infos": [],
 	"fee_pool": {
@@ -48,9 +49,12 @@ func TestMigrateJSON(t *testing.T) {
 	},
 	"outstanding_rewards": [],
 	"params": {
+		"auto_compound_max_per_block": 100,
+		"auto_compound_min_reward": "1000",
 		"base_proposer_reward": "0.000000000000000000",
 		"bonus_proposer_reward": "0.000000000000000000",
 		"community_tax": "0.020000000000000000",
+		"redistribute_forfeited_rewards": false,
 		"withdraw_addr_enabled": true
 	},
 	"previous_proposer": "",
@@ -1,6 +1,8 @@
 package v3
 
 import (
+	"reflect"
+
 	"cosmossdk.io/core/store"
 
 	sdkmath "cosmossdk.io/math"
@@ -30,7 +32,7 @@ func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, legacySubs
 	currParams.BaseProposerReward = sdkmath.LegacyZeroDec()
 	currParams.BonusProposerReward = sdkmath.LegacyZeroDec()
 
-	if err := currParams.ValidateBasic(); err != nil {
+	if err := validateLegacyParams(&currParams); err != nil {
 		return err
 	}
 
@@ -41,3 +43,15 @@ func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, legacySubs
 
 	return store.Set(ParamsKey, bz)
 }
+
+// validateLegacyParams validates the params managed by x/params. The params
+// introduced after this migration are set and validated by later migrations.
+func validateLegacyParams(params *types.Params) error {
+	for _, pair := range params.ParamSetPairs() {
+		if err := pair.ValidatorFn(reflect.ValueOf(pair.Value).Elem().Interface()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package v4
+
+import (
+	"cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+var ParamsKey = []byte{0x09}
+
+// MigrateStore migrates the x/distribution module state from the consensus
+// version 3 to version 4. Specifically, it sets the auto-compounding params
+// to their default values.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	store := storeService.OpenKVStore(ctx)
+	bz, err := store.Get(ParamsKey)
+	if err != nil {
+		return err
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	params.AutoCompoundMinReward = types.DefaultAutoCompoundMinReward
+	params.AutoCompoundMaxPerBlock = types.DefaultAutoCompoundMaxPerBlock
+
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	bz, err = cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(ParamsKey, bz)
+}
@@ -0,0 +1,46 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	v4 "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v4"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	storeService := runtime.NewKVStoreService(storeKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// params stored before the auto-compounding params were introduced
+	oldParams := types.Params{
+		CommunityTax:        sdkmath.LegacyNewDecWithPrec(3, 2),
+		BaseProposerReward:  sdkmath.LegacyZeroDec(),
+		BonusProposerReward: sdkmath.LegacyZeroDec(),
+		WithdrawAddrEnabled: true,
+	}
+	bz, err := cdc.Marshal(&oldParams)
+	require.NoError(t, err)
+	store.Set(v4.ParamsKey, bz)
+
+	require.NoError(t, v4.MigrateStore(ctx, storeService, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v4.ParamsKey), &res))
+	require.Equal(t, oldParams.CommunityTax, res.CommunityTax)
+	require.Equal(t, oldParams.WithdrawAddrEnabled, res.WithdrawAddrEnabled)
+	require.Equal(t, types.DefaultAutoCompoundMinReward, res.AutoCompoundMinReward)
+	require.Equal(t, types.DefaultAutoCompoundMaxPerBlock, res.AutoCompoundMaxPerBlock)
+}
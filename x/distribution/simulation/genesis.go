@@ -13,8 +13,11 @@ import (
 
 // Simulation parameter constants
 const (
-	CommunityTax    = "community_tax"
-	WithdrawEnabled = "withdraw_enabled"
+	CommunityTax            = "community_tax"
+	WithdrawEnabled         = "withdraw_enabled"
+	Redistribute            = "redistribute_forfeited_rewards"
+	AutoCompoundMinReward   = "auto_compound_min_reward"
+	AutoCompoundMaxPerBlock = "auto_compound_max_per_block"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -27,6 +30,28 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenRedistributeForfeitedRewards returns a randomized
+// RedistributeForfeitedRewards parameter.
+func GenRedistributeForfeitedRewards(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenAutoCompoundMinReward returns a randomized AutoCompoundMinReward
+// parameter.
+func GenAutoCompoundMinReward(r *rand.Rand) math.Int {
+	return math.NewInt(r.Int63n(10000))
+}
+
+// GenAutoCompoundMaxPerBlock returns a randomized AutoCompoundMaxPerBlock
+// parameter.
+func GenAutoCompoundMaxPerBlock(r *rand.Rand) uint32 {
+	if r.Intn(10) == 0 {
+		return 0 // 10% chance of auto-compounding being disabled
+	}
+
+	return uint32(1 + r.Intn(200))
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax math.LegacyDec
@@ -35,11 +60,23 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var withdrawEnabled bool
 	simState.AppParams.GetOrGenerate(WithdrawEnabled, &withdrawEnabled, simState.Rand, func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) })
 
+	var redistribute bool
+	simState.AppParams.GetOrGenerate(Redistribute, &redistribute, simState.Rand, func(r *rand.Rand) { redistribute = GenRedistributeForfeitedRewards(r) })
+
+	var autoCompoundMinReward math.Int
+	simState.AppParams.GetOrGenerate(AutoCompoundMinReward, &autoCompoundMinReward, simState.Rand, func(r *rand.Rand) { autoCompoundMinReward = GenAutoCompoundMinReward(r) })
+
+	var autoCompoundMaxPerBlock uint32
+	simState.AppParams.GetOrGenerate(AutoCompoundMaxPerBlock, &autoCompoundMaxPerBlock, simState.Rand, func(r *rand.Rand) { autoCompoundMaxPerBlock = GenAutoCompoundMaxPerBlock(r) })
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:                 communityTax,
+			WithdrawAddrEnabled:          withdrawEnabled,
+			RedistributeForfeitedRewards: redistribute,
+			AutoCompoundMinReward:        autoCompoundMinReward,
+			AutoCompoundMaxPerBlock:      autoCompoundMaxPerBlock,
 		},
 	}
 
@@ -43,6 +43,8 @@ func TestRandomizedGenState(t *testing.T) {
 
 	require.Equal(t, dec1, distrGenesis.Params.CommunityTax)
 	require.Equal(t, true, distrGenesis.Params.WithdrawAddrEnabled)
+	require.Equal(t, "8084", distrGenesis.Params.AutoCompoundMinReward.String())
+	require.Equal(t, uint32(163), distrGenesis.Params.AutoCompoundMaxPerBlock)
 	require.Len(t, distrGenesis.DelegatorStartingInfos, 0)
 	require.Len(t, distrGenesis.DelegatorWithdrawInfos, 0)
 	require.Len(t, distrGenesis.ValidatorSlashEvents, 0)
@@ -21,11 +21,13 @@ const (
 	OpWeightMsgWithdrawDelegationReward    = "op_weight_msg_withdraw_delegation_reward"
 	OpWeightMsgWithdrawValidatorCommission = "op_weight_msg_withdraw_validator_commission"
 	OpWeightMsgFundCommunityPool           = "op_weight_msg_fund_community_pool"
+	OpWeightMsgSetAutoCompound             = "op_weight_msg_set_auto_compound"
 
 	DefaultWeightMsgSetWithdrawAddress          int = 50
 	DefaultWeightMsgWithdrawDelegationReward    int = 50
 	DefaultWeightMsgWithdrawValidatorCommission int = 50
 	DefaultWeightMsgFundCommunityPool           int = 50
+	DefaultWeightMsgSetAutoCompound             int = 50
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -58,6 +60,11 @@ func WeightedOperations(
 		weightMsgFundCommunityPool = DefaultWeightMsgFundCommunityPool
 	})
 
+	var weightMsgSetAutoCompound int
+	appParams.GetOrGenerate(OpWeightMsgSetAutoCompound, &weightMsgSetAutoCompound, nil, func(_ *rand.Rand) {
+		weightMsgSetAutoCompound = DefaultWeightMsgSetAutoCompound
+	})
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgSetWithdrawAddress,
@@ -75,6 +82,10 @@ func WeightedOperations(
 			weightMsgFundCommunityPool,
 			SimulateMsgFundCommunityPool(txConfig, ak, bk, k, sk),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgSetAutoCompound,
+			SimulateMsgSetAutoCompound(txConfig, ak, bk, k, sk),
+		),
 	}
 }
 
@@ -254,3 +265,54 @@ func SimulateMsgFundCommunityPool(txConfig client.TxConfig, ak types.AccountKeep
 		return simulation.GenAndDeliverTx(txCtx, fees)
 	}
 }
+
+// SimulateMsgSetAutoCompound generates a MsgSetAutoCompound enabling or
+// disabling the auto-compounding of a random delegation.
+func SimulateMsgSetAutoCompound(txConfig client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk types.StakingKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSetAutoCompound{})
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "error getting params"), nil, err
+		}
+
+		if params.AutoCompoundMaxPerBlock == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "auto-compounding is disabled"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		delegations := sk.GetAllDelegatorDelegations(ctx, simAccount.Address)
+		if len(delegations) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "number of delegators equal 0"), nil, nil
+		}
+
+		delegation := delegations[r.Intn(len(delegations))]
+		if sk.Validator(ctx, delegation.GetValidatorAddr()) == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "validator is nil"), nil, fmt.Errorf("validator %s not found", delegation.GetValidatorAddr())
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		msg := types.NewMsgSetAutoCompound(simAccount.Address, delegation.GetValidatorAddr(), r.Intn(4) != 0)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
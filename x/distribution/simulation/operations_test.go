@@ -49,6 +49,7 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 		{simulation.DefaultWeightMsgWithdrawDelegationReward, types.ModuleName, sdk.MsgTypeURL(&types.MsgWithdrawDelegatorReward{})},
 		{simulation.DefaultWeightMsgWithdrawValidatorCommission, types.ModuleName, sdk.MsgTypeURL(&types.MsgWithdrawValidatorCommission{})},
 		{simulation.DefaultWeightMsgFundCommunityPool, types.ModuleName, sdk.MsgTypeURL(&types.MsgFundCommunityPool{})},
+		{simulation.DefaultWeightMsgSetAutoCompound, types.ModuleName, sdk.MsgTypeURL(&types.MsgSetAutoCompound{})},
 	}
 
 	for i, w := range weightedOps {
@@ -224,6 +225,46 @@ func (suite *SimTestSuite) TestSimulateMsgFundCommunityPool() {
 	suite.Require().Len(futureOperations, 0)
 }
 
+// TestSimulateMsgSetAutoCompound tests the normal scenario of a valid message
+// of type TypeMsgSetAutoCompound.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func (suite *SimTestSuite) TestSimulateMsgSetAutoCompound() {
+	// setup 3 accounts
+	s := rand.NewSource(4)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	// setup accounts[0] as validator
+	validator0 := suite.getTestingValidator0(accounts)
+
+	// setup delegation
+	delTokens := sdk.TokensFromConsensusPower(2, sdk.DefaultPowerReduction)
+	validator0, issuedShares := validator0.AddTokensFromDel(delTokens)
+	delegator := accounts[1]
+	delegation := stakingtypes.NewDelegation(delegator.Address, validator0.GetOperator(), issuedShares)
+	suite.stakingKeeper.SetDelegation(suite.ctx, delegation)
+
+	suite.app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: suite.app.LastBlockHeight() + 1,
+		Hash:   suite.app.LastCommitID().Hash,
+	})
+
+	// execute operation
+	op := simulation.SimulateMsgSetAutoCompound(suite.txConfig, suite.accountKeeper, suite.bankKeeper, suite.distrKeeper, suite.stakingKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg types.MsgSetAutoCompound
+	err = proto.Unmarshal(operationMsg.Msg, &msg)
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal("cosmosvaloper1l4s054098kk9hmr5753c6k3m2kw65h686d3mhr", msg.ValidatorAddress)
+	suite.Require().Equal("cosmos1d6u7zhjwmsucs678d7qn95uqajd4ucl9jcjt26", msg.DelegatorAddress)
+	suite.Require().False(msg.Enabled)
+	suite.Require().Equal(sdk.MsgTypeURL(&types.MsgSetAutoCompound{}), sdk.MsgTypeURL(&msg))
+	suite.Require().Len(futureOperations, 0)
+}
+
 type SimTestSuite struct {
 	suite.Suite
 
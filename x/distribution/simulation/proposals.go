@@ -38,6 +38,9 @@ func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account)
 	params := types.DefaultParams()
 	params.CommunityTax = simtypes.RandomDecAmount(r, sdkmath.LegacyNewDec(1))
 	params.WithdrawAddrEnabled = r.Intn(2) == 0
+	params.RedistributeForfeitedRewards = r.Intn(2) == 0
+	params.AutoCompoundMinReward = sdkmath.NewInt(r.Int63n(10000))
+	params.AutoCompoundMaxPerBlock = uint32(r.Intn(200))
 
 	return &types.MsgUpdateParams{
 		Authority: authority.String(),
@@ -40,4 +40,6 @@ func TestProposalMsgs(t *testing.T) {
 	assert.Equal(t, sdk.AccAddress(address.Module("gov")).String(), msgUpdateParams.Authority)
 	assert.DeepEqual(t, sdkmath.LegacyNewDec(0), msgUpdateParams.Params.CommunityTax)
 	assert.Equal(t, true, msgUpdateParams.Params.WithdrawAddrEnabled)
+	assert.Equal(t, "8084", msgUpdateParams.Params.AutoCompoundMinReward.String())
+	assert.Equal(t, uint32(111), msgUpdateParams.Params.AutoCompoundMaxPerBlock)
 }
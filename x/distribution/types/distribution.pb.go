@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -37,6 +38,16 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// redistribute_forfeited_rewards defines whether the rewards forfeited by
+	// validators with an allocation weight lower than one are redistributed to
+	// the other validators. Otherwise they are sent to the community pool.
+	RedistributeForfeitedRewards bool `protobuf:"varint,5,opt,name=redistribute_forfeited_rewards,json=redistributeForfeitedRewards,proto3" json:"redistribute_forfeited_rewards,omitempty"`
+	// auto_compound_min_reward defines the minimum amount of bond denom rewards
+	// a delegation must have accrued to be auto-compounded.
+	AutoCompoundMinReward cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=auto_compound_min_reward,json=autoCompoundMinReward,proto3,customtype=cosmossdk.io/math.Int" json:"auto_compound_min_reward"`
+	// auto_compound_max_per_block defines the maximum number of delegations
+	// auto-compounded per block. Auto-compounding is disabled when set to 0.
+	AutoCompoundMaxPerBlock uint32 `protobuf:"varint,7,opt,name=auto_compound_max_per_block,json=autoCompoundMaxPerBlock,proto3" json:"auto_compound_max_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,6 +90,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetRedistributeForfeitedRewards() bool {
+	if m != nil {
+		return m.RedistributeForfeitedRewards
+	}
+	return false
+}
+
+func (m *Params) GetAutoCompoundMaxPerBlock() uint32 {
+	if m != nil {
+		return m.AutoCompoundMaxPerBlock
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -622,6 +647,48 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// AutoCompoundDelegation defines a delegation whose rewards are automatically
+// re-delegated to its validator.
+type AutoCompoundDelegation struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *AutoCompoundDelegation) Reset()         { *m = AutoCompoundDelegation{} }
+func (m *AutoCompoundDelegation) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundDelegation) ProtoMessage()    {}
+func (*AutoCompoundDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *AutoCompoundDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundDelegation.Merge(m, src)
+}
+func (m *AutoCompoundDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundDelegation proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -635,6 +702,7 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*AutoCompoundDelegation)(nil), "cosmos.distribution.v1beta1.AutoCompoundDelegation")
 }
 
 func init() {
@@ -642,70 +710,80 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x34, 0x89, 0xd3, 0x4c, 0xdb, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x1d, 0x56, 0x2a,
-	0x84, 0x40, 0x6c, 0x52, 0x2e, 0x28, 0xe2, 0x12, 0xdb, 0xad, 0xe0, 0x42, 0xa3, 0x0d, 0xa2, 0x88,
-	0xcb, 0x6a, 0xbc, 0x3b, 0xb1, 0x47, 0xdd, 0x9d, 0x59, 0x66, 0x66, 0x9d, 0xe4, 0xc0, 0x3d, 0x70,
-	0x00, 0x6e, 0xa0, 0x9e, 0x2a, 0xb8, 0x54, 0x9c, 0x72, 0xc8, 0x8d, 0x7f, 0xa0, 0xe2, 0x54, 0x15,
-	0x09, 0x21, 0x0e, 0x29, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x84, 0x52,
-	0xa9, 0xb5, 0xb8, 0x24, 0x9e, 0xef, 0xcd, 0xbe, 0xef, 0x7d, 0xef, 0xbd, 0x79, 0x33, 0xb0, 0xe6,
-	0x73, 0x19, 0x71, 0x59, 0x0f, 0xa8, 0x54, 0x82, 0xb6, 0x13, 0x45, 0x39, 0xab, 0xf7, 0x56, 0xda,
-	0x44, 0xe1, 0x95, 0x13, 0x60, 0x2d, 0x16, 0x5c, 0x71, 0x74, 0xd5, 0xec, 0xaf, 0x9d, 0x30, 0xd9,
-	0xfd, 0xe5, 0x99, 0x0e, 0xef, 0xf0, 0x74, 0x5f, 0x5d, 0xff, 0x32, 0x9f, 0x94, 0x2b, 0x96, 0xa2,
-	0x8d, 0x25, 0xc9, 0x5c, 0xfb, 0x9c, 0x5a, 0x97, 0xe5, 0x79, 0x63, 0xf7, 0xcc, 0x87, 0xd6, 0xbf,
-	0x31, 0x5d, 0xc6, 0x11, 0x65, 0xbc, 0x9e, 0xfe, 0x35, 0x90, 0xf3, 0xe3, 0x08, 0x2c, 0xae, 0x63,
-	0x81, 0x23, 0x89, 0x36, 0xe1, 0x25, 0x9f, 0x47, 0x51, 0xc2, 0xa8, 0xda, 0xf1, 0x14, 0xde, 0x2e,
-	0x81, 0x05, 0xb0, 0x38, 0xd1, 0x58, 0x7b, 0x78, 0x50, 0x2d, 0xfc, 0x76, 0x50, 0x7d, 0xb5, 0x43,
-	0x55, 0x37, 0x69, 0xd7, 0x7c, 0x1e, 0x59, 0xaf, 0xf6, 0xdf, 0xb2, 0x0c, 0xee, 0xd6, 0xd5, 0x4e,
-	0x4c, 0x64, 0xad, 0x45, 0xfc, 0xc7, 0xfb, 0xcb, 0xd0, 0x92, 0xb6, 0x88, 0xff, 0xe0, 0x78, 0x6f,
-	0x09, 0xb8, 0x17, 0x33, 0xbf, 0x1f, 0xe2, 0x6d, 0x94, 0xc0, 0x19, 0x1d, 0xbb, 0x0e, 0x30, 0xe6,
-	0x92, 0x08, 0x4f, 0x90, 0x2d, 0x2c, 0x82, 0xd2, 0xb9, 0x94, 0xae, 0xf9, 0xdc, 0x74, 0x25, 0xe0,
-	0x22, 0x4d, 0xb0, 0x6e, 0xfd, 0xbb, 0xa9, 0x7b, 0xb4, 0x05, 0x67, 0xdb, 0x9c, 0x25, 0xf2, 0x1f,
-	0xbc, 0x23, 0x2f, 0x8e, 0x77, 0x3a, 0x65, 0x38, 0x45, 0x7c, 0x03, 0xce, 0x6e, 0x51, 0xd5, 0x0d,
-	0x04, 0xde, 0xf2, 0x70, 0x10, 0x08, 0x8f, 0x30, 0xdc, 0x0e, 0x49, 0x50, 0x1a, 0x5d, 0x00, 0x8b,
-	0xe7, 0xdd, 0xe9, 0xbe, 0x71, 0x2d, 0x08, 0xc4, 0x4d, 0x63, 0x5a, 0xbd, 0xfe, 0xc5, 0xf1, 0xde,
-	0xd2, 0xc2, 0x00, 0xf1, 0xf6, 0xc9, 0x96, 0x32, 0x25, 0x73, 0x7e, 0x01, 0xb0, 0xfc, 0x11, 0x0e,
-	0x69, 0x80, 0x15, 0x17, 0xef, 0x51, 0xa9, 0xb8, 0xa0, 0x3e, 0x0e, 0x0d, 0xb1, 0x44, 0x5f, 0x02,
-	0x78, 0xc5, 0x4f, 0xa2, 0x24, 0xc4, 0x8a, 0xf6, 0x88, 0xd5, 0xeb, 0x09, 0xac, 0x28, 0x2f, 0x81,
-	0x85, 0x91, 0xc5, 0x0b, 0x37, 0xae, 0xd9, 0x86, 0xad, 0xe9, 0x84, 0xf5, 0x1b, 0x4f, 0x2b, 0x6a,
-	0x72, 0xca, 0x1a, 0xef, 0xe8, 0x9c, 0xfc, 0xf0, 0xa4, 0xfa, 0xc6, 0xb3, 0xe5, 0x44, 0x7f, 0x23,
-	0x4d, 0xc5, 0x67, 0x73, 0x5a, 0x13, 0x8c, 0xab, 0x49, 0xd1, 0x6b, 0x70, 0x4a, 0x90, 0x4d, 0x22,
-	0x08, 0xf3, 0x89, 0xe7, 0xf3, 0x84, 0xa9, 0xb4, 0xea, 0x97, 0xdc, 0xc9, 0x0c, 0x6e, 0x6a, 0xd4,
-	0xf9, 0x1e, 0xc0, 0x2b, 0x99, 0xb0, 0x66, 0x22, 0x04, 0x61, 0xaa, 0xaf, 0x2a, 0x86, 0xe3, 0x46,
-	0x89, 0x1c, 0xb2, 0x88, 0x3e, 0x0d, 0x9a, 0x83, 0xc5, 0x98, 0x08, 0xca, 0x4d, 0x8f, 0x8e, 0xba,
-	0x76, 0xe5, 0x7c, 0x0b, 0x60, 0x25, 0x8b, 0x72, 0xcd, 0xb7, 0x9a, 0x49, 0xd0, 0xe4, 0x51, 0x44,
-	0xa5, 0xa4, 0x9c, 0xa1, 0x1e, 0x84, 0x7e, 0xb6, 0x1a, 0x72, 0xbc, 0x03, 0x4c, 0xce, 0x57, 0x00,
-	0x5e, 0xcd, 0x42, 0xbb, 0x9d, 0x28, 0xa9, 0x30, 0x0b, 0x28, 0xeb, 0xfc, 0x6f, 0x49, 0x74, 0xee,
-	0x01, 0x38, 0x9d, 0x45, 0xb4, 0x11, 0x62, 0xd9, 0xbd, 0xd9, 0x23, 0x4c, 0xa1, 0xd7, 0xe1, 0x4b,
-	0xbd, 0x3e, 0xec, 0xd9, 0x34, 0x83, 0x34, 0xcd, 0x53, 0x19, 0xbe, 0x9e, 0xc2, 0xe8, 0x63, 0x78,
-	0x7e, 0x53, 0x60, 0x5f, 0x9f, 0x00, 0x3b, 0x2d, 0xde, 0x7d, 0x9e, 0x53, 0xeb, 0x66, 0xde, 0x9c,
-	0xcf, 0x01, 0x9c, 0x39, 0x23, 0x38, 0x89, 0x3e, 0x85, 0x73, 0x79, 0x74, 0x52, 0x1b, 0x3c, 0x92,
-	0x5a, 0x6c, 0xda, 0xde, 0xaa, 0x3d, 0x65, 0x82, 0xd7, 0xce, 0x70, 0xd9, 0x98, 0xd0, 0x21, 0x9b,
-	0xdc, 0xcc, 0xf4, 0xce, 0xa0, 0x74, 0x76, 0x01, 0x1c, 0xbf, 0x45, 0xc8, 0x3a, 0xe7, 0x21, 0xfa,
-	0x0c, 0x4e, 0xe6, 0x33, 0x39, 0xe6, 0x3c, 0x1c, 0x72, 0xb5, 0xf2, 0x1b, 0x40, 0xd3, 0x3b, 0xdf,
-	0x9c, 0x83, 0xe5, 0xe6, 0x20, 0xb2, 0x11, 0x13, 0x16, 0x98, 0xf9, 0x86, 0x43, 0x34, 0x03, 0xc7,
-	0x14, 0x55, 0x21, 0x31, 0x37, 0x85, 0x6b, 0x16, 0x68, 0x01, 0x5e, 0x08, 0x88, 0xf4, 0x05, 0x8d,
-	0xf3, 0x42, 0xb9, 0x83, 0x10, 0xba, 0x06, 0x27, 0x04, 0xf1, 0x69, 0x4c, 0x09, 0x53, 0x66, 0xfc,
-	0xba, 0x39, 0x80, 0x76, 0x60, 0x11, 0x47, 0xe9, 0x6c, 0x18, 0x4d, 0xb5, 0xce, 0x9f, 0xa9, 0x35,
-	0x15, 0x7a, 0xcb, 0x0a, 0x5d, 0x7c, 0x06, 0xa1, 0xa9, 0xca, 0x7b, 0xc7, 0x7b, 0x4b, 0x17, 0x43,
-	0xd2, 0xc1, 0xfe, 0x8e, 0xe7, 0xe7, 0xb2, 0x2d, 0xe1, 0xea, 0xe2, 0xee, 0xfd, 0x6a, 0xe1, 0xcf,
-	0xfb, 0xd5, 0xc2, 0x4f, 0xfb, 0xcb, 0x65, 0xcb, 0xda, 0xe1, 0xbd, 0x01, 0x52, 0xa6, 0x74, 0xcc,
-	0xc0, 0x79, 0x02, 0xe0, 0x6c, 0x8b, 0x68, 0x4f, 0xba, 0x7a, 0x0a, 0x0b, 0x45, 0x59, 0xe7, 0x7d,
-	0xb6, 0x99, 0xce, 0xb8, 0x58, 0x90, 0x1e, 0xe5, 0xfa, 0xaa, 0x19, 0x6c, 0xe7, 0xc9, 0x3e, 0x6c,
-	0xbb, 0xf9, 0x0e, 0x1c, 0x93, 0x0a, 0xdf, 0x25, 0xb6, 0x95, 0x5f, 0xc0, 0x3d, 0x6b, 0xfc, 0xa1,
-	0x16, 0x2c, 0x76, 0x09, 0xed, 0x74, 0x4d, 0x6e, 0x47, 0x1b, 0x6f, 0xfe, 0x75, 0x50, 0x9d, 0xf2,
-	0x05, 0xd1, 0x23, 0x98, 0x79, 0xc6, 0xf4, 0xdd, 0xf1, 0xde, 0xd2, 0x69, 0xcc, 0xe6, 0xc2, 0x2c,
-	0x9c, 0x3f, 0x00, 0x9c, 0xb7, 0x0a, 0x29, 0x67, 0x99, 0x56, 0x7b, 0xa9, 0x7d, 0x00, 0x2f, 0xe7,
-	0xe7, 0x42, 0xdf, 0x6a, 0x44, 0x4a, 0xfb, 0x60, 0x78, 0xe5, 0xf1, 0xfe, 0xf2, 0xcb, 0x36, 0xb4,
-	0x7c, 0x3a, 0x9a, 0x2d, 0x1b, 0x4a, 0xe8, 0x21, 0x94, 0x9f, 0x78, 0x8b, 0x23, 0x06, 0x8b, 0xd9,
-	0x33, 0x60, 0x98, 0x0d, 0x6e, 0x59, 0x56, 0x47, 0x75, 0xa5, 0x9d, 0x9f, 0x01, 0xbc, 0xfe, 0xef,
-	0xfd, 0x7d, 0x87, 0xaa, 0x6e, 0x8b, 0xc4, 0x5c, 0x52, 0x35, 0xa4, 0x56, 0x9f, 0x1b, 0x68, 0x75,
-	0x6d, 0xb2, 0x2b, 0x54, 0x82, 0xe3, 0x81, 0x21, 0x2e, 0x8d, 0xa5, 0x86, 0xfe, 0x72, 0xd5, 0xd9,
-	0xfd, 0xcf, 0xee, 0x6c, 0xdc, 0x7e, 0x70, 0x58, 0x01, 0x0f, 0x0f, 0x2b, 0xe0, 0xd1, 0x61, 0x05,
-	0xfc, 0x7e, 0x58, 0x01, 0x5f, 0x1f, 0x55, 0x0a, 0x8f, 0x8e, 0x2a, 0x85, 0x5f, 0x8f, 0x2a, 0x85,
-	0x4f, 0x56, 0x9e, 0x9a, 0xb3, 0x53, 0xef, 0x8c, 0x34, 0x85, 0xed, 0x62, 0xfa, 0x56, 0x7c, 0xfb,
-	0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xcc, 0x9a, 0x24, 0xde, 0x0a, 0x00, 0x00,
+	// 1159 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xa4, 0x89, 0xd3, 0x4c, 0x7f, 0x6f, 0x9d, 0xd4, 0x75, 0xfb, 0xb5, 0xfd, 0x5d, 0xa9,
+	0x60, 0x02, 0xb1, 0x9b, 0x72, 0x41, 0x51, 0x2f, 0xb1, 0x9d, 0x88, 0x1c, 0xa0, 0xd1, 0x06, 0x51,
+	0xc4, 0x65, 0x35, 0xde, 0x1d, 0xdb, 0xa3, 0x78, 0x67, 0x96, 0x99, 0x59, 0x27, 0x39, 0x70, 0x0f,
+	0x1c, 0x80, 0x1b, 0xa8, 0xa7, 0x0a, 0x2e, 0x55, 0x4f, 0x39, 0xe4, 0xc2, 0x7f, 0x50, 0x71, 0xaa,
+	0x82, 0x84, 0x10, 0x87, 0x14, 0x92, 0x43, 0x10, 0x7f, 0x05, 0x9a, 0x9d, 0xd9, 0xb5, 0x63, 0x42,
+	0xa9, 0x68, 0x23, 0x2e, 0xf6, 0xce, 0x7b, 0x33, 0xef, 0xf3, 0x3e, 0xef, 0xf3, 0xe6, 0x07, 0xac,
+	0x7a, 0x4c, 0x04, 0x4c, 0xd4, 0x7c, 0x22, 0x24, 0x27, 0xad, 0x48, 0x12, 0x46, 0x6b, 0xfd, 0xf9,
+	0x16, 0x96, 0x68, 0xfe, 0x98, 0xb1, 0x1a, 0x72, 0x26, 0x99, 0x75, 0x43, 0xcf, 0xaf, 0x1e, 0x73,
+	0x99, 0xf9, 0x85, 0x5c, 0x87, 0x75, 0x58, 0x3c, 0xaf, 0xa6, 0xbe, 0xf4, 0x92, 0x42, 0xd1, 0x40,
+	0xb4, 0x90, 0xc0, 0x69, 0x68, 0x8f, 0x11, 0x13, 0xb2, 0x70, 0x5d, 0xfb, 0x5d, 0xbd, 0xd0, 0xc4,
+	0xd7, 0xae, 0x2b, 0x28, 0x20, 0x94, 0xd5, 0xe2, 0x5f, 0x6d, 0xb2, 0x1f, 0x4f, 0xc0, 0xec, 0x2a,
+	0xe2, 0x28, 0x10, 0x56, 0x1b, 0x5e, 0xf0, 0x58, 0x10, 0x44, 0x94, 0xc8, 0x2d, 0x57, 0xa2, 0xcd,
+	0x3c, 0x28, 0x83, 0xca, 0x54, 0x7d, 0xf1, 0xc9, 0x7e, 0x29, 0xf3, 0xcb, 0x7e, 0xe9, 0xb5, 0x0e,
+	0x91, 0xdd, 0xa8, 0x55, 0xf5, 0x58, 0x60, 0xa2, 0x9a, 0xbf, 0x39, 0xe1, 0xaf, 0xd7, 0xe4, 0x56,
+	0x88, 0x45, 0xb5, 0x89, 0xbd, 0xbd, 0xdd, 0x39, 0x68, 0x40, 0x9b, 0xd8, 0x7b, 0x74, 0xb4, 0x33,
+	0x0b, 0x9c, 0xf3, 0x69, 0xdc, 0x0f, 0xd0, 0xa6, 0x15, 0xc1, 0x9c, 0xca, 0x5d, 0x25, 0x18, 0x32,
+	0x81, 0xb9, 0xcb, 0xf1, 0x06, 0xe2, 0x7e, 0x7e, 0x2c, 0x86, 0x6b, 0xbc, 0x34, 0x5c, 0x1e, 0x38,
+	0x96, 0x02, 0x58, 0x35, 0xf1, 0x9d, 0x38, 0xbc, 0xb5, 0x01, 0xa7, 0x5b, 0x8c, 0x46, 0xe2, 0x2f,
+	0xb8, 0x67, 0x5e, 0x1d, 0xee, 0xd5, 0x18, 0x61, 0x04, 0xf8, 0x0e, 0x9c, 0xde, 0x20, 0xb2, 0xeb,
+	0x73, 0xb4, 0xe1, 0x22, 0xdf, 0xe7, 0x2e, 0xa6, 0xa8, 0xd5, 0xc3, 0x7e, 0x7e, 0xbc, 0x0c, 0x2a,
+	0x67, 0x9d, 0xab, 0x89, 0x73, 0xd1, 0xf7, 0xf9, 0x92, 0x76, 0x59, 0x4d, 0x58, 0xe4, 0x38, 0x6d,
+	0x0a, 0xec, 0xb6, 0x19, 0x6f, 0x63, 0x22, 0xb1, 0x6f, 0x92, 0x16, 0xf9, 0x89, 0x78, 0xf1, 0xcd,
+	0xe1, 0x59, 0xcb, 0xc9, 0x24, 0x0d, 0x2c, 0x2c, 0x02, 0xf3, 0x28, 0x92, 0xcc, 0xf5, 0x58, 0x10,
+	0xb2, 0x88, 0xfa, 0x6e, 0x40, 0x68, 0xc2, 0x3a, 0x1b, 0xb3, 0xbe, 0x6d, 0x58, 0x4f, 0x6b, 0x2e,
+	0xc2, 0x5f, 0xaf, 0x12, 0x56, 0x0b, 0x90, 0xec, 0x56, 0x57, 0xa8, 0x1c, 0x22, 0xb9, 0x42, 0xa5,
+	0xd6, 0x72, 0x5a, 0x45, 0x6c, 0x98, 0x80, 0xef, 0x11, 0x6a, 0x48, 0xde, 0x85, 0x37, 0x46, 0xa0,
+	0xd0, 0xa6, 0x1b, 0x62, 0xee, 0xb6, 0x7a, 0xcc, 0x5b, 0xcf, 0x4f, 0x96, 0x41, 0xe5, 0x82, 0x73,
+	0xed, 0xd8, 0x5a, 0xb4, 0xb9, 0x8a, 0x79, 0x5d, 0xb9, 0x17, 0x6e, 0x7d, 0x7e, 0xb4, 0x33, 0x5b,
+	0x1e, 0xaa, 0xf3, 0xe6, 0xf1, 0x1d, 0xa4, 0x3b, 0xd4, 0xfe, 0x09, 0xc0, 0xc2, 0x87, 0xa8, 0x47,
+	0x7c, 0x24, 0x19, 0x7f, 0x97, 0x08, 0xc9, 0x38, 0xf1, 0x50, 0x2f, 0xa1, 0xfb, 0x05, 0x80, 0xd7,
+	0xbc, 0x28, 0x88, 0x7a, 0x48, 0x92, 0x3e, 0x36, 0x44, 0x5d, 0x8e, 0x24, 0x61, 0x79, 0x50, 0x3e,
+	0x53, 0x39, 0x77, 0xe7, 0xa6, 0xd9, 0x9f, 0x55, 0xd5, 0x1f, 0xc9, 0x3e, 0x53, 0x02, 0x36, 0x18,
+	0xa1, 0xf5, 0x77, 0x54, 0x31, 0x1e, 0x3f, 0x2b, 0xbd, 0xf9, 0x62, 0x2d, 0xa0, 0xd6, 0x08, 0x53,
+	0x94, 0x01, 0xac, 0x4e, 0xc6, 0x51, 0xa0, 0xd6, 0xeb, 0xf0, 0x12, 0xc7, 0x6d, 0xcc, 0x31, 0xf5,
+	0xb0, 0xeb, 0xb1, 0x88, 0xca, 0xb8, 0xc9, 0x2f, 0x38, 0x17, 0x53, 0x73, 0x43, 0x59, 0xed, 0xef,
+	0x00, 0xbc, 0x96, 0x12, 0x6b, 0x44, 0x9c, 0x63, 0x2a, 0x13, 0x56, 0x21, 0x9c, 0x4c, 0x34, 0x3f,
+	0x5d, 0x12, 0x09, 0x8c, 0x35, 0x03, 0xb3, 0x21, 0xe6, 0x84, 0xe9, 0x2d, 0x39, 0xee, 0x98, 0x91,
+	0xfd, 0x0d, 0x80, 0xc5, 0x34, 0xcb, 0x45, 0xcf, 0x70, 0xc6, 0x7e, 0x83, 0x05, 0x01, 0x11, 0x82,
+	0x30, 0x6a, 0xf5, 0x21, 0xf4, 0xd2, 0xd1, 0x29, 0xe7, 0x3b, 0x84, 0x64, 0x7f, 0x09, 0xe0, 0x8d,
+	0x34, 0xb5, 0x7b, 0x91, 0x14, 0x12, 0x51, 0x9f, 0xd0, 0xce, 0x7f, 0x56, 0x44, 0xfb, 0x01, 0x80,
+	0x57, 0xd3, 0x8c, 0xd6, 0x7a, 0x48, 0x74, 0x97, 0xfa, 0x98, 0x4a, 0xeb, 0x0d, 0x78, 0xb9, 0x9f,
+	0x98, 0x5d, 0x53, 0x66, 0x10, 0x97, 0xf9, 0x52, 0x6a, 0x5f, 0x8d, 0xcd, 0xd6, 0x47, 0xf0, 0x6c,
+	0x9b, 0x23, 0x4f, 0xed, 0x00, 0x73, 0x38, 0xde, 0x7d, 0x99, 0x43, 0xca, 0x49, 0xa3, 0xd9, 0x9f,
+	0x01, 0x98, 0x3b, 0x21, 0x39, 0x61, 0x7d, 0x02, 0x67, 0x06, 0xd9, 0x09, 0xe5, 0x70, 0x71, 0xec,
+	0x31, 0x65, 0xbb, 0x5d, 0x7d, 0xce, 0x85, 0x55, 0x3d, 0x21, 0x64, 0x7d, 0x4a, 0xa5, 0xac, 0x6b,
+	0x93, 0xeb, 0x9f, 0x00, 0x69, 0x6f, 0x03, 0x38, 0xb9, 0x8c, 0xf1, 0x2a, 0x63, 0x3d, 0xeb, 0x53,
+	0x78, 0x71, 0x70, 0x05, 0x85, 0x8c, 0xf5, 0x4e, 0x59, 0xad, 0xc1, 0x85, 0xa7, 0xe0, 0xed, 0xaf,
+	0xc7, 0x60, 0xa1, 0x31, 0x6c, 0x59, 0x0b, 0x31, 0xf5, 0xf5, 0x71, 0x8e, 0x7a, 0x56, 0x0e, 0x4e,
+	0x48, 0x22, 0x7b, 0x58, 0x5f, 0x8c, 0x8e, 0x1e, 0x58, 0x65, 0x78, 0xce, 0xc7, 0xc2, 0xe3, 0x24,
+	0x1c, 0x08, 0xe5, 0x0c, 0x9b, 0xac, 0x9b, 0x70, 0x8a, 0x63, 0x8f, 0x84, 0x04, 0x53, 0xa9, 0x6f,
+	0x1b, 0x67, 0x60, 0xb0, 0xb6, 0x60, 0x16, 0x05, 0xf1, 0xd9, 0x30, 0x1e, 0x73, 0xbd, 0x7e, 0x22,
+	0xd7, 0x98, 0xe8, 0xb2, 0x21, 0x5a, 0x79, 0x01, 0xa2, 0x31, 0xcb, 0x07, 0x47, 0x3b, 0xb3, 0xe7,
+	0x7b, 0xb8, 0x83, 0xbc, 0x2d, 0xd7, 0x1b, 0xd0, 0x36, 0x80, 0x0b, 0x95, 0xed, 0x87, 0xa5, 0xcc,
+	0xef, 0x0f, 0x4b, 0x99, 0x1f, 0x76, 0xe7, 0x0a, 0x06, 0xb5, 0xc3, 0xfa, 0x43, 0xa0, 0x54, 0xaa,
+	0x9c, 0x81, 0xfd, 0x0c, 0xc0, 0xe9, 0x26, 0x56, 0x91, 0x94, 0x7a, 0x12, 0x71, 0x49, 0x68, 0x67,
+	0x85, 0xb6, 0xe3, 0x33, 0x2e, 0xe4, 0xb8, 0x4f, 0x98, 0xba, 0x59, 0x87, 0xdb, 0xf9, 0x62, 0x62,
+	0x36, 0xdd, 0x7c, 0x1f, 0x4e, 0x08, 0x89, 0xd6, 0x71, 0x7e, 0xec, 0x55, 0x3d, 0x2b, 0x74, 0x3c,
+	0xab, 0x09, 0xb3, 0x5d, 0x4c, 0x3a, 0x5d, 0x5d, 0xdb, 0xf1, 0xfa, 0x5b, 0x7f, 0xec, 0x97, 0x2e,
+	0x79, 0x1c, 0xab, 0x23, 0x98, 0xba, 0xda, 0xf5, 0xed, 0xd1, 0xce, 0xec, 0xa8, 0xcd, 0xd4, 0x42,
+	0x0f, 0xec, 0xdf, 0x00, 0xbc, 0x6e, 0x18, 0x12, 0x46, 0x53, 0xae, 0xe6, 0x7a, 0x7b, 0x1f, 0x5e,
+	0x19, 0xec, 0x0b, 0x75, 0x89, 0x63, 0x21, 0xcc, 0xfb, 0xe8, 0xff, 0x7b, 0xbb, 0x73, 0xff, 0x33,
+	0xa9, 0x0d, 0x4e, 0x47, 0x3d, 0x65, 0x4d, 0x72, 0x75, 0x08, 0x5d, 0xee, 0x8f, 0xd8, 0x2d, 0x0a,
+	0xb3, 0xe9, 0xab, 0xe7, 0x34, 0x1b, 0xdc, 0xa0, 0x2c, 0x8c, 0x2b, 0xa5, 0xed, 0x1f, 0x01, 0xbc,
+	0xf5, 0xf7, 0xfd, 0x7d, 0x9f, 0xc8, 0x6e, 0x13, 0x87, 0x4c, 0x10, 0x79, 0x4a, 0xad, 0x3e, 0x33,
+	0xd4, 0xea, 0xca, 0x65, 0x46, 0x56, 0x1e, 0x4e, 0xfa, 0x1a, 0x38, 0x7e, 0xd6, 0x4c, 0x39, 0xc9,
+	0x70, 0xc1, 0xde, 0xfe, 0xc7, 0xee, 0xb4, 0xbf, 0x07, 0x70, 0x66, 0x71, 0xe8, 0x61, 0x31, 0x50,
+	0xd1, 0x5a, 0x82, 0x57, 0xfc, 0x44, 0xc9, 0x11, 0xd9, 0xf2, 0x7b, 0xbb, 0x73, 0x39, 0x13, 0x75,
+	0x44, 0xad, 0x74, 0x49, 0xa2, 0xd6, 0x89, 0xea, 0x8f, 0xfd, 0x6b, 0xf5, 0x17, 0xce, 0x26, 0xfb,
+	0xae, 0x7e, 0xef, 0xd1, 0x41, 0x11, 0x3c, 0x39, 0x28, 0x82, 0xa7, 0x07, 0x45, 0xf0, 0xeb, 0x41,
+	0x11, 0x7c, 0x75, 0x58, 0xcc, 0x3c, 0x3d, 0x2c, 0x66, 0x7e, 0x3e, 0x2c, 0x66, 0x3e, 0x9e, 0x7f,
+	0xae, 0xde, 0x23, 0x6f, 0xa4, 0x58, 0xfe, 0x56, 0x36, 0x7e, 0xd6, 0xbf, 0xfd, 0xe7, 0x00, 0xc1,
+	0x56, 0x5b, 0xc6, 0x89, 0x0c, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -739,6 +817,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.RedistributeForfeitedRewards != that1.RedistributeForfeitedRewards {
+		return false
+	}
+	if !this.AutoCompoundMinReward.Equal(that1.AutoCompoundMinReward) {
+		return false
+	}
+	if this.AutoCompoundMaxPerBlock != that1.AutoCompoundMaxPerBlock {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1066,6 +1153,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundMaxPerBlock != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundMaxPerBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.AutoCompoundMinReward.Size()
+		i -= size
+		if _, err := m.AutoCompoundMinReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.RedistributeForfeitedRewards {
+		i--
+		if m.RedistributeForfeitedRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1582,6 +1694,43 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoCompoundDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -1608,6 +1757,14 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.RedistributeForfeitedRewards {
+		n += 2
+	}
+	l = m.AutoCompoundMinReward.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.AutoCompoundMaxPerBlock != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundMaxPerBlock))
+	}
 	return n
 }
 
@@ -1813,6 +1970,23 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
+func (m *AutoCompoundDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
 func sovDistribution(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1970,6 +2144,79 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedistributeForfeitedRewards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RedistributeForfeitedRewards = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMinReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoCompoundMinReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMaxPerBlock", wireType)
+			}
+			m.AutoCompoundMaxPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundMaxPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -3264,6 +3511,120 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoCompoundDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8,8 +8,17 @@ const (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeForfeitedRewards   = "forfeited_rewards"
+	EventTypeSetAutoCompound    = "set_auto_compound"
+	EventTypeAutoCompound       = "auto_compound"
+	EventTypeAutoCompoundFailed = "auto_compound_failed"
+
+	EventTypeWithdrawTokenizeShareReward = "withdraw_tokenize_share_reward"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyWeight          = "weight"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyReason          = "reason"
 )
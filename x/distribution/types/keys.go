@@ -43,6 +43,10 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0a<accAddrLen (1 Byte)><accAddr_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: AutoCompoundDelegation
+//
+// - 0x0b: AutoCompoundCursor
 var (
 	FeePoolKey                        = collections.NewPrefix(0) // key for global distribution state
 	ProposerKey                       = []byte{0x01}             // key for the proposer operator address
@@ -56,6 +60,9 @@ var (
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
 
 	ParamsKey = collections.NewPrefix(9) // key for distribution module params
+
+	AutoCompoundDelegationsPrefix = collections.NewPrefix(10) // key for the auto-compounded delegations
+	AutoCompoundCursorKey         = collections.NewPrefix(11) // key for the last auto-compounded delegation
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
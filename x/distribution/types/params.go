@@ -6,13 +6,21 @@ import (
 	"cosmossdk.io/math"
 )
 
+// Default auto-compounding parameters
+var (
+	DefaultAutoCompoundMinReward          = math.NewInt(1000)
+	DefaultAutoCompoundMaxPerBlock uint32 = 100
+)
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        math.LegacyNewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
-		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
-		WithdrawAddrEnabled: true,
+		CommunityTax:            math.LegacyNewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:      math.LegacyZeroDec(),            // deprecated
+		BonusProposerReward:     math.LegacyZeroDec(),            // deprecated
+		WithdrawAddrEnabled:     true,
+		AutoCompoundMinReward:   DefaultAutoCompoundMinReward,
+		AutoCompoundMaxPerBlock: DefaultAutoCompoundMaxPerBlock,
 	}
 }
 
@@ -24,7 +32,7 @@ func (p Params) ValidateBasic() error {
 		)
 	}
 
-	return nil
+	return validateAutoCompoundMinReward(p.AutoCompoundMinReward)
 }
 
 func validateCommunityTax(i interface{}) error {
@@ -46,6 +54,22 @@ func validateCommunityTax(i interface{}) error {
 	return nil
 }
 
+func validateAutoCompoundMinReward(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("auto-compound min reward must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("auto-compound min reward must be positive: %s", v)
+	}
+
+	return nil
+}
+
 func validateWithdrawAddrEnabled(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
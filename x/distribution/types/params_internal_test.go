@@ -29,3 +29,23 @@ func Test_validateAuxFuncs(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateAutoCompoundMinReward(t *testing.T) {
+	tests := []struct {
+		name    string
+		i       interface{}
+		wantErr bool
+	}{
+		{"wrong type", math.LegacyOneDec(), true},
+		{"empty math.Int", math.Int{}, true},
+		{"negative", math.NewInt(-1), true},
+		{"zero", math.ZeroInt(), false},
+		{"positive", math.NewInt(1000), false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantErr, validateAutoCompoundMinReward(tt.i) != nil)
+		})
+	}
+}
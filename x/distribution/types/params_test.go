@@ -14,28 +14,32 @@ func TestParams_ValidateBasic(t *testing.T) {
 	toDec := sdk.MustNewDecFromStr
 
 	type fields struct {
-		CommunityTax        sdkmath.LegacyDec
-		BaseProposerReward  sdkmath.LegacyDec
-		BonusProposerReward sdkmath.LegacyDec
-		WithdrawAddrEnabled bool
+		CommunityTax          sdkmath.LegacyDec
+		BaseProposerReward    sdkmath.LegacyDec
+		BonusProposerReward   sdkmath.LegacyDec
+		WithdrawAddrEnabled   bool
+		AutoCompoundMinReward sdkmath.Int
 	}
 	tests := []struct {
 		name    string
 		fields  fields
 		wantErr bool
 	}{
-		{"success", fields{toDec("0.1"), toDec("0"), toDec("0"), false}, false},
-		{"negative community tax", fields{toDec("-0.1"), toDec("0"), toDec("0"), false}, true},
-		{"negative base proposer reward (must not matter)", fields{toDec("0.1"), toDec("0"), toDec("-0.1"), false}, false},
-		{"negative bonus proposer reward (must not matter)", fields{toDec("0.1"), toDec("0"), toDec("-0.1"), false}, false},
-		{"total sum greater than 1 (must not matter)", fields{toDec("0.2"), toDec("0.5"), toDec("0.4"), false}, false},
-		{"community tax greater than 1", fields{toDec("1.1"), toDec("0"), toDec("0"), false}, true},
+		{"success", fields{toDec("0.1"), toDec("0"), toDec("0"), false, sdkmath.ZeroInt()}, false},
+		{"negative community tax", fields{toDec("-0.1"), toDec("0"), toDec("0"), false, sdkmath.ZeroInt()}, true},
+		{"negative base proposer reward (must not matter)", fields{toDec("0.1"), toDec("0"), toDec("-0.1"), false, sdkmath.ZeroInt()}, false},
+		{"negative bonus proposer reward (must not matter)", fields{toDec("0.1"), toDec("0"), toDec("-0.1"), false, sdkmath.ZeroInt()}, false},
+		{"total sum greater than 1 (must not matter)", fields{toDec("0.2"), toDec("0.5"), toDec("0.4"), false, sdkmath.ZeroInt()}, false},
+		{"community tax greater than 1", fields{toDec("1.1"), toDec("0"), toDec("0"), false, sdkmath.ZeroInt()}, true},
+		{"negative auto-compound min reward", fields{toDec("0.1"), toDec("0"), toDec("0"), false, sdkmath.NewInt(-1)}, true},
+		{"nil auto-compound min reward", fields{toDec("0.1"), toDec("0"), toDec("0"), false, sdkmath.Int{}}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := types.Params{
-				CommunityTax:        tt.fields.CommunityTax,
-				WithdrawAddrEnabled: tt.fields.WithdrawAddrEnabled,
+				CommunityTax:          tt.fields.CommunityTax,
+				WithdrawAddrEnabled:   tt.fields.WithdrawAddrEnabled,
+				AutoCompoundMinReward: tt.fields.AutoCompoundMinReward,
 			}
 			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
 				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
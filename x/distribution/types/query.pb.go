@@ -867,6 +867,94 @@ func (m *QueryDelegatorWithdrawAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryDelegatorWithdrawAddressResponse proto.InternalMessageInfo
 
+// QueryAutoCompoundDelegationsRequest is the request type for the
+// Query/AutoCompoundDelegations RPC method.
+type QueryAutoCompoundDelegationsRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryAutoCompoundDelegationsRequest) Reset()         { *m = QueryAutoCompoundDelegationsRequest{} }
+func (m *QueryAutoCompoundDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundDelegationsRequest) ProtoMessage()    {}
+func (*QueryAutoCompoundDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+}
+func (m *QueryAutoCompoundDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundDelegationsRequest.Merge(m, src)
+}
+func (m *QueryAutoCompoundDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundDelegationsRequest proto.InternalMessageInfo
+
+// QueryAutoCompoundDelegationsResponse is the response type for the
+// Query/AutoCompoundDelegations RPC method.
+type QueryAutoCompoundDelegationsResponse struct {
+	// validators defines the validators of the auto-compounded delegations of
+	// the delegator.
+	Validators []string `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *QueryAutoCompoundDelegationsResponse) Reset()         { *m = QueryAutoCompoundDelegationsResponse{} }
+func (m *QueryAutoCompoundDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundDelegationsResponse) ProtoMessage()    {}
+func (*QueryAutoCompoundDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+}
+func (m *QueryAutoCompoundDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundDelegationsResponse.Merge(m, src)
+}
+func (m *QueryAutoCompoundDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundDelegationsResponse) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
 // QueryCommunityPoolRequest is the request type for the Query/CommunityPool RPC
 // method.
 type QueryCommunityPoolRequest struct {
@@ -876,7 +964,7 @@ func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolReq
 func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolRequest) ProtoMessage()    {}
 func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{18}
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
 }
 func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -916,7 +1004,7 @@ func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolRe
 func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolResponse) ProtoMessage()    {}
 func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{19}
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
 }
 func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -971,6 +1059,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorValidatorsResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorValidatorsResponse")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressRequest")
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
+	proto.RegisterType((*QueryAutoCompoundDelegationsRequest)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundDelegationsRequest")
+	proto.RegisterType((*QueryAutoCompoundDelegationsResponse)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundDelegationsResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
 }
@@ -980,87 +1070,91 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1269 bytes of a gzipped FileDescriptorProto
+	// 1329 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xce, 0xb8, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x9e, 0x46, 0xc8, 0xd9, 0xa4, 0x4e, 0x70,
-	0x68, 0x13, 0x35, 0x8a, 0xb7, 0x49, 0xa4, 0x52, 0x12, 0x2a, 0x88, 0x9d, 0x84, 0xa2, 0x46, 0x69,
-	0xeb, 0x16, 0x22, 0x40, 0x95, 0xb5, 0xf6, 0x6e, 0x36, 0x0b, 0xf6, 0x8e, 0xb3, 0xb3, 0x8e, 0x89,
-	0xaa, 0x5e, 0xca, 0xa5, 0xf4, 0x84, 0xe0, 0xc2, 0x91, 0x23, 0xe2, 0xc4, 0xa1, 0x9c, 0xe1, 0xc0,
-	0xa1, 0xc7, 0xaa, 0x48, 0x88, 0x13, 0xa0, 0x04, 0x89, 0x72, 0x00, 0x71, 0xe3, 0x8a, 0x76, 0x66,
-	0xd6, 0xde, 0xb5, 0xd7, 0xeb, 0x7f, 0xf2, 0x25, 0xb1, 0x66, 0xe7, 0x7d, 0xdf, 0xfb, 0xde, 0x7b,
-	0x33, 0xfb, 0xd9, 0x30, 0x9b, 0x27, 0xb4, 0x48, 0xa8, 0xac, 0x1a, 0xd4, 0xb6, 0x8c, 0x5c, 0xd9,
-	0x36, 0x88, 0x29, 0x1f, 0x2c, 0xe6, 0x34, 0x5b, 0x59, 0x94, 0xf7, 0xcb, 0x9a, 0x75, 0x98, 0x2c,
-	0x59, 0xc4, 0x26, 0x78, 0x82, 0x6f, 0x4c, 0x7a, 0x37, 0x26, 0xc5, 0x46, 0xe9, 0xa2, 0x40, 0xc9,
-	0x29, 0x54, 0xe3, 0x51, 0x55, 0x8c, 0x92, 0xa2, 0x1b, 0xa6, 0xc2, 0x76, 0x33, 0x20, 0x69, 0x4c,
-	0x27, 0x3a, 0x61, 0x1f, 0x65, 0xe7, 0x93, 0x58, 0x9d, 0xd4, 0x09, 0xd1, 0x0b, 0x9a, 0xac, 0x94,
-	0x0c, 0x59, 0x31, 0x4d, 0x62, 0xb3, 0x10, 0x2a, 0x9e, 0xc6, 0xbd, 0xf8, 0x2e, 0x72, 0x9e, 0x18,
-	0x2e, 0x66, 0x32, 0x4c, 0x85, 0x2f, 0x63, 0xbe, 0x7f, 0x9c, 0xef, 0xcf, 0xf2, 0x34, 0x84, 0x32,
-	0xfe, 0x28, 0xaa, 0x14, 0x0d, 0x93, 0xc8, 0xec, 0x2f, 0x5f, 0x4a, 0x8c, 0x01, 0xbe, 0xe5, 0x68,
-	0xba, 0xa9, 0x58, 0x4a, 0x91, 0x66, 0xb4, 0xfd, 0xb2, 0x46, 0xed, 0xc4, 0x5d, 0x38, 0xeb, 0x5b,
-	0xa5, 0x25, 0x62, 0x52, 0x0d, 0x6f, 0xc2, 0x50, 0x89, 0xad, 0xc4, 0xd0, 0x34, 0x9a, 0x3b, 0xbd,
-	0x34, 0x93, 0x0c, 0x29, 0x5c, 0x92, 0x07, 0xa7, 0x86, 0x9f, 0xfc, 0x32, 0x35, 0xf0, 0xd5, 0x1f,
-	0xdf, 0x5c, 0x44, 0x19, 0x11, 0x9d, 0xa8, 0xc0, 0x79, 0x06, 0xff, 0x8e, 0x52, 0x30, 0x54, 0xc5,
-	0x26, 0xd6, 0xba, 0x27, 0xfe, 0x2d, 0x73, 0x97, 0x88, 0x3c, 0xf0, 0x36, 0x44, 0x0f, 0xdc, 0x3d,
-	0x59, 0x45, 0x55, 0x2d, 0x8d, 0x72, 0xee, 0xe1, 0xd4, 0x4b, 0xcf, 0x1e, 0x2f, 0x9c, 0x13, 0xf4,
-	0x55, 0x9c, 0x35, 0xbe, 0xe5, 0xb6, 0x6d, 0x19, 0xa6, 0x9e, 0x19, 0x3d, 0xa8, 0x5b, 0x4f, 0xfc,
-	0x1d, 0x81, 0x0b, 0xad, 0x98, 0x85, 0xd6, 0x2d, 0x18, 0x25, 0x25, 0xcd, 0xea, 0x8e, 0x79, 0xc4,
-	0x0d, 0x15, 0xcb, 0xf8, 0x01, 0x82, 0x28, 0xd5, 0x0a, 0xbb, 0xd9, 0x1c, 0x31, 0xd5, 0xac, 0xa5,
-	0x55, 0x14, 0x4b, 0xa5, 0xb1, 0xc8, 0xf4, 0x89, 0xb9, 0xd3, 0x4b, 0x93, 0x6e, 0x15, 0x9d, 0x09,
-	0xa8, 0x56, 0x6f, 0x5d, 0xcb, 0xa7, 0x89, 0x61, 0xa6, 0xae, 0x38, 0xe5, 0xfb, 0xfa, 0xd7, 0xa9,
-	0x79, 0xdd, 0xb0, 0xf7, 0xca, 0xb9, 0x64, 0x9e, 0x14, 0x45, 0x53, 0xc5, 0xbf, 0x05, 0xaa, 0x7e,
-	0x28, 0xdb, 0x87, 0x25, 0x8d, 0xba, 0x31, 0x94, 0x57, 0x7b, 0xc4, 0x21, 0x4c, 0x11, 0x53, 0xcd,
-	0x70, 0x3a, 0xbc, 0x0f, 0x90, 0x27, 0xc5, 0xa2, 0x41, 0xa9, 0x41, 0xcc, 0xd8, 0x89, 0x36, 0xc8,
-	0x97, 0xbb, 0x20, 0xcf, 0x78, 0x48, 0x12, 0x87, 0x30, 0xeb, 0xaf, 0xf7, 0x8d, 0xb2, 0x4d, 0x6d,
-	0xc5, 0x54, 0x9d, 0x2a, 0xf1, 0xb4, 0xfa, 0xd5, 0xeb, 0x4f, 0x10, 0xcc, 0xb5, 0xe6, 0x16, 0xdd,
-	0xbe, 0x0b, 0xa7, 0xdc, 0xa6, 0xf0, 0xd1, 0xbe, 0x12, 0x3a, 0xda, 0x21, 0x90, 0xde, 0x79, 0x77,
-	0x31, 0x13, 0xfb, 0x30, 0xe5, 0x4f, 0x25, 0x5d, 0x2d, 0x51, 0xbf, 0xe4, 0x3f, 0x42, 0x30, 0xdd,
-	0x9c, 0x53, 0xc8, 0xde, 0xf5, 0x4d, 0x04, 0x57, 0xbe, 0xda, 0x9e, 0xf2, 0xb5, 0x7c, 0xbe, 0x5c,
-	0x2c, 0x17, 0x14, 0x5b, 0x53, 0x6b, 0xc0, 0x5e, 0xf1, 0xde, 0x31, 0x78, 0x14, 0x81, 0x49, 0x7f,
-	0x32, 0xb7, 0x0b, 0x0a, 0xdd, 0xd3, 0xfa, 0xd5, 0x7c, 0x3c, 0x0b, 0x23, 0xd4, 0x56, 0x2c, 0xdb,
-	0x30, 0xf5, 0xec, 0x9e, 0x66, 0xe8, 0x7b, 0x76, 0x2c, 0x32, 0x8d, 0xe6, 0x06, 0x33, 0x2f, 0xb8,
-	0xcb, 0xd7, 0xd8, 0x2a, 0x9e, 0x81, 0x33, 0x1a, 0x6b, 0x9f, 0xbb, 0xed, 0x04, 0xdb, 0xf6, 0x7f,
-	0xbe, 0x28, 0x36, 0x6d, 0x02, 0xd4, 0xae, 0xfa, 0xd8, 0x20, 0x2b, 0xd3, 0x05, 0xdf, 0xc1, 0xe1,
-	0x6f, 0x93, 0xda, 0xcd, 0xa7, 0x6b, 0x42, 0x59, 0xc6, 0x13, 0xb9, 0x32, 0xf8, 0xf0, 0xcb, 0xa9,
-	0x81, 0xc4, 0x77, 0x08, 0xce, 0x35, 0x29, 0x86, 0x68, 0xcb, 0xdb, 0x70, 0x8a, 0xf2, 0xa5, 0x18,
-	0x62, 0xa7, 0xf4, 0x52, 0x7b, 0x3d, 0x61, 0x38, 0x1b, 0x07, 0x9a, 0x69, 0xfb, 0xa6, 0x50, 0x60,
-	0xe1, 0x37, 0x7d, 0x32, 0x22, 0x4c, 0xc6, 0x6c, 0x4b, 0x19, 0x3c, 0x27, 0xaf, 0x8e, 0xc4, 0xf7,
-	0xae, 0x82, 0x75, 0xad, 0xa0, 0xe9, 0x6c, 0xad, 0xee, 0x30, 0x6f, 0x40, 0x54, 0xe5, 0xcf, 0x1a,
-	0xfa, 0x19, 0x7b, 0xf6, 0x78, 0x61, 0x4c, 0x90, 0xd6, 0xb5, 0xb1, 0x1a, 0xe2, 0xb6, 0x31, 0x70,
-	0x2c, 0x22, 0x5d, 0x8f, 0xc5, 0xca, 0xff, 0x9c, 0x06, 0x3c, 0x77, 0x9a, 0xf0, 0x19, 0x82, 0x78,
-	0x33, 0x09, 0xa2, 0x0b, 0x25, 0xef, 0x9d, 0xd0, 0xcf, 0x8b, 0xba, 0x7a, 0x4d, 0x94, 0x21, 0x51,
-	0x97, 0xd3, 0x1d, 0x62, 0x2b, 0x85, 0xbe, 0xd4, 0xd6, 0x53, 0x8b, 0x7f, 0x10, 0xcc, 0x84, 0xf2,
-	0x8a, 0x82, 0xbc, 0x5f, 0x5f, 0x90, 0xcb, 0xa1, 0x63, 0x59, 0x43, 0x5b, 0x77, 0xb9, 0x39, 0x62,
-	0xd0, 0x15, 0x89, 0x0b, 0x70, 0xd2, 0x76, 0x48, 0xfb, 0xfc, 0x52, 0xe4, 0x24, 0x09, 0x4b, 0x5c,
-	0xc8, 0xd5, 0xcc, 0xaa, 0x23, 0xd4, 0xbf, 0x32, 0x6f, 0x89, 0x0b, 0x39, 0x90, 0x53, 0x94, 0x38,
-	0x0e, 0x50, 0x1d, 0x5a, 0x5e, 0xe5, 0xe1, 0x8c, 0x67, 0xc5, 0x83, 0x56, 0x81, 0x97, 0xfd, 0x68,
-	0x3b, 0x86, 0xbd, 0xa7, 0x5a, 0x4a, 0x45, 0x10, 0xf7, 0x4d, 0xc6, 0x81, 0x30, 0x6f, 0xcd, 0x89,
-	0x85, 0x96, 0x34, 0x8c, 0x56, 0xc4, 0xa3, 0xb6, 0x89, 0x47, 0x2a, 0x7e, 0x30, 0x0f, 0xef, 0x04,
-	0x8c, 0x33, 0x5e, 0xe7, 0x6d, 0x53, 0x36, 0x0d, 0xfb, 0xf0, 0x26, 0x21, 0x05, 0xd7, 0xb0, 0x3e,
-	0x44, 0x20, 0x05, 0x3d, 0x15, 0xa9, 0x7c, 0x00, 0x83, 0x25, 0x42, 0x0a, 0x7d, 0x3e, 0xc7, 0x8c,
-	0x63, 0xe9, 0x87, 0x28, 0x9c, 0x64, 0xa9, 0xe0, 0x2f, 0x10, 0x0c, 0x71, 0x13, 0x8c, 0xe5, 0xd0,
-	0x93, 0xd2, 0xe8, 0xc0, 0xa5, 0x4b, 0xed, 0x07, 0x70, 0x8d, 0x89, 0xf9, 0x07, 0x3f, 0xfe, 0xfe,
-	0x79, 0xe4, 0x3c, 0x9e, 0x91, 0xc3, 0xbe, 0x30, 0x70, 0x07, 0x8e, 0xff, 0x44, 0x30, 0xde, 0xd4,
-	0x03, 0xe3, 0x54, 0x6b, 0xf2, 0x56, 0xd6, 0x5d, 0x4a, 0xf7, 0x84, 0x21, 0x34, 0xa5, 0x99, 0xa6,
-	0xab, 0x78, 0x35, 0x54, 0x53, 0xed, 0x7c, 0xc8, 0xf7, 0x1a, 0x5e, 0x17, 0xf7, 0xf1, 0xc7, 0x11,
-	0x98, 0x08, 0x31, 0x6c, 0x78, 0xbd, 0x83, 0x4c, 0x9b, 0xda, 0x57, 0x69, 0xa3, 0x47, 0x14, 0xa1,
-	0x78, 0x87, 0x29, 0xbe, 0x85, 0x6f, 0xf4, 0xa0, 0x58, 0x26, 0x35, 0x7c, 0xf7, 0xbb, 0x06, 0x3e,
-	0x42, 0x70, 0x36, 0xc0, 0x0a, 0xe2, 0xd7, 0x3a, 0xc8, 0xbb, 0xc1, 0xb5, 0x4a, 0x57, 0xbb, 0x8c,
-	0x16, 0x6a, 0xb7, 0x99, 0xda, 0x6b, 0x78, 0xb3, 0x17, 0xb5, 0x35, 0x9f, 0x89, 0x7f, 0x42, 0x30,
-	0x5a, 0xef, 0xaa, 0xf0, 0xab, 0x1d, 0xe4, 0xe8, 0xb7, 0xa5, 0xd2, 0x4a, 0x37, 0xa1, 0x42, 0xdb,
-	0x75, 0xa6, 0x6d, 0x03, 0xa7, 0x7b, 0xd1, 0xe6, 0x5a, 0xb7, 0xbf, 0x10, 0x44, 0x1b, 0x9c, 0x0a,
-	0x6e, 0x23, 0xbd, 0x66, 0x0e, 0x4d, 0x5a, 0xed, 0x2a, 0x56, 0x68, 0xcb, 0x32, 0x6d, 0xef, 0xe2,
-	0x9d, 0x50, 0x6d, 0xd5, 0x97, 0x08, 0x95, 0xef, 0x35, 0xbc, 0x83, 0xee, 0xcb, 0x62, 0x32, 0x03,
-	0xcf, 0xec, 0x73, 0x04, 0x2f, 0x06, 0xbb, 0x11, 0xfc, 0x7a, 0x27, 0x89, 0x07, 0xf8, 0x27, 0xe9,
-	0x8d, 0xee, 0x01, 0x3a, 0x6a, 0x6d, 0x7b, 0xf2, 0xd9, 0xc1, 0x0c, 0xb0, 0x04, 0xed, 0x1c, 0xcc,
-	0xe6, 0xee, 0xa5, 0x9d, 0x83, 0x19, 0xe2, 0x43, 0xda, 0x3c, 0x98, 0x2d, 0x14, 0xd6, 0x66, 0x1b,
-	0xff, 0x8b, 0x20, 0xd6, 0xcc, 0x30, 0xe0, 0xb5, 0x0e, 0x72, 0x0d, 0x76, 0x39, 0x52, 0xaa, 0x17,
-	0x08, 0xa1, 0xf9, 0x0e, 0xd3, 0xbc, 0x8d, 0xb7, 0x7a, 0xd1, 0x5c, 0xef, 0x78, 0xf0, 0xb7, 0x08,
-	0xce, 0xf8, 0x4c, 0x09, 0xbe, 0xdc, 0x3a, 0xd7, 0x20, 0x8f, 0x23, 0xbd, 0xd2, 0x71, 0x9c, 0x10,
-	0xb6, 0xcc, 0x84, 0x2d, 0xe0, 0xf9, 0x50, 0x61, 0x79, 0x37, 0x36, 0xeb, 0xd8, 0x98, 0xd4, 0xf5,
-	0x27, 0x47, 0x71, 0xf4, 0xf4, 0x28, 0x8e, 0x7e, 0x3b, 0x8a, 0xa3, 0x4f, 0x8f, 0xe3, 0x03, 0x4f,
-	0x8f, 0xe3, 0x03, 0x3f, 0x1f, 0xc7, 0x07, 0xde, 0x5b, 0x0c, 0x35, 0x46, 0x1f, 0xf9, 0xd1, 0x99,
-	0x4f, 0xca, 0x0d, 0xb1, 0x1f, 0x1b, 0x97, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x2b, 0x1c,
-	0xf2, 0x92, 0x15, 0x00, 0x00,
+	0x17, 0xce, 0xb8, 0x69, 0xfb, 0xeb, 0xeb, 0xaf, 0x24, 0x99, 0x46, 0xe0, 0x6c, 0x52, 0x27, 0x6c,
+	0x9a, 0x26, 0x6a, 0x14, 0x6f, 0x93, 0x48, 0xa5, 0x24, 0x44, 0x60, 0x3b, 0x09, 0xad, 0x12, 0xa5,
+	0x8d, 0x5b, 0x88, 0x00, 0x55, 0xd6, 0xda, 0xbb, 0x71, 0x16, 0xec, 0x1d, 0x67, 0x77, 0x36, 0x26,
+	0xaa, 0x7a, 0x29, 0x97, 0xd2, 0x13, 0x82, 0x0b, 0x47, 0x8e, 0x88, 0x13, 0x87, 0x22, 0x71, 0x83,
+	0x63, 0x8f, 0x55, 0x91, 0x10, 0x27, 0x40, 0x09, 0x12, 0xe5, 0x00, 0x82, 0x13, 0x57, 0xe4, 0x99,
+	0x59, 0x7b, 0xd7, 0x7f, 0xd6, 0xff, 0xe4, 0x4b, 0x6b, 0xcd, 0xce, 0xfb, 0xbe, 0xf7, 0xbd, 0xf7,
+	0x66, 0xf6, 0xdb, 0xc0, 0x74, 0x86, 0xd8, 0x79, 0x62, 0x2b, 0x9a, 0x61, 0x53, 0xcb, 0x48, 0x3b,
+	0xd4, 0x20, 0xa6, 0x72, 0x30, 0x9f, 0xd6, 0xa9, 0x3a, 0xaf, 0xec, 0x3b, 0xba, 0x75, 0x18, 0x2d,
+	0x58, 0x84, 0x12, 0x3c, 0xca, 0x37, 0x46, 0xbd, 0x1b, 0xa3, 0x62, 0xa3, 0x74, 0x59, 0xa0, 0xa4,
+	0x55, 0x5b, 0xe7, 0x51, 0x65, 0x8c, 0x82, 0x9a, 0x35, 0x4c, 0x95, 0xed, 0x66, 0x40, 0xd2, 0x70,
+	0x96, 0x64, 0x09, 0xfb, 0xa9, 0x94, 0x7e, 0x89, 0xd5, 0xb1, 0x2c, 0x21, 0xd9, 0x9c, 0xae, 0xa8,
+	0x05, 0x43, 0x51, 0x4d, 0x93, 0x50, 0x16, 0x62, 0x8b, 0xa7, 0x11, 0x2f, 0xbe, 0x8b, 0x9c, 0x21,
+	0x86, 0x8b, 0x19, 0x0d, 0x52, 0xe1, 0xcb, 0x98, 0xef, 0x1f, 0xe1, 0xfb, 0x53, 0x3c, 0x0d, 0xa1,
+	0x8c, 0x3f, 0x1a, 0x52, 0xf3, 0x86, 0x49, 0x14, 0xf6, 0x2f, 0x5f, 0x92, 0x87, 0x01, 0x6f, 0x97,
+	0x34, 0xdd, 0x52, 0x2d, 0x35, 0x6f, 0x27, 0xf5, 0x7d, 0x47, 0xb7, 0xa9, 0x7c, 0x17, 0xce, 0xfb,
+	0x56, 0xed, 0x02, 0x31, 0x6d, 0x1d, 0xaf, 0xc3, 0xa9, 0x02, 0x5b, 0x09, 0xa3, 0x09, 0x34, 0x73,
+	0x76, 0x61, 0x32, 0x1a, 0x50, 0xb8, 0x28, 0x0f, 0x8e, 0x9f, 0x79, 0xf2, 0xf3, 0x78, 0xdf, 0x97,
+	0xbf, 0x7f, 0x7d, 0x19, 0x25, 0x45, 0xb4, 0x5c, 0x84, 0x29, 0x06, 0xff, 0xb6, 0x9a, 0x33, 0x34,
+	0x95, 0x12, 0x6b, 0xd5, 0x13, 0x7f, 0xc3, 0xdc, 0x25, 0x22, 0x0f, 0xbc, 0x05, 0x43, 0x07, 0xee,
+	0x9e, 0x94, 0xaa, 0x69, 0x96, 0x6e, 0x73, 0xee, 0x33, 0xf1, 0x97, 0x9f, 0x3d, 0x9e, 0xbb, 0x20,
+	0xe8, 0xcb, 0x38, 0x31, 0xbe, 0xe5, 0x36, 0xb5, 0x0c, 0x33, 0x9b, 0x1c, 0x3c, 0xa8, 0x5a, 0x97,
+	0xff, 0x0a, 0xc1, 0xa5, 0x66, 0xcc, 0x42, 0xeb, 0x26, 0x0c, 0x92, 0x82, 0x6e, 0x75, 0xc6, 0x3c,
+	0xe0, 0x86, 0x8a, 0x65, 0xfc, 0x00, 0xc1, 0x90, 0xad, 0xe7, 0x76, 0x53, 0x69, 0x62, 0x6a, 0x29,
+	0x4b, 0x2f, 0xaa, 0x96, 0x66, 0x87, 0x43, 0x13, 0x27, 0x66, 0xce, 0x2e, 0x8c, 0xb9, 0x55, 0x2c,
+	0x4d, 0x40, 0xb9, 0x7a, 0xab, 0x7a, 0x26, 0x41, 0x0c, 0x33, 0x7e, 0xad, 0x54, 0xbe, 0xaf, 0x7e,
+	0x19, 0x9f, 0xcd, 0x1a, 0x74, 0xcf, 0x49, 0x47, 0x33, 0x24, 0x2f, 0x9a, 0x2a, 0xfe, 0x9b, 0xb3,
+	0xb5, 0x0f, 0x14, 0x7a, 0x58, 0xd0, 0x6d, 0x37, 0xc6, 0xe6, 0xd5, 0x1e, 0x28, 0x11, 0xc6, 0x89,
+	0xa9, 0x25, 0x39, 0x1d, 0xde, 0x07, 0xc8, 0x90, 0x7c, 0xde, 0xb0, 0x6d, 0x83, 0x98, 0xe1, 0x13,
+	0x2d, 0x90, 0x2f, 0x76, 0x40, 0x9e, 0xf4, 0x90, 0xc8, 0x87, 0x30, 0xed, 0xaf, 0xf7, 0x4d, 0x87,
+	0xda, 0x54, 0x35, 0xb5, 0x52, 0x95, 0x78, 0x5a, 0xbd, 0xea, 0xf5, 0xc7, 0x08, 0x66, 0x9a, 0x73,
+	0x8b, 0x6e, 0xdf, 0x85, 0xd3, 0x6e, 0x53, 0xf8, 0x68, 0x5f, 0x0b, 0x1c, 0xed, 0x00, 0x48, 0xef,
+	0xbc, 0xbb, 0x98, 0xf2, 0x3e, 0x8c, 0xfb, 0x53, 0x49, 0x94, 0x4b, 0xd4, 0x2b, 0xf9, 0x8f, 0x10,
+	0x4c, 0x34, 0xe6, 0x14, 0xb2, 0x77, 0x7d, 0x13, 0xc1, 0x95, 0x2f, 0xb7, 0xa6, 0x3c, 0x96, 0xc9,
+	0x38, 0x79, 0x27, 0xa7, 0x52, 0x5d, 0xab, 0x00, 0x7b, 0xc5, 0x7b, 0xc7, 0xe0, 0x51, 0x08, 0xc6,
+	0xfc, 0xc9, 0xdc, 0xce, 0xa9, 0xf6, 0x9e, 0xde, 0xab, 0xe6, 0xe3, 0x69, 0x18, 0xb0, 0xa9, 0x6a,
+	0x51, 0xc3, 0xcc, 0xa6, 0xf6, 0x74, 0x23, 0xbb, 0x47, 0xc3, 0xa1, 0x09, 0x34, 0xd3, 0x9f, 0x7c,
+	0xc1, 0x5d, 0xbe, 0xce, 0x56, 0xf1, 0x24, 0x9c, 0xd3, 0x4d, 0xcd, 0xb3, 0xed, 0x04, 0xdb, 0xf6,
+	0x7f, 0xbe, 0x28, 0x36, 0xad, 0x03, 0x54, 0xae, 0xfa, 0x70, 0x3f, 0x2b, 0xd3, 0x25, 0xdf, 0xc1,
+	0xe1, 0x6f, 0x93, 0xca, 0xcd, 0x97, 0xd5, 0x85, 0xb2, 0xa4, 0x27, 0x72, 0xa9, 0xff, 0xe1, 0x17,
+	0xe3, 0x7d, 0xf2, 0x77, 0x08, 0x2e, 0x34, 0x28, 0x86, 0x68, 0xcb, 0x5b, 0x70, 0xda, 0xe6, 0x4b,
+	0x61, 0xc4, 0x4e, 0xe9, 0x95, 0xd6, 0x7a, 0xc2, 0x70, 0xd6, 0x0e, 0x74, 0x93, 0xfa, 0xa6, 0x50,
+	0x60, 0xe1, 0x37, 0x7d, 0x32, 0x42, 0x4c, 0xc6, 0x74, 0x53, 0x19, 0x3c, 0x27, 0xaf, 0x0e, 0xf9,
+	0x7b, 0x57, 0xc1, 0xaa, 0x9e, 0xd3, 0xb3, 0x6c, 0xad, 0xea, 0x30, 0xaf, 0xc1, 0x90, 0xc6, 0x9f,
+	0xd5, 0xf4, 0x33, 0xfc, 0xec, 0xf1, 0xdc, 0xb0, 0x20, 0xad, 0x6a, 0x63, 0x39, 0xc4, 0x6d, 0x63,
+	0xdd, 0xb1, 0x08, 0x75, 0x3c, 0x16, 0x4b, 0xff, 0x2b, 0x35, 0xe0, 0x79, 0xa9, 0x09, 0x9f, 0x22,
+	0x88, 0x34, 0x92, 0x20, 0xba, 0x50, 0xf0, 0xde, 0x09, 0xbd, 0xbc, 0xa8, 0xcb, 0xd7, 0x84, 0x03,
+	0x72, 0x55, 0x4e, 0x77, 0x08, 0x55, 0x73, 0x3d, 0xa9, 0xad, 0xa7, 0x16, 0x7f, 0x23, 0x98, 0x0c,
+	0xe4, 0x15, 0x05, 0x79, 0xaf, 0xba, 0x20, 0x57, 0x03, 0xc7, 0xb2, 0x82, 0xb6, 0xea, 0x72, 0x73,
+	0xc4, 0x7a, 0x57, 0x24, 0xce, 0xc1, 0x49, 0x5a, 0x22, 0xed, 0xf1, 0x4b, 0x91, 0x93, 0xc8, 0x96,
+	0xb8, 0x90, 0xcb, 0x99, 0x95, 0x47, 0xa8, 0x77, 0x65, 0xde, 0x84, 0x89, 0xc6, 0x9c, 0xa2, 0xc4,
+	0x11, 0x80, 0xf2, 0xd0, 0xf2, 0x2a, 0x9f, 0x49, 0x7a, 0x56, 0x3c, 0x68, 0x45, 0xb8, 0xe8, 0x47,
+	0xdb, 0x31, 0xe8, 0x9e, 0x66, 0xa9, 0x45, 0x41, 0xdc, 0x33, 0x19, 0x07, 0x30, 0xd5, 0x84, 0x58,
+	0x68, 0x49, 0xc0, 0x60, 0x51, 0x3c, 0x6a, 0x99, 0x78, 0xa0, 0xe8, 0x07, 0xf3, 0xf1, 0xf2, 0x21,
+	0x8d, 0x39, 0x94, 0x24, 0x48, 0xbe, 0x40, 0x1c, 0x53, 0xab, 0x8c, 0x58, 0xef, 0xf4, 0x1a, 0x70,
+	0x31, 0x98, 0x57, 0xc8, 0x8d, 0xd5, 0xb6, 0xae, 0x95, 0x4b, 0xca, 0x13, 0x24, 0x8f, 0xc2, 0x08,
+	0xa3, 0x2a, 0xbd, 0x50, 0x1d, 0xd3, 0xa0, 0x87, 0xb7, 0x08, 0xc9, 0xb9, 0x9e, 0xfc, 0x21, 0x02,
+	0xa9, 0xde, 0x53, 0x41, 0xff, 0x3e, 0xf4, 0x17, 0x08, 0xc9, 0xf5, 0xf8, 0xaa, 0x62, 0x1c, 0x0b,
+	0xdf, 0x9e, 0x87, 0x93, 0x2c, 0x15, 0xfc, 0x39, 0x82, 0x53, 0xdc, 0xe7, 0x63, 0x25, 0xf0, 0x32,
+	0xa8, 0xfd, 0xc8, 0x90, 0xae, 0xb4, 0x1e, 0xc0, 0x35, 0xca, 0xb3, 0x0f, 0x7e, 0xf8, 0xed, 0xb3,
+	0xd0, 0x14, 0x9e, 0x54, 0x82, 0xbe, 0x89, 0xf8, 0x47, 0x06, 0xfe, 0x03, 0xc1, 0x48, 0x43, 0x9b,
+	0x8f, 0xe3, 0xcd, 0xc9, 0x9b, 0x7d, 0x9d, 0x48, 0x89, 0xae, 0x30, 0x84, 0xa6, 0x04, 0xd3, 0xb4,
+	0x82, 0x97, 0x03, 0x35, 0x55, 0x86, 0x44, 0xb9, 0x57, 0xf3, 0x46, 0xbc, 0x8f, 0x3f, 0x0a, 0xc1,
+	0x68, 0x80, 0x27, 0xc5, 0xab, 0x6d, 0x64, 0xda, 0xd0, 0xa1, 0x4b, 0x6b, 0x5d, 0xa2, 0x08, 0xc5,
+	0x3b, 0x4c, 0xf1, 0x36, 0xbe, 0xd9, 0x85, 0x62, 0x85, 0x54, 0xf0, 0xdd, 0xcf, 0x29, 0x7c, 0x84,
+	0xe0, 0x7c, 0x1d, 0xb7, 0x8b, 0x5f, 0x6b, 0x23, 0xef, 0x1a, 0x63, 0x2e, 0xad, 0x74, 0x18, 0x2d,
+	0xd4, 0x6e, 0x31, 0xb5, 0xd7, 0xf1, 0x7a, 0x37, 0x6a, 0x2b, 0x56, 0x1a, 0xff, 0x88, 0x60, 0xb0,
+	0xda, 0x38, 0xe2, 0x57, 0xdb, 0xc8, 0xd1, 0xef, 0xbc, 0xa5, 0xa5, 0x4e, 0x42, 0x85, 0xb6, 0x0d,
+	0xa6, 0x6d, 0x0d, 0x27, 0xba, 0xd1, 0xe6, 0xba, 0xd3, 0x3f, 0x11, 0x0c, 0xd5, 0x98, 0x31, 0xdc,
+	0x42, 0x7a, 0x8d, 0x4c, 0xa8, 0xb4, 0xdc, 0x51, 0xac, 0xd0, 0x96, 0x62, 0xda, 0xde, 0xc1, 0x3b,
+	0x81, 0xda, 0xca, 0xef, 0x0d, 0x5b, 0xb9, 0x57, 0xf3, 0xda, 0xb9, 0xaf, 0x88, 0xc9, 0xac, 0x7b,
+	0x66, 0x9f, 0x23, 0x78, 0xb1, 0xbe, 0xe1, 0xc2, 0xaf, 0xb7, 0x93, 0x78, 0x1d, 0x8b, 0x28, 0xbd,
+	0xd1, 0x39, 0x40, 0x5b, 0xad, 0x6d, 0x4d, 0x3e, 0x3b, 0x98, 0x75, 0x5c, 0x4f, 0x2b, 0x07, 0xb3,
+	0xb1, 0x41, 0x93, 0x56, 0x3a, 0x8c, 0x6e, 0xeb, 0x60, 0x36, 0x51, 0x58, 0x99, 0x6d, 0xfc, 0x0f,
+	0x82, 0x97, 0x1a, 0x78, 0x04, 0xdc, 0x42, 0x3f, 0x82, 0x6d, 0x8d, 0x14, 0xeb, 0x02, 0x41, 0x08,
+	0xde, 0x66, 0x82, 0x37, 0xf0, 0x8d, 0x6e, 0x04, 0xab, 0x0e, 0x25, 0xa9, 0x8c, 0x60, 0xc1, 0xff,
+	0x22, 0x08, 0x37, 0xf2, 0x81, 0x38, 0xd6, 0x46, 0x7f, 0xea, 0x9b, 0x57, 0x29, 0xde, 0x0d, 0x84,
+	0x90, 0x7d, 0x87, 0xc9, 0xde, 0xc2, 0x9b, 0xdd, 0xc8, 0xae, 0x36, 0xb2, 0xf8, 0x1b, 0x04, 0xe7,
+	0x7c, 0x46, 0x0c, 0x5f, 0x6d, 0x9e, 0x6b, 0x3d, 0x5f, 0x27, 0xbd, 0xd2, 0x76, 0x9c, 0x10, 0xb6,
+	0xc8, 0x84, 0xcd, 0xe1, 0xd9, 0x40, 0x61, 0x19, 0x37, 0x36, 0x55, 0xb2, 0x6e, 0xf1, 0x8d, 0x27,
+	0x47, 0x11, 0xf4, 0xf4, 0x28, 0x82, 0x7e, 0x3d, 0x8a, 0xa0, 0x4f, 0x8e, 0x23, 0x7d, 0x4f, 0x8f,
+	0x23, 0x7d, 0x3f, 0x1d, 0x47, 0xfa, 0xde, 0x9d, 0x0f, 0x34, 0x83, 0x1f, 0xfa, 0xd1, 0x99, 0x37,
+	0x4c, 0x9f, 0x62, 0x7f, 0x43, 0x5e, 0xfc, 0x6f, 0x00, 0xdd, 0x2c, 0xc8, 0x52, 0x69, 0x17, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1092,6 +1186,9 @@ type QueryClient interface {
 	DelegationTotalRewards(ctx context.Context, in *QueryDelegationTotalRewardsRequest, opts ...grpc.CallOption) (*QueryDelegationTotalRewardsResponse, error)
 	// DelegatorValidators queries the validators of a delegator.
 	DelegatorValidators(ctx context.Context, in *QueryDelegatorValidatorsRequest, opts ...grpc.CallOption) (*QueryDelegatorValidatorsResponse, error)
+	// AutoCompoundDelegations queries the auto-compounded delegations of a
+	// delegator.
+	AutoCompoundDelegations(ctx context.Context, in *QueryAutoCompoundDelegationsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundDelegationsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
@@ -1178,6 +1275,15 @@ func (c *queryClient) DelegatorValidators(ctx context.Context, in *QueryDelegato
 	return out, nil
 }
 
+func (c *queryClient) AutoCompoundDelegations(ctx context.Context, in *QueryAutoCompoundDelegationsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundDelegationsResponse, error) {
+	out := new(QueryAutoCompoundDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/AutoCompoundDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error) {
 	out := new(QueryDelegatorWithdrawAddressResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress", in, out, opts...)
@@ -1215,6 +1321,9 @@ type QueryServer interface {
 	DelegationTotalRewards(context.Context, *QueryDelegationTotalRewardsRequest) (*QueryDelegationTotalRewardsResponse, error)
 	// DelegatorValidators queries the validators of a delegator.
 	DelegatorValidators(context.Context, *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error)
+	// AutoCompoundDelegations queries the auto-compounded delegations of a
+	// delegator.
+	AutoCompoundDelegations(context.Context, *QueryAutoCompoundDelegationsRequest) (*QueryAutoCompoundDelegationsResponse, error)
 	// DelegatorWithdrawAddress queries withdraw address of a delegator.
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
@@ -1249,6 +1358,9 @@ func (*UnimplementedQueryServer) DelegationTotalRewards(ctx context.Context, req
 func (*UnimplementedQueryServer) DelegatorValidators(ctx context.Context, req *QueryDelegatorValidatorsRequest) (*QueryDelegatorValidatorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorValidators not implemented")
 }
+func (*UnimplementedQueryServer) AutoCompoundDelegations(ctx context.Context, req *QueryAutoCompoundDelegationsRequest) (*QueryAutoCompoundDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompoundDelegations not implemented")
+}
 func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, req *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddress not implemented")
 }
@@ -1404,6 +1516,24 @@ func _Query_DelegatorValidators_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AutoCompoundDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompoundDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/AutoCompoundDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompoundDelegations(ctx, req.(*QueryAutoCompoundDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_DelegatorWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDelegatorWithdrawAddressRequest)
 	if err := dec(in); err != nil {
@@ -1476,6 +1606,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegatorValidators",
 			Handler:    _Query_DelegatorValidators_Handler,
 		},
+		{
+			MethodName: "AutoCompoundDelegations",
+			Handler:    _Query_AutoCompoundDelegations_Handler,
+		},
 		{
 			MethodName: "DelegatorWithdrawAddress",
 			Handler:    _Query_DelegatorWithdrawAddress_Handler,
@@ -2137,6 +2271,68 @@ func (m *QueryDelegatorWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAutoCompoundDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryCommunityPoolRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2476,6 +2672,34 @@ func (m *QueryDelegatorWithdrawAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAutoCompoundDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoCompoundDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryCommunityPoolRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4203,6 +4427,170 @@ func (m *QueryDelegatorWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAutoCompoundDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCompoundDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryCommunityPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,6 +14,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
+const (
+	FlagType      = "type"
+	FlagValidator = "validator"
+)
+
 // GetQueryCmd returns the CLI command with all evidence module query commands
 // mounted.
 func GetQueryCmd() *cobra.Command {
@@ -21,13 +26,17 @@ func GetQueryCmd() *cobra.Command {
 		Use:   types.ModuleName,
 		Short: "Query for evidence by hash or for all (paginated) submitted evidence",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query for specific submitted evidence by hash or query for all (paginated) evidence:
+			fmt.Sprintf(`Query for specific submitted evidence by hash or query for all (paginated) evidence,
+optionally filtered by type or by validator:
 
 Example:
 $ %s query %s DF0C23E8634E480F84B9D5674A7CDC9816466DEC28A3358F73260F68D28D7660
 $ %s query %s --page=2 --limit=50
+$ %s query %s --type=equivocation
+$ %s query %s --validator=cosmosvalcons1...
 `,
 				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
 			),
 		),
 		Args:                       cobra.MaximumNArgs(1),
@@ -35,6 +44,8 @@ $ %s query %s --page=2 --limit=50
 		RunE:                       QueryEvidenceCmd(),
 	}
 
+	cmd.Flags().String(FlagType, "", "Filter evidence by type route, e.g. equivocation")
+	cmd.Flags().String(FlagValidator, "", "Filter evidence by validator consensus address")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "evidence")
 
@@ -58,7 +69,26 @@ func QueryEvidenceCmd() func(*cobra.Command, []string) error {
 			return err
 		}
 
-		return queryAllEvidence(clientCtx, pageReq)
+		route, err := cmd.Flags().GetString(FlagType)
+		if err != nil {
+			return err
+		}
+
+		consAddr, err := cmd.Flags().GetString(FlagValidator)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case route != "" && consAddr != "":
+			return fmt.Errorf("--%s and --%s flags are mutually exclusive", FlagType, FlagValidator)
+		case route != "":
+			return queryEvidenceByType(clientCtx, route, pageReq)
+		case consAddr != "":
+			return queryEvidenceByValidator(clientCtx, consAddr, pageReq)
+		default:
+			return queryAllEvidence(clientCtx, pageReq)
+		}
 	}
 }
 
@@ -90,3 +120,27 @@ func queryAllEvidence(clientCtx client.Context, pageReq *query.PageRequest) erro
 
 	return clientCtx.PrintProto(res)
 }
+
+// queryEvidenceByType returns all evidences of the given type.
+func queryEvidenceByType(clientCtx client.Context, route string, pageReq *query.PageRequest) error {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	res, err := queryClient.EvidenceByType(context.Background(), types.NewQueryEvidenceByTypeRequest(route, pageReq))
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// queryEvidenceByValidator returns all evidences against the given validator.
+func queryEvidenceByValidator(clientCtx client.Context, consAddr string, pageReq *query.PageRequest) error {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	res, err := queryClient.EvidenceByValidator(context.Background(), types.NewQueryEvidenceByValidatorRequest(consAddr, pageReq))
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
@@ -54,6 +54,30 @@ func TestGetQueryCmd(t *testing.T) {
 			`{"evidence":[],"pagination":null}`,
 			"",
 		},
+		"evidence by type": {
+			[]string{
+				fmt.Sprintf("--%s=equivocation", cli.FlagType),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			`{"evidence":[],"pagination":null}`,
+			"",
+		},
+		"evidence by validator": {
+			[]string{
+				fmt.Sprintf("--%s=cosmosvalcons1...", cli.FlagValidator),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			`{"evidence":[],"pagination":null}`,
+			"",
+		},
+		"type and validator": {
+			[]string{
+				fmt.Sprintf("--%s=equivocation", cli.FlagType),
+				fmt.Sprintf("--%s=cosmosvalcons1...", cli.FlagValidator),
+			},
+			"",
+			"mutually exclusive",
+		},
 	}
 
 	for name, tc := range testCases {
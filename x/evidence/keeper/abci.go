@@ -13,7 +13,8 @@ import (
 )
 
 // BeginBlocker iterates through and handles any newly discovered evidence of
-// misbehavior submitted by CometBFT. Currently, only equivocation is handled.
+// misbehavior submitted by CometBFT, i.e. duplicate votes and light client
+// attacks.
 func (k Keeper) BeginBlocker(ctx context.Context) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
@@ -28,10 +29,16 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	for i := 0; i < evidences.Len(); i++ {
 		switch evidences.Get(i).Type() {
+		case comet.DuplicateVote:
+			evidence := types.FromABCIEvidence(evidences.Get(i))
+			err := k.handleEquivocationEvidence(ctx, evidence)
+			if err != nil {
+				return err
+			}
 		// It's still ongoing discussion how should we treat and slash attacks with
 		// premeditation. So for now we agree to treat them in the same way.
-		case comet.LightClientAttack, comet.DuplicateVote:
-			evidence := types.FromABCIEvidence(evidences.Get(i))
+		case comet.LightClientAttack:
+			evidence := types.FromABCILightClientAttack(evidences.Get(i))
 			err := k.handleEquivocationEvidence(ctx, evidence)
 			if err != nil {
 				return err
@@ -82,3 +82,67 @@ func (k Querier) AllEvidence(ctx context.Context, req *types.QueryAllEvidenceReq
 
 	return &types.QueryAllEvidenceResponse{Evidence: evidence, Pagination: pageRes}, nil
 }
+
+// EvidenceByType implements the Query/EvidenceByType gRPC method
+func (k Querier) EvidenceByType(ctx context.Context, req *types.QueryEvidenceByTypeRequest) (*types.QueryEvidenceByTypeResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Route == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request; route is empty")
+	}
+
+	evidence, pageRes, err := k.filterEvidence(ctx, req.Pagination, func(e exported.Evidence) bool {
+		return e.Route() == req.Route
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEvidenceByTypeResponse{Evidence: evidence, Pagination: pageRes}, nil
+}
+
+// EvidenceByValidator implements the Query/EvidenceByValidator gRPC method
+func (k Querier) EvidenceByValidator(ctx context.Context, req *types.QueryEvidenceByValidatorRequest) (*types.QueryEvidenceByValidatorResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsensusAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid consensus address: %s", err)
+	}
+
+	evidence, pageRes, err := k.filterEvidence(ctx, req.Pagination, func(e exported.Evidence) bool {
+		ve, ok := e.(exported.ValidatorEvidence)
+		return ok && ve.GetConsensusAddress().Equals(consAddr)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEvidenceByValidatorResponse{Evidence: evidence, Pagination: pageRes}, nil
+}
+
+// filterEvidence returns the paginated evidence matching the given filter.
+func (k Querier) filterEvidence(ctx context.Context, pageReq *query.PageRequest, filter func(exported.Evidence) bool) ([]*codectypes.Any, *query.PageResponse, error) {
+	var evidence []*codectypes.Any
+	_, pageRes, err := query.CollectionFilteredPaginate(ctx, k.k.Evidences, pageReq, func(_ []byte, value exported.Evidence) (include bool, err error) {
+		if !filter(value) {
+			return false, nil
+		}
+
+		evidenceAny, err := codectypes.NewAnyWithValue(value)
+		if err != nil {
+			return false, err
+		}
+		evidence = append(evidence, evidenceAny)
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return evidence, pageRes, nil
+}
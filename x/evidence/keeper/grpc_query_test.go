@@ -156,3 +156,126 @@ func (suite *KeeperTestSuite) TestQueryAllEvidence() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryEvidenceByType() {
+	var req *types.QueryEvidenceByTypeRequest
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expPass   bool
+		posttests func(res *types.QueryEvidenceByTypeResponse)
+	}{
+		{
+			"invalid request with empty route",
+			func() {
+				req = &types.QueryEvidenceByTypeRequest{}
+			},
+			false,
+			func(res *types.QueryEvidenceByTypeResponse) {},
+		},
+		{
+			"success without evidence of the type",
+			func() {
+				_ = suite.populateEvidence(suite.ctx, 10)
+				req = types.NewQueryEvidenceByTypeRequest(types.RouteLightClientAttack, nil)
+			},
+			true,
+			func(res *types.QueryEvidenceByTypeResponse) {
+				suite.Require().Empty(res.Evidence)
+			},
+		},
+		{
+			"success",
+			func() {
+				_ = suite.populateEvidence(suite.ctx, 100)
+				pageReq := &query.PageRequest{
+					Limit: 50,
+				}
+				req = types.NewQueryEvidenceByTypeRequest(types.RouteEquivocation, pageReq)
+			},
+			true,
+			func(res *types.QueryEvidenceByTypeResponse) {
+				suite.Equal(50, len(res.Evidence))
+				suite.NotNil(res.Pagination.NextKey)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+
+			tc.malleate()
+			res, err := suite.queryClient.EvidenceByType(suite.ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+
+			tc.posttests(res)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryEvidenceByValidator() {
+	var (
+		req      *types.QueryEvidenceByValidatorRequest
+		evidence []exported.Evidence
+	)
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expPass   bool
+		posttests func(res *types.QueryEvidenceByValidatorResponse)
+	}{
+		{
+			"invalid consensus address",
+			func() {
+				req = types.NewQueryEvidenceByValidatorRequest("invalid", nil)
+			},
+			false,
+			func(res *types.QueryEvidenceByValidatorResponse) {},
+		},
+		{
+			"success",
+			func() {
+				evidence = suite.populateEvidence(suite.ctx, 10)
+				consAddr := evidence[0].(exported.ValidatorEvidence).GetConsensusAddress()
+				req = types.NewQueryEvidenceByValidatorRequest(consAddr.String(), nil)
+			},
+			true,
+			func(res *types.QueryEvidenceByValidatorResponse) {
+				suite.Require().Len(res.Evidence, 1)
+
+				var evi exported.Evidence
+				suite.Require().NoError(suite.encCfg.InterfaceRegistry.UnpackAny(res.Evidence[0], &evi))
+				suite.Require().Equal(evidence[0], evi)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+
+			tc.malleate()
+			res, err := suite.queryClient.EvidenceByValidator(suite.ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+
+			tc.posttests(res)
+		})
+	}
+}
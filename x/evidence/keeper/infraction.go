@@ -2,29 +2,63 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/evidence/exported"
 	"cosmossdk.io/x/evidence/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+// doubleSignEvidence defines the evidence of a validator signing conflicting
+// blocks, i.e. an Equivocation or a LightClientAttack, punished as a double sign.
+type doubleSignEvidence interface {
+	exported.ValidatorEvidence
+
+	GetTime() time.Time
+}
+
+// asDoubleSignEvidence returns the given evidence as a doubleSignEvidence if it
+// is punished as a double sign.
+func asDoubleSignEvidence(e exported.Evidence) (doubleSignEvidence, bool) {
+	switch e := e.(type) {
+	case *types.Equivocation:
+		return e, true
+	case *types.LightClientAttack:
+		return e, true
+	default:
+		return nil, false
+	}
+}
+
 // HandleEquivocationEvidence implements an equivocation evidence handler. Assuming the
 // evidence is valid, the validator committing the misbehavior will be slashed,
 // jailed and tombstoned. Once tombstoned, the validator will not be able to
 // recover. Note, the evidence contains the block time and height at the time of
-// the equivocation.
+// the equivocation. Validators taking part in a light client attack are
+// punished the same way.
+//
+// When the x/slashing DoubleSignJailDuration param is set, the validator is
+// jailed for that duration instead of being tombstoned. When the
+// CorrelatedSlashWindow param is set, the slash fraction scales with the total
+// voting power which equivocated within the window, see doubleSignSlashFraction.
 //
 // The evidence is considered invalid if:
 // - the evidence is too old
 // - the validator is unbonded or does not exist
 // - the signing info does not exist (will panic)
 // - is already tombstoned
+// - the validator was already slashed for a double sign committed during the
+// same bonding period, when validators are not tombstoned
 //
 // TODO: Some of the invalid constraints listed above may need to be reconsidered
 // in the case of a lunatic attack.
-func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.Equivocation) error {
+func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence doubleSignEvidence) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	logger := k.Logger(sdkCtx)
 	consAddr := evidence.GetConsensusAddress()
@@ -52,28 +86,20 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 		}
 	}
 
-	// calculate the age of the evidence
+	// Reject evidence if the double-sign is too old.
 	infractionHeight := evidence.GetHeight()
 	infractionTime := evidence.GetTime()
-	ageDuration := sdkCtx.BlockHeader().Time.Sub(infractionTime)
-	ageBlocks := sdkCtx.BlockHeader().Height - infractionHeight
-
-	// Reject evidence if the double-sign is too old. Evidence is considered stale
-	// if the difference in time and number of blocks is greater than the allowed
-	// parameters defined.
-	cp := sdkCtx.ConsensusParams()
-	if cp.Evidence != nil {
-		if ageDuration > cp.Evidence.MaxAgeDuration && ageBlocks > cp.Evidence.MaxAgeNumBlocks {
-			logger.Info(
-				"ignored equivocation; evidence too old",
-				"validator", consAddr,
-				"infraction_height", infractionHeight,
-				"max_age_num_blocks", cp.Evidence.MaxAgeNumBlocks,
-				"infraction_time", infractionTime,
-				"max_age_duration", cp.Evidence.MaxAgeDuration,
-			)
-			return nil
-		}
+	if isExpired(sdkCtx, infractionHeight, infractionTime) {
+		cp := sdkCtx.ConsensusParams()
+		logger.Info(
+			"ignored equivocation; evidence too old",
+			"validator", consAddr,
+			"infraction_height", infractionHeight,
+			"max_age_num_blocks", cp.Evidence.MaxAgeNumBlocks,
+			"infraction_time", infractionTime,
+			"max_age_duration", cp.Evidence.MaxAgeDuration,
+		)
+		return nil
 	}
 
 	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
@@ -91,6 +117,30 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 		return nil
 	}
 
+	doubleSignJailDuration, err := k.slashingKeeper.DoubleSignJailDuration(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Without tombstoning, ignore the evidence if the validator was already
+	// punished for the same bonding period.
+	if doubleSignJailDuration > 0 {
+		punished, err := k.isDoubleSignPunished(ctx, evidence)
+		if err != nil {
+			return err
+		}
+
+		if punished {
+			logger.Info(
+				"ignored equivocation; validator already slashed for double signing",
+				"validator", consAddr,
+				"infraction_height", infractionHeight,
+				"infraction_time", infractionTime,
+			)
+			return nil
+		}
+	}
+
 	logger.Info(
 		"confirmed equivocation",
 		"validator", consAddr,
@@ -110,7 +160,7 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 	// to/by CometBFT. This value is validator.Tokens as sent to CometBFT via
 	// ABCI, and now received as evidence. The fraction is passed in to separately
 	// to slash unbonding and rebonding delegations.
-	slashFractionDoubleSign, err := k.slashingKeeper.SlashFractionDoubleSign(ctx)
+	slashFractionDoubleSign, err := k.doubleSignSlashFraction(ctx, evidence)
 	if err != nil {
 		return err
 	}
@@ -135,6 +185,15 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 		}
 	}
 
+	if doubleSignJailDuration > 0 {
+		err = k.slashingKeeper.JailUntil(ctx, consAddr, sdkCtx.BlockHeader().Time.Add(doubleSignJailDuration))
+		if err != nil {
+			return err
+		}
+
+		return k.Evidences.Set(ctx, evidence.Hash(), evidence)
+	}
+
 	err = k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
 	if err != nil {
 		return err
@@ -146,3 +205,96 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 	}
 	return k.Evidences.Set(ctx, evidence.Hash(), evidence)
 }
+
+// doubleSignSlashFraction returns the fraction of the stake slashed for the
+// given equivocation. When the correlated penalty is enabled, the fraction is
+// the CorrelatedSlashMultiplier times the fraction of the total voting power
+// which equivocated within CorrelatedSlashWindow blocks of the infraction,
+// including the misbehaving validator. The fraction is bounded by
+// SlashFractionDoubleSign and 1.
+//
+// NOTE: only the equivocations already handled are taken into account, so the
+// validators which equivocated first within a window are slashed less.
+func (k Keeper) doubleSignSlashFraction(ctx context.Context, evidence doubleSignEvidence) (math.LegacyDec, error) {
+	slashFraction, err := k.slashingKeeper.SlashFractionDoubleSign(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	window, err := k.slashingKeeper.CorrelatedSlashWindow(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if window == 0 {
+		return slashFraction, nil
+	}
+
+	multiplier, err := k.slashingKeeper.CorrelatedSlashMultiplier(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	totalPower := k.stakingKeeper.GetLastTotalPower(sdk.UnwrapSDKContext(ctx))
+	if !totalPower.IsPositive() {
+		return slashFraction, nil
+	}
+
+	// count the power of each validator which equivocated within the window once
+	height := evidence.GetHeight()
+	powers := map[string]int64{evidence.GetConsensusAddress().String(): evidence.GetValidatorPower()}
+	err = k.Evidences.Walk(ctx, nil, func(_ []byte, e exported.Evidence) (stop bool, err error) {
+		doubleSign, ok := asDoubleSignEvidence(e)
+		if !ok || doubleSign.GetHeight() < height-window || doubleSign.GetHeight() > height+window {
+			return false, nil
+		}
+
+		consAddr := doubleSign.GetConsensusAddress().String()
+		if doubleSign.GetValidatorPower() > powers[consAddr] {
+			powers[consAddr] = doubleSign.GetValidatorPower()
+		}
+
+		return false, nil
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return math.LegacyDec{}, err
+	}
+
+	equivocatedPower := math.ZeroInt()
+	for _, power := range powers {
+		equivocatedPower = equivocatedPower.AddRaw(power)
+	}
+
+	correlatedFraction := multiplier.MulInt(equivocatedPower).QuoInt(totalPower)
+	return math.LegacyMinDec(math.LegacyOneDec(), math.LegacyMaxDec(slashFraction, correlatedFraction)), nil
+}
+
+// isDoubleSignPunished returns true if the validator was already slashed for
+// an equivocation committed during the same bonding period as the given one,
+// i.e. both equivocations were committed either before or after the validator
+// was last bonded. It replaces tombstoning when validators are only jailed for
+// double signing, so that they are slashed at most once per bonding period.
+func (k Keeper) isDoubleSignPunished(ctx context.Context, evidence doubleSignEvidence) (bool, error) {
+	signingInfo, err := k.slashingKeeper.GetValidatorSigningInfo(ctx, evidence.GetConsensusAddress())
+	if err != nil {
+		return false, err
+	}
+
+	startHeight := signingInfo.StartHeight
+	punished := false
+
+	err = k.Evidences.Walk(ctx, nil, func(_ []byte, e exported.Evidence) (stop bool, err error) {
+		doubleSign, ok := asDoubleSignEvidence(e)
+		if !ok || !doubleSign.GetConsensusAddress().Equals(evidence.GetConsensusAddress()) {
+			return false, nil
+		}
+
+		punished = (doubleSign.GetHeight() >= startHeight) == (evidence.GetHeight() >= startHeight)
+		return punished, nil
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return false, err
+	}
+
+	return punished, nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
@@ -95,8 +96,8 @@ func (k Keeper) GetEvidenceHandler(evidenceRoute string) (types.Handler, error)
 
 // SubmitEvidence attempts to match evidence against the keepers router and execute
 // the corresponding registered Evidence Handler. An error is returned if no
-// registered Handler exists or if the Handler fails. Otherwise, the evidence is
-// persisted.
+// registered Handler exists, if the evidence is expired or if the Handler fails.
+// Otherwise, the evidence is persisted.
 func (k Keeper) SubmitEvidence(ctx context.Context, evidence exported.Evidence) error {
 	if _, err := k.Evidences.Get(ctx, evidence.Hash()); err == nil {
 		return errors.Wrap(types.ErrEvidenceExists, strings.ToUpper(hex.EncodeToString(evidence.Hash())))
@@ -105,6 +106,15 @@ func (k Keeper) SubmitEvidence(ctx context.Context, evidence exported.Evidence)
 		return errors.Wrap(types.ErrNoEvidenceHandlerExists, evidence.Route())
 	}
 
+	// evidence which does not define its time is only expired by its height
+	var infractionTime time.Time
+	if e, ok := evidence.(interface{ GetTime() time.Time }); ok {
+		infractionTime = e.GetTime()
+	}
+	if isExpired(sdk.UnwrapSDKContext(ctx), evidence.GetHeight(), infractionTime) {
+		return errors.Wrapf(types.ErrEvidenceExpired, "infraction height %d", evidence.GetHeight())
+	}
+
 	handler := k.router.GetRoute(evidence.Route())
 	if err := handler(ctx, evidence); err != nil {
 		return errors.Wrap(types.ErrInvalidEvidence, err.Error())
@@ -120,3 +130,19 @@ func (k Keeper) SubmitEvidence(ctx context.Context, evidence exported.Evidence)
 
 	return k.Evidences.Set(ctx, evidence.Hash(), evidence)
 }
+
+// isExpired returns true if the evidence of an infraction committed at the
+// given height and time is too old to be handled. Evidence is considered stale
+// if both its age in time and in number of blocks are greater than the
+// MaxAgeDuration and MaxAgeNumBlocks evidence consensus params.
+func isExpired(ctx sdk.Context, height int64, infractionTime time.Time) bool {
+	cp := ctx.ConsensusParams()
+	if cp.Evidence == nil {
+		return false
+	}
+
+	ageDuration := ctx.BlockHeader().Time.Sub(infractionTime)
+	ageBlocks := ctx.BlockHeader().Height - height
+
+	return ageDuration > cp.Evidence.MaxAgeDuration && ageBlocks > cp.Evidence.MaxAgeNumBlocks
+}
@@ -210,6 +210,30 @@ func (suite *KeeperTestSuite) TestSubmitInvalidEvidence() {
 	suite.Nil(res)
 }
 
+func (suite *KeeperTestSuite) TestSubmitExpiredEvidence() {
+	ctx := suite.ctx.WithIsCheckTx(false).
+		WithBlockHeight(100).
+		WithBlockTime(time.Now().UTC()).
+		WithConsensusParams(cmtproto.ConsensusParams{
+			Evidence: &cmtproto.EvidenceParams{MaxAgeNumBlocks: 10, MaxAgeDuration: time.Hour},
+		})
+	pk := ed25519.GenPrivKey()
+
+	e := &types.Equivocation{
+		Height:           1,
+		Power:            100,
+		Time:             ctx.BlockTime().Add(-2 * time.Hour),
+		ConsensusAddress: sdk.ConsAddress(pk.PubKey().Address().Bytes()).String(),
+	}
+
+	err := suite.evidenceKeeper.SubmitEvidence(ctx, e)
+	suite.ErrorIs(err, types.ErrEvidenceExpired)
+
+	// evidence is not expired as long as it is recent enough in time
+	e.Time = ctx.BlockTime()
+	suite.Nil(suite.evidenceKeeper.SubmitEvidence(ctx, e))
+}
+
 func (suite *KeeperTestSuite) TestIterateEvidence() {
 	ctx := suite.ctx.WithIsCheckTx(false)
 	numEvidence := 100
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/x/evidence/exported"
+	"cosmossdk.io/x/evidence/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VoteExtensionHandler implements the ABCI handlers allowing validators to
+// submit app-defined evidence of misbehavior in their vote extensions.
+//
+// Validators include the evidence returned by the EvidenceProvider in their
+// vote extensions, and PreBlock submits the evidence included in the vote
+// extensions injected in the block, each evidence being handled by the Handler
+// registered for its route. The handler is a baseapp.VoteExtensionModule,
+// registered in the baseapp.VoteExtensionMux of the application which injects
+// the vote extensions in the proposals.
+type VoteExtensionHandler struct {
+	keeper   Keeper
+	provider types.EvidenceProvider
+}
+
+var _ baseapp.VoteExtensionModule = (*VoteExtensionHandler)(nil)
+
+// NewVoteExtensionHandler creates a new VoteExtensionHandler.
+func NewVoteExtensionHandler(keeper Keeper, provider types.EvidenceProvider) *VoteExtensionHandler {
+	return &VoteExtensionHandler{
+		keeper:   keeper,
+		provider: provider,
+	}
+}
+
+// ExtendVoteHandler returns an ExtendVoteHandler including the evidence returned
+// by the EvidenceProvider which can be handled and is not already persisted.
+func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, _ *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		var evidence []exported.Evidence
+		for _, evi := range h.provider(ctx) {
+			if len(evidence) == types.MaxVoteExtensionEvidence {
+				break
+			}
+
+			if evi.ValidateBasic() != nil || !h.keeper.router.HasRoute(evi.Route()) {
+				continue
+			}
+
+			if has, err := h.keeper.Evidences.Has(ctx, evi.Hash()); err != nil || has {
+				continue
+			}
+
+			evidence = append(evidence, evi)
+		}
+
+		if len(evidence) == 0 {
+			return &abci.ResponseExtendVote{}, nil
+		}
+
+		ve, err := types.NewEvidenceVoteExtension(evidence)
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := h.keeper.cdc.Marshal(ve)
+		if err != nil {
+			return nil, err
+		}
+
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns a VerifyVoteExtensionHandler rejecting the
+// vote extensions which cannot be decoded or include evidence which is invalid
+// or cannot be handled.
+func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if len(req.VoteExtension) == 0 {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+		}
+
+		if _, err := h.keeper.decodeVoteExtension(req.VoteExtension); err != nil {
+			h.keeper.Logger(ctx).Info("rejected vote extension", "validator", fmt.Sprintf("%X", req.ValidatorAddress), "err", err)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+// PreBlock submits the evidence included in the vote extensions injected in the
+// block.
+func (h *VoteExtensionHandler) PreBlock(ctx sdk.Context, extCommit abci.ExtendedCommitInfo) error {
+	return h.keeper.SubmitVoteExtensionEvidence(ctx, extCommit)
+}
+
+// SubmitVoteExtensionEvidence submits the evidence included in the vote
+// extensions of the given extended commit info. Invalid vote extensions and
+// evidence are ignored, as well as evidence already submitted, e.g. by another
+// validator.
+func (k Keeper) SubmitVoteExtensionEvidence(ctx context.Context, extCommit abci.ExtendedCommitInfo) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	logger := k.Logger(ctx)
+
+	for _, vote := range extCommit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		evidence, err := k.decodeVoteExtension(vote.VoteExtension)
+		if err != nil {
+			logger.Info("ignored vote extension", "validator", fmt.Sprintf("%X", vote.Validator.Address), "err", err)
+			continue
+		}
+
+		for _, evi := range evidence {
+			cacheCtx, write := sdkCtx.CacheContext()
+			if err := k.SubmitEvidence(cacheCtx, evi); err != nil {
+				logger.Info("ignored vote extension evidence", "validator", fmt.Sprintf("%X", vote.Validator.Address), "err", err)
+				continue
+			}
+
+			write()
+		}
+	}
+
+	return nil
+}
+
+// decodeVoteExtension decodes the given vote extension and returns the
+// evidence it includes. An error is returned if any evidence is invalid or
+// cannot be handled.
+func (k Keeper) decodeVoteExtension(bz []byte) ([]exported.Evidence, error) {
+	var ve types.EvidenceVoteExtension
+	if err := k.cdc.Unmarshal(bz, &ve); err != nil {
+		return nil, types.ErrInvalidVoteExtension.Wrap(err.Error())
+	}
+
+	if err := ve.Validate(); err != nil {
+		return nil, types.ErrInvalidVoteExtension.Wrap(err.Error())
+	}
+
+	evidence, err := ve.UnpackEvidence()
+	if err != nil {
+		return nil, types.ErrInvalidVoteExtension.Wrap(err.Error())
+	}
+
+	for _, evi := range evidence {
+		if !k.router.HasRoute(evi.Route()) {
+			return nil, types.ErrNoEvidenceHandlerExists.Wrap(evi.Route())
+		}
+	}
+
+	return evidence, nil
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/x/evidence/exported"
+	"cosmossdk.io/x/evidence/keeper"
+	"cosmossdk.io/x/evidence/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *KeeperTestSuite) newEquivocation(height int64) *types.Equivocation {
+	return &types.Equivocation{
+		Height:           height,
+		Power:            100,
+		Time:             time.Now().UTC(),
+		ConsensusAddress: sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address().Bytes()).String(),
+	}
+}
+
+func (suite *KeeperTestSuite) voteExtension(evidence ...exported.Evidence) []byte {
+	ve, err := types.NewEvidenceVoteExtension(evidence)
+	suite.Require().NoError(err)
+
+	bz, err := suite.encCfg.Codec.Marshal(ve)
+	suite.Require().NoError(err)
+
+	return bz
+}
+
+func (suite *KeeperTestSuite) TestExtendVoteHandler() {
+	ctx := suite.ctx
+
+	stored := suite.populateEvidence(ctx, 1)[0]
+	valid := suite.newEquivocation(1)
+	invalid := suite.newEquivocation(0)
+	unhandled := &types.LightClientAttack{Height: 1, Time: time.Now().UTC(), Power: 100, ConsensusAddress: valid.ConsensusAddress, TotalPower: 300}
+
+	h := keeper.NewVoteExtensionHandler(suite.evidenceKeeper, func(sdk.Context) []exported.Evidence {
+		return []exported.Evidence{stored, valid, invalid, unhandled}
+	})
+
+	res, err := h.ExtendVoteHandler()(ctx, &abci.RequestExtendVote{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.voteExtension(valid), res.VoteExtension)
+
+	// no vote extension without evidence
+	h = keeper.NewVoteExtensionHandler(suite.evidenceKeeper, func(sdk.Context) []exported.Evidence {
+		return []exported.Evidence{stored}
+	})
+
+	res, err = h.ExtendVoteHandler()(ctx, &abci.RequestExtendVote{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.VoteExtension)
+}
+
+func (suite *KeeperTestSuite) TestVerifyVoteExtensionHandler() {
+	h := keeper.NewVoteExtensionHandler(suite.evidenceKeeper, nil)
+
+	testCases := []struct {
+		name      string
+		extension []byte
+		expStatus abci.ResponseVerifyVoteExtension_VerifyStatus
+	}{
+		{"empty vote extension", nil, abci.ResponseVerifyVoteExtension_ACCEPT},
+		{"valid evidence", suite.voteExtension(suite.newEquivocation(1)), abci.ResponseVerifyVoteExtension_ACCEPT},
+		{"invalid encoding", []byte("invalid"), abci.ResponseVerifyVoteExtension_REJECT},
+		{"invalid evidence", suite.voteExtension(suite.newEquivocation(0)), abci.ResponseVerifyVoteExtension_REJECT},
+		{
+			"unhandled evidence",
+			suite.voteExtension(&types.LightClientAttack{Height: 1, Time: time.Now().UTC(), Power: 100, ConsensusAddress: suite.newEquivocation(1).ConsensusAddress, TotalPower: 300}),
+			abci.ResponseVerifyVoteExtension_REJECT,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := h.VerifyVoteExtensionHandler()(suite.ctx, &abci.RequestVerifyVoteExtension{VoteExtension: tc.extension})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expStatus, res.Status)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPreBlock() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	h := keeper.NewVoteExtensionHandler(suite.evidenceKeeper, nil)
+
+	valid := suite.newEquivocation(1)
+	rejected := suite.newEquivocation(2)
+	absent := suite.newEquivocation(3)
+
+	extCommit := abci.ExtendedCommitInfo{
+		Votes: []abci.ExtendedVoteInfo{
+			{VoteExtension: suite.voteExtension(valid, rejected), BlockIdFlag: cmtproto.BlockIDFlagCommit},
+			{VoteExtension: suite.voteExtension(valid), BlockIdFlag: cmtproto.BlockIDFlagCommit},
+			{VoteExtension: []byte("invalid"), BlockIdFlag: cmtproto.BlockIDFlagCommit},
+			{VoteExtension: suite.voteExtension(absent), BlockIdFlag: cmtproto.BlockIDFlagAbsent},
+		},
+	}
+
+	suite.Require().NoError(h.PreBlock(ctx, extCommit))
+
+	// only the evidence accepted by its handler is persisted, once
+	var evidence []exported.Evidence
+	suite.Require().NoError(suite.evidenceKeeper.Evidences.Walk(ctx, nil, func(_ []byte, value exported.Evidence) (stop bool, err error) {
+		evidence = append(evidence, value)
+		return false, nil
+	}))
+	suite.Require().Equal([]exported.Evidence{valid}, evidence)
+}
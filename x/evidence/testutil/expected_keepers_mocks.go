@@ -13,6 +13,7 @@ import (
 	math "cosmossdk.io/math"
 	types "github.com/cosmos/cosmos-sdk/crypto/types"
 	types0 "github.com/cosmos/cosmos-sdk/types"
+	types2 "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -40,6 +41,20 @@ func (m *MockStakingKeeper) EXPECT() *MockStakingKeeperMockRecorder {
 	return m.recorder
 }
 
+// GetLastTotalPower mocks base method.
+func (m *MockStakingKeeper) GetLastTotalPower(ctx types0.Context) math.Int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastTotalPower", ctx)
+	ret0, _ := ret[0].(math.Int)
+	return ret0
+}
+
+// GetLastTotalPower indicates an expected call of GetLastTotalPower.
+func (mr *MockStakingKeeperMockRecorder) GetLastTotalPower(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastTotalPower", reflect.TypeOf((*MockStakingKeeper)(nil).GetLastTotalPower), ctx)
+}
+
 // GetParams mocks base method.
 func (m *MockStakingKeeper) GetParams(ctx types0.Context) types1.Params {
 	m.ctrl.T.Helper()
@@ -91,6 +106,51 @@ func (m *MockSlashingKeeper) EXPECT() *MockSlashingKeeperMockRecorder {
 	return m.recorder
 }
 
+// CorrelatedSlashMultiplier mocks base method.
+func (m *MockSlashingKeeper) CorrelatedSlashMultiplier(arg0 context.Context) (math.LegacyDec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CorrelatedSlashMultiplier", arg0)
+	ret0, _ := ret[0].(math.LegacyDec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CorrelatedSlashMultiplier indicates an expected call of CorrelatedSlashMultiplier.
+func (mr *MockSlashingKeeperMockRecorder) CorrelatedSlashMultiplier(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CorrelatedSlashMultiplier", reflect.TypeOf((*MockSlashingKeeper)(nil).CorrelatedSlashMultiplier), arg0)
+}
+
+// CorrelatedSlashWindow mocks base method.
+func (m *MockSlashingKeeper) CorrelatedSlashWindow(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CorrelatedSlashWindow", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CorrelatedSlashWindow indicates an expected call of CorrelatedSlashWindow.
+func (mr *MockSlashingKeeperMockRecorder) CorrelatedSlashWindow(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CorrelatedSlashWindow", reflect.TypeOf((*MockSlashingKeeper)(nil).CorrelatedSlashWindow), arg0)
+}
+
+// DoubleSignJailDuration mocks base method.
+func (m *MockSlashingKeeper) DoubleSignJailDuration(arg0 context.Context) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DoubleSignJailDuration", arg0)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DoubleSignJailDuration indicates an expected call of DoubleSignJailDuration.
+func (mr *MockSlashingKeeperMockRecorder) DoubleSignJailDuration(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoubleSignJailDuration", reflect.TypeOf((*MockSlashingKeeper)(nil).DoubleSignJailDuration), arg0)
+}
+
 // GetPubkey mocks base method.
 func (m *MockSlashingKeeper) GetPubkey(arg0 context.Context, arg1 types.Address) (types.PubKey, error) {
 	m.ctrl.T.Helper()
@@ -106,6 +166,21 @@ func (mr *MockSlashingKeeperMockRecorder) GetPubkey(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPubkey", reflect.TypeOf((*MockSlashingKeeper)(nil).GetPubkey), arg0, arg1)
 }
 
+// GetValidatorSigningInfo mocks base method.
+func (m *MockSlashingKeeper) GetValidatorSigningInfo(arg0 context.Context, arg1 types0.ConsAddress) (types2.ValidatorSigningInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorSigningInfo", arg0, arg1)
+	ret0, _ := ret[0].(types2.ValidatorSigningInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorSigningInfo indicates an expected call of GetValidatorSigningInfo.
+func (mr *MockSlashingKeeperMockRecorder) GetValidatorSigningInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorSigningInfo", reflect.TypeOf((*MockSlashingKeeper)(nil).GetValidatorSigningInfo), arg0, arg1)
+}
+
 // HasValidatorSigningInfo mocks base method.
 func (m *MockSlashingKeeper) HasValidatorSigningInfo(arg0 context.Context, arg1 types0.ConsAddress) bool {
 	m.ctrl.T.Helper()
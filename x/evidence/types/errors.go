@@ -7,4 +7,6 @@ var (
 	ErrNoEvidenceHandlerExists = errors.Register(ModuleName, 2, "unregistered handler for evidence type")
 	ErrInvalidEvidence         = errors.Register(ModuleName, 3, "invalid evidence")
 	ErrEvidenceExists          = errors.Register(ModuleName, 5, "evidence already exists")
+	ErrEvidenceExpired         = errors.Register(ModuleName, 6, "evidence expired")
+	ErrInvalidVoteExtension    = errors.Register(ModuleName, 7, "invalid vote extension")
 )
@@ -12,9 +12,15 @@ import (
 )
 
 // Evidence type constants
-const RouteEquivocation = "equivocation"
+const (
+	RouteEquivocation      = "equivocation"
+	RouteLightClientAttack = "lightclientattack"
+)
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.ValidatorEvidence = &Equivocation{}
+	_ exported.ValidatorEvidence = &LightClientAttack{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -88,3 +94,81 @@ func FromABCIEvidence(e comet.Evidence) *Equivocation {
 		Time:             e.Time(),
 	}
 }
+
+// Route returns the Evidence Handler route for a LightClientAttack type.
+func (e *LightClientAttack) Route() string { return RouteLightClientAttack }
+
+// Hash returns the hash of a LightClientAttack object.
+func (e *LightClientAttack) Hash() []byte {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on a LightClientAttack object.
+func (e *LightClientAttack) ValidateBasic() error {
+	if e.Time.Unix() <= 0 {
+		return fmt.Errorf("invalid light client attack time: %s", e.Time)
+	}
+	if e.Height < 1 {
+		return fmt.Errorf("invalid light client attack height: %d", e.Height)
+	}
+	if e.Power < 1 {
+		return fmt.Errorf("invalid light client attack validator power: %d", e.Power)
+	}
+	if e.TotalPower < e.Power {
+		return fmt.Errorf("invalid light client attack total power: %d", e.TotalPower)
+	}
+	if e.ConsensusAddress == "" {
+		return fmt.Errorf("invalid light client attack validator consensus address: %s", e.ConsensusAddress)
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the byzantine validator's consensus address.
+func (e LightClientAttack) GetConsensusAddress() sdk.ConsAddress {
+	addr, _ := sdk.ConsAddressFromBech32(e.ConsensusAddress)
+	return addr
+}
+
+// GetHeight returns the common height of the LightClientAttack.
+func (e LightClientAttack) GetHeight() int64 {
+	return e.Height
+}
+
+// GetTime returns the time of the block at the common height of the
+// LightClientAttack.
+func (e LightClientAttack) GetTime() time.Time {
+	return e.Time
+}
+
+// GetValidatorPower returns the byzantine validator's power at the common
+// height of the LightClientAttack.
+func (e LightClientAttack) GetValidatorPower() int64 {
+	return e.Power
+}
+
+// GetTotalPower returns the total power of the validator set at the common
+// height of the LightClientAttack.
+func (e LightClientAttack) GetTotalPower() int64 { return e.TotalPower }
+
+// FromABCILightClientAttack converts a CometBFT light client attack Evidence
+// to SDK Evidence using LightClientAttack as the concrete type.
+func FromABCILightClientAttack(e comet.Evidence) *LightClientAttack {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	consAddr, err := sdk.Bech32ifyAddressBytes(bech32PrefixConsAddr, e.Validator().Address())
+	if err != nil {
+		panic(err)
+	}
+
+	return &LightClientAttack{
+		Height:           e.Height(),
+		Power:            e.Validator().Power(),
+		ConsensusAddress: consAddr,
+		Time:             e.Time(),
+		TotalPower:       e.TotalVotingPower(),
+	}
+}
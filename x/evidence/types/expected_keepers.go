@@ -9,6 +9,7 @@ import (
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -18,6 +19,7 @@ type (
 	StakingKeeper interface {
 		ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI
 		GetParams(ctx sdk.Context) (params stakingtypes.Params)
+		GetLastTotalPower(ctx sdk.Context) sdkmath.Int
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
@@ -26,10 +28,14 @@ type (
 		GetPubkey(context.Context, cryptotypes.Address) (cryptotypes.PubKey, error)
 		IsTombstoned(context.Context, sdk.ConsAddress) bool
 		HasValidatorSigningInfo(context.Context, sdk.ConsAddress) bool
+		GetValidatorSigningInfo(context.Context, sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, error)
 		Tombstone(context.Context, sdk.ConsAddress) error
 		Slash(context.Context, sdk.ConsAddress, sdkmath.LegacyDec, int64, int64) error
 		SlashWithInfractionReason(context.Context, sdk.ConsAddress, sdkmath.LegacyDec, int64, int64, stakingtypes.Infraction) error
 		SlashFractionDoubleSign(context.Context) (sdkmath.LegacyDec, error)
+		CorrelatedSlashWindow(context.Context) (int64, error)
+		CorrelatedSlashMultiplier(context.Context) (sdkmath.LegacyDec, error)
+		DoubleSignJailDuration(context.Context) (time.Duration, error)
 		Jail(context.Context, sdk.ConsAddress) error
 		JailUntil(context.Context, sdk.ConsAddress, time.Time) error
 	}
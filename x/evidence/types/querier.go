@@ -20,6 +20,16 @@ func NewQueryAllEvidenceRequest(pageReq *query.PageRequest) *QueryAllEvidenceReq
 	return &QueryAllEvidenceRequest{Pagination: pageReq}
 }
 
+// NewQueryEvidenceByTypeRequest creates a new instance of QueryEvidenceByTypeRequest.
+func NewQueryEvidenceByTypeRequest(route string, pageReq *query.PageRequest) *QueryEvidenceByTypeRequest {
+	return &QueryEvidenceByTypeRequest{Route: route, Pagination: pageReq}
+}
+
+// NewQueryEvidenceByValidatorRequest creates a new instance of QueryEvidenceByValidatorRequest.
+func NewQueryEvidenceByValidatorRequest(consAddr string, pageReq *query.PageRequest) *QueryEvidenceByValidatorRequest {
+	return &QueryEvidenceByValidatorRequest{ConsensusAddress: consAddr, Pagination: pageReq}
+}
+
 // QueryAllEvidenceParams defines the parameters necessary for querying for all Evidence.
 type QueryAllEvidenceParams struct {
 	Page  int `json:"page" yaml:"page"`
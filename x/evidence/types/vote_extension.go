@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/x/evidence/exported"
+	proto "github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxVoteExtensionEvidence defines the maximum number of evidence a validator
+// can submit in a single vote extension.
+const MaxVoteExtensionEvidence = 32
+
+var _ types.UnpackInterfacesMessage = EvidenceVoteExtension{}
+
+// NewEvidenceVoteExtension creates a new vote extension submitting the given
+// evidence.
+func NewEvidenceVoteExtension(e []exported.Evidence) (*EvidenceVoteExtension, error) {
+	evidence := make([]*types.Any, len(e))
+	for i, evi := range e {
+		msg, ok := evi.(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf("cannot proto marshal %T", evi)
+		}
+		any, err := types.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		evidence[i] = any
+	}
+
+	return &EvidenceVoteExtension{Evidence: evidence}, nil
+}
+
+// UnpackEvidence returns the evidence submitted in the vote extension.
+func (ve EvidenceVoteExtension) UnpackEvidence() ([]exported.Evidence, error) {
+	evidence := make([]exported.Evidence, len(ve.Evidence))
+	for i, any := range ve.Evidence {
+		evi, ok := any.GetCachedValue().(exported.Evidence)
+		if !ok {
+			return nil, fmt.Errorf("expected evidence, got %T", any.GetCachedValue())
+		}
+		evidence[i] = evi
+	}
+
+	return evidence, nil
+}
+
+// Validate performs basic validation of the vote extension.
+func (ve EvidenceVoteExtension) Validate() error {
+	if len(ve.Evidence) > MaxVoteExtensionEvidence {
+		return fmt.Errorf("too many evidence in vote extension: %d > %d", len(ve.Evidence), MaxVoteExtensionEvidence)
+	}
+
+	evidence, err := ve.UnpackEvidence()
+	if err != nil {
+		return err
+	}
+
+	for _, evi := range evidence {
+		if err := evi.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (ve EvidenceVoteExtension) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, any := range ve.Evidence {
+		var evi exported.Evidence
+		if err := unpacker.UnpackAny(any, &evi); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EvidenceProvider returns the evidence of misbehavior observed by the local
+// node, which is submitted by the validator in its vote extensions.
+type EvidenceProvider func(ctx sdk.Context) []exported.Evidence
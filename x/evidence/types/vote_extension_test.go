@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/x/evidence"
+	"cosmossdk.io/x/evidence/exported"
+	"cosmossdk.io/x/evidence/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+func TestEvidenceVoteExtension(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(evidence.AppModuleBasic{}).Codec
+	addr := sdk.ConsAddress("foo_________________")
+
+	e := []exported.Evidence{
+		&types.Equivocation{Height: 100, Time: time.Now().UTC(), Power: 100, ConsensusAddress: addr.String()},
+		&types.LightClientAttack{Height: 100, Time: time.Now().UTC(), Power: 100, ConsensusAddress: addr.String(), TotalPower: 300},
+	}
+
+	ve, err := types.NewEvidenceVoteExtension(e)
+	require.NoError(t, err)
+	require.NoError(t, ve.Validate())
+
+	bz, err := cdc.Marshal(ve)
+	require.NoError(t, err)
+
+	var decoded types.EvidenceVoteExtension
+	require.NoError(t, cdc.Unmarshal(bz, &decoded))
+
+	res, err := decoded.UnpackEvidence()
+	require.NoError(t, err)
+	require.Equal(t, e, res)
+
+	// invalid evidence
+	ve, err = types.NewEvidenceVoteExtension([]exported.Evidence{&types.Equivocation{Height: 100, Time: time.Now().UTC()}})
+	require.NoError(t, err)
+	require.Error(t, ve.Validate())
+
+	// too many evidence
+	e = make([]exported.Evidence, types.MaxVoteExtensionEvidence+1)
+	for i := range e {
+		e[i] = &types.Equivocation{Height: int64(i + 1), Time: time.Now().UTC(), Power: 100, ConsensusAddress: addr.String()}
+	}
+	ve, err = types.NewEvidenceVoteExtension(e)
+	require.NoError(t, err)
+	require.ErrorContains(t, ve.Validate(), "too many evidence")
+}
@@ -86,11 +86,12 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 			return false, err
 		}
 
-		// If an expedited proposal fails, we do not want to update
+		// If an expedited or optimistic proposal fails, we do not want to update
 		// the deposit at this point since the proposal is converted to regular.
 		// As a result, the deposits are either deleted or refunded in all cases
-		// EXCEPT when an expedited proposal fails.
-		if !(proposal.Expedited && !passes) {
+		// EXCEPT when an expedited or optimistic proposal fails.
+		convertedToStandard := !passes && (proposal.Expedited || proposal.ProposalType == v1.ProposalTypeOptimistic)
+		if !convertedToStandard {
 			if burnDeposits {
 				err = keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
 			} else {
@@ -159,13 +160,36 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 				tagValue = types.AttributeValueProposalFailed
 				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
 			}
+		case proposal.ProposalType == v1.ProposalTypeOptimistic:
+			// When the no votes of an optimistic proposal reach the rejected
+			// threshold, it is converted to a standard proposal. As a result,
+			// a new regular voting period starts, after which the proposal is
+			// tallied according to the regular proposal rules.
+			proposal.ProposalType = v1.ProposalTypeStandard
+			params, err := keeper.ProposalParams(ctx, proposal)
+			if err != nil {
+				return false, err
+			}
+			startTime := ctx.BlockHeader().Time
+			endTime := startTime.Add(*params.VotingPeriod)
+			proposal.VotingStartTime = &startTime
+			proposal.VotingEndTime = &endTime
+
+			err = keeper.ActiveProposalsQueue.Set(ctx, collections.Join(*proposal.VotingEndTime, proposal.Id), proposal.Id)
+			if err != nil {
+				return false, err
+			}
+
+			tagValue = types.AttributeValueOptimisticProposalRejected
+			logMsg = "optimistic proposal converted to standard"
 		case proposal.Expedited:
 			// When expedited proposal fails, it is converted
 			// to a regular proposal. As a result, the voting period is extended, and,
 			// once the regular voting period expires again, the tally is repeated
 			// according to the regular proposal rules.
 			proposal.Expedited = false
-			params, err := keeper.Params.Get(ctx)
+			proposal.ProposalType = v1.ProposalTypeStandard
+			params, err := keeper.ProposalParams(ctx, proposal)
 			if err != nil {
 				return false, err
 			}
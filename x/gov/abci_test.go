@@ -323,7 +323,11 @@ func TestProposalPassedEndblocker(t *testing.T) {
 			require.NotNil(t, macc)
 			initialModuleAccCoins := suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-			proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", proposer, tc.expedited)
+			proposalType := v1.ProposalTypeStandard
+			if tc.expedited {
+				proposalType = v1.ProposalTypeExpedited
+			}
+			proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", proposer, proposalType)
 			require.NoError(t, err)
 
 			proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10*depositMultiplier))}
@@ -379,7 +383,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	suite.StakingKeeper.EndBlocker(ctx)
 
 	msg := banktypes.NewMsgSend(authtypes.NewModuleAddress(types.ModuleName), addrs[0], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000))))
-	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "title", "summary", proposer, false)
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "title", "summary", proposer, v1.ProposalTypeStandard)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
@@ -600,6 +604,111 @@ func TestExpeditedProposal_PassAndConversionToRegular(t *testing.T) {
 	}
 }
 
+func TestOptimisticProposal_PassAndConversionToStandard(t *testing.T) {
+	testcases := []struct {
+		name string
+		// indicates whether the optimistic proposal is vetoed.
+		vetoed bool
+	}{
+		{
+			name:   "optimistic passes without being voted",
+			vetoed: false,
+		},
+		{
+			name:   "optimistic is vetoed, converted to standard - standard eventually passes",
+			vetoed: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite := createTestSuite(t)
+			app := suite.App
+			ctx := app.BaseApp.NewContext(false)
+			addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 3, valTokens)
+			params, err := suite.GovKeeper.Params.Get(ctx)
+			require.NoError(t, err)
+
+			SortAddresses(addrs)
+
+			govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+			app.FinalizeBlock(&abci.RequestFinalizeBlock{
+				Height: app.LastBlockHeight() + 1,
+				Hash:   app.LastCommitID().Hash,
+			})
+
+			valAddr := sdk.ValAddress(addrs[0])
+			proposer := addrs[0]
+
+			params.OptimisticAuthorizedAddresses = []string{proposer.String()}
+			require.NoError(t, suite.GovKeeper.Params.Set(ctx, params))
+
+			// Create a validator so that able to vote on proposal.
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+			suite.StakingKeeper.EndBlocker(ctx)
+
+			proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
+			newProposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{}, proposalCoins, proposer.String(), "metadata", "title", "summary", false)
+			require.NoError(t, err)
+			newProposalMsg.ProposalType = v1.ProposalTypeOptimistic
+
+			res, err := govMsgSvr.SubmitProposal(ctx, newProposalMsg)
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			proposal, err := suite.GovKeeper.Proposals.Get(ctx, res.ProposalId)
+			require.Nil(t, err)
+			require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+			require.Equal(t, v1.ProposalTypeOptimistic, proposal.ProposalType)
+
+			if tc.vetoed {
+				// Validator votes NO, vetoing the optimistic proposal.
+				err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), "metadata")
+				require.NoError(t, err)
+			}
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(*params.VotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			// Here the optimistic proposal is tallied after expiry.
+			require.NoError(t, gov.EndBlocker(ctx, suite.GovKeeper))
+
+			proposal, err = suite.GovKeeper.Proposals.Get(ctx, res.ProposalId)
+			require.Nil(t, err)
+
+			if !tc.vetoed {
+				require.Equal(t, v1.StatusPassed, proposal.Status)
+				return
+			}
+
+			// Optimistic proposal should be converted to a standard proposal instead.
+			require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+			require.Equal(t, v1.ProposalTypeStandard, proposal.ProposalType)
+			require.True(t, proposal.VotingStartTime.Equal(newHeader.Time))
+			require.Equal(t, proposal.VotingStartTime.Add(*params.VotingPeriod), *proposal.VotingEndTime)
+
+			// The deposit is not refunded yet
+			_, err = suite.GovKeeper.Deposits.Get(ctx, collections.Join(proposal.Id, proposer))
+			require.NoError(t, err)
+
+			// Validator votes YES, letting the converted standard proposal pass.
+			err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "metadata")
+			require.NoError(t, err)
+
+			newHeader.Time = newHeader.Time.Add(*params.VotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+			require.NoError(t, gov.EndBlocker(ctx, suite.GovKeeper))
+
+			proposal, err = suite.GovKeeper.Proposals.Get(ctx, res.ProposalId)
+			require.Nil(t, err)
+			require.Equal(t, v1.StatusPassed, proposal.Status)
+		})
+	}
+}
+
 func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sdk.Context, addrs []sdk.ValAddress, powerAmt []int64) {
 	require.True(t, len(addrs) <= len(pubkeys), "Not enough pubkeys specified at top of file.")
 
@@ -648,3 +757,67 @@ func checkInactiveProposalsQueue(t *testing.T, ctx sdk.Context, k *keeper.Keeper
 		require.NoError(t, err)
 	}
 }
+
+func TestMultipleChoiceProposal(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false)
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 3, valTokens)
+	params, err := suite.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	SortAddresses(addrs)
+
+	govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+	app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: app.LastBlockHeight() + 1,
+		Hash:   app.LastCommitID().Hash,
+	})
+
+	valAddr := sdk.ValAddress(addrs[0])
+	proposer := addrs[0]
+
+	// Create a validator so that able to vote on proposal.
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	suite.StakingKeeper.EndBlocker(ctx)
+
+	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
+	newProposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{}, proposalCoins, proposer.String(), "", "title", "summary", false)
+	require.NoError(t, err)
+	newProposalMsg.ProposalType = v1.ProposalTypeMultipleChoice
+	newProposalMsg.VoteOptions = []string{"red", "blue", "green"}
+
+	res, err := govMsgSvr.SubmitProposal(ctx, newProposalMsg)
+	require.NoError(t, err)
+
+	proposal, err := suite.GovKeeper.Proposals.Get(ctx, res.ProposalId)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+
+	err = suite.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.WeightedVoteOptions{
+		v1.NewWeightedVoteChoice(0, math.LegacyNewDecWithPrec(75, 2)),
+		v1.NewWeightedVoteChoice(2, math.LegacyNewDecWithPrec(25, 2)),
+	}, "")
+	require.NoError(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*params.VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	require.NoError(t, gov.EndBlocker(ctx, suite.GovKeeper))
+
+	// the proposal passes once the quorum is reached, its result is the
+	// voting power of each option
+	proposal, err = suite.GovKeeper.Proposals.Get(ctx, res.ProposalId)
+	require.NoError(t, err)
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+
+	tally := proposal.MultipleChoiceTallyResult(*proposal.FinalTallyResult)
+	require.Len(t, tally, 3)
+	require.Equal(t, "red", tally[0].Label)
+	require.Equal(t, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10).MulRaw(3).QuoRaw(4).String(), tally[0].Count)
+	require.Equal(t, "0", tally[1].Count)
+	require.Equal(t, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10).QuoRaw(4).String(), tally[2].Count)
+}
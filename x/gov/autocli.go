@@ -11,6 +11,19 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
 		Tx: &autocliv1.ServiceCommandDescriptor{
 			Service: govv1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "DelegateGovernor",
+					Use:            "delegate-governor [governor]",
+					Short:          "Delegate your governance voting power to a governor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "governor_address"}},
+				},
+				{
+					RpcMethod: "UndelegateGovernor",
+					Use:       "undelegate-governor",
+					Short:     "Remove the delegation of your governance voting power to your governor",
+				},
+			},
 			// map v1beta1 as a sub-command
 			SubCommands: map[string]*autocliv1.ServiceCommandDescriptor{
 				"v1beta1": {Service: govv1beta1.Msg_ServiceDesc.ServiceName},
@@ -18,6 +31,31 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 		},
 		Query: &autocliv1.ServiceCommandDescriptor{
 			Service: govv1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "MultipleChoiceTallyResult",
+					Use:            "multiple-choice-tally [proposal-id]",
+					Short:          "Query the tally of each option of a multiple choice proposal",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "proposal_id"}},
+				},
+				{
+					RpcMethod:      "GovernanceDelegation",
+					Use:            "governance-delegation [delegator-addr]",
+					Short:          "Query the governor to which an account delegated its governance voting power",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator_address"}},
+				},
+				{
+					RpcMethod:      "Governor",
+					Use:            "governor [governor-addr]",
+					Short:          "Query a governor and the voting power delegated to it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "governor_address"}},
+				},
+				{
+					RpcMethod: "Governors",
+					Use:       "governors",
+					Short:     "Query all the governors and the voting power delegated to them",
+				},
+			},
 			// map v1beta1 as a sub-command
 			SubCommands: map[string]*autocliv1.ServiceCommandDescriptor{
 				"v1beta1": {Service: govv1beta1.Query_ServiceDesc.ServiceName},
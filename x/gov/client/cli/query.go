@@ -38,7 +38,11 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryMultipleChoiceTally(),
 		GetCmdConstitution(),
+		GetCmdQueryGovernanceDelegation(ac),
+		GetCmdQueryGovernor(ac),
+		GetCmdQueryGovernors(),
 	)
 
 	return govQueryCmd
@@ -509,6 +513,53 @@ $ %s query gov tally 1
 	return cmd
 }
 
+// GetCmdQueryMultipleChoiceTally implements the command to query for the tally
+// of each option of a multiple choice proposal.
+func GetCmdQueryMultipleChoiceTally() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multiple-choice-tally [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the tally of each option of a multiple choice proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query tally of votes on each option of a multiple choice proposal.
+You can find the proposal-id by running "%s query gov proposals".
+
+Example:
+$ %s query gov multiple-choice-tally 1
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.MultipleChoiceTallyResult(
+				cmd.Context(),
+				&v1.QueryMultipleChoiceTallyResultRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 //
 //nolint:staticcheck // this function contains deprecated commands that we need.
@@ -675,3 +726,134 @@ func GetCmdConstitution() *cobra.Command {
 		},
 	}
 }
+
+// GetCmdQueryGovernanceDelegation implements the query governance delegation command.
+func GetCmdQueryGovernanceDelegation(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "governance-delegation [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the governor to which an account delegated its governance voting power",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the governor to which an account delegated its governance voting power.
+
+Example:
+$ %s query gov governance-delegation cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			if _, err := ac.StringToBytes(args[0]); err != nil {
+				return err
+			}
+
+			res, err := queryClient.GovernanceDelegation(
+				cmd.Context(),
+				&v1.QueryGovernanceDelegationRequest{DelegatorAddress: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGovernor implements the query governor command.
+func GetCmdQueryGovernor(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "governor [governor-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a governor and the voting power delegated to it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a governor, its number of delegators and the voting power delegated to it.
+
+Example:
+$ %s query gov governor cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			if _, err := ac.StringToBytes(args[0]); err != nil {
+				return err
+			}
+
+			res, err := queryClient.Governor(
+				cmd.Context(),
+				&v1.QueryGovernorRequest{GovernorAddress: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Governor)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGovernors implements the query governors command.
+func GetCmdQueryGovernors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "governors",
+		Args:  cobra.NoArgs,
+		Short: "Query all the governors and the voting power delegated to them",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the governors, their number of delegators and the voting power delegated to them.
+
+Example:
+$ %s query gov governors
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Governors(
+				cmd.Context(),
+				&v1.QueryGovernorsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "governors")
+
+	return cmd
+}
@@ -72,6 +72,8 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 		NewCmdSubmitProposal(),
 		NewCmdDraftProposal(),
 		NewCmdCancelProposal(),
+		NewCmdDelegateGovernor(),
+		NewCmdUndelegateGovernor(),
 
 		// Deprecated
 		cmdSubmitLegacyProp,
@@ -111,7 +113,13 @@ Where proposal.json contains:
   "deposit": "10stake"
   "title: "My proposal"
   "summary": "A short summary of my proposal",
-  "expedited": false
+  "expedited": false,
+  // optional, one of PROPOSAL_TYPE_STANDARD, PROPOSAL_TYPE_OPTIMISTIC, PROPOSAL_TYPE_EXPEDITED
+  // or PROPOSAL_TYPE_MULTIPLE_CHOICE
+  "proposal_type": "PROPOSAL_TYPE_STANDARD",
+  // only for multiple choice proposals, which have no messages: the labels of the
+  // options, voted with their index, e.g. choice:0 for the first one
+  "vote_options": []
 }
 
 metadata example: 
@@ -142,6 +150,8 @@ metadata example:
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			msg.ProposalType = proposal.proposalType()
+			msg.VoteOptions = proposal.VoteOptions
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -182,6 +192,54 @@ func NewCmdCancelProposal() *cobra.Command {
 	return cmd
 }
 
+// NewCmdDelegateGovernor implements delegating governance voting power to a governor.
+func NewCmdDelegateGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delegate-governor [governor]",
+		Short:   "Delegate your governance voting power to a governor, which votes on your behalf on the proposals you do not vote on",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`$ %s tx gov delegate-governor cosmos1... --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return fmt.Errorf("invalid governor address: %w", err)
+			}
+
+			msg := v1.NewMsgDelegateGovernor(clientCtx.GetFromAddress().String(), args[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUndelegateGovernor implements removing the delegation of governance voting power to a governor.
+func NewCmdUndelegateGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "undelegate-governor",
+		Short:   "Remove the delegation of your governance voting power to your governor",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %s tx gov undelegate-governor --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgUndelegateGovernor(clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewCmdSubmitLegacyProposal implements submitting a proposal transaction command.
 // Deprecated: please use NewCmdSubmitProposal instead.
 func NewCmdSubmitLegacyProposal() *cobra.Command {
@@ -308,11 +366,13 @@ func NewCmdVote() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Submit a vote for an active proposal. You can
 find the proposal-id by running "%s query gov proposals".
+Multiple choice proposals are voted with the index of an option, e.g. choice:0.
 
 Example:
 $ %s tx gov vote 1 yes --from mykey
+$ %s tx gov vote 2 choice:0 --from mykey
 `,
-				version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -329,13 +389,23 @@ $ %s tx gov vote 1 yes --from mykey
 				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
 			}
 
-			// Find out which vote option user chose
-			byteVoteOption, err := v1.VoteOptionFromString(govutils.NormalizeVoteOption(args[1]))
+			metadata, err := cmd.Flags().GetString(FlagMetadata)
 			if err != nil {
 				return err
 			}
 
-			metadata, err := cmd.Flags().GetString(FlagMetadata)
+			// Find out which choice of a multiple choice proposal user chose
+			choice, isChoice, err := v1.VoteChoiceFromString(args[1])
+			if err != nil {
+				return err
+			}
+			if isChoice {
+				msg := v1.NewMsgVoteChoice(from, proposalID, choice, metadata)
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			}
+
+			// Find out which vote option user chose
+			byteVoteOption, err := v1.VoteOptionFromString(govutils.NormalizeVoteOption(args[1]))
 			if err != nil {
 				return err
 			}
@@ -362,11 +432,13 @@ func NewCmdWeightedVote() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Submit a vote for an active proposal. You can
 find the proposal-id by running "%s query gov proposals".
+Multiple choice proposals are voted with the index of an option, e.g. choice:0.
 
 Example:
 $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from mykey
+$ %s tx gov weighted-vote 2 choice:0=0.6,choice:1=0.4 --from mykey
 `,
-				version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -88,6 +88,15 @@ type proposal struct {
 	Title     string            `json:"title"`
 	Summary   string            `json:"summary"`
 	Expedited bool              `json:"expedited"`
+	// ProposalType is the name of the proposal type, e.g. PROPOSAL_TYPE_OPTIMISTIC.
+	ProposalType string `json:"proposal_type,omitempty"`
+	// VoteOptions are the labels of the options of a multiple choice proposal.
+	VoteOptions []string `json:"vote_options,omitempty"`
+}
+
+// proposalType returns the type of the proposal.
+func (p proposal) proposalType() govv1.ProposalType {
+	return govv1.ProposalType(govv1.ProposalType_value[p.ProposalType])
 }
 
 // parseSubmitProposal reads and parses the proposal.
@@ -120,6 +129,10 @@ func parseSubmitProposal(cdc codec.Codec, path string) (proposal, []sdk.Msg, sdk
 		return proposal, nil, nil, err
 	}
 
+	if _, ok := govv1.ProposalType_value[proposal.ProposalType]; proposal.ProposalType != "" && !ok {
+		return proposal, nil, nil, fmt.Errorf("invalid proposal type: %s", proposal.ProposalType)
+	}
+
 	return proposal, msgs, deposit, nil
 }
 
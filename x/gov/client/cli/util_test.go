@@ -165,7 +165,8 @@ func TestParseSubmitProposal(t *testing.T) {
 	"title": "My awesome title",
 	"summary": "My awesome summary",
 	"deposit": "1000test",
-	"expedited": true
+	"expedited": true,
+	"proposal_type": "PROPOSAL_TYPE_EXPEDITED"
 }
 `, addr, addr, addr, addr, addr, base64.StdEncoding.EncodeToString(expectedMetadata)))
 
@@ -205,6 +206,7 @@ func TestParseSubmitProposal(t *testing.T) {
 	require.Equal(t, "My awesome title", proposal.Title)
 	require.Equal(t, "My awesome summary", proposal.Summary)
 	require.Equal(t, true, proposal.Expedited)
+	require.Equal(t, v1.ProposalTypeExpedited, proposal.proposalType())
 
 	err = okJSON.Close()
 	require.Nil(t, err, "unexpected error")
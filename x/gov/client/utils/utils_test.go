@@ -41,6 +41,10 @@ func TestNormalizeWeightedVoteOptions(t *testing.T) {
 			options:    "Yes=0.5,No=0.6,NoWithVeto=-0.1",
 			normalized: "VOTE_OPTION_YES=0.5,VOTE_OPTION_NO=0.6,VOTE_OPTION_NO_WITH_VETO=-0.1",
 		},
+		"multiple choice options": {
+			options:    "choice:0=0.5,choice:1=0.3,choice:5",
+			normalized: "choice:0=0.5,choice:1=0.3,choice:5=1",
+		},
 		"empty options": {
 			options:    "",
 			normalized: "=1",
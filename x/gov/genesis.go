@@ -74,6 +74,13 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		}
 	}
 
+	for _, delegation := range data.GovernanceDelegations {
+		err := k.SetGovernanceDelegation(ctx, *delegation)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -131,12 +138,23 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) (*v1.GenesisState, error)
 		panic(err)
 	}
 
+	// export governance delegations
+	var governanceDelegations []*v1.GovernanceDelegation
+	err = k.GovernanceDelegations.Walk(ctx, nil, func(_ sdk.AccAddress, value v1.GovernanceDelegation) (stop bool, err error) {
+		governanceDelegations = append(governanceDelegations, &value)
+		return false, nil
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:    startingProposalID,
+		Deposits:              proposalsDeposits,
+		Votes:                 proposalsVotes,
+		Proposals:             proposals,
+		Params:                &params,
+		Constitution:          constitution,
+		GovernanceDelegations: governanceDelegations,
 	}, nil
 }
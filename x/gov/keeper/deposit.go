@@ -85,7 +85,7 @@ func (keeper Keeper) AddDeposit(ctx context.Context, proposalID uint64, deposito
 
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
-	params, err := keeper.Params.Get(ctx)
+	params, err := keeper.ProposalParams(ctx, proposal)
 	if err != nil {
 		return false, err
 	}
@@ -233,13 +233,8 @@ func (keeper Keeper) RefundAndDeleteDeposits(ctx context.Context, proposalID uin
 
 // validateInitialDeposit validates if initial deposit is greater than or equal to the minimum
 // required at the time of proposal submission. This threshold amount is determined by
-// the deposit parameters. Returns nil on success, error otherwise.
-func (keeper Keeper) validateInitialDeposit(ctx context.Context, initialDeposit sdk.Coins, expedited bool) error {
-	params, err := keeper.Params.Get(ctx)
-	if err != nil {
-		return err
-	}
-
+// the deposit parameters which apply to the proposal. Returns nil on success, error otherwise.
+func (keeper Keeper) validateInitialDeposit(params v1.Params, initialDeposit sdk.Coins, expedited bool) error {
 	minInitialDepositRatio, err := sdk.NewDecFromStr(params.MinInitialDepositRatio)
 	if err != nil {
 		return err
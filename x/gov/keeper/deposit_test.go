@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"cosmossdk.io/collections"
 
@@ -13,6 +14,7 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
@@ -53,7 +55,11 @@ func TestDeposits(t *testing.T) {
 			authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
 
 			tp := TestProposal
-			proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "summary", TestAddrs[0], tc.expedited)
+			proposalType := v1.ProposalTypeStandard
+			if tc.expedited {
+				proposalType = v1.ProposalTypeExpedited
+			}
+			proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "summary", TestAddrs[0], proposalType)
 			require.NoError(t, err)
 			proposalID := proposal.Id
 
@@ -143,7 +149,7 @@ func TestDeposits(t *testing.T) {
 			require.Equal(t, addr1Initial, bankKeeper.GetAllBalances(ctx, TestAddrs[1]))
 
 			// Test delete and burn deposits
-			proposal, err = govKeeper.SubmitProposal(ctx, tp, "", "title", "summary", TestAddrs[0], true)
+			proposal, err = govKeeper.SubmitProposal(ctx, tp, "", "title", "summary", TestAddrs[0], v1.ProposalTypeExpedited)
 			require.NoError(t, err)
 			proposalID = proposal.Id
 			_, err = govKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
@@ -156,6 +162,81 @@ func TestDeposits(t *testing.T) {
 	}
 }
 
+func TestDepositMessageBasedParams(t *testing.T) {
+	govKeeper, authKeeper, bankKeeper, stakingKeeper, distKeeper, _, ctx := setupGovKeeper(t)
+	trackMockBalances(bankKeeper, distKeeper)
+	authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	// the proposal only contains a MsgSend, which requires a twice higher
+	// deposit and a shorter voting period than the default ones
+	tenStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 10)))
+	votingPeriod := time.Hour
+	params, err := govKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.MessageBasedParams = []v1.MessageBasedParams{
+		{
+			MsgUrl:       sdk.MsgTypeURL(&banktypes.MsgSend{}),
+			MinDeposit:   tenStake.Add(tenStake...),
+			VotingPeriod: &votingPeriod,
+		},
+	}
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 1, sdkmath.NewInt(100000000))
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal[:1], "", "title", "summary", TestAddrs[0], v1.ProposalTypeStandard)
+	require.NoError(t, err)
+
+	votingStarted, err := govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], tenStake)
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+
+	votingStarted, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], tenStake)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	proposal, err = govKeeper.Proposals.Get(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, proposal.VotingStartTime.Add(votingPeriod), *proposal.VotingEndTime)
+}
+
+func TestDepositMessageBasedParamsExpedited(t *testing.T) {
+	govKeeper, authKeeper, bankKeeper, stakingKeeper, distKeeper, _, ctx := setupGovKeeper(t)
+	trackMockBalances(bankKeeper, distKeeper)
+	authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	params, err := govKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+
+	// the proposal contains a MsgSend, which requires a higher deposit and a
+	// longer voting period than the expedited ones
+	votingPeriod := *params.ExpeditedVotingPeriod * 3
+	minDeposit := sdk.Coins(params.ExpeditedMinDeposit).Add(params.ExpeditedMinDeposit...)
+	params.MessageBasedParams = []v1.MessageBasedParams{
+		{
+			MsgUrl:       sdk.MsgTypeURL(&banktypes.MsgSend{}),
+			MinDeposit:   minDeposit,
+			VotingPeriod: &votingPeriod,
+		},
+	}
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 1, sdkmath.NewInt(1000000000))
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", TestAddrs[0], v1.ProposalTypeExpedited)
+	require.NoError(t, err)
+
+	votingStarted, err := govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], params.ExpeditedMinDeposit)
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+
+	votingStarted, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], params.ExpeditedMinDeposit)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	proposal, err = govKeeper.Proposals.Get(ctx, proposal.Id)
+	require.NoError(t, err)
+	require.Equal(t, proposal.VotingStartTime.Add(votingPeriod), *proposal.VotingEndTime)
+}
+
 func TestValidateInitialDeposit(t *testing.T) {
 	testcases := map[string]struct {
 		minDeposit               sdk.Coins
@@ -329,7 +410,7 @@ func TestChargeDeposit(t *testing.T) {
 				require.NoError(t, err)
 
 				tp := TestProposal
-				proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "summary", TestAddrs[0], false)
+				proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "summary", TestAddrs[0], v1.ProposalTypeStandard)
 				require.NoError(t, err)
 				proposalID := proposal.Id
 				// deposit to proposal
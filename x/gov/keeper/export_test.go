@@ -5,5 +5,16 @@ import sdk "github.com/cosmos/cosmos-sdk/types"
 // ValidateInitialDeposit is a helper function used only in deposit tests which returns the same
 // functionality of validateInitialDeposit private function.
 func (k Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins, expedited bool) error {
-	return k.validateInitialDeposit(ctx, initialDeposit, expedited)
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.validateInitialDeposit(params, initialDeposit, expedited)
+}
+
+// SetTallyFn is a helper function used only in tally tests which replaces the
+// keeper's TallyFn.
+func (k *Keeper) SetTallyFn(tallyFn TallyFn) {
+	k.tallyFn = tallyFn
 }
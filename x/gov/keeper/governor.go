@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// DelegateGovernor delegates the governance voting power of the delegator to
+// the governor, replacing the previous governor of the delegator if any.
+func (keeper Keeper) DelegateGovernor(ctx context.Context, delegator, governor sdk.AccAddress) error {
+	params, err := keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.GovernorDelegationEnabled {
+		return errorsmod.Wrap(types.ErrGovernorDelegation, "governor delegation is disabled")
+	}
+
+	if delegator.Equals(governor) {
+		return errorsmod.Wrap(types.ErrGovernorDelegation, "cannot delegate to self")
+	}
+
+	if _, err := keeper.removeGovernanceDelegation(ctx, delegator); err != nil {
+		return err
+	}
+
+	delegation := v1.GovernanceDelegation{
+		DelegatorAddress: delegator.String(),
+		GovernorAddress:  governor.String(),
+	}
+	if err := keeper.SetGovernanceDelegation(ctx, delegation); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelegateGovernor,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegation.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyGovernor, delegation.GovernorAddress),
+		),
+	)
+
+	return nil
+}
+
+// UndelegateGovernor removes the delegation of the governance voting power of
+// the delegator to its governor.
+func (keeper Keeper) UndelegateGovernor(ctx context.Context, delegator sdk.AccAddress) error {
+	delegation, err := keeper.removeGovernanceDelegation(ctx, delegator)
+	if err != nil {
+		return err
+	}
+
+	if delegation == nil {
+		return errorsmod.Wrapf(types.ErrGovernorDelegation, "%s has no governor", delegator)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUndelegateGovernor,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegation.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyGovernor, delegation.GovernorAddress),
+		),
+	)
+
+	return nil
+}
+
+// SetGovernanceDelegation stores a governance delegation, indexes the
+// delegator under its governor and adds the shares of the delegations of the
+// delegator to the validator shares of the governor.
+func (keeper Keeper) SetGovernanceDelegation(ctx context.Context, delegation v1.GovernanceDelegation) error {
+	delegator, err := keeper.authKeeper.AddressCodec().StringToBytes(delegation.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	governor, err := keeper.authKeeper.AddressCodec().StringToBytes(delegation.GovernorAddress)
+	if err != nil {
+		return err
+	}
+
+	count, err := keeper.Governors.Get(ctx, governor)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if err := keeper.Governors.Set(ctx, governor, count+1); err != nil {
+		return err
+	}
+
+	if err := keeper.GovernorDelegators.Set(ctx, collections.Join(sdk.AccAddress(governor), sdk.AccAddress(delegator))); err != nil {
+		return err
+	}
+
+	if err := keeper.GovernanceDelegations.Set(ctx, delegator, delegation); err != nil {
+		return err
+	}
+
+	return keeper.addDelegatorValShares(ctx, governor, delegator, true)
+}
+
+// removeGovernanceDelegation removes the governance delegation of the
+// delegator, if any, and returns it.
+func (keeper Keeper) removeGovernanceDelegation(ctx context.Context, delegator sdk.AccAddress) (*v1.GovernanceDelegation, error) {
+	delegation, err := keeper.GovernanceDelegations.Get(ctx, delegator)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	governor, err := keeper.authKeeper.AddressCodec().StringToBytes(delegation.GovernorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := keeper.Governors.Get(ctx, governor)
+	if err != nil {
+		return nil, err
+	}
+
+	if count > 1 {
+		err = keeper.Governors.Set(ctx, governor, count-1)
+	} else {
+		err = keeper.Governors.Remove(ctx, governor)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := keeper.GovernorDelegators.Remove(ctx, collections.Join(sdk.AccAddress(governor), delegator)); err != nil {
+		return nil, err
+	}
+
+	if err := keeper.GovernanceDelegations.Remove(ctx, delegator); err != nil {
+		return nil, err
+	}
+
+	if err := keeper.addDelegatorValShares(ctx, governor, delegator, false); err != nil {
+		return nil, err
+	}
+
+	return &delegation, nil
+}
+
+// addDelegatorValShares adds the shares of the delegations of the delegator to
+// the validator shares of the governor, or subtracts them if add is false.
+func (keeper Keeper) addDelegatorValShares(ctx context.Context, governor, delegator sdk.AccAddress, add bool) error {
+	var err error
+	keeper.sk.IterateDelegations(sdk.UnwrapSDKContext(ctx), delegator, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+		shares := delegation.GetShares()
+		if !add {
+			shares = shares.Neg()
+		}
+
+		err = keeper.addGovernorValShares(ctx, governor, delegation.GetValidatorAddr(), shares)
+		return err != nil
+	})
+
+	return err
+}
+
+// addGovernorValShares adds the given shares, which may be negative, to the
+// validator shares of the governor. The entry is removed once no shares are
+// left.
+func (keeper Keeper) addGovernorValShares(ctx context.Context, governor sdk.AccAddress, validator sdk.ValAddress, shares math.LegacyDec) error {
+	key := collections.Join(governor, validator)
+	valShares, err := keeper.GovernorValShares.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		valShares.Dec = math.LegacyZeroDec()
+	}
+
+	valShares.Dec = valShares.Dec.Add(shares)
+	if !valShares.Dec.IsPositive() {
+		return keeper.GovernorValShares.Remove(ctx, key)
+	}
+
+	return keeper.GovernorValShares.Set(ctx, key, valShares)
+}
+
+// GetGovernor returns the governor with its number of delegators and the
+// voting power delegated to it, which is the voting power of the validator
+// shares of the governor on bonded validators.
+func (keeper Keeper) GetGovernor(ctx context.Context, governor sdk.AccAddress) (v1.Governor, error) {
+	count, err := keeper.Governors.Get(ctx, governor)
+	if err != nil {
+		return v1.Governor{}, err
+	}
+
+	validators := keeper.bondedValidators(sdk.UnwrapSDKContext(ctx))
+	votingPower := math.LegacyZeroDec()
+
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](governor)
+	err = keeper.GovernorValShares.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], shares sdk.DecProto) (bool, error) {
+		if val, ok := validators[key.K2().String()]; ok {
+			votingPower = votingPower.Add(shares.Dec.MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+		}
+
+		return false, nil
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return v1.Governor{}, err
+	}
+
+	return v1.Governor{
+		Address:              governor.String(),
+		DelegatorsCount:      count,
+		DelegatedVotingPower: votingPower.TruncateInt().String(),
+	}, nil
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestDelegateGovernor(t *testing.T) {
+	govKeeper, _, bankKeeper, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 3, sdkmath.NewInt(10000000))
+
+	// governor delegation is disabled by default
+	err := govKeeper.DelegateGovernor(ctx, addrs[0], addrs[1])
+	require.ErrorIs(t, err, types.ErrGovernorDelegation)
+
+	params, err := govKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.GovernorDelegationEnabled = true
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+	err = govKeeper.DelegateGovernor(ctx, addrs[0], addrs[0])
+	require.ErrorIs(t, err, types.ErrGovernorDelegation)
+
+	require.NoError(t, govKeeper.DelegateGovernor(ctx, addrs[0], addrs[1]))
+	require.NoError(t, govKeeper.DelegateGovernor(ctx, addrs[2], addrs[1]))
+
+	delegation, err := govKeeper.GovernanceDelegations.Get(ctx, addrs[0])
+	require.NoError(t, err)
+	require.Equal(t, addrs[1].String(), delegation.GovernorAddress)
+
+	governor, err := govKeeper.GetGovernor(ctx, addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, addrs[1].String(), governor.Address)
+	require.Equal(t, uint64(2), governor.DelegatorsCount)
+
+	// redelegating to another governor moves the delegator
+	require.NoError(t, govKeeper.DelegateGovernor(ctx, addrs[0], addrs[2]))
+
+	count, err := govKeeper.Governors.Get(ctx, addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+	count, err = govKeeper.Governors.Get(ctx, addrs[2])
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+
+	has, err := govKeeper.GovernorDelegators.Has(ctx, collections.Join(addrs[1], addrs[0]))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = govKeeper.GovernorDelegators.Has(ctx, collections.Join(addrs[2], addrs[0]))
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func TestUndelegateGovernor(t *testing.T) {
+	govKeeper, _, bankKeeper, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdkmath.NewInt(10000000))
+
+	params, err := govKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.GovernorDelegationEnabled = true
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+	err = govKeeper.UndelegateGovernor(ctx, addrs[0])
+	require.ErrorIs(t, err, types.ErrGovernorDelegation)
+
+	require.NoError(t, govKeeper.DelegateGovernor(ctx, addrs[0], addrs[1]))
+	require.NoError(t, govKeeper.UndelegateGovernor(ctx, addrs[0]))
+
+	has, err := govKeeper.GovernanceDelegations.Has(ctx, addrs[0])
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the governor is removed once it has no delegators left
+	has, err = govKeeper.Governors.Has(ctx, addrs[1])
+	require.NoError(t, err)
+	require.False(t, has)
+
+	_, err = govKeeper.GetGovernor(ctx, addrs[1])
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+func TestGovernorValSharesStakingHooks(t *testing.T) {
+	govKeeper, _, bankKeeper, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 3, sdkmath.NewInt(10000000))
+	valAddr := sdk.ValAddress(addrs[2])
+	hooks := govKeeper.StakingHooks()
+
+	params, err := govKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.GovernorDelegationEnabled = true
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+	require.NoError(t, govKeeper.DelegateGovernor(ctx, addrs[0], addrs[1]))
+
+	// a new delegation of the delegator is added to the governor
+	delegation := stakingtypes.NewDelegation(addrs[0], valAddr, sdkmath.LegacyNewDec(100))
+	stakingKeeper.EXPECT().Delegation(ctx, addrs[0], valAddr).Return(delegation).Times(2)
+	require.NoError(t, hooks.BeforeDelegationCreated(ctx, addrs[0], valAddr))
+	require.NoError(t, hooks.AfterDelegationModified(ctx, addrs[0], valAddr))
+
+	shares, err := govKeeper.GovernorValShares.Get(ctx, collections.Join(addrs[1], valAddr))
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(100), shares.Dec)
+
+	// a modified delegation replaces its previous shares
+	require.NoError(t, hooks.BeforeDelegationSharesModified(ctx, addrs[0], valAddr))
+	delegation.Shares = sdkmath.LegacyNewDec(40)
+	stakingKeeper.EXPECT().Delegation(ctx, addrs[0], valAddr).Return(delegation)
+	require.NoError(t, hooks.AfterDelegationModified(ctx, addrs[0], valAddr))
+
+	shares, err = govKeeper.GovernorValShares.Get(ctx, collections.Join(addrs[1], valAddr))
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(40), shares.Dec)
+
+	// a removed delegation is removed from the governor
+	stakingKeeper.EXPECT().Delegation(ctx, addrs[0], valAddr).Return(delegation)
+	require.NoError(t, hooks.BeforeDelegationSharesModified(ctx, addrs[0], valAddr))
+	require.NoError(t, hooks.BeforeDelegationRemoved(ctx, addrs[0], valAddr))
+
+	has, err := govKeeper.GovernorValShares.Has(ctx, collections.Join(addrs[1], valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// the delegations of delegators without governor are ignored
+	require.NoError(t, hooks.AfterDelegationModified(ctx, addrs[2], valAddr))
+}
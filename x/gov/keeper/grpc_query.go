@@ -191,6 +191,46 @@ func (q queryServer) Params(ctx context.Context, req *v1.QueryParamsRequest) (*v
 	return response, nil
 }
 
+// EffectiveParams queries the governance params which apply to a proposal with the given messages
+func (q queryServer) EffectiveParams(ctx context.Context, req *v1.QueryEffectiveParamsRequest) (*v1.QueryEffectiveParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := q.k.EffectiveParams(ctx, req.MsgUrls)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryEffectiveParamsResponse{Params: &params}, nil
+}
+
+// ProposalParams queries the governance params which apply to a proposal
+func (q queryServer) ProposalParams(ctx context.Context, req *v1.QueryProposalParamsRequest) (*v1.QueryProposalParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	proposal, err := q.k.Proposals.Get(ctx, req.ProposalId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	params, err := q.k.ProposalParams(ctx, proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryProposalParamsResponse{Params: &params}, nil
+}
+
 // Deposit queries single deposit information based on proposalID, depositAddr.
 func (q queryServer) Deposit(ctx context.Context, req *v1.QueryDepositRequest) (*v1.QueryDepositResponse, error) {
 	if req == nil {
@@ -278,6 +318,111 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// MultipleChoiceTallyResult queries the tally of each option of a multiple choice proposal
+func (q queryServer) MultipleChoiceTallyResult(ctx context.Context, req *v1.QueryMultipleChoiceTallyResultRequest) (*v1.QueryMultipleChoiceTallyResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	proposal, err := q.k.Proposals.Get(ctx, req.ProposalId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if proposal.ProposalType != v1.ProposalTypeMultipleChoice {
+		return nil, status.Errorf(codes.InvalidArgument, "proposal %d is not a multiple choice proposal", req.ProposalId)
+	}
+
+	res, err := q.TallyResult(ctx, &v1.QueryTallyResultRequest{ProposalId: req.ProposalId})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryMultipleChoiceTallyResultResponse{Tally: proposal.MultipleChoiceTallyResult(*res.Tally)}, nil
+}
+
+// GovernanceDelegation queries the governor to which an account delegated its governance voting power
+func (q queryServer) GovernanceDelegation(ctx context.Context, req *v1.QueryGovernanceDelegationRequest) (*v1.QueryGovernanceDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delegator, err := q.k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	delegation, err := q.k.GovernanceDelegations.Get(ctx, delegator)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "delegator %s has no governor", req.DelegatorAddress)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernanceDelegationResponse{GovernorAddress: delegation.GovernorAddress}, nil
+}
+
+// Governor queries a governor and the voting power delegated to it
+func (q queryServer) Governor(ctx context.Context, req *v1.QueryGovernorRequest) (*v1.QueryGovernorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GovernorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty governor address")
+	}
+
+	governorAddr, err := q.k.authKeeper.AddressCodec().StringToBytes(req.GovernorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	governor, err := q.k.GetGovernor(ctx, governorAddr)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "governor %s doesn't exist", req.GovernorAddress)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorResponse{Governor: &governor}, nil
+}
+
+// Governors queries all the governors and the voting power delegated to them
+func (q queryServer) Governors(ctx context.Context, req *v1.QueryGovernorsRequest) (*v1.QueryGovernorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var governors []*v1.Governor
+	_, pageRes, err := query.CollectionFilteredPaginate(ctx, q.k.Governors, req.Pagination, func(governorAddr sdk.AccAddress, _ uint64) (bool, error) {
+		governor, err := q.k.GetGovernor(ctx, governorAddr)
+		if err != nil {
+			return false, err
+		}
+
+		governors = append(governors, &governor)
+		return false, nil // we don't include results as they're being appended to the slice above.
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorsResponse{Governors: governors, Pagination: pageRes}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct{ qs v1.QueryServer }
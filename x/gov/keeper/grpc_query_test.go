@@ -12,6 +12,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	v3 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v3"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -59,7 +60,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "title", "summary", addrs[0], false)
+				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "title", "summary", addrs[0], v1.ProposalTypeStandard)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -129,7 +130,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "title", "summary", addrs[0], false)
+				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "title", "summary", addrs[0], v1.ProposalTypeStandard)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -145,7 +146,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "title", "summary", addrs[0], true)
+				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "title", "summary", addrs[0], v1.ProposalTypeExpedited)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -206,7 +207,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 					testProposal := []sdk.Msg{
 						v1.NewMsgVote(govAddress, uint64(i), v1.OptionYes, ""),
 					}
-					proposal, err := suite.govKeeper.SubmitProposal(ctx, testProposal, "", "title", "summary", addrs[0], false)
+					proposal, err := suite.govKeeper.SubmitProposal(ctx, testProposal, "", "title", "summary", addrs[0], v1.ProposalTypeStandard)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, &proposal)
@@ -339,7 +340,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposals() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "title", "summary", addrs[0], false)
+				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "title", "summary", addrs[0], v1.ProposalTypeStandard)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 			},
@@ -420,7 +421,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], v1.ProposalTypeStandard)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryVoteRequest{
@@ -534,7 +535,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], v1.ProposalTypeStandard)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryVoteRequest{
@@ -640,7 +641,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], v1.ProposalTypeStandard)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryVotesRequest{
@@ -744,7 +745,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], v1.ProposalTypeStandard)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryVotesRequest{
@@ -975,6 +976,122 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryParams() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryEffectiveAndProposalParams() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+
+	sendMsgURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	params := v1.DefaultParams()
+	params.MessageBasedParams = []v1.MessageBasedParams{
+		{MsgUrl: sendMsgURL, Quorum: "0.5", Threshold: "0.75"},
+	}
+	suite.Require().NoError(suite.govKeeper.Params.Set(ctx, params))
+	expParams := params.EffectiveParams([]string{sendMsgURL})
+
+	// effective params
+	res, err := queryClient.EffectiveParams(gocontext.Background(), &v1.QueryEffectiveParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, *res.Params)
+
+	res, err = queryClient.EffectiveParams(gocontext.Background(), &v1.QueryEffectiveParamsRequest{MsgUrls: []string{sendMsgURL}})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expParams, *res.Params)
+
+	// proposal params
+	_, err = queryClient.ProposalParams(gocontext.Background(), &v1.QueryProposalParamsRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.ProposalParams(gocontext.Background(), &v1.QueryProposalParamsRequest{ProposalId: 1})
+	suite.Require().Error(err)
+
+	proposal, err := suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], v1.ProposalTypeStandard)
+	suite.Require().NoError(err)
+
+	proposalRes, err := queryClient.ProposalParams(gocontext.Background(), &v1.QueryProposalParamsRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expParams, *proposalRes.Params)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryMultipleChoiceTallyResult() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	_, err := queryClient.MultipleChoiceTallyResult(gocontext.Background(), &v1.QueryMultipleChoiceTallyResultRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.MultipleChoiceTallyResult(gocontext.Background(), &v1.QueryMultipleChoiceTallyResultRequest{ProposalId: 1})
+	suite.Require().Error(err)
+
+	propTime := time.Now()
+	proposal := v1.Proposal{
+		Id:     1,
+		Status: v1.StatusPassed,
+		FinalTallyResult: &v1.TallyResult{
+			YesCount:        "4",
+			AbstainCount:    "1",
+			NoCount:         "0",
+			NoWithVetoCount: "0",
+			ChoiceCounts:    []string{"4", "1"},
+		},
+		SubmitTime:      &propTime,
+		VotingStartTime: &propTime,
+		VotingEndTime:   &propTime,
+		ProposalType:    v1.ProposalTypeStandard,
+	}
+	suite.Require().NoError(suite.govKeeper.SetProposal(ctx, proposal))
+
+	// standard proposals have no multiple choice tally
+	_, err = queryClient.MultipleChoiceTallyResult(gocontext.Background(), &v1.QueryMultipleChoiceTallyResultRequest{ProposalId: proposal.Id})
+	suite.Require().ErrorContains(err, "is not a multiple choice proposal")
+
+	proposal.ProposalType = v1.ProposalTypeMultipleChoice
+	proposal.VoteOptions = []string{"red", "blue"}
+	suite.Require().NoError(suite.govKeeper.SetProposal(ctx, proposal))
+
+	res, err := queryClient.MultipleChoiceTallyResult(gocontext.Background(), &v1.QueryMultipleChoiceTallyResultRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.OptionTallyResult{
+		{Choice: 0, Label: "red", Count: "4"},
+		{Choice: 1, Label: "blue", Count: "1"},
+	}, res.Tally)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryGovernors() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+
+	_, err := queryClient.GovernanceDelegation(gocontext.Background(), &v1.QueryGovernanceDelegationRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.GovernanceDelegation(gocontext.Background(), &v1.QueryGovernanceDelegationRequest{DelegatorAddress: addrs[0].String()})
+	suite.Require().ErrorContains(err, "has no governor")
+
+	_, err = queryClient.Governor(gocontext.Background(), &v1.QueryGovernorRequest{GovernorAddress: addrs[1].String()})
+	suite.Require().ErrorContains(err, "doesn't exist")
+
+	params, err := suite.govKeeper.Params.Get(ctx)
+	suite.Require().NoError(err)
+	params.GovernorDelegationEnabled = true
+	suite.Require().NoError(suite.govKeeper.Params.Set(ctx, params))
+
+	suite.Require().NoError(suite.govKeeper.DelegateGovernor(ctx, addrs[0], addrs[1]))
+	suite.Require().NoError(suite.govKeeper.DelegateGovernor(ctx, addrs[2], addrs[1]))
+	suite.Require().NoError(suite.govKeeper.DelegateGovernor(ctx, addrs[1], addrs[2]))
+
+	delegation, err := queryClient.GovernanceDelegation(gocontext.Background(), &v1.QueryGovernanceDelegationRequest{DelegatorAddress: addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(addrs[1].String(), delegation.GovernorAddress)
+
+	governor, err := queryClient.Governor(gocontext.Background(), &v1.QueryGovernorRequest{GovernorAddress: addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(addrs[1].String(), governor.Governor.Address)
+	suite.Require().Equal(uint64(2), governor.Governor.DelegatorsCount)
+
+	governors, err := queryClient.Governors(gocontext.Background(), &v1.QueryGovernorsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(governors.Governors, 2)
+}
+
 func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 	suite.reset()
 	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
@@ -1031,7 +1148,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], v1.ProposalTypeStandard)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1132,7 +1249,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], v1.ProposalTypeStandard)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1222,7 +1339,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], true)
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], v1.ProposalTypeExpedited)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryDepositsRequest{
@@ -1317,7 +1434,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], false)
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", addrs[0], v1.ProposalTypeStandard)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryDepositsRequest{
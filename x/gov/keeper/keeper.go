@@ -43,6 +43,9 @@ type Keeper struct {
 
 	config types.Config
 
+	// tallyFn computes the results of the votes cast on a proposal
+	tallyFn TallyFn
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -57,6 +60,15 @@ type Keeper struct {
 	ActiveProposalsQueue   collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	InactiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	VotingPeriodProposals  collections.Map[uint64, []byte]                              // TODO(tip): this could be a keyset or index.
+	// GovernanceDelegations maps delegators to their governance delegation.
+	GovernanceDelegations collections.Map[sdk.AccAddress, v1.GovernanceDelegation]
+	// GovernorDelegators indexes the delegators of each governor.
+	GovernorDelegators collections.KeySet[collections.Pair[sdk.AccAddress, sdk.AccAddress]]
+	// Governors maps governors to their number of delegators.
+	Governors collections.Map[sdk.AccAddress, uint64]
+	// GovernorValShares maps governors and validators to the shares of the
+	// delegations of the delegators of the governor to the validator.
+	GovernorValShares collections.Map[collections.Pair[sdk.AccAddress, sdk.ValAddress], sdk.DecProto]
 }
 
 // GetAuthority returns the x/gov module's authority.
@@ -68,13 +80,13 @@ func (k Keeper) GetAuthority() string {
 // - submitting governance proposals
 // - depositing funds into proposals, and activating upon sufficient funds being deposited
 // - users voting on proposals, with weight proportional to stake in the system
-// - and tallying the result of the vote.
+// - and tallying the result of the vote with tallyFn, or StakeWeightedTally if it is nil.
 //
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc codec.Codec, storeService corestoretypes.KVStoreService, authKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper, sk types.StakingKeeper, distrKeeper types.DistributionKeeper,
-	router baseapp.MessageRouter, config types.Config, authority string,
+	router baseapp.MessageRouter, config types.Config, tallyFn TallyFn, authority string,
 ) *Keeper {
 	// ensure governance module account is set
 	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -90,6 +102,14 @@ func NewKeeper(
 		config.MaxMetadataLen = types.DefaultConfig().MaxMetadataLen
 	}
 
+	if config.MaxVoteOptions == 0 {
+		config.MaxVoteOptions = types.DefaultConfig().MaxVoteOptions
+	}
+
+	if tallyFn == nil {
+		tallyFn = StakeWeightedTally
+	}
+
 	sb := collections.NewSchemaBuilder(storeService)
 	k := &Keeper{
 		storeService:           storeService,
@@ -100,6 +120,7 @@ func NewKeeper(
 		cdc:                    cdc,
 		router:                 router,
 		config:                 config,
+		tallyFn:                tallyFn,
 		authority:              authority,
 		Constitution:           collections.NewItem(sb, types.ConstitutionKey, "constitution", collections.StringValue),
 		Params:                 collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[v1.Params](cdc)),
@@ -110,6 +131,10 @@ func NewKeeper(
 		ActiveProposalsQueue:   collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
 		InactiveProposalsQueue: collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
 		VotingPeriodProposals:  collections.NewMap(sb, types.VotingPeriodProposalKeyPrefix, "voting_period_proposals", collections.Uint64Key, collections.BytesValue),
+		GovernanceDelegations:  collections.NewMap(sb, types.GovernanceDelegationsPrefix, "governance_delegations", sdk.AccAddressKey, codec.CollValue[v1.GovernanceDelegation](cdc)),
+		GovernorDelegators:     collections.NewKeySet(sb, types.GovernorDelegatorsPrefix, "governor_delegators", collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey)),
+		Governors:              collections.NewMap(sb, types.GovernorsPrefix, "governors", sdk.AccAddressKey, collections.Uint64Value),
+		GovernorValShares:      collections.NewMap(sb, types.GovernorValSharesPrefix, "governor_val_shares", collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey), codec.CollValue[sdk.DecProto](cdc)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -48,9 +48,9 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, err
 	}
 
-	// check that either metadata or Msgs length is non nil.
-	if len(msg.Messages) == 0 && len(msg.Metadata) == 0 {
-		return nil, errors.Wrap(govtypes.ErrNoProposalMsgs, "either metadata or Msgs length must be non-nil")
+	// check that either metadata, Msgs or vote options length is non nil.
+	if len(msg.Messages) == 0 && len(msg.Metadata) == 0 && len(msg.VoteOptions) == 0 {
+		return nil, errors.Wrap(govtypes.ErrNoProposalMsgs, "either metadata, Msgs or vote options length must be non-nil")
 	}
 
 	// verify that if present, the metadata title and summary equals the proposal title and summary
@@ -78,11 +78,27 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	initialDeposit := msg.GetInitialDeposit()
 
-	if err := k.validateInitialDeposit(ctx, initialDeposit, msg.Expedited); err != nil {
+	params, err := k.EffectiveParams(ctx, msgURLs(proposalMsgs))
+	if err != nil {
+		return nil, err
+	}
+
+	proposalType := msg.ProposalType
+	if _, ok := v1.ProposalType_name[int32(proposalType)]; !ok {
+		return nil, errors.Wrapf(govtypes.ErrInvalidProposalType, "%d", proposalType)
+	}
+	if msg.Expedited {
+		if proposalType != v1.ProposalType_PROPOSAL_TYPE_UNSPECIFIED && proposalType != v1.ProposalTypeExpedited {
+			return nil, errors.Wrapf(govtypes.ErrInvalidProposalType, "expedited proposal cannot be of type %s", proposalType)
+		}
+		proposalType = v1.ProposalTypeExpedited
+	}
+
+	if err := k.validateInitialDeposit(params, initialDeposit, proposalType == v1.ProposalTypeExpedited); err != nil {
 		return nil, err
 	}
 
-	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.Expedited)
+	proposal, err := k.Keeper.submitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, proposalType, msg.VoteOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -179,12 +195,16 @@ func (k msgServer) Vote(goCtx context.Context, msg *v1.MsgVote) (*v1.MsgVoteResp
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
 	}
 
-	if !v1.ValidVoteOption(msg.Option) {
+	// the choices of multiple choice proposals are voted with an unspecified option
+	options := v1.NewNonSplitVoteOption(msg.Option)
+	if msg.Option == v1.OptionEmpty {
+		options = v1.NewNonSplitVoteChoice(msg.Choice)
+	} else if !v1.ValidVoteOption(msg.Option) || msg.Choice != 0 {
 		return nil, errors.Wrap(govtypes.ErrInvalidVote, msg.Option.String())
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	err = k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, v1.NewNonSplitVoteOption(msg.Option), msg.Metadata)
+	err = k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, options, msg.Metadata)
 	if err != nil {
 		return nil, err
 	}
@@ -212,7 +232,11 @@ func (k msgServer) VoteWeighted(goCtx context.Context, msg *v1.MsgVoteWeighted)
 	}
 
 	totalWeight := math.LegacyNewDec(0)
-	usedOptions := make(map[v1.VoteOption]bool)
+	type voteOptionKey struct {
+		option v1.VoteOption
+		choice uint32
+	}
+	usedOptions := make(map[voteOptionKey]bool)
 	for _, option := range msg.Options {
 		if !option.IsValid() {
 			return nil, errors.Wrap(govtypes.ErrInvalidVote, option.String())
@@ -222,10 +246,11 @@ func (k msgServer) VoteWeighted(goCtx context.Context, msg *v1.MsgVoteWeighted)
 			return nil, errors.Wrapf(govtypes.ErrInvalidVote, "invalid weight: %s", err)
 		}
 		totalWeight = totalWeight.Add(weight)
-		if usedOptions[option.Option] {
+		key := voteOptionKey{option.Option, option.Choice}
+		if usedOptions[key] {
 			return nil, errors.Wrap(govtypes.ErrInvalidVote, "duplicated vote option")
 		}
-		usedOptions[option.Option] = true
+		usedOptions[key] = true
 	}
 
 	if totalWeight.GT(math.LegacyNewDec(1)) {
@@ -308,6 +333,41 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *v1.MsgUpdateParams)
 	return &v1.MsgUpdateParamsResponse{}, nil
 }
 
+// DelegateGovernor implements the MsgServer.DelegateGovernor method.
+func (k msgServer) DelegateGovernor(goCtx context.Context, msg *v1.MsgDelegateGovernor) (*v1.MsgDelegateGovernorResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	governor, err := k.authKeeper.AddressCodec().StringToBytes(msg.GovernorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.DelegateGovernor(ctx, delegator, governor); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgDelegateGovernorResponse{}, nil
+}
+
+// UndelegateGovernor implements the MsgServer.UndelegateGovernor method.
+func (k msgServer) UndelegateGovernor(goCtx context.Context, msg *v1.MsgUndelegateGovernor) (*v1.MsgUndelegateGovernorResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.UndelegateGovernor(ctx, delegator); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUndelegateGovernorResponse{}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
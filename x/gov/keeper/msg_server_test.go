@@ -31,6 +31,8 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 	initialDeposit := coins
 	params, _ := suite.govKeeper.Params.Get(suite.ctx)
 	minDeposit := params.MinDeposit
+	params.OptimisticAuthorizedAddresses = []string{proposer.String()}
+	suite.Require().NoError(suite.govKeeper.Params.Set(suite.ctx, params))
 	bankMsg := &banktypes.MsgSend{
 		FromAddress: govAcct.String(),
 		ToAddress:   proposer.String(),
@@ -192,6 +194,142 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 			expErr:    true,
 			expErrMsg: "proposal message not recognized by router",
 		},
+		"invalid proposal type": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				msg, err := v1.NewMsgSubmitProposal(
+					[]sdk.Msg{bankMsg},
+					initialDeposit,
+					proposer.String(),
+					"",
+					"Proposal",
+					"description of proposal",
+					false,
+				)
+				msg.ProposalType = 10
+				return msg, err
+			},
+			expErr:    true,
+			expErrMsg: "invalid proposal type",
+		},
+		"expedited optimistic proposal": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				msg, err := v1.NewMsgSubmitProposal(
+					[]sdk.Msg{bankMsg},
+					initialDeposit,
+					proposer.String(),
+					"",
+					"Proposal",
+					"description of proposal",
+					true,
+				)
+				msg.ProposalType = v1.ProposalTypeOptimistic
+				return msg, err
+			},
+			expErr:    true,
+			expErrMsg: "expedited proposal cannot be of type PROPOSAL_TYPE_OPTIMISTIC",
+		},
+		"optimistic proposal from unauthorized proposer": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				msg, err := v1.NewMsgSubmitProposal(
+					[]sdk.Msg{bankMsg},
+					initialDeposit,
+					addrs[1].String(),
+					"",
+					"Proposal",
+					"description of proposal",
+					false,
+				)
+				msg.ProposalType = v1.ProposalTypeOptimistic
+				return msg, err
+			},
+			expErr:    true,
+			expErrMsg: "proposer is not authorized to submit optimistic proposals",
+		},
+		"multiple choice proposal with messages": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				msg, err := v1.NewMsgSubmitProposal(
+					[]sdk.Msg{bankMsg},
+					initialDeposit,
+					proposer.String(),
+					"",
+					"Proposal",
+					"description of proposal",
+					false,
+				)
+				msg.ProposalType = v1.ProposalTypeMultipleChoice
+				msg.VoteOptions = []string{"red", "blue"}
+				return msg, err
+			},
+			expErr:    true,
+			expErrMsg: "multiple choice proposals cannot have messages",
+		},
+		"multiple choice proposal without vote options": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				msg, err := v1.NewMsgSubmitProposal(
+					[]sdk.Msg{},
+					initialDeposit,
+					proposer.String(),
+					"metadata",
+					"Proposal",
+					"description of proposal",
+					false,
+				)
+				msg.ProposalType = v1.ProposalTypeMultipleChoice
+				return msg, err
+			},
+			expErr:    true,
+			expErrMsg: "a multiple choice proposal must have between 2 and 10 vote options",
+		},
+		"vote options on standard proposal": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				msg, err := v1.NewMsgSubmitProposal(
+					[]sdk.Msg{bankMsg},
+					initialDeposit,
+					proposer.String(),
+					"",
+					"Proposal",
+					"description of proposal",
+					false,
+				)
+				msg.VoteOptions = []string{"red", "blue"}
+				return msg, err
+			},
+			expErr:    true,
+			expErrMsg: "vote options can only be set on multiple choice proposals",
+		},
+		"all good multiple choice": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				msg, err := v1.NewMsgSubmitProposal(
+					[]sdk.Msg{},
+					initialDeposit,
+					proposer.String(),
+					"",
+					"Proposal",
+					"description of proposal",
+					false,
+				)
+				msg.ProposalType = v1.ProposalTypeMultipleChoice
+				msg.VoteOptions = []string{"red", "blue", "green"}
+				return msg, err
+			},
+			expErr: false,
+		},
+		"all good optimistic": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				msg, err := v1.NewMsgSubmitProposal(
+					[]sdk.Msg{bankMsg},
+					initialDeposit,
+					proposer.String(),
+					"",
+					"Proposal",
+					"description of proposal",
+					false,
+				)
+				msg.ProposalType = v1.ProposalTypeOptimistic
+				return msg, err
+			},
+			expErr: false,
+		},
 		"all good": {
 			preRun: func() (*v1.MsgSubmitProposal, error) {
 				return v1.NewMsgSubmitProposal(
@@ -364,11 +502,29 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 	suite.Require().NotNil(res.ProposalId)
 	proposalID := res.ProposalId
 
+	msg, err = v1.NewMsgSubmitProposal(
+		[]sdk.Msg{},
+		minDeposit,
+		addrs[1].String(),
+		"",
+		"Proposal",
+		"description of proposal",
+		false,
+	)
+	suite.Require().NoError(err)
+	msg.ProposalType = v1.ProposalTypeMultipleChoice
+	msg.VoteOptions = []string{"red", "blue", "green", "yellow", "purple"}
+
+	res, err = suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+	suite.Require().NoError(err)
+	multipleChoiceProposalID := res.ProposalId
+
 	cases := map[string]struct {
 		preRun    func() uint64
 		expErr    bool
 		expErrMsg string
 		option    v1.VoteOption
+		choice    uint32
 		metadata  string
 		voter     sdk.AccAddress
 	}{
@@ -392,6 +548,38 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 			expErr:    true,
 			expErrMsg: "invalid vote option",
 		},
+		"vote option with choice": {
+			preRun: func() uint64 {
+				return proposalID
+			},
+			option:    v1.VoteOption_VOTE_OPTION_YES,
+			choice:    1,
+			voter:     proposer,
+			metadata:  "",
+			expErr:    true,
+			expErrMsg: "invalid vote",
+		},
+		"choice on standard proposal": {
+			preRun: func() uint64 {
+				return proposalID
+			},
+			option:    v1.VoteOption_VOTE_OPTION_UNSPECIFIED,
+			choice:    0,
+			voter:     proposer,
+			metadata:  "",
+			expErr:    true,
+			expErrMsg: "does not accept the vote",
+		},
+		"choice on multiple choice proposal": {
+			preRun: func() uint64 {
+				return multipleChoiceProposalID
+			},
+			option:   v1.VoteOption_VOTE_OPTION_UNSPECIFIED,
+			choice:   4,
+			voter:    proposer,
+			metadata: "",
+			expErr:   false,
+		},
 		"vote on inactive proposal": {
 			preRun: func() uint64 {
 				msg, err := v1.NewMsgSubmitProposal(
@@ -465,6 +653,7 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 		suite.Run(name, func() {
 			pID := tc.preRun()
 			voteReq := v1.NewMsgVote(tc.voter, pID, tc.option, tc.metadata)
+			voteReq.Choice = tc.choice
 			_, err := suite.msgSrvr.Vote(suite.ctx, voteReq)
 			if tc.expErr {
 				suite.Require().Error(err)
@@ -554,6 +743,19 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 			expErr:    true,
 			expErrMsg: "duplicated vote option",
 		},
+		"duplicate vote choices": {
+			preRun: func() uint64 {
+				return proposalID
+			},
+			option: v1.WeightedVoteOptions{
+				v1.NewWeightedVoteChoice(1, sdk.NewDecWithPrec(5, 1)),
+				v1.NewWeightedVoteChoice(1, sdk.NewDecWithPrec(5, 1)),
+			},
+			voter:     proposer,
+			metadata:  "",
+			expErr:    true,
+			expErrMsg: "duplicated vote option",
+		},
 		"zero weight": {
 			preRun: func() uint64 {
 				return proposalID
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// EffectiveParams returns the governance params which apply to a proposal
+// containing messages with the given type URLs, with the message based params
+// of these messages applied.
+func (keeper Keeper) EffectiveParams(ctx context.Context, msgURLs []string) (v1.Params, error) {
+	params, err := keeper.Params.Get(ctx)
+	if err != nil {
+		return v1.Params{}, err
+	}
+
+	return params.EffectiveParams(msgURLs), nil
+}
+
+// ProposalParams returns the governance params which apply to a proposal.
+func (keeper Keeper) ProposalParams(ctx context.Context, proposal v1.Proposal) (v1.Params, error) {
+	msgURLs := make([]string, len(proposal.Messages))
+	for i, msg := range proposal.Messages {
+		msgURLs[i] = msg.TypeUrl
+	}
+
+	return keeper.EffectiveParams(ctx, msgURLs)
+}
+
+// msgURLs returns the type URLs of msgs.
+func msgURLs(msgs []sdk.Msg) []string {
+	urls := make([]string, len(msgs))
+	for i, msg := range msgs {
+		urls[i] = sdk.MsgTypeURL(msg)
+	}
+
+	return urls
+}
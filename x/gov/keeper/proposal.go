@@ -9,13 +9,25 @@ import (
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"golang.org/x/exp/slices"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 // SubmitProposal creates a new proposal given an array of messages
-func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, expedited bool) (v1.Proposal, error) {
+func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, proposalType v1.ProposalType) (v1.Proposal, error) {
+	return keeper.submitProposal(ctx, messages, metadata, title, summary, proposer, proposalType, nil)
+}
+
+// SubmitMultipleChoiceProposal creates a new multiple choice proposal given
+// the labels of its vote options. Multiple choice proposals have no messages.
+func (keeper Keeper) SubmitMultipleChoiceProposal(ctx context.Context, metadata, title, summary string, proposer sdk.AccAddress, voteOptions []string) (v1.Proposal, error) {
+	return keeper.submitProposal(ctx, nil, metadata, title, summary, proposer, v1.ProposalTypeMultipleChoice, voteOptions)
+}
+
+func (keeper Keeper) submitProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, proposalType v1.ProposalType, voteOptions []string) (v1.Proposal, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
@@ -85,6 +97,20 @@ func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, met
 
 	}
 
+	// assert only multiple choice proposals have vote options, and that they
+	// have no messages
+	if proposalType == v1.ProposalTypeMultipleChoice {
+		if len(messages) > 0 {
+			return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalMsg, "multiple choice proposals cannot have messages")
+		}
+
+		if err := v1.ValidateVoteOptions(voteOptions, keeper.config.MaxVoteOptions); err != nil {
+			return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposal, err.Error())
+		}
+	} else if len(voteOptions) > 0 {
+		return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposal, "vote options can only be set on multiple choice proposals")
+	}
+
 	proposalID, err := keeper.ProposalID.Next(ctx)
 	if err != nil {
 		return v1.Proposal{}, err
@@ -95,13 +121,19 @@ func (keeper Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, met
 		return v1.Proposal{}, err
 	}
 
+	// assert the proposer is allowed to submit optimistic proposals
+	if proposalType == v1.ProposalTypeOptimistic && !slices.Contains(params.OptimisticAuthorizedAddresses, proposer.String()) {
+		return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposer, "proposer is not authorized to submit optimistic proposals")
+	}
+
 	submitTime := sdkCtx.BlockHeader().Time
 	depositPeriod := params.MaxDepositPeriod
 
-	proposal, err := v1.NewProposal(messages, proposalID, submitTime, submitTime.Add(*depositPeriod), metadata, title, summary, proposer, expedited)
+	proposal, err := v1.NewProposal(messages, proposalID, submitTime, submitTime.Add(*depositPeriod), metadata, title, summary, proposer, proposalType)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
+	proposal.VoteOptions = voteOptions
 
 	err = keeper.SetProposal(ctx, proposal)
 	if err != nil {
@@ -239,7 +271,7 @@ func (keeper Keeper) ActivateVotingPeriod(ctx context.Context, proposal v1.Propo
 	startTime := sdkCtx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
 	var votingPeriod *time.Duration
-	params, err := keeper.Params.Get(ctx)
+	params, err := keeper.ProposalParams(ctx, proposal)
 	if err != nil {
 		return err
 	}
@@ -19,17 +19,17 @@ import (
 // TODO(tip): remove this
 func (suite *KeeperTestSuite) TestGetSetProposal() {
 	testCases := map[string]struct {
-		expedited bool
+		proposalType v1.ProposalType
 	}{
 		"regular proposal": {},
 		"expedited proposal": {
-			expedited: true,
+			proposalType: v1.ProposalTypeExpedited,
 		},
 	}
 
 	for _, tc := range testCases {
 		tp := TestProposal
-		proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", suite.addrs[0], tc.expedited)
+		proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", suite.addrs[0], tc.proposalType)
 		suite.Require().NoError(err)
 		proposalID := proposal.Id
 		suite.govKeeper.SetProposal(suite.ctx, proposal)
@@ -43,11 +43,11 @@ func (suite *KeeperTestSuite) TestGetSetProposal() {
 // TODO(tip): remove this
 func (suite *KeeperTestSuite) TestDeleteProposal() {
 	testCases := map[string]struct {
-		expedited bool
+		proposalType v1.ProposalType
 	}{
 		"regular proposal": {},
 		"expedited proposal": {
-			expedited: true,
+			proposalType: v1.ProposalTypeExpedited,
 		},
 	}
 
@@ -56,7 +56,7 @@ func (suite *KeeperTestSuite) TestDeleteProposal() {
 		suite.Require().ErrorIs(suite.govKeeper.DeleteProposal(suite.ctx, 10), collections.ErrNotFound)
 
 		tp := TestProposal
-		proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", suite.addrs[0], tc.expedited)
+		proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", suite.addrs[0], tc.proposalType)
 		suite.Require().NoError(err)
 		proposalID := proposal.Id
 		suite.govKeeper.SetProposal(suite.ctx, proposal)
@@ -68,16 +68,16 @@ func (suite *KeeperTestSuite) TestDeleteProposal() {
 
 func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	testCases := []struct {
-		name      string
-		expedited bool
+		name         string
+		proposalType v1.ProposalType
 	}{
 		{name: "regular proposal"},
-		{name: "expedited proposal", expedited: true},
+		{name: "expedited proposal", proposalType: v1.ProposalTypeExpedited},
 	}
 
 	for _, tc := range testCases {
 		tp := TestProposal
-		proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", suite.addrs[0], tc.expedited)
+		proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", suite.addrs[0], tc.proposalType)
 		suite.Require().NoError(err)
 
 		suite.Require().Nil(proposal.VotingStartTime)
@@ -97,17 +97,17 @@ func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 
 func (suite *KeeperTestSuite) TestDeleteProposalInVotingPeriod() {
 	testCases := []struct {
-		name      string
-		expedited bool
+		name         string
+		proposalType v1.ProposalType
 	}{
 		{name: "regular proposal"},
-		{name: "expedited proposal", expedited: true},
+		{name: "expedited proposal", proposalType: v1.ProposalTypeExpedited},
 	}
 
 	for _, tc := range testCases {
 		suite.reset()
 		tp := TestProposal
-		proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", suite.addrs[0], tc.expedited)
+		proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", suite.addrs[0], tc.proposalType)
 		suite.Require().NoError(err)
 		suite.Require().Nil(proposal.VotingStartTime)
 
@@ -144,45 +144,83 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	tp := v1beta1.TextProposal{Title: "title", Description: "description"}
 
 	testCases := []struct {
-		content     v1beta1.Content
-		authority   string
-		metadata    string
-		expedited   bool
-		expectedErr error
+		content      v1beta1.Content
+		authority    string
+		metadata     string
+		proposalType v1.ProposalType
+		expectedErr  error
 	}{
-		{&tp, govAcct, "", false, nil},
-		{&tp, govAcct, "", true, nil},
+		{&tp, govAcct, "", v1.ProposalTypeStandard, nil},
+		{&tp, govAcct, "", v1.ProposalTypeExpedited, nil},
 		// Keeper does not check the validity of title and description, no error
-		{&v1beta1.TextProposal{Title: "", Description: "description"}, govAcct, "", false, nil},
-		{&v1beta1.TextProposal{Title: strings.Repeat("1234567890", 100), Description: "description"}, govAcct, "", false, nil},
-		{&v1beta1.TextProposal{Title: "title", Description: ""}, govAcct, "", false, nil},
-		{&v1beta1.TextProposal{Title: "title", Description: strings.Repeat("1234567890", 1000)}, govAcct, "", true, nil},
+		{&v1beta1.TextProposal{Title: "", Description: "description"}, govAcct, "", v1.ProposalTypeStandard, nil},
+		{&v1beta1.TextProposal{Title: strings.Repeat("1234567890", 100), Description: "description"}, govAcct, "", v1.ProposalTypeStandard, nil},
+		{&v1beta1.TextProposal{Title: "title", Description: ""}, govAcct, "", v1.ProposalTypeStandard, nil},
+		{&v1beta1.TextProposal{Title: "title", Description: strings.Repeat("1234567890", 1000)}, govAcct, "", v1.ProposalTypeExpedited, nil},
 		// error when metadata is too long (>10000)
-		{&tp, govAcct, strings.Repeat("a", 100001), true, types.ErrMetadataTooLong},
+		{&tp, govAcct, strings.Repeat("a", 100001), v1.ProposalTypeExpedited, types.ErrMetadataTooLong},
 		// error when signer is not gov acct
-		{&tp, randomAddr.String(), "", false, types.ErrInvalidSigner},
+		{&tp, randomAddr.String(), "", v1.ProposalTypeStandard, types.ErrInvalidSigner},
 		// error only when invalid route
-		{&invalidProposalRoute{}, govAcct, "", false, types.ErrNoProposalHandlerExists},
+		{&invalidProposalRoute{}, govAcct, "", v1.ProposalTypeStandard, types.ErrNoProposalHandlerExists},
+		// error when the proposer is not allowed to submit optimistic proposals
+		{&tp, govAcct, "", v1.ProposalTypeOptimistic, types.ErrInvalidProposer},
 	}
 
 	for i, tc := range testCases {
 		prop, err := v1.NewLegacyContent(tc.content, tc.authority)
 		suite.Require().NoError(err)
-		_, err = suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata, "title", "", suite.addrs[0], tc.expedited)
+		_, err = suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata, "title", "", suite.addrs[0], tc.proposalType)
 		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitMultipleChoiceProposal() {
+	testCases := []struct {
+		name        string
+		voteOptions []string
+		expectedErr error
+	}{
+		{"valid vote options", []string{"red", "blue"}, nil},
+		{"not enough vote options", []string{"red"}, types.ErrInvalidProposal},
+		{"five vote options", []string{"red", "blue", "green", "yellow", "purple"}, nil},
+		{"too many vote options", []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"}, types.ErrInvalidProposal},
+		{"duplicate vote options", []string{"red", "red"}, types.ErrInvalidProposal},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			proposal, err := suite.govKeeper.SubmitMultipleChoiceProposal(suite.ctx, "", "title", "summary", suite.addrs[0], tc.voteOptions)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(v1.ProposalTypeMultipleChoice, proposal.ProposalType)
+			suite.Require().Empty(proposal.Messages)
+
+			gotProposal, err := suite.govKeeper.Proposals.Get(suite.ctx, proposal.Id)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.voteOptions, gotProposal.VoteOptions)
+		})
+	}
+
+	// multiple choice proposals cannot have messages
+	_, err := suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "title", "summary", suite.addrs[0], v1.ProposalTypeMultipleChoice)
+	suite.Require().ErrorIs(err, types.ErrInvalidProposalMsg)
+}
+
 func (suite *KeeperTestSuite) TestCancelProposal() {
 	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress().String()
 	tp := v1beta1.TextProposal{Title: "title", Description: "description"}
 	prop, err := v1.NewLegacyContent(&tp, govAcct)
 	suite.Require().NoError(err)
-	proposalResp, err := suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, "", "title", "summary", suite.addrs[0], false)
+	proposalResp, err := suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, "", "title", "summary", suite.addrs[0], v1.ProposalTypeStandard)
 	suite.Require().NoError(err)
 	proposalID := proposalResp.Id
 
-	proposal2Resp, err := suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, "", "title", "summary", suite.addrs[1], true)
+	proposal2Resp, err := suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, "", "title", "summary", suite.addrs[1], v1.ProposalTypeExpedited)
 	proposal2ID := proposal2Resp.Id
 	makeProposalPass := func() {
 		proposal2, err := suite.govKeeper.Proposals.Get(suite.ctx, proposal2ID)
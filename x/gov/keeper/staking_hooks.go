@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingHooks keeps the validator shares of the governors up to date with the
+// delegations of their delegators.
+type StakingHooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = StakingHooks{}
+
+// StakingHooks returns the staking hooks of the governance keeper.
+func (keeper Keeper) StakingHooks() StakingHooks {
+	return StakingHooks{keeper}
+}
+
+// BeforeDelegationSharesModified subtracts the shares of the delegation from the
+// validator shares of the governor of the delegator, if any.
+func (h StakingHooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.addDelegationShares(ctx, delAddr, valAddr, false)
+}
+
+// AfterDelegationModified adds the shares of the delegation to the validator
+// shares of the governor of the delegator, if any.
+func (h StakingHooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.addDelegationShares(ctx, delAddr, valAddr, true)
+}
+
+// addDelegationShares adds the shares of the delegation to the validator shares
+// of the governor of the delegator, or subtracts them if add is false.
+func (h StakingHooks) addDelegationShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, add bool) error {
+	govDelegation, err := h.k.GovernanceDelegations.Get(ctx, delAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	governor, err := h.k.authKeeper.AddressCodec().StringToBytes(govDelegation.GovernorAddress)
+	if err != nil {
+		return err
+	}
+
+	delegation := h.k.sk.Delegation(ctx, delAddr, valAddr)
+	if delegation == nil {
+		return nil
+	}
+
+	shares := delegation.GetShares()
+	if !add {
+		shares = shares.Neg()
+	}
+
+	return h.k.addGovernorValShares(ctx, governor, valAddr, shares)
+}
+
+func (h StakingHooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdkmath.LegacyDec) error {
+	return nil
+}
+
+func (h StakingHooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
+	return nil
+}
+
+func (h StakingHooks) BeforeTokenizeShareRecordRemoved(_ sdk.Context, _ uint64) error {
+	return nil
+}
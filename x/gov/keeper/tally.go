@@ -13,49 +13,69 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// TODO: Break into several smaller functions for clarity
+// TallyFn computes the results of the votes cast on a proposal. It returns the
+// voting power cast for each vote option, the voting power cast for each choice
+// of a multiple choice proposal, indexed by choice, the total voting power of
+// the voters and the total voting power of the electorate, against which the
+// quorum is checked. A TallyFn must not modify the votes as they are removed by
+// the keeper once the proposal has been tallied.
+type TallyFn func(ctx context.Context, k Keeper, proposal v1.Proposal) (results map[v1.VoteOption]math.LegacyDec, choiceResults []math.LegacyDec, totalVoterPower, totalPower math.LegacyDec, err error)
 
-// Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
-func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
-	results := make(map[v1.VoteOption]math.LegacyDec)
+// StakeWeightedTally is the default TallyFn. Delegators vote with the voting
+// power of their delegations to bonded validators and validators vote with the
+// voting power of the delegations of the delegators which did not vote. When
+// governor delegation is enabled, governors vote with the voting power of the
+// validator shares delegated to them, kept up to date by the staking hooks,
+// minus the shares of their delegators which voted, before validators. The
+// electorate is the total bonded stake.
+func StakeWeightedTally(ctx context.Context, k Keeper, proposal v1.Proposal) (results map[v1.VoteOption]math.LegacyDec, choiceResults []math.LegacyDec, totalVoterPower, totalPower math.LegacyDec, err error) {
+	results = make(map[v1.VoteOption]math.LegacyDec)
 	results[v1.OptionYes] = math.LegacyZeroDec()
 	results[v1.OptionAbstain] = math.LegacyZeroDec()
 	results[v1.OptionNo] = math.LegacyZeroDec()
 	results[v1.OptionNoWithVeto] = math.LegacyZeroDec()
 
-	totalVotingPower := math.LegacyZeroDec()
-	currValidators := make(map[string]v1.ValidatorGovInfo)
+	choiceResults = make([]math.LegacyDec, len(proposal.VoteOptions))
+	for i := range choiceResults {
+		choiceResults[i] = math.LegacyZeroDec()
+	}
 
-	// fetch all the bonded validators, insert them into currValidators
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	keeper.sk.IterateBondedValidatorsByPower(sdkCtx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
-		currValidators[validator.GetOperator().String()] = v1.NewValidatorGovInfo(
-			validator.GetOperator(),
-			validator.GetBondedTokens(),
-			validator.GetDelegatorShares(),
-			math.LegacyZeroDec(),
-			v1.WeightedVoteOptions{},
-		)
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, nil, math.LegacyDec{}, math.LegacyDec{}, err
+	}
 
-		return false
-	})
-	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
-	err = keeper.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
-		// if validator, just record it in the map
-		voter, err := keeper.authKeeper.AddressCodec().StringToBytes(vote.Voter)
-		if err != nil {
-			return false, err
-		}
+	totalVotingPower := math.LegacyZeroDec()
 
-		valAddrStr := sdk.ValAddress(voter).String()
-		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Options
-			currValidators[valAddrStr] = val
+	// addVotingPower splits the voting power between the vote options, or the
+	// choices of a multiple choice proposal, by weight.
+	addVotingPower := func(options []*v1.WeightedVoteOption, votingPower math.LegacyDec) {
+		for _, option := range options {
+			weight, _ := math.LegacyNewDecFromStr(option.Weight)
+			subPower := votingPower.Mul(weight)
+			if option.Option == v1.OptionEmpty {
+				if int(option.Choice) < len(choiceResults) {
+					choiceResults[option.Choice] = choiceResults[option.Choice].Add(subPower)
+				}
+				continue
+			}
+			results[option.Option] = results[option.Option].Add(subPower)
 		}
+		totalVotingPower = totalVotingPower.Add(votingPower)
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currValidators := k.bondedValidators(sdkCtx)
+
+	// governorVotes are the votes of the governors and governorDeductions the
+	// validator shares of the governors' delegators which voted themselves.
+	governorVotes := make(map[string]v1.WeightedVoteOptions)
+	governorDeductions := make(map[string]map[string]math.LegacyDec)
 
-		// iterate over all delegations from voter, deduct from any delegated-to validators
-		keeper.sk.IterateDelegations(sdkCtx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+	// tallyDelegations iterates over all delegations from the delegator, deducts
+	// them from any delegated-to validators and governor and adds their voting
+	// power to the results of the vote options.
+	tallyDelegations := func(delegator sdk.AccAddress, governor string, options []*v1.WeightedVoteOption) {
+		k.sk.IterateDelegations(sdkCtx, delegator, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
 			valAddrStr := delegation.GetValidatorAddr().String()
 
 			if val, ok := currValidators[valAddrStr]; ok {
@@ -64,25 +84,107 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
 				currValidators[valAddrStr] = val
 
+				if governor != "" {
+					if governorDeductions[governor] == nil {
+						governorDeductions[governor] = make(map[string]math.LegacyDec)
+					}
+					if deductions, ok := governorDeductions[governor][valAddrStr]; ok {
+						governorDeductions[governor][valAddrStr] = deductions.Add(delegation.GetShares())
+					} else {
+						governorDeductions[governor][valAddrStr] = delegation.GetShares()
+					}
+				}
+
 				// delegation shares * bonded / total shares
 				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
-
-				for _, option := range vote.Options {
-					weight, _ := math.LegacyNewDecFromStr(option.Weight)
-					subPower := votingPower.Mul(weight)
-					results[option.Option] = results[option.Option].Add(subPower)
-				}
-				totalVotingPower = totalVotingPower.Add(votingPower)
+				addVotingPower(options, votingPower)
 			}
 
 			return false
 		})
+	}
+
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
+	err = k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+		// if validator, just record it in the map
+		voter, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
+		if err != nil {
+			return false, err
+		}
+
+		valAddrStr := sdk.ValAddress(voter).String()
+		if val, ok := currValidators[valAddrStr]; ok {
+			val.Vote = vote.Options
+			currValidators[valAddrStr] = val
+		}
+
+		if !params.GovernorDelegationEnabled {
+			tallyDelegations(voter, "", vote.Options)
+			return false, nil
+		}
+
+		// if governor, just record it in the map
+		isGovernor, err := k.Governors.Has(ctx, voter)
+		if err != nil {
+			return false, err
+		}
+		if isGovernor {
+			governorVotes[sdk.AccAddress(voter).String()] = vote.Options
+		}
+
+		// the delegations of a delegator which voted are deducted from its governor
+		var governor string
+		govDelegation, err := k.GovernanceDelegations.Get(ctx, voter)
+		switch {
+		case err == nil:
+			governor = govDelegation.GovernorAddress
+		case !errors.Is(err, collections.ErrNotFound):
+			return false, err
+		}
 
-		return false, keeper.Votes.Remove(ctx, collections.Join(vote.ProposalId, sdk.AccAddress(voter)))
+		tallyDelegations(voter, governor, vote.Options)
+		return false, nil
 	})
 
 	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
-		return false, false, tallyResults, err
+		return nil, nil, math.LegacyDec{}, math.LegacyDec{}, err
+	}
+
+	// iterate over the governors to tally the voting power of the validator
+	// shares of their delegators which did not vote, before validators
+	for governorStr, options := range governorVotes {
+		governor, err := k.authKeeper.AddressCodec().StringToBytes(governorStr)
+		if err != nil {
+			return nil, nil, math.LegacyDec{}, math.LegacyDec{}, err
+		}
+
+		governorRng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](governor)
+		err = k.GovernorValShares.Walk(ctx, governorRng, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], valShares sdk.DecProto) (bool, error) {
+			valAddrStr := key.K2().String()
+			val, ok := currValidators[valAddrStr]
+			if !ok {
+				return false, nil
+			}
+
+			shares := valShares.Dec
+			if deductions, ok := governorDeductions[governorStr][valAddrStr]; ok {
+				shares = shares.Sub(deductions)
+			}
+			if !shares.IsPositive() {
+				return false, nil
+			}
+
+			val.DelegatorDeductions = val.DelegatorDeductions.Add(shares)
+			currValidators[valAddrStr] = val
+
+			votingPower := shares.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+			addVotingPower(options, votingPower)
+
+			return false, nil
+		})
+		if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+			return nil, nil, math.LegacyDec{}, math.LegacyDec{}, err
+		}
 	}
 
 	// iterate over the validators again to tally their voting power
@@ -93,34 +195,101 @@ func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, b
 
 		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
 		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+		addVotingPower(val.Vote, votingPower)
+	}
 
-		for _, option := range val.Vote {
-			weight, _ := math.LegacyNewDecFromStr(option.Weight)
-			subPower := votingPower.Mul(weight)
-			results[option.Option] = results[option.Option].Add(subPower)
+	return results, choiceResults, totalVotingPower, math.LegacyNewDecFromInt(k.sk.TotalBondedTokens(sdkCtx)), nil
+}
+
+// bondedValidators returns the bonded validators, without votes nor
+// deductions, indexed by operator address.
+func (keeper Keeper) bondedValidators(ctx sdk.Context) map[string]v1.ValidatorGovInfo {
+	validators := make(map[string]v1.ValidatorGovInfo)
+	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
+		validators[validator.GetOperator().String()] = v1.NewValidatorGovInfo(
+			validator.GetOperator(),
+			validator.GetBondedTokens(),
+			validator.GetDelegatorShares(),
+			math.LegacyZeroDec(),
+			v1.WeightedVoteOptions{},
+		)
+
+		return false
+	})
+
+	return validators
+}
+
+// Tally computes the results of the votes of a proposal with the keeper's
+// TallyFn, removes the votes and checks the results against the quorum and
+// thresholds of the governance params which apply to the proposal. Optimistic
+// proposals pass unless their no votes reach the optimistic rejected threshold,
+// and multiple choice proposals pass once the quorum is reached.
+func (keeper Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
+	results, choiceResults, totalVotingPower, totalPower, err := keeper.tallyFn(ctx, keeper, proposal)
+	if err != nil {
+		return false, false, tallyResults, err
+	}
+
+	for _, option := range []v1.VoteOption{v1.OptionYes, v1.OptionAbstain, v1.OptionNo, v1.OptionNoWithVeto} {
+		if results[option].IsNil() {
+			results[option] = math.LegacyZeroDec()
 		}
-		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
-	params, err := keeper.Params.Get(ctx)
+	if err := keeper.deleteVotes(ctx, proposal.Id); err != nil {
+		return false, false, tallyResults, err
+	}
+
+	params, err := keeper.ProposalParams(ctx, proposal)
 	if err != nil {
 		return false, false, tallyResults, err
 	}
 	tallyResults = v1.NewTallyResultFromMap(results)
+	if proposal.ProposalType == v1.ProposalTypeMultipleChoice {
+		tallyResults.ChoiceCounts = make([]string, len(proposal.VoteOptions))
+		for i := range tallyResults.ChoiceCounts {
+			count := math.ZeroInt()
+			if i < len(choiceResults) && !choiceResults[i].IsNil() {
+				count = choiceResults[i].TruncateInt()
+			}
+			tallyResults.ChoiceCounts[i] = count.String()
+		}
+	}
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
-	// If there is no staked coins, the proposal fails
-	if keeper.sk.TotalBondedTokens(sdkCtx).IsZero() {
+	// If there is no voting power, the proposal fails
+	if totalPower.IsZero() {
 		return false, false, tallyResults, nil
 	}
 
+	// An optimistic proposal passes unless the no votes reach the optimistic
+	// rejected threshold of the total voting power
+	if proposal.ProposalType == v1.ProposalTypeOptimistic {
+		rejectedThreshold, err := math.LegacyNewDecFromStr(params.OptimisticRejectedThreshold)
+		if err != nil {
+			return false, false, tallyResults, err
+		}
+		if results[v1.OptionNo].Quo(totalPower).GTE(rejectedThreshold) {
+			return false, false, tallyResults, nil
+		}
+
+		return true, false, tallyResults, nil
+	}
+
 	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(math.LegacyNewDecFromInt(keeper.sk.TotalBondedTokens(sdkCtx)))
+	percentVoting := totalVotingPower.Quo(totalPower)
 	quorum, _ := math.LegacyNewDecFromStr(params.Quorum)
 	if percentVoting.LT(quorum) {
 		return false, params.BurnVoteQuorum, tallyResults, nil
 	}
 
+	// Multiple choice proposals have no threshold, they pass once the quorum
+	// is reached and their result is the voting power of each option.
+	if proposal.ProposalType == v1.ProposalTypeMultipleChoice {
+		return true, false, tallyResults, nil
+	}
+
 	// If no one votes (everyone abstains), proposal fails
 	if totalVotingPower.Sub(results[v1.OptionAbstain]).Equal(math.LegacyZeroDec()) {
 		return false, false, tallyResults, nil
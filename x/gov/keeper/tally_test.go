@@ -0,0 +1,351 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// oneAccountOneVote returns a TallyFn in which each member has a single vote.
+func oneAccountOneVote(members []sdk.AccAddress) keeper.TallyFn {
+	return func(ctx context.Context, k keeper.Keeper, proposal v1.Proposal) (map[v1.VoteOption]sdkmath.LegacyDec, []sdkmath.LegacyDec, sdkmath.LegacyDec, sdkmath.LegacyDec, error) {
+		results := map[v1.VoteOption]sdkmath.LegacyDec{}
+		choiceResults := make([]sdkmath.LegacyDec, len(proposal.VoteOptions))
+		for i := range choiceResults {
+			choiceResults[i] = sdkmath.LegacyZeroDec()
+		}
+		totalVoterPower := sdkmath.LegacyZeroDec()
+
+		rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
+		err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+			for _, member := range members {
+				if !member.Equals(key.K2()) {
+					continue
+				}
+
+				for _, option := range vote.Options {
+					weight, err := sdkmath.LegacyNewDecFromStr(option.Weight)
+					if err != nil {
+						return true, err
+					}
+
+					if option.Option == v1.OptionEmpty {
+						choiceResults[option.Choice] = choiceResults[option.Choice].Add(weight)
+						continue
+					}
+
+					if results[option.Option].IsNil() {
+						results[option.Option] = sdkmath.LegacyZeroDec()
+					}
+					results[option.Option] = results[option.Option].Add(weight)
+				}
+				totalVoterPower = totalVoterPower.Add(sdkmath.LegacyOneDec())
+			}
+
+			return false, nil
+		})
+
+		if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+			return nil, nil, sdkmath.LegacyDec{}, sdkmath.LegacyDec{}, err
+		}
+
+		return results, choiceResults, totalVoterPower, sdkmath.LegacyNewDec(int64(len(members))), nil
+	}
+}
+
+func TestTallyFn(t *testing.T) {
+	testCases := []struct {
+		name            string
+		votes           []v1.VoteOption
+		expPass         bool
+		expTallyResults v1.TallyResult
+	}{
+		{
+			name:            "no quorum",
+			votes:           []v1.VoteOption{v1.OptionYes},
+			expPass:         false,
+			expTallyResults: v1.NewTallyResult(sdkmath.NewInt(1), sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdkmath.ZeroInt()),
+		},
+		{
+			name:            "majority yes",
+			votes:           []v1.VoteOption{v1.OptionYes, v1.OptionYes, v1.OptionNo},
+			expPass:         true,
+			expTallyResults: v1.NewTallyResult(sdkmath.NewInt(2), sdkmath.ZeroInt(), sdkmath.NewInt(1), sdkmath.ZeroInt()),
+		},
+		{
+			name:            "majority no",
+			votes:           []v1.VoteOption{v1.OptionYes, v1.OptionNo, v1.OptionNo, v1.OptionAbstain},
+			expPass:         false,
+			expTallyResults: v1.NewTallyResult(sdkmath.NewInt(1), sdkmath.NewInt(1), sdkmath.NewInt(2), sdkmath.ZeroInt()),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			govKeeper, _, bankKeeper, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+			members := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 4, sdkmath.NewInt(10000000))
+			govKeeper.SetTallyFn(oneAccountOneVote(members))
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "description", members[0], v1.ProposalTypeStandard)
+			require.NoError(t, err)
+			proposal.Status = v1.StatusVotingPeriod
+			govKeeper.SetProposal(ctx, proposal)
+
+			for i, option := range tc.votes {
+				require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, members[i], v1.NewNonSplitVoteOption(option), ""))
+			}
+
+			passes, burnDeposits, tallyResults, err := govKeeper.Tally(ctx, proposal)
+			require.NoError(t, err)
+			require.Equal(t, tc.expPass, passes)
+			require.False(t, burnDeposits)
+			require.Equal(t, tc.expTallyResults, tallyResults)
+
+			// votes are removed once the proposal has been tallied
+			for _, member := range members {
+				has, err := govKeeper.Votes.Has(ctx, collections.Join(proposal.Id, member))
+				require.NoError(t, err)
+				require.False(t, has)
+			}
+		})
+	}
+}
+
+func TestTallyMessageBasedParams(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msgs    []sdk.Msg
+		expPass bool
+	}{
+		{
+			name:    "with MsgSend",
+			msgs:    TestProposal,
+			expPass: false,
+		},
+		{
+			name:    "without MsgSend",
+			msgs:    TestProposal[1:],
+			expPass: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			govKeeper, _, bankKeeper, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+			members := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 4, sdkmath.NewInt(10000000))
+			govKeeper.SetTallyFn(oneAccountOneVote(members))
+
+			// proposals containing a MsgSend need a 75% majority
+			params, err := govKeeper.Params.Get(ctx)
+			require.NoError(t, err)
+			params.MessageBasedParams = []v1.MessageBasedParams{
+				{MsgUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), Threshold: "0.75"},
+			}
+			require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+			proposal, err := govKeeper.SubmitProposal(ctx, tc.msgs, "", "title", "description", members[0], v1.ProposalTypeStandard)
+			require.NoError(t, err)
+			proposal.Status = v1.StatusVotingPeriod
+			govKeeper.SetProposal(ctx, proposal)
+
+			for i, option := range []v1.VoteOption{v1.OptionYes, v1.OptionYes, v1.OptionNo} {
+				require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, members[i], v1.NewNonSplitVoteOption(option), ""))
+			}
+
+			passes, _, _, err := govKeeper.Tally(ctx, proposal)
+			require.NoError(t, err)
+			require.Equal(t, tc.expPass, passes)
+		})
+	}
+}
+
+func TestTallyStrictestParams(t *testing.T) {
+	yes, no := v1.OptionYes, v1.OptionNo
+	testCases := []struct {
+		name         string
+		msgs         []sdk.Msg
+		threshold    string
+		proposalType v1.ProposalType
+		votes        []v1.VoteOption
+		expPass      bool
+	}{
+		{
+			name:         "laxer override",
+			msgs:         TestProposal[:1],
+			threshold:    "0.2",
+			proposalType: v1.ProposalTypeStandard,
+			votes:        []v1.VoteOption{yes, no, no},
+			expPass:      true,
+		},
+		{
+			name:         "laxer override bundled with a message without override",
+			msgs:         TestProposal,
+			threshold:    "0.2",
+			proposalType: v1.ProposalTypeStandard,
+			votes:        []v1.VoteOption{yes, no, no},
+			expPass:      false,
+		},
+		{
+			name:         "expedited without override",
+			msgs:         TestProposal[1:],
+			threshold:    "0.8",
+			proposalType: v1.ProposalTypeExpedited,
+			votes:        []v1.VoteOption{yes, yes, yes, no},
+			expPass:      true,
+		},
+		{
+			name:         "expedited with a stricter override",
+			msgs:         TestProposal,
+			threshold:    "0.8",
+			proposalType: v1.ProposalTypeExpedited,
+			votes:        []v1.VoteOption{yes, yes, yes, no},
+			expPass:      false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			govKeeper, _, bankKeeper, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+			members := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 4, sdkmath.NewInt(10000000))
+			govKeeper.SetTallyFn(oneAccountOneVote(members))
+
+			params, err := govKeeper.Params.Get(ctx)
+			require.NoError(t, err)
+			params.MessageBasedParams = []v1.MessageBasedParams{
+				{MsgUrl: sdk.MsgTypeURL(&banktypes.MsgSend{}), Threshold: tc.threshold},
+			}
+			require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+			proposal, err := govKeeper.SubmitProposal(ctx, tc.msgs, "", "title", "description", members[0], tc.proposalType)
+			require.NoError(t, err)
+			proposal.Status = v1.StatusVotingPeriod
+			govKeeper.SetProposal(ctx, proposal)
+
+			for i, option := range tc.votes {
+				require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, members[i], v1.NewNonSplitVoteOption(option), ""))
+			}
+
+			passes, _, _, err := govKeeper.Tally(ctx, proposal)
+			require.NoError(t, err)
+			require.Equal(t, tc.expPass, passes)
+		})
+	}
+}
+
+func TestTallyOptimistic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		noVotes int
+		expPass bool
+	}{
+		{
+			name:    "no votes",
+			expPass: true,
+		},
+		{
+			name:    "no votes below the rejected threshold",
+			noVotes: 1,
+			expPass: true,
+		},
+		{
+			name:    "no votes reach the rejected threshold",
+			noVotes: 2,
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			govKeeper, _, bankKeeper, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+			members := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 10, sdkmath.NewInt(10000000))
+			govKeeper.SetTallyFn(oneAccountOneVote(members))
+
+			// 2 out of the 10 members voting no reject the proposal
+			params, err := govKeeper.Params.Get(ctx)
+			require.NoError(t, err)
+			params.OptimisticAuthorizedAddresses = []string{members[0].String()}
+			params.OptimisticRejectedThreshold = "0.2"
+			require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "description", members[0], v1.ProposalTypeOptimistic)
+			require.NoError(t, err)
+			proposal.Status = v1.StatusVotingPeriod
+			govKeeper.SetProposal(ctx, proposal)
+
+			for i := 0; i < tc.noVotes; i++ {
+				require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, members[i], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+			}
+
+			passes, burnDeposits, tallyResults, err := govKeeper.Tally(ctx, proposal)
+			require.NoError(t, err)
+			require.Equal(t, tc.expPass, passes)
+			require.False(t, burnDeposits)
+			require.Equal(t, v1.NewTallyResult(sdkmath.ZeroInt(), sdkmath.ZeroInt(), sdkmath.NewInt(int64(tc.noVotes)), sdkmath.ZeroInt()), tallyResults)
+		})
+	}
+}
+
+func TestTallyMultipleChoice(t *testing.T) {
+	testCases := []struct {
+		name    string
+		votes   []uint32
+		expPass bool
+	}{
+		{
+			name:    "quorum not reached",
+			votes:   []uint32{0, 1, 4},
+			expPass: false,
+		},
+		{
+			name:    "quorum reached",
+			votes:   []uint32{0, 0, 1, 4},
+			expPass: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			govKeeper, _, bankKeeper, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+			members := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 10, sdkmath.NewInt(10000000))
+			govKeeper.SetTallyFn(oneAccountOneVote(members))
+
+			labels := []string{"red", "blue", "green", "yellow", "purple"}
+			proposal, err := govKeeper.SubmitMultipleChoiceProposal(ctx, "", "title", "description", members[0], labels)
+			require.NoError(t, err)
+			proposal.Status = v1.StatusVotingPeriod
+			govKeeper.SetProposal(ctx, proposal)
+
+			results := make([]int64, len(labels))
+			for i, choice := range tc.votes {
+				require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, members[i], v1.NewNonSplitVoteChoice(choice), ""))
+				results[choice]++
+			}
+
+			expResults := make([]*v1.OptionTallyResult, len(labels))
+			for i, label := range labels {
+				expResults[i] = &v1.OptionTallyResult{Choice: uint32(i), Label: label, Count: sdkmath.NewInt(results[i]).String()}
+			}
+
+			passes, burnDeposits, tallyResults, err := govKeeper.Tally(ctx, proposal)
+			require.NoError(t, err)
+			require.Equal(t, tc.expPass, passes)
+			require.False(t, burnDeposits)
+			require.Equal(t, expResults, proposal.MultipleChoiceTallyResult(tallyResults))
+		})
+	}
+}
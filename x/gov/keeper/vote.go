@@ -29,10 +29,25 @@ func (keeper Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr s
 		return err
 	}
 
+	proposal, err := keeper.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
 	for _, option := range options {
 		if !v1.ValidWeightedVoteOption(*option) {
 			return errors.Wrap(types.ErrInvalidVote, option.String())
 		}
+
+		// multiple choice proposals can only be voted on their labelled choices
+		if !proposal.IsVoteAllowed(*option) {
+			return errors.Wrapf(types.ErrInvalidVote, "proposal %d does not accept the vote %s", proposalID, option)
+		}
+
+		// optimistic proposals can only be vetoed
+		if proposal.ProposalType == v1.ProposalTypeOptimistic && option.Option != v1.OptionNo {
+			return errors.Wrapf(types.ErrInvalidVote, "optimistic proposals can only be voted no: %s", option)
+		}
 	}
 
 	vote := v1.NewVote(proposalID, voterAddr, options, metadata)
@@ -58,6 +73,13 @@ func (keeper Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr s
 
 // deleteVotes deletes the all votes from a given proposalID.
 func (keeper Keeper) deleteVotes(ctx context.Context, proposalID uint64) error {
-	// TODO(tip): fix https://github.com/cosmos/cosmos-sdk/issues/16162
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+	err := keeper.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], _ v1.Vote) (bool, error) {
+		return false, keeper.Votes.Remove(ctx, key)
+	})
+	if err != nil && !errors.IsOf(err, collections.ErrInvalidIterator) {
+		return err
+	}
+
 	return nil
 }
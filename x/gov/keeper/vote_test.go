@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
@@ -20,7 +21,7 @@ func TestVotes(t *testing.T) {
 	authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
 
 	tp := TestProposal
-	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "description", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
+	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "description", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), v1.ProposalTypeStandard)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	metadata := "metadata"
@@ -104,3 +105,57 @@ func TestVotes(t *testing.T) {
 	_, err = govKeeper.Votes.Get(ctx, collections.Join(proposalID+100, addrs[1]))
 	require.ErrorIs(t, err, collections.ErrNotFound)
 }
+
+func TestOptimisticProposalVotes(t *testing.T) {
+	govKeeper, authKeeper, bankKeeper, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 1, sdkmath.NewInt(10000000))
+	authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	params, err := govKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.OptimisticAuthorizedAddresses = []string{addrs[0].String()}
+	require.NoError(t, govKeeper.Params.Set(ctx, params))
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "description", addrs[0], v1.ProposalTypeOptimistic)
+	require.NoError(t, err)
+	proposal.Status = v1.StatusVotingPeriod
+	govKeeper.SetProposal(ctx, proposal)
+
+	// optimistic proposals can only be voted no
+	require.ErrorIs(t, govKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""), types.ErrInvalidVote)
+	require.ErrorIs(t, govKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionNoWithVeto), ""), types.ErrInvalidVote)
+	require.ErrorIs(t, govKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.WeightedVoteOptions{
+		v1.NewWeightedVoteOption(v1.OptionNo, sdkmath.LegacyNewDecWithPrec(50, 2)),
+		v1.NewWeightedVoteOption(v1.OptionAbstain, sdkmath.LegacyNewDecWithPrec(50, 2)),
+	}, ""), types.ErrInvalidVote)
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+}
+
+func TestMultipleChoiceProposalVotes(t *testing.T) {
+	govKeeper, authKeeper, bankKeeper, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 1, sdkmath.NewInt(10000000))
+	authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	proposal, err := govKeeper.SubmitMultipleChoiceProposal(ctx, "", "title", "description", addrs[0], []string{"red", "blue", "green"})
+	require.NoError(t, err)
+	proposal.Status = v1.StatusVotingPeriod
+	govKeeper.SetProposal(ctx, proposal)
+
+	// the fourth choice has no label and the standard options are not allowed
+	require.ErrorIs(t, govKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteChoice(3), ""), types.ErrInvalidVote)
+	require.ErrorIs(t, govKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""), types.ErrInvalidVote)
+	require.ErrorIs(t, govKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.WeightedVoteOptions{
+		v1.NewWeightedVoteChoice(0, sdkmath.LegacyNewDecWithPrec(50, 2)),
+		v1.NewWeightedVoteChoice(3, sdkmath.LegacyNewDecWithPrec(50, 2)),
+	}, ""), types.ErrInvalidVote)
+
+	options := v1.WeightedVoteOptions{
+		v1.NewWeightedVoteChoice(0, sdkmath.LegacyNewDecWithPrec(60, 2)),
+		v1.NewWeightedVoteChoice(2, sdkmath.LegacyNewDecWithPrec(40, 2)),
+	}
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, addrs[0], options, ""))
+
+	vote, err := govKeeper.Votes.Get(ctx, collections.Join(proposal.Id, addrs[0]))
+	require.NoError(t, err)
+	require.Equal(t, options, v1.WeightedVoteOptions(vote.Options))
+}
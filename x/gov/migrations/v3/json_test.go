@@ -85,6 +85,7 @@ func TestMigrateJSON(t *testing.T) {
 		]
 	},
 	"deposits": [],
+	"governance_delegations": [],
 	"params": null,
 	"proposals": [
 		{
@@ -92,6 +93,7 @@ func TestMigrateJSON(t *testing.T) {
 			"expedited": false,
 			"final_tally_result": {
 				"abstain_count": "0",
+				"choice_counts": [],
 				"no_count": "0",
 				"no_with_veto_count": "0",
 				"yes_count": "0"
@@ -109,6 +111,7 @@ func TestMigrateJSON(t *testing.T) {
 				}
 			],
 			"metadata": "",
+			"proposal_type": "PROPOSAL_TYPE_UNSPECIFIED",
 			"proposer": "",
 			"status": "PROPOSAL_STATUS_DEPOSIT_PERIOD",
 			"submit_time": "2001-09-09T01:46:40Z",
@@ -120,6 +123,7 @@ func TestMigrateJSON(t *testing.T) {
 					"denom": "stake"
 				}
 			],
+			"vote_options": [],
 			"voting_end_time": "2001-09-09T01:46:40Z",
 			"voting_start_time": "2001-09-09T01:46:40Z"
 		}
@@ -135,6 +139,7 @@ func TestMigrateJSON(t *testing.T) {
 			"metadata": "",
 			"options": [
 				{
+					"choice": 0,
 					"option": "VOTE_OPTION_ABSTAIN",
 					"weight": "1.000000000000000000"
 				}
@@ -146,6 +151,7 @@ func TestMigrateJSON(t *testing.T) {
 			"metadata": "",
 			"options": [
 				{
+					"choice": 0,
 					"option": "VOTE_OPTION_NO",
 					"weight": "1.000000000000000000"
 				}
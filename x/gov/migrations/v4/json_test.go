@@ -41,6 +41,9 @@ func TestMigrateJSON(t *testing.T) {
 
 	migrated, err := v4.MigrateJSON(oldGovState)
 	require.NoError(t, err)
+
+	// the optimistic params are added by the v6 store migration
+	govGenState.Params.OptimisticRejectedThreshold = ""
 	require.Equal(t, migrated, govGenState)
 
 	bz, err := clientCtx.Codec.MarshalJSON(migrated)
@@ -59,6 +62,7 @@ func TestMigrateJSON(t *testing.T) {
 	"constitution": "",
 	"deposit_params": null,
 	"deposits": [],
+	"governance_delegations": [],
 	"params": {
 		"burn_proposal_deposit_prevote": false,
 		"burn_vote_quorum": false,
@@ -71,7 +75,9 @@ func TestMigrateJSON(t *testing.T) {
 		],
 		"expedited_threshold": "0.667000000000000000",
 		"expedited_voting_period": "86400s",
+		"governor_delegation_enabled": false,
 		"max_deposit_period": "172800s",
+		"message_based_params": [],
 		"min_deposit": [
 			{
 				"amount": "10000000",
@@ -79,6 +85,8 @@ func TestMigrateJSON(t *testing.T) {
 			}
 		],
 		"min_initial_deposit_ratio": "0.000000000000000000",
+		"optimistic_authorized_addresses": [],
+		"optimistic_rejected_threshold": "",
 		"proposal_cancel_dest": "",
 		"proposal_cancel_ratio": "0.500000000000000000",
 		"quorum": "0.334000000000000000",
@@ -0,0 +1,46 @@
+package v6
+
+import (
+	corestoretypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v4 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v4"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6. The migration
+// includes:
+//
+// Addition of the governor delegation parameter, which is disabled so that
+// proposals keep being tallied from staking delegations only until governance
+// enables it.
+//
+// Addition of the optimistic proposal parameters, which are set to their
+// default values.
+func MigrateStore(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	store := storeService.OpenKVStore(ctx)
+	paramsBz, err := store.Get(v4.ParamsKey)
+	if err != nil {
+		return err
+	}
+
+	var params govv1.Params
+	err = cdc.Unmarshal(paramsBz, &params)
+	if err != nil {
+		return err
+	}
+
+	params.GovernorDelegationEnabled = false
+
+	defaultParams := govv1.DefaultParams()
+	params.OptimisticRejectedThreshold = defaultParams.OptimisticRejectedThreshold
+	params.OptimisticAuthorizedAddresses = defaultParams.OptimisticAuthorizedAddresses
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(v4.ParamsKey, bz)
+}
@@ -0,0 +1,51 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	v4 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v4"
+	v6 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v6"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(gov.AppModuleBasic{}, bank.AppModuleBasic{}).Codec
+	govKey := storetypes.NewKVStoreKey("gov")
+	ctx := testutil.DefaultContext(govKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(govKey)
+
+	// the params of a chain at consensus version 5 have no optimistic params
+	oldParams := v1.DefaultParams()
+	oldParams.Quorum = "0.4"
+	oldParams.OptimisticRejectedThreshold = ""
+	oldParams.OptimisticAuthorizedAddresses = nil
+	bz, err := cdc.Marshal(&oldParams)
+	require.NoError(t, err)
+	store.Set(v4.ParamsKey, bz)
+
+	// Run migrations.
+	storeService := runtime.NewKVStoreService(govKey)
+	err = v6.MigrateStore(ctx, storeService, cdc)
+	require.NoError(t, err)
+
+	// Check params, governor delegation is disabled, the optimistic params are
+	// set to their default values and the other params are kept
+	var params v1.Params
+	bz = store.Get(v4.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &params))
+	require.False(t, params.GovernorDelegationEnabled)
+	require.Equal(t, v1.DefaultParams().OptimisticRejectedThreshold, params.OptimisticRejectedThreshold)
+	require.NoError(t, params.ValidateBasic())
+
+	params.OptimisticRejectedThreshold = ""
+	require.Equal(t, oldParams, params)
+}
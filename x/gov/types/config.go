@@ -4,11 +4,15 @@ package types
 type Config struct {
 	// MaxMetadataLen defines the maximum proposal metadata length.
 	MaxMetadataLen uint64
+	// MaxVoteOptions defines the maximum number of vote options of a multiple
+	// choice proposal.
+	MaxVoteOptions uint64
 }
 
 // DefaultConfig returns the default config for gov.
 func DefaultConfig() Config {
 	return Config{
 		MaxMetadataLen: 255,
+		MaxVoteOptions: 10,
 	}
 }
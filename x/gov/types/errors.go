@@ -23,4 +23,5 @@ var (
 	ErrInvalidProposer         = errors.Register(ModuleName, 18, "invalid proposer")
 	ErrVotingPeriodEnded       = errors.Register(ModuleName, 20, "voting period already ended")
 	ErrInvalidProposal         = errors.Register(ModuleName, 21, "invalid proposal")
+	ErrGovernorDelegation      = errors.Register(ModuleName, 22, "invalid governor delegation")
 )
@@ -2,25 +2,31 @@ package types
 
 // Governance module event types
 const (
-	EventTypeSubmitProposal   = "submit_proposal"
-	EventTypeProposalDeposit  = "proposal_deposit"
-	EventTypeProposalVote     = "proposal_vote"
-	EventTypeInactiveProposal = "inactive_proposal"
-	EventTypeActiveProposal   = "active_proposal"
-	EventTypeCancelProposal   = "cancel_proposal"
+	EventTypeSubmitProposal     = "submit_proposal"
+	EventTypeProposalDeposit    = "proposal_deposit"
+	EventTypeProposalVote       = "proposal_vote"
+	EventTypeInactiveProposal   = "inactive_proposal"
+	EventTypeActiveProposal     = "active_proposal"
+	EventTypeCancelProposal     = "cancel_proposal"
+	EventTypeDelegateGovernor   = "delegate_governor"
+	EventTypeUndelegateGovernor = "undelegate_governor"
 
-	AttributeKeyProposalResult              = "proposal_result"
-	AttributeKeyOption                      = "option"
-	AttributeKeyProposalID                  = "proposal_id"
-	AttributeKeyProposalMessages            = "proposal_messages" // Msg type_urls in the proposal
-	AttributeKeyVotingPeriodStart           = "voting_period_start"
-	AttributeKeyProposalLog                 = "proposal_log"                // log of proposal execution
-	AttributeValueProposalDropped           = "proposal_dropped"            // didn't meet min deposit
-	AttributeValueProposalPassed            = "proposal_passed"             // met vote quorum
-	AttributeValueProposalRejected          = "proposal_rejected"           // didn't meet vote quorum
-	AttributeValueExpeditedProposalRejected = "expedited_proposal_rejected" // didn't meet expedited vote quorum
-	AttributeValueProposalFailed            = "proposal_failed"             // error on proposal handler
-	AttributeValueProposalCanceled          = "proposal_canceled"           // error on proposal handler
+	AttributeKeyProposalResult               = "proposal_result"
+	AttributeKeyOption                       = "option"
+	AttributeKeyProposalID                   = "proposal_id"
+	AttributeKeyProposalMessages             = "proposal_messages" // Msg type_urls in the proposal
+	AttributeKeyVotingPeriodStart            = "voting_period_start"
+	AttributeKeyProposalLog                  = "proposal_log"                 // log of proposal execution
+	AttributeValueProposalDropped            = "proposal_dropped"             // didn't meet min deposit
+	AttributeValueProposalPassed             = "proposal_passed"              // met vote quorum
+	AttributeValueProposalRejected           = "proposal_rejected"            // didn't meet vote quorum
+	AttributeValueExpeditedProposalRejected  = "expedited_proposal_rejected"  // didn't meet expedited vote quorum
+	AttributeValueOptimisticProposalRejected = "optimistic_proposal_rejected" // no votes reached the optimistic rejected threshold
+	AttributeValueProposalFailed             = "proposal_failed"              // error on proposal handler
+	AttributeValueProposalCanceled           = "proposal_canceled"            // error on proposal handler
+
+	AttributeKeyDelegator = "delegator"
+	AttributeKeyGovernor  = "governor"
 
 	AttributeKeyProposalType   = "proposal_type"
 	AttributeSignalTitle       = "signal_title"
@@ -24,6 +24,7 @@ type StakingKeeper interface {
 	)
 
 	TotalBondedTokens(sdk.Context) math.Int // total bonded tokens within the validator set
+	Delegation(ctx sdk.Context, delegator sdk.AccAddress, validator sdk.ValAddress) stakingtypes.DelegationI
 	IterateDelegations(
 		ctx sdk.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation stakingtypes.DelegationI) (stop bool),
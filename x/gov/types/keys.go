@@ -25,4 +25,8 @@ var (
 	VotesKeyPrefix                = collections.NewPrefix(32) // VotesKeyPrefix stores the votes of proposals.
 	ParamsKey                     = collections.NewPrefix(48) // ParamsKey stores the module's params.
 	ConstitutionKey               = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
+	GovernanceDelegationsPrefix   = collections.NewPrefix(64) // GovernanceDelegationsPrefix stores the governor of each delegator.
+	GovernorDelegatorsPrefix      = collections.NewPrefix(65) // GovernorDelegatorsPrefix stores the delegators of each governor.
+	GovernorsPrefix               = collections.NewPrefix(66) // GovernorsPrefix stores the number of delegators of each governor.
+	GovernorValSharesPrefix       = collections.NewPrefix(67) // GovernorValSharesPrefix stores the validator shares delegated to each governor.
 )
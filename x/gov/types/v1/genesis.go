@@ -2,6 +2,7 @@ package v1
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 )
@@ -33,6 +34,18 @@ func ValidateGenesis(data *GenesisState) error {
 		return errors.New("starting proposal id must be greater than 0")
 	}
 
+	delegators := make(map[string]bool, len(data.GovernanceDelegations))
+	for _, delegation := range data.GovernanceDelegations {
+		if err := delegation.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if delegators[delegation.DelegatorAddress] {
+			return fmt.Errorf("duplicate governance delegation for delegator %s", delegation.DelegatorAddress)
+		}
+		delegators[delegation.DelegatorAddress] = true
+	}
+
 	return data.Params.ValidateBasic()
 }
 
@@ -52,6 +52,9 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.50
 	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// governance_delegations defines the delegations of governance voting power
+	// to governors present at genesis.
+	GovernanceDelegations []*GovernanceDelegation `protobuf:"bytes,10,rep,name=governance_delegations,json=governanceDelegations,proto3" json:"governance_delegations,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -153,6 +156,13 @@ func (m *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (m *GenesisState) GetGovernanceDelegations() []*GovernanceDelegation {
+	if m != nil {
+		return m.GovernanceDelegations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -160,31 +170,33 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 379 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x4e, 0xfa, 0x40,
-	0x14, 0xc5, 0x19, 0xbe, 0xfe, 0x30, 0x94, 0xff, 0x62, 0xfc, 0xa0, 0x01, 0xd3, 0x34, 0xac, 0x6a,
-	0x0c, 0xad, 0x60, 0x7c, 0x00, 0x09, 0x86, 0xb8, 0x23, 0xd5, 0xb8, 0x70, 0x43, 0x0a, 0x6d, 0x6a,
-	0x23, 0xf4, 0x36, 0x9d, 0x61, 0x22, 0x6f, 0xe1, 0x63, 0xb9, 0x64, 0xe9, 0xd2, 0xc0, 0x63, 0xb8,
-	0x31, 0xcc, 0xb4, 0x02, 0xd5, 0xd5, 0x24, 0xf7, 0xfc, 0xce, 0x99, 0x93, 0x9b, 0x8b, 0x5b, 0x53,
-	0xa0, 0x73, 0xa0, 0x96, 0x0f, 0xdc, 0xe2, 0x5d, 0xcb, 0xf7, 0x42, 0x8f, 0x06, 0xd4, 0x8c, 0x62,
-	0x60, 0x40, 0xea, 0x52, 0x34, 0x7d, 0xe0, 0x26, 0xef, 0x36, 0x1b, 0x19, 0x16, 0xb8, 0xe4, 0xda,
-	0x5f, 0x05, 0xac, 0x0c, 0xa5, 0xf3, 0x9e, 0x39, 0xcc, 0x23, 0x97, 0xf8, 0x98, 0x32, 0x27, 0x66,
-	0x41, 0xe8, 0x8f, 0xa3, 0x18, 0x22, 0xa0, 0xce, 0x6c, 0x1c, 0xb8, 0x2a, 0xd2, 0x91, 0x51, 0xb4,
-	0x49, 0xaa, 0x8d, 0x12, 0xe9, 0xce, 0x25, 0x3d, 0x5c, 0x71, 0xbd, 0x08, 0x68, 0xc0, 0xa8, 0x9a,
-	0xd7, 0x0b, 0x46, 0xad, 0x77, 0x6a, 0x1e, 0xfc, 0x6e, 0x0e, 0xa4, 0x6c, 0xff, 0x70, 0xe4, 0x1c,
-	0x97, 0x38, 0x30, 0x8f, 0xaa, 0x05, 0x61, 0x38, 0xca, 0x18, 0x1e, 0x81, 0x79, 0xb6, 0x24, 0xc8,
-	0x35, 0xae, 0xa6, 0x3d, 0xa8, 0x5a, 0x14, 0x78, 0x23, 0x83, 0xa7, 0x65, 0xec, 0x1d, 0x49, 0x86,
-	0xf8, 0x7f, 0xf2, 0xdb, 0x38, 0x72, 0x62, 0x67, 0x4e, 0xd5, 0x92, 0x8e, 0x8c, 0x5a, 0xef, 0xec,
-	0xef, 0x6e, 0x23, 0xc1, 0xf4, 0xf3, 0x2a, 0xb2, 0xeb, 0xee, 0xfe, 0x88, 0x0c, 0x70, 0x9d, 0x83,
-	0x5c, 0x87, 0xcc, 0x29, 0x8b, 0x9c, 0xd6, 0xef, 0xca, 0xdb, 0xb5, 0xec, 0x62, 0x14, 0xbe, 0x37,
-	0x21, 0x37, 0x58, 0x61, 0xce, 0x6c, 0xb6, 0x4c, 0x43, 0xfe, 0x89, 0x90, 0x66, 0x26, 0xe4, 0x61,
-	0x8b, 0xec, 0x65, 0xd4, 0xd8, 0x6e, 0x40, 0x3a, 0xb8, 0x9c, 0x98, 0x2b, 0xc2, 0x7c, 0x92, 0xdd,
-	0x82, 0x10, 0xed, 0x04, 0x22, 0x6d, 0xac, 0x4c, 0x21, 0xa4, 0x2c, 0x60, 0x0b, 0x16, 0x40, 0xa8,
-	0x56, 0x75, 0x64, 0x54, 0xed, 0x83, 0x59, 0xff, 0xf6, 0x7d, 0xad, 0xa1, 0xd5, 0x5a, 0x43, 0x9f,
-	0x6b, 0x0d, 0xbd, 0x6d, 0xb4, 0xdc, 0x6a, 0xa3, 0xe5, 0x3e, 0x36, 0x5a, 0xee, 0xe9, 0xc2, 0x0f,
-	0xd8, 0xf3, 0x62, 0x62, 0x4e, 0x61, 0x6e, 0x25, 0xb7, 0x23, 0x9f, 0x0e, 0x75, 0x5f, 0xac, 0x57,
-	0x71, 0x48, 0x6c, 0x19, 0x79, 0xd4, 0xe2, 0xdd, 0x49, 0x59, 0xdc, 0xd2, 0xd5, 0x77, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x1b, 0x69, 0x07, 0x35, 0x92, 0x02, 0x00, 0x00,
+	// 414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x6e, 0x13, 0x31,
+	0x10, 0x86, 0xe3, 0x36, 0x0d, 0x8d, 0x93, 0x70, 0x30, 0xb4, 0xb5, 0x5a, 0xb4, 0x8a, 0xca, 0x25,
+	0x08, 0x75, 0x97, 0x04, 0xf1, 0x00, 0x54, 0x41, 0x11, 0xb7, 0xca, 0x20, 0x0e, 0xbd, 0x44, 0xee,
+	0xae, 0x65, 0x56, 0x6c, 0x76, 0x56, 0x3b, 0xae, 0x45, 0xdf, 0x82, 0xc7, 0xe2, 0xd8, 0x1b, 0x1c,
+	0x51, 0xf2, 0x22, 0x28, 0xf6, 0x6e, 0x92, 0x6e, 0x7b, 0xb2, 0xf4, 0xff, 0xdf, 0xff, 0x7b, 0x34,
+	0x1a, 0x7a, 0x16, 0x03, 0x2e, 0x00, 0x23, 0x0d, 0x36, 0xb2, 0xe3, 0x48, 0xab, 0x5c, 0x61, 0x8a,
+	0x61, 0x51, 0x82, 0x01, 0x36, 0xf0, 0x66, 0xa8, 0xc1, 0x86, 0x76, 0x7c, 0x7a, 0xd2, 0x60, 0xc1,
+	0x7a, 0xee, 0xfc, 0x4f, 0x9b, 0xf6, 0x67, 0x3e, 0xf9, 0xc5, 0x48, 0xa3, 0xd8, 0x3b, 0xfa, 0x12,
+	0x8d, 0x2c, 0x4d, 0x9a, 0xeb, 0x79, 0x51, 0x42, 0x01, 0x28, 0xb3, 0x79, 0x9a, 0x70, 0x32, 0x24,
+	0xa3, 0xb6, 0x60, 0xb5, 0x77, 0x55, 0x59, 0x9f, 0x13, 0x36, 0xa1, 0x87, 0x89, 0x2a, 0x00, 0x53,
+	0x83, 0x7c, 0x6f, 0xb8, 0x3f, 0xea, 0x4d, 0x8e, 0xc3, 0x07, 0xbf, 0x87, 0x53, 0x6f, 0x8b, 0x0d,
+	0xc7, 0xde, 0xd0, 0x03, 0x0b, 0x46, 0x21, 0xdf, 0x77, 0x81, 0x17, 0x8d, 0xc0, 0x37, 0x30, 0x4a,
+	0x78, 0x82, 0x7d, 0xa0, 0xdd, 0x7a, 0x0e, 0xe4, 0x6d, 0x87, 0x9f, 0x34, 0xf0, 0x7a, 0x18, 0xb1,
+	0x25, 0xd9, 0x8c, 0x3e, 0xaf, 0x7e, 0x9b, 0x17, 0xb2, 0x94, 0x0b, 0xe4, 0x07, 0x43, 0x32, 0xea,
+	0x4d, 0x5e, 0x3d, 0x3d, 0xdb, 0x95, 0x63, 0x2e, 0xf7, 0x38, 0x11, 0x83, 0x64, 0x57, 0x62, 0x53,
+	0x3a, 0xb0, 0xe0, 0xd7, 0xe1, 0x7b, 0x3a, 0xae, 0xe7, 0xec, 0xf1, 0xc8, 0xeb, 0xb5, 0x6c, 0x6b,
+	0xfa, 0x76, 0x47, 0x61, 0x1f, 0x69, 0xdf, 0xc8, 0x2c, 0xbb, 0xab, 0x4b, 0x9e, 0xb9, 0x92, 0xd3,
+	0x46, 0xc9, 0xd7, 0x35, 0xb2, 0xd3, 0xd1, 0x33, 0x5b, 0x81, 0x5d, 0xd0, 0x4e, 0x15, 0x3e, 0x74,
+	0xe1, 0xa3, 0xe6, 0x16, 0x9c, 0x29, 0x2a, 0x88, 0x9d, 0xd3, 0x7e, 0x0c, 0x39, 0x9a, 0xd4, 0xdc,
+	0x9a, 0x14, 0x72, 0xde, 0x1d, 0x92, 0x51, 0x57, 0x3c, 0xd0, 0xd8, 0x35, 0x3d, 0xd6, 0x60, 0x55,
+	0x99, 0xcb, 0x3c, 0x56, 0xf3, 0x44, 0x65, 0x4a, 0xcb, 0xb5, 0x81, 0x9c, 0xba, 0x45, 0xbf, 0x6e,
+	0x7c, 0x31, 0xdb, 0xc0, 0xd3, 0x0d, 0x2b, 0x8e, 0xf4, 0x13, 0x2a, 0x5e, 0x7e, 0xfa, 0xbd, 0x0c,
+	0xc8, 0xfd, 0x32, 0x20, 0xff, 0x96, 0x01, 0xf9, 0xb5, 0x0a, 0x5a, 0xf7, 0xab, 0xa0, 0xf5, 0x77,
+	0x15, 0xb4, 0xae, 0xdf, 0xea, 0xd4, 0x7c, 0xbf, 0xbd, 0x09, 0x63, 0x58, 0x44, 0xd5, 0x5d, 0xfa,
+	0xe7, 0x02, 0x93, 0x1f, 0xd1, 0x4f, 0x77, 0xa4, 0xe6, 0xae, 0x50, 0x18, 0xd9, 0xf1, 0x4d, 0xc7,
+	0xdd, 0xe9, 0xfb, 0xff, 0x03, 0x00, 0x8f, 0xd9, 0xf8, 0xd6, 0xee, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -207,6 +219,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GovernanceDelegations) > 0 {
+		for iNdEx := len(m.GovernanceDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovernanceDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Constitution) > 0 {
 		i -= len(m.Constitution)
 		copy(dAtA[i:], m.Constitution)
@@ -370,6 +396,12 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.GovernanceDelegations) > 0 {
+		for _, e := range m.GovernanceDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -705,6 +737,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernanceDelegations = append(m.GovernanceDelegations, &GovernanceDelegation{})
+			if err := m.GovernanceDelegations[len(m.GovernanceDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -20,6 +20,11 @@ func TestEmptyGenesis(t *testing.T) {
 
 func TestValidateGenesis(t *testing.T) {
 	params := v1.DefaultParams()
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("delegator1__________"),
+		sdk.AccAddress("delegator2__________"),
+		sdk.AccAddress("governor____________"),
+	}
 
 	testCases := []struct {
 		name         string
@@ -92,6 +97,43 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErr: true,
 		},
+		{
+			name: "valid governance delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.GovernanceDelegations = []*v1.GovernanceDelegation{
+					{DelegatorAddress: addrs[0].String(), GovernorAddress: addrs[2].String()},
+					{DelegatorAddress: addrs[1].String(), GovernorAddress: addrs[2].String()},
+				}
+
+				return state
+			},
+		},
+		{
+			name: "self governance delegation",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.GovernanceDelegations = []*v1.GovernanceDelegation{
+					{DelegatorAddress: addrs[0].String(), GovernorAddress: addrs[0].String()},
+				}
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate governance delegation",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.GovernanceDelegations = []*v1.GovernanceDelegation{
+					{DelegatorAddress: addrs[0].String(), GovernorAddress: addrs[1].String()},
+					{DelegatorAddress: addrs[0].String(), GovernorAddress: addrs[2].String()},
+				}
+
+				return state
+			},
+			expErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs basic validation of a governance delegation.
+func (d GovernanceDelegation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(d.DelegatorAddress); err != nil {
+		return fmt.Errorf("invalid delegator address: %w", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(d.GovernorAddress); err != nil {
+		return fmt.Errorf("invalid governor address: %w", err)
+	}
+
+	if d.DelegatorAddress == d.GovernorAddress {
+		return fmt.Errorf("delegator %s cannot delegate to self", d.DelegatorAddress)
+	}
+
+	return nil
+}
@@ -8,8 +8,8 @@ import (
 )
 
 var (
-	_, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}
-	_, _                codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}, &MsgDelegateGovernor{}, &MsgUndelegateGovernor{}
+	_, _                      codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -79,7 +79,13 @@ func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
 
 // NewMsgVote creates a message to cast a vote on an active proposal
 func NewMsgVote(voter sdk.AccAddress, proposalID uint64, option VoteOption, metadata string) *MsgVote {
-	return &MsgVote{proposalID, voter.String(), option, metadata}
+	return &MsgVote{ProposalId: proposalID, Voter: voter.String(), Option: option, Metadata: metadata}
+}
+
+// NewMsgVoteChoice creates a message to vote for a choice of an active multiple
+// choice proposal
+func NewMsgVoteChoice(voter sdk.AccAddress, proposalID uint64, choice uint32, metadata string) *MsgVote {
+	return &MsgVote{ProposalId: proposalID, Voter: voter.String(), Option: OptionEmpty, Metadata: metadata, Choice: choice}
 }
 
 // GetSigners returns the expected signers for a MsgVote.
@@ -148,3 +154,30 @@ func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
 	proposer, _ := sdk.AccAddressFromBech32(msg.Proposer)
 	return []sdk.AccAddress{proposer}
 }
+
+// NewMsgDelegateGovernor creates a new MsgDelegateGovernor instance.
+func NewMsgDelegateGovernor(delegator, governor string) *MsgDelegateGovernor {
+	return &MsgDelegateGovernor{
+		DelegatorAddress: delegator,
+		GovernorAddress:  governor,
+	}
+}
+
+// GetSigners implements Msg
+func (msg MsgDelegateGovernor) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// NewMsgUndelegateGovernor creates a new MsgUndelegateGovernor instance.
+func NewMsgUndelegateGovernor(delegator string) *MsgUndelegateGovernor {
+	return &MsgUndelegateGovernor{
+		DelegatorAddress: delegator,
+	}
+}
+
+// GetSigners implements Msg
+func (msg MsgUndelegateGovernor) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
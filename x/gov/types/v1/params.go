@@ -18,18 +18,19 @@ const (
 
 // Default governance params
 var (
-	DefaultMinDepositTokens          = sdkmath.NewInt(10000000)
-	DefaultMinExpeditedDepositTokens = DefaultMinDepositTokens.Mul(sdkmath.NewInt(DefaultMinExpeditedDepositTokensRatio))
-	DefaultQuorum                    = sdkmath.LegacyNewDecWithPrec(334, 3)
-	DefaultThreshold                 = sdkmath.LegacyNewDecWithPrec(5, 1)
-	DefaultExpeditedThreshold        = sdkmath.LegacyNewDecWithPrec(667, 3)
-	DefaultVetoThreshold             = sdkmath.LegacyNewDecWithPrec(334, 3)
-	DefaultMinInitialDepositRatio    = sdkmath.LegacyZeroDec()
-	DefaultProposalCancelRatio       = sdkmath.LegacyMustNewDecFromStr("0.5")
-	DefaultProposalCancelDestAddress = ""
-	DefaultBurnProposalPrevote       = false // set to false to replicate behavior of when this change was made (0.47)
-	DefaultBurnVoteQuorom            = false // set to false to  replicate behavior of when this change was made (0.47)
-	DefaultBurnVoteVeto              = true  // set to true to replicate behavior of when this change was made (0.47)
+	DefaultMinDepositTokens            = sdkmath.NewInt(10000000)
+	DefaultMinExpeditedDepositTokens   = DefaultMinDepositTokens.Mul(sdkmath.NewInt(DefaultMinExpeditedDepositTokensRatio))
+	DefaultQuorum                      = sdkmath.LegacyNewDecWithPrec(334, 3)
+	DefaultThreshold                   = sdkmath.LegacyNewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold          = sdkmath.LegacyNewDecWithPrec(667, 3)
+	DefaultVetoThreshold               = sdkmath.LegacyNewDecWithPrec(334, 3)
+	DefaultMinInitialDepositRatio      = sdkmath.LegacyZeroDec()
+	DefaultProposalCancelRatio         = sdkmath.LegacyMustNewDecFromStr("0.5")
+	DefaultProposalCancelDestAddress   = ""
+	DefaultBurnProposalPrevote         = false // set to false to replicate behavior of when this change was made (0.47)
+	DefaultBurnVoteQuorom              = false // set to false to  replicate behavior of when this change was made (0.47)
+	DefaultBurnVoteVeto                = true  // set to true to replicate behavior of when this change was made (0.47)
+	DefaultOptimisticRejectedThreshold = sdkmath.LegacyNewDecWithPrec(1, 1)
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -82,7 +83,7 @@ func NewParams(
 
 // DefaultParams returns the default governance params
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinExpeditedDepositTokens)),
 		DefaultPeriod,
@@ -99,6 +100,9 @@ func DefaultParams() Params {
 		DefaultBurnVoteQuorom,
 		DefaultBurnVoteVeto,
 	)
+	params.OptimisticRejectedThreshold = DefaultOptimisticRejectedThreshold.String()
+
+	return params
 }
 
 // ValidateBasic performs basic validation on governance parameters.
@@ -215,5 +219,200 @@ func (p Params) ValidateBasic() error {
 		}
 	}
 
+	optimisticAddrs := make(map[string]bool, len(p.OptimisticAuthorizedAddresses))
+	for _, addr := range p.OptimisticAuthorizedAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("optimistic authorized address is invalid: %s", addr)
+		}
+		if optimisticAddrs[addr] {
+			return fmt.Errorf("duplicate optimistic authorized address: %s", addr)
+		}
+		optimisticAddrs[addr] = true
+	}
+
+	optimisticRejectedThreshold, err := sdkmath.LegacyNewDecFromStr(p.OptimisticRejectedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid optimistic rejected threshold string: %w", err)
+	}
+	if !optimisticRejectedThreshold.IsPositive() {
+		return fmt.Errorf("optimistic rejected threshold must be positive: %s", optimisticRejectedThreshold)
+	}
+	if optimisticRejectedThreshold.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("optimistic rejected threshold too large: %s", optimisticRejectedThreshold)
+	}
+
+	msgURLs := make(map[string]bool, len(p.MessageBasedParams))
+	for _, mp := range p.MessageBasedParams {
+		if msgURLs[mp.MsgUrl] {
+			return fmt.Errorf("duplicate message based params for %s", mp.MsgUrl)
+		}
+		msgURLs[mp.MsgUrl] = true
+
+		if err := mp.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation on message based governance parameters.
+func (p MessageBasedParams) ValidateBasic() error {
+	if p.MsgUrl == "" {
+		return fmt.Errorf("message based params must have a message type URL")
+	}
+
+	if minDeposit := sdk.Coins(p.MinDeposit); !minDeposit.IsValid() {
+		return fmt.Errorf("invalid minimum deposit for %s: %s", p.MsgUrl, minDeposit)
+	}
+
+	if p.VotingPeriod != nil && p.VotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("voting period for %s must be positive: %s", p.MsgUrl, p.VotingPeriod)
+	}
+
+	if p.Quorum != "" {
+		quorum, err := sdkmath.LegacyNewDecFromStr(p.Quorum)
+		if err != nil {
+			return fmt.Errorf("invalid quorum string for %s: %w", p.MsgUrl, err)
+		}
+		if quorum.IsNegative() {
+			return fmt.Errorf("quorom for %s cannot be negative: %s", p.MsgUrl, quorum)
+		}
+		if quorum.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("quorom for %s too large: %s", p.MsgUrl, quorum)
+		}
+	}
+
+	if p.Threshold != "" {
+		threshold, err := sdkmath.LegacyNewDecFromStr(p.Threshold)
+		if err != nil {
+			return fmt.Errorf("invalid threshold string for %s: %w", p.MsgUrl, err)
+		}
+		if !threshold.IsPositive() {
+			return fmt.Errorf("vote threshold for %s must be positive: %s", p.MsgUrl, threshold)
+		}
+		if threshold.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("vote threshold for %s too large: %s", p.MsgUrl, threshold)
+		}
+	}
+
+	if p.VetoThreshold != "" {
+		vetoThreshold, err := sdkmath.LegacyNewDecFromStr(p.VetoThreshold)
+		if err != nil {
+			return fmt.Errorf("invalid vetoThreshold string for %s: %w", p.MsgUrl, err)
+		}
+		if !vetoThreshold.IsPositive() {
+			return fmt.Errorf("veto threshold for %s must be positive: %s", p.MsgUrl, vetoThreshold)
+		}
+		if vetoThreshold.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("veto threshold for %s too large: %s", p.MsgUrl, vetoThreshold)
+		}
+	}
+
 	return nil
 }
+
+// EffectiveParams returns the params which apply to a proposal containing
+// messages with the given type URLs. Each message is subject to the min
+// deposit, voting period, quorum, threshold and veto threshold set by its
+// message based params, or to the global params for the values it does not
+// override, and the strictest values among the messages apply: the highest
+// amount of each denom of the min deposit, the longest voting period, the
+// highest quorum and threshold and the lowest veto threshold. The expedited
+// min deposit, voting period and threshold of a message are the stricter of
+// the global expedited params and of its overrides. The params must be valid.
+func (p Params) EffectiveParams(msgURLs []string) Params {
+	if len(msgURLs) == 0 {
+		return p
+	}
+
+	var (
+		minDeposit, expeditedMinDeposit                      sdk.Coins
+		votingPeriod, expeditedVotingPeriod                  time.Duration
+		quorum, threshold, expeditedThreshold, vetoThreshold sdkmath.LegacyDec
+	)
+
+	globalQuorum := sdkmath.LegacyMustNewDecFromStr(p.Quorum)
+	globalThreshold := sdkmath.LegacyMustNewDecFromStr(p.Threshold)
+	globalExpeditedThreshold := sdkmath.LegacyMustNewDecFromStr(p.ExpeditedThreshold)
+	globalVetoThreshold := sdkmath.LegacyMustNewDecFromStr(p.VetoThreshold)
+
+	for i, msgURL := range msgURLs {
+		// the params of the message, the global ones unless overridden
+		msgMinDeposit := sdk.Coins(p.MinDeposit)
+		msgExpeditedMinDeposit := sdk.Coins(p.ExpeditedMinDeposit)
+		msgVotingPeriod, msgExpeditedVotingPeriod := *p.VotingPeriod, *p.ExpeditedVotingPeriod
+		msgQuorum, msgThreshold, msgVetoThreshold := globalQuorum, globalThreshold, globalVetoThreshold
+		msgExpeditedThreshold := globalExpeditedThreshold
+
+		if mp, ok := p.messageBasedParams(msgURL); ok {
+			if len(mp.MinDeposit) > 0 {
+				msgMinDeposit = mp.MinDeposit
+				msgExpeditedMinDeposit = msgExpeditedMinDeposit.Max(mp.MinDeposit)
+			}
+
+			if mp.VotingPeriod != nil {
+				msgVotingPeriod = *mp.VotingPeriod
+				if msgVotingPeriod > msgExpeditedVotingPeriod {
+					msgExpeditedVotingPeriod = msgVotingPeriod
+				}
+			}
+
+			if mp.Quorum != "" {
+				msgQuorum = sdkmath.LegacyMustNewDecFromStr(mp.Quorum)
+			}
+
+			if mp.Threshold != "" {
+				msgThreshold = sdkmath.LegacyMustNewDecFromStr(mp.Threshold)
+				msgExpeditedThreshold = sdkmath.LegacyMaxDec(msgExpeditedThreshold, msgThreshold)
+			}
+
+			if mp.VetoThreshold != "" {
+				msgVetoThreshold = sdkmath.LegacyMustNewDecFromStr(mp.VetoThreshold)
+			}
+		}
+
+		if i == 0 {
+			minDeposit, expeditedMinDeposit = msgMinDeposit, msgExpeditedMinDeposit
+			votingPeriod, expeditedVotingPeriod = msgVotingPeriod, msgExpeditedVotingPeriod
+			quorum, threshold, expeditedThreshold, vetoThreshold = msgQuorum, msgThreshold, msgExpeditedThreshold, msgVetoThreshold
+			continue
+		}
+
+		minDeposit = minDeposit.Max(msgMinDeposit)
+		expeditedMinDeposit = expeditedMinDeposit.Max(msgExpeditedMinDeposit)
+		if msgVotingPeriod > votingPeriod {
+			votingPeriod = msgVotingPeriod
+		}
+		if msgExpeditedVotingPeriod > expeditedVotingPeriod {
+			expeditedVotingPeriod = msgExpeditedVotingPeriod
+		}
+		quorum = sdkmath.LegacyMaxDec(quorum, msgQuorum)
+		threshold = sdkmath.LegacyMaxDec(threshold, msgThreshold)
+		expeditedThreshold = sdkmath.LegacyMaxDec(expeditedThreshold, msgExpeditedThreshold)
+		vetoThreshold = sdkmath.LegacyMinDec(vetoThreshold, msgVetoThreshold)
+	}
+
+	p.MinDeposit = minDeposit
+	p.ExpeditedMinDeposit = expeditedMinDeposit
+	p.VotingPeriod = &votingPeriod
+	p.ExpeditedVotingPeriod = &expeditedVotingPeriod
+	p.Quorum = quorum.String()
+	p.Threshold = threshold.String()
+	p.ExpeditedThreshold = expeditedThreshold.String()
+	p.VetoThreshold = vetoThreshold.String()
+
+	return p
+}
+
+// messageBasedParams returns the message based params of the message with the
+// given type URL, if any.
+func (p Params) messageBasedParams(msgURL string) (MessageBasedParams, bool) {
+	for _, mp := range p.MessageBasedParams {
+		if mp.MsgUrl == msgURL {
+			return mp, true
+		}
+	}
+
+	return MessageBasedParams{}, false
+}
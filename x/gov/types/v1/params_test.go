@@ -0,0 +1,211 @@
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+const (
+	sendMsgURL    = "/cosmos.bank.v1beta1.MsgSend"
+	upgradeMsgURL = "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade"
+)
+
+func TestParamsValidateBasicMessageBasedParams(t *testing.T) {
+	negativePeriod := -time.Hour
+
+	testCases := []struct {
+		name      string
+		mbp       []v1.MessageBasedParams
+		expErrMsg string
+	}{
+		{
+			name: "valid",
+			mbp: []v1.MessageBasedParams{
+				{MsgUrl: sendMsgURL, Quorum: "0.5"},
+				{MsgUrl: upgradeMsgURL, Threshold: "0.75", VetoThreshold: "0.2"},
+			},
+		},
+		{
+			name:      "empty msg url",
+			mbp:       []v1.MessageBasedParams{{Quorum: "0.5"}},
+			expErrMsg: "must have a message type URL",
+		},
+		{
+			name: "duplicate msg url",
+			mbp: []v1.MessageBasedParams{
+				{MsgUrl: sendMsgURL, Quorum: "0.5"},
+				{MsgUrl: sendMsgURL, Threshold: "0.75"},
+			},
+			expErrMsg: "duplicate",
+		},
+		{
+			name:      "invalid min deposit",
+			mbp:       []v1.MessageBasedParams{{MsgUrl: sendMsgURL, MinDeposit: []sdk.Coin{{Denom: "stake", Amount: sdkmath.NewInt(-1)}}}},
+			expErrMsg: "invalid minimum deposit",
+		},
+		{
+			name:      "negative voting period",
+			mbp:       []v1.MessageBasedParams{{MsgUrl: sendMsgURL, VotingPeriod: &negativePeriod}},
+			expErrMsg: "voting period",
+		},
+		{
+			name:      "quorum too large",
+			mbp:       []v1.MessageBasedParams{{MsgUrl: sendMsgURL, Quorum: "1.1"}},
+			expErrMsg: "quorom for /cosmos.bank.v1beta1.MsgSend too large",
+		},
+		{
+			name:      "zero threshold",
+			mbp:       []v1.MessageBasedParams{{MsgUrl: sendMsgURL, Threshold: "0"}},
+			expErrMsg: "vote threshold for /cosmos.bank.v1beta1.MsgSend must be positive",
+		},
+		{
+			name:      "invalid veto threshold",
+			mbp:       []v1.MessageBasedParams{{MsgUrl: sendMsgURL, VetoThreshold: "abc"}},
+			expErrMsg: "invalid vetoThreshold string",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := v1.DefaultParams()
+			params.MessageBasedParams = tc.mbp
+
+			err := params.ValidateBasic()
+			if tc.expErrMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			}
+		})
+	}
+}
+
+func TestParamsValidateBasicOptimistic(t *testing.T) {
+	addr := sdk.AccAddress("addr1_______________").String()
+
+	testCases := []struct {
+		name      string
+		addresses []string
+		threshold string
+		expErrMsg string
+	}{
+		{
+			name:      "valid",
+			addresses: []string{addr},
+			threshold: "0.1",
+		},
+		{
+			name:      "invalid address",
+			addresses: []string{"invalid"},
+			threshold: "0.1",
+			expErrMsg: "optimistic authorized address is invalid",
+		},
+		{
+			name:      "duplicate address",
+			addresses: []string{addr, addr},
+			threshold: "0.1",
+			expErrMsg: "duplicate optimistic authorized address",
+		},
+		{
+			name:      "empty threshold",
+			expErrMsg: "invalid optimistic rejected threshold string",
+		},
+		{
+			name:      "zero threshold",
+			threshold: "0",
+			expErrMsg: "optimistic rejected threshold must be positive",
+		},
+		{
+			name:      "threshold too large",
+			threshold: "1.1",
+			expErrMsg: "optimistic rejected threshold too large",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := v1.DefaultParams()
+			params.OptimisticAuthorizedAddresses = tc.addresses
+			params.OptimisticRejectedThreshold = tc.threshold
+
+			err := params.ValidateBasic()
+			if tc.expErrMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			}
+		})
+	}
+}
+
+func TestEffectiveParams(t *testing.T) {
+	longPeriod := 10 * 24 * time.Hour
+	shortPeriod := time.Hour
+
+	params := v1.DefaultParams()
+	params.MessageBasedParams = []v1.MessageBasedParams{
+		{
+			MsgUrl:        sendMsgURL,
+			MinDeposit:    sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 5)),
+			VotingPeriod:  &shortPeriod,
+			Quorum:        "0.5",
+			VetoThreshold: "0.2",
+		},
+		{
+			MsgUrl:        upgradeMsgURL,
+			MinDeposit:    sdk.NewCoins(sdk.NewInt64Coin("stake", 50), sdk.NewInt64Coin("atom", 10)),
+			VotingPeriod:  &longPeriod,
+			Quorum:        "0.4",
+			Threshold:     "0.75",
+			VetoThreshold: "0.25",
+		},
+	}
+	require.NoError(t, params.ValidateBasic())
+
+	// no overrides
+	require.Equal(t, params, params.EffectiveParams(nil))
+	require.Equal(t, params, params.EffectiveParams([]string{"/cosmos.gov.v1.MsgExecLegacyContent"}))
+
+	// single message
+	effective := params.EffectiveParams([]string{sendMsgURL})
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 5)), sdk.Coins(effective.MinDeposit))
+	require.Equal(t, shortPeriod, *effective.VotingPeriod)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.5").String(), effective.Quorum)
+	require.Equal(t, params.Threshold, effective.Threshold)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.2").String(), effective.VetoThreshold)
+
+	// the strictest params apply
+	effective = params.EffectiveParams([]string{sendMsgURL, upgradeMsgURL})
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 10)), sdk.Coins(effective.MinDeposit))
+	require.Equal(t, longPeriod, *effective.VotingPeriod)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.5").String(), effective.Quorum)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.75").String(), effective.Threshold)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.2").String(), effective.VetoThreshold)
+
+	// a message without overrides is subject to the global params, which are
+	// stricter than the overrides of the other message
+	effective = params.EffectiveParams([]string{sendMsgURL, "/cosmos.gov.v1.MsgExecLegacyContent"})
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10000000), sdk.NewInt64Coin("atom", 5)), sdk.Coins(effective.MinDeposit))
+	require.Equal(t, *params.VotingPeriod, *effective.VotingPeriod)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.5").String(), effective.Quorum)
+	require.Equal(t, params.Threshold, effective.Threshold)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.2").String(), effective.VetoThreshold)
+
+	// the overrides apply to expedited proposals when they are stricter than
+	// the expedited params
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 50000000), sdk.NewInt64Coin("atom", 10)), sdk.Coins(params.EffectiveParams([]string{upgradeMsgURL}).ExpeditedMinDeposit))
+	require.Equal(t, longPeriod, *params.EffectiveParams([]string{upgradeMsgURL}).ExpeditedVotingPeriod)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.75").String(), params.EffectiveParams([]string{upgradeMsgURL}).ExpeditedThreshold)
+	require.Equal(t, *params.ExpeditedVotingPeriod, *params.EffectiveParams([]string{sendMsgURL}).ExpeditedVotingPeriod)
+	require.Equal(t, params.ExpeditedThreshold, params.EffectiveParams([]string{sendMsgURL}).ExpeditedThreshold)
+
+	// the default params are not modified
+	require.Equal(t, v1.DefaultParams().VotingPeriod, params.VotingPeriod)
+	require.Equal(t, v1.DefaultParams().MinDeposit, params.MinDeposit)
+}
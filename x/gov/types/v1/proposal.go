@@ -20,15 +20,24 @@ const (
 	StatusPassed        = ProposalStatus_PROPOSAL_STATUS_PASSED
 	StatusRejected      = ProposalStatus_PROPOSAL_STATUS_REJECTED
 	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
+
+	ProposalTypeStandard       = ProposalType_PROPOSAL_TYPE_STANDARD
+	ProposalTypeOptimistic     = ProposalType_PROPOSAL_TYPE_OPTIMISTIC
+	ProposalTypeExpedited      = ProposalType_PROPOSAL_TYPE_EXPEDITED
+	ProposalTypeMultipleChoice = ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE
 )
 
 // NewProposal creates a new Proposal instance
-func NewProposal(messages []sdk.Msg, id uint64, submitTime, depositEndTime time.Time, metadata, title, summary string, proposer sdk.AccAddress, expedited bool) (Proposal, error) {
+func NewProposal(messages []sdk.Msg, id uint64, submitTime, depositEndTime time.Time, metadata, title, summary string, proposer sdk.AccAddress, proposalType ProposalType) (Proposal, error) {
 	msgs, err := sdktx.SetMsgs(messages)
 	if err != nil {
 		return Proposal{}, err
 	}
 
+	if proposalType == ProposalType_PROPOSAL_TYPE_UNSPECIFIED {
+		proposalType = ProposalTypeStandard
+	}
+
 	tally := EmptyTallyResult()
 
 	p := Proposal{
@@ -42,7 +51,8 @@ func NewProposal(messages []sdk.Msg, id uint64, submitTime, depositEndTime time.
 		Title:            title,
 		Summary:          summary,
 		Proposer:         proposer.String(),
-		Expedited:        expedited,
+		Expedited:        proposalType == ProposalTypeExpedited,
+		ProposalType:     proposalType,
 	}
 
 	return p, nil
@@ -63,6 +73,60 @@ func (p Proposal) GetMinDepositFromParams(params Params) sdk.Coins {
 	return params.MinDeposit
 }
 
+// IsVoteAllowed returns true if the sub vote can be cast on the proposal.
+// Multiple choice proposals only accept the choices which have a label, the
+// other proposals only accept the standard vote options.
+func (p Proposal) IsVoteAllowed(option WeightedVoteOption) bool {
+	if p.ProposalType != ProposalTypeMultipleChoice {
+		return ValidVoteOption(option.Option) && option.Choice == 0
+	}
+
+	return option.Option == OptionEmpty && int(option.Choice) < len(p.VoteOptions)
+}
+
+// MultipleChoiceTallyResult returns the tally of each choice of a multiple
+// choice proposal from its tally result.
+func (p Proposal) MultipleChoiceTallyResult(tally TallyResult) []*OptionTallyResult {
+	results := make([]*OptionTallyResult, 0, len(p.VoteOptions))
+	for i, label := range p.VoteOptions {
+		count := "0"
+		if i < len(tally.ChoiceCounts) {
+			count = tally.ChoiceCounts[i]
+		}
+
+		results = append(results, &OptionTallyResult{
+			Choice: uint32(i),
+			Label:  label,
+			Count:  count,
+		})
+	}
+
+	return results
+}
+
+// ValidateVoteOptions validates the vote options of a multiple choice proposal.
+// A multiple choice proposal has between 2 and maxVoteOptions unique,
+// non-empty labels.
+func ValidateVoteOptions(voteOptions []string, maxVoteOptions uint64) error {
+	if len(voteOptions) < 2 || uint64(len(voteOptions)) > maxVoteOptions {
+		return fmt.Errorf("a multiple choice proposal must have between 2 and %d vote options, got %d", maxVoteOptions, len(voteOptions))
+	}
+
+	seen := make(map[string]bool, len(voteOptions))
+	for _, label := range voteOptions {
+		if strings.TrimSpace(label) == "" {
+			return fmt.Errorf("vote option label cannot be empty")
+		}
+
+		if seen[label] {
+			return fmt.Errorf("duplicate vote option label: %s", label)
+		}
+		seen[label] = true
+	}
+
+	return nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	return sdktx.UnpackInterfaces(unpacker, p.Messages)
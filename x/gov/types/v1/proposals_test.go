@@ -36,7 +36,7 @@ func TestNestedAnys(t *testing.T) {
 	testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 	msgContent, err := v1.NewLegacyContent(testProposal, "cosmos1govacct")
 	require.NoError(t, err)
-	proposal, err := v1.NewProposal([]sdk.Msg{msgContent}, 1, time.Now(), time.Now(), "", "title", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false)
+	proposal, err := v1.NewProposal([]sdk.Msg{msgContent}, 1, time.Now(), time.Now(), "", "title", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), v1.ProposalTypeStandard)
 	require.NoError(t, err)
 
 	require.NotPanics(t, func() { _ = proposal.String() })
@@ -44,33 +44,44 @@ func TestNestedAnys(t *testing.T) {
 }
 
 func TestProposalSetExpedited(t *testing.T) {
-	const startExpedited = false
-	proposal, err := v1.NewProposal([]sdk.Msg{}, 1, time.Now(), time.Now(), "", "title", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), startExpedited)
+	proposal, err := v1.NewProposal([]sdk.Msg{}, 1, time.Now(), time.Now(), "", "title", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), v1.ProposalTypeStandard)
 	require.NoError(t, err)
-	require.Equal(t, startExpedited, proposal.Expedited)
+	require.False(t, proposal.Expedited)
+	require.Equal(t, v1.ProposalTypeStandard, proposal.ProposalType)
 
-	proposal, err = v1.NewProposal([]sdk.Msg{}, 1, time.Now(), time.Now(), "", "title", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), !startExpedited)
+	proposal, err = v1.NewProposal([]sdk.Msg{}, 1, time.Now(), time.Now(), "", "title", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), v1.ProposalTypeExpedited)
 	require.NoError(t, err)
-	require.Equal(t, !startExpedited, proposal.Expedited)
+	require.True(t, proposal.Expedited)
+	require.Equal(t, v1.ProposalTypeExpedited, proposal.ProposalType)
+
+	// proposals without a type are standard proposals
+	proposal, err = v1.NewProposal([]sdk.Msg{}, 1, time.Now(), time.Now(), "", "title", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), v1.ProposalType_PROPOSAL_TYPE_UNSPECIFIED)
+	require.NoError(t, err)
+	require.False(t, proposal.Expedited)
+	require.Equal(t, v1.ProposalTypeStandard, proposal.ProposalType)
 }
 
 func TestProposalGetMinDepositFromParams(t *testing.T) {
 	testcases := []struct {
-		expedited          bool
+		proposalType       v1.ProposalType
 		expectedMinDeposit math.Int
 	}{
 		{
-			expedited:          true,
+			proposalType:       v1.ProposalTypeExpedited,
 			expectedMinDeposit: v1.DefaultMinExpeditedDepositTokens,
 		},
 		{
-			expedited:          false,
+			proposalType:       v1.ProposalTypeStandard,
+			expectedMinDeposit: v1.DefaultMinDepositTokens,
+		},
+		{
+			proposalType:       v1.ProposalTypeOptimistic,
 			expectedMinDeposit: v1.DefaultMinDepositTokens,
 		},
 	}
 
 	for _, tc := range testcases {
-		proposal, err := v1.NewProposal([]sdk.Msg{}, 1, time.Now(), time.Now(), "", "title", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), tc.expedited)
+		proposal, err := v1.NewProposal([]sdk.Msg{}, 1, time.Now(), time.Now(), "", "title", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), tc.proposalType)
 		require.NoError(t, err)
 
 		actualMinDeposit := proposal.GetMinDepositFromParams(v1.DefaultParams())
@@ -80,3 +91,55 @@ func TestProposalGetMinDepositFromParams(t *testing.T) {
 		require.Equal(t, tc.expectedMinDeposit, actualMinDeposit[0].Amount)
 	}
 }
+
+func TestValidateVoteOptions(t *testing.T) {
+	testCases := []struct {
+		name        string
+		voteOptions []string
+		expErr      bool
+	}{
+		{"two options", []string{"red", "blue"}, false},
+		{"five options", []string{"red", "blue", "green", "yellow", "purple"}, false},
+		{"no options", nil, true},
+		{"one option", []string{"red"}, true},
+		{"six options", []string{"red", "blue", "green", "yellow", "purple", "orange"}, true},
+		{"empty label", []string{"red", " "}, true},
+		{"duplicate label", []string{"red", "red"}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := v1.ValidateVoteOptions(tc.voteOptions, 5)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestProposalMultipleChoice(t *testing.T) {
+	proposal, err := v1.NewProposal(nil, 1, time.Now(), time.Now(), "", "title", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), v1.ProposalTypeMultipleChoice)
+	require.NoError(t, err)
+	proposal.VoteOptions = []string{"red", "blue", "green"}
+
+	require.True(t, proposal.IsVoteAllowed(*v1.NewWeightedVoteChoice(0, math.LegacyOneDec())))
+	require.True(t, proposal.IsVoteAllowed(*v1.NewWeightedVoteChoice(2, math.LegacyOneDec())))
+	require.False(t, proposal.IsVoteAllowed(*v1.NewWeightedVoteChoice(3, math.LegacyOneDec())))
+	require.False(t, proposal.IsVoteAllowed(*v1.NewWeightedVoteOption(v1.OptionYes, math.LegacyOneDec())))
+
+	// the choices without count are not counted yet
+	tally := v1.NewTallyResult(math.NewInt(10), math.NewInt(20), math.NewInt(30), math.ZeroInt())
+	tally.ChoiceCounts = []string{"40", "50"}
+	require.Equal(t, []*v1.OptionTallyResult{
+		{Choice: 0, Label: "red", Count: "40"},
+		{Choice: 1, Label: "blue", Count: "50"},
+		{Choice: 2, Label: "green", Count: "0"},
+	}, proposal.MultipleChoiceTallyResult(tally))
+
+	standard, err := v1.NewProposal(nil, 2, time.Now(), time.Now(), "", "title", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), v1.ProposalTypeStandard)
+	require.NoError(t, err)
+	require.True(t, standard.IsVoteAllowed(*v1.NewWeightedVoteOption(v1.OptionYes, math.LegacyOneDec())))
+	require.False(t, standard.IsVoteAllowed(*v1.NewWeightedVoteChoice(0, math.LegacyOneDec())))
+}
@@ -57,5 +57,20 @@ func (tr TallyResult) Equals(comp TallyResult) bool {
 	return tr.YesCount == comp.YesCount &&
 		tr.AbstainCount == comp.AbstainCount &&
 		tr.NoCount == comp.NoCount &&
-		tr.NoWithVetoCount == comp.NoWithVetoCount
+		tr.NoWithVetoCount == comp.NoWithVetoCount &&
+		equalChoiceCounts(tr.ChoiceCounts, comp.ChoiceCounts)
+}
+
+func equalChoiceCounts(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
 }
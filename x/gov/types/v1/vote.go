@@ -3,6 +3,7 @@ package v1
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"cosmossdk.io/math"
@@ -16,6 +17,10 @@ const (
 	OptionNo         = VoteOption_VOTE_OPTION_NO
 	OptionNoWithVeto = VoteOption_VOTE_OPTION_NO_WITH_VETO
 	OptionAbstain    = VoteOption_VOTE_OPTION_ABSTAIN
+
+	// VoteChoicePrefix is the prefix of the choices of multiple choice
+	// proposals in vote option strings, e.g. "choice:0".
+	VoteChoicePrefix = "choice:"
 )
 
 // NewVote creates a new Vote instance
@@ -62,6 +67,12 @@ func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) *WeightedVoteOptio
 	return &WeightedVoteOption{Option: option, Weight: weight.String()}
 }
 
+// NewWeightedVoteChoice creates a weighted vote for the choice of a multiple
+// choice proposal.
+func NewWeightedVoteChoice(choice uint32, weight sdk.Dec) *WeightedVoteOption {
+	return &WeightedVoteOption{Option: OptionEmpty, Weight: weight.String(), Choice: choice}
+}
+
 // IsValid returns true if the sub vote is valid and false otherwise.
 func (w *WeightedVoteOption) IsValid() bool {
 	weight, err := math.LegacyNewDecFromStr(w.Weight)
@@ -73,12 +84,18 @@ func (w *WeightedVoteOption) IsValid() bool {
 		return false
 	}
 
-	return ValidVoteOption(w.Option)
+	return validVoteOptionOrChoice(*w)
 }
 
 // NewNonSplitVoteOption creates a single option vote with weight 1
 func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
-	return WeightedVoteOptions{{option, math.LegacyNewDec(1).String()}}
+	return WeightedVoteOptions{{Option: option, Weight: math.LegacyNewDec(1).String()}}
+}
+
+// NewNonSplitVoteChoice creates a single choice vote with weight 1 on a
+// multiple choice proposal.
+func NewNonSplitVoteChoice(choice uint32) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteChoice(choice, math.LegacyNewDec(1))}
 }
 
 // ValidWeightedVoteOption returns true if the sub vote is valid and false otherwise.
@@ -87,7 +104,18 @@ func ValidWeightedVoteOption(option WeightedVoteOption) bool {
 	if err != nil || !weight.IsPositive() || weight.GT(math.LegacyNewDec(1)) {
 		return false
 	}
-	return ValidVoteOption(option.Option)
+	return validVoteOptionOrChoice(option)
+}
+
+// validVoteOptionOrChoice returns true if the sub vote is either a valid vote
+// option without choice, or the choice of a multiple choice proposal with an
+// unspecified option. The choice is checked against the proposal when voting.
+func validVoteOptionOrChoice(option WeightedVoteOption) bool {
+	if option.Option == OptionEmpty {
+		return true
+	}
+
+	return ValidVoteOption(option.Option) && option.Choice == 0
 }
 
 // WeightedVoteOptions describes array of WeightedVoteOptions
@@ -108,16 +136,29 @@ func VoteOptionFromString(str string) (VoteOption, error) {
 	return VoteOption(option), nil
 }
 
+// VoteChoiceFromString returns the choice of a multiple choice proposal from a
+// string prefixed with VoteChoicePrefix. It returns false if the string is not
+// prefixed and an error if the choice is invalid.
+func VoteChoiceFromString(str string) (uint32, bool, error) {
+	if !strings.HasPrefix(str, VoteChoicePrefix) {
+		return 0, false, nil
+	}
+
+	choice, err := strconv.ParseUint(strings.TrimPrefix(str, VoteChoicePrefix), 10, 32)
+	if err != nil {
+		return 0, true, fmt.Errorf("'%s' is not a valid vote choice: %w", str, err)
+	}
+
+	return uint32(choice), true, nil
+}
+
 // WeightedVoteOptionsFromString returns weighted vote options from string. It returns an error
-// if the string is invalid.
+// if the string is invalid. The choices of multiple choice proposals are prefixed with
+// VoteChoicePrefix, e.g. "choice:0=0.6,choice:1=0.4".
 func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
 	options := WeightedVoteOptions{}
 	for _, option := range strings.Split(str, ",") {
 		fields := strings.Split(option, "=")
-		option, err := VoteOptionFromString(fields[0])
-		if err != nil {
-			return options, err
-		}
 		if len(fields) < 2 {
 			return options, fmt.Errorf("weight field does not exist for %s option", fields[0])
 		}
@@ -125,6 +166,20 @@ func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
 		if err != nil {
 			return options, err
 		}
+
+		choice, isChoice, err := VoteChoiceFromString(fields[0])
+		if err != nil {
+			return options, err
+		}
+		if isChoice {
+			options = append(options, NewWeightedVoteChoice(choice, weight))
+			continue
+		}
+
+		option, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return options, err
+		}
 		options = append(options, NewWeightedVoteOption(option, weight))
 	}
 	return options, nil
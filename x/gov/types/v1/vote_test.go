@@ -0,0 +1,63 @@
+package v1_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	testCases := []struct {
+		name       string
+		str        string
+		expOptions v1.WeightedVoteOptions
+		expErr     bool
+	}{
+		{
+			"vote options",
+			"VOTE_OPTION_YES=0.6,VOTE_OPTION_NO=0.4",
+			v1.WeightedVoteOptions{
+				v1.NewWeightedVoteOption(v1.OptionYes, math.LegacyNewDecWithPrec(6, 1)),
+				v1.NewWeightedVoteOption(v1.OptionNo, math.LegacyNewDecWithPrec(4, 1)),
+			},
+			false,
+		},
+		{
+			"vote choices",
+			"choice:0=0.6,choice:12=0.4",
+			v1.WeightedVoteOptions{
+				v1.NewWeightedVoteChoice(0, math.LegacyNewDecWithPrec(6, 1)),
+				v1.NewWeightedVoteChoice(12, math.LegacyNewDecWithPrec(4, 1)),
+			},
+			false,
+		},
+		{"invalid choice", "choice:red=1", nil, true},
+		{"negative choice", "choice:-1=1", nil, true},
+		{"invalid option", "VOTE_OPTION_ONE=1", nil, true},
+		{"missing weight", "choice:0", nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			options, err := v1.WeightedVoteOptionsFromString(tc.str)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expOptions, options)
+			for _, option := range options {
+				require.True(t, v1.ValidWeightedVoteOption(*option))
+			}
+		})
+	}
+}
+
+func TestValidWeightedVoteOption(t *testing.T) {
+	require.True(t, v1.ValidWeightedVoteOption(*v1.NewWeightedVoteChoice(3, math.LegacyOneDec())))
+	require.False(t, v1.ValidWeightedVoteOption(v1.WeightedVoteOption{Option: v1.OptionYes, Weight: "1", Choice: 3}))
+}
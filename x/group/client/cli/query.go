@@ -35,6 +35,8 @@ func QueryCmd(name string) *cobra.Command {
 		QueryGroupsByMemberCmd(),
 		QueryTallyResultCmd(),
 		QueryGroupsCmd(),
+		QueryQueuedExecutionCmd(),
+		QueryQueuedExecutionsByGroupPolicyCmd(),
 	)
 
 	return queryCmd
@@ -554,3 +556,75 @@ func QueryGroupsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// QueryQueuedExecutionCmd creates a CLI command for Query/QueuedExecution.
+func QueryQueuedExecutionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queued-execution [proposal-id]",
+		Short: "Query for the queued execution of a proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueuedExecution(cmd.Context(), &group.QueryQueuedExecutionRequest{
+				ProposalId: proposalID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryQueuedExecutionsByGroupPolicyCmd creates a CLI command for Query/QueuedExecutionsByGroupPolicy.
+func QueryQueuedExecutionsByGroupPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queued-executions-by-group-policy [group-policy-account]",
+		Short: "Query for queued executions by account address of group policy with pagination flags",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueuedExecutionsByGroupPolicy(cmd.Context(), &group.QueryQueuedExecutionsByGroupPolicyRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "queued-executions-by-group-policy")
+
+	return cmd
+}
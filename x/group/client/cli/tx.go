@@ -46,6 +46,7 @@ func TxCmd(name string, ac address.Codec) *cobra.Command {
 		MsgUpdateGroupPolicyDecisionPolicyCmd(ac),
 		MsgUpdateGroupPolicyMetadataCmd(),
 		MsgWithdrawProposalCmd(),
+		MsgCancelProposalCmd(),
 		MsgSubmitProposalCmd(),
 		MsgVoteCmd(),
 		MsgExecCmd(),
@@ -702,6 +703,52 @@ Parameters:
 	return cmd
 }
 
+// MsgCancelProposalCmd creates a CLI command for Msg/CancelProposal.
+func MsgCancelProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-proposal [proposal-id] [guardian]",
+		Short: "Cancel a proposal queued for execution",
+		Long: `Cancel a proposal queued for execution during the timelock of its group policy.
+
+Parameters:
+			proposal-id: unique ID of the proposal.
+			guardian: one of the guardians of the group policy decision policy.
+			Note: --from flag will be ignored here.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[1]); err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			if proposalID == 0 {
+				return fmt.Errorf("invalid proposal id: %d", proposalID)
+			}
+
+			msg := &group.MsgCancelProposal{
+				ProposalId: proposalID,
+				Guardian:   clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // MsgVoteCmd creates a CLI command for Msg/Vote.
 func MsgVoteCmd() *cobra.Command {
 	cmd := &cobra.Command{
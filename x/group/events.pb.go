@@ -6,16 +6,21 @@ package group
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -468,7 +473,7 @@ func (m *EventLeaveGroup) GetAddress() string {
 type EventProposalPruned struct {
 	// proposal_id is the unique ID of the proposal.
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// status is the proposal status (UNSPECIFIED, SUBMITTED, ACCEPTED, REJECTED, ABORTED, WITHDRAWN).
+	// status is the proposal status (UNSPECIFIED, SUBMITTED, ACCEPTED, REJECTED, ABORTED, WITHDRAWN, CANCELLED).
 	Status ProposalStatus `protobuf:"varint,2,opt,name=status,proto3,enum=cosmos.group.v1.ProposalStatus" json:"status,omitempty"`
 	// tally_result is the proposal tally result (when applicable).
 	TallyResult *TallyResult `protobuf:"bytes,3,opt,name=tally_result,json=tallyResult,proto3" json:"tally_result,omitempty"`
@@ -528,6 +533,118 @@ func (m *EventProposalPruned) GetTallyResult() *TallyResult {
 	return nil
 }
 
+// EventProposalQueued is an event emitted when an accepted proposal is queued
+// for execution at the end of its timelock.
+type EventProposalQueued struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// execute_after is the end of the timelock of the proposal.
+	ExecuteAfter time.Time `protobuf:"bytes,2,opt,name=execute_after,json=executeAfter,proto3,stdtime" json:"execute_after"`
+}
+
+func (m *EventProposalQueued) Reset()         { *m = EventProposalQueued{} }
+func (m *EventProposalQueued) String() string { return proto.CompactTextString(m) }
+func (*EventProposalQueued) ProtoMessage()    {}
+func (*EventProposalQueued) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{10}
+}
+func (m *EventProposalQueued) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProposalQueued) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProposalQueued.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventProposalQueued) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProposalQueued.Merge(m, src)
+}
+func (m *EventProposalQueued) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProposalQueued) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProposalQueued.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProposalQueued proto.InternalMessageInfo
+
+func (m *EventProposalQueued) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventProposalQueued) GetExecuteAfter() time.Time {
+	if m != nil {
+		return m.ExecuteAfter
+	}
+	return time.Time{}
+}
+
+// EventProposalCancelled is an event emitted when a guardian cancels a
+// proposal during its timelock.
+type EventProposalCancelled struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// guardian is the account address of the guardian.
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+}
+
+func (m *EventProposalCancelled) Reset()         { *m = EventProposalCancelled{} }
+func (m *EventProposalCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventProposalCancelled) ProtoMessage()    {}
+func (*EventProposalCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{11}
+}
+func (m *EventProposalCancelled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProposalCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProposalCancelled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventProposalCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProposalCancelled.Merge(m, src)
+}
+func (m *EventProposalCancelled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProposalCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProposalCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProposalCancelled proto.InternalMessageInfo
+
+func (m *EventProposalCancelled) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *EventProposalCancelled) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCreateGroup)(nil), "cosmos.group.v1.EventCreateGroup")
 	proto.RegisterType((*EventUpdateGroup)(nil), "cosmos.group.v1.EventUpdateGroup")
@@ -539,40 +656,49 @@ func init() {
 	proto.RegisterType((*EventExec)(nil), "cosmos.group.v1.EventExec")
 	proto.RegisterType((*EventLeaveGroup)(nil), "cosmos.group.v1.EventLeaveGroup")
 	proto.RegisterType((*EventProposalPruned)(nil), "cosmos.group.v1.EventProposalPruned")
+	proto.RegisterType((*EventProposalQueued)(nil), "cosmos.group.v1.EventProposalQueued")
+	proto.RegisterType((*EventProposalCancelled)(nil), "cosmos.group.v1.EventProposalCancelled")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/events.proto", fileDescriptor_e8d753981546f032) }
 
 var fileDescriptor_e8d753981546f032 = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0xef, 0xd2, 0x30,
-	0x18, 0xc7, 0xe9, 0x4f, 0x02, 0x52, 0x8c, 0x98, 0xfa, 0x27, 0x03, 0xc9, 0x20, 0xc4, 0x44, 0x0e,
-	0xb2, 0x05, 0x4c, 0xd4, 0x93, 0x44, 0x0c, 0x31, 0x24, 0x1c, 0xc8, 0xf0, 0x4f, 0xe2, 0x05, 0xc7,
-	0xda, 0x8c, 0xc5, 0x41, 0x97, 0xb6, 0x9b, 0x70, 0xf4, 0x1d, 0xf8, 0x52, 0x3c, 0xf8, 0x22, 0x3c,
-	0x12, 0x4f, 0x1e, 0x0d, 0xbc, 0x11, 0xb3, 0xae, 0x03, 0x82, 0x31, 0x23, 0xf9, 0x9d, 0x68, 0xfb,
-	0xfd, 0x7c, 0xbf, 0x3c, 0x4f, 0x9f, 0x15, 0xd6, 0x1d, 0xca, 0x97, 0x94, 0x9b, 0x2e, 0xa3, 0x61,
-	0x60, 0x46, 0x5d, 0x93, 0x44, 0x64, 0x25, 0xb8, 0x11, 0x30, 0x2a, 0x28, 0xaa, 0x24, 0xaa, 0x21,
-	0x55, 0x23, 0xea, 0xd6, 0xaa, 0xc9, 0xc1, 0x4c, 0xca, 0xa6, 0x52, 0xe5, 0xa6, 0xf6, 0xf0, 0x3c,
-	0x49, 0x6c, 0x02, 0xa2, 0xc4, 0x56, 0x07, 0xde, 0x19, 0xc6, 0xc1, 0xaf, 0x19, 0xb1, 0x05, 0x79,
-	0x13, 0x23, 0xa8, 0x0a, 0x6f, 0x4a, 0x76, 0xe6, 0x61, 0x0d, 0x34, 0x41, 0x3b, 0x6f, 0x15, 0xe5,
-	0x7e, 0x84, 0x0f, 0xf8, 0xbb, 0x00, 0x5f, 0x82, 0x8f, 0xe1, 0x83, 0xf3, 0xf4, 0x09, 0xf5, 0x3d,
-	0x67, 0x83, 0x7a, 0xb0, 0x68, 0x63, 0xcc, 0x08, 0xe7, 0xd2, 0x53, 0x1a, 0x68, 0xbf, 0x7e, 0x74,
-	0xee, 0xa9, 0xba, 0x5f, 0x25, 0xca, 0x54, 0x30, 0x6f, 0xe5, 0x5a, 0x29, 0x78, 0x48, 0x3b, 0xf9,
-	0xf3, 0x6b, 0xa4, 0x3d, 0x83, 0x77, 0x65, 0xda, 0x34, 0x9c, 0x2f, 0x3d, 0x31, 0x61, 0x34, 0xa0,
-	0xdc, 0xf6, 0x51, 0x03, 0x96, 0x03, 0xb5, 0x3e, 0x36, 0x04, 0xd3, 0xa3, 0x11, 0x6e, 0xbd, 0x80,
-	0xf7, 0xa5, 0xef, 0x83, 0x27, 0x16, 0x98, 0xd9, 0x5f, 0x2e, 0x77, 0x3e, 0x81, 0x25, 0xe9, 0x7c,
-	0x4f, 0x05, 0xc9, 0xa6, 0xbf, 0x02, 0x85, 0x0f, 0xd7, 0xc4, 0xc9, 0xc4, 0x51, 0x1f, 0x16, 0x18,
-	0xe1, 0xa1, 0x2f, 0xb4, 0xab, 0x26, 0x68, 0xdf, 0xee, 0x3d, 0x36, 0xce, 0x3e, 0x11, 0x23, 0x2d,
-	0x34, 0xce, 0x0b, 0x05, 0x65, 0x96, 0xc4, 0x2d, 0x65, 0x43, 0x08, 0xe6, 0x7d, 0xea, 0x72, 0xed,
-	0x46, 0x7c, 0x81, 0x96, 0x5c, 0xb7, 0x3e, 0xc1, 0x8a, 0x2c, 0x61, 0x4c, 0xec, 0x28, 0x73, 0xda,
-	0xa7, 0x53, 0xb8, 0xba, 0x74, 0x0a, 0xdf, 0x81, 0x1a, 0x43, 0x5a, 0xdd, 0x84, 0x85, 0x2b, 0x82,
-	0xb3, 0xfb, 0x7d, 0x0e, 0x0b, 0x5c, 0xd8, 0x22, 0xe4, 0xaa, 0xdf, 0xc6, 0x7f, 0xfb, 0x9d, 0x4a,
-	0xcc, 0x52, 0x38, 0xea, 0xc3, 0x5b, 0xc2, 0xf6, 0xfd, 0xcd, 0x4c, 0x5d, 0x57, 0xdc, 0x6f, 0xb9,
-	0x57, 0xff, 0xc7, 0xfe, 0x36, 0x86, 0xd4, 0x1d, 0x95, 0xc5, 0x71, 0x33, 0x78, 0xf9, 0x73, 0xa7,
-	0x83, 0xed, 0x4e, 0x07, 0x7f, 0x76, 0x3a, 0xf8, 0xb6, 0xd7, 0x73, 0xdb, 0xbd, 0x9e, 0xfb, 0xbd,
-	0xd7, 0x73, 0x1f, 0x1f, 0xb9, 0x9e, 0x58, 0x84, 0x73, 0xc3, 0xa1, 0x4b, 0xf5, 0x04, 0xd5, 0x4f,
-	0x87, 0xe3, 0xcf, 0xe6, 0x3a, 0x79, 0x81, 0xf3, 0x82, 0x7c, 0x79, 0x4f, 0xff, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0xa5, 0x1a, 0x1c, 0xb9, 0xe2, 0x03, 0x00, 0x00,
+	// 547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xe3, 0x52, 0xa5, 0xe9, 0xa6, 0x50, 0x64, 0x4a, 0x95, 0x86, 0xca, 0xae, 0x2c, 0x24,
+	0x7a, 0x20, 0xb6, 0x1a, 0x10, 0x70, 0xa2, 0x6a, 0xaa, 0x0a, 0x45, 0xea, 0x21, 0x38, 0x05, 0x24,
+	0x2e, 0x61, 0xe3, 0xdd, 0xba, 0x16, 0xb6, 0xd7, 0xda, 0x3f, 0xa1, 0x39, 0xf6, 0x0d, 0xfa, 0x28,
+	0x1c, 0x78, 0x88, 0x1e, 0x2b, 0x4e, 0x9c, 0x00, 0x25, 0x2f, 0x82, 0xbc, 0x5e, 0xa7, 0x21, 0x08,
+	0xd9, 0x52, 0x4f, 0xde, 0xf5, 0xfc, 0xbe, 0xf1, 0x37, 0x33, 0xde, 0x05, 0xdb, 0x1e, 0x61, 0x11,
+	0x61, 0x8e, 0x4f, 0x89, 0x48, 0x9c, 0xd1, 0x9e, 0x83, 0x47, 0x38, 0xe6, 0xcc, 0x4e, 0x28, 0xe1,
+	0x44, 0x5f, 0xcf, 0xa2, 0xb6, 0x8c, 0xda, 0xa3, 0xbd, 0xe6, 0x86, 0x4f, 0x7c, 0x22, 0x63, 0x4e,
+	0xba, 0xca, 0xb0, 0xa6, 0xe9, 0x13, 0xe2, 0x87, 0xd8, 0x91, 0xbb, 0xa1, 0x38, 0x75, 0x78, 0x10,
+	0x61, 0xc6, 0x61, 0x94, 0x28, 0x60, 0x2b, 0xcb, 0x33, 0xc8, 0x94, 0x2a, 0x69, 0x16, 0x7a, 0xb4,
+	0x68, 0x80, 0x8f, 0x13, 0xac, 0x82, 0x56, 0x0b, 0xdc, 0x3f, 0x4a, 0xfd, 0x1c, 0x52, 0x0c, 0x39,
+	0x7e, 0x93, 0x22, 0xfa, 0x16, 0xa8, 0x49, 0x76, 0x10, 0xa0, 0x86, 0xb6, 0xa3, 0xed, 0x2e, 0xbb,
+	0x2b, 0x72, 0xdf, 0x45, 0x33, 0xfc, 0x5d, 0x82, 0xca, 0xe0, 0xc7, 0x60, 0x73, 0x31, 0x7b, 0x8f,
+	0x84, 0x81, 0x37, 0xd6, 0xdb, 0x60, 0x05, 0x22, 0x44, 0x31, 0x63, 0x52, 0xb3, 0xda, 0x69, 0x7c,
+	0xff, 0xd6, 0xda, 0x50, 0xbe, 0x0f, 0xb2, 0x48, 0x9f, 0xd3, 0x20, 0xf6, 0xdd, 0x1c, 0x9c, 0x65,
+	0x9b, 0xfb, 0xf8, 0x2d, 0xb2, 0xbd, 0x00, 0x0f, 0x64, 0xb6, 0xbe, 0x18, 0x46, 0x01, 0xef, 0x51,
+	0x92, 0x10, 0x06, 0x43, 0xdd, 0x04, 0xf5, 0x44, 0xad, 0x6f, 0x0a, 0x02, 0xf9, 0xab, 0x2e, 0xb2,
+	0x5e, 0x81, 0x87, 0x52, 0xf7, 0x21, 0xe0, 0x67, 0x88, 0xc2, 0x2f, 0xe5, 0x95, 0x4f, 0xc1, 0xaa,
+	0x54, 0xbe, 0x27, 0x1c, 0x17, 0xd3, 0x17, 0x9a, 0xc2, 0x8f, 0xce, 0xb1, 0x57, 0x88, 0xeb, 0xfb,
+	0xa0, 0x4a, 0x31, 0x13, 0x21, 0x6f, 0x2c, 0xed, 0x68, 0xbb, 0xf7, 0xda, 0x4f, 0xec, 0x85, 0x3f,
+	0xcb, 0xce, 0x8d, 0xa6, 0xf9, 0x04, 0x27, 0xd4, 0x95, 0xb8, 0xab, 0x64, 0xba, 0x0e, 0x96, 0x43,
+	0xe2, 0xb3, 0xc6, 0x9d, 0xb4, 0x81, 0xae, 0x5c, 0x5b, 0x9f, 0xc0, 0xba, 0xb4, 0x70, 0x8c, 0xe1,
+	0xa8, 0x70, 0xda, 0xf3, 0x53, 0x58, 0x2a, 0x3b, 0x85, 0xaf, 0x9a, 0x1a, 0x43, 0xee, 0xae, 0x47,
+	0x45, 0x8c, 0x51, 0x71, 0xbd, 0x2f, 0x41, 0x95, 0x71, 0xc8, 0x05, 0x53, 0xf5, 0x9a, 0xff, 0xad,
+	0xb7, 0x2f, 0x31, 0x57, 0xe1, 0xfa, 0x3e, 0x58, 0xe3, 0x30, 0x0c, 0xc7, 0x03, 0xd5, 0xae, 0xb4,
+	0xde, 0x7a, 0x7b, 0xfb, 0x1f, 0xf9, 0x49, 0x0a, 0xa9, 0x1e, 0xd5, 0xf9, 0xcd, 0xc6, 0xba, 0x58,
+	0xb4, 0xfc, 0x56, 0x60, 0x51, 0xc6, 0x72, 0x17, 0xdc, 0xc5, 0xb2, 0xf7, 0x78, 0x00, 0x4f, 0x39,
+	0xa6, 0xd2, 0x79, 0xbd, 0xdd, 0xb4, 0xb3, 0xc3, 0x6d, 0xe7, 0x87, 0xdb, 0x3e, 0xc9, 0x0f, 0x77,
+	0xa7, 0x76, 0xf5, 0xd3, 0xac, 0x5c, 0xfe, 0x32, 0x35, 0x77, 0x4d, 0x49, 0x0f, 0x52, 0xa5, 0x45,
+	0xc0, 0xe6, 0x5f, 0x16, 0x0e, 0x61, 0xec, 0xe1, 0x30, 0x2c, 0xe3, 0xe2, 0x39, 0xa8, 0xf9, 0x02,
+	0x52, 0x14, 0xc0, 0xb8, 0x70, 0x4c, 0x33, 0xb2, 0xf3, 0xfa, 0x6a, 0x62, 0x68, 0xd7, 0x13, 0x43,
+	0xfb, 0x3d, 0x31, 0xb4, 0xcb, 0xa9, 0x51, 0xb9, 0x9e, 0x1a, 0x95, 0x1f, 0x53, 0xa3, 0xf2, 0xf1,
+	0xb1, 0x1f, 0xf0, 0x33, 0x31, 0xb4, 0x3d, 0x12, 0xa9, 0x7b, 0x47, 0x3d, 0x5a, 0x0c, 0x7d, 0x76,
+	0xce, 0xb3, 0x6b, 0x67, 0x58, 0x95, 0xc5, 0x3d, 0xfb, 0x33, 0x00, 0xb7, 0x48, 0x41, 0x82, 0x0e,
+	0x05, 0x00, 0x00,
 }
 
 func (m *EventCreateGroup) Marshal() (dAtA []byte, err error) {
@@ -895,6 +1021,77 @@ func (m *EventProposalPruned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventProposalQueued) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProposalQueued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProposalQueued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecuteAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecuteAfter):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvents(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventProposalCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProposalCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProposalCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1046,6 +1243,36 @@ func (m *EventProposalPruned) Size() (n int) {
 	return n
 }
 
+func (m *EventProposalQueued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecuteAfter)
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventProposalCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1906,6 +2133,209 @@ func (m *EventProposalPruned) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventProposalQueued) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProposalQueued: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProposalQueued: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecuteAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventProposalCancelled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProposalCancelled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProposalCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
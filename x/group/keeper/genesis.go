@@ -5,6 +5,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -17,75 +18,177 @@ func (k Keeper) InitGenesis(ctx types.Context, cdc codec.JSONCodec, data json.Ra
 	var genesisState group.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
 
-	if err := k.groupTable.Import(ctx.KVStore(k.key), genesisState.Groups, genesisState.GroupSeq); err != nil {
-		panic(errors.Wrap(err, "groups"))
+	if err := k.importState(ctx, &genesisState); err != nil {
+		panic(err)
 	}
 
-	if err := k.groupMemberTable.Import(ctx.KVStore(k.key), genesisState.GroupMembers, 0); err != nil {
-		panic(errors.Wrap(err, "group members"))
+	return []abci.ValidatorUpdate{}
+}
+
+// importState writes the given group state to the store.
+func (k Keeper) importState(ctx types.Context, genesisState *group.GenesisState) error {
+	if err := k.GroupSeq.Set(ctx, genesisState.GroupSeq); err != nil {
+		return errors.Wrap(err, "group seq")
 	}
 
-	if err := k.groupPolicyTable.Import(ctx.KVStore(k.key), genesisState.GroupPolicies, 0); err != nil {
-		panic(errors.Wrap(err, "group policies"))
+	for _, g := range genesisState.Groups {
+		if err := validateAndSet(ctx, k.GroupInfos.Set, g.Id, *g); err != nil {
+			return errors.Wrap(err, "groups")
+		}
 	}
 
-	if err := k.groupPolicySeq.InitVal(ctx.KVStore(k.key), genesisState.GroupPolicySeq); err != nil {
-		panic(errors.Wrap(err, "group policy account seq"))
+	for _, m := range genesisState.GroupMembers {
+		if err := k.setGroupMember(ctx, *m); err != nil {
+			return errors.Wrap(err, "group members")
+		}
 	}
 
-	if err := k.proposalTable.Import(ctx.KVStore(k.key), genesisState.Proposals, genesisState.ProposalSeq); err != nil {
-		panic(errors.Wrap(err, "proposals"))
+	for _, p := range genesisState.GroupPolicies {
+		if err := k.setGroupPolicyInfo(ctx, *p); err != nil {
+			return errors.Wrap(err, "group policies")
+		}
 	}
 
-	if err := k.voteTable.Import(ctx.KVStore(k.key), genesisState.Votes, 0); err != nil {
-		panic(errors.Wrap(err, "votes"))
+	if err := k.GroupPolicySeq.Set(ctx, genesisState.GroupPolicySeq); err != nil {
+		return errors.Wrap(err, "group policy account seq")
 	}
 
-	return []abci.ValidatorUpdate{}
+	if err := k.ProposalSeq.Set(ctx, genesisState.ProposalSeq); err != nil {
+		return errors.Wrap(err, "proposal seq")
+	}
+
+	for _, p := range genesisState.Proposals {
+		if err := validateAndSet(ctx, k.Proposals.Set, p.Id, *p); err != nil {
+			return errors.Wrap(err, "proposals")
+		}
+
+		if err := k.scheduleImportedPrune(ctx, *p); err != nil {
+			return errors.Wrap(err, "proposals")
+		}
+	}
+
+	for _, v := range genesisState.Votes {
+		voter, err := k.accKeeper.AddressCodec().StringToBytes(v.Voter)
+		if err != nil {
+			return errors.Wrap(err, "votes")
+		}
+		if err := validateAndSet(ctx, k.Votes.Set, collections.Join(v.ProposalId, types.AccAddress(voter)), *v); err != nil {
+			return errors.Wrap(err, "votes")
+		}
+	}
+
+	for _, w := range genesisState.ProposalMemberWeights {
+		member, err := k.accKeeper.AddressCodec().StringToBytes(w.Member)
+		if err != nil {
+			return errors.Wrap(err, "proposal member weights")
+		}
+		if err := validateAndSet(ctx, k.ProposalMemberWeights.Set, collections.Join(w.ProposalId, types.AccAddress(member)), *w); err != nil {
+			return errors.Wrap(err, "proposal member weights")
+		}
+	}
+
+	for _, q := range genesisState.QueuedExecutions {
+		if err := validateAndSet(ctx, k.QueuedExecutions.Set, q.ProposalId, *q); err != nil {
+			return errors.Wrap(err, "queued executions")
+		}
+	}
+
+	return nil
+}
+
+// scheduleImportedPrune schedules the pruning of an imported proposal using the
+// decision policy of its group policy.
+func (k Keeper) scheduleImportedPrune(ctx types.Context, p group.Proposal) error {
+	policyInfo, err := k.getGroupPolicyInfo(ctx, p.GroupPolicyAddress)
+	if err != nil {
+		return err
+	}
+
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return err
+	}
+
+	return k.schedulePrune(ctx, p, policy)
 }
 
 // ExportGenesis returns the group module's exported genesis.
 func (k Keeper) ExportGenesis(ctx types.Context, _ codec.JSONCodec) *group.GenesisState {
 	genesisState := group.NewGenesisState()
 
-	var groups []*group.GroupInfo
-
-	groupSeq, err := k.groupTable.Export(ctx.KVStore(k.key), &groups)
+	groupSeq, err := k.GroupSeq.Peek(ctx)
 	if err != nil {
-		panic(errors.Wrap(err, "groups"))
+		panic(errors.Wrap(err, "group seq"))
 	}
-	genesisState.Groups = groups
 	genesisState.GroupSeq = groupSeq
 
-	var groupMembers []*group.GroupMember
-	_, err = k.groupMemberTable.Export(ctx.KVStore(k.key), &groupMembers)
-	if err != nil {
+	err = k.GroupInfos.Walk(ctx, nil, func(_ uint64, g group.GroupInfo) (bool, error) {
+		genesisState.Groups = append(genesisState.Groups, &g)
+		return false, nil
+	})
+	if err != nil && !errors.IsOf(err, collections.ErrInvalidIterator) {
+		panic(errors.Wrap(err, "groups"))
+	}
+
+	err = k.Members.Walk(ctx, nil, func(_ collections.Pair[uint64, types.AccAddress], m group.GroupMember) (bool, error) {
+		genesisState.GroupMembers = append(genesisState.GroupMembers, &m)
+		return false, nil
+	})
+	if err != nil && !errors.IsOf(err, collections.ErrInvalidIterator) {
 		panic(errors.Wrap(err, "group members"))
 	}
-	genesisState.GroupMembers = groupMembers
 
-	var groupPolicies []*group.GroupPolicyInfo
-	_, err = k.groupPolicyTable.Export(ctx.KVStore(k.key), &groupPolicies)
-	if err != nil {
+	err = k.GroupPolicies.Walk(ctx, nil, func(_ types.AccAddress, p group.GroupPolicyInfo) (bool, error) {
+		genesisState.GroupPolicies = append(genesisState.GroupPolicies, &p)
+		return false, nil
+	})
+	if err != nil && !errors.IsOf(err, collections.ErrInvalidIterator) {
 		panic(errors.Wrap(err, "group policies"))
 	}
-	genesisState.GroupPolicies = groupPolicies
-	genesisState.GroupPolicySeq = k.groupPolicySeq.CurVal(ctx.KVStore(k.key))
 
-	var proposals []*group.Proposal
-	proposalSeq, err := k.proposalTable.Export(ctx.KVStore(k.key), &proposals)
+	groupPolicySeq, err := k.GroupPolicySeq.Peek(ctx)
 	if err != nil {
-		panic(errors.Wrap(err, "proposals"))
+		panic(errors.Wrap(err, "group policy account seq"))
 	}
-	genesisState.Proposals = proposals
-	genesisState.ProposalSeq = proposalSeq
+	genesisState.GroupPolicySeq = groupPolicySeq
 
-	var votes []*group.Vote
-	_, err = k.voteTable.Export(ctx.KVStore(k.key), &votes)
+	proposalSeq, err := k.ProposalSeq.Peek(ctx)
 	if err != nil {
+		panic(errors.Wrap(err, "proposal seq"))
+	}
+	genesisState.ProposalSeq = proposalSeq
+
+	err = k.Proposals.Walk(ctx, nil, func(_ uint64, p group.Proposal) (bool, error) {
+		genesisState.Proposals = append(genesisState.Proposals, &p)
+		return false, nil
+	})
+	if err != nil && !errors.IsOf(err, collections.ErrInvalidIterator) {
+		panic(errors.Wrap(err, "proposals"))
+	}
+
+	err = k.Votes.Walk(ctx, nil, func(_ collections.Pair[uint64, types.AccAddress], v group.Vote) (bool, error) {
+		genesisState.Votes = append(genesisState.Votes, &v)
+		return false, nil
+	})
+	if err != nil && !errors.IsOf(err, collections.ErrInvalidIterator) {
 		panic(errors.Wrap(err, "votes"))
 	}
-	genesisState.Votes = votes
+
+	err = k.ProposalMemberWeights.Walk(ctx, nil, func(_ collections.Pair[uint64, types.AccAddress], w group.ProposalMemberWeight) (bool, error) {
+		genesisState.ProposalMemberWeights = append(genesisState.ProposalMemberWeights, &w)
+		return false, nil
+	})
+	if err != nil && !errors.IsOf(err, collections.ErrInvalidIterator) {
+		panic(errors.Wrap(err, "proposal member weights"))
+	}
+
+	err = k.QueuedExecutions.Walk(ctx, nil, func(_ uint64, q group.QueuedExecution) (bool, error) {
+		genesisState.QueuedExecutions = append(genesisState.QueuedExecutions, &q)
+		return false, nil
+	})
+	if err != nil && !errors.IsOf(err, collections.ErrInvalidIterator) {
+		panic(errors.Wrap(err, "queued executions"))
+	}
 
 	return genesisState
 }
@@ -71,7 +71,7 @@ func (s *GenesisTestSuite) SetupTest() {
 	s.cdc = codec.NewProtoCodec(encCfg.InterfaceRegistry)
 	s.ctx = s.sdkCtx
 
-	s.keeper = keeper.NewKeeper(key, s.cdc, bApp.MsgServiceRouter(), accountKeeper, group.DefaultConfig())
+	s.keeper = keeper.NewKeeper(key, s.cdc, bApp.MsgServiceRouter(), accountKeeper, grouptestutil.NewMockBankKeeper(ctrl), grouptestutil.NewMockStakingKeeper(ctrl), group.DefaultConfig())
 }
 
 func (s *GenesisTestSuite) TestInitExportGenesis() {
@@ -2,18 +2,18 @@ package keeper
 
 import (
 	"context"
-	"math"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/cosmos/cosmos-sdk/x/group/errors"
-	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
 )
 
 var _ group.QueryServer = Keeper{}
@@ -32,8 +32,10 @@ func (k Keeper) GroupInfo(goCtx context.Context, request *group.QueryGroupInfoRe
 
 // getGroupInfo gets the group info of the given group id.
 func (k Keeper) getGroupInfo(ctx sdk.Context, id uint64) (group.GroupInfo, error) {
-	var obj group.GroupInfo
-	_, err := k.groupTable.GetOne(ctx.KVStore(k.key), id, &obj)
+	obj, err := k.GroupInfos.Get(ctx, id)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		return obj, sdkerrors.ErrNotFound
+	}
 	return obj, err
 }
 
@@ -50,21 +52,27 @@ func (k Keeper) GroupPolicyInfo(goCtx context.Context, request *group.QueryGroup
 
 // getGroupPolicyInfo gets the group policy info of the given account address.
 func (k Keeper) getGroupPolicyInfo(ctx sdk.Context, accountAddress string) (group.GroupPolicyInfo, error) {
-	var obj group.GroupPolicyInfo
-	return obj, k.groupPolicyTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.GroupPolicyInfo{Address: accountAddress}), &obj)
+	addr, err := k.accKeeper.AddressCodec().StringToBytes(accountAddress)
+	if err != nil {
+		return group.GroupPolicyInfo{}, err
+	}
+	obj, err := k.GroupPolicies.Get(ctx, addr)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		return obj, sdkerrors.ErrNotFound
+	}
+	return obj, err
 }
 
 // GroupMembers queries all members of a group.
 func (k Keeper) GroupMembers(goCtx context.Context, request *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	groupID := request.GroupId
-	it, err := k.getGroupMembers(ctx, groupID, request.Pagination)
-	if err != nil {
-		return nil, err
-	}
 
 	var members []*group.GroupMember
-	pageRes, err := orm.Paginate(it, request.Pagination, &members)
+	_, pageRes, err := query.CollectionFilteredPaginate(ctx, k.Members, request.Pagination, func(_ collections.Pair[uint64, sdk.AccAddress], member group.GroupMember) (bool, error) {
+		members = append(members, &member)
+		return true, nil
+	}, query.WithCollectionPaginationPairPrefix[uint64, sdk.AccAddress](groupID))
 	if err != nil {
 		return nil, err
 	}
@@ -75,11 +83,6 @@ func (k Keeper) GroupMembers(goCtx context.Context, request *group.QueryGroupMem
 	}, nil
 }
 
-// getGroupMembers returns an iterator for the given group id and page request.
-func (k Keeper) getGroupMembers(ctx sdk.Context, id uint64, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.groupMemberByGroupIndex.GetPaginated(ctx.KVStore(k.key), id, pageRequest)
-}
-
 // GroupsByAdmin queries all groups where a given address is admin.
 func (k Keeper) GroupsByAdmin(goCtx context.Context, request *group.QueryGroupsByAdminRequest) (*group.QueryGroupsByAdminResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -87,13 +90,16 @@ func (k Keeper) GroupsByAdmin(goCtx context.Context, request *group.QueryGroupsB
 	if err != nil {
 		return nil, err
 	}
-	it, err := k.getGroupsByAdmin(ctx, addr, request.Pagination)
-	if err != nil {
-		return nil, err
-	}
 
 	var groups []*group.GroupInfo
-	pageRes, err := orm.Paginate(it, request.Pagination, &groups)
+	_, pageRes, err := query.CollectionFilteredPaginate(ctx, k.GroupInfos.Indexes.Admin, request.Pagination, func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (bool, error) {
+		groupInfo, err := k.GroupInfos.Get(ctx, key.K2())
+		if err != nil {
+			return false, err
+		}
+		groups = append(groups, &groupInfo)
+		return true, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr))
 	if err != nil {
 		return nil, err
 	}
@@ -104,22 +110,20 @@ func (k Keeper) GroupsByAdmin(goCtx context.Context, request *group.QueryGroupsB
 	}, nil
 }
 
-// getGroupsByAdmin returns an iterator for the given admin account address and page request.
-func (k Keeper) getGroupsByAdmin(ctx sdk.Context, admin sdk.AccAddress, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.groupByAdminIndex.GetPaginated(ctx.KVStore(k.key), admin.Bytes(), pageRequest)
-}
-
 // GroupPoliciesByGroup queries all groups policies of a given group.
 func (k Keeper) GroupPoliciesByGroup(goCtx context.Context, request *group.QueryGroupPoliciesByGroupRequest) (*group.QueryGroupPoliciesByGroupResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	groupID := request.GroupId
-	it, err := k.getGroupPoliciesByGroup(ctx, groupID, request.Pagination)
-	if err != nil {
-		return nil, err
-	}
 
 	var policies []*group.GroupPolicyInfo
-	pageRes, err := orm.Paginate(it, request.Pagination, &policies)
+	_, pageRes, err := query.CollectionFilteredPaginate(ctx, k.GroupPolicies.Indexes.Group, request.Pagination, func(key collections.Pair[uint64, sdk.AccAddress], _ collections.NoValue) (bool, error) {
+		policy, err := k.GroupPolicies.Get(ctx, key.K2())
+		if err != nil {
+			return false, err
+		}
+		policies = append(policies, &policy)
+		return true, nil
+	}, query.WithCollectionPaginationPairPrefix[uint64, sdk.AccAddress](groupID))
 	if err != nil {
 		return nil, err
 	}
@@ -130,11 +134,6 @@ func (k Keeper) GroupPoliciesByGroup(goCtx context.Context, request *group.Query
 	}, nil
 }
 
-// getGroupPoliciesByGroup returns an iterator for the given group id and page request.
-func (k Keeper) getGroupPoliciesByGroup(ctx sdk.Context, id uint64, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.groupPolicyByGroupIndex.GetPaginated(ctx.KVStore(k.key), id, pageRequest)
-}
-
 // GroupPoliciesByAdmin queries all groups policies where a given address is
 // admin.
 func (k Keeper) GroupPoliciesByAdmin(goCtx context.Context, request *group.QueryGroupPoliciesByAdminRequest) (*group.QueryGroupPoliciesByAdminResponse, error) {
@@ -143,13 +142,16 @@ func (k Keeper) GroupPoliciesByAdmin(goCtx context.Context, request *group.Query
 	if err != nil {
 		return nil, err
 	}
-	it, err := k.getGroupPoliciesByAdmin(ctx, addr, request.Pagination)
-	if err != nil {
-		return nil, err
-	}
 
 	var policies []*group.GroupPolicyInfo
-	pageRes, err := orm.Paginate(it, request.Pagination, &policies)
+	_, pageRes, err := query.CollectionFilteredPaginate(ctx, k.GroupPolicies.Indexes.Admin, request.Pagination, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], _ collections.NoValue) (bool, error) {
+		policy, err := k.GroupPolicies.Get(ctx, key.K2())
+		if err != nil {
+			return false, err
+		}
+		policies = append(policies, &policy)
+		return true, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](addr))
 	if err != nil {
 		return nil, err
 	}
@@ -160,11 +162,6 @@ func (k Keeper) GroupPoliciesByAdmin(goCtx context.Context, request *group.Query
 	}, nil
 }
 
-// getGroupPoliciesByAdmin returns an iterator for the given admin account address and page request.
-func (k Keeper) getGroupPoliciesByAdmin(ctx sdk.Context, admin sdk.AccAddress, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.groupPolicyByAdminIndex.GetPaginated(ctx.KVStore(k.key), admin.Bytes(), pageRequest)
-}
-
 // Proposal queries a proposal.
 func (k Keeper) Proposal(goCtx context.Context, request *group.QueryProposalRequest) (*group.QueryProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -184,13 +181,16 @@ func (k Keeper) ProposalsByGroupPolicy(goCtx context.Context, request *group.Que
 	if err != nil {
 		return nil, err
 	}
-	it, err := k.getProposalsByGroupPolicy(ctx, addr, request.Pagination)
-	if err != nil {
-		return nil, err
-	}
 
 	var proposals []*group.Proposal
-	pageRes, err := orm.Paginate(it, request.Pagination, &proposals)
+	_, pageRes, err := query.CollectionFilteredPaginate(ctx, k.Proposals.Indexes.GroupPolicy, request.Pagination, func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (bool, error) {
+		proposal, err := k.Proposals.Get(ctx, key.K2())
+		if err != nil {
+			return false, err
+		}
+		proposals = append(proposals, &proposal)
+		return true, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr))
 	if err != nil {
 		return nil, err
 	}
@@ -201,15 +201,13 @@ func (k Keeper) ProposalsByGroupPolicy(goCtx context.Context, request *group.Que
 	}, nil
 }
 
-// getProposalsByGroupPolicy returns an iterator for the given account address and page request.
-func (k Keeper) getProposalsByGroupPolicy(ctx sdk.Context, account sdk.AccAddress, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.proposalByGroupPolicyIndex.GetPaginated(ctx.KVStore(k.key), account.Bytes(), pageRequest)
-}
-
 // getProposal gets the proposal info of the given proposal id.
 func (k Keeper) getProposal(ctx sdk.Context, proposalID uint64) (group.Proposal, error) {
-	var p group.Proposal
-	if _, err := k.proposalTable.GetOne(ctx.KVStore(k.key), proposalID, &p); err != nil {
+	p, err := k.Proposals.Get(ctx, proposalID)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		err = sdkerrors.ErrNotFound
+	}
+	if err != nil {
 		return group.Proposal{}, errorsmod.Wrap(err, "load proposal")
 	}
 	return p, nil
@@ -236,13 +234,12 @@ func (k Keeper) VoteByProposalVoter(goCtx context.Context, request *group.QueryV
 func (k Keeper) VotesByProposal(goCtx context.Context, request *group.QueryVotesByProposalRequest) (*group.QueryVotesByProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	proposalID := request.ProposalId
-	it, err := k.getVotesByProposal(ctx, proposalID, request.Pagination)
-	if err != nil {
-		return nil, err
-	}
 
 	var votes []*group.Vote
-	pageRes, err := orm.Paginate(it, request.Pagination, &votes)
+	_, pageRes, err := query.CollectionFilteredPaginate(ctx, k.Votes, request.Pagination, func(_ collections.Pair[uint64, sdk.AccAddress], vote group.Vote) (bool, error) {
+		votes = append(votes, &vote)
+		return true, nil
+	}, query.WithCollectionPaginationPairPrefix[uint64, sdk.AccAddress](proposalID))
 	if err != nil {
 		return nil, err
 	}
@@ -260,13 +257,16 @@ func (k Keeper) VotesByVoter(goCtx context.Context, request *group.QueryVotesByV
 	if err != nil {
 		return nil, err
 	}
-	it, err := k.getVotesByVoter(ctx, addr, request.Pagination)
-	if err != nil {
-		return nil, err
-	}
 
 	var votes []*group.Vote
-	pageRes, err := orm.Paginate(it, request.Pagination, &votes)
+	_, pageRes, err := query.CollectionFilteredPaginate(ctx, k.Votes.Indexes.Voter, request.Pagination, func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (bool, error) {
+		vote, err := k.Votes.Get(ctx, collections.Join(key.K2(), key.K1()))
+		if err != nil {
+			return false, err
+		}
+		votes = append(votes, &vote)
+		return true, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr))
 	if err != nil {
 		return nil, err
 	}
@@ -289,24 +289,17 @@ func (k Keeper) GroupsByMember(goCtx context.Context, request *group.QueryGroups
 		return nil, err
 	}
 
-	iter, err := k.groupMemberByMemberIndex.GetPaginated(ctx.KVStore(k.key), member, request.Pagination)
-	if err != nil {
-		return nil, err
-	}
-
-	var members []*group.GroupMember
-	pageRes, err := orm.Paginate(iter, request.Pagination, &members)
-	if err != nil {
-		return nil, err
-	}
-
 	var groups []*group.GroupInfo
-	for _, gm := range members {
-		groupInfo, err := k.getGroupInfo(ctx, gm.GroupId)
+	_, pageRes, err := query.CollectionFilteredPaginate(ctx, k.Members.Indexes.Member, request.Pagination, func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (bool, error) {
+		groupInfo, err := k.getGroupInfo(ctx, key.K2())
 		if err != nil {
-			return nil, err
+			return false, err
 		}
 		groups = append(groups, &groupInfo)
+		return true, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](member))
+	if err != nil {
+		return nil, err
 	}
 
 	return &group.QueryGroupsByMemberResponse{
@@ -317,18 +310,11 @@ func (k Keeper) GroupsByMember(goCtx context.Context, request *group.QueryGroups
 
 // getVote gets the vote info for the given proposal id and voter address.
 func (k Keeper) getVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (group.Vote, error) {
-	var v group.Vote
-	return v, k.voteTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.Vote{ProposalId: proposalID, Voter: voter.String()}), &v)
-}
-
-// getVotesByProposal returns an iterator for the given proposal id and page request.
-func (k Keeper) getVotesByProposal(ctx sdk.Context, proposalID uint64, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.voteByProposalIndex.GetPaginated(ctx.KVStore(k.key), proposalID, pageRequest)
-}
-
-// getVotesByVoter returns an iterator for the given voter address and page request.
-func (k Keeper) getVotesByVoter(ctx sdk.Context, voter sdk.AccAddress, pageRequest *query.PageRequest) (orm.Iterator, error) {
-	return k.voteByVoterIndex.GetPaginated(ctx.KVStore(k.key), voter.Bytes(), pageRequest)
+	v, err := k.Votes.Get(ctx, collections.Join(proposalID, voter))
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		return v, sdkerrors.ErrNotFound
+	}
+	return v, err
 }
 
 // TallyResult computes the live tally result of a proposal.
@@ -364,14 +350,11 @@ func (k Keeper) TallyResult(goCtx context.Context, request *group.QueryTallyResu
 func (k Keeper) Groups(goCtx context.Context, request *group.QueryGroupsRequest) (*group.QueryGroupsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	it, err := k.groupTable.PrefixScan(ctx.KVStore(k.key), 1, math.MaxUint64)
-	if err != nil {
-		return nil, err
-	}
-	defer it.Close()
-
 	var groups []*group.GroupInfo
-	pageRes, err := orm.Paginate(it, request.Pagination, &groups)
+	_, pageRes, err := query.CollectionFilteredPaginate(ctx, k.GroupInfos, request.Pagination, func(_ uint64, groupInfo group.GroupInfo) (bool, error) {
+		groups = append(groups, &groupInfo)
+		return true, nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -381,3 +364,42 @@ func (k Keeper) Groups(goCtx context.Context, request *group.QueryGroupsRequest)
 		Pagination: pageRes,
 	}, nil
 }
+
+// QueuedExecution queries the queued execution of a proposal.
+func (k Keeper) QueuedExecution(goCtx context.Context, request *group.QueryQueuedExecutionRequest) (*group.QueryQueuedExecutionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	q, err := k.getQueuedExecution(ctx, request.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryQueuedExecutionResponse{QueuedExecution: &q}, nil
+}
+
+// QueuedExecutionsByGroupPolicy queries all queued executions of a group
+// policy.
+func (k Keeper) QueuedExecutionsByGroupPolicy(goCtx context.Context, request *group.QueryQueuedExecutionsByGroupPolicyRequest) (*group.QueryQueuedExecutionsByGroupPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	addr, err := k.accKeeper.AddressCodec().StringToBytes(request.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	var queued []*group.QueuedExecution
+	_, pageRes, err := query.CollectionFilteredPaginate(ctx, k.QueuedExecutions.Indexes.GroupPolicy, request.Pagination, func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (bool, error) {
+		q, err := k.QueuedExecutions.Get(ctx, key.K2())
+		if err != nil {
+			return false, err
+		}
+		queued = append(queued, &q)
+		return true, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr))
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryQueuedExecutionsByGroupPolicyResponse{
+		QueuedExecutions: queued,
+		Pagination:       pageRes,
+	}, nil
+}
@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+	"cosmossdk.io/collections/indexes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// multiIndex is a collections index mapping a reference key to multiple
+// primary keys. It behaves like indexes.Multi but also exposes IterateRaw and
+// KeyCodec, so that it can be paginated using query.CollectionPaginate.
+type multiIndex[ReferenceKey, PrimaryKey, Value any] struct {
+	getRefKey func(pk PrimaryKey, value Value) (ReferenceKey, error)
+	refKeys   collections.KeySet[collections.Pair[ReferenceKey, PrimaryKey]]
+}
+
+func newMultiIndex[ReferenceKey, PrimaryKey, Value any](
+	schema *collections.SchemaBuilder,
+	prefix byte,
+	name string,
+	refCodec codec.KeyCodec[ReferenceKey],
+	pkCodec codec.KeyCodec[PrimaryKey],
+	getRefKeyFunc func(pk PrimaryKey, value Value) (ReferenceKey, error),
+) *multiIndex[ReferenceKey, PrimaryKey, Value] {
+	return &multiIndex[ReferenceKey, PrimaryKey, Value]{
+		getRefKey: getRefKeyFunc,
+		refKeys:   collections.NewKeySet(schema, collections.NewPrefix(int(prefix)), name, collections.PairKeyCodec(refCodec, pkCodec)),
+	}
+}
+
+func (m *multiIndex[ReferenceKey, PrimaryKey, Value]) Reference(ctx context.Context, pk PrimaryKey, newValue Value, lazyOldValue func() (Value, error)) error {
+	oldValue, err := lazyOldValue()
+	switch {
+	case err == nil:
+		if err := m.Unreference(ctx, pk, func() (Value, error) { return oldValue, nil }); err != nil {
+			return err
+		}
+	case errors.Is(err, collections.ErrNotFound):
+	default:
+		return err
+	}
+
+	refKey, err := m.getRefKey(pk, newValue)
+	if err != nil {
+		return err
+	}
+	return m.refKeys.Set(ctx, collections.Join(refKey, pk))
+}
+
+func (m *multiIndex[ReferenceKey, PrimaryKey, Value]) Unreference(ctx context.Context, pk PrimaryKey, getValue func() (Value, error)) error {
+	value, err := getValue()
+	if err != nil {
+		return err
+	}
+	refKey, err := m.getRefKey(pk, value)
+	if err != nil {
+		return err
+	}
+	return m.refKeys.Remove(ctx, collections.Join(refKey, pk))
+}
+
+// MatchExact returns all the primary keys referenced by the given reference key.
+func (m *multiIndex[ReferenceKey, PrimaryKey, Value]) MatchExact(ctx context.Context, refKey ReferenceKey) ([]PrimaryKey, error) {
+	return m.match(ctx, collections.NewPrefixedPairRange[ReferenceKey, PrimaryKey](refKey))
+}
+
+func (m *multiIndex[ReferenceKey, PrimaryKey, Value]) match(ctx context.Context, ranger collections.Ranger[collections.Pair[ReferenceKey, PrimaryKey]]) ([]PrimaryKey, error) {
+	iter, err := m.refKeys.Iterate(ctx, ranger)
+	if errors.Is(err, collections.ErrInvalidIterator) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	pks := make([]PrimaryKey, len(keys))
+	for i, key := range keys {
+		pks[i] = key.K2()
+	}
+	return pks, nil
+}
+
+// Has returns whether the given reference key references any primary key.
+func (m *multiIndex[ReferenceKey, PrimaryKey, Value]) Has(ctx context.Context, refKey ReferenceKey) (bool, error) {
+	iter, err := m.refKeys.Iterate(ctx, collections.NewPrefixedPairRange[ReferenceKey, PrimaryKey](refKey))
+	if errors.Is(err, collections.ErrInvalidIterator) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+	return iter.Valid(), nil
+}
+
+func (m *multiIndex[ReferenceKey, PrimaryKey, Value]) IterateRaw(ctx context.Context, start, end []byte, order collections.Order) (collections.Iterator[collections.Pair[ReferenceKey, PrimaryKey], collections.NoValue], error) {
+	return m.refKeys.IterateRaw(ctx, start, end, order)
+}
+
+func (m *multiIndex[ReferenceKey, PrimaryKey, Value]) KeyCodec() codec.KeyCodec[collections.Pair[ReferenceKey, PrimaryKey]] {
+	return m.refKeys.KeyCodec()
+}
+
+// GroupsIndexes defines the indexes of the groups collection.
+type GroupsIndexes struct {
+	// Admin indexes groups by their admin.
+	Admin *multiIndex[sdk.AccAddress, uint64, group.GroupInfo]
+}
+
+func (i GroupsIndexes) IndexesList() []collections.Index[uint64, group.GroupInfo] {
+	return []collections.Index[uint64, group.GroupInfo]{i.Admin}
+}
+
+// GroupMembersIndexes defines the indexes of the group members collection.
+// Members of a given group are iterated using the group id prefix of their
+// primary key.
+type GroupMembersIndexes struct {
+	// Member indexes group members by their address.
+	Member *indexes.ReversePair[uint64, sdk.AccAddress, group.GroupMember]
+}
+
+func (i GroupMembersIndexes) IndexesList() []collections.Index[collections.Pair[uint64, sdk.AccAddress], group.GroupMember] {
+	return []collections.Index[collections.Pair[uint64, sdk.AccAddress], group.GroupMember]{i.Member}
+}
+
+// GroupPoliciesIndexes defines the indexes of the group policies collection.
+type GroupPoliciesIndexes struct {
+	// Group indexes group policies by their group id.
+	Group *multiIndex[uint64, sdk.AccAddress, group.GroupPolicyInfo]
+	// Admin indexes group policies by their admin.
+	Admin *multiIndex[sdk.AccAddress, sdk.AccAddress, group.GroupPolicyInfo]
+}
+
+func (i GroupPoliciesIndexes) IndexesList() []collections.Index[sdk.AccAddress, group.GroupPolicyInfo] {
+	return []collections.Index[sdk.AccAddress, group.GroupPolicyInfo]{i.Group, i.Admin}
+}
+
+// ProposalsIndexes defines the indexes of the proposals collection.
+type ProposalsIndexes struct {
+	// GroupPolicy indexes proposals by their group policy address.
+	GroupPolicy *multiIndex[sdk.AccAddress, uint64, group.Proposal]
+	// VotingPeriodEnd indexes proposals by the end of their voting period.
+	VotingPeriodEnd *multiIndex[time.Time, uint64, group.Proposal]
+}
+
+func (i ProposalsIndexes) IndexesList() []collections.Index[uint64, group.Proposal] {
+	return []collections.Index[uint64, group.Proposal]{i.GroupPolicy, i.VotingPeriodEnd}
+}
+
+// VotesIndexes defines the indexes of the votes collection. Votes on a given
+// proposal are iterated using the proposal id prefix of their primary key.
+type VotesIndexes struct {
+	// Voter indexes votes by their voter.
+	Voter *indexes.ReversePair[uint64, sdk.AccAddress, group.Vote]
+}
+
+func (i VotesIndexes) IndexesList() []collections.Index[collections.Pair[uint64, sdk.AccAddress], group.Vote] {
+	return []collections.Index[collections.Pair[uint64, sdk.AccAddress], group.Vote]{i.Voter}
+}
+
+// QueuedExecutionsIndexes defines the indexes of the queued executions
+// collection.
+type QueuedExecutionsIndexes struct {
+	// ExecuteAfter indexes queued executions by their execution time.
+	ExecuteAfter *multiIndex[time.Time, uint64, group.QueuedExecution]
+	// GroupPolicy indexes queued executions by their group policy address.
+	GroupPolicy *multiIndex[sdk.AccAddress, uint64, group.QueuedExecution]
+}
+
+func (i QueuedExecutionsIndexes) IndexesList() []collections.Index[uint64, group.QueuedExecution] {
+	return []collections.Index[uint64, group.QueuedExecution]{i.ExecuteAfter, i.GroupPolicy}
+}
@@ -7,6 +7,8 @@ import (
 
 	"golang.org/x/exp/maps"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,11 +28,28 @@ func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
 // GroupTotalWeightInvariant checks that group's TotalWeight must be equal to the sum of its members.
 func GroupTotalWeightInvariant(keeper Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		msg, broken := GroupTotalWeightInvariantHelper(ctx, keeper.key, keeper.groupTable, keeper.groupMemberByGroupIndex)
+		groups := make(map[uint64]group.GroupInfo)
+		err := keeper.GroupInfos.Walk(ctx, nil, func(id uint64, groupInfo group.GroupInfo) (bool, error) {
+			groups[id] = groupInfo
+			return false, nil
+		})
+		if err != nil && !errorsmod.IsOf(err, collections.ErrInvalidIterator) {
+			msg := fmt.Sprintf("Walk failure on groups\n%v\n", err)
+			return sdk.FormatInvariant(group.ModuleName, weightInvariant, msg), false
+		}
+
+		msg, broken := groupTotalWeightInvariant(groups, func(groupID uint64) ([]group.GroupMember, error) {
+			return keeper.groupMembers(ctx, groupID)
+		})
 		return sdk.FormatInvariant(group.ModuleName, weightInvariant, msg), broken
 	}
 }
 
+// GroupTotalWeightInvariantHelper checks the group total weight invariant
+// against the given ORM group table and group member index.
+//
+// Deprecated: the group keeper stores its state using collections, use
+// GroupTotalWeightInvariant instead.
 func GroupTotalWeightInvariantHelper(ctx sdk.Context, key storetypes.StoreKey, groupTable orm.AutoUInt64Table, groupMemberByGroupIndex orm.Index) (string, bool) {
 	var msg string
 	var broken bool
@@ -57,6 +76,35 @@ func GroupTotalWeightInvariantHelper(ctx sdk.Context, key storetypes.StoreKey, g
 		groups[groupInfo.Id] = groupInfo
 	}
 
+	return groupTotalWeightInvariant(groups, func(groupID uint64) ([]group.GroupMember, error) {
+		memIt, err := groupMemberByGroupIndex.Get(ctx.KVStore(key), groupID)
+		if err != nil {
+			return nil, err
+		}
+		defer memIt.Close()
+
+		var members []group.GroupMember
+		for {
+			var groupMember group.GroupMember
+			_, err = memIt.LoadNext(&groupMember)
+			if errors.ErrORMIteratorDone.Is(err) {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			members = append(members, groupMember)
+		}
+		return members, nil
+	})
+}
+
+// groupTotalWeightInvariant checks that the TotalWeight of each of the given
+// groups is equal to the sum of the weights of its members.
+func groupTotalWeightInvariant(groups map[uint64]group.GroupInfo, groupMembers func(groupID uint64) ([]group.GroupMember, error)) (string, bool) {
+	var msg string
+	var broken bool
+
 	groupByIDs := maps.Keys(groups)
 	sort.Slice(groupByIDs, func(i, j int) bool {
 		return groupByIDs[i] < groupByIDs[j]
@@ -69,24 +117,13 @@ func GroupTotalWeightInvariantHelper(ctx sdk.Context, key storetypes.StoreKey, g
 			return msg, broken
 		}
 
-		memIt, err := groupMemberByGroupIndex.Get(ctx.KVStore(key), groupInfo.Id)
+		members, err := groupMembers(groupInfo.Id)
 		if err != nil {
-			msg += fmt.Sprintf("error while returning group member iterator for group with ID %d\n%v\n", groupInfo.Id, err)
+			msg += fmt.Sprintf("error while returning group members for group with ID %d\n%v\n", groupInfo.Id, err)
 			return msg, broken
 		}
-		defer memIt.Close()
-
-		for {
-			var groupMember group.GroupMember
-			_, err = memIt.LoadNext(&groupMember)
-			if errors.ErrORMIteratorDone.Is(err) {
-				break
-			}
-			if err != nil {
-				msg += fmt.Sprintf("LoadNext failure on member table iterator\n%v\n", err)
-				return msg, broken
-			}
 
+		for _, groupMember := range members {
 			curMemWeight, err := groupmath.NewPositiveDecFromString(groupMember.GetMember().GetWeight())
 			if err != nil {
 				msg += fmt.Sprintf("error while parsing non-nengative decimal for group member %s\n%v\n", groupMember.Member.Address, err)
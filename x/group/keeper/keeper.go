@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/log"
 
 	storetypes "cosmossdk.io/store/types"
@@ -12,10 +15,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
-	"github.com/cosmos/cosmos-sdk/x/group/errors"
-	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
 )
 
 const (
@@ -25,7 +27,9 @@ const (
 	GroupByAdminIndexPrefix byte = 0x2
 
 	// Group Member Table
-	GroupMemberTablePrefix         byte = 0x10
+	GroupMemberTablePrefix byte = 0x10
+	// Deprecated: group members are iterated by group id using their primary
+	// key, this prefix is only used by the legacy ORM state.
 	GroupMemberByGroupIndexPrefix  byte = 0x11
 	GroupMemberByMemberIndexPrefix byte = 0x12
 
@@ -40,172 +44,170 @@ const (
 	ProposalTableSeqPrefix           byte = 0x31
 	ProposalByGroupPolicyIndexPrefix byte = 0x32
 	ProposalsByVotingPeriodEndPrefix byte = 0x33
+	ProposalPruneQueuePrefix         byte = 0x34
 
 	// Vote Table
-	VoteTablePrefix           byte = 0x40
+	VoteTablePrefix byte = 0x40
+	// Deprecated: votes are iterated by proposal id using their primary key,
+	// this prefix is only used by the legacy ORM state.
 	VoteByProposalIndexPrefix byte = 0x41
 	VoteByVoterIndexPrefix    byte = 0x42
+
+	// Proposal Member Weight Table
+	ProposalMemberWeightTablePrefix byte = 0x50
+	// Deprecated: proposal member weights are iterated by proposal id using
+	// their primary key, this prefix is only used by the legacy ORM state.
+	ProposalMemberWeightByProposalIndexPrefix byte = 0x51
+
+	// Queued Execution Table
+	QueuedExecutionTablePrefix               byte = 0x60
+	QueuedExecutionByExecuteAfterIndexPrefix byte = 0x61
+	QueuedExecutionByGroupPolicyIndexPrefix  byte = 0x62
 )
 
 type Keeper struct {
 	key storetypes.StoreKey
 
-	accKeeper group.AccountKeeper
-
-	// Group Table
-	groupTable        orm.AutoUInt64Table
-	groupByAdminIndex orm.Index
-
-	// Group Member Table
-	groupMemberTable         orm.PrimaryKeyTable
-	groupMemberByGroupIndex  orm.Index
-	groupMemberByMemberIndex orm.Index
-
-	// Group Policy Table
-	groupPolicySeq          orm.Sequence
-	groupPolicyTable        orm.PrimaryKeyTable
-	groupPolicyByGroupIndex orm.Index
-	groupPolicyByAdminIndex orm.Index
-
-	// Proposal Table
-	proposalTable              orm.AutoUInt64Table
-	proposalByGroupPolicyIndex orm.Index
-	proposalsByVotingPeriodEnd orm.Index
-
-	// Vote Table
-	voteTable           orm.PrimaryKeyTable
-	voteByProposalIndex orm.Index
-	voteByVoterIndex    orm.Index
+	accKeeper     group.AccountKeeper
+	bankKeeper    group.BankKeeper
+	stakingKeeper group.StakingKeeper
 
 	router baseapp.MessageRouter
 
 	config group.Config
 
 	cdc codec.Codec
+
+	Schema collections.Schema
+	// GroupSeq is the group id sequence, it stores the last used group id.
+	GroupSeq collections.Sequence
+	// GroupInfos key: groupID | value: GroupInfo
+	GroupInfos *collections.IndexedMap[uint64, group.GroupInfo, GroupsIndexes]
+	// Members key: groupID+memberAddr | value: GroupMember
+	Members *collections.IndexedMap[collections.Pair[uint64, sdk.AccAddress], group.GroupMember, GroupMembersIndexes]
+	// GroupPolicySeq is the group policy account derivation sequence, it stores
+	// the last used value.
+	GroupPolicySeq collections.Sequence
+	// GroupPolicies key: groupPolicyAddr | value: GroupPolicyInfo
+	GroupPolicies *collections.IndexedMap[sdk.AccAddress, group.GroupPolicyInfo, GroupPoliciesIndexes]
+	// ProposalSeq is the proposal id sequence, it stores the last used proposal id.
+	ProposalSeq collections.Sequence
+	// Proposals key: proposalID | value: Proposal
+	Proposals *collections.IndexedMap[uint64, group.Proposal, ProposalsIndexes]
+	// ProposalPruneQueue key: pruneDeadline+proposalID, it stores the proposals
+	// to prune once their execution deadline has passed.
+	ProposalPruneQueue collections.KeySet[collections.Pair[time.Time, uint64]]
+	// Votes key: proposalID+voterAddr | value: Vote
+	Votes *collections.IndexedMap[collections.Pair[uint64, sdk.AccAddress], group.Vote, VotesIndexes]
+	// ProposalMemberWeights key: proposalID+memberAddr | value: ProposalMemberWeight
+	ProposalMemberWeights collections.Map[collections.Pair[uint64, sdk.AccAddress], group.ProposalMemberWeight]
+	// QueuedExecutions key: proposalID | value: QueuedExecution
+	QueuedExecutions *collections.IndexedMap[uint64, group.QueuedExecution, QueuedExecutionsIndexes]
 }
 
 // NewKeeper creates a new group keeper.
-func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router baseapp.MessageRouter, accKeeper group.AccountKeeper, config group.Config) Keeper {
-	k := Keeper{
-		key:       storeKey,
-		router:    router,
-		accKeeper: accKeeper,
-		cdc:       cdc,
+func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router baseapp.MessageRouter, accKeeper group.AccountKeeper, bankKeeper group.BankKeeper, stakingKeeper group.StakingKeeper, config group.Config) Keeper {
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(storeKey.(*storetypes.KVStoreKey)))
+	addressOf := func(addr string) (sdk.AccAddress, error) {
+		return accKeeper.AddressCodec().StringToBytes(addr)
 	}
 
-	groupTable, err := orm.NewAutoUInt64Table([2]byte{GroupTablePrefix}, GroupTableSeqPrefix, &group.GroupInfo{}, cdc)
-	if err != nil {
-		panic(err.Error())
-	}
-	k.groupByAdminIndex, err = orm.NewIndex(groupTable, GroupByAdminIndexPrefix, func(val interface{}) ([]interface{}, error) {
-		addr, err := accKeeper.AddressCodec().StringToBytes(val.(*group.GroupInfo).Admin)
-		if err != nil {
-			return nil, err
-		}
-		return []interface{}{addr}, nil
-	}, []byte{})
-	if err != nil {
-		panic(err.Error())
-	}
-	k.groupTable = *groupTable
-
-	// Group Member Table
-	groupMemberTable, err := orm.NewPrimaryKeyTable([2]byte{GroupMemberTablePrefix}, &group.GroupMember{}, cdc)
-	if err != nil {
-		panic(err.Error())
-	}
-	k.groupMemberByGroupIndex, err = orm.NewIndex(groupMemberTable, GroupMemberByGroupIndexPrefix, func(val interface{}) ([]interface{}, error) {
-		group := val.(*group.GroupMember).GroupId
-		return []interface{}{group}, nil
-	}, group.GroupMember{}.GroupId)
-	if err != nil {
-		panic(err.Error())
-	}
-	k.groupMemberByMemberIndex, err = orm.NewIndex(groupMemberTable, GroupMemberByMemberIndexPrefix, func(val interface{}) ([]interface{}, error) {
-		memberAddr := val.(*group.GroupMember).Member.Address
-		addr, err := accKeeper.AddressCodec().StringToBytes(memberAddr)
-		if err != nil {
-			return nil, err
-		}
-		return []interface{}{addr}, nil
-	}, []byte{})
-	if err != nil {
-		panic(err.Error())
-	}
-	k.groupMemberTable = *groupMemberTable
-
-	// Group Policy Table
-	k.groupPolicySeq = orm.NewSequence(GroupPolicyTableSeqPrefix)
-	groupPolicyTable, err := orm.NewPrimaryKeyTable([2]byte{GroupPolicyTablePrefix}, &group.GroupPolicyInfo{}, cdc)
-	if err != nil {
-		panic(err.Error())
-	}
-	k.groupPolicyByGroupIndex, err = orm.NewIndex(groupPolicyTable, GroupPolicyByGroupIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		return []interface{}{value.(*group.GroupPolicyInfo).GroupId}, nil
-	}, group.GroupPolicyInfo{}.GroupId)
-	if err != nil {
-		panic(err.Error())
-	}
-	k.groupPolicyByAdminIndex, err = orm.NewIndex(groupPolicyTable, GroupPolicyByAdminIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		admin := value.(*group.GroupPolicyInfo).Admin
-		addr, err := accKeeper.AddressCodec().StringToBytes(admin)
-		if err != nil {
-			return nil, err
-		}
-		return []interface{}{addr}, nil
-	}, []byte{})
-	if err != nil {
-		panic(err.Error())
-	}
-	k.groupPolicyTable = *groupPolicyTable
-
-	// Proposal Table
-	proposalTable, err := orm.NewAutoUInt64Table([2]byte{ProposalTablePrefix}, ProposalTableSeqPrefix, &group.Proposal{}, cdc)
-	if err != nil {
-		panic(err.Error())
-	}
-	k.proposalByGroupPolicyIndex, err = orm.NewIndex(proposalTable, ProposalByGroupPolicyIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		account := value.(*group.Proposal).GroupPolicyAddress
-		addr, err := accKeeper.AddressCodec().StringToBytes(account)
-		if err != nil {
-			return nil, err
-		}
-		return []interface{}{addr}, nil
-	}, []byte{})
-	if err != nil {
-		panic(err.Error())
-	}
-	k.proposalsByVotingPeriodEnd, err = orm.NewIndex(proposalTable, ProposalsByVotingPeriodEndPrefix, func(value interface{}) ([]interface{}, error) {
-		votingPeriodEnd := value.(*group.Proposal).VotingPeriodEnd
-		return []interface{}{sdk.FormatTimeBytes(votingPeriodEnd)}, nil
-	}, []byte{})
-	if err != nil {
-		panic(err.Error())
-	}
-	k.proposalTable = *proposalTable
-
-	// Vote Table
-	voteTable, err := orm.NewPrimaryKeyTable([2]byte{VoteTablePrefix}, &group.Vote{}, cdc)
-	if err != nil {
-		panic(err.Error())
-	}
-	k.voteByProposalIndex, err = orm.NewIndex(voteTable, VoteByProposalIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		return []interface{}{value.(*group.Vote).ProposalId}, nil
-	}, group.Vote{}.ProposalId)
-	if err != nil {
-		panic(err.Error())
-	}
-	k.voteByVoterIndex, err = orm.NewIndex(voteTable, VoteByVoterIndexPrefix, func(value interface{}) ([]interface{}, error) {
-		addr, err := accKeeper.AddressCodec().StringToBytes(value.(*group.Vote).Voter)
-		if err != nil {
-			return nil, err
-		}
-		return []interface{}{addr}, nil
-	}, []byte{})
+	k := Keeper{
+		key:           storeKey,
+		router:        router,
+		accKeeper:     accKeeper,
+		bankKeeper:    bankKeeper,
+		stakingKeeper: stakingKeeper,
+		cdc:           cdc,
+
+		GroupSeq: collections.NewSequence(sb, collections.NewPrefix(int(GroupTableSeqPrefix)), "group_seq"),
+		GroupInfos: collections.NewIndexedMap(
+			sb, collections.NewPrefix(int(GroupTablePrefix)), "groups", collections.Uint64Key, codec.CollValue[group.GroupInfo](cdc),
+			GroupsIndexes{
+				Admin: newMultiIndex(
+					sb, GroupByAdminIndexPrefix, "groups_by_admin", sdk.AccAddressKey, collections.Uint64Key,
+					func(_ uint64, g group.GroupInfo) (sdk.AccAddress, error) { return addressOf(g.Admin) },
+				),
+			},
+		),
+		Members: collections.NewIndexedMap(
+			sb, collections.NewPrefix(int(GroupMemberTablePrefix)), "group_members",
+			collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey), codec.CollValue[group.GroupMember](cdc),
+			GroupMembersIndexes{
+				Member: indexes.NewReversePair[group.GroupMember](
+					sb, collections.NewPrefix(int(GroupMemberByMemberIndexPrefix)), "group_members_by_member",
+					collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey),
+				),
+			},
+		),
+		GroupPolicySeq: collections.NewSequence(sb, collections.NewPrefix(int(GroupPolicyTableSeqPrefix)), "group_policy_seq"),
+		GroupPolicies: collections.NewIndexedMap(
+			sb, collections.NewPrefix(int(GroupPolicyTablePrefix)), "group_policies", sdk.AccAddressKey, codec.CollValue[group.GroupPolicyInfo](cdc),
+			GroupPoliciesIndexes{
+				Group: newMultiIndex(
+					sb, GroupPolicyByGroupIndexPrefix, "group_policies_by_group", collections.Uint64Key, sdk.AccAddressKey,
+					func(_ sdk.AccAddress, p group.GroupPolicyInfo) (uint64, error) { return p.GroupId, nil },
+				),
+				Admin: newMultiIndex(
+					sb, GroupPolicyByAdminIndexPrefix, "group_policies_by_admin", sdk.AccAddressKey, sdk.AccAddressKey,
+					func(_ sdk.AccAddress, p group.GroupPolicyInfo) (sdk.AccAddress, error) { return addressOf(p.Admin) },
+				),
+			},
+		),
+		ProposalSeq: collections.NewSequence(sb, collections.NewPrefix(int(ProposalTableSeqPrefix)), "proposal_seq"),
+		Proposals: collections.NewIndexedMap(
+			sb, collections.NewPrefix(int(ProposalTablePrefix)), "proposals", collections.Uint64Key, codec.CollValue[group.Proposal](cdc),
+			ProposalsIndexes{
+				GroupPolicy: newMultiIndex(
+					sb, ProposalByGroupPolicyIndexPrefix, "proposals_by_group_policy", sdk.AccAddressKey, collections.Uint64Key,
+					func(_ uint64, p group.Proposal) (sdk.AccAddress, error) { return addressOf(p.GroupPolicyAddress) },
+				),
+				VotingPeriodEnd: newMultiIndex(
+					sb, ProposalsByVotingPeriodEndPrefix, "proposals_by_voting_period_end", sdk.TimeKey, collections.Uint64Key,
+					func(_ uint64, p group.Proposal) (time.Time, error) { return p.VotingPeriodEnd, nil },
+				),
+			},
+		),
+		ProposalPruneQueue: collections.NewKeySet(
+			sb, collections.NewPrefix(int(ProposalPruneQueuePrefix)), "proposal_prune_queue",
+			collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key),
+		),
+		Votes: collections.NewIndexedMap(
+			sb, collections.NewPrefix(int(VoteTablePrefix)), "votes",
+			collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey), codec.CollValue[group.Vote](cdc),
+			VotesIndexes{
+				Voter: indexes.NewReversePair[group.Vote](
+					sb, collections.NewPrefix(int(VoteByVoterIndexPrefix)), "votes_by_voter",
+					collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey),
+				),
+			},
+		),
+		ProposalMemberWeights: collections.NewMap(
+			sb, collections.NewPrefix(int(ProposalMemberWeightTablePrefix)), "proposal_member_weights",
+			collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey), codec.CollValue[group.ProposalMemberWeight](cdc),
+		),
+		QueuedExecutions: collections.NewIndexedMap(
+			sb, collections.NewPrefix(int(QueuedExecutionTablePrefix)), "queued_executions", collections.Uint64Key, codec.CollValue[group.QueuedExecution](cdc),
+			QueuedExecutionsIndexes{
+				ExecuteAfter: newMultiIndex(
+					sb, QueuedExecutionByExecuteAfterIndexPrefix, "queued_executions_by_execute_after", sdk.TimeKey, collections.Uint64Key,
+					func(_ uint64, q group.QueuedExecution) (time.Time, error) { return q.ExecuteAfter, nil },
+				),
+				GroupPolicy: newMultiIndex(
+					sb, QueuedExecutionByGroupPolicyIndexPrefix, "queued_executions_by_group_policy", sdk.AccAddressKey, collections.Uint64Key,
+					func(_ uint64, q group.QueuedExecution) (sdk.AccAddress, error) {
+						return addressOf(q.GroupPolicyAddress)
+					},
+				),
+			},
+		),
+	}
+
+	schema, err := sb.Build()
 	if err != nil {
-		panic(err.Error())
+		panic(err)
 	}
-	k.voteTable = *voteTable
+	k.Schema = schema
 
 	if config.MaxMetadataLen == 0 {
 		config.MaxMetadataLen = group.DefaultConfig().MaxMetadataLen
@@ -225,38 +227,51 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 // GetGroupSequence returns the current value of the group table sequence
 func (k Keeper) GetGroupSequence(ctx sdk.Context) uint64 {
-	return k.groupTable.Sequence().CurVal(ctx.KVStore(k.key))
+	seq, err := k.GroupSeq.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return seq
 }
 
 // GetGroupPolicySeq returns the current value of the group policy table sequence
 func (k Keeper) GetGroupPolicySeq(ctx sdk.Context) uint64 {
-	return k.groupPolicySeq.CurVal(ctx.KVStore(k.key))
+	seq, err := k.GroupPolicySeq.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return seq
+}
+
+// nextSequenceValue increments the given sequence and returns its new value.
+// The group module sequences store the last value they handed out, zero
+// meaning that none was handed out yet.
+func nextSequenceValue(ctx sdk.Context, seq collections.Sequence) (uint64, error) {
+	last, err := seq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return last + 1, nil
+}
+
+// validateAndSet checks a state object with its ValidateBasic method before
+// persisting it under the given key.
+func validateAndSet[K any, V interface{ ValidateBasic() error }](ctx sdk.Context, set func(context.Context, K, V) error, key K, value V) error {
+	if err := value.ValidateBasic(); err != nil {
+		return err
+	}
+	return set(ctx, key, value)
 }
 
 // proposalsByVPEnd returns all proposals whose voting_period_end is after the `endTime` time argument.
 func (k Keeper) proposalsByVPEnd(ctx sdk.Context, endTime time.Time) (proposals []group.Proposal, err error) {
-	timeBytes := sdk.FormatTimeBytes(endTime)
-	it, err := k.proposalsByVotingPeriodEnd.PrefixScan(ctx.KVStore(k.key), nil, timeBytes)
+	ids, err := k.Proposals.Indexes.VotingPeriodEnd.match(ctx, timeRangeBefore[uint64](endTime))
 	if err != nil {
 		return proposals, err
 	}
-	defer it.Close()
-
-	for {
-		// Important: this following line cannot be outside of the for loop.
-		// It seems that when one unmarshals into the same `group.Proposal`
-		// reference, then gogoproto somehow "adds" the new bytes to the old
-		// object for some fields. When running simulations, for proposals with
-		// each 1-2 proposers, after a couple of loop iterations we got to a
-		// proposal with 60k+ proposers.
-		// So we're declaring a local variable that gets GCed.
-		//
-		// Also see `x/group/types/proposal_test.go`, TestGogoUnmarshalProposal().
-		var proposal group.Proposal
-		_, err := it.LoadNext(&proposal)
-		if errors.ErrORMIteratorDone.Is(err) {
-			break
-		}
+
+	for _, id := range ids {
+		proposal, err := k.Proposals.Get(ctx, id)
 		if err != nil {
 			return proposals, err
 		}
@@ -266,15 +281,24 @@ func (k Keeper) proposalsByVPEnd(ctx sdk.Context, endTime time.Time) (proposals
 	return proposals, nil
 }
 
+// timeRangeBefore returns a range over the keys of a time index whose time is
+// strictly before the given end time.
+func timeRangeBefore[K any](endTime time.Time) collections.Ranger[collections.Pair[time.Time, K]] {
+	var zero K
+	return new(collections.Range[collections.Pair[time.Time, K]]).EndExclusive(collections.Join(endTime, zero))
+}
+
 // pruneProposal deletes a proposal from state.
 func (k Keeper) pruneProposal(ctx sdk.Context, proposalID uint64) error {
-	store := ctx.KVStore(k.key)
-
-	err := k.proposalTable.Delete(store, proposalID)
+	err := k.Proposals.Remove(ctx, proposalID)
 	if err != nil {
 		return err
 	}
 
+	if err := k.dequeueExecution(ctx, proposalID); err != nil {
+		return err
+	}
+
 	k.Logger(ctx).Debug(fmt.Sprintf("Pruned proposal %d", proposalID))
 	return nil
 }
@@ -293,7 +317,7 @@ func (k Keeper) abortProposals(ctx sdk.Context, groupPolicyAddr sdk.AccAddress)
 		if proposalInfo.Status == group.PROPOSAL_STATUS_SUBMITTED {
 			proposalInfo.Status = group.PROPOSAL_STATUS_ABORTED
 
-			if err := k.proposalTable.Update(ctx.KVStore(k.key), proposalInfo.Id, &proposalInfo); err != nil {
+			if err := validateAndSet(ctx, k.Proposals.Set, proposalInfo.Id, proposalInfo); err != nil {
 				return err
 			}
 		}
@@ -303,19 +327,14 @@ func (k Keeper) abortProposals(ctx sdk.Context, groupPolicyAddr sdk.AccAddress)
 
 // proposalsByGroupPolicy returns all proposals for a given group policy.
 func (k Keeper) proposalsByGroupPolicy(ctx sdk.Context, groupPolicyAddr sdk.AccAddress) ([]group.Proposal, error) {
-	proposalIt, err := k.proposalByGroupPolicyIndex.Get(ctx.KVStore(k.key), groupPolicyAddr.Bytes())
+	ids, err := k.Proposals.Indexes.GroupPolicy.MatchExact(ctx, groupPolicyAddr)
 	if err != nil {
 		return nil, err
 	}
-	defer proposalIt.Close()
 
 	var proposals []group.Proposal
-	for {
-		var proposalInfo group.Proposal
-		_, err = proposalIt.LoadNext(&proposalInfo)
-		if errors.ErrORMIteratorDone.Is(err) {
-			break
-		}
+	for _, id := range ids {
+		proposalInfo, err := k.Proposals.Get(ctx, id)
 		if err != nil {
 			return proposals, err
 		}
@@ -332,9 +351,12 @@ func (k Keeper) pruneVotes(ctx sdk.Context, proposalID uint64) error {
 		return err
 	}
 
-	//nolint:gosec // "implicit memory aliasing in the for loop (because of the pointer on &v)"
 	for _, v := range votes {
-		err = k.voteTable.Delete(ctx.KVStore(k.key), &v)
+		voter, err := k.accKeeper.AddressCodec().StringToBytes(v.Voter)
+		if err != nil {
+			return err
+		}
+		err = k.Votes.Remove(ctx, collections.Join(proposalID, sdk.AccAddress(voter)))
 		if err != nil {
 			return err
 		}
@@ -345,40 +367,50 @@ func (k Keeper) pruneVotes(ctx sdk.Context, proposalID uint64) error {
 
 // votesByProposal returns all votes for a given proposal.
 func (k Keeper) votesByProposal(ctx sdk.Context, proposalID uint64) ([]group.Vote, error) {
-	it, err := k.voteByProposalIndex.Get(ctx.KVStore(k.key), proposalID)
+	it, err := k.Votes.Iterate(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID))
+	if errorsmod.IsOf(err, collections.ErrInvalidIterator) {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, err
 	}
-	defer it.Close()
-
-	var votes []group.Vote
-	for {
-		var vote group.Vote
-		_, err = it.LoadNext(&vote)
-		if errors.ErrORMIteratorDone.Is(err) {
-			break
-		}
-		if err != nil {
-			return votes, err
-		}
-		votes = append(votes, vote)
-	}
-	return votes, nil
+	return it.Values()
 }
 
 // PruneProposals prunes all proposals that are expired, i.e. whose
-// `voting_period + max_execution_period` is greater than the current block
-// time.
+// `voting_period + execution_deadline` is greater than the current block
+// time. The execution deadline is the one of the decision policy of the
+// proposal's group policy, or `max_execution_period` if it has none.
 func (k Keeper) PruneProposals(ctx sdk.Context) error {
-	proposals, err := k.proposalsByVPEnd(ctx, ctx.BlockTime().Add(-k.config.MaxExecutionPeriod))
-	if err != nil {
+	it, err := k.ProposalPruneQueue.Iterate(ctx, timeRangeBefore[uint64](ctx.BlockTime()))
+	if errorsmod.IsOf(err, collections.ErrInvalidIterator) {
 		return nil
 	}
-	for _, proposal := range proposals {
-		err := k.pruneProposal(ctx, proposal.Id)
+	if err != nil {
+		return err
+	}
+	keys, err := it.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.ProposalPruneQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		// the proposal may already have been pruned after its execution
+		proposal, err := k.Proposals.Get(ctx, key.K2())
+		if errorsmod.IsOf(err, collections.ErrNotFound) {
+			continue
+		}
 		if err != nil {
 			return err
 		}
+
+		if err := k.pruneProposal(ctx, proposal.Id); err != nil {
+			return err
+		}
 		// Emit event for proposal finalized with its result
 		if err := ctx.EventManager().EmitTypedEvent(
 			&group.EventProposalPruned{
@@ -393,6 +425,18 @@ func (k Keeper) PruneProposals(ctx sdk.Context) error {
 	return nil
 }
 
+// schedulePrune queues a proposal for pruning once the execution
+// deadline of its decision policy, or `max_execution_period` if it has none,
+// has passed after the end of its voting period.
+func (k Keeper) schedulePrune(ctx sdk.Context, p group.Proposal, policy group.DecisionPolicy) error {
+	executionPeriod := k.config.MaxExecutionPeriod
+	if windows := decisionPolicyWindows(policy); windows != nil {
+		executionPeriod = windows.EffectiveExecutionDeadline(k.config)
+	}
+
+	return k.ProposalPruneQueue.Set(ctx, collections.Join(p.VotingPeriodEnd.Add(executionPeriod), p.Id))
+}
+
 // TallyProposalsAtVPEnd iterates over all proposals whose voting period
 // has ended, tallies their votes, prunes them, and updates the proposal's
 // `FinalTallyResult` field.
@@ -414,13 +458,16 @@ func (k Keeper) TallyProposalsAtVPEnd(ctx sdk.Context) error {
 		}
 
 		proposalID := proposal.Id
-		if proposal.Status == group.PROPOSAL_STATUS_ABORTED || proposal.Status == group.PROPOSAL_STATUS_WITHDRAWN {
+		if proposal.Status == group.PROPOSAL_STATUS_ABORTED || proposal.Status == group.PROPOSAL_STATUS_WITHDRAWN || proposal.Status == group.PROPOSAL_STATUS_CANCELLED {
 			if err := k.pruneProposal(ctx, proposalID); err != nil {
 				return err
 			}
 			if err := k.pruneVotes(ctx, proposalID); err != nil {
 				return err
 			}
+			if err := k.pruneMemberWeights(ctx, proposalID); err != nil {
+				return err
+			}
 			// Emit event for proposal finalized with its result
 			if err := ctx.EventManager().EmitTypedEvent(
 				&group.EventProposalPruned{
@@ -434,7 +481,7 @@ func (k Keeper) TallyProposalsAtVPEnd(ctx sdk.Context) error {
 				return errorsmod.Wrap(err, "doTallyAndUpdate")
 			}
 
-			if err := k.proposalTable.Update(ctx.KVStore(k.key), proposal.Id, &proposal); err != nil {
+			if err := validateAndSet(ctx, k.Proposals.Set, proposal.Id, proposal); err != nil {
 				return errorsmod.Wrap(err, "proposal update")
 			}
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
@@ -43,6 +44,7 @@ type TestSuite struct {
 	groupKeeper     keeper.Keeper
 	blockTime       time.Time
 	bankKeeper      *grouptestutil.MockBankKeeper
+	stakingKeeper   *grouptestutil.MockStakingKeeper
 	accountKeeper   *grouptestutil.MockAccountKeeper
 }
 
@@ -63,6 +65,7 @@ func (s *TestSuite) SetupTest() {
 	s.accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
 
 	s.bankKeeper = grouptestutil.NewMockBankKeeper(ctrl)
+	s.stakingKeeper = grouptestutil.NewMockStakingKeeper(ctrl)
 
 	bApp := baseapp.NewBaseApp(
 		"group",
@@ -74,7 +77,7 @@ func (s *TestSuite) SetupTest() {
 	banktypes.RegisterMsgServer(bApp.MsgServiceRouter(), s.bankKeeper)
 
 	config := group.DefaultConfig()
-	s.groupKeeper = keeper.NewKeeper(key, encCfg.Codec, bApp.MsgServiceRouter(), s.accountKeeper, config)
+	s.groupKeeper = keeper.NewKeeper(key, encCfg.Codec, bApp.MsgServiceRouter(), s.accountKeeper, s.bankKeeper, s.stakingKeeper, config)
 	s.ctx = testCtx.Ctx.WithBlockTime(s.blockTime)
 	s.sdkCtx = sdk.UnwrapSDKContext(s.ctx)
 
@@ -455,6 +458,155 @@ func (s *TestSuite) TestTallyProposalsAtVPEnd() {
 	s.NotPanics(func() { module.EndBlocker(ctx, s.groupKeeper) })
 }
 
+func (s *TestSuite) TestPruneProposalsExecutionDeadline() {
+	addrs := s.addrs
+	executionDeadline := 2 * time.Hour
+	policy := &group.ThresholdDecisionPolicy{
+		Threshold: "1",
+		Windows: &group.DecisionPolicyWindows{
+			VotingPeriod:      time.Hour,
+			ExecutionDeadline: &executionDeadline,
+		},
+	}
+	policyAddr, _ := s.createGroupAndGroupPolicy(addrs[0], []group.MemberRequest{
+		{Address: addrs[1].String(), Weight: "1"},
+	}, policy)
+
+	proposalReq := &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyAddr,
+		Proposers:          []string{addrs[1].String()},
+	}
+	proposalRes, err := s.groupKeeper.SubmitProposal(s.ctx, proposalReq)
+	s.Require().NoError(err)
+	queryProposal := &group.QueryProposalRequest{ProposalId: proposalRes.ProposalId}
+
+	// the proposal is kept until the execution deadline of its policy
+	ctx := s.sdkCtx.WithBlockTime(s.blockTime.Add(time.Hour + executionDeadline))
+	s.Require().NoError(s.groupKeeper.PruneProposals(ctx))
+	_, err = s.groupKeeper.Proposal(ctx, queryProposal)
+	s.Require().NoError(err)
+
+	// and pruned after it, well before max_execution_period
+	ctx = s.sdkCtx.WithBlockTime(s.blockTime.Add(time.Hour + executionDeadline + 1))
+	s.Require().NoError(s.groupKeeper.PruneProposals(ctx))
+	_, err = s.groupKeeper.Proposal(ctx, queryProposal)
+	s.Require().ErrorContains(err, "not found")
+
+	// the prune queue entry is consumed
+	pruneKey := collections.Join(s.blockTime.Add(time.Hour+executionDeadline), proposalRes.ProposalId)
+	has, err := s.groupKeeper.ProposalPruneQueue.Has(ctx, pruneKey)
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+func (s *TestSuite) TestPruneProposalsAlreadyExecuted() {
+	addrs := s.addrs
+	policy := group.NewThresholdDecisionPolicy("1", time.Hour, 0)
+	policyAddr, _ := s.createGroupAndGroupPolicy(addrs[0], []group.MemberRequest{
+		{Address: addrs[1].String(), Weight: "1"},
+	}, policy)
+
+	// the proposal is executed and pruned right away
+	proposalRes, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyAddr,
+		Proposers:          []string{addrs[1].String()},
+		Exec:               group.Exec_EXEC_TRY,
+	})
+	s.Require().NoError(err)
+	_, err = s.groupKeeper.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: proposalRes.ProposalId})
+	s.Require().ErrorContains(err, "not found")
+
+	pruneKey := collections.Join(s.blockTime.Add(time.Hour+group.DefaultConfig().MaxExecutionPeriod), proposalRes.ProposalId)
+	has, err := s.groupKeeper.ProposalPruneQueue.Has(s.ctx, pruneKey)
+	s.Require().NoError(err)
+	s.Require().True(has)
+
+	// its prune queue entry is dropped at the deadline without any event
+	ctx := s.sdkCtx.WithBlockTime(s.blockTime.Add(time.Hour + group.DefaultConfig().MaxExecutionPeriod + 1)).
+		WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.groupKeeper.PruneProposals(ctx))
+	s.Require().Empty(ctx.EventManager().Events())
+
+	has, err = s.groupKeeper.ProposalPruneQueue.Has(ctx, pruneKey)
+	s.Require().NoError(err)
+	s.Require().False(has)
+}
+
+func (s *TestSuite) TestExecuteQueuedProposals() {
+	addrs := s.addrs
+	timelock := 2 * time.Hour
+	policy := &group.ThresholdDecisionPolicy{
+		Threshold: "1",
+		Windows: &group.DecisionPolicyWindows{
+			VotingPeriod: time.Hour,
+			Timelock:     &timelock,
+			Guardians:    []string{addrs[3].String()},
+		},
+	}
+	policyAddr, _ := s.createGroupAndGroupPolicy(addrs[0], []group.MemberRequest{
+		{Address: addrs[1].String(), Weight: "1"},
+		{Address: addrs[2].String(), Weight: "1"},
+	}, policy)
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: policyAddr,
+		ToAddress:   addrs[2].String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	proposalReq := &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyAddr,
+		Proposers:          []string{addrs[1].String()},
+	}
+	s.Require().NoError(proposalReq.SetMsgs([]sdk.Msg{msgSend}))
+	proposalRes, err := s.groupKeeper.SubmitProposal(s.ctx, proposalReq)
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
+
+	// the proposal is accepted but queued instead of being executed
+	_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{
+		ProposalId: proposalID,
+		Voter:      addrs[1].String(),
+		Option:     group.VOTE_OPTION_YES,
+		Exec:       group.Exec_EXEC_TRY,
+	})
+	s.Require().NoError(err)
+
+	proposal, err := s.groupKeeper.Proposal(s.ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, proposal.Proposal.Status)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, proposal.Proposal.ExecutorResult)
+
+	queued, err := s.groupKeeper.QueuedExecution(s.ctx, &group.QueryQueuedExecutionRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(s.blockTime.Add(timelock), queued.QueuedExecution.ExecuteAfter)
+
+	queuedByPolicy, err := s.groupKeeper.QueuedExecutionsByGroupPolicy(s.ctx, &group.QueryQueuedExecutionsByGroupPolicyRequest{Address: policyAddr})
+	s.Require().NoError(err)
+	s.Require().Len(queuedByPolicy.QueuedExecutions, 1)
+
+	// manual execution is a no-op during the timelock
+	execRes, err := s.groupKeeper.Exec(s.ctx, &group.MsgExec{ProposalId: proposalID, Executor: addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, execRes.Result)
+
+	// nothing is executed before the end of the timelock
+	ctx := s.sdkCtx.WithBlockTime(s.blockTime.Add(timelock))
+	s.Require().NoError(module.EndBlocker(ctx, s.groupKeeper))
+	_, err = s.groupKeeper.QueuedExecution(ctx, &group.QueryQueuedExecutionRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+
+	// the proposal is executed and pruned once the timelock has passed
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, nil)
+	ctx = s.sdkCtx.WithBlockTime(s.blockTime.Add(timelock + 1))
+	s.Require().NoError(module.EndBlocker(ctx, s.groupKeeper))
+
+	_, err = s.groupKeeper.QueuedExecution(ctx, &group.QueryQueuedExecutionRequest{ProposalId: proposalID})
+	s.Require().ErrorContains(err, "not found")
+	_, err = s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().ErrorContains(err, "not found")
+	s.Require().True(eventTypeFound(ctx.EventManager().ABCIEvents(), EventProposalPruned))
+}
+
 // TestTallyProposalsAtVPEnd_GroupMemberLeaving test that the node doesn't
 // panic if a member leaves after the voting period end.
 func (s *TestSuite) TestTallyProposalsAtVPEnd_GroupMemberLeaving() {
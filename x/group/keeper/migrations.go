@@ -2,7 +2,10 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
 	v2 "github.com/cosmos/cosmos-sdk/x/group/migrations/v2"
+	v3 "github.com/cosmos/cosmos-sdk/x/group/migrations/v3"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -17,11 +20,22 @@ func NewMigrator(keeper Keeper) Migrator {
 
 // Migrate1to2 migrates from version 1 to 2.
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	// the group policy state is still stored using the legacy ORM at version 1.
+	groupPolicyTable, err := orm.NewPrimaryKeyTable([2]byte{GroupPolicyTablePrefix}, &group.GroupPolicyInfo{}, m.keeper.cdc)
+	if err != nil {
+		return err
+	}
+
 	return v2.Migrate(
 		ctx,
 		m.keeper.key,
 		m.keeper.accKeeper,
-		m.keeper.groupPolicySeq,
-		m.keeper.groupPolicyTable,
+		orm.NewSequence(GroupPolicyTableSeqPrefix),
+		*groupPolicyTable,
 	)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.Migrate(ctx, m.keeper.key, m.keeper.cdc, m.keeper.importState)
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,7 +17,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/cosmos/cosmos-sdk/x/group/errors"
 	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
-	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
 
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -61,24 +61,27 @@ func (k Keeper) CreateGroup(goCtx context.Context, msg *group.MsgCreateGroup) (*
 		}
 	}
 
-	// Create a new group in the groupTable.
+	// Create a new group in the Groups collection.
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	groupInfo := &group.GroupInfo{
-		Id:          k.groupTable.Sequence().PeekNextVal(ctx.KVStore(k.key)),
+	groupID, err := nextSequenceValue(ctx, k.GroupSeq)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "could not create group")
+	}
+	groupInfo := group.GroupInfo{
+		Id:          groupID,
 		Admin:       msg.Admin,
 		Metadata:    msg.Metadata,
 		Version:     1,
 		TotalWeight: totalWeight.String(),
 		CreatedAt:   ctx.BlockTime(),
 	}
-	groupID, err := k.groupTable.Create(ctx.KVStore(k.key), groupInfo)
-	if err != nil {
+	if err := validateAndSet(ctx, k.GroupInfos.Set, groupID, groupInfo); err != nil {
 		return nil, errorsmod.Wrap(err, "could not create group")
 	}
 
-	// Create new group members in the groupMemberTable.
+	// Create new group members in the GroupMembers collection.
 	for i, m := range msg.Members {
-		err := k.groupMemberTable.Create(ctx.KVStore(k.key), &group.GroupMember{
+		err := k.setGroupMember(ctx, group.GroupMember{
 			GroupId: groupID,
 			Member: &group.Member{
 				Address:  m.Address,
@@ -132,13 +135,18 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, msg *group.MsgUpdateGr
 				},
 			}
 
+			memberAddr, err := k.accKeeper.AddressCodec().StringToBytes(member.Address)
+			if err != nil {
+				return err
+			}
+
 			// Checking if the group member is already part of the group
 			var found bool
-			var prevGroupMember group.GroupMember
-			switch err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&groupMember), &prevGroupMember); {
+			prevGroupMember, err := k.Members.Get(ctx, collections.Join(msg.GroupId, sdk.AccAddress(memberAddr)))
+			switch {
 			case err == nil:
 				found = true
-			case sdkerrors.ErrNotFound.Is(err):
+			case errorsmod.IsOf(err, collections.ErrNotFound):
 				found = false
 			default:
 				return errorsmod.Wrap(err, "get group member")
@@ -167,8 +175,8 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, msg *group.MsgUpdateGr
 					return err
 				}
 
-				// Delete group member in the groupMemberTable.
-				if err := k.groupMemberTable.Delete(ctx.KVStore(k.key), &groupMember); err != nil {
+				// Delete group member in the GroupMembers collection.
+				if err := k.Members.Remove(ctx, collections.Join(msg.GroupId, sdk.AccAddress(memberAddr))); err != nil {
 					return errorsmod.Wrap(err, "delete member")
 				}
 				continue
@@ -184,14 +192,14 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, msg *group.MsgUpdateGr
 				if err != nil {
 					return err
 				}
-				// Save updated group member in the groupMemberTable.
+				// Save updated group member in the GroupMembers collection.
 				groupMember.Member.AddedAt = prevGroupMember.Member.AddedAt
-				if err := k.groupMemberTable.Update(ctx.KVStore(k.key), &groupMember); err != nil {
+				if err := k.setGroupMember(ctx, groupMember); err != nil {
 					return errorsmod.Wrap(err, "add member")
 				}
 			} else { // else handle create.
 				groupMember.Member.AddedAt = ctx.BlockTime()
-				if err := k.groupMemberTable.Create(ctx.KVStore(k.key), &groupMember); err != nil {
+				if err := k.setGroupMember(ctx, groupMember); err != nil {
 					return errorsmod.Wrap(err, "add member")
 				}
 			}
@@ -201,7 +209,7 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, msg *group.MsgUpdateGr
 				return err
 			}
 		}
-		// Update group in the groupTable.
+		// Update group in the Groups collection.
 		g.TotalWeight = totalWeight.String()
 		g.Version++
 
@@ -209,7 +217,7 @@ func (k Keeper) UpdateGroupMembers(goCtx context.Context, msg *group.MsgUpdateGr
 			return err
 		}
 
-		return k.groupTable.Update(ctx.KVStore(k.key), g.Id, g)
+		return validateAndSet(ctx, k.GroupInfos.Set, g.Id, *g)
 	}
 
 	if err := k.doUpdateGroup(ctx, msg.GetGroupID(), msg.GetAdmin(), action, "members updated"); err != nil {
@@ -241,7 +249,7 @@ func (k Keeper) UpdateGroupAdmin(goCtx context.Context, msg *group.MsgUpdateGrou
 		g.Admin = msg.NewAdmin
 		g.Version++
 
-		return k.groupTable.Update(ctx.KVStore(k.key), g.Id, g)
+		return validateAndSet(ctx, k.GroupInfos.Set, g.Id, *g)
 	}
 
 	if err := k.doUpdateGroup(ctx, msg.GetGroupID(), msg.GetAdmin(), action, "admin updated"); err != nil {
@@ -268,7 +276,7 @@ func (k Keeper) UpdateGroupMetadata(goCtx context.Context, msg *group.MsgUpdateG
 	action := func(g *group.GroupInfo) error {
 		g.Metadata = msg.Metadata
 		g.Version++
-		return k.groupTable.Update(ctx.KVStore(k.key), g.Id, g)
+		return validateAndSet(ctx, k.GroupInfos.Set, g.Id, *g)
 	}
 
 	if err := k.doUpdateGroup(ctx, msg.GetGroupID(), msg.GetAdmin(), action, "metadata updated"); err != nil {
@@ -374,7 +382,10 @@ func (k Keeper) CreateGroupPolicy(goCtx context.Context, msg *group.MsgCreateGro
 	// loop here in the rare case where a ADR-028-derived address creates a
 	// collision with an existing address.
 	for {
-		nextAccVal := k.groupPolicySeq.NextVal(ctx.KVStore(k.key))
+		nextAccVal, err := nextSequenceValue(ctx, k.GroupPolicySeq)
+		if err != nil {
+			return nil, err
+		}
 		derivationKey := make([]byte, 8)
 		binary.BigEndian.PutUint64(derivationKey, nextAccVal)
 
@@ -414,7 +425,7 @@ func (k Keeper) CreateGroupPolicy(goCtx context.Context, msg *group.MsgCreateGro
 		return nil, err
 	}
 
-	if err := k.groupPolicyTable.Create(ctx.KVStore(k.key), &groupPolicy); err != nil {
+	if err := validateAndSet(ctx, k.GroupPolicies.Set, accountAddr, groupPolicy); err != nil {
 		return nil, errorsmod.Wrap(err, "could not create group policy")
 	}
 
@@ -434,7 +445,7 @@ func (k Keeper) UpdateGroupPolicyAdmin(goCtx context.Context, msg *group.MsgUpda
 	action := func(groupPolicy *group.GroupPolicyInfo) error {
 		groupPolicy.Admin = msg.NewAdmin
 		groupPolicy.Version++
-		return k.groupPolicyTable.Update(ctx.KVStore(k.key), groupPolicy)
+		return k.setGroupPolicyInfo(ctx, *groupPolicy)
 	}
 
 	if err := k.doUpdateGroupPolicy(ctx, msg.GroupPolicyAddress, msg.Admin, action, "group policy admin updated"); err != nil {
@@ -472,7 +483,7 @@ func (k Keeper) UpdateGroupPolicyDecisionPolicy(goCtx context.Context, msg *grou
 		}
 
 		groupPolicy.Version++
-		return k.groupPolicyTable.Update(ctx.KVStore(k.key), groupPolicy)
+		return k.setGroupPolicyInfo(ctx, *groupPolicy)
 	}
 
 	if err = k.doUpdateGroupPolicy(ctx, msg.GroupPolicyAddress, msg.Admin, action, "group policy's decision policy updated"); err != nil {
@@ -489,7 +500,7 @@ func (k Keeper) UpdateGroupPolicyMetadata(goCtx context.Context, msg *group.MsgU
 	action := func(groupPolicy *group.GroupPolicyInfo) error {
 		groupPolicy.Metadata = metadata
 		groupPolicy.Version++
-		return k.groupPolicyTable.Update(ctx.KVStore(k.key), groupPolicy)
+		return k.setGroupPolicyInfo(ctx, *groupPolicy)
 	}
 
 	if err := k.assertMetadataLength(metadata, "group policy metadata"); err != nil {
@@ -569,7 +580,15 @@ func (k Keeper) SubmitProposal(goCtx context.Context, msg *group.MsgSubmitPropos
 
 	// Only members of the group can submit a new proposal.
 	for _, proposer := range msg.Proposers {
-		if !k.groupMemberTable.Has(ctx.KVStore(k.key), orm.PrimaryKey(&group.GroupMember{GroupId: groupInfo.Id, Member: &group.Member{Address: proposer}})) {
+		proposerAddr, err := k.accKeeper.AddressCodec().StringToBytes(proposer)
+		if err != nil {
+			return nil, err
+		}
+		isMember, err := k.Members.Has(ctx, collections.Join(groupInfo.Id, sdk.AccAddress(proposerAddr)))
+		if err != nil {
+			return nil, err
+		}
+		if !isMember {
 			return nil, errorsmod.Wrapf(errors.ErrUnauthorized, "not in group: %s", proposer)
 		}
 	}
@@ -590,7 +609,6 @@ func (k Keeper) SubmitProposal(goCtx context.Context, msg *group.MsgSubmitPropos
 	}
 
 	m := &group.Proposal{
-		Id:                 k.proposalTable.Sequence().PeekNextVal(ctx.KVStore(k.key)),
 		GroupPolicyAddress: msg.GroupPolicyAddress,
 		Metadata:           msg.Metadata,
 		Proposers:          msg.Proposers,
@@ -609,10 +627,28 @@ func (k Keeper) SubmitProposal(goCtx context.Context, msg *group.MsgSubmitPropos
 		return nil, errorsmod.Wrap(err, "create proposal")
 	}
 
-	id, err := k.proposalTable.Create(ctx.KVStore(k.key), m)
+	id, err := nextSequenceValue(ctx, k.ProposalSeq)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "create proposal")
 	}
+	m.Id = id
+	if err := validateAndSet(ctx, k.Proposals.Set, id, *m); err != nil {
+		return nil, errorsmod.Wrap(err, "create proposal")
+	}
+
+	// The decision policy can't change without aborting the proposal, so its
+	// prune deadline is already known.
+	if err := k.schedulePrune(ctx, *m, policy); err != nil {
+		return nil, errorsmod.Wrap(err, "create proposal")
+	}
+
+	// Snapshot the token weights of the members to prevent voting with tokens
+	// transferred after a vote.
+	if tokenPolicy, ok := policy.(*group.TokenWeightedDecisionPolicy); ok {
+		if err := k.snapshotMemberWeights(ctx, id, groupInfo.Id, tokenPolicy); err != nil {
+			return nil, errorsmod.Wrap(err, "snapshot member weights")
+		}
+	}
 
 	if err := ctx.EventManager().EmitTypedEvent(&group.EventSubmitProposal{ProposalId: id}); err != nil {
 		return nil, err
@@ -679,7 +715,7 @@ func (k Keeper) WithdrawProposal(goCtx context.Context, msg *group.MsgWithdrawPr
 	}
 
 	proposal.Status = group.PROPOSAL_STATUS_WITHDRAWN
-	if err := k.proposalTable.Update(ctx.KVStore(k.key), msg.ProposalId, &proposal); err != nil {
+	if err := validateAndSet(ctx, k.Proposals.Set, msg.ProposalId, proposal); err != nil {
 		return nil, err
 	}
 
@@ -690,6 +726,65 @@ func (k Keeper) WithdrawProposal(goCtx context.Context, msg *group.MsgWithdrawPr
 	return &group.MsgWithdrawProposalResponse{}, nil
 }
 
+// CancelProposal implements the MsgServer/CancelProposal method.
+func (k Keeper) CancelProposal(goCtx context.Context, msg *group.MsgCancelProposal) (*group.MsgCancelProposalResponse, error) {
+	if msg.ProposalId == 0 {
+		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposal id")
+	}
+
+	if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.Guardian); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid guardian address: %s", msg.Guardian)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proposal, err := k.getProposal(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the proposal is still timelocked.
+	q, err := k.getQueuedExecution(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(errors.ErrInvalid, "proposal %d is not queued for execution", msg.ProposalId)
+	}
+
+	if ctx.BlockTime().After(q.ExecuteAfter) {
+		return nil, errorsmod.Wrapf(errors.ErrExpired, "timelock of proposal %d has ended already", msg.ProposalId)
+	}
+
+	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "load group policy")
+	}
+
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	if windows := decisionPolicyWindows(policy); windows == nil || !windows.IsGuardian(msg.Guardian) {
+		return nil, errorsmod.Wrapf(errors.ErrUnauthorized, "given address is not a guardian of the group policy: %s", msg.Guardian)
+	}
+
+	proposal.Status = group.PROPOSAL_STATUS_CANCELLED
+	if err := validateAndSet(ctx, k.Proposals.Set, msg.ProposalId, proposal); err != nil {
+		return nil, err
+	}
+
+	if err := k.dequeueExecution(ctx, msg.ProposalId); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventProposalCancelled{
+		ProposalId: msg.ProposalId,
+		Guardian:   msg.Guardian,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgCancelProposalResponse{}, nil
+}
+
 func (k Keeper) Vote(goCtx context.Context, msg *group.MsgVote) (*group.MsgVoteResponse, error) {
 	if msg.ProposalId == 0 {
 		return nil, errorsmod.Wrap(errors.ErrEmpty, "proposal id")
@@ -708,7 +803,8 @@ func (k Keeper) Vote(goCtx context.Context, msg *group.MsgVote) (*group.MsgVoteR
 		return nil, err
 	}
 
-	if _, err := k.accKeeper.AddressCodec().StringToBytes(msg.Voter); err != nil {
+	voterAddr, err := k.accKeeper.AddressCodec().StringToBytes(msg.Voter)
+	if err != nil {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid voter address: %s", msg.Voter)
 	}
 
@@ -738,9 +834,12 @@ func (k Keeper) Vote(goCtx context.Context, msg *group.MsgVote) (*group.MsgVoteR
 	}
 
 	// Count and store votes.
-	voter := group.GroupMember{GroupId: groupInfo.Id, Member: &group.Member{Address: msg.Voter}}
-	if err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&voter), &voter); err != nil {
-		return nil, errorsmod.Wrapf(err, "voter address: %s", msg.Voter)
+	isMember, err := k.Members.Has(ctx, collections.Join(groupInfo.Id, sdk.AccAddress(voterAddr)))
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "voter address: %s", msg.Voter)
 	}
 	newVote := group.Vote{
 		ProposalId: msg.ProposalId,
@@ -750,9 +849,14 @@ func (k Keeper) Vote(goCtx context.Context, msg *group.MsgVote) (*group.MsgVoteR
 		SubmitTime: ctx.BlockTime(),
 	}
 
-	// The ORM will return an error if the vote already exists,
-	// making sure than a voter hasn't already voted.
-	if err := k.voteTable.Create(ctx.KVStore(k.key), &newVote); err != nil {
+	// Make sure that a voter hasn't already voted.
+	voteKey := collections.Join(msg.ProposalId, sdk.AccAddress(voterAddr))
+	if hasVoted, err := k.Votes.Has(ctx, voteKey); err != nil {
+		return nil, err
+	} else if hasVoted {
+		return nil, errorsmod.Wrap(errors.ErrORMUniqueConstraint, "store vote")
+	}
+	if err := validateAndSet(ctx, k.Votes.Set, voteKey, newVote); err != nil {
 		return nil, errorsmod.Wrap(err, "store vote")
 	}
 
@@ -773,7 +877,8 @@ func (k Keeper) Vote(goCtx context.Context, msg *group.MsgVote) (*group.MsgVoteR
 
 // doTallyAndUpdate performs a tally, and, if the tally result is final, then:
 // - updates the proposal's `Status` and `FinalTallyResult` fields,
-// - prune all the votes.
+// - prune all the votes and snapshotted member weights,
+// - queues the proposal for execution if its decision policy has a timelock.
 func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, groupInfo group.GroupInfo, policyInfo group.GroupPolicyInfo) error {
 	policy, err := policyInfo.GetDecisionPolicy()
 	if err != nil {
@@ -785,7 +890,12 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, groupInfo g
 		return err
 	}
 
-	result, err := policy.Allow(tallyResult, groupInfo.TotalWeight)
+	totalWeight, err := k.proposalTotalWeight(ctx, p.Id, groupInfo)
+	if err != nil {
+		return err
+	}
+
+	result, err := policy.Allow(tallyResult, totalWeight)
 	if err != nil {
 		return errorsmod.Wrap(err, "policy allow")
 	}
@@ -796,6 +906,9 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, groupInfo g
 		if err := k.pruneVotes(ctx, p.Id); err != nil {
 			return err
 		}
+		if err := k.pruneMemberWeights(ctx, p.Id); err != nil {
+			return err
+		}
 		p.FinalTallyResult = tallyResult
 		if result.Allow {
 			p.Status = group.PROPOSAL_STATUS_ACCEPTED
@@ -803,6 +916,13 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, groupInfo g
 			p.Status = group.PROPOSAL_STATUS_REJECTED
 		}
 
+		// Accepted proposals of a decision policy with a timelock are queued
+		// for automatic execution once the timelock has passed.
+		if windows := decisionPolicyWindows(policy); p.Status == group.PROPOSAL_STATUS_ACCEPTED && windows != nil && windows.GetTimelockDuration() > 0 {
+			if err := k.queueExecution(ctx, *p, windows); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -843,6 +963,41 @@ func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecR
 		}
 	}
 
+	var logs string
+	if q, err := k.getQueuedExecution(ctx, proposal.Id); err == nil && !ctx.BlockTime().After(q.ExecuteAfter) {
+		// The proposal is timelocked, it will be executed automatically in
+		// EndBlock once the timelock has passed.
+		logs = fmt.Sprintf("proposal %d is queued for execution after %s", proposal.Id, q.ExecuteAfter)
+		if err := validateAndSet(ctx, k.Proposals.Set, proposal.Id, proposal); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := k.dequeueExecution(ctx, proposal.Id); err != nil {
+			return nil, err
+		}
+
+		if logs, err = k.executeProposal(ctx, &proposal, policyInfo); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventExec{
+		ProposalId: proposal.Id,
+		Logs:       logs,
+		Result:     proposal.ExecutorResult,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgExecResponse{
+		Result: proposal.ExecutorResult,
+	}, nil
+}
+
+// executeProposal executes the messages of an accepted proposal which hasn't
+// been successfully executed yet, and updates or prunes the proposal in state
+// depending on the execution result.
+func (k Keeper) executeProposal(ctx sdk.Context, proposal *group.Proposal, policyInfo group.GroupPolicyInfo) (string, error) {
 	// Execute proposal payload.
 	var logs string
 	if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
@@ -851,11 +1006,11 @@ func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecR
 
 		addr, err := k.accKeeper.AddressCodec().StringToBytes(policyInfo.Address)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 
 		decisionPolicy := policyInfo.DecisionPolicy.GetCachedValue().(group.DecisionPolicy)
-		if results, err := k.doExecuteMsgs(cacheCtx, k.router, proposal, addr, decisionPolicy); err != nil {
+		if results, err := k.doExecuteMsgs(cacheCtx, k.router, *proposal, addr, decisionPolicy); err != nil {
 			proposal.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_FAILURE
 			logs = fmt.Sprintf("proposal execution failed on proposal %d, because of error %s", proposal.Id, err.Error())
 			k.Logger(ctx).Info("proposal execution failed", "cause", err, "proposalID", proposal.Id)
@@ -870,11 +1025,11 @@ func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecR
 		}
 	}
 
-	// Update proposal in proposalTable
+	// Update proposal in the Proposals collection.
 	// If proposal has successfully run, delete it from state.
 	if proposal.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
 		if err := k.pruneProposal(ctx, proposal.Id); err != nil {
-			return nil, err
+			return "", err
 		}
 
 		// Emit event for proposal finalized with its result
@@ -884,26 +1039,15 @@ func (k Keeper) Exec(goCtx context.Context, msg *group.MsgExec) (*group.MsgExecR
 				Status:      proposal.Status,
 				TallyResult: &proposal.FinalTallyResult,
 			}); err != nil {
-			return nil, err
+			return "", err
 		}
 	} else {
-		store := ctx.KVStore(k.key)
-		if err := k.proposalTable.Update(store, proposal.Id, &proposal); err != nil {
-			return nil, err
+		if err := validateAndSet(ctx, k.Proposals.Set, proposal.Id, *proposal); err != nil {
+			return "", err
 		}
 	}
 
-	if err := ctx.EventManager().EmitTypedEvent(&group.EventExec{
-		ProposalId: proposal.Id,
-		Logs:       logs,
-		Result:     proposal.ExecutorResult,
-	}); err != nil {
-		return nil, err
-	}
-
-	return &group.MsgExecResponse{
-		Result: proposal.ExecutorResult,
-	}, nil
+	return logs, nil
 }
 
 // LeaveGroup implements the MsgServer/LeaveGroup method.
@@ -912,7 +1056,7 @@ func (k Keeper) LeaveGroup(goCtx context.Context, msg *group.MsgLeaveGroup) (*gr
 		return nil, errorsmod.Wrap(errors.ErrEmpty, "group-id")
 	}
 
-	_, err := k.accKeeper.AddressCodec().StringToBytes(msg.Address)
+	memberAddr, err := k.accKeeper.AddressCodec().StringToBytes(msg.Address)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "group member")
 	}
@@ -928,10 +1072,7 @@ func (k Keeper) LeaveGroup(goCtx context.Context, msg *group.MsgLeaveGroup) (*gr
 		return nil, err
 	}
 
-	gm, err := k.getGroupMember(ctx, &group.GroupMember{
-		GroupId: msg.GroupId,
-		Member:  &group.Member{Address: msg.Address},
-	})
+	gm, err := k.getGroupMember(ctx, msg.GroupId, memberAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -946,8 +1087,8 @@ func (k Keeper) LeaveGroup(goCtx context.Context, msg *group.MsgLeaveGroup) (*gr
 		return nil, err
 	}
 
-	// delete group member in the groupMemberTable.
-	if err := k.groupMemberTable.Delete(ctx.KVStore(k.key), gm); err != nil {
+	// delete group member in the GroupMembers collection.
+	if err := k.Members.Remove(ctx, collections.Join(msg.GroupId, sdk.AccAddress(memberAddr))); err != nil {
 		return nil, errorsmod.Wrap(err, "group member")
 	}
 
@@ -959,7 +1100,7 @@ func (k Keeper) LeaveGroup(goCtx context.Context, msg *group.MsgLeaveGroup) (*gr
 		return nil, err
 	}
 
-	if err := k.groupTable.Update(ctx.KVStore(k.key), groupInfo.Id, &groupInfo); err != nil {
+	if err := validateAndSet(ctx, k.GroupInfos.Set, groupInfo.Id, groupInfo); err != nil {
 		return nil, err
 	}
 
@@ -973,14 +1114,13 @@ func (k Keeper) LeaveGroup(goCtx context.Context, msg *group.MsgLeaveGroup) (*gr
 	return &group.MsgLeaveGroupResponse{}, nil
 }
 
-func (k Keeper) getGroupMember(ctx sdk.Context, member *group.GroupMember) (*group.GroupMember, error) {
-	var groupMember group.GroupMember
-	switch err := k.groupMemberTable.GetOne(ctx.KVStore(k.key),
-		orm.PrimaryKey(member), &groupMember); {
+func (k Keeper) getGroupMember(ctx sdk.Context, groupID uint64, member sdk.AccAddress) (*group.GroupMember, error) {
+	groupMember, err := k.Members.Get(ctx, collections.Join(groupID, member))
+	switch {
 	case err == nil:
 		break
-	case sdkerrors.ErrNotFound.Is(err):
-		return nil, sdkerrors.ErrNotFound.Wrapf("%s is not part of group %d", member.Member.Address, member.GroupId)
+	case errorsmod.IsOf(err, collections.ErrNotFound):
+		return nil, sdkerrors.ErrNotFound.Wrapf("%s is not part of group %d", member, groupID)
 	default:
 		return nil, err
 	}
@@ -988,6 +1128,24 @@ func (k Keeper) getGroupMember(ctx sdk.Context, member *group.GroupMember) (*gro
 	return &groupMember, nil
 }
 
+// setGroupMember stores the given group member under its group id and address.
+func (k Keeper) setGroupMember(ctx sdk.Context, member group.GroupMember) error {
+	addr, err := k.accKeeper.AddressCodec().StringToBytes(member.Member.Address)
+	if err != nil {
+		return err
+	}
+	return validateAndSet(ctx, k.Members.Set, collections.Join(member.GroupId, sdk.AccAddress(addr)), member)
+}
+
+// setGroupPolicyInfo stores the given group policy info under its account address.
+func (k Keeper) setGroupPolicyInfo(ctx sdk.Context, groupPolicy group.GroupPolicyInfo) error {
+	addr, err := k.accKeeper.AddressCodec().StringToBytes(groupPolicy.Address)
+	if err != nil {
+		return err
+	}
+	return validateAndSet(ctx, k.GroupPolicies.Set, sdk.AccAddress(addr), groupPolicy)
+}
+
 type (
 	actionFn            func(m *group.GroupInfo) error
 	groupPolicyActionFn func(m *group.GroupPolicyInfo) error
@@ -1066,18 +1224,13 @@ func (k Keeper) assertMetadataLength(metadata, description string) error {
 // validateDecisionPolicies loops through all decision policies from the group,
 // and calls each of their Validate() method.
 func (k Keeper) validateDecisionPolicies(ctx sdk.Context, g group.GroupInfo) error {
-	it, err := k.groupPolicyByGroupIndex.Get(ctx.KVStore(k.key), g.Id)
+	addrs, err := k.GroupPolicies.Indexes.Group.MatchExact(ctx, g.Id)
 	if err != nil {
 		return err
 	}
-	defer it.Close()
 
-	for {
-		var groupPolicy group.GroupPolicyInfo
-		_, err = it.LoadNext(&groupPolicy)
-		if errors.ErrORMIteratorDone.Is(err) {
-			break
-		}
+	for _, addr := range addrs {
+		groupPolicy, err := k.GroupPolicies.Get(ctx, addr)
 		if err != nil {
 			return err
 		}
@@ -2021,6 +2021,111 @@ func (s *TestSuite) TestWithdrawProposal() {
 	}
 }
 
+func (s *TestSuite) TestCancelProposal() {
+	addrs := s.addrs
+	guardian := addrs[3].String()
+	timelock := time.Hour
+	policy := &group.ThresholdDecisionPolicy{
+		Threshold: "1",
+		Windows: &group.DecisionPolicyWindows{
+			VotingPeriod: time.Hour,
+			Timelock:     &timelock,
+			Guardians:    []string{guardian},
+		},
+	}
+	policyAddr, _ := s.createGroupAndGroupPolicy(addrs[0], []group.MemberRequest{
+		{Address: addrs[1].String(), Weight: "1"},
+		{Address: addrs[2].String(), Weight: "1"},
+	}, policy)
+	proposers := []string{addrs[1].String()}
+
+	submitAndVote := func(option group.VoteOption) uint64 {
+		res, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+			GroupPolicyAddress: policyAddr,
+			Proposers:          proposers,
+		})
+		s.Require().NoError(err)
+
+		_, err = s.groupKeeper.Vote(s.ctx, &group.MsgVote{
+			ProposalId: res.ProposalId,
+			Voter:      proposers[0],
+			Option:     option,
+			Exec:       group.Exec_EXEC_TRY,
+		})
+		s.Require().NoError(err)
+		return res.ProposalId
+	}
+
+	specs := map[string]struct {
+		preRun    func() uint64
+		blockTime time.Time
+		guardian  string
+		expErrMsg string
+	}{
+		"wrong proposal id": {
+			preRun:    func() uint64 { return 1111 },
+			guardian:  guardian,
+			expErrMsg: "not found",
+		},
+		"not a guardian": {
+			preRun:    func() uint64 { return submitAndVote(group.VOTE_OPTION_YES) },
+			guardian:  proposers[0],
+			expErrMsg: "unauthorized",
+		},
+		"proposal not queued": {
+			preRun:    func() uint64 { return submitAndVote(group.VOTE_OPTION_NO) },
+			guardian:  guardian,
+			expErrMsg: "is not queued for execution",
+		},
+		"timelock ended": {
+			preRun:    func() uint64 { return submitAndVote(group.VOTE_OPTION_YES) },
+			blockTime: s.blockTime.Add(timelock + 1),
+			guardian:  guardian,
+			expErrMsg: "has ended already",
+		},
+		"happy case": {
+			preRun:   func() uint64 { return submitAndVote(group.VOTE_OPTION_YES) },
+			guardian: guardian,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			pID := spec.preRun()
+
+			ctx := s.sdkCtx
+			if !spec.blockTime.IsZero() {
+				ctx = ctx.WithBlockTime(spec.blockTime)
+			}
+			_, err := s.groupKeeper.CancelProposal(ctx, &group.MsgCancelProposal{
+				ProposalId: pID,
+				Guardian:   spec.guardian,
+			})
+			if spec.expErrMsg != "" {
+				s.Require().ErrorContains(err, spec.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			resp, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: pID})
+			s.Require().NoError(err)
+			s.Require().Equal(group.PROPOSAL_STATUS_CANCELLED, resp.Proposal.Status)
+
+			_, err = s.groupKeeper.QueuedExecution(ctx, &group.QueryQueuedExecutionRequest{ProposalId: pID})
+			s.Require().ErrorContains(err, "not found")
+
+			// cancelled proposals can't be executed and are pruned in EndBlock
+			_, err = s.groupKeeper.Exec(ctx, &group.MsgExec{ProposalId: pID, Executor: proposers[0]})
+			s.Require().ErrorContains(err, "not possible to exec with proposal status PROPOSAL_STATUS_CANCELLED")
+
+			ctx = ctx.WithBlockTime(resp.Proposal.VotingPeriodEnd.Add(1))
+			s.Require().NoError(s.groupKeeper.TallyProposalsAtVPEnd(ctx))
+			_, err = s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: pID})
+			s.Require().ErrorContains(err, "not found")
+		})
+	}
+}
+
 func (s *TestSuite) TestVote() {
 	addrs := s.addrs
 	addr1 := addrs[0]
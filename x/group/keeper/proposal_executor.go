@@ -28,7 +28,11 @@ func (s Keeper) doExecuteMsgs(ctx sdk.Context, router baseapp.MessageRouter, pro
 	// be pruned automatically, so this function should not even be called, as
 	// the proposal doesn't exist in state. For sanity check, we can still keep
 	// this simple and cheap check.
-	expiryDate := proposal.VotingPeriodEnd.Add(s.config.MaxExecutionPeriod)
+	executionPeriod := s.config.MaxExecutionPeriod
+	if windows := decisionPolicyWindows(decisionPolicy); windows != nil {
+		executionPeriod = windows.EffectiveExecutionDeadline(s.config)
+	}
+	expiryDate := proposal.VotingPeriodEnd.Add(executionPeriod)
 	if expiryDate.Before(ctx.BlockTime()) {
 		return nil, errors.ErrExpired.Wrapf("proposal expired on %s", expiryDate)
 	}
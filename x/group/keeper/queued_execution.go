@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// decisionPolicyWindows returns the windows of a decision policy, or nil for
+// custom decision policies which do not define any.
+func decisionPolicyWindows(policy group.DecisionPolicy) *group.DecisionPolicyWindows {
+	p, ok := policy.(interface {
+		GetWindows() *group.DecisionPolicyWindows
+	})
+	if !ok {
+		return nil
+	}
+	return p.GetWindows()
+}
+
+// queueExecution queues an accepted proposal for automatic execution in
+// EndBlock once both its min execution period and the timelock of its
+// decision policy have passed.
+func (k Keeper) queueExecution(ctx sdk.Context, p group.Proposal, windows *group.DecisionPolicyWindows) error {
+	executeAfter := ctx.BlockTime().Add(windows.GetTimelockDuration())
+	if minExecutionDate := p.SubmitTime.Add(windows.MinExecutionPeriod); minExecutionDate.After(executeAfter) {
+		executeAfter = minExecutionDate
+	}
+
+	if err := validateAndSet(ctx, k.QueuedExecutions.Set, p.Id, group.QueuedExecution{
+		ProposalId:         p.Id,
+		GroupPolicyAddress: p.GroupPolicyAddress,
+		ExecuteAfter:       executeAfter,
+	}); err != nil {
+		return errorsmod.Wrap(err, "store queued execution")
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&group.EventProposalQueued{
+		ProposalId:   p.Id,
+		ExecuteAfter: executeAfter,
+	})
+}
+
+// getQueuedExecution gets the queued execution of the given proposal id.
+func (k Keeper) getQueuedExecution(ctx sdk.Context, proposalID uint64) (group.QueuedExecution, error) {
+	q, err := k.QueuedExecutions.Get(ctx, proposalID)
+	if errorsmod.IsOf(err, collections.ErrNotFound) {
+		return q, sdkerrors.ErrNotFound
+	}
+	return q, err
+}
+
+// isQueued returns whether a proposal is queued for execution.
+func (k Keeper) isQueued(ctx sdk.Context, proposalID uint64) (bool, error) {
+	return k.QueuedExecutions.Has(ctx, proposalID)
+}
+
+// dequeueExecution removes a proposal from the execution queue, if queued.
+func (k Keeper) dequeueExecution(ctx sdk.Context, proposalID uint64) error {
+	queued, err := k.isQueued(ctx, proposalID)
+	if err != nil || !queued {
+		return err
+	}
+	return k.QueuedExecutions.Remove(ctx, proposalID)
+}
+
+// queuedExecutionsDue returns all queued executions whose execute_after is
+// before the `endTime` time argument.
+func (k Keeper) queuedExecutionsDue(ctx sdk.Context, endTime time.Time) ([]group.QueuedExecution, error) {
+	ids, err := k.QueuedExecutions.Indexes.ExecuteAfter.match(ctx, timeRangeBefore[uint64](endTime))
+	if err != nil {
+		return nil, err
+	}
+
+	var queued []group.QueuedExecution
+	for _, id := range ids {
+		q, err := k.QueuedExecutions.Get(ctx, id)
+		if err != nil {
+			return queued, err
+		}
+		queued = append(queued, q)
+	}
+	return queued, nil
+}
+
+// ExecuteQueuedProposals iterates over all queued executions whose timelock
+// has passed, and executes their proposals.
+func (k Keeper) ExecuteQueuedProposals(ctx sdk.Context) error {
+	queued, err := k.queuedExecutionsDue(ctx, ctx.BlockTime())
+	if err != nil {
+		return err
+	}
+
+	for _, q := range queued {
+		if err := k.dequeueExecution(ctx, q.ProposalId); err != nil {
+			return err
+		}
+
+		proposal, err := k.getProposal(ctx, q.ProposalId)
+		if err != nil {
+			return err
+		}
+
+		policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+		if err != nil {
+			return errorsmod.Wrap(err, "load group policy")
+		}
+
+		logs, err := k.executeProposal(ctx, &proposal, policyInfo)
+		if err != nil {
+			return err
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&group.EventExec{
+			ProposalId: proposal.Id,
+			Logs:       logs,
+			Result:     proposal.ExecutorResult,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -1,17 +1,18 @@
 package keeper
 
 import (
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/group"
-	"github.com/cosmos/cosmos-sdk/x/group/errors"
-	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
 )
 
 // Tally is a function that tallies a proposal by iterating through its votes,
 // and returns the tally result without modifying the proposal or any state.
+// The votes are weighted by the member weights snapshotted at submission for
+// proposals of token weighted decision policies, and by the group member
+// weights otherwise.
 func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.TallyResult, error) {
 	// If proposal has already been tallied and updated, then its status is
 	// accepted/rejected, in which case we just return the previously stored result.
@@ -22,41 +23,61 @@ func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.
 		return p.FinalTallyResult, nil
 	}
 
-	it, err := k.voteByProposalIndex.Get(ctx.KVStore(k.key), p.Id)
-	if err != nil {
+	it, err := k.Votes.Iterate(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](p.Id))
+	switch {
+	case errorsmod.IsOf(err, collections.ErrInvalidIterator):
+		// No votes were cast on the proposal.
+		return group.DefaultTallyResult(), nil
+	case err != nil:
 		return group.TallyResult{}, err
 	}
 	defer it.Close()
 
+	hasMemberWeights, err := k.hasMemberWeights(ctx, p.Id)
+	if err != nil {
+		return group.TallyResult{}, err
+	}
+
 	tallyResult := group.DefaultTallyResult()
 
-	for {
-		var vote group.Vote
-		_, err = it.LoadNext(&vote)
-		if errors.ErrORMIteratorDone.Is(err) {
-			break
-		}
+	for ; it.Valid(); it.Next() {
+		kv, err := it.KeyValue()
 		if err != nil {
 			return group.TallyResult{}, err
 		}
+		voter, vote := kv.Key.K2(), kv.Value
 
-		var member group.GroupMember
-		err := k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.GroupMember{
-			GroupId: groupID,
-			Member:  &group.Member{Address: vote.Voter},
-		}), &member)
+		var weight string
+		if hasMemberWeights {
+			memberWeight, err := k.ProposalMemberWeights.Get(ctx, collections.Join(p.Id, voter))
 
-		switch {
-		case sdkerrors.ErrNotFound.Is(err):
-			// If the member left the group after voting, then we simply skip the
-			// vote.
-			continue
-		case err != nil:
-			// For any other errors, we stop and return the error.
-			return group.TallyResult{}, err
+			switch {
+			case errorsmod.IsOf(err, collections.ErrNotFound):
+				// If the member held no tokens when the proposal was
+				// submitted, then its vote has no weight.
+				continue
+			case err != nil:
+				return group.TallyResult{}, err
+			}
+
+			weight = memberWeight.Weight
+		} else {
+			member, err := k.Members.Get(ctx, collections.Join(groupID, voter))
+
+			switch {
+			case errorsmod.IsOf(err, collections.ErrNotFound):
+				// If the member left the group after voting, then we simply skip the
+				// vote.
+				continue
+			case err != nil:
+				// For any other errors, we stop and return the error.
+				return group.TallyResult{}, err
+			}
+
+			weight = member.Member.Weight
 		}
 
-		if err := tallyResult.Add(vote, member.Member.Weight); err != nil {
+		if err := tallyResult.Add(vote, weight); err != nil {
 			return group.TallyResult{}, errorsmod.Wrap(err, "add new vote")
 		}
 	}
@@ -4,6 +4,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
@@ -86,3 +90,111 @@ func (s *TestSuite) TestTally() {
 		})
 	}
 }
+
+func (s *TestSuite) TestTallyTokenWeighted() {
+	addrs := s.addrs
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+
+	balances := map[string]int64{
+		addrs[1].String(): 30,
+		addrs[2].String(): 10,
+	}
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), gomock.Any(), "token").DoAndReturn(func(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+		return sdk.NewInt64Coin(denom, balances[addr.String()])
+	}).AnyTimes()
+
+	members := []group.MemberRequest{
+		{Address: addrs[1].String(), Weight: "1"},
+		{Address: addrs[2].String(), Weight: "1"},
+		{Address: addrs[3].String(), Weight: "1"},
+	}
+	policy := group.NewTokenWeightedDecisionPolicy("token", group.TOKEN_WEIGHT_SOURCE_BALANCE, "0.5", time.Hour, 0)
+	policyAddr, _ := s.createGroupAndGroupPolicy(addrs[0], members, policy)
+
+	proposalRes, err := s.groupKeeper.SubmitProposal(sdkCtx, &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyAddr,
+		Proposers:          []string{addrs[1].String()},
+	})
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
+
+	// tokens transferred after the submission don't change the votes weights
+	balances[addrs[3].String()] = balances[addrs[1].String()]
+	balances[addrs[1].String()] = 0
+
+	for voter, option := range map[string]group.VoteOption{
+		addrs[1].String(): group.VOTE_OPTION_YES,
+		addrs[2].String(): group.VOTE_OPTION_NO,
+		addrs[3].String(): group.VOTE_OPTION_NO,
+	} {
+		_, err = s.groupKeeper.Vote(sdkCtx, &group.MsgVote{
+			ProposalId: proposalID,
+			Voter:      voter,
+			Option:     option,
+		})
+		s.Require().NoError(err)
+	}
+
+	res, err := s.groupKeeper.TallyResult(sdkCtx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.TallyResult{
+		YesCount:        "30",
+		NoCount:         "10",
+		NoWithVetoCount: "0",
+		AbstainCount:    "0",
+	}, res.Tally)
+
+	// the proposal passes with 30 out of the 40 snapshotted tokens
+	sdkCtx = sdkCtx.WithBlockTime(sdkCtx.BlockTime().Add(time.Hour + time.Second))
+	s.Require().NoError(s.groupKeeper.TallyProposalsAtVPEnd(sdkCtx))
+
+	proposal, err := s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, proposal.Proposal.Status)
+
+	// the snapshotted weights are pruned with the votes
+	s.Require().Empty(s.groupKeeper.ExportGenesis(sdkCtx, nil).ProposalMemberWeights)
+}
+
+func (s *TestSuite) TestSubmitProposalTokenWeighted() {
+	addrs := s.addrs
+	members := []group.MemberRequest{
+		{Address: addrs[1].String(), Weight: "1"},
+		{Address: addrs[2].String(), Weight: "1"},
+	}
+
+	s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("stake").AnyTimes()
+	s.stakingKeeper.EXPECT().GetDelegatorBonded(gomock.Any(), gomock.Any()).Return(math.ZeroInt()).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), gomock.Any(), "token").Return(sdk.NewInt64Coin("token", 0)).AnyTimes()
+
+	specs := map[string]struct {
+		policy group.DecisionPolicy
+		expErr string
+	}{
+		"no member holds the denom": {
+			policy: group.NewTokenWeightedDecisionPolicy("token", group.TOKEN_WEIGHT_SOURCE_BALANCE, "0.5", time.Hour, 0),
+			expErr: "no group member holds token",
+		},
+		"no member has delegated stake": {
+			policy: group.NewTokenWeightedDecisionPolicy("stake", group.TOKEN_WEIGHT_SOURCE_DELEGATED_STAKE, "0.5", time.Hour, 0),
+			expErr: "no group member holds stake",
+		},
+		"delegated stake of another denom": {
+			policy: group.NewTokenWeightedDecisionPolicy("token", group.TOKEN_WEIGHT_SOURCE_DELEGATED_STAKE, "0.5", time.Hour, 0),
+			expErr: "delegated stake denom must be stake",
+		},
+	}
+
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			policyAddr, _ := s.createGroupAndGroupPolicy(addrs[0], members, spec.policy)
+
+			_, err := s.groupKeeper.SubmitProposal(s.ctx, &group.MsgSubmitProposal{
+				GroupPolicyAddress: policyAddr,
+				Proposers:          []string{addrs[1].String()},
+			})
+			s.Require().ErrorContains(err, spec.expErr)
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
+)
+
+// snapshotMemberWeights stores the token weights of the group members for a
+// proposal submitted to a token weighted decision policy. Members without
+// tokens are not stored, and the proposal is rejected if no member has tokens
+// since it could never pass.
+func (k Keeper) snapshotMemberWeights(ctx sdk.Context, proposalID, groupID uint64, policy *group.TokenWeightedDecisionPolicy) error {
+	if policy.Source == group.TOKEN_WEIGHT_SOURCE_DELEGATED_STAKE {
+		if bondDenom := k.stakingKeeper.BondDenom(ctx); policy.Denom != bondDenom {
+			return errorsmod.Wrapf(errors.ErrInvalid, "delegated stake denom must be %s, got %s", bondDenom, policy.Denom)
+		}
+	}
+
+	members, err := k.groupMembers(ctx, groupID)
+	if err != nil {
+		return err
+	}
+
+	totalWeight := math.NewDecFromInt64(0)
+	for _, member := range members {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "snapshot member weight")
+
+		addr, err := k.accKeeper.AddressCodec().StringToBytes(member.Member.Address)
+		if err != nil {
+			return err
+		}
+
+		var amount sdkmath.Int
+		switch policy.Source {
+		case group.TOKEN_WEIGHT_SOURCE_BALANCE:
+			amount = k.bankKeeper.GetBalance(ctx, addr, policy.Denom).Amount
+		case group.TOKEN_WEIGHT_SOURCE_DELEGATED_STAKE:
+			amount = k.stakingKeeper.GetDelegatorBonded(ctx, addr)
+		default:
+			return errorsmod.Wrapf(errors.ErrInvalid, "token weight source %s", policy.Source)
+		}
+
+		if !amount.IsPositive() {
+			continue
+		}
+
+		weight, err := math.NewPositiveDecFromString(amount.String())
+		if err != nil {
+			return err
+		}
+
+		if err := validateAndSet(ctx, k.ProposalMemberWeights.Set, collections.Join(proposalID, sdk.AccAddress(addr)), group.ProposalMemberWeight{
+			ProposalId: proposalID,
+			Member:     member.Member.Address,
+			Weight:     weight.String(),
+		}); err != nil {
+			return errorsmod.Wrap(err, "store proposal member weight")
+		}
+
+		totalWeight, err = totalWeight.Add(weight)
+		if err != nil {
+			return err
+		}
+	}
+
+	if totalWeight.IsZero() {
+		return errorsmod.Wrapf(errors.ErrInvalid, "no group member holds %s", policy.Denom)
+	}
+
+	return nil
+}
+
+// groupMembers returns all members of a given group.
+func (k Keeper) groupMembers(ctx sdk.Context, groupID uint64) ([]group.GroupMember, error) {
+	it, err := k.Members.Iterate(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](groupID))
+	if errorsmod.IsOf(err, collections.ErrInvalidIterator) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return it.Values()
+}
+
+// hasMemberWeights returns whether member weights were snapshotted for a
+// proposal, i.e. whether it was submitted to a token weighted decision policy.
+func (k Keeper) hasMemberWeights(ctx sdk.Context, proposalID uint64) (bool, error) {
+	it, err := k.ProposalMemberWeights.Iterate(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID))
+	if errorsmod.IsOf(err, collections.ErrInvalidIterator) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer it.Close()
+	return it.Valid(), nil
+}
+
+// memberWeightsByProposal returns all member weights snapshotted for a given
+// proposal.
+func (k Keeper) memberWeightsByProposal(ctx sdk.Context, proposalID uint64) ([]group.ProposalMemberWeight, error) {
+	it, err := k.ProposalMemberWeights.Iterate(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID))
+	if errorsmod.IsOf(err, collections.ErrInvalidIterator) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return it.Values()
+}
+
+// proposalTotalWeight returns the total weight of the electorate of a
+// proposal: the snapshotted token weight of the group members for proposals
+// of token weighted decision policies, or the total weight of the group.
+func (k Keeper) proposalTotalWeight(ctx sdk.Context, proposalID uint64, groupInfo group.GroupInfo) (string, error) {
+	weights, err := k.memberWeightsByProposal(ctx, proposalID)
+	if err != nil {
+		return "", err
+	}
+
+	if len(weights) == 0 {
+		return groupInfo.TotalWeight, nil
+	}
+
+	totalWeight := math.NewDecFromInt64(0)
+	for _, w := range weights {
+		weight, err := math.NewPositiveDecFromString(w.Weight)
+		if err != nil {
+			return "", err
+		}
+
+		totalWeight, err = totalWeight.Add(weight)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return totalWeight.String(), nil
+}
+
+// pruneMemberWeights prunes all member weights snapshotted for a proposal
+// from state.
+func (k Keeper) pruneMemberWeights(ctx sdk.Context, proposalID uint64) error {
+	it, err := k.ProposalMemberWeights.Iterate(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID))
+	if errorsmod.IsOf(err, collections.ErrInvalidIterator) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	keys, err := it.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.ProposalMemberWeights.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package v3
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
+)
+
+const (
+	ModuleName = "group"
+
+	GroupTablePrefix                byte = 0x0
+	GroupTableSeqPrefix             byte = 0x1
+	GroupMemberTablePrefix          byte = 0x10
+	GroupPolicyTablePrefix          byte = 0x20
+	GroupPolicyTableSeqPrefix       byte = 0x21
+	ProposalTablePrefix             byte = 0x30
+	ProposalTableSeqPrefix          byte = 0x31
+	VoteTablePrefix                 byte = 0x40
+	ProposalMemberWeightTablePrefix byte = 0x50
+	QueuedExecutionTablePrefix      byte = 0x60
+)
+
+// Migrate migrates the x/group module state from the consensus version 2 to version 3.
+// Specifically, it reads all the state stored using the legacy ORM, deletes it
+// from the module store, and writes it back using collections through the
+// given importState function.
+func Migrate(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.Codec,
+	importState func(sdk.Context, *group.GenesisState) error,
+) error {
+	store := ctx.KVStore(storeKey)
+
+	state, err := exportLegacyState(store, cdc)
+	if err != nil {
+		return err
+	}
+
+	// delete the legacy state, including all the ORM indexes.
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return importState(ctx, state)
+}
+
+// exportLegacyState reads the x/group state stored using the legacy ORM.
+func exportLegacyState(store storetypes.KVStore, cdc codec.Codec) (*group.GenesisState, error) {
+	state := group.NewGenesisState()
+
+	groupTable, err := orm.NewAutoUInt64Table([2]byte{GroupTablePrefix}, GroupTableSeqPrefix, &group.GroupInfo{}, cdc)
+	if err != nil {
+		return nil, err
+	}
+	if state.GroupSeq, err = groupTable.Export(store, &state.Groups); err != nil {
+		return nil, fmt.Errorf("failed to get groups: %w", err)
+	}
+
+	groupMemberTable, err := orm.NewPrimaryKeyTable([2]byte{GroupMemberTablePrefix}, &group.GroupMember{}, cdc)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := groupMemberTable.Export(store, &state.GroupMembers); err != nil {
+		return nil, fmt.Errorf("failed to get group members: %w", err)
+	}
+
+	groupPolicyTable, err := orm.NewPrimaryKeyTable([2]byte{GroupPolicyTablePrefix}, &group.GroupPolicyInfo{}, cdc)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := groupPolicyTable.Export(store, &state.GroupPolicies); err != nil {
+		return nil, fmt.Errorf("failed to get group policies: %w", err)
+	}
+	state.GroupPolicySeq = orm.NewSequence(GroupPolicyTableSeqPrefix).CurVal(store)
+
+	proposalTable, err := orm.NewAutoUInt64Table([2]byte{ProposalTablePrefix}, ProposalTableSeqPrefix, &group.Proposal{}, cdc)
+	if err != nil {
+		return nil, err
+	}
+	if state.ProposalSeq, err = proposalTable.Export(store, &state.Proposals); err != nil {
+		return nil, fmt.Errorf("failed to get proposals: %w", err)
+	}
+
+	voteTable, err := orm.NewPrimaryKeyTable([2]byte{VoteTablePrefix}, &group.Vote{}, cdc)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := voteTable.Export(store, &state.Votes); err != nil {
+		return nil, fmt.Errorf("failed to get votes: %w", err)
+	}
+
+	proposalMemberWeightTable, err := orm.NewPrimaryKeyTable([2]byte{ProposalMemberWeightTablePrefix}, &group.ProposalMemberWeight{}, cdc)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := proposalMemberWeightTable.Export(store, &state.ProposalMemberWeights); err != nil {
+		return nil, fmt.Errorf("failed to get proposal member weights: %w", err)
+	}
+
+	queuedExecutionTable, err := orm.NewPrimaryKeyTable([2]byte{QueuedExecutionTablePrefix}, &group.QueuedExecution{}, cdc)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := queuedExecutionTable.Export(store, &state.QueuedExecutions); err != nil {
+		return nil, fmt.Errorf("failed to get queued executions: %w", err)
+	}
+
+	return state, nil
+}
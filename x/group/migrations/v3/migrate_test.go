@@ -0,0 +1,113 @@
+package v3_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/orm"
+	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
+	v3 "github.com/cosmos/cosmos-sdk/x/group/migrations/v3"
+	groupmodule "github.com/cosmos/cosmos-sdk/x/group/module"
+)
+
+var (
+	adminAddr  = sdk.AccAddress("admin")
+	memberAddr = sdk.AccAddress("member")
+	policyAddr = sdk.MustAccAddressFromBech32("cosmos1q32tjg5qm3n9fj8wjgpd7gl98prefntrckjkyvh8tntp7q33zj0s5tkjrk")
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(groupmodule.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(v3.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey).WithBlockTime(time.Unix(1000, 0).UTC())
+	store := ctx.KVStore(storeKey)
+
+	groupTable, err := orm.NewAutoUInt64Table([2]byte{groupkeeper.GroupTablePrefix}, groupkeeper.GroupTableSeqPrefix, &group.GroupInfo{}, cdc)
+	require.NoError(t, err)
+	groupID, err := groupTable.Create(store, &group.GroupInfo{
+		Id:          groupTable.Sequence().PeekNextVal(store),
+		Admin:       adminAddr.String(),
+		Version:     1,
+		TotalWeight: "1",
+		CreatedAt:   ctx.BlockTime(),
+	})
+	require.NoError(t, err)
+
+	groupMemberTable, err := orm.NewPrimaryKeyTable([2]byte{groupkeeper.GroupMemberTablePrefix}, &group.GroupMember{}, cdc)
+	require.NoError(t, err)
+	require.NoError(t, groupMemberTable.Create(store, &group.GroupMember{
+		GroupId: groupID,
+		Member:  &group.Member{Address: memberAddr.String(), Weight: "1", AddedAt: ctx.BlockTime()},
+	}))
+
+	groupPolicyTable, err := orm.NewPrimaryKeyTable([2]byte{groupkeeper.GroupPolicyTablePrefix}, &group.GroupPolicyInfo{}, cdc)
+	require.NoError(t, err)
+	groupPolicy, err := group.NewGroupPolicyInfo(policyAddr, groupID, adminAddr, "", 1, group.NewThresholdDecisionPolicy("1", time.Second, 0), ctx.BlockTime())
+	require.NoError(t, err)
+	require.NoError(t, groupPolicyTable.Create(store, &groupPolicy))
+	groupPolicySeq := orm.NewSequence(groupkeeper.GroupPolicyTableSeqPrefix)
+	groupPolicySeq.NextVal(store)
+
+	proposalTable, err := orm.NewAutoUInt64Table([2]byte{groupkeeper.ProposalTablePrefix}, groupkeeper.ProposalTableSeqPrefix, &group.Proposal{}, cdc)
+	require.NoError(t, err)
+	proposalID, err := proposalTable.Create(store, &group.Proposal{
+		Id:                 proposalTable.Sequence().PeekNextVal(store),
+		GroupPolicyAddress: policyAddr.String(),
+		Proposers:          []string{memberAddr.String()},
+		SubmitTime:         ctx.BlockTime(),
+		GroupVersion:       1,
+		GroupPolicyVersion: 1,
+		Status:             group.PROPOSAL_STATUS_SUBMITTED,
+		FinalTallyResult:   group.DefaultTallyResult(),
+		VotingPeriodEnd:    ctx.BlockTime().Add(time.Second),
+		ExecutorResult:     group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN,
+	})
+	require.NoError(t, err)
+
+	voteTable, err := orm.NewPrimaryKeyTable([2]byte{groupkeeper.VoteTablePrefix}, &group.Vote{}, cdc)
+	require.NoError(t, err)
+	require.NoError(t, voteTable.Create(store, &group.Vote{
+		ProposalId: proposalID,
+		Voter:      memberAddr.String(),
+		Option:     group.VOTE_OPTION_YES,
+		SubmitTime: ctx.BlockTime(),
+	}))
+
+	// legacy ORM indexes are deleted by the migration.
+	store.Set([]byte{groupkeeper.GroupByAdminIndexPrefix, 0x1}, []byte{})
+
+	var imported *group.GenesisState
+	require.NoError(t, v3.Migrate(ctx, storeKey, cdc, func(_ sdk.Context, state *group.GenesisState) error {
+		imported = state
+		return nil
+	}))
+
+	iter := store.Iterator(nil, nil)
+	require.False(t, iter.Valid())
+	require.NoError(t, iter.Close())
+
+	require.NotNil(t, imported)
+	require.Equal(t, uint64(1), imported.GroupSeq)
+	require.Len(t, imported.Groups, 1)
+	require.Equal(t, adminAddr.String(), imported.Groups[0].Admin)
+	require.Len(t, imported.GroupMembers, 1)
+	require.Equal(t, memberAddr.String(), imported.GroupMembers[0].Member.Address)
+	require.Equal(t, uint64(1), imported.GroupPolicySeq)
+	require.Len(t, imported.GroupPolicies, 1)
+	require.Equal(t, policyAddr.String(), imported.GroupPolicies[0].Address)
+	require.Equal(t, uint64(1), imported.ProposalSeq)
+	require.Len(t, imported.Proposals, 1)
+	require.Equal(t, proposalID, imported.Proposals[0].Id)
+	require.Len(t, imported.Votes, 1)
+	require.Equal(t, group.VOTE_OPTION_YES, imported.Votes[0].Option)
+	require.NoError(t, imported.Validate())
+}
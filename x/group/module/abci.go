@@ -5,12 +5,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/group/keeper"
 )
 
-// EndBlocker called at every block, updates proposal's `FinalTallyResult` and
-// prunes expired proposals.
+// EndBlocker called at every block, updates proposal's `FinalTallyResult`,
+// executes proposals whose timelock has passed and prunes expired proposals.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	if err := k.TallyProposalsAtVPEnd(ctx); err != nil {
 		return err
 	}
 
+	if err := k.ExecuteQueuedProposals(ctx); err != nil {
+		return err
+	}
+
 	return k.PruneProposals(ctx)
 }
@@ -26,6 +26,7 @@ var (
 	_ sdk.Msg = &MsgExec{}
 	_ sdk.Msg = &MsgVote{}
 	_ sdk.Msg = &MsgWithdrawProposal{}
+	_ sdk.Msg = &MsgCancelProposal{}
 	_ sdk.Msg = &MsgSubmitProposal{}
 	_ sdk.Msg = &MsgCreateGroupPolicy{}
 
@@ -336,3 +337,9 @@ func (m MsgLeaveGroup) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{signer}
 }
+
+// GetSigners returns the expected signers for a MsgCancelProposal.
+func (m MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	guardian := sdk.MustAccAddressFromBech32(m.Guardian)
+	return []sdk.AccAddress{guardian}
+}
@@ -1486,6 +1486,216 @@ func (m *QueryGroupsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryQueuedExecutionRequest is the Query/QueuedExecution request type.
+type QueryQueuedExecutionRequest struct {
+	// proposal_id is the unique ID of a proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryQueuedExecutionRequest) Reset()         { *m = QueryQueuedExecutionRequest{} }
+func (m *QueryQueuedExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedExecutionRequest) ProtoMessage()    {}
+func (*QueryQueuedExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{28}
+}
+func (m *QueryQueuedExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedExecutionRequest.Merge(m, src)
+}
+func (m *QueryQueuedExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedExecutionRequest proto.InternalMessageInfo
+
+func (m *QueryQueuedExecutionRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryQueuedExecutionResponse is the Query/QueuedExecution response type.
+type QueryQueuedExecutionResponse struct {
+	// queued_execution is the queued execution of the proposal.
+	QueuedExecution *QueuedExecution `protobuf:"bytes,1,opt,name=queued_execution,json=queuedExecution,proto3" json:"queued_execution,omitempty"`
+}
+
+func (m *QueryQueuedExecutionResponse) Reset()         { *m = QueryQueuedExecutionResponse{} }
+func (m *QueryQueuedExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedExecutionResponse) ProtoMessage()    {}
+func (*QueryQueuedExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{29}
+}
+func (m *QueryQueuedExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedExecutionResponse.Merge(m, src)
+}
+func (m *QueryQueuedExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedExecutionResponse proto.InternalMessageInfo
+
+func (m *QueryQueuedExecutionResponse) GetQueuedExecution() *QueuedExecution {
+	if m != nil {
+		return m.QueuedExecution
+	}
+	return nil
+}
+
+// QueryQueuedExecutionsByGroupPolicyRequest is the Query/QueuedExecutionsByGroupPolicy request type.
+type QueryQueuedExecutionsByGroupPolicyRequest struct {
+	// address is the account address of the group policy.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryQueuedExecutionsByGroupPolicyRequest) Reset() {
+	*m = QueryQueuedExecutionsByGroupPolicyRequest{}
+}
+func (m *QueryQueuedExecutionsByGroupPolicyRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryQueuedExecutionsByGroupPolicyRequest) ProtoMessage() {}
+func (*QueryQueuedExecutionsByGroupPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{30}
+}
+func (m *QueryQueuedExecutionsByGroupPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedExecutionsByGroupPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedExecutionsByGroupPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedExecutionsByGroupPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedExecutionsByGroupPolicyRequest.Merge(m, src)
+}
+func (m *QueryQueuedExecutionsByGroupPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedExecutionsByGroupPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedExecutionsByGroupPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedExecutionsByGroupPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryQueuedExecutionsByGroupPolicyRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryQueuedExecutionsByGroupPolicyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryQueuedExecutionsByGroupPolicyResponse is the Query/QueuedExecutionsByGroupPolicy response type.
+type QueryQueuedExecutionsByGroupPolicyResponse struct {
+	// queued_executions are the queued executions of the group policy.
+	QueuedExecutions []*QueuedExecution `protobuf:"bytes,1,rep,name=queued_executions,json=queuedExecutions,proto3" json:"queued_executions,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryQueuedExecutionsByGroupPolicyResponse) Reset() {
+	*m = QueryQueuedExecutionsByGroupPolicyResponse{}
+}
+func (m *QueryQueuedExecutionsByGroupPolicyResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryQueuedExecutionsByGroupPolicyResponse) ProtoMessage() {}
+func (*QueryQueuedExecutionsByGroupPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{31}
+}
+func (m *QueryQueuedExecutionsByGroupPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedExecutionsByGroupPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedExecutionsByGroupPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedExecutionsByGroupPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedExecutionsByGroupPolicyResponse.Merge(m, src)
+}
+func (m *QueryQueuedExecutionsByGroupPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedExecutionsByGroupPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedExecutionsByGroupPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedExecutionsByGroupPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryQueuedExecutionsByGroupPolicyResponse) GetQueuedExecutions() []*QueuedExecution {
+	if m != nil {
+		return m.QueuedExecutions
+	}
+	return nil
+}
+
+func (m *QueryQueuedExecutionsByGroupPolicyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGroupInfoRequest)(nil), "cosmos.group.v1.QueryGroupInfoRequest")
 	proto.RegisterType((*QueryGroupInfoResponse)(nil), "cosmos.group.v1.QueryGroupInfoResponse")
@@ -1515,94 +1725,106 @@ func init() {
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.group.v1.QueryTallyResultResponse")
 	proto.RegisterType((*QueryGroupsRequest)(nil), "cosmos.group.v1.QueryGroupsRequest")
 	proto.RegisterType((*QueryGroupsResponse)(nil), "cosmos.group.v1.QueryGroupsResponse")
+	proto.RegisterType((*QueryQueuedExecutionRequest)(nil), "cosmos.group.v1.QueryQueuedExecutionRequest")
+	proto.RegisterType((*QueryQueuedExecutionResponse)(nil), "cosmos.group.v1.QueryQueuedExecutionResponse")
+	proto.RegisterType((*QueryQueuedExecutionsByGroupPolicyRequest)(nil), "cosmos.group.v1.QueryQueuedExecutionsByGroupPolicyRequest")
+	proto.RegisterType((*QueryQueuedExecutionsByGroupPolicyResponse)(nil), "cosmos.group.v1.QueryQueuedExecutionsByGroupPolicyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/query.proto", fileDescriptor_0fcf9f1d74302290) }
 
 var fileDescriptor_0fcf9f1d74302290 = []byte{
-	// 1310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x25, 0x3f, 0x5f, 0xda, 0x46, 0x9d, 0xa6, 0x6d, 0xb2, 0x8d, 0x9c, 0xb0, 0x2d,
-	0xf9, 0x9d, 0xdd, 0xd8, 0x49, 0x53, 0x04, 0x14, 0x54, 0x4b, 0x10, 0x72, 0x28, 0x4a, 0x4d, 0x85,
-	0x04, 0x42, 0x8a, 0xd6, 0xf1, 0xc6, 0xac, 0xb0, 0x77, 0x5c, 0xef, 0x26, 0xc2, 0x8a, 0x7c, 0x41,
-	0x82, 0x03, 0xe2, 0x00, 0x2d, 0x42, 0x25, 0xe2, 0xd0, 0x03, 0x12, 0x3d, 0x72, 0x00, 0x21, 0x71,
-	0xeb, 0xad, 0xc7, 0x0a, 0x2e, 0x9c, 0x10, 0x4a, 0x90, 0xf8, 0x23, 0xb8, 0xa0, 0x9d, 0x79, 0x6b,
-	0xef, 0x6f, 0x6f, 0x84, 0x45, 0x73, 0x69, 0xeb, 0x9d, 0x37, 0xf3, 0x3e, 0xf3, 0x7d, 0x6f, 0x67,
-	0xbf, 0x53, 0xb8, 0xbc, 0xcd, 0xac, 0x2a, 0xb3, 0xd4, 0x72, 0x9d, 0xed, 0xd6, 0xd4, 0xbd, 0xac,
-	0x7a, 0x77, 0x57, 0xaf, 0x37, 0x94, 0x5a, 0x9d, 0xd9, 0x8c, 0x8e, 0x88, 0x41, 0x85, 0x0f, 0x2a,
-	0x7b, 0x59, 0x69, 0xb4, 0xcc, 0xca, 0x8c, 0x8f, 0xa9, 0xce, 0xbf, 0x44, 0x98, 0x34, 0x51, 0x66,
-	0xac, 0x5c, 0xd1, 0x55, 0xad, 0x66, 0xa8, 0x9a, 0x69, 0x32, 0x5b, 0xb3, 0x0d, 0x66, 0x5a, 0x38,
-	0x1a, 0xca, 0x60, 0x37, 0x6a, 0xba, 0x3b, 0x38, 0x8f, 0x83, 0x45, 0xcd, 0xd2, 0x45, 0x6a, 0x75,
-	0x2f, 0x5b, 0xd4, 0x6d, 0x2d, 0xab, 0xd6, 0xb4, 0xb2, 0x61, 0xf2, 0x95, 0x30, 0x76, 0x5c, 0xc4,
-	0x6e, 0x89, 0xfc, 0x88, 0x26, 0x86, 0xce, 0x69, 0x55, 0xc3, 0x64, 0x2a, 0xff, 0x53, 0x3c, 0x92,
-	0x73, 0x70, 0xe1, 0xb6, 0xb3, 0xde, 0xba, 0x93, 0x76, 0xc3, 0xdc, 0x61, 0x05, 0xfd, 0xee, 0xae,
-	0x6e, 0xd9, 0x74, 0x1c, 0x06, 0x39, 0xca, 0x96, 0x51, 0x1a, 0x23, 0x53, 0x64, 0xb6, 0xb7, 0x30,
-	0xc0, 0x7f, 0x6f, 0x94, 0xe4, 0x37, 0xe1, 0x62, 0x70, 0x8e, 0x55, 0x63, 0xa6, 0xa5, 0x53, 0x05,
-	0x7a, 0x0d, 0x73, 0x87, 0xf1, 0x09, 0xc3, 0x39, 0x49, 0x09, 0x08, 0xa3, 0xb4, 0x67, 0xf0, 0x38,
-	0xf9, 0x36, 0x5c, 0x6e, 0xaf, 0xb4, 0xc9, 0x2a, 0xc6, 0x76, 0xc3, 0xcb, 0x90, 0x83, 0x01, 0xad,
-	0x54, 0xaa, 0xeb, 0x96, 0xc5, 0x57, 0x1c, 0xca, 0x8f, 0xfd, 0xfa, 0xe3, 0xd2, 0x28, 0x2e, 0x7a,
-	0x53, 0x8c, 0xbc, 0x6d, 0xd7, 0x0d, 0xb3, 0x5c, 0x70, 0x03, 0xe5, 0x3b, 0x30, 0x11, 0xbd, 0x24,
-	0x22, 0xae, 0xfa, 0x10, 0xa7, 0xa2, 0x11, 0x3d, 0xf3, 0x04, 0x68, 0x13, 0xc6, 0xda, 0xab, 0xde,
-	0xd2, 0xab, 0x45, 0xbd, 0x6e, 0x75, 0x56, 0x8a, 0xbe, 0x01, 0xd0, 0xae, 0xcf, 0xd8, 0x29, 0x9e,
-	0x72, 0xda, 0x4d, 0xe9, 0x14, 0x53, 0x11, 0x7d, 0x84, 0xc5, 0x54, 0x36, 0xb5, 0xb2, 0x8e, 0xcb,
-	0x16, 0x3c, 0x33, 0xe5, 0x6f, 0x09, 0x8c, 0x47, 0xe4, 0xc7, 0x2d, 0xad, 0xc1, 0x40, 0x55, 0x3c,
-	0x1a, 0x23, 0x53, 0xcf, 0xcd, 0x0e, 0xe7, 0x26, 0xa2, 0x77, 0x25, 0xe6, 0x15, 0xdc, 0x60, 0xba,
-	0x1e, 0x41, 0x37, 0xd3, 0x91, 0x4e, 0x24, 0xf5, 0xe1, 0xdd, 0xf7, 0xe1, 0x59, 0xf9, 0xc6, 0xcd,
-	0x52, 0xd5, 0x30, 0x5d, 0x7d, 0x14, 0xe8, 0xd3, 0x9c, 0xdf, 0x1d, 0x6b, 0x28, 0xc2, 0xba, 0x26,
-	0xda, 0x37, 0x04, 0xa4, 0x28, 0x2a, 0x54, 0x2d, 0x07, 0xfd, 0x5c, 0x1e, 0x57, 0xb4, 0xa4, 0x6e,
-	0xc5, 0xc8, 0xee, 0x29, 0xf6, 0x09, 0x81, 0xa9, 0x40, 0x9b, 0x1a, 0xba, 0x95, 0x17, 0x3f, 0xff,
-	0xc7, 0xc6, 0xfa, 0x89, 0xc0, 0xf3, 0x09, 0x1c, 0x28, 0xd5, 0x3a, 0x9c, 0x15, 0x20, 0x35, 0x0c,
-	0x40, 0xc9, 0x3a, 0xbf, 0x3d, 0x67, 0xca, 0xde, 0x75, 0xbb, 0xa7, 0xdf, 0x41, 0x8c, 0x7e, 0x27,
-	0xa2, 0xf1, 0xe2, 0x44, 0xf5, 0xf7, 0xdf, 0xc9, 0x13, 0xf5, 0x3a, 0x8c, 0x72, 0xec, 0xcd, 0x3a,
-	0xab, 0x31, 0x4b, 0xab, 0xb8, 0x3a, 0x4e, 0xc2, 0x70, 0x0d, 0x1f, 0xb5, 0x5b, 0x11, 0xdc, 0x47,
-	0x1b, 0x25, 0xf9, 0x2d, 0xfc, 0x88, 0xb4, 0x27, 0xe2, 0x1e, 0xaf, 0xc1, 0xa0, 0x1b, 0x86, 0x07,
-	0xee, 0x78, 0x68, 0x77, 0xad, 0x49, 0xad, 0x50, 0xf9, 0x21, 0x01, 0xd9, 0xb7, 0xa0, 0xdb, 0x91,
-	0x42, 0x84, 0xff, 0xf0, 0x79, 0xe8, 0x5a, 0x8d, 0xbf, 0x27, 0x70, 0x25, 0x11, 0x11, 0x15, 0xb8,
-	0x0e, 0x43, 0xee, 0xb6, 0xdc, 0x02, 0x27, 0x48, 0xd0, 0x8e, 0xed, 0x5e, 0x55, 0xeb, 0x30, 0xc9,
-	0x41, 0xdf, 0x61, 0xb6, 0x9e, 0x6f, 0xe1, 0x3a, 0xbf, 0xea, 0x69, 0x0b, 0xec, 0xbc, 0x49, 0x7b,
-	0xce, 0x04, 0xce, 0x91, 0xf8, 0x26, 0xf1, 0x30, 0xf9, 0x16, 0xbe, 0x9d, 0x91, 0x39, 0x51, 0x99,
-	0x39, 0xe8, 0x75, 0x82, 0xb1, 0x2f, 0x2e, 0x84, 0x44, 0x71, 0xa2, 0x0b, 0x3c, 0x44, 0xfe, 0x94,
-	0xa0, 0x4f, 0x70, 0x9e, 0x59, 0xf9, 0x63, 0x37, 0x68, 0xd7, 0xaa, 0xfe, 0x15, 0x41, 0x77, 0x11,
-	0x02, 0xc1, 0x4d, 0x2d, 0x08, 0xa1, 0xdc, 0x52, 0xc7, 0xec, 0x4a, 0xc4, 0x74, 0xaf, 0xc4, 0xf7,
-	0x08, 0xda, 0x13, 0xc4, 0xf2, 0x15, 0xb7, 0x55, 0x3b, 0x92, 0xaa, 0x76, 0x5d, 0xd3, 0xea, 0x4b,
-	0xd7, 0x14, 0xf8, 0xa1, 0x9e, 0xa9, 0x50, 0x0f, 0x82, 0x96, 0x00, 0x2d, 0xd1, 0x09, 0x38, 0x50,
-	0x0e, 0x88, 0xd7, 0x0b, 0x7b, 0xd0, 0x4e, 0x82, 0x5d, 0x79, 0x09, 0x2e, 0x71, 0xb6, 0x3b, 0x5a,
-	0xa5, 0xe2, 0x9c, 0x6d, 0xbb, 0x15, 0x3b, 0xf5, 0xc7, 0xe1, 0x5d, 0xec, 0x4d, 0xdf, 0x5c, 0xdc,
-	0xd4, 0x0d, 0xe8, 0xb3, 0x9d, 0xc7, 0x78, 0x08, 0x84, 0x7d, 0xab, 0x67, 0x52, 0x7e, 0xe8, 0xc9,
-	0x1f, 0x93, 0x3d, 0x8f, 0xfe, 0xfe, 0x61, 0x9e, 0x14, 0xc4, 0x2c, 0xf9, 0x7d, 0xa0, 0x1e, 0xc9,
-	0x5c, 0xa2, 0x6e, 0x55, 0xe4, 0x1e, 0x81, 0xf3, 0xbe, 0xe5, 0x4f, 0x40, 0x25, 0x72, 0xff, 0x9c,
-	0x83, 0x3e, 0x0e, 0x45, 0x3f, 0x27, 0x30, 0xd4, 0x4a, 0x44, 0xa7, 0x43, 0x10, 0x91, 0xd7, 0x3a,
-	0x69, 0xa6, 0x63, 0x9c, 0x48, 0x2a, 0x2b, 0x1f, 0xff, 0xf6, 0xd7, 0xfd, 0x53, 0xb3, 0x74, 0x5a,
-	0x0d, 0x5e, 0x4c, 0xd1, 0x93, 0x9a, 0x3b, 0x4c, 0xdd, 0x77, 0xfd, 0x69, 0x93, 0x7e, 0x47, 0x60,
-	0x24, 0x60, 0x54, 0xe8, 0x62, 0x42, 0xb2, 0xd0, 0x6d, 0x4f, 0x5a, 0x4a, 0x19, 0x8d, 0x80, 0xab,
-	0x1c, 0x50, 0xa1, 0x8b, 0x31, 0x80, 0xdc, 0x56, 0x35, 0x90, 0x13, 0xdf, 0xd6, 0x26, 0x7d, 0x40,
-	0xe0, 0xb4, 0xf7, 0x12, 0x45, 0xe7, 0x12, 0xb2, 0xfa, 0x2f, 0x7a, 0xd2, 0x7c, 0x9a, 0x50, 0xa4,
-	0xcb, 0x72, 0xba, 0x05, 0x3a, 0x17, 0x43, 0x87, 0x77, 0x30, 0xaf, 0x82, 0x07, 0x04, 0xce, 0xf8,
-	0xae, 0x2a, 0x34, 0x29, 0x61, 0xc0, 0xec, 0x4a, 0x0b, 0xa9, 0x62, 0x91, 0x6e, 0x99, 0xd3, 0xcd,
-	0xd3, 0xd9, 0x68, 0x3a, 0x6b, 0xab, 0xd8, 0xd8, 0xe2, 0x9e, 0xd8, 0x51, 0xae, 0x6a, 0x98, 0x4d,
-	0xfa, 0x0b, 0x81, 0xd1, 0xa8, 0x3b, 0x02, 0xcd, 0x76, 0xaa, 0x5a, 0xe8, 0x5e, 0x23, 0xe5, 0x8e,
-	0x33, 0x05, 0x89, 0x5f, 0xe6, 0xc4, 0xd7, 0xe8, 0x4a, 0x52, 0xb5, 0x0d, 0x9d, 0x93, 0x8b, 0x21,
-	0x8f, 0xb2, 0x3f, 0x87, 0xe1, 0x85, 0xc0, 0xe9, 0xe0, 0x7d, 0x3a, 0xe7, 0x8e, 0x33, 0x05, 0xe1,
-	0x5f, 0xe4, 0xf0, 0x39, 0xba, 0x9c, 0x02, 0xde, 0x2f, 0xfb, 0x67, 0x04, 0x06, 0x5d, 0x93, 0x41,
-	0x5f, 0x88, 0x4e, 0x1d, 0x70, 0x43, 0xd2, 0x74, 0xa7, 0x30, 0xa4, 0x52, 0x39, 0xd5, 0x1c, 0x9d,
-	0x09, 0x51, 0xb9, 0xa7, 0xb7, 0xba, 0xef, 0x39, 0xda, 0x9b, 0xf4, 0x31, 0x81, 0x8b, 0xd1, 0x76,
-	0x97, 0xae, 0x24, 0xe7, 0x8c, 0xf4, 0xef, 0xd2, 0xea, 0xf1, 0x26, 0x21, 0xf6, 0x2b, 0x1c, 0x7b,
-	0x8d, 0xae, 0xc6, 0x62, 0xb7, 0x9b, 0x00, 0x0f, 0x01, 0xcf, 0xfb, 0xff, 0x98, 0xc0, 0xf9, 0x08,
-	0x57, 0x4a, 0x97, 0xa3, 0x59, 0xe2, 0x4d, 0xb3, 0x94, 0x3d, 0xc6, 0x0c, 0x44, 0x7f, 0x9d, 0xa3,
-	0xbf, 0x46, 0x6f, 0x84, 0xd0, 0x1d, 0x9f, 0xe3, 0x50, 0xb7, 0xf4, 0xe6, 0x5e, 0xcc, 0xaf, 0xbf,
-	0xba, 0xcf, 0x1f, 0x36, 0xe9, 0x23, 0x02, 0x23, 0x01, 0x03, 0x1a, 0x77, 0xd4, 0x46, 0x1b, 0xe6,
-	0xb8, 0xa3, 0x36, 0xc6, 0xd5, 0x26, 0xf4, 0x2f, 0xf7, 0x67, 0x5e, 0xf0, 0x40, 0xcb, 0x7c, 0x4d,
-	0xe0, 0xb4, 0xd7, 0xff, 0xc5, 0x1d, 0xb7, 0x11, 0xc6, 0x35, 0xee, 0xb8, 0x8d, 0xb2, 0x93, 0x09,
-	0xbd, 0xdc, 0x22, 0x44, 0x45, 0x51, 0xc3, 0x87, 0x04, 0xce, 0xfa, 0x9d, 0x16, 0xed, 0x70, 0x82,
-	0xfa, 0xac, 0xa2, 0xb4, 0x98, 0x2e, 0x18, 0xf1, 0x56, 0x38, 0xde, 0x12, 0x5d, 0x48, 0x38, 0x6f,
-	0xc5, 0x17, 0xc1, 0xd3, 0xaa, 0x07, 0x04, 0x86, 0x3d, 0xfe, 0x87, 0xce, 0x46, 0xa7, 0x0c, 0x7b,
-	0x32, 0x69, 0x2e, 0x45, 0x24, 0x92, 0xad, 0x71, 0xb2, 0x65, 0xaa, 0xc4, 0xbf, 0x4d, 0x81, 0x2e,
-	0xe4, 0xd6, 0x8b, 0xda, 0xd0, 0x2f, 0xf6, 0x4a, 0xaf, 0x24, 0x29, 0xe1, 0x12, 0x5d, 0x4d, 0x0e,
-	0x42, 0x98, 0x49, 0x0e, 0x33, 0x4e, 0x2f, 0xc5, 0xc8, 0x94, 0x7f, 0xf5, 0xc9, 0x61, 0x86, 0x3c,
-	0x3d, 0xcc, 0x90, 0x3f, 0x0f, 0x33, 0xe4, 0x8b, 0xa3, 0x4c, 0xcf, 0xd3, 0xa3, 0x4c, 0xcf, 0xef,
-	0x47, 0x99, 0x9e, 0xf7, 0xae, 0x96, 0x0d, 0xfb, 0x83, 0xdd, 0xa2, 0xb2, 0xcd, 0xaa, 0xee, 0x64,
-	0xf1, 0xd7, 0x92, 0x55, 0xfa, 0x50, 0xfd, 0x48, 0x2c, 0x50, 0xec, 0xe7, 0xff, 0xe9, 0xbd, 0xf2,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x22, 0xb3, 0x86, 0xf8, 0xcf, 0x17, 0x00, 0x00,
+	// 1437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xdf, 0x6f, 0x14, 0x55,
+	0x14, 0xc7, 0x7b, 0x91, 0x5f, 0x3d, 0x05, 0x0a, 0x97, 0x16, 0xb6, 0x43, 0x6d, 0xeb, 0x80, 0xa5,
+	0x3f, 0xe8, 0x4c, 0x77, 0x5b, 0xc0, 0xf0, 0xcb, 0xb0, 0x11, 0x91, 0x18, 0x0c, 0xac, 0xc4, 0x44,
+	0x63, 0xd2, 0x4c, 0xbb, 0xc3, 0x3a, 0x61, 0x77, 0x66, 0xbb, 0x33, 0xdb, 0xb0, 0x21, 0xfb, 0x62,
+	0xa2, 0x26, 0xc6, 0x07, 0x05, 0x63, 0xb0, 0xf1, 0x81, 0x07, 0x13, 0x79, 0xf4, 0x41, 0x63, 0xe2,
+	0x1b, 0x89, 0x0f, 0x3c, 0x12, 0x7d, 0xf1, 0x89, 0x18, 0x30, 0xf1, 0xdf, 0x30, 0x73, 0xef, 0x99,
+	0xdd, 0xf9, 0x71, 0xe7, 0x47, 0x75, 0xa3, 0xfb, 0x02, 0xbb, 0xf7, 0x9e, 0x7b, 0xcf, 0xe7, 0x7e,
+	0xcf, 0x99, 0x3b, 0xe7, 0x6c, 0xe1, 0xc8, 0x9a, 0x65, 0xd7, 0x2c, 0x5b, 0xad, 0x34, 0xac, 0x66,
+	0x5d, 0xdd, 0xc8, 0xab, 0xeb, 0x4d, 0xbd, 0xd1, 0x52, 0xea, 0x0d, 0xcb, 0xb1, 0xe8, 0x30, 0x9f,
+	0x54, 0xd8, 0xa4, 0xb2, 0x91, 0x97, 0x46, 0x2a, 0x56, 0xc5, 0x62, 0x73, 0xaa, 0xfb, 0x89, 0x9b,
+	0x49, 0xe3, 0x15, 0xcb, 0xaa, 0x54, 0x75, 0x55, 0xab, 0x1b, 0xaa, 0x66, 0x9a, 0x96, 0xa3, 0x39,
+	0x86, 0x65, 0xda, 0x38, 0x1b, 0xf1, 0xe0, 0xb4, 0xea, 0xba, 0x37, 0x39, 0x87, 0x93, 0xab, 0x9a,
+	0xad, 0x73, 0xd7, 0xea, 0x46, 0x7e, 0x55, 0x77, 0xb4, 0xbc, 0x5a, 0xd7, 0x2a, 0x86, 0xc9, 0x76,
+	0x42, 0xdb, 0x31, 0x6e, 0xbb, 0xc2, 0xfd, 0x23, 0x1a, 0x9f, 0x3a, 0xa0, 0xd5, 0x0c, 0xd3, 0x52,
+	0xd9, 0xbf, 0x7c, 0x48, 0x2e, 0xc0, 0xe8, 0x75, 0x77, 0xbf, 0xcb, 0xae, 0xdb, 0x2b, 0xe6, 0x4d,
+	0xab, 0xa4, 0xaf, 0x37, 0x75, 0xdb, 0xa1, 0x63, 0xb0, 0x9b, 0xa1, 0xac, 0x18, 0xe5, 0x1c, 0x99,
+	0x22, 0x33, 0xdb, 0x4b, 0xbb, 0xd8, 0xf7, 0x2b, 0x65, 0xf9, 0x0d, 0x38, 0x14, 0x5e, 0x63, 0xd7,
+	0x2d, 0xd3, 0xd6, 0xa9, 0x02, 0xdb, 0x0d, 0xf3, 0xa6, 0xc5, 0x16, 0x0c, 0x15, 0x24, 0x25, 0x24,
+	0x8c, 0xd2, 0x5d, 0xc1, 0xec, 0xe4, 0xeb, 0x70, 0xa4, 0xbb, 0xd3, 0x35, 0xab, 0x6a, 0xac, 0xb5,
+	0xfc, 0x0c, 0x05, 0xd8, 0xa5, 0x95, 0xcb, 0x0d, 0xdd, 0xb6, 0xd9, 0x8e, 0x83, 0xc5, 0xdc, 0xaf,
+	0x3f, 0x2c, 0x8c, 0xe0, 0xa6, 0x17, 0xf9, 0xcc, 0xdb, 0x4e, 0xc3, 0x30, 0x2b, 0x25, 0xcf, 0x50,
+	0xbe, 0x01, 0xe3, 0xe2, 0x2d, 0x11, 0x71, 0x39, 0x80, 0x38, 0x25, 0x46, 0xf4, 0xad, 0xe3, 0xa0,
+	0x6d, 0xc8, 0x75, 0x77, 0xbd, 0xaa, 0xd7, 0x56, 0xf5, 0x86, 0x9d, 0xae, 0x14, 0x7d, 0x1d, 0xa0,
+	0x1b, 0x9f, 0xdc, 0x36, 0xe6, 0x72, 0xda, 0x73, 0xe9, 0x06, 0x53, 0xe1, 0x79, 0x84, 0xc1, 0x54,
+	0xae, 0x69, 0x15, 0x1d, 0xb7, 0x2d, 0xf9, 0x56, 0xca, 0xdf, 0x10, 0x18, 0x13, 0xf8, 0xc7, 0x23,
+	0x9d, 0x82, 0x5d, 0x35, 0x3e, 0x94, 0x23, 0x53, 0x2f, 0xcc, 0x0c, 0x15, 0xc6, 0xc5, 0xa7, 0xe2,
+	0xeb, 0x4a, 0x9e, 0x31, 0xbd, 0x2c, 0xa0, 0x3b, 0x9e, 0x4a, 0xc7, 0x9d, 0x06, 0xf0, 0xee, 0x05,
+	0xf0, 0xec, 0x62, 0xeb, 0x62, 0xb9, 0x66, 0x98, 0x9e, 0x3e, 0x0a, 0xec, 0xd0, 0xdc, 0xef, 0xa9,
+	0x31, 0xe4, 0x66, 0x3d, 0x13, 0xed, 0x6b, 0x02, 0x92, 0x88, 0x0a, 0x55, 0x2b, 0xc0, 0x4e, 0x26,
+	0x8f, 0x27, 0x5a, 0x52, 0xb6, 0xa2, 0x65, 0xef, 0x14, 0xfb, 0x88, 0xc0, 0x54, 0x28, 0x4d, 0x0d,
+	0xdd, 0x2e, 0xf2, 0xaf, 0xff, 0x61, 0x62, 0xfd, 0x48, 0xe0, 0xa5, 0x04, 0x0e, 0x94, 0xea, 0x32,
+	0xec, 0xe3, 0x20, 0x75, 0x34, 0x40, 0xc9, 0xd2, 0x9f, 0x9e, 0xbd, 0x15, 0xff, 0xbe, 0xbd, 0xd3,
+	0x6f, 0x33, 0x46, 0xbf, 0xbe, 0x48, 0xbc, 0x38, 0x51, 0x83, 0xf9, 0xd7, 0x7f, 0xa2, 0x9e, 0x86,
+	0x11, 0x86, 0x7d, 0xad, 0x61, 0xd5, 0x2d, 0x5b, 0xab, 0x7a, 0x3a, 0x4e, 0xc2, 0x50, 0x1d, 0x87,
+	0xba, 0xa9, 0x08, 0xde, 0xd0, 0x95, 0xb2, 0xfc, 0x16, 0x8c, 0x86, 0x16, 0xe2, 0x19, 0x4f, 0xc2,
+	0x6e, 0xcf, 0x0c, 0x2f, 0xdc, 0xb1, 0xc8, 0xe9, 0x3a, 0x8b, 0x3a, 0xa6, 0xf2, 0x03, 0x02, 0x72,
+	0x60, 0x43, 0x2f, 0x23, 0xb9, 0x08, 0xff, 0xe2, 0xf5, 0xd0, 0xb3, 0x18, 0x7f, 0x47, 0xe0, 0x68,
+	0x22, 0x22, 0x2a, 0x70, 0x1a, 0x06, 0xbd, 0x63, 0x79, 0x01, 0x4e, 0x90, 0xa0, 0x6b, 0xdb, 0xbb,
+	0xa8, 0x36, 0x60, 0x92, 0x81, 0xbe, 0x63, 0x39, 0x7a, 0xb1, 0x83, 0xeb, 0x7e, 0x6b, 0x64, 0x0d,
+	0xb0, 0xfb, 0x24, 0x6d, 0xb8, 0x0b, 0x72, 0xdb, 0x52, 0x74, 0xe6, 0x66, 0xf2, 0x55, 0x7c, 0x3a,
+	0x85, 0x3e, 0x51, 0x99, 0x59, 0xd8, 0xee, 0x1a, 0x63, 0x5e, 0x8c, 0x46, 0x44, 0x71, 0xad, 0x4b,
+	0xcc, 0x44, 0xfe, 0x98, 0x60, 0x9d, 0xe0, 0x8e, 0xd9, 0xc5, 0x2d, 0x27, 0x68, 0xcf, 0xa2, 0xfe,
+	0x25, 0x81, 0x71, 0x31, 0x08, 0x1e, 0x6a, 0x9e, 0x0b, 0xe5, 0x85, 0x3a, 0xe6, 0x54, 0xdc, 0xa6,
+	0x77, 0x21, 0xbe, 0x4b, 0xb0, 0x3c, 0x41, 0xac, 0x40, 0x70, 0x3b, 0xb1, 0x23, 0x99, 0x62, 0xd7,
+	0x33, 0xad, 0xbe, 0xf0, 0x8a, 0x82, 0x20, 0xd4, 0xff, 0x2a, 0xd4, 0xfd, 0x70, 0x49, 0x80, 0x25,
+	0x51, 0x1f, 0x5c, 0x28, 0x9b, 0x04, 0x8e, 0x08, 0xd1, 0xfa, 0xa1, 0x5c, 0x39, 0x03, 0x87, 0x19,
+	0xdb, 0x0d, 0xad, 0x5a, 0x75, 0xef, 0xb6, 0x66, 0xd5, 0xc9, 0xfc, 0x72, 0x78, 0x17, 0x72, 0xd1,
+	0xb5, 0x78, 0xa8, 0xf3, 0xb0, 0xc3, 0x71, 0x87, 0xf1, 0x12, 0x88, 0xd6, 0xad, 0xbe, 0x45, 0xc5,
+	0xc1, 0xc7, 0x4f, 0x27, 0x07, 0x1e, 0xfe, 0xf5, 0xfd, 0x1c, 0x29, 0xf1, 0x55, 0xf2, 0xfb, 0x40,
+	0x7d, 0x92, 0x79, 0x44, 0xbd, 0x8a, 0xc8, 0x5d, 0x02, 0x07, 0x03, 0xdb, 0xf7, 0x43, 0x24, 0x2e,
+	0x60, 0x96, 0x5c, 0x6f, 0xea, 0x4d, 0xbd, 0x7c, 0xe9, 0xb6, 0xbe, 0xd6, 0x74, 0xc7, 0x33, 0x47,
+	0xe3, 0x16, 0x8c, 0x8b, 0xd7, 0xe3, 0xe1, 0xde, 0x84, 0xfd, 0xeb, 0x6c, 0x6a, 0x45, 0xf7, 0xe6,
+	0x62, 0x5b, 0xa5, 0xf0, 0x1e, 0xc3, 0xeb, 0xc1, 0x01, 0xf7, 0x25, 0x39, 0x2b, 0xf2, 0xd6, 0x7f,
+	0xaf, 0xf3, 0x5f, 0x08, 0xcc, 0x65, 0x21, 0x45, 0x95, 0xae, 0xc2, 0x81, 0xb0, 0x4a, 0xf1, 0xe5,
+	0x5b, 0x58, 0xa6, 0xfd, 0x21, 0x99, 0x7a, 0x97, 0x1d, 0x85, 0x4f, 0x46, 0x61, 0x07, 0x3b, 0x06,
+	0xfd, 0x8c, 0xc0, 0x60, 0x27, 0x0d, 0xe9, 0xb4, 0x08, 0x2a, 0xda, 0xf4, 0x4b, 0xc7, 0x53, 0xed,
+	0xb8, 0x53, 0x59, 0xf9, 0xf0, 0xb7, 0x3f, 0xef, 0x6d, 0x9b, 0xa1, 0xd3, 0x6a, 0xf8, 0x67, 0x0b,
+	0xec, 0x58, 0xcc, 0x9b, 0x96, 0x7a, 0x07, 0x3f, 0x97, 0xdb, 0xf4, 0x5b, 0x02, 0xc3, 0xa1, 0x32,
+	0x96, 0x9e, 0x48, 0x70, 0x16, 0xf9, 0x2d, 0x40, 0x5a, 0xc8, 0x68, 0x8d, 0x80, 0xcb, 0x0c, 0x50,
+	0xa1, 0x27, 0x62, 0x00, 0x59, 0xd1, 0xdd, 0x42, 0x4e, 0xcc, 0xa6, 0x36, 0xbd, 0x4f, 0x60, 0x8f,
+	0xbf, 0xc5, 0xa6, 0xb3, 0x09, 0x5e, 0x83, 0x3f, 0x03, 0x48, 0x73, 0x59, 0x4c, 0x91, 0x2e, 0xcf,
+	0xe8, 0xe6, 0xe9, 0x6c, 0x0c, 0x1d, 0x76, 0xe8, 0x7e, 0x05, 0x37, 0x09, 0xec, 0x0d, 0x34, 0xb2,
+	0x34, 0xc9, 0x61, 0xa8, 0x15, 0x92, 0xe6, 0x33, 0xd9, 0x22, 0xdd, 0x22, 0xa3, 0x9b, 0xa3, 0x33,
+	0x62, 0x3a, 0x7b, 0x65, 0xb5, 0xb5, 0xc2, 0x3a, 0x26, 0x57, 0xb9, 0x9a, 0x61, 0xb6, 0xe9, 0xcf,
+	0x04, 0x46, 0x44, 0x1d, 0x24, 0xcd, 0xa7, 0x45, 0x2d, 0xd2, 0xf5, 0x4a, 0x85, 0xad, 0x2c, 0x41,
+	0xe2, 0xb3, 0x8c, 0xf8, 0x24, 0x5d, 0x4a, 0x8a, 0xb6, 0xa1, 0x33, 0x72, 0x3e, 0xe5, 0x53, 0xf6,
+	0xa7, 0x28, 0x3c, 0x17, 0x38, 0x1b, 0x7c, 0x40, 0xe7, 0xc2, 0x56, 0x96, 0x20, 0xfc, 0x2b, 0x0c,
+	0xbe, 0x40, 0x17, 0x33, 0xc0, 0x07, 0x65, 0xff, 0x94, 0xc0, 0x6e, 0xaf, 0x04, 0xa5, 0x2f, 0x8b,
+	0x5d, 0x87, 0x6a, 0x65, 0x69, 0x3a, 0xcd, 0x0c, 0xa9, 0x54, 0x46, 0x35, 0x4b, 0x8f, 0x47, 0xa8,
+	0xbc, 0xb7, 0x89, 0x7a, 0xc7, 0xf7, 0xaa, 0x69, 0xd3, 0x47, 0x04, 0x0e, 0x89, 0x9b, 0x21, 0xba,
+	0x94, 0xec, 0x53, 0xf8, 0x3a, 0x90, 0x96, 0xb7, 0xb6, 0x08, 0xb1, 0xcf, 0x31, 0xec, 0x53, 0x74,
+	0x39, 0x16, 0xbb, 0x9b, 0x04, 0x78, 0x09, 0xf8, 0x9e, 0xff, 0x47, 0x04, 0x0e, 0x0a, 0x7a, 0x16,
+	0xba, 0x28, 0x66, 0x89, 0x6f, 0xa9, 0xa4, 0xfc, 0x16, 0x56, 0x20, 0xfa, 0x25, 0x86, 0xfe, 0x2a,
+	0x3d, 0x1f, 0x41, 0x77, 0xab, 0x60, 0x97, 0xba, 0xa3, 0xb7, 0x3b, 0xd0, 0x08, 0xea, 0xaf, 0xde,
+	0x61, 0x83, 0x6d, 0xfa, 0x90, 0xc0, 0x70, 0xa8, 0x3d, 0x89, 0xbb, 0x6a, 0xc5, 0xed, 0x94, 0xb4,
+	0x90, 0xd1, 0x3a, 0x35, 0x7f, 0x5d, 0x22, 0xdb, 0x0f, 0x1e, 0x4a, 0x99, 0xaf, 0x08, 0xec, 0xf1,
+	0x77, 0x07, 0x71, 0xd7, 0xad, 0xa0, 0xad, 0x89, 0xbb, 0x6e, 0x45, 0xcd, 0x46, 0x42, 0x2e, 0x77,
+	0x08, 0x51, 0x51, 0xd4, 0xf0, 0x01, 0x81, 0x7d, 0xc1, 0x3a, 0x9c, 0xa6, 0xdc, 0xa0, 0x81, 0x46,
+	0x42, 0x3a, 0x91, 0xcd, 0x18, 0xf1, 0x96, 0x18, 0xde, 0x02, 0x9d, 0x4f, 0xb8, 0x6f, 0xf9, 0x1b,
+	0xc1, 0x97, 0xaa, 0x9b, 0x04, 0x86, 0x7c, 0xd5, 0x31, 0x9d, 0x11, 0xbb, 0x8c, 0x56, 0xec, 0xd2,
+	0x6c, 0x06, 0x4b, 0x24, 0x3b, 0xc5, 0xc8, 0x16, 0xa9, 0x12, 0xff, 0x34, 0x85, 0xb2, 0x90, 0x15,
+	0xe6, 0xd4, 0x81, 0x9d, 0xfc, 0xac, 0xf4, 0x68, 0x92, 0x12, 0x1e, 0xd1, 0xb1, 0x64, 0x23, 0x84,
+	0x99, 0x64, 0x30, 0x63, 0xf4, 0x70, 0x8c, 0x4c, 0x2c, 0xf3, 0x43, 0xc5, 0x56, 0x5c, 0xe6, 0x8b,
+	0xcb, 0x67, 0x69, 0x21, 0xa3, 0x75, 0x6a, 0xe6, 0x47, 0xaa, 0xc3, 0x50, 0xe6, 0x3f, 0x25, 0xf0,
+	0x62, 0x62, 0xa9, 0x49, 0xcf, 0x64, 0x42, 0x11, 0x5f, 0x9d, 0x67, 0xff, 0xd1, 0x5a, 0x3c, 0xd4,
+	0x6b, 0xec, 0x50, 0x17, 0xe8, 0xb9, 0xf4, 0x43, 0xc5, 0xdf, 0xa4, 0xc5, 0x0b, 0x8f, 0x9f, 0x4d,
+	0x90, 0x27, 0xcf, 0x26, 0xc8, 0x1f, 0xcf, 0x26, 0xc8, 0xe7, 0xcf, 0x27, 0x06, 0x9e, 0x3c, 0x9f,
+	0x18, 0xf8, 0xfd, 0xf9, 0xc4, 0xc0, 0x7b, 0xc7, 0x2a, 0x86, 0xf3, 0x41, 0x73, 0x55, 0x59, 0xb3,
+	0x6a, 0x9e, 0x07, 0xfe, 0xdf, 0x82, 0x5d, 0xbe, 0xa5, 0xde, 0xe6, 0xee, 0x56, 0x77, 0xb2, 0x3f,
+	0x4f, 0x2d, 0xfd, 0x3d, 0x00, 0x6e, 0x13, 0x4f, 0x30, 0x79, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1651,6 +1873,12 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// QueuedExecution queries an accepted proposal queued for execution at the
+	// end of its timelock.
+	QueuedExecution(ctx context.Context, in *QueryQueuedExecutionRequest, opts ...grpc.CallOption) (*QueryQueuedExecutionResponse, error)
+	// QueuedExecutionsByGroupPolicy queries the accepted proposals of a group
+	// policy queued for execution at the end of their timelock.
+	QueuedExecutionsByGroupPolicy(ctx context.Context, in *QueryQueuedExecutionsByGroupPolicyRequest, opts ...grpc.CallOption) (*QueryQueuedExecutionsByGroupPolicyResponse, error)
 }
 
 type queryClient struct {
@@ -1787,6 +2015,24 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) QueuedExecution(ctx context.Context, in *QueryQueuedExecutionRequest, opts ...grpc.CallOption) (*QueryQueuedExecutionResponse, error) {
+	out := new(QueryQueuedExecutionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/QueuedExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedExecutionsByGroupPolicy(ctx context.Context, in *QueryQueuedExecutionsByGroupPolicyRequest, opts ...grpc.CallOption) (*QueryQueuedExecutionsByGroupPolicyResponse, error) {
+	out := new(QueryQueuedExecutionsByGroupPolicyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/QueuedExecutionsByGroupPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GroupInfo queries group info based on group id.
@@ -1823,6 +2069,12 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// QueuedExecution queries an accepted proposal queued for execution at the
+	// end of its timelock.
+	QueuedExecution(context.Context, *QueryQueuedExecutionRequest) (*QueryQueuedExecutionResponse, error)
+	// QueuedExecutionsByGroupPolicy queries the accepted proposals of a group
+	// policy queued for execution at the end of their timelock.
+	QueuedExecutionsByGroupPolicy(context.Context, *QueryQueuedExecutionsByGroupPolicyRequest) (*QueryQueuedExecutionsByGroupPolicyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1871,6 +2123,12 @@ func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTall
 func (*UnimplementedQueryServer) Groups(ctx context.Context, req *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (*UnimplementedQueryServer) QueuedExecution(ctx context.Context, req *QueryQueuedExecutionRequest) (*QueryQueuedExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedExecution not implemented")
+}
+func (*UnimplementedQueryServer) QueuedExecutionsByGroupPolicy(ctx context.Context, req *QueryQueuedExecutionsByGroupPolicyRequest) (*QueryQueuedExecutionsByGroupPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedExecutionsByGroupPolicy not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2128,6 +2386,42 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueuedExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/QueuedExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedExecution(ctx, req.(*QueryQueuedExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedExecutionsByGroupPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedExecutionsByGroupPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedExecutionsByGroupPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/QueuedExecutionsByGroupPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedExecutionsByGroupPolicy(ctx, req.(*QueryQueuedExecutionsByGroupPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2188,6 +2482,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "QueuedExecution",
+			Handler:    _Query_QueuedExecution_Handler,
+		},
+		{
+			MethodName: "QueuedExecutionsByGroupPolicy",
+			Handler:    _Query_QueuedExecutionsByGroupPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -3321,78 +3623,232 @@ func (m *QueryGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryQueuedExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryGroupInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovQuery(uint64(m.GroupId))
-	}
-	return n
+
+func (m *QueryQueuedExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryQueuedExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGroupPolicyInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryQueuedExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGroupPolicyInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryQueuedExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGroupMembersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryQueuedExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GroupId != 0 {
-		n += 1 + sovQuery(uint64(m.GroupId))
-	}
-	if m.Pagination != nil {
+	if m.QueuedExecution != nil {
+		{
+			size, err := m.QueuedExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedExecutionsByGroupPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedExecutionsByGroupPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedExecutionsByGroupPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedExecutionsByGroupPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedExecutionsByGroupPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedExecutionsByGroupPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QueuedExecutions) > 0 {
+		for iNdEx := len(m.QueuedExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGroupInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *QueryGroupInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupPolicyInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupPolicyInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGroupMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		n += 1 + sovQuery(uint64(m.GroupId))
+	}
+	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
@@ -3777,6 +4233,67 @@ func (m *QueryGroupsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryQueuedExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryQueuedExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueuedExecution != nil {
+		l = m.QueuedExecution.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedExecutionsByGroupPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedExecutionsByGroupPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueuedExecutions) > 0 {
+		for _, e := range m.QueuedExecutions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6671,6 +7188,399 @@ func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryQueuedExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueuedExecution == nil {
+				m.QueuedExecution = &QueuedExecution{}
+			}
+			if err := m.QueuedExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedExecutionsByGroupPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedExecutionsByGroupPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedExecutionsByGroupPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueuedExecutionsByGroupPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedExecutionsByGroupPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedExecutionsByGroupPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedExecutions = append(m.QueuedExecutions, &QueuedExecution{})
+			if err := m.QueuedExecutions[len(m.QueuedExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -24,3 +24,8 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 }
+
+// StakingKeeper extends `StakingKeeper` from expected_keepers.
+type StakingKeeper interface {
+	group.StakingKeeper
+}
@@ -1469,6 +1469,98 @@ func (m *MsgLeaveGroupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLeaveGroupResponse proto.InternalMessageInfo
 
+// MsgCancelProposal is the Msg/CancelProposal request type.
+type MsgCancelProposal struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// guardian is one of the guardians of the group policy of the proposal.
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+}
+
+func (m *MsgCancelProposal) Reset()         { *m = MsgCancelProposal{} }
+func (m *MsgCancelProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposal) ProtoMessage()    {}
+func (*MsgCancelProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{28}
+}
+func (m *MsgCancelProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposal.Merge(m, src)
+}
+func (m *MsgCancelProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposal proto.InternalMessageInfo
+
+func (m *MsgCancelProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgCancelProposal) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+// MsgCancelProposalResponse is the Msg/CancelProposal response type.
+type MsgCancelProposalResponse struct {
+}
+
+func (m *MsgCancelProposalResponse) Reset()         { *m = MsgCancelProposalResponse{} }
+func (m *MsgCancelProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposalResponse) ProtoMessage()    {}
+func (*MsgCancelProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{29}
+}
+func (m *MsgCancelProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposalResponse.Merge(m, src)
+}
+func (m *MsgCancelProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposalResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.Exec", Exec_name, Exec_value)
 	proto.RegisterType((*MsgCreateGroup)(nil), "cosmos.group.v1.MsgCreateGroup")
@@ -1499,103 +1591,108 @@ func init() {
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.group.v1.MsgExecResponse")
 	proto.RegisterType((*MsgLeaveGroup)(nil), "cosmos.group.v1.MsgLeaveGroup")
 	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "cosmos.group.v1.MsgLeaveGroupResponse")
+	proto.RegisterType((*MsgCancelProposal)(nil), "cosmos.group.v1.MsgCancelProposal")
+	proto.RegisterType((*MsgCancelProposalResponse)(nil), "cosmos.group.v1.MsgCancelProposalResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/tx.proto", fileDescriptor_6b8d3d629f136420) }
 
 var fileDescriptor_6b8d3d629f136420 = []byte{
-	// 1442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xda, 0xce, 0xaf, 0x97, 0x6f, 0xdd, 0x64, 0x9b, 0xb4, 0x9b, 0x6d, 0x6b, 0xbb, 0xd3,
-	0xb4, 0x49, 0xad, 0xc6, 0x6e, 0x9c, 0x6f, 0x2b, 0x61, 0x10, 0xa8, 0x49, 0x0d, 0x0a, 0xc2, 0x10,
-	0x6d, 0x5b, 0x0a, 0x5c, 0xcc, 0x26, 0xde, 0x6e, 0x57, 0x64, 0xbd, 0xc6, 0xb3, 0x4e, 0x93, 0x1b,
-	0x3f, 0x2e, 0x80, 0x90, 0x40, 0x82, 0x3f, 0x00, 0x6e, 0x1c, 0x8b, 0xd4, 0x03, 0x37, 0x6e, 0xa8,
-	0x2a, 0x97, 0x8a, 0x13, 0x27, 0x04, 0xad, 0x50, 0x6f, 0xfc, 0x0b, 0xa0, 0x9d, 0xd9, 0x1d, 0xef,
-	0x78, 0x77, 0xbd, 0x5b, 0xcb, 0x82, 0x4b, 0x94, 0x9d, 0xf7, 0x79, 0xbf, 0x3e, 0xef, 0xcd, 0x9b,
-	0x19, 0x83, 0xb4, 0x6b, 0x61, 0xd3, 0xc2, 0x65, 0xbd, 0x63, 0x75, 0xdb, 0xe5, 0xfd, 0xb5, 0xb2,
-	0x7d, 0x50, 0x6a, 0x77, 0x2c, 0xdb, 0x12, 0x8f, 0x52, 0x49, 0x89, 0x48, 0x4a, 0xfb, 0x6b, 0xf2,
-	0xbc, 0x6e, 0xe9, 0x16, 0x91, 0x95, 0x9d, 0xff, 0x28, 0x4c, 0x5e, 0xa4, 0xb0, 0x06, 0x15, 0xb8,
-	0x3a, 0xae, 0x48, 0xb7, 0x2c, 0x7d, 0x4f, 0x2b, 0x93, 0xaf, 0x9d, 0xee, 0xed, 0xb2, 0xda, 0x3a,
-	0x74, 0x45, 0x27, 0x03, 0x6e, 0x0f, 0xdb, 0x9a, 0xa7, 0x77, 0xc2, 0x15, 0x9a, 0x58, 0x77, 0x44,
-	0x26, 0xd6, 0x5d, 0xc1, 0x9c, 0x6a, 0x1a, 0x2d, 0xab, 0x4c, 0xfe, 0xd2, 0x25, 0xf4, 0xb3, 0x00,
-	0xd9, 0x3a, 0xd6, 0x37, 0x3b, 0x9a, 0x6a, 0x6b, 0xaf, 0x38, 0xd6, 0xc4, 0x12, 0x8c, 0xab, 0x4d,
-	0xd3, 0x68, 0x49, 0x42, 0x41, 0x58, 0x99, 0xde, 0x90, 0x7e, 0xb9, 0xbf, 0x3a, 0xef, 0xc6, 0x75,
-	0xb5, 0xd9, 0xec, 0x68, 0x18, 0x5f, 0xb7, 0x3b, 0x46, 0x4b, 0x57, 0x28, 0x4c, 0xdc, 0x84, 0x49,
-	0x53, 0x33, 0x77, 0xb4, 0x0e, 0x96, 0x52, 0x85, 0xf4, 0xca, 0x4c, 0x25, 0x57, 0xea, 0x4b, 0xbd,
-	0x54, 0x27, 0x72, 0x45, 0x7b, 0xbf, 0xab, 0x61, 0x7b, 0x63, 0xfa, 0xc1, 0x6f, 0xf9, 0xb1, 0xef,
-	0x9e, 0xde, 0x2b, 0x0a, 0x8a, 0xa7, 0x29, 0xca, 0x30, 0x65, 0x6a, 0xb6, 0xda, 0x54, 0x6d, 0x55,
-	0x4a, 0x3b, 0x7e, 0x15, 0xf6, 0x5d, 0x5d, 0xf9, 0xe8, 0xe9, 0xbd, 0x22, 0x75, 0xf6, 0xd9, 0xd3,
-	0x7b, 0x45, 0x97, 0xb1, 0x55, 0xdc, 0x7c, 0xaf, 0xcc, 0x87, 0x8e, 0xd6, 0xe1, 0x38, 0xbf, 0xa2,
-	0x68, 0xb8, 0x6d, 0xb5, 0xb0, 0x26, 0x2e, 0xc2, 0x14, 0x89, 0xa6, 0x61, 0x34, 0x49, 0x5e, 0x19,
-	0x65, 0x92, 0x7c, 0x6f, 0x35, 0xd1, 0x9f, 0x02, 0x2c, 0xd4, 0xb1, 0x7e, 0xb3, 0xdd, 0xf4, 0xb4,
-	0xea, 0x6e, 0x50, 0xcf, 0xca, 0x84, 0xdf, 0x49, 0x8a, 0x73, 0x22, 0x6e, 0x43, 0x96, 0xa6, 0xda,
-	0xe8, 0x12, 0x3f, 0x58, 0x4a, 0x3f, 0x2b, 0x57, 0x47, 0xa8, 0x01, 0x1a, 0x27, 0xae, 0x96, 0x79,
-	0x56, 0x0a, 0x3c, 0x2b, 0xc1, 0x6c, 0x50, 0x1e, 0x4e, 0x87, 0x0a, 0x3c, 0x8e, 0xd0, 0x4f, 0x02,
-	0x1c, 0xe3, 0x11, 0x57, 0x49, 0x5a, 0x23, 0xa4, 0xe1, 0x32, 0x4c, 0xb7, 0xb4, 0xbb, 0x0d, 0x6a,
-	0x2e, 0x1d, 0x63, 0x6e, 0xaa, 0xa5, 0xdd, 0x25, 0x11, 0x54, 0x57, 0xf9, 0x5c, 0x73, 0x91, 0xb9,
-	0x12, 0x38, 0x3a, 0x0d, 0x27, 0x43, 0x96, 0x59, 0x9e, 0xdf, 0x0b, 0xa4, 0x4d, 0x38, 0x26, 0x68,
-	0xab, 0x8d, 0x32, 0xd5, 0x41, 0x1d, 0x7d, 0x89, 0xcf, 0xe7, 0xcc, 0x80, 0xda, 0x51, 0x0d, 0x54,
-	0x80, 0x5c, 0xb8, 0x84, 0x65, 0xf5, 0x75, 0x0a, 0xe6, 0xf9, 0xe6, 0xdf, 0xb6, 0xf6, 0x8c, 0xdd,
-	0xc3, 0x7f, 0x29, 0x27, 0x51, 0x85, 0xa3, 0x4d, 0x6d, 0xd7, 0xc0, 0x86, 0xd5, 0x6a, 0xb4, 0x89,
-	0x67, 0x29, 0x53, 0x10, 0x56, 0x66, 0x2a, 0xf3, 0x25, 0x3a, 0xc7, 0x4a, 0xde, 0x1c, 0x2b, 0x5d,
-	0x6d, 0x1d, 0x6e, 0xa0, 0x87, 0xf7, 0x57, 0x73, 0xfd, 0xbd, 0x7f, 0xcd, 0x35, 0x40, 0x23, 0x57,
-	0xb2, 0x4d, 0xee, 0xbb, 0x5a, 0xf9, 0xe4, 0x9b, 0xfc, 0x18, 0x4f, 0x5d, 0x3e, 0x72, 0x18, 0x50,
-	0x1d, 0xa4, 0xc0, 0xa9, 0xb0, 0x75, 0x36, 0x18, 0x2a, 0x30, 0xa9, 0x52, 0x16, 0x62, 0xf9, 0xf1,
-	0x80, 0xe8, 0xe3, 0x14, 0x2c, 0xf2, 0xd5, 0xa0, 0x46, 0x87, 0xdb, 0x2e, 0xaf, 0xc2, 0x3c, 0xe5,
-	0x9b, 0xb2, 0xd6, 0xf0, 0xc2, 0x49, 0xc5, 0xa8, 0x8b, 0xba, 0xdf, 0x33, 0x91, 0x0c, 0xbb, 0xbf,
-	0xd6, 0x79, 0x52, 0x97, 0x22, 0xfb, 0xd1, 0x97, 0x27, 0x3a, 0x0b, 0x67, 0x22, 0x85, 0xac, 0x2b,
-	0x7f, 0x48, 0x83, 0xc4, 0xf3, 0x7f, 0xcb, 0xb0, 0xef, 0x0c, 0xd9, 0x99, 0x23, 0x39, 0x69, 0xce,
-	0x41, 0x96, 0xd2, 0xdd, 0xd7, 0xc9, 0x47, 0x74, 0x6e, 0x12, 0x54, 0x60, 0x81, 0xab, 0x0a, 0x43,
-	0x67, 0x08, 0xfa, 0x98, 0x8f, 0x7c, 0xa6, 0xb3, 0xd6, 0xa7, 0xa3, 0x62, 0xb7, 0x12, 0xe3, 0x05,
-	0x61, 0x65, 0x8a, 0x2f, 0x18, 0xa6, 0xcd, 0x12, 0xb2, 0x6b, 0x26, 0x46, 0xbc, 0x6b, 0xae, 0x04,
-	0x77, 0xcd, 0xd9, 0xc8, 0x5d, 0xd3, 0xab, 0x0e, 0xfa, 0x54, 0x80, 0x42, 0x94, 0x30, 0xc1, 0xb9,
-	0x3a, 0xca, 0xbe, 0x46, 0x3f, 0xa6, 0x00, 0x85, 0x35, 0x1b, 0x9f, 0xfa, 0x7f, 0xba, 0xf5, 0x42,
-	0x2a, 0x99, 0x1e, 0x71, 0x25, 0xab, 0xc1, 0x4a, 0x2e, 0x47, 0x6e, 0x55, 0xde, 0x16, 0xba, 0x08,
-	0xc5, 0x78, 0x02, 0xd9, 0xb6, 0xfd, 0x4b, 0x20, 0x63, 0x33, 0x00, 0x1f, 0xfa, 0xa0, 0x1c, 0x25,
-	0xd3, 0x83, 0x4e, 0xd6, 0x2b, 0x49, 0xe9, 0xe1, 0xf3, 0x41, 0xe7, 0x61, 0x69, 0x90, 0x9c, 0x11,
-	0xf3, 0x47, 0x0a, 0xe6, 0xea, 0x58, 0xbf, 0xde, 0xdd, 0x31, 0x0d, 0x7b, 0xbb, 0x63, 0xb5, 0x2d,
-	0xac, 0xee, 0x45, 0x66, 0x27, 0x0c, 0x91, 0xdd, 0x29, 0x98, 0x6e, 0x13, 0xbb, 0xde, 0x98, 0x9b,
-	0x56, 0x7a, 0x0b, 0x03, 0x4f, 0xe0, 0x4b, 0x8e, 0x0c, 0x63, 0x55, 0xd7, 0xb0, 0x94, 0x21, 0xf3,
-	0x31, 0xb4, 0xf5, 0x14, 0x86, 0x12, 0x2f, 0x40, 0x46, 0x3b, 0xd0, 0x76, 0xc9, 0x7c, 0xca, 0x56,
-	0x16, 0x02, 0xd3, 0xb4, 0x76, 0xa0, 0xed, 0x2a, 0x04, 0x22, 0xce, 0xc3, 0xb8, 0x6d, 0xd8, 0x7b,
-	0x1a, 0x19, 0x4f, 0xd3, 0x0a, 0xfd, 0x10, 0x25, 0x98, 0xc4, 0x5d, 0xd3, 0x54, 0x3b, 0x87, 0xd2,
-	0x24, 0x59, 0xf7, 0x3e, 0xab, 0xcf, 0x79, 0xbd, 0xda, 0x0b, 0xde, 0x29, 0x08, 0xf2, 0x15, 0x84,
-	0x3e, 0x5e, 0x02, 0x6c, 0xa2, 0x17, 0xc8, 0xe9, 0xca, 0x2f, 0xb2, 0x81, 0x93, 0x87, 0x99, 0xb6,
-	0xbb, 0xd6, 0x9b, 0x39, 0xe0, 0x2d, 0x6d, 0x35, 0xd1, 0xb7, 0xf4, 0x16, 0xeb, 0xcc, 0xaa, 0x66,
-	0x47, 0xbd, 0xcb, 0x6a, 0x14, 0xa7, 0xe8, 0xbf, 0x09, 0xa4, 0x12, 0xde, 0x04, 0xaa, 0x97, 0x9d,
-	0x0c, 0xbd, 0xaf, 0xfe, 0xa3, 0x93, 0xe5, 0xd7, 0x1f, 0x8b, 0x7b, 0x41, 0xed, 0x5f, 0x66, 0x4d,
-	0xf6, 0xb7, 0x00, 0x93, 0x75, 0xac, 0xbf, 0x69, 0xd9, 0xf1, 0xf9, 0x3a, 0x3b, 0x71, 0xdf, 0xb2,
-	0xb5, 0x4e, 0x6c, 0xd0, 0x14, 0x26, 0xae, 0xc3, 0x84, 0xd5, 0xb6, 0x0d, 0x8b, 0xde, 0x0f, 0xb2,
-	0x95, 0x93, 0x81, 0xaa, 0x3b, 0x7e, 0xdf, 0x20, 0x10, 0xc5, 0x85, 0x72, 0x6d, 0x97, 0xe9, 0x6b,
-	0xbb, 0xe4, 0x4d, 0x54, 0x5d, 0x26, 0xbb, 0x93, 0xc4, 0xe1, 0x90, 0x25, 0x85, 0x91, 0xe5, 0x78,
-	0x47, 0x73, 0x70, 0xd4, 0xfd, 0x97, 0x91, 0xf2, 0x39, 0x25, 0xc5, 0xb1, 0x16, 0x4f, 0xca, 0xff,
-	0x61, 0xca, 0x71, 0xd8, 0xb5, 0xad, 0x78, 0x5e, 0x18, 0xb2, 0x5a, 0x74, 0xc2, 0x63, 0x9f, 0x91,
-	0x11, 0x3a, 0x21, 0x20, 0x85, 0x44, 0x48, 0x72, 0xf3, 0x5a, 0xf3, 0x25, 0x98, 0xe8, 0x68, 0xb8,
-	0xbb, 0x67, 0x13, 0x97, 0xd9, 0xca, 0x72, 0x80, 0x0a, 0xaf, 0xd2, 0x35, 0xd7, 0x85, 0x42, 0xe0,
-	0x8a, 0xab, 0x86, 0xbe, 0x10, 0xe0, 0x48, 0x1d, 0xeb, 0xaf, 0x69, 0xea, 0xbe, 0xfb, 0x16, 0x1f,
-	0xe2, 0x76, 0x3a, 0xe0, 0xfe, 0x4e, 0xdf, 0x8c, 0xfe, 0x76, 0xcd, 0x85, 0xe5, 0xd7, 0xf3, 0x8f,
-	0x4e, 0x90, 0xa7, 0x71, 0x6f, 0xc1, 0xcb, 0xb5, 0x58, 0x84, 0x4c, 0x8d, 0x8e, 0x85, 0xd9, 0xda,
-	0x5b, 0xb5, 0xcd, 0xc6, 0xcd, 0xd7, 0xaf, 0x6f, 0xd7, 0x36, 0xb7, 0x5e, 0xde, 0xaa, 0x5d, 0x9b,
-	0x1d, 0x13, 0xff, 0x07, 0x53, 0x64, 0xf5, 0x86, 0xf2, 0xf6, 0xac, 0x50, 0x79, 0x38, 0x03, 0xe9,
-	0x3a, 0xd6, 0xc5, 0x5b, 0x30, 0xe3, 0xff, 0x9d, 0x21, 0x1f, 0xbc, 0xbc, 0x71, 0xb7, 0x0d, 0x79,
-	0x39, 0x06, 0xc0, 0x88, 0xdf, 0x03, 0x31, 0xe4, 0xf5, 0x7e, 0x3e, 0x4c, 0x3d, 0x88, 0x93, 0x4b,
-	0xc9, 0x70, 0xcc, 0xdb, 0x6d, 0x98, 0x0d, 0x3c, 0x91, 0x97, 0x62, 0x6c, 0x10, 0x94, 0x7c, 0x31,
-	0x09, 0x8a, 0xf9, 0xb1, 0xe0, 0x58, 0xd8, 0x13, 0x75, 0x39, 0x36, 0x5c, 0x0a, 0x94, 0xcb, 0x09,
-	0x81, 0xcc, 0xa1, 0x01, 0x73, 0xc1, 0xd7, 0xe3, 0xb9, 0x98, 0x22, 0x50, 0x98, 0xbc, 0x9a, 0x08,
-	0xc6, 0x5c, 0x75, 0x61, 0x21, 0xfc, 0x49, 0x70, 0x21, 0xc6, 0x4e, 0x0f, 0x2a, 0xaf, 0x25, 0x86,
-	0x32, 0xb7, 0x07, 0x70, 0x3c, 0xe2, 0xd1, 0x56, 0x8c, 0x21, 0xcb, 0x87, 0x95, 0x2b, 0xc9, 0xb1,
-	0xcc, 0xf3, 0x57, 0x02, 0xe4, 0xe3, 0x6e, 0xaf, 0xeb, 0x89, 0xec, 0xf2, 0x4a, 0xf2, 0xf3, 0x43,
-	0x28, 0xb1, 0xa8, 0x3e, 0x14, 0x60, 0x31, 0xfa, 0x8e, 0xb7, 0x9a, 0xc8, 0x34, 0xeb, 0xb7, 0xcb,
-	0xcf, 0x04, 0x67, 0x31, 0xbc, 0x0b, 0xd9, 0xbe, 0xdb, 0x14, 0x0a, 0x33, 0xc4, 0x63, 0xe4, 0x62,
-	0x3c, 0xc6, 0xbf, 0x61, 0x03, 0xb7, 0x81, 0xd0, 0x0d, 0xdb, 0x8f, 0x0a, 0xdf, 0xb0, 0x51, 0xc7,
-	0xb6, 0xb8, 0x01, 0x19, 0x72, 0x64, 0x4b, 0x61, 0x5a, 0x8e, 0x44, 0x2e, 0x44, 0x49, 0xfc, 0x36,
-	0xc8, 0x5c, 0x0d, 0xb5, 0xe1, 0x48, 0xc2, 0x6d, 0x70, 0xe7, 0xd0, 0x0d, 0x00, 0xdf, 0x11, 0x92,
-	0x0b, 0xc3, 0xf7, 0xe4, 0xf2, 0xf9, 0xc1, 0x72, 0xcf, 0xaa, 0x3c, 0xfe, 0x81, 0xf3, 0x8e, 0xde,
-	0x78, 0xf1, 0xc1, 0xe3, 0x9c, 0xf0, 0xe8, 0x71, 0x4e, 0xf8, 0xfd, 0x71, 0x4e, 0xf8, 0xf2, 0x49,
-	0x6e, 0xec, 0xd1, 0x93, 0xdc, 0xd8, 0xaf, 0x4f, 0x72, 0x63, 0xef, 0x2c, 0xe9, 0x86, 0x7d, 0xa7,
-	0xbb, 0x53, 0xda, 0xb5, 0x4c, 0xf7, 0x77, 0xec, 0xb2, 0xef, 0x74, 0x39, 0xa0, 0xe7, 0xcb, 0xce,
-	0x04, 0xb9, 0x8a, 0xae, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x17, 0xcf, 0x29, 0x39, 0x17,
-	0x00, 0x00,
+	// 1491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0xce, 0xd7, 0x4b, 0xeb, 0x24, 0xdb, 0xa4, 0xdd, 0x6c, 0x5a, 0xdb, 0xdd, 0xa6,
+	0x4d, 0x6a, 0x35, 0x76, 0xe3, 0xb4, 0x95, 0x30, 0x08, 0xd4, 0xa4, 0x06, 0x05, 0x61, 0x88, 0xb6,
+	0x2d, 0x05, 0x2e, 0x66, 0xe3, 0xdd, 0x6e, 0x57, 0xd8, 0x5e, 0xe3, 0x59, 0xa7, 0xc9, 0x8d, 0x8f,
+	0x0b, 0x20, 0x24, 0x90, 0xe0, 0x0f, 0x28, 0x37, 0x0e, 0x1c, 0x8a, 0xd4, 0x03, 0x37, 0x6e, 0xa8,
+	0x82, 0x4b, 0xc5, 0x89, 0x13, 0x82, 0x56, 0xa8, 0x37, 0xfe, 0x05, 0xd0, 0xce, 0xec, 0x8e, 0x77,
+	0xbc, 0xbb, 0xde, 0xad, 0x65, 0xc1, 0xc5, 0xf2, 0xcc, 0xfb, 0xbd, 0xef, 0x37, 0x6f, 0xde, 0x2c,
+	0x08, 0x75, 0x13, 0x35, 0x4d, 0x54, 0xd4, 0x3b, 0x66, 0xb7, 0x5d, 0xdc, 0xdf, 0x28, 0x5a, 0x07,
+	0x85, 0x76, 0xc7, 0xb4, 0x4c, 0x7e, 0x96, 0x50, 0x0a, 0x98, 0x52, 0xd8, 0xdf, 0x10, 0x17, 0x74,
+	0x53, 0x37, 0x31, 0xad, 0x68, 0xff, 0x23, 0x30, 0x71, 0x89, 0xc0, 0x6a, 0x84, 0xe0, 0xf0, 0x38,
+	0x24, 0xdd, 0x34, 0xf5, 0x86, 0x56, 0xc4, 0xab, 0xbd, 0xee, 0xed, 0xa2, 0xd2, 0x3a, 0x74, 0x48,
+	0xcb, 0x3e, 0xb5, 0x87, 0x6d, 0xcd, 0xe5, 0x3b, 0xe1, 0x10, 0x9b, 0x48, 0xb7, 0x49, 0x4d, 0xa4,
+	0x3b, 0x84, 0x79, 0xa5, 0x69, 0xb4, 0xcc, 0x22, 0xfe, 0x25, 0x5b, 0xd2, 0x2f, 0x1c, 0xa4, 0xab,
+	0x48, 0xdf, 0xee, 0x68, 0x8a, 0xa5, 0xbd, 0x62, 0x4b, 0xe3, 0x0b, 0x30, 0xae, 0xa8, 0x4d, 0xa3,
+	0x25, 0x70, 0x39, 0x6e, 0x6d, 0x7a, 0x4b, 0xf8, 0xf5, 0xc1, 0xfa, 0x82, 0x63, 0xd7, 0x55, 0x55,
+	0xed, 0x68, 0x08, 0x5d, 0xb7, 0x3a, 0x46, 0x4b, 0x97, 0x09, 0x8c, 0xdf, 0x86, 0xc9, 0xa6, 0xd6,
+	0xdc, 0xd3, 0x3a, 0x48, 0x48, 0xe4, 0x92, 0x6b, 0x33, 0xa5, 0x4c, 0xa1, 0xcf, 0xf5, 0x42, 0x15,
+	0xd3, 0x65, 0xed, 0xfd, 0xae, 0x86, 0xac, 0xad, 0xe9, 0x87, 0xbf, 0x67, 0xc7, 0xbe, 0x7d, 0x7a,
+	0x3f, 0xcf, 0xc9, 0x2e, 0x27, 0x2f, 0xc2, 0x54, 0x53, 0xb3, 0x14, 0x55, 0xb1, 0x14, 0x21, 0x69,
+	0xeb, 0x95, 0xe9, 0xba, 0xbc, 0xf6, 0xd1, 0xd3, 0xfb, 0x79, 0xa2, 0xec, 0xb3, 0xa7, 0xf7, 0xf3,
+	0x4e, 0xc4, 0xd6, 0x91, 0xfa, 0x5e, 0x91, 0x35, 0x5d, 0xda, 0x84, 0xe3, 0xec, 0x8e, 0xac, 0xa1,
+	0xb6, 0xd9, 0x42, 0x1a, 0xbf, 0x04, 0x53, 0xd8, 0x9a, 0x9a, 0xa1, 0x62, 0xbf, 0x52, 0xf2, 0x24,
+	0x5e, 0xef, 0xa8, 0xd2, 0x5f, 0x1c, 0x2c, 0x56, 0x91, 0x7e, 0xb3, 0xad, 0xba, 0x5c, 0x55, 0xc7,
+	0xa8, 0x67, 0x8d, 0x84, 0x57, 0x49, 0x82, 0x51, 0xc2, 0xef, 0x42, 0x9a, 0xb8, 0x5a, 0xeb, 0x62,
+	0x3d, 0x48, 0x48, 0x3e, 0x6b, 0xac, 0x8e, 0x12, 0x01, 0xc4, 0x4e, 0x54, 0x2e, 0xb2, 0x51, 0xc9,
+	0xb1, 0x51, 0xf1, 0x7b, 0x23, 0x65, 0xe1, 0x54, 0x20, 0xc1, 0x8d, 0x91, 0xf4, 0x13, 0x07, 0xc7,
+	0x58, 0xc4, 0x55, 0xec, 0xd6, 0x08, 0xc3, 0x70, 0x19, 0xa6, 0x5b, 0xda, 0xdd, 0x1a, 0x11, 0x97,
+	0x8c, 0x10, 0x37, 0xd5, 0xd2, 0xee, 0x62, 0x0b, 0xca, 0xeb, 0xac, 0xaf, 0x99, 0x50, 0x5f, 0x31,
+	0x5c, 0x3a, 0x05, 0xcb, 0x01, 0xdb, 0xd4, 0xcf, 0xef, 0x39, 0x38, 0xce, 0xd2, 0xab, 0x4e, 0xa9,
+	0x8d, 0xd2, 0xd5, 0x41, 0x15, 0x7d, 0x91, 0xf5, 0xe7, 0xf4, 0x80, 0xdc, 0x11, 0x0e, 0x29, 0x07,
+	0x99, 0x60, 0x0a, 0xf5, 0xea, 0xeb, 0x04, 0x2c, 0xb0, 0xc5, 0xbf, 0x6b, 0x36, 0x8c, 0xfa, 0xe1,
+	0x7f, 0xe4, 0x13, 0xaf, 0xc0, 0xac, 0xaa, 0xd5, 0x0d, 0x64, 0x98, 0xad, 0x5a, 0x1b, 0x6b, 0x16,
+	0x52, 0x39, 0x6e, 0x6d, 0xa6, 0xb4, 0x50, 0x20, 0x7d, 0xac, 0xe0, 0xf6, 0xb1, 0xc2, 0xd5, 0xd6,
+	0xe1, 0x96, 0xf4, 0xf3, 0x83, 0xf5, 0x4c, 0x7f, 0xed, 0x5f, 0x73, 0x04, 0x10, 0xcb, 0xe5, 0xb4,
+	0xca, 0xac, 0xcb, 0xa5, 0x4f, 0xee, 0x65, 0xc7, 0xd8, 0xd0, 0x65, 0x43, 0x9b, 0x01, 0xe1, 0x91,
+	0x64, 0x38, 0x19, 0xb4, 0x4f, 0x1b, 0x43, 0x09, 0x26, 0x15, 0x12, 0x85, 0xc8, 0xf8, 0xb8, 0x40,
+	0xe9, 0xe3, 0x04, 0x2c, 0xb1, 0xd9, 0x20, 0x42, 0x87, 0x3b, 0x2e, 0xaf, 0xc2, 0x02, 0x89, 0x37,
+	0x89, 0x5a, 0xcd, 0x35, 0x27, 0x11, 0xc1, 0xce, 0xeb, 0x5e, 0xcd, 0x98, 0x32, 0xec, 0xf9, 0xda,
+	0x64, 0x83, 0xba, 0x12, 0x5a, 0x8f, 0x1e, 0x3f, 0xa5, 0x33, 0x70, 0x3a, 0x94, 0x48, 0xab, 0xf2,
+	0x87, 0x24, 0x08, 0x6c, 0xfc, 0x6f, 0x19, 0xd6, 0x9d, 0x21, 0x2b, 0x73, 0x24, 0x37, 0xcd, 0x59,
+	0x48, 0x93, 0x70, 0xf7, 0x55, 0xf2, 0x51, 0x9d, 0xe9, 0x04, 0x25, 0x58, 0x64, 0xb2, 0x42, 0xd1,
+	0x29, 0x8c, 0x3e, 0xe6, 0x09, 0x3e, 0xe5, 0xd9, 0xe8, 0xe3, 0x51, 0x90, 0x93, 0x89, 0xf1, 0x1c,
+	0xb7, 0x36, 0xc5, 0x26, 0x0c, 0x91, 0x62, 0x09, 0x38, 0x35, 0x13, 0x23, 0x3e, 0x35, 0x57, 0xfc,
+	0xa7, 0xe6, 0x4c, 0xe8, 0xa9, 0xe9, 0x65, 0x47, 0xfa, 0x94, 0x83, 0x5c, 0x18, 0x31, 0xc6, 0xbd,
+	0x3a, 0xca, 0xba, 0x96, 0x7e, 0x4c, 0x80, 0x14, 0x54, 0x6c, 0xac, 0xeb, 0xff, 0xeb, 0xd1, 0x0b,
+	0xc8, 0x64, 0x72, 0xc4, 0x99, 0x2c, 0xfb, 0x33, 0xb9, 0x1a, 0x7a, 0x54, 0x59, 0x59, 0xd2, 0x05,
+	0xc8, 0x47, 0x07, 0x90, 0x1e, 0xdb, 0xbf, 0x39, 0x38, 0x19, 0x04, 0x1f, 0xfa, 0xa2, 0x1c, 0x65,
+	0xa4, 0x07, 0xdd, 0xac, 0x57, 0xe2, 0x86, 0x87, 0xf5, 0x47, 0x3a, 0x07, 0x2b, 0x83, 0xe8, 0x34,
+	0x30, 0x7f, 0x26, 0x60, 0xbe, 0x8a, 0xf4, 0xeb, 0xdd, 0xbd, 0xa6, 0x61, 0xed, 0x76, 0xcc, 0xb6,
+	0x89, 0x94, 0x46, 0xa8, 0x77, 0xdc, 0x10, 0xde, 0x9d, 0x84, 0xe9, 0x36, 0x96, 0xeb, 0xb6, 0xb9,
+	0x69, 0xb9, 0xb7, 0x31, 0xf0, 0x06, 0xbe, 0x68, 0xd3, 0x10, 0x52, 0x74, 0x0d, 0x09, 0xa9, 0x5c,
+	0x32, 0xac, 0xf4, 0x64, 0x8a, 0xe2, 0xcf, 0x43, 0x4a, 0x3b, 0xd0, 0xea, 0xb8, 0x3f, 0xa5, 0x4b,
+	0x8b, 0xbe, 0x6e, 0x5a, 0x39, 0xd0, 0xea, 0x32, 0x86, 0xf0, 0x0b, 0x30, 0x6e, 0x19, 0x56, 0x43,
+	0xc3, 0xed, 0x69, 0x5a, 0x26, 0x0b, 0x5e, 0x80, 0x49, 0xd4, 0x6d, 0x36, 0x95, 0xce, 0xa1, 0x30,
+	0x89, 0xf7, 0xdd, 0x65, 0xf9, 0x39, 0xb7, 0x56, 0x7b, 0xc6, 0xdb, 0x09, 0x91, 0x3c, 0x09, 0x21,
+	0x8f, 0x17, 0x5f, 0x34, 0xa5, 0x17, 0x60, 0xc9, 0xb7, 0x49, 0x1b, 0x4e, 0x16, 0x66, 0xda, 0xce,
+	0x5e, 0xaf, 0xe7, 0x80, 0xbb, 0xb5, 0xa3, 0x4a, 0xdf, 0x90, 0x29, 0xd6, 0xee, 0x55, 0x6a, 0x47,
+	0xb9, 0x4b, 0x73, 0x14, 0xc5, 0xe8, 0x9d, 0x04, 0x12, 0x31, 0x27, 0x81, 0xf2, 0x65, 0xdb, 0x43,
+	0x77, 0xd5, 0x7f, 0x75, 0x52, 0xff, 0xfa, 0x6d, 0x71, 0x06, 0xd4, 0xfe, 0x6d, 0x5a, 0x64, 0xff,
+	0x70, 0x30, 0x59, 0x45, 0xfa, 0x9b, 0xa6, 0x15, 0xed, 0xaf, 0x7d, 0x12, 0xf7, 0x4d, 0x4b, 0xeb,
+	0x44, 0x1a, 0x4d, 0x60, 0xfc, 0x26, 0x4c, 0x98, 0x6d, 0xcb, 0x30, 0xc9, 0x7c, 0x90, 0x2e, 0x2d,
+	0xfb, 0xb2, 0x6e, 0xeb, 0x7d, 0x03, 0x43, 0x64, 0x07, 0xca, 0x94, 0x5d, 0xaa, 0xaf, 0xec, 0xe2,
+	0x17, 0x51, 0x79, 0x15, 0x9f, 0x4e, 0x6c, 0x87, 0x1d, 0x2c, 0x21, 0x28, 0x58, 0xb6, 0x76, 0x69,
+	0x1e, 0x66, 0x9d, 0xbf, 0x34, 0x28, 0x9f, 0x93, 0xa0, 0xd8, 0xd2, 0xa2, 0x83, 0x72, 0x09, 0xa6,
+	0x6c, 0x85, 0x5d, 0xcb, 0x8c, 0x8e, 0x0b, 0x45, 0x96, 0xf3, 0xb6, 0x79, 0x74, 0x19, 0x6a, 0xa1,
+	0x6d, 0x82, 0x24, 0xc3, 0xac, 0xf3, 0x97, 0x96, 0xe6, 0x4b, 0x30, 0xd1, 0xd1, 0x50, 0xb7, 0x61,
+	0x61, 0x95, 0xe9, 0xd2, 0xaa, 0x2f, 0x14, 0x6e, 0xa6, 0x2b, 0x8e, 0x0a, 0x19, 0xc3, 0x65, 0x87,
+	0x4d, 0xfa, 0x82, 0x83, 0xa3, 0x55, 0xa4, 0xbf, 0xa6, 0x29, 0xfb, 0xce, 0x5b, 0x7c, 0x88, 0xe9,
+	0x74, 0xc0, 0xfc, 0x4e, 0xde, 0x8c, 0xde, 0x72, 0xcd, 0x04, 0xf9, 0xd7, 0xd3, 0x2f, 0x9d, 0x80,
+	0x45, 0x66, 0x83, 0x66, 0xe3, 0x1e, 0x87, 0xfb, 0xe0, 0xb6, 0xd2, 0xaa, 0x6b, 0x8d, 0xf8, 0x67,
+	0xec, 0x12, 0x4c, 0xe9, 0x5d, 0xa5, 0xa3, 0x1a, 0x4a, 0x2b, 0x3a, 0x2f, 0x2e, 0xb2, 0x7c, 0x09,
+	0xe7, 0xc5, 0x5d, 0x86, 0xb6, 0x11, 0xd6, 0x18, 0x69, 0x19, 0x96, 0x7c, 0x9b, 0xae, 0xfd, 0xf9,
+	0x3c, 0xa4, 0x2a, 0xa4, 0xad, 0xcd, 0x55, 0xde, 0xaa, 0x6c, 0xd7, 0x6e, 0xbe, 0x7e, 0x7d, 0xb7,
+	0xb2, 0xbd, 0xf3, 0xf2, 0x4e, 0xe5, 0xda, 0xdc, 0x18, 0x7f, 0x04, 0xa6, 0xf0, 0xee, 0x0d, 0xf9,
+	0xed, 0x39, 0xae, 0xf4, 0xdd, 0x11, 0x48, 0x56, 0x91, 0xce, 0xdf, 0x82, 0x19, 0xef, 0x77, 0x92,
+	0xac, 0x7f, 0xf8, 0x64, 0xa6, 0x25, 0x71, 0x35, 0x02, 0x40, 0x0b, 0xa7, 0x01, 0x7c, 0xc0, 0xd7,
+	0x87, 0x73, 0x41, 0xec, 0x7e, 0x9c, 0x58, 0x88, 0x87, 0xa3, 0xda, 0x6e, 0xc3, 0x9c, 0xef, 0x89,
+	0xbf, 0x12, 0x21, 0x03, 0xa3, 0xc4, 0x0b, 0x71, 0x50, 0x54, 0x8f, 0x09, 0xc7, 0x82, 0x9e, 0xd8,
+	0xab, 0x91, 0xe6, 0x12, 0xa0, 0x58, 0x8c, 0x09, 0xa4, 0x0a, 0x0d, 0x98, 0xf7, 0xbf, 0x7e, 0xcf,
+	0x46, 0x24, 0x81, 0xc0, 0xc4, 0xf5, 0x58, 0x30, 0xaa, 0xaa, 0x0b, 0x8b, 0xc1, 0x4f, 0x9a, 0xf3,
+	0x11, 0x72, 0x7a, 0x50, 0x71, 0x23, 0x36, 0x94, 0xaa, 0x3d, 0x80, 0xe3, 0x21, 0x8f, 0xce, 0x7c,
+	0x44, 0xb0, 0x3c, 0x58, 0xb1, 0x14, 0x1f, 0x4b, 0x35, 0x7f, 0xc5, 0x41, 0x36, 0x6a, 0xfa, 0xde,
+	0x8c, 0x25, 0x97, 0x65, 0x12, 0x9f, 0x1f, 0x82, 0x89, 0x5a, 0xf5, 0x21, 0x07, 0x4b, 0xe1, 0x33,
+	0xea, 0x7a, 0x2c, 0xd1, 0xb4, 0xde, 0x2e, 0x3f, 0x13, 0x9c, 0xda, 0xf0, 0x2e, 0xa4, 0xfb, 0xa6,
+	0x41, 0x29, 0x48, 0x10, 0x8b, 0x11, 0xf3, 0xd1, 0x18, 0xef, 0x81, 0xf5, 0x4d, 0x33, 0x81, 0x07,
+	0xb6, 0x1f, 0x25, 0x5e, 0x88, 0x83, 0xa2, 0x7a, 0xb6, 0x20, 0x85, 0x47, 0x0e, 0x21, 0x88, 0xcb,
+	0xa6, 0x88, 0xb9, 0x30, 0x8a, 0x57, 0x06, 0xee, 0xab, 0x81, 0x32, 0x6c, 0x8a, 0x98, 0x0b, 0xa3,
+	0x50, 0x19, 0x37, 0x00, 0x3c, 0x57, 0x60, 0x26, 0x08, 0xdf, 0xa3, 0x8b, 0xe7, 0x06, 0xd3, 0xbd,
+	0x79, 0xea, 0xbb, 0xad, 0x02, 0xf3, 0xc4, 0x62, 0xc4, 0x7c, 0x34, 0xc6, 0xd5, 0x20, 0x8e, 0x7f,
+	0x60, 0x7f, 0x69, 0xd8, 0x7a, 0xf1, 0xe1, 0xe3, 0x0c, 0xf7, 0xe8, 0x71, 0x86, 0xfb, 0xe3, 0x71,
+	0x86, 0xfb, 0xf2, 0x49, 0x66, 0xec, 0xd1, 0x93, 0xcc, 0xd8, 0x6f, 0x4f, 0x32, 0x63, 0xef, 0xac,
+	0xe8, 0x86, 0x75, 0xa7, 0xbb, 0x57, 0xa8, 0x9b, 0x4d, 0xe7, 0x4b, 0x7f, 0xd1, 0x73, 0x8f, 0x1d,
+	0x90, 0x9b, 0x6c, 0x6f, 0x02, 0x0f, 0xeb, 0x9b, 0xff, 0x0e, 0x00, 0xdf, 0x00, 0x89, 0x69, 0x5b,
+	0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1638,6 +1735,9 @@ type MsgClient interface {
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// CancelProposal allows a guardian of a group policy to cancel a proposal
+	// during its timelock.
+	CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error)
 }
 
 type msgClient struct {
@@ -1774,6 +1874,15 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error) {
+	out := new(MsgCancelProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/CancelProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateGroup creates a new group with an admin account address, a list of members and some optional metadata.
@@ -1804,6 +1913,9 @@ type MsgServer interface {
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// CancelProposal allows a guardian of a group policy to cancel a proposal
+	// during its timelock.
+	CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1852,6 +1964,9 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (*UnimplementedMsgServer) CancelProposal(ctx context.Context, req *MsgCancelProposal) (*MsgCancelProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2109,6 +2224,24 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/CancelProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelProposal(ctx, req.(*MsgCancelProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2169,6 +2302,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "CancelProposal",
+			Handler:    _Msg_CancelProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
@@ -3221,6 +3358,64 @@ func (m *MsgLeaveGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -3698,6 +3893,31 @@ func (m *MsgLeaveGroupResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6677,6 +6897,157 @@ func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
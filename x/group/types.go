@@ -52,7 +52,7 @@ var _ DecisionPolicy = &ThresholdDecisionPolicy{}
 
 // NewThresholdDecisionPolicy creates a threshold DecisionPolicy
 func NewThresholdDecisionPolicy(threshold string, votingPeriod, minExecutionPeriod time.Duration) DecisionPolicy {
-	return &ThresholdDecisionPolicy{threshold, &DecisionPolicyWindows{votingPeriod, minExecutionPeriod}}
+	return &ThresholdDecisionPolicy{threshold, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: minExecutionPeriod}}
 }
 
 // GetVotingPeriod returns the voitng period of ThresholdDecisionPolicy
@@ -75,7 +75,7 @@ func (p ThresholdDecisionPolicy) ValidateBasic() error {
 		return errorsmod.Wrap(errors.ErrInvalid, "voting period cannot be zero")
 	}
 
-	return nil
+	return p.Windows.validateBasic()
 }
 
 // Allow allows a proposal to pass when the tally of yes votes equals or exceeds the threshold before the timeout.
@@ -149,7 +149,7 @@ func (p *ThresholdDecisionPolicy) Validate(g GroupInfo, config Config) error {
 	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
 		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
 	}
-	return nil
+	return p.Windows.validate(config)
 }
 
 // Implements DecisionPolicy Interface
@@ -157,7 +157,7 @@ var _ DecisionPolicy = &PercentageDecisionPolicy{}
 
 // NewPercentageDecisionPolicy creates a new percentage DecisionPolicy
 func NewPercentageDecisionPolicy(percentage string, votingPeriod, executionPeriod time.Duration) DecisionPolicy {
-	return &PercentageDecisionPolicy{percentage, &DecisionPolicyWindows{votingPeriod, executionPeriod}}
+	return &PercentageDecisionPolicy{percentage, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: executionPeriod}}
 }
 
 // GetVotingPeriod returns the voitng period of PercentageDecisionPolicy
@@ -184,7 +184,7 @@ func (p PercentageDecisionPolicy) ValidateBasic() error {
 		return errorsmod.Wrap(errors.ErrInvalid, "voting period cannot be 0")
 	}
 
-	return nil
+	return p.Windows.validateBasic()
 }
 
 // Validate validates the policy against the group.
@@ -192,7 +192,7 @@ func (p *PercentageDecisionPolicy) Validate(g GroupInfo, config Config) error {
 	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
 		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
 	}
-	return nil
+	return p.Windows.validate(config)
 }
 
 // Allow allows a proposal to pass when the tally of yes votes equals or exceeds the percentage threshold before the timeout.
@@ -241,6 +241,136 @@ func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string) (D
 	return DecisionPolicyResult{Allow: false, Final: false}, nil
 }
 
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &TokenWeightedDecisionPolicy{}
+
+// NewTokenWeightedDecisionPolicy creates a new token weighted DecisionPolicy
+func NewTokenWeightedDecisionPolicy(denom string, source TokenWeightSource, percentage string, votingPeriod, executionPeriod time.Duration) DecisionPolicy {
+	return &TokenWeightedDecisionPolicy{denom, source, percentage, &DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: executionPeriod}}
+}
+
+// GetVotingPeriod returns the voting period of TokenWeightedDecisionPolicy
+func (p TokenWeightedDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+// GetMinExecutionPeriod returns the minimum execution period of TokenWeightedDecisionPolicy
+func (p TokenWeightedDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+// ValidateBasic does basic validation on TokenWeightedDecisionPolicy
+func (p TokenWeightedDecisionPolicy) ValidateBasic() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return errorsmod.Wrap(err, "denom")
+	}
+
+	if p.Source == TOKEN_WEIGHT_SOURCE_UNSPECIFIED {
+		return errorsmod.Wrap(errors.ErrEmpty, "token weight source")
+	}
+	if _, ok := TokenWeightSource_name[int32(p.Source)]; !ok {
+		return errorsmod.Wrap(errors.ErrInvalid, "token weight source")
+	}
+
+	return p.percentagePolicy().ValidateBasic()
+}
+
+// Validate validates the policy against the group. The group member weights
+// are not used by the policy, so only the windows are validated.
+func (p *TokenWeightedDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
+		return errorsmod.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
+	}
+	return p.Windows.validate(config)
+}
+
+// Allow allows a proposal to pass when the tally of yes votes equals or
+// exceeds the percentage threshold of the total token weight snapshotted for
+// the proposal before the timeout.
+func (p TokenWeightedDecisionPolicy) Allow(tally TallyResult, totalPower string) (DecisionPolicyResult, error) {
+	return p.percentagePolicy().Allow(tally, totalPower)
+}
+
+// percentagePolicy returns the percentage decision policy applied to the token
+// weights.
+func (p TokenWeightedDecisionPolicy) percentagePolicy() PercentageDecisionPolicy {
+	return PercentageDecisionPolicy{Percentage: p.Percentage, Windows: p.Windows}
+}
+
+// EffectiveExecutionDeadline returns the duration after the end of the voting
+// period after which a proposal can't be executed anymore, which defaults to
+// the app-wide max execution period.
+func (w DecisionPolicyWindows) EffectiveExecutionDeadline(config Config) time.Duration {
+	if w.ExecutionDeadline == nil || *w.ExecutionDeadline == 0 {
+		return config.MaxExecutionPeriod
+	}
+	return *w.ExecutionDeadline
+}
+
+// GetTimelockDuration returns the timelock of the windows, or 0 if not set.
+func (w DecisionPolicyWindows) GetTimelockDuration() time.Duration {
+	if w.Timelock == nil {
+		return 0
+	}
+	return *w.Timelock
+}
+
+// validateBasic does basic validation on the execution deadline, timelock and
+// guardians of the windows.
+func (w DecisionPolicyWindows) validateBasic() error {
+	if w.ExecutionDeadline != nil && *w.ExecutionDeadline < 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "execution deadline cannot be negative")
+	}
+
+	if w.GetTimelockDuration() < 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "timelock cannot be negative")
+	}
+
+	if len(w.Guardians) > 0 && w.GetTimelockDuration() == 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "guardians require a timelock")
+	}
+
+	index := make(map[string]struct{}, len(w.Guardians))
+	for _, guardian := range w.Guardians {
+		if _, err := sdk.AccAddressFromBech32(guardian); err != nil {
+			return errorsmod.Wrapf(err, "guardian %s", guardian)
+		}
+
+		if _, exists := index[guardian]; exists {
+			return errorsmod.Wrapf(errors.ErrDuplicate, "guardian %s", guardian)
+		}
+		index[guardian] = struct{}{}
+	}
+
+	return nil
+}
+
+// validate validates the execution deadline and timelock of the windows
+// against the app config.
+func (w DecisionPolicyWindows) validate(config Config) error {
+	if w.EffectiveExecutionDeadline(config) > config.MaxExecutionPeriod {
+		return errorsmod.Wrap(errors.ErrInvalid, "execution_deadline should be smaller than max_execution_period")
+	}
+
+	// a proposal queued for exactly its expiry time would always expire
+	// before being executed
+	if w.GetTimelockDuration() > 0 && w.GetTimelockDuration() >= w.EffectiveExecutionDeadline(config) {
+		return errorsmod.Wrap(errors.ErrInvalid, "timelock should be smaller than execution_deadline")
+	}
+
+	return nil
+}
+
+// IsGuardian returns whether the address is one of the guardians of the windows.
+func (w DecisionPolicyWindows) IsGuardian(address string) bool {
+	for _, guardian := range w.Guardians {
+		if guardian == address {
+			return true
+		}
+	}
+	return false
+}
+
 var _ orm.Validateable = GroupPolicyInfo{}
 
 // NewGroupPolicyInfo creates a new GroupPolicyInfo instance
@@ -447,6 +577,54 @@ func (v Vote) ValidateBasic() error {
 	return nil
 }
 
+func (w ProposalMemberWeight) PrimaryKeyFields() []interface{} {
+	addr := sdk.MustAccAddressFromBech32(w.Member)
+
+	return []interface{}{w.ProposalId, addr.Bytes()}
+}
+
+var _ orm.Validateable = ProposalMemberWeight{}
+
+// ValidateBasic does basic validation on proposal member weight.
+func (w ProposalMemberWeight) ValidateBasic() error {
+	if w.ProposalId == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "proposal member weight's proposal id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(w.Member); err != nil {
+		return errorsmod.Wrap(err, "proposal member weight's member")
+	}
+
+	if _, err := math.NewPositiveDecFromString(w.Weight); err != nil {
+		return errorsmod.Wrap(err, "weight must be positive")
+	}
+
+	return nil
+}
+
+func (q QueuedExecution) PrimaryKeyFields() []interface{} {
+	return []interface{}{q.ProposalId}
+}
+
+var _ orm.Validateable = QueuedExecution{}
+
+// ValidateBasic does basic validation on queued execution.
+func (q QueuedExecution) ValidateBasic() error {
+	if q.ProposalId == 0 {
+		return errorsmod.Wrap(errors.ErrEmpty, "queued execution's proposal id")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(q.GroupPolicyAddress); err != nil {
+		return errorsmod.Wrap(err, "queued execution's group policy address")
+	}
+
+	if q.ExecuteAfter.IsZero() {
+		return errorsmod.Wrap(errors.ErrEmpty, "queued execution's execute after")
+	}
+
+	return nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (q QueryGroupPoliciesByGroupResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpackGroupPolicies(unpacker, q.GroupPolicies)
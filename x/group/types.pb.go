@@ -72,6 +72,41 @@ func (VoteOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f5bddd15d7a54a9d, []int{0}
 }
 
+// TokenWeightSource enumerates how the token weight of a group member is
+// computed by a TokenWeightedDecisionPolicy.
+type TokenWeightSource int32
+
+const (
+	// TOKEN_WEIGHT_SOURCE_UNSPECIFIED defines an unspecified token weight source
+	// which will return an error.
+	TOKEN_WEIGHT_SOURCE_UNSPECIFIED TokenWeightSource = 0
+	// TOKEN_WEIGHT_SOURCE_BALANCE weights a member by its balance of the denom.
+	TOKEN_WEIGHT_SOURCE_BALANCE TokenWeightSource = 1
+	// TOKEN_WEIGHT_SOURCE_DELEGATED_STAKE weights a member by its bonded
+	// delegations, the denom being the staking bond denom.
+	TOKEN_WEIGHT_SOURCE_DELEGATED_STAKE TokenWeightSource = 2
+)
+
+var TokenWeightSource_name = map[int32]string{
+	0: "TOKEN_WEIGHT_SOURCE_UNSPECIFIED",
+	1: "TOKEN_WEIGHT_SOURCE_BALANCE",
+	2: "TOKEN_WEIGHT_SOURCE_DELEGATED_STAKE",
+}
+
+var TokenWeightSource_value = map[string]int32{
+	"TOKEN_WEIGHT_SOURCE_UNSPECIFIED":     0,
+	"TOKEN_WEIGHT_SOURCE_BALANCE":         1,
+	"TOKEN_WEIGHT_SOURCE_DELEGATED_STAKE": 2,
+}
+
+func (x TokenWeightSource) String() string {
+	return proto.EnumName(TokenWeightSource_name, int32(x))
+}
+
+func (TokenWeightSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{1}
+}
+
 // ProposalStatus defines proposal statuses.
 type ProposalStatus int32
 
@@ -92,6 +127,9 @@ const (
 	// A proposal can be withdrawn before the voting start time by the owner.
 	// When this happens the final status is Withdrawn.
 	PROPOSAL_STATUS_WITHDRAWN ProposalStatus = 5
+	// Final status of an accepted proposal cancelled by a guardian of the group
+	// policy during its timelock.
+	PROPOSAL_STATUS_CANCELLED ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -101,6 +139,7 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_REJECTED",
 	4: "PROPOSAL_STATUS_ABORTED",
 	5: "PROPOSAL_STATUS_WITHDRAWN",
+	6: "PROPOSAL_STATUS_CANCELLED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -110,6 +149,7 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_REJECTED":    3,
 	"PROPOSAL_STATUS_ABORTED":     4,
 	"PROPOSAL_STATUS_WITHDRAWN":   5,
+	"PROPOSAL_STATUS_CANCELLED":   6,
 }
 
 func (x ProposalStatus) String() string {
@@ -117,7 +157,7 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{1}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{2}
 }
 
 // ProposalExecutorResult defines types of proposal executor results.
@@ -153,7 +193,7 @@ func (x ProposalExecutorResult) String() string {
 }
 
 func (ProposalExecutorResult) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{2}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{3}
 }
 
 // Member represents a group member with an account address,
@@ -418,6 +458,89 @@ func (m *PercentageDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 	return nil
 }
 
+// TokenWeightedDecisionPolicy is a decision policy where the weight of a group
+// member is its amount of `denom` tokens instead of its member weight. A
+// proposal passes when it satisfies the two following conditions:
+//  1. The percentage of all `YES` voters' token weights out of the total token
+//     weight of the group members is greater or equal than the given `percentage`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
+//
+// The token weights of the group members are snapshotted when a proposal is
+// submitted, so that tokens transferred after a vote can't be used to vote again.
+type TokenWeightedDecisionPolicy struct {
+	// denom is the denom of the tokens weighting the group members.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// source defines how the token weight of a group member is computed.
+	Source TokenWeightSource `protobuf:"varint,2,opt,name=source,proto3,enum=cosmos.group.v1.TokenWeightSource" json:"source,omitempty"`
+	// percentage is the minimum percentage of the token weighted sum of `YES`
+	// votes must meet for a proposal to succeed.
+	Percentage string `protobuf:"bytes,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// windows defines the different windows for voting and execution.
+	Windows *DecisionPolicyWindows `protobuf:"bytes,4,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (m *TokenWeightedDecisionPolicy) Reset()         { *m = TokenWeightedDecisionPolicy{} }
+func (m *TokenWeightedDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*TokenWeightedDecisionPolicy) ProtoMessage()    {}
+func (*TokenWeightedDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+}
+func (m *TokenWeightedDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenWeightedDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenWeightedDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenWeightedDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenWeightedDecisionPolicy.Merge(m, src)
+}
+func (m *TokenWeightedDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenWeightedDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenWeightedDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenWeightedDecisionPolicy proto.InternalMessageInfo
+
+func (m *TokenWeightedDecisionPolicy) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TokenWeightedDecisionPolicy) GetSource() TokenWeightSource {
+	if m != nil {
+		return m.Source
+	}
+	return TOKEN_WEIGHT_SOURCE_UNSPECIFIED
+}
+
+func (m *TokenWeightedDecisionPolicy) GetPercentage() string {
+	if m != nil {
+		return m.Percentage
+	}
+	return ""
+}
+
+func (m *TokenWeightedDecisionPolicy) GetWindows() *DecisionPolicyWindows {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
 // DecisionPolicyWindows defines the different windows for voting and execution.
 type DecisionPolicyWindows struct {
 	// voting_period is the duration from submission of a proposal to the end of voting period
@@ -435,13 +558,25 @@ type DecisionPolicyWindows struct {
 	// is empty, meaning that all proposals created with this decision policy
 	// won't be able to be executed.
 	MinExecutionPeriod time.Duration `protobuf:"bytes,2,opt,name=min_execution_period,json=minExecutionPeriod,proto3,stdduration" json:"min_execution_period"`
+	// execution_deadline is the duration after the end of the voting period
+	// after which a proposal can't be executed anymore. It can't be greater than
+	// the app-specific max_execution_period, which it defaults to when not set.
+	ExecutionDeadline *time.Duration `protobuf:"bytes,3,opt,name=execution_deadline,json=executionDeadline,proto3,stdduration" json:"execution_deadline,omitempty"`
+	// timelock is the duration after the acceptance of a proposal during which
+	// it can't be executed and can be cancelled by the `guardians`. Once the
+	// timelock ends, the proposal is executed automatically at the end of the
+	// block. If not set, proposals are not timelocked nor executed automatically.
+	Timelock *time.Duration `protobuf:"bytes,4,opt,name=timelock,proto3,stdduration" json:"timelock,omitempty"`
+	// guardians is the list of account addresses allowed to cancel a proposal
+	// during its timelock.
+	Guardians []string `protobuf:"bytes,5,rep,name=guardians,proto3" json:"guardians,omitempty"`
 }
 
 func (m *DecisionPolicyWindows) Reset()         { *m = DecisionPolicyWindows{} }
 func (m *DecisionPolicyWindows) String() string { return proto.CompactTextString(m) }
 func (*DecisionPolicyWindows) ProtoMessage()    {}
 func (*DecisionPolicyWindows) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
 }
 func (m *DecisionPolicyWindows) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,6 +619,27 @@ func (m *DecisionPolicyWindows) GetMinExecutionPeriod() time.Duration {
 	return 0
 }
 
+func (m *DecisionPolicyWindows) GetExecutionDeadline() *time.Duration {
+	if m != nil {
+		return m.ExecutionDeadline
+	}
+	return nil
+}
+
+func (m *DecisionPolicyWindows) GetTimelock() *time.Duration {
+	if m != nil {
+		return m.Timelock
+	}
+	return nil
+}
+
+func (m *DecisionPolicyWindows) GetGuardians() []string {
+	if m != nil {
+		return m.Guardians
+	}
+	return nil
+}
+
 // GroupInfo represents the high-level on-chain information for a group.
 type GroupInfo struct {
 	// id is the unique ID of the group.
@@ -508,7 +664,7 @@ func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -591,7 +747,7 @@ func (m *GroupMember) Reset()         { *m = GroupMember{} }
 func (m *GroupMember) String() string { return proto.CompactTextString(m) }
 func (*GroupMember) ProtoMessage()    {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,7 +815,7 @@ func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
 func (m *GroupPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupPolicyInfo) ProtoMessage()    {}
 func (*GroupPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
 }
 func (m *GroupPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +900,7 @@ func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -789,7 +945,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -837,7 +993,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -901,14 +1057,147 @@ func (m *Vote) GetSubmitTime() time.Time {
 	return time.Time{}
 }
 
+// ProposalMemberWeight is the token weight of a group member snapshotted at the
+// submission of a proposal of a TokenWeightedDecisionPolicy.
+type ProposalMemberWeight struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// member is the account address of the group member.
+	Member string `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+	// weight is the token weight of the member.
+	Weight string `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *ProposalMemberWeight) Reset()         { *m = ProposalMemberWeight{} }
+func (m *ProposalMemberWeight) String() string { return proto.CompactTextString(m) }
+func (*ProposalMemberWeight) ProtoMessage()    {}
+func (*ProposalMemberWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{12}
+}
+func (m *ProposalMemberWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalMemberWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalMemberWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalMemberWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalMemberWeight.Merge(m, src)
+}
+func (m *ProposalMemberWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalMemberWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalMemberWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalMemberWeight proto.InternalMessageInfo
+
+func (m *ProposalMemberWeight) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ProposalMemberWeight) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *ProposalMemberWeight) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
+// QueuedExecution is an accepted proposal queued for automatic execution at
+// the end of the timelock of its group policy.
+type QueuedExecution struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// group_policy_address is the account address of the group policy of the proposal.
+	GroupPolicyAddress string `protobuf:"bytes,2,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// execute_after is the end of the timelock, after which the proposal is
+	// executed at the end of the block.
+	ExecuteAfter time.Time `protobuf:"bytes,3,opt,name=execute_after,json=executeAfter,proto3,stdtime" json:"execute_after"`
+}
+
+func (m *QueuedExecution) Reset()         { *m = QueuedExecution{} }
+func (m *QueuedExecution) String() string { return proto.CompactTextString(m) }
+func (*QueuedExecution) ProtoMessage()    {}
+func (*QueuedExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{13}
+}
+func (m *QueuedExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedExecution.Merge(m, src)
+}
+func (m *QueuedExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedExecution proto.InternalMessageInfo
+
+func (m *QueuedExecution) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *QueuedExecution) GetGroupPolicyAddress() string {
+	if m != nil {
+		return m.GroupPolicyAddress
+	}
+	return ""
+}
+
+func (m *QueuedExecution) GetExecuteAfter() time.Time {
+	if m != nil {
+		return m.ExecuteAfter
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("cosmos.group.v1.TokenWeightSource", TokenWeightSource_name, TokenWeightSource_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalExecutorResult", ProposalExecutorResult_name, ProposalExecutorResult_value)
 	proto.RegisterType((*Member)(nil), "cosmos.group.v1.Member")
 	proto.RegisterType((*MemberRequest)(nil), "cosmos.group.v1.MemberRequest")
 	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "cosmos.group.v1.ThresholdDecisionPolicy")
 	proto.RegisterType((*PercentageDecisionPolicy)(nil), "cosmos.group.v1.PercentageDecisionPolicy")
+	proto.RegisterType((*TokenWeightedDecisionPolicy)(nil), "cosmos.group.v1.TokenWeightedDecisionPolicy")
 	proto.RegisterType((*DecisionPolicyWindows)(nil), "cosmos.group.v1.DecisionPolicyWindows")
 	proto.RegisterType((*GroupInfo)(nil), "cosmos.group.v1.GroupInfo")
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1.GroupMember")
@@ -916,98 +1205,116 @@ func init() {
 	proto.RegisterType((*Proposal)(nil), "cosmos.group.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.group.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.group.v1.Vote")
+	proto.RegisterType((*ProposalMemberWeight)(nil), "cosmos.group.v1.ProposalMemberWeight")
+	proto.RegisterType((*QueuedExecution)(nil), "cosmos.group.v1.QueuedExecution")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1369 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x3b, 0x6f, 0x1b, 0xc7,
-	0x16, 0xd6, 0x92, 0x14, 0x1f, 0x87, 0x12, 0x49, 0x8f, 0x75, 0xad, 0x95, 0xe4, 0x4b, 0xea, 0xd2,
-	0xc6, 0xbd, 0x82, 0x2e, 0x4c, 0xda, 0x32, 0x90, 0x00, 0x2e, 0x82, 0x90, 0xd4, 0x3a, 0xa6, 0x60,
-	0x93, 0xc4, 0x72, 0x29, 0xc5, 0x6e, 0x16, 0x2b, 0xee, 0x98, 0x5a, 0x84, 0xbb, 0xc3, 0xec, 0x0e,
-	0x25, 0xf3, 0x1f, 0x18, 0x69, 0xe2, 0x32, 0x4d, 0x00, 0x03, 0x69, 0x52, 0xba, 0x30, 0x52, 0xa4,
-	0x0c, 0x52, 0x18, 0x29, 0x02, 0x23, 0x55, 0xaa, 0x24, 0xb0, 0x0b, 0xa7, 0x4a, 0x95, 0x36, 0x41,
-	0xb0, 0x33, 0xb3, 0x14, 0x1f, 0x12, 0x1d, 0x19, 0x46, 0x1a, 0x41, 0x33, 0xdf, 0x77, 0xce, 0x9c,
-	0xef, 0xbc, 0x48, 0xc2, 0x5a, 0x9b, 0x78, 0x36, 0xf1, 0x8a, 0x1d, 0x97, 0xf4, 0x7b, 0xc5, 0xc3,
-	0x6b, 0x45, 0x3a, 0xe8, 0x61, 0xaf, 0xd0, 0x73, 0x09, 0x25, 0x28, 0xcd, 0xc1, 0x02, 0x03, 0x0b,
-	0x87, 0xd7, 0x56, 0x97, 0x3a, 0xa4, 0x43, 0x18, 0x56, 0xf4, 0xff, 0xe3, 0xb4, 0xd5, 0x6c, 0x87,
-	0x90, 0x4e, 0x17, 0x17, 0xd9, 0x69, 0xbf, 0x7f, 0xbf, 0x68, 0xf6, 0x5d, 0x83, 0x5a, 0xc4, 0x11,
-	0x78, 0x6e, 0x12, 0xa7, 0x96, 0x8d, 0x3d, 0x6a, 0xd8, 0x3d, 0x41, 0x58, 0xe1, 0xef, 0xe8, 0xdc,
-	0xb3, 0x78, 0x54, 0x40, 0x93, 0xb6, 0x86, 0x33, 0x10, 0xd0, 0x39, 0xc3, 0xb6, 0x1c, 0x52, 0x64,
-	0x7f, 0xf9, 0x55, 0xfe, 0x2b, 0x09, 0xa2, 0x77, 0xb0, 0xbd, 0x8f, 0x5d, 0xb4, 0x05, 0x31, 0xc3,
-	0x34, 0x5d, 0xec, 0x79, 0xb2, 0xb4, 0x2e, 0x6d, 0x24, 0xca, 0xf2, 0x0f, 0x4f, 0xaf, 0x2c, 0x09,
-	0xdf, 0x25, 0x8e, 0x34, 0xa9, 0x6b, 0x39, 0x1d, 0x35, 0x20, 0xa2, 0x0b, 0x10, 0x3d, 0xc2, 0x56,
-	0xe7, 0x80, 0xca, 0x21, 0xdf, 0x44, 0x15, 0x27, 0xb4, 0x0a, 0x71, 0x1b, 0x53, 0xc3, 0x34, 0xa8,
-	0x21, 0x87, 0x19, 0x32, 0x3c, 0xa3, 0x6d, 0x88, 0x1b, 0xa6, 0x89, 0x4d, 0xdd, 0xa0, 0x72, 0x64,
-	0x5d, 0xda, 0x48, 0x6e, 0xad, 0x16, 0x78, 0xcc, 0x85, 0x20, 0xe6, 0x82, 0x16, 0xe8, 0x2d, 0x2f,
-	0x3e, 0xfb, 0x29, 0x37, 0xf7, 0xe8, 0xe7, 0x9c, 0xf4, 0xe5, 0xab, 0x27, 0x9b, 0x12, 0x7b, 0x19,
-	0x9b, 0x25, 0x9a, 0x3f, 0x82, 0x45, 0x1e, 0xb7, 0x8a, 0x3f, 0xee, 0x63, 0x8f, 0xfe, 0x53, 0xe1,
-	0xe7, 0xbf, 0x95, 0x60, 0x59, 0x3b, 0x70, 0xb1, 0x77, 0x40, 0xba, 0xe6, 0x36, 0x6e, 0x5b, 0x9e,
-	0x45, 0x9c, 0x06, 0xe9, 0x5a, 0xed, 0x01, 0xba, 0x08, 0x09, 0x1a, 0x40, 0x3c, 0x0a, 0xf5, 0xf8,
-	0x02, 0xbd, 0x0f, 0xb1, 0x23, 0xcb, 0x31, 0xc9, 0x91, 0xc7, 0x9e, 0x4b, 0x6e, 0xfd, 0xb7, 0x30,
-	0xd1, 0x2e, 0x85, 0x71, 0x7f, 0x7b, 0x9c, 0xad, 0x06, 0x66, 0x37, 0xaa, 0xdf, 0x3d, 0xbd, 0x92,
-	0x9d, 0x6d, 0xf3, 0xc9, 0xab, 0x27, 0x9b, 0x79, 0x4e, 0xb9, 0xe2, 0x99, 0x1f, 0x15, 0x4f, 0x09,
-	0x35, 0xff, 0x4c, 0x02, 0xb9, 0x81, 0xdd, 0x36, 0x76, 0xa8, 0xd1, 0xc1, 0x13, 0x3a, 0xb2, 0x00,
-	0xbd, 0x21, 0x26, 0x84, 0x8c, 0xdc, 0xbc, 0x05, 0x25, 0x3b, 0x7f, 0x4f, 0xc9, 0xa5, 0x11, 0x25,
-	0xa7, 0x45, 0x9b, 0xff, 0x46, 0x82, 0x7f, 0x9d, 0xf8, 0x1c, 0xba, 0x03, 0x8b, 0x87, 0x84, 0x5a,
-	0x4e, 0x47, 0xef, 0x61, 0xd7, 0x22, 0xbc, 0x26, 0xc9, 0xad, 0x95, 0xa9, 0x7e, 0xdb, 0x16, 0xf3,
-	0xc7, 0xdb, 0xed, 0xb3, 0x61, 0xbb, 0x2d, 0x70, 0xf3, 0x06, 0xb3, 0x46, 0xf7, 0x60, 0xc9, 0xb6,
-	0x1c, 0x1d, 0x3f, 0xc0, 0xed, 0xbe, 0xcf, 0x0e, 0xbc, 0x86, 0xce, 0xe8, 0x15, 0xd9, 0x96, 0xa3,
-	0x04, 0x4e, 0xb8, 0xef, 0xfc, 0x6f, 0x12, 0x24, 0x3e, 0xf0, 0x13, 0x51, 0x75, 0xee, 0x13, 0x94,
-	0x82, 0x90, 0xc5, 0xa3, 0x8d, 0xa8, 0x21, 0xcb, 0x44, 0x05, 0x98, 0x37, 0x4c, 0xdb, 0x72, 0x78,
-	0x9f, 0xce, 0x68, 0x6d, 0x4e, 0x9b, 0x39, 0x7f, 0x32, 0xc4, 0x0e, 0xb1, 0xeb, 0x27, 0x8b, 0x8d,
-	0x5f, 0x44, 0x0d, 0x8e, 0xe8, 0x3f, 0xb0, 0x40, 0x09, 0x35, 0xba, 0xba, 0x18, 0x8a, 0x79, 0x66,
-	0x99, 0x64, 0x77, 0x7b, 0x7c, 0x32, 0x6e, 0x01, 0xb4, 0x5d, 0x6c, 0x50, 0x3e, 0xbe, 0xd1, 0xb3,
-	0x8e, 0x6f, 0x42, 0x18, 0x97, 0x68, 0xfe, 0x2e, 0x24, 0x99, 0x5e, 0xb1, 0x7d, 0x56, 0x20, 0xce,
-	0xfa, 0x40, 0x1f, 0xea, 0x8e, 0xb1, 0x73, 0xd5, 0x44, 0x45, 0x88, 0xda, 0x8c, 0x24, 0x12, 0xbd,
-	0x3c, 0xd5, 0x6c, 0x62, 0x13, 0x08, 0x5a, 0xfe, 0x8f, 0x10, 0xa4, 0x99, 0x6f, 0xde, 0x0d, 0x2c,
-	0xa3, 0x6f, 0xb2, 0x1e, 0x46, 0x63, 0x0a, 0x8d, 0xc7, 0x34, 0x2c, 0x48, 0xf8, 0xec, 0x05, 0x89,
-	0x9c, 0x5e, 0x90, 0xf9, 0xf1, 0x82, 0x18, 0x90, 0x36, 0x45, 0x63, 0xeb, 0x3d, 0xa6, 0x45, 0xa4,
-	0x7c, 0x69, 0x2a, 0xe5, 0x25, 0x67, 0x50, 0xce, 0xbf, 0x7e, 0xa8, 0xd4, 0x94, 0x39, 0x3e, 0xea,
-	0xe3, 0x05, 0x8d, 0xbd, 0x79, 0x41, 0x6f, 0xc4, 0x1f, 0x3e, 0xce, 0xcd, 0xfd, 0xfa, 0x38, 0x27,
-	0xe5, 0xff, 0x9c, 0x87, 0x78, 0xc3, 0x25, 0x3d, 0xe2, 0x19, 0xdd, 0xa9, 0x56, 0xde, 0x81, 0x25,
-	0x9e, 0x54, 0x2e, 0x48, 0x0f, 0xaa, 0xf2, 0xba, 0xce, 0x46, 0x9d, 0xe3, 0x8a, 0x0a, 0x64, 0x66,
-	0x9b, 0xbf, 0x03, 0x89, 0x1e, 0x8b, 0x01, 0xbb, 0x9e, 0x1c, 0x59, 0x0f, 0xcf, 0x74, 0x7e, 0x4c,
-	0x45, 0x3b, 0x90, 0xf4, 0xfa, 0xfb, 0xb6, 0x45, 0x75, 0xff, 0x43, 0x97, 0x55, 0xe4, 0x4c, 0x19,
-	0x01, 0x6e, 0xed, 0xe3, 0xe8, 0x12, 0x2c, 0x72, 0xad, 0x41, 0x7d, 0xa3, 0x2c, 0x0d, 0x0b, 0xec,
-	0x72, 0x57, 0x14, 0xf9, 0xea, 0x44, 0x42, 0x02, 0x6e, 0x8c, 0x71, 0x47, 0x65, 0x07, 0x16, 0xef,
-	0x42, 0xd4, 0xa3, 0x06, 0xed, 0x7b, 0x72, 0x7c, 0x5d, 0xda, 0x48, 0x6d, 0xe5, 0xa6, 0x06, 0x22,
-	0xc8, 0x7e, 0x93, 0xd1, 0x54, 0x41, 0x47, 0x2d, 0x40, 0xf7, 0x2d, 0xc7, 0xe8, 0xea, 0xd4, 0xe8,
-	0x76, 0x07, 0xba, 0x8b, 0xbd, 0x7e, 0x97, 0xca, 0x09, 0x26, 0xf1, 0xe2, 0x94, 0x13, 0xcd, 0x27,
-	0xa9, 0x8c, 0x53, 0x4e, 0xf8, 0x22, 0xb9, 0xc0, 0x0c, 0x73, 0x31, 0x02, 0xa2, 0x16, 0x9c, 0x1b,
-	0x5b, 0xb3, 0x3a, 0x76, 0x4c, 0x19, 0xce, 0x9a, 0xb8, 0xf4, 0xe8, 0xae, 0x55, 0x1c, 0x13, 0x35,
-	0x20, 0xcd, 0x57, 0x2d, 0x71, 0x83, 0x50, 0x93, 0x4c, 0xef, 0xff, 0x4e, 0xd5, 0xab, 0x08, 0x3e,
-	0x0f, 0x4c, 0x4d, 0xe1, 0xb1, 0x33, 0xba, 0xea, 0xf7, 0x8b, 0xe7, 0x19, 0x1d, 0xec, 0xc9, 0x0b,
-	0xeb, 0xe1, 0xd3, 0x06, 0x49, 0x1d, 0xb2, 0xd0, 0x12, 0xcc, 0x53, 0x8b, 0x76, 0xb1, 0xbc, 0xc8,
-	0xda, 0x8b, 0x1f, 0xfc, 0x89, 0xf5, 0xfa, 0xb6, 0x6d, 0xb8, 0x03, 0x39, 0xc5, 0xee, 0x83, 0xe3,
-	0x8d, 0x88, 0x3f, 0x04, 0xf9, 0xcf, 0x25, 0x48, 0x8e, 0x26, 0x68, 0x0d, 0x12, 0x03, 0xec, 0xe9,
-	0x6d, 0xd2, 0x77, 0xa8, 0xf8, 0x38, 0x8d, 0x0f, 0xb0, 0x57, 0xf1, 0xcf, 0x7e, 0x93, 0x18, 0xfb,
-	0x1e, 0x35, 0x2c, 0x47, 0x10, 0xf8, 0x77, 0x91, 0x05, 0x71, 0xc9, 0x49, 0x2b, 0x10, 0x77, 0x88,
-	0xc0, 0x79, 0xa7, 0xc7, 0x1c, 0xc2, 0xa1, 0xff, 0x03, 0x72, 0x88, 0x7e, 0x64, 0xd1, 0x03, 0xfd,
-	0x10, 0xd3, 0x80, 0xc4, 0x97, 0x4c, 0xda, 0x21, 0x7b, 0x16, 0x3d, 0xd8, 0xc5, 0x94, 0x93, 0x45,
-	0x7c, 0xbf, 0x4b, 0x10, 0xd9, 0x25, 0x14, 0xa3, 0x1c, 0x24, 0x7b, 0x22, 0x75, 0xc7, 0x8b, 0x17,
-	0x82, 0x2b, 0xbe, 0xe7, 0x0e, 0x09, 0x15, 0xab, 0x77, 0xe6, 0x9e, 0x63, 0x34, 0x74, 0x1d, 0xa2,
-	0xa4, 0xe7, 0x7f, 0xac, 0xb1, 0x28, 0x53, 0x5b, 0x6b, 0x53, 0xa5, 0xf2, 0xdf, 0xad, 0x33, 0x8a,
-	0x2a, 0xa8, 0x33, 0x97, 0xe3, 0x5b, 0x1c, 0xc7, 0xcd, 0x4f, 0x25, 0x80, 0xe3, 0xe7, 0xd1, 0x1a,
-	0x2c, 0xef, 0xd6, 0x35, 0x45, 0xaf, 0x37, 0xb4, 0x6a, 0xbd, 0xa6, 0xb7, 0x6a, 0xcd, 0x86, 0x52,
-	0xa9, 0xde, 0xac, 0x2a, 0xdb, 0x99, 0x39, 0x74, 0x1e, 0xd2, 0xa3, 0xe0, 0x5d, 0xa5, 0x99, 0x91,
-	0xd0, 0x32, 0x9c, 0x1f, 0xbd, 0x2c, 0x95, 0x9b, 0x5a, 0xa9, 0x5a, 0xcb, 0x84, 0x10, 0x82, 0xd4,
-	0x28, 0x50, 0xab, 0x67, 0xc2, 0xe8, 0x22, 0xc8, 0xe3, 0x77, 0xfa, 0x5e, 0x55, 0xbb, 0xa5, 0xef,
-	0x2a, 0x5a, 0x3d, 0x13, 0x59, 0x8d, 0x3c, 0xfc, 0x22, 0x3b, 0xb7, 0xf9, 0xbd, 0x04, 0xa9, 0xf1,
-	0x59, 0x45, 0x39, 0x58, 0x6b, 0xa8, 0xf5, 0x46, 0xbd, 0x59, 0xba, 0xad, 0x37, 0xb5, 0x92, 0xd6,
-	0x6a, 0x4e, 0x44, 0xf6, 0x6f, 0x58, 0x99, 0x24, 0x34, 0x5b, 0xe5, 0x3b, 0x55, 0x4d, 0x53, 0xb6,
-	0x33, 0x92, 0xff, 0xec, 0x24, 0x5c, 0xaa, 0x54, 0x94, 0x86, 0x8f, 0x86, 0x4e, 0x42, 0x55, 0x65,
-	0x47, 0xa9, 0xf8, 0x68, 0xd8, 0xcf, 0xc8, 0x94, 0x6d, 0xb9, 0xae, 0xfa, 0x60, 0xe4, 0xa4, 0x77,
-	0x7d, 0x41, 0xdb, 0x6a, 0x69, 0xaf, 0x96, 0x99, 0x17, 0x82, 0xbe, 0x96, 0xe0, 0xc2, 0xc9, 0xc3,
-	0x88, 0x36, 0xe0, 0xf2, 0xd0, 0x5e, 0xf9, 0x50, 0xa9, 0xb4, 0xb4, 0xba, 0xaa, 0xab, 0x4a, 0xb3,
-	0x75, 0x5b, 0x9b, 0x50, 0x78, 0x19, 0xd6, 0x4f, 0x65, 0xd6, 0xea, 0x9a, 0xae, 0xb6, 0x6a, 0x19,
-	0x69, 0x26, 0xab, 0xd9, 0xaa, 0x54, 0x94, 0x66, 0x33, 0x13, 0x9a, 0xc9, 0xba, 0x59, 0xaa, 0xde,
-	0x6e, 0xa9, 0x4a, 0x26, 0xcc, 0x83, 0x2f, 0xbf, 0xf7, 0xec, 0x45, 0x56, 0x7a, 0xfe, 0x22, 0x2b,
-	0xfd, 0xf2, 0x22, 0x2b, 0x3d, 0x7a, 0x99, 0x9d, 0x7b, 0xfe, 0x32, 0x3b, 0xf7, 0xe3, 0xcb, 0xec,
-	0xdc, 0xbd, 0xcb, 0x1d, 0x8b, 0x1e, 0xf4, 0xf7, 0x0b, 0x6d, 0x62, 0x8b, 0x5f, 0x5b, 0xc5, 0x91,
-	0x6f, 0xa6, 0x0f, 0xf8, 0x8f, 0xc1, 0xfd, 0x28, 0x6b, 0xc7, 0xeb, 0x7f, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0x98, 0xe9, 0x54, 0xdb, 0x23, 0x0e, 0x00, 0x00,
+	// 1625 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x3d, 0x6c, 0x1b, 0xc9,
+	0x15, 0xd6, 0xf2, 0x4f, 0xe4, 0xa3, 0x44, 0x52, 0x73, 0xca, 0x69, 0x25, 0x39, 0xa4, 0xb2, 0x32,
+	0xee, 0x0c, 0x05, 0x26, 0x7d, 0x3a, 0xe0, 0x02, 0x28, 0x40, 0x10, 0xfe, 0xec, 0xd9, 0xd4, 0x49,
+	0x24, 0xb3, 0x5c, 0x4a, 0xb9, 0x6b, 0x16, 0x2b, 0xee, 0x88, 0x5a, 0x98, 0xdc, 0x61, 0x76, 0x87,
+	0xd2, 0xb1, 0x4c, 0x15, 0x23, 0x4d, 0xae, 0x4c, 0x13, 0xc0, 0x40, 0x9a, 0x94, 0x2e, 0x8c, 0x14,
+	0xa9, 0x53, 0x18, 0x29, 0x02, 0x23, 0x55, 0xaa, 0x24, 0xb0, 0x0b, 0xa7, 0x49, 0xaa, 0xb4, 0x09,
+	0x82, 0x9d, 0x99, 0xa5, 0xf8, 0x23, 0x51, 0x96, 0x61, 0x5c, 0x23, 0x68, 0xe6, 0x7d, 0xef, 0xcd,
+	0x7b, 0xdf, 0xfb, 0x99, 0x59, 0xc2, 0x66, 0x9b, 0x78, 0x3d, 0xe2, 0x15, 0x3a, 0x2e, 0x19, 0xf4,
+	0x0b, 0xe7, 0x9f, 0x14, 0xe8, 0xb0, 0x8f, 0xbd, 0x7c, 0xdf, 0x25, 0x94, 0xa0, 0x34, 0x17, 0xe6,
+	0x99, 0x30, 0x7f, 0xfe, 0xc9, 0xc6, 0x6a, 0x87, 0x74, 0x08, 0x93, 0x15, 0xfc, 0xff, 0x38, 0x6c,
+	0x23, 0xdb, 0x21, 0xa4, 0xd3, 0xc5, 0x05, 0xb6, 0x3a, 0x19, 0x9c, 0x16, 0xac, 0x81, 0x6b, 0x52,
+	0x9b, 0x38, 0x42, 0x9e, 0x9b, 0x96, 0x53, 0xbb, 0x87, 0x3d, 0x6a, 0xf6, 0xfa, 0x02, 0xb0, 0xce,
+	0xcf, 0x31, 0xb8, 0x65, 0x71, 0xa8, 0x10, 0x4d, 0xeb, 0x9a, 0xce, 0x50, 0x88, 0x56, 0xcc, 0x9e,
+	0xed, 0x90, 0x02, 0xfb, 0xcb, 0xb7, 0x94, 0xdf, 0x4b, 0x10, 0x3b, 0xc4, 0xbd, 0x13, 0xec, 0xa2,
+	0x5d, 0x58, 0x34, 0x2d, 0xcb, 0xc5, 0x9e, 0x27, 0x4b, 0x5b, 0xd2, 0xbd, 0x44, 0x49, 0xfe, 0xcb,
+	0xf3, 0xfb, 0xab, 0xc2, 0x76, 0x91, 0x4b, 0x9a, 0xd4, 0xb5, 0x9d, 0x8e, 0x16, 0x00, 0xd1, 0x87,
+	0x10, 0xbb, 0xc0, 0x76, 0xe7, 0x8c, 0xca, 0x21, 0x5f, 0x45, 0x13, 0x2b, 0xb4, 0x01, 0xf1, 0x1e,
+	0xa6, 0xa6, 0x65, 0x52, 0x53, 0x0e, 0x33, 0xc9, 0x68, 0x8d, 0x2a, 0x10, 0x37, 0x2d, 0x0b, 0x5b,
+	0x86, 0x49, 0xe5, 0xc8, 0x96, 0x74, 0x2f, 0xb9, 0xbb, 0x91, 0xe7, 0x3e, 0xe7, 0x03, 0x9f, 0xf3,
+	0x7a, 0x10, 0x6f, 0x69, 0xf9, 0xc5, 0xdf, 0x72, 0x0b, 0xdf, 0xfc, 0x3d, 0x27, 0xfd, 0xee, 0xcd,
+	0xb3, 0x1d, 0x89, 0x9d, 0x8c, 0xad, 0x22, 0x55, 0x2e, 0x60, 0x99, 0xfb, 0xad, 0xe1, 0x9f, 0x0d,
+	0xb0, 0x47, 0xbf, 0x2d, 0xf7, 0x95, 0x3f, 0x4a, 0xb0, 0xa6, 0x9f, 0xb9, 0xd8, 0x3b, 0x23, 0x5d,
+	0xab, 0x82, 0xdb, 0xb6, 0x67, 0x13, 0xa7, 0x41, 0xba, 0x76, 0x7b, 0x88, 0xee, 0x40, 0x82, 0x06,
+	0x22, 0xee, 0x85, 0x76, 0xb9, 0x81, 0x7e, 0x0c, 0x8b, 0x17, 0xb6, 0x63, 0x91, 0x0b, 0x8f, 0x1d,
+	0x97, 0xdc, 0xfd, 0x28, 0x3f, 0x55, 0x2e, 0xf9, 0x49, 0x7b, 0xc7, 0x1c, 0xad, 0x05, 0x6a, 0x7b,
+	0xd5, 0x3f, 0x3d, 0xbf, 0x9f, 0x9d, 0xaf, 0xf3, 0xcb, 0x37, 0xcf, 0x76, 0x14, 0x0e, 0xb9, 0xef,
+	0x59, 0x8f, 0x0b, 0xd7, 0xb8, 0xaa, 0xbc, 0x90, 0x40, 0x6e, 0x60, 0xb7, 0x8d, 0x1d, 0x6a, 0x76,
+	0xf0, 0x54, 0x1c, 0x59, 0x80, 0xfe, 0x48, 0x26, 0x02, 0x19, 0xdb, 0x79, 0x0f, 0x91, 0xec, 0xbf,
+	0x5d, 0x24, 0xdb, 0x63, 0x91, 0x5c, 0xe7, 0xad, 0xf2, 0x34, 0x04, 0x9b, 0x3a, 0x79, 0x8c, 0x9d,
+	0x63, 0x96, 0x3d, 0x3c, 0x9d, 0x95, 0x55, 0x88, 0x5a, 0xd8, 0x21, 0x3d, 0x11, 0x08, 0x5f, 0xa0,
+	0x3d, 0x88, 0x79, 0x64, 0xe0, 0xb6, 0x31, 0x0b, 0x21, 0xb5, 0xab, 0xcc, 0x84, 0x30, 0x66, 0xb3,
+	0xc9, 0x90, 0x9a, 0xd0, 0x98, 0xe2, 0x27, 0x3c, 0x8f, 0x9f, 0xc8, 0xbb, 0xf1, 0x73, 0xf8, 0x76,
+	0xfc, 0x7c, 0x34, 0x9e, 0xe9, 0xeb, 0x29, 0x50, 0x7e, 0x11, 0x86, 0xef, 0x5c, 0x79, 0x22, 0x3a,
+	0x84, 0xe5, 0x73, 0x42, 0x6d, 0xa7, 0x63, 0xf4, 0xb1, 0x6b, 0x13, 0x5e, 0xb6, 0xc9, 0xdd, 0xf5,
+	0x99, 0x96, 0xac, 0x88, 0x11, 0xc5, 0x3b, 0xf2, 0xd7, 0xa3, 0x8e, 0x5c, 0xe2, 0xea, 0x0d, 0xa6,
+	0x8d, 0xbe, 0x82, 0xd5, 0x9e, 0xed, 0x18, 0xf8, 0x6b, 0xdc, 0x1e, 0xf8, 0xe8, 0xc0, 0x6a, 0xe8,
+	0x96, 0x56, 0x51, 0xcf, 0x76, 0xd4, 0xc0, 0x88, 0xb0, 0x5d, 0x03, 0x74, 0x69, 0xd7, 0xc2, 0xa6,
+	0xd5, 0xb5, 0x1d, 0xce, 0xfe, 0x5c, 0xcb, 0x11, 0xdf, 0xaa, 0xb6, 0x32, 0x52, 0xad, 0x08, 0x4d,
+	0xf4, 0x43, 0x88, 0xfb, 0x73, 0xb5, 0x4b, 0xda, 0x8f, 0xe5, 0xc8, 0xdb, 0x59, 0x19, 0x29, 0xa0,
+	0xcf, 0x20, 0xd1, 0x19, 0x98, 0xae, 0x65, 0x9b, 0x8e, 0x27, 0x47, 0xb7, 0xc2, 0x73, 0x07, 0xce,
+	0x25, 0x54, 0xf9, 0xb7, 0x04, 0x89, 0x87, 0x7e, 0x42, 0xab, 0xce, 0x29, 0x41, 0x29, 0x08, 0xd9,
+	0x9c, 0xf2, 0x88, 0x16, 0xb2, 0x2d, 0x94, 0x87, 0xa8, 0x69, 0xf5, 0x6c, 0x87, 0xcf, 0xa3, 0x39,
+	0x16, 0x39, 0x6c, 0xee, 0x9c, 0x95, 0x61, 0xf1, 0x1c, 0xbb, 0x7e, 0xc6, 0x59, 0x74, 0x11, 0x2d,
+	0x58, 0xa2, 0xef, 0xc1, 0x12, 0x25, 0xd4, 0xec, 0x1a, 0x62, 0xf8, 0x45, 0x99, 0x66, 0x92, 0xed,
+	0xf1, 0x02, 0x42, 0x8f, 0x00, 0xda, 0x2e, 0x36, 0x29, 0x1f, 0xd3, 0xb1, 0xdb, 0x8e, 0xe9, 0x84,
+	0x50, 0x2e, 0x52, 0xe5, 0x4b, 0x48, 0xb2, 0x78, 0xc5, 0x2d, 0xb3, 0x0e, 0x71, 0x56, 0xcf, 0xc6,
+	0x28, 0xee, 0x45, 0xb6, 0xae, 0x5a, 0xa8, 0x00, 0xb1, 0x1e, 0x03, 0x89, 0x6a, 0x59, 0x9b, 0x69,
+	0x1a, 0x31, 0xf1, 0x05, 0x4c, 0xf9, 0x6f, 0x08, 0xd2, 0xcc, 0x36, 0x2f, 0x69, 0xc6, 0xe8, 0xbb,
+	0x5c, 0x03, 0xe3, 0x3e, 0x85, 0x26, 0x7d, 0x1a, 0x25, 0x24, 0x7c, 0xfb, 0x84, 0x44, 0xae, 0x4f,
+	0x48, 0x74, 0x32, 0x21, 0x26, 0xa4, 0x2d, 0xd1, 0x9d, 0x46, 0x9f, 0xc5, 0x22, 0x28, 0x5f, 0x9d,
+	0xa1, 0xbc, 0xe8, 0x0c, 0x4b, 0xca, 0xcd, 0xc3, 0x41, 0x4b, 0x59, 0x13, 0xeb, 0xa9, 0x84, 0x2e,
+	0xbe, 0x7b, 0x42, 0xf7, 0xe2, 0x4f, 0x9e, 0xe6, 0x16, 0xfe, 0xf9, 0x34, 0x27, 0x29, 0xff, 0x8b,
+	0x42, 0xbc, 0xe1, 0x92, 0x3e, 0xf1, 0xcc, 0xee, 0x4c, 0x29, 0xef, 0xc3, 0x2a, 0x27, 0x95, 0x07,
+	0x64, 0x04, 0x59, 0xb9, 0xa9, 0xb2, 0x51, 0xe7, 0x32, 0xa3, 0x42, 0x32, 0xb7, 0xcc, 0x3f, 0x83,
+	0x44, 0x9f, 0xf9, 0x80, 0x5d, 0x7f, 0xda, 0xde, 0xd0, 0x88, 0x23, 0x28, 0xda, 0x87, 0xa4, 0x37,
+	0x38, 0xe9, 0xd9, 0xd4, 0xf0, 0x7b, 0x5a, 0x8e, 0xde, 0x96, 0x11, 0xe0, 0xda, 0xbe, 0x1c, 0x6d,
+	0xc3, 0x32, 0x8f, 0x35, 0xc8, 0x6f, 0x8c, 0xd1, 0xb0, 0xc4, 0x36, 0x8f, 0x44, 0x92, 0x1f, 0x4c,
+	0x11, 0x12, 0x60, 0x17, 0x19, 0x76, 0x3c, 0xec, 0x40, 0xe3, 0x07, 0x10, 0xf3, 0xa8, 0x49, 0x07,
+	0x9e, 0x1c, 0x67, 0x57, 0x54, 0x6e, 0xa6, 0x21, 0x02, 0xf6, 0x9b, 0x0c, 0xa6, 0x09, 0x38, 0x6a,
+	0x01, 0x3a, 0xb5, 0x1d, 0xb3, 0x6b, 0x50, 0xb3, 0xdb, 0x1d, 0x1a, 0x2e, 0xf6, 0x06, 0x5d, 0x2a,
+	0x27, 0x58, 0x88, 0x77, 0x66, 0xef, 0x39, 0x1f, 0xa4, 0x31, 0x4c, 0x29, 0xe1, 0x07, 0xc9, 0x03,
+	0xcc, 0x30, 0x13, 0x63, 0x42, 0xd4, 0x82, 0x95, 0x89, 0xbb, 0xc2, 0xc0, 0x8e, 0x25, 0xc3, 0x6d,
+	0x89, 0x4b, 0x8f, 0x5f, 0x18, 0xaa, 0x63, 0xa1, 0x06, 0xa4, 0xf9, 0x70, 0x26, 0x6e, 0xe0, 0x6a,
+	0x92, 0xc5, 0xfb, 0xf1, 0xb5, 0xf1, 0xaa, 0x02, 0xcf, 0x1d, 0xd3, 0x52, 0x78, 0x62, 0x8d, 0x1e,
+	0xf8, 0xf5, 0xe2, 0x79, 0x66, 0x07, 0x7b, 0xf2, 0xd2, 0x56, 0xf8, 0xba, 0x46, 0xd2, 0x46, 0x28,
+	0xff, 0x8d, 0x40, 0x6d, 0xda, 0xc5, 0xf2, 0x32, 0x7f, 0x23, 0xb0, 0x85, 0xdf, 0xb1, 0xde, 0xa0,
+	0xd7, 0x33, 0xdd, 0xa1, 0x9c, 0x62, 0xfb, 0xc1, 0x72, 0x2f, 0xe2, 0x37, 0x81, 0xf2, 0x1b, 0x09,
+	0x92, 0xe3, 0x04, 0x6d, 0x42, 0x62, 0x88, 0x3d, 0xa3, 0x4d, 0x06, 0x0e, 0x15, 0xaf, 0x8d, 0xf8,
+	0x10, 0x7b, 0x65, 0x7f, 0xed, 0x17, 0x89, 0x79, 0xe2, 0x51, 0xd3, 0x76, 0x04, 0x80, 0xbf, 0x39,
+	0x97, 0xc4, 0x26, 0x07, 0xad, 0x43, 0xdc, 0x21, 0x42, 0xce, 0x2b, 0x7d, 0xd1, 0x21, 0x5c, 0xf4,
+	0x7d, 0x40, 0x0e, 0x31, 0x2e, 0x6c, 0x7a, 0x66, 0x9c, 0x63, 0x1a, 0x80, 0xf8, 0x90, 0x49, 0x3b,
+	0xe4, 0xd8, 0xa6, 0x67, 0x47, 0x98, 0x72, 0xb0, 0xf0, 0xef, 0x3f, 0x12, 0x44, 0x8e, 0x08, 0xc5,
+	0x28, 0x07, 0xc9, 0xbe, 0xa0, 0xee, 0x72, 0xf0, 0x42, 0xb0, 0xc5, 0xe7, 0xdc, 0x39, 0xa1, 0x62,
+	0xf4, 0xce, 0x9d, 0x73, 0x0c, 0x86, 0x3e, 0x85, 0x18, 0xe9, 0xfb, 0x17, 0x23, 0xf3, 0x32, 0xb5,
+	0xbb, 0x39, 0x93, 0x2a, 0xff, 0xdc, 0x3a, 0x83, 0x68, 0x02, 0x3a, 0x77, 0x38, 0xbe, 0xc7, 0x76,
+	0x54, 0x7e, 0x2e, 0xc1, 0x6a, 0x50, 0x29, 0xfc, 0xca, 0x10, 0xb7, 0xda, 0x8d, 0x34, 0x3c, 0x98,
+	0xb8, 0x82, 0xe6, 0xf1, 0x20, 0x70, 0x63, 0x9f, 0x10, 0xe1, 0xf1, 0x4f, 0x08, 0xe5, 0xcf, 0x12,
+	0xa4, 0x7f, 0x32, 0xc0, 0x03, 0x6c, 0x8d, 0x9e, 0x31, 0x37, 0x1f, 0xff, 0x3e, 0x67, 0x66, 0x0d,
+	0x96, 0x79, 0x57, 0x60, 0xc3, 0x3c, 0xf5, 0x33, 0x1b, 0xbe, 0x2d, 0xa5, 0x4b, 0x42, 0xbf, 0xe8,
+	0xab, 0xef, 0xfc, 0x4a, 0x02, 0xb8, 0xcc, 0x29, 0xda, 0x84, 0xb5, 0xa3, 0xba, 0xae, 0x1a, 0xf5,
+	0x86, 0x5e, 0xad, 0xd7, 0x8c, 0x56, 0xad, 0xd9, 0x50, 0xcb, 0xd5, 0xcf, 0xab, 0x6a, 0x25, 0xb3,
+	0x80, 0x3e, 0x80, 0xf4, 0xb8, 0xf0, 0x4b, 0xb5, 0x99, 0x91, 0xd0, 0x1a, 0x7c, 0x30, 0xbe, 0x59,
+	0x2c, 0x35, 0xf5, 0x62, 0xb5, 0x96, 0x09, 0x21, 0x04, 0xa9, 0x71, 0x41, 0xad, 0x9e, 0x09, 0xa3,
+	0x3b, 0x20, 0x4f, 0xee, 0x19, 0xc7, 0x55, 0xfd, 0x91, 0x71, 0xa4, 0xea, 0xf5, 0x4c, 0x64, 0x23,
+	0xf2, 0xe4, 0xb7, 0xd9, 0x85, 0x9d, 0x27, 0x12, 0xac, 0xcc, 0xbc, 0xd1, 0xd1, 0x36, 0xe4, 0xf4,
+	0xfa, 0x17, 0x6a, 0xcd, 0x38, 0x56, 0xab, 0x0f, 0x1f, 0xe9, 0x46, 0xb3, 0xde, 0xd2, 0xca, 0xea,
+	0x94, 0x83, 0x39, 0xd8, 0xbc, 0x0a, 0x54, 0x2a, 0x1e, 0x14, 0x6b, 0x65, 0x35, 0x23, 0xa1, 0x8f,
+	0x61, 0xfb, 0x2a, 0x40, 0x45, 0x3d, 0x50, 0x1f, 0x16, 0x75, 0xb5, 0x62, 0x34, 0xf5, 0xe2, 0x17,
+	0x6a, 0x26, 0x24, 0x5c, 0xf9, 0x97, 0x04, 0xa9, 0xc9, 0x59, 0xec, 0x1f, 0xd1, 0xd0, 0xea, 0x8d,
+	0x7a, 0xb3, 0x78, 0xe0, 0x83, 0xf5, 0x56, 0x73, 0xca, 0x87, 0xef, 0xc2, 0xfa, 0x34, 0xa0, 0xd9,
+	0x2a, 0x1d, 0x56, 0x75, 0x5d, 0xad, 0x64, 0x24, 0x9f, 0x81, 0x69, 0x71, 0xb1, 0x5c, 0x56, 0x1b,
+	0xbe, 0x34, 0x74, 0x95, 0x54, 0x53, 0xf7, 0xd5, 0xb2, 0x2f, 0x0d, 0xfb, 0xc9, 0x99, 0xd1, 0x2d,
+	0xd5, 0x35, 0x5f, 0x18, 0xb9, 0xea, 0x5c, 0x9f, 0xdb, 0x8a, 0x56, 0x3c, 0xae, 0x65, 0xa2, 0x57,
+	0x89, 0xcb, 0x3e, 0x29, 0x07, 0x07, 0x6a, 0x25, 0x13, 0x13, 0xf1, 0xfe, 0x41, 0x82, 0x0f, 0xaf,
+	0x9e, 0xc5, 0xe8, 0x1e, 0xdc, 0x1d, 0xe9, 0xab, 0x3f, 0x55, 0xcb, 0x2d, 0xbd, 0xae, 0x19, 0x9a,
+	0xda, 0x6c, 0x1d, 0xe8, 0x53, 0x04, 0xdc, 0x85, 0xad, 0x6b, 0x91, 0xb5, 0xba, 0x6e, 0x68, 0xad,
+	0x5a, 0x46, 0x9a, 0x8b, 0x6a, 0xb6, 0xca, 0x65, 0xb5, 0xd9, 0xcc, 0x84, 0xe6, 0xa2, 0x3e, 0x2f,
+	0x56, 0x0f, 0x5a, 0x9a, 0x9a, 0x09, 0x73, 0xe7, 0x4b, 0x3f, 0x7a, 0xf1, 0x2a, 0x2b, 0xbd, 0x7c,
+	0x95, 0x95, 0xfe, 0xf1, 0x2a, 0x2b, 0x7d, 0xf3, 0x3a, 0xbb, 0xf0, 0xf2, 0x75, 0x76, 0xe1, 0xaf,
+	0xaf, 0xb3, 0x0b, 0x5f, 0xdd, 0xed, 0xd8, 0xf4, 0x6c, 0x70, 0x92, 0x6f, 0x93, 0x9e, 0xf8, 0x51,
+	0xa5, 0x30, 0xf6, 0x81, 0xf5, 0x35, 0xff, 0xcd, 0xe7, 0x24, 0xc6, 0x5a, 0xe7, 0xd3, 0xff, 0x0f,
+	0x00, 0x0b, 0xed, 0x94, 0x92, 0x0a, 0x12, 0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1232,6 +1539,60 @@ func (m *PercentageDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenWeightedDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenWeightedDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenWeightedDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Windows != nil {
+		{
+			size, err := m.Windows.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Percentage) > 0 {
+		i -= len(m.Percentage)
+		copy(dAtA[i:], m.Percentage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Percentage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Source != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DecisionPolicyWindows) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1252,20 +1613,49 @@ func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
-	if err4 != nil {
-		return 0, err4
+	if len(m.Guardians) > 0 {
+		for iNdEx := len(m.Guardians) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Guardians[iNdEx])
+			copy(dAtA[i:], m.Guardians[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Guardians[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Timelock != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Timelock, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Timelock):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTypes(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExecutionDeadline != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExecutionDeadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExecutionDeadline):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTypes(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n4
-	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x12
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
-	if err5 != nil {
-		return 0, err5
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n5
-	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i -= n8
+	i = encodeVarintTypes(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1291,12 +1681,12 @@ func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err6 != nil {
-		return 0, err6
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n6
-	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i -= n9
+	i = encodeVarintTypes(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x32
 	if len(m.TotalWeight) > 0 {
@@ -1393,12 +1783,12 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err8 != nil {
-		return 0, err8
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n8
-	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i -= n11
+	i = encodeVarintTypes(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x3a
 	if m.DecisionPolicy != nil {
@@ -1500,12 +1890,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x58
 	}
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err10 != nil {
-		return 0, err10
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n13
+	i = encodeVarintTypes(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x52
 	{
@@ -1533,12 +1923,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err12 != nil {
-		return 0, err12
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1643,12 +2033,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err13 != nil {
-		return 0, err13
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err16 != nil {
+		return 0, err16
 	}
-	i -= n13
-	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i -= n16
+	i = encodeVarintTypes(dAtA, i, uint64(n16))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1678,16 +2068,101 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ProposalMemberWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *ProposalMemberWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalMemberWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecuteAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecuteAfter):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintTypes(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *Member) Size() (n int) {
 	if m == nil {
@@ -1767,6 +2242,30 @@ func (m *PercentageDecisionPolicy) Size() (n int) {
 	return n
 }
 
+func (m *TokenWeightedDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovTypes(uint64(m.Source))
+	}
+	l = len(m.Percentage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Windows != nil {
+		l = m.Windows.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *DecisionPolicyWindows) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1777,6 +2276,20 @@ func (m *DecisionPolicyWindows) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod)
 	n += 1 + l + sovTypes(uint64(l))
+	if m.ExecutionDeadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExecutionDeadline)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Timelock != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Timelock)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Guardians) > 0 {
+		for _, s := range m.Guardians {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1966,6 +2479,44 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *ProposalMemberWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *QueuedExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecuteAfter)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2533,7 +3084,7 @@ func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
+func (m *TokenWeightedDecisionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2556,17 +3107,17 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecisionPolicyWindows: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenWeightedDecisionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecisionPolicyWindows: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenWeightedDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2576,28 +3127,78 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= TokenWeightSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Percentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2624,7 +3225,10 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinExecutionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Windows == nil {
+				m.Windows = &DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2649,7 +3253,7 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupInfo) Unmarshal(dAtA []byte) error {
+func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2672,17 +3276,17 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecisionPolicyWindows: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecisionPolicyWindows: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2692,16 +3296,30 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2711,29 +3329,30 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinExecutionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDeadline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2743,13 +3362,218 @@ func (m *GroupInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionDeadline == nil {
+				m.ExecutionDeadline = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ExecutionDeadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timelock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timelock == nil {
+				m.Timelock = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Timelock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardians", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardians = append(m.Guardians, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GroupInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GroupInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GroupInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
 			postIndex := iNdEx + intStringLen
@@ -4025,6 +4849,273 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProposalMemberWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalMemberWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalMemberWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecuteAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
 )
 
@@ -386,3 +387,193 @@ func TestThresholdDecisionPolicyAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenWeightedDecisionPolicyValidateBasic(t *testing.T) {
+	windows := &group.DecisionPolicyWindows{VotingPeriod: time.Hour}
+	testCases := []struct {
+		name   string
+		policy group.TokenWeightedDecisionPolicy
+		expErr bool
+	}{
+		{
+			"invalid denom",
+			group.TokenWeightedDecisionPolicy{
+				Denom:      "",
+				Source:     group.TOKEN_WEIGHT_SOURCE_BALANCE,
+				Percentage: "0.5",
+				Windows:    windows,
+			},
+			true,
+		},
+		{
+			"unspecified source",
+			group.TokenWeightedDecisionPolicy{
+				Denom:      "stake",
+				Source:     group.TOKEN_WEIGHT_SOURCE_UNSPECIFIED,
+				Percentage: "0.5",
+				Windows:    windows,
+			},
+			true,
+		},
+		{
+			"invalid source",
+			group.TokenWeightedDecisionPolicy{
+				Denom:      "stake",
+				Source:     group.TokenWeightSource(10),
+				Percentage: "0.5",
+				Windows:    windows,
+			},
+			true,
+		},
+		{
+			"percentage too big",
+			group.TokenWeightedDecisionPolicy{
+				Denom:      "stake",
+				Source:     group.TOKEN_WEIGHT_SOURCE_BALANCE,
+				Percentage: "1.5",
+				Windows:    windows,
+			},
+			true,
+		},
+		{
+			"no voting period",
+			group.TokenWeightedDecisionPolicy{
+				Denom:      "stake",
+				Source:     group.TOKEN_WEIGHT_SOURCE_BALANCE,
+				Percentage: "0.5",
+				Windows:    &group.DecisionPolicyWindows{},
+			},
+			true,
+		},
+		{
+			"all good",
+			group.TokenWeightedDecisionPolicy{
+				Denom:      "stake",
+				Source:     group.TOKEN_WEIGHT_SOURCE_DELEGATED_STAKE,
+				Percentage: "0.5",
+				Windows:    windows,
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDecisionPolicyWindowsValidate(t *testing.T) {
+	g := group.GroupInfo{
+		TotalWeight: "10",
+	}
+	config := group.DefaultConfig()
+	guardian := sdk.AccAddress("guardian____________").String()
+	duration := func(d time.Duration) *time.Duration { return &d }
+	testCases := []struct {
+		name    string
+		windows *group.DecisionPolicyWindows
+		expErr  bool
+	}{
+		{
+			"negative execution deadline",
+			&group.DecisionPolicyWindows{
+				VotingPeriod:      time.Hour,
+				ExecutionDeadline: duration(-time.Hour),
+			},
+			true,
+		},
+		{
+			"execution deadline too big",
+			&group.DecisionPolicyWindows{
+				VotingPeriod:      time.Hour,
+				ExecutionDeadline: duration(config.MaxExecutionPeriod + time.Hour),
+			},
+			true,
+		},
+		{
+			"negative timelock",
+			&group.DecisionPolicyWindows{
+				VotingPeriod: time.Hour,
+				Timelock:     duration(-time.Hour),
+			},
+			true,
+		},
+		{
+			"timelock bigger than execution deadline",
+			&group.DecisionPolicyWindows{
+				VotingPeriod:      time.Hour,
+				ExecutionDeadline: duration(time.Hour),
+				Timelock:          duration(time.Hour * 2),
+			},
+			true,
+		},
+		{
+			"timelock equal to execution deadline",
+			&group.DecisionPolicyWindows{
+				VotingPeriod:      time.Hour,
+				ExecutionDeadline: duration(time.Hour),
+				Timelock:          duration(time.Hour),
+			},
+			true,
+		},
+		{
+			"guardians without timelock",
+			&group.DecisionPolicyWindows{
+				VotingPeriod: time.Hour,
+				Guardians:    []string{guardian},
+			},
+			true,
+		},
+		{
+			"invalid guardian",
+			&group.DecisionPolicyWindows{
+				VotingPeriod: time.Hour,
+				Timelock:     duration(time.Hour),
+				Guardians:    []string{"invalid"},
+			},
+			true,
+		},
+		{
+			"duplicate guardians",
+			&group.DecisionPolicyWindows{
+				VotingPeriod: time.Hour,
+				Timelock:     duration(time.Hour),
+				Guardians:    []string{guardian, guardian},
+			},
+			true,
+		},
+		{
+			"all good",
+			&group.DecisionPolicyWindows{
+				VotingPeriod:      time.Hour,
+				ExecutionDeadline: duration(time.Hour * 24),
+				Timelock:          duration(time.Hour),
+				Guardians:         []string{guardian},
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy := group.ThresholdDecisionPolicy{
+				Threshold: "5",
+				Windows:   tc.windows,
+			}
+			err := policy.ValidateBasic()
+			if err == nil {
+				err = policy.Validate(g, config)
+			}
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -10,7 +10,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
-// BeginBlocker mints new tokens for the previous block.
+// BeginBlocker mints new tokens for the previous block, or for the previous
+// epoch at the end of each epoch if epochs are enabled.
 func BeginBlocker(ctx context.Context, k keeper.Keeper, ic types.InflationCalculationFn) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
@@ -35,17 +36,14 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper, ic types.InflationCalcul
 		return err
 	}
 
-	// mint coins, update supply
-	mintedCoin := minter.BlockProvision(params)
-	mintedCoins := sdk.NewCoins(mintedCoin)
-
-	err = k.MintCoins(ctx, mintedCoins)
-	if err != nil {
-		return err
+	// with epochs, the provisions of an epoch are minted at its last block
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if params.EpochLength > 1 && uint64(sdkCtx.BlockHeight())%params.EpochLength != 0 {
+		return nil
 	}
 
-	// send the minted coins to the fee collector account
-	err = k.AddCollectedFees(ctx, mintedCoins)
+	// mint coins, update supply and distribute them
+	mintedCoin, err := k.MintProvision(ctx, minter.EpochProvision(params))
 	if err != nil {
 		return err
 	}
@@ -54,7 +52,6 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper, ic types.InflationCalcul
 		defer telemetry.ModuleSetGauge(types.ModuleName, float32(mintedCoin.Amount.Int64()), "minted_tokens")
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeMint,
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// SetDistributionKeeper sets the distribution keeper used to fund the community
+// pool with the share of the minted provisions of the community pool
+// distribution target.
+func (k *Keeper) SetDistributionKeeper(dk types.DistributionKeeper) {
+	k.distrKeeper = dk
+}
+
+// ValidateDistributionTargets returns an error if a recipient of the given
+// distribution targets cannot receive the minted provisions, i.e. if it is a
+// blocked address, an unknown or blocked module account, or the community pool
+// without a distribution keeper.
+func (k Keeper) ValidateDistributionTargets(targets []types.DistributionTarget) error {
+	for _, target := range targets {
+		switch target.Recipient {
+		case types.BurnRecipient:
+		case types.CommunityPoolRecipient:
+			if k.distrKeeper == nil {
+				return fmt.Errorf("cannot fund the community pool without a distribution keeper")
+			}
+		default:
+			addr, err := sdk.AccAddressFromBech32(target.Recipient)
+			if err != nil {
+				addr = k.accountKeeper.GetModuleAddress(target.Recipient)
+				if addr == nil {
+					return fmt.Errorf("distribution target recipient is neither an address nor a module account: %s", target.Recipient)
+				}
+			}
+
+			if k.bankKeeper.BlockedAddr(addr) {
+				return fmt.Errorf("distribution target recipient is not allowed to receive funds: %s", target.Recipient)
+			}
+		}
+	}
+
+	return nil
+}
+
+// MintProvision mints the given provision and distributes it to the
+// distribution targets, the remainder being sent to the fee collector. The
+// share of the burn distribution target is not minted. It returns the minted
+// coin and emits an event per recipient.
+//
+// It is called by the BeginBlocker every block or at the end of each epoch,
+// but can also be used by applications driving the minting from their own
+// hooks.
+func (k Keeper) MintProvision(ctx context.Context, provision sdk.Coin) (sdk.Coin, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	type share struct {
+		recipient string
+		amount    math.Int
+	}
+
+	burned, distributed := math.ZeroInt(), math.ZeroInt()
+	shares := make([]share, 0, len(params.DistributionTargets))
+	for _, target := range params.DistributionTargets {
+		amount := target.Fraction.MulInt(provision.Amount).TruncateInt()
+		if target.Recipient == types.BurnRecipient {
+			burned = burned.Add(amount)
+			continue
+		}
+
+		shares = append(shares, share{recipient: target.Recipient, amount: amount})
+		distributed = distributed.Add(amount)
+	}
+
+	minted := sdk.NewCoin(provision.Denom, provision.Amount.Sub(burned))
+	if err := k.MintCoins(ctx, sdk.NewCoins(minted)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	emit := func(recipient string, amount math.Int) {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMintDistribution,
+				sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(provision.Denom, amount).String()),
+			),
+		)
+	}
+
+	if burned.IsPositive() {
+		emit(types.BurnRecipient, burned)
+	}
+
+	for _, share := range shares {
+		if !share.amount.IsPositive() {
+			continue
+		}
+
+		if err := k.sendProvision(ctx, share.recipient, sdk.NewCoins(sdk.NewCoin(provision.Denom, share.amount))); err != nil {
+			return sdk.Coin{}, err
+		}
+		emit(share.recipient, share.amount)
+	}
+
+	// send the remainder to the fee collector account
+	remainder := minted.Amount.Sub(distributed)
+	if err := k.AddCollectedFees(ctx, sdk.NewCoins(sdk.NewCoin(provision.Denom, remainder))); err != nil {
+		return sdk.Coin{}, err
+	}
+	if remainder.IsPositive() {
+		emit(k.feeCollectorName, remainder)
+	}
+
+	return minted, nil
+}
+
+// sendProvision sends the given minted coins to the given distribution target
+// recipient.
+func (k Keeper) sendProvision(ctx context.Context, recipient string, coins sdk.Coins) error {
+	if recipient == types.CommunityPoolRecipient {
+		if k.distrKeeper == nil {
+			return fmt.Errorf("cannot fund the community pool without a distribution keeper")
+		}
+
+		return k.distrKeeper.FundCommunityPool(ctx, coins, k.accountKeeper.GetModuleAddress(types.ModuleName))
+	}
+
+	if addr, err := sdk.AccAddressFromBech32(recipient); err == nil {
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipient, coins)
+}
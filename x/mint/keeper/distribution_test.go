@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func (s *IntegrationTestSuite) TestValidateDistributionTargets() {
+	devFund := sdk.AccAddress([]byte("dev_fund____________"))
+	blocked := sdk.AccAddress([]byte("blocked_____________"))
+	s.accountKeeper.EXPECT().GetModuleAddress("dev").Return(authtypes.NewModuleAddress("dev"))
+	s.accountKeeper.EXPECT().GetModuleAddress("unknown").Return(nil)
+	s.accountKeeper.EXPECT().GetModuleAddress("bonded_tokens_pool").Return(authtypes.NewModuleAddress("bonded_tokens_pool"))
+	s.bankKeeper.EXPECT().BlockedAddr(devFund).Return(false)
+	s.bankKeeper.EXPECT().BlockedAddr(authtypes.NewModuleAddress("dev")).Return(false)
+	s.bankKeeper.EXPECT().BlockedAddr(blocked).Return(true)
+	s.bankKeeper.EXPECT().BlockedAddr(authtypes.NewModuleAddress("bonded_tokens_pool")).Return(true)
+
+	s.Require().NoError(s.mintKeeper.ValidateDistributionTargets([]types.DistributionTarget{
+		{Recipient: types.CommunityPoolRecipient, Fraction: math.LegacyNewDecWithPrec(1, 1)},
+		{Recipient: types.BurnRecipient, Fraction: math.LegacyNewDecWithPrec(1, 1)},
+		{Recipient: devFund.String(), Fraction: math.LegacyNewDecWithPrec(1, 1)},
+		{Recipient: "dev", Fraction: math.LegacyNewDecWithPrec(1, 1)},
+	}))
+
+	s.Require().ErrorContains(s.mintKeeper.ValidateDistributionTargets([]types.DistributionTarget{
+		{Recipient: "unknown", Fraction: math.LegacyNewDecWithPrec(1, 1)},
+	}), "neither an address nor a module account")
+
+	s.Require().ErrorContains(s.mintKeeper.ValidateDistributionTargets([]types.DistributionTarget{
+		{Recipient: blocked.String(), Fraction: math.LegacyNewDecWithPrec(1, 1)},
+	}), "not allowed to receive funds")
+
+	s.Require().ErrorContains(s.mintKeeper.ValidateDistributionTargets([]types.DistributionTarget{
+		{Recipient: "bonded_tokens_pool", Fraction: math.LegacyNewDecWithPrec(1, 1)},
+	}), "not allowed to receive funds")
+}
+
+func (s *IntegrationTestSuite) TestMintProvision() {
+	devFund := sdk.AccAddress([]byte("dev_fund____________"))
+	mintAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	params := types.DefaultParams()
+	params.DistributionTargets = []types.DistributionTarget{
+		{Recipient: types.CommunityPoolRecipient, Fraction: math.LegacyNewDecWithPrec(1, 1)},
+		{Recipient: types.BurnRecipient, Fraction: math.LegacyNewDecWithPrec(2, 1)},
+		{Recipient: devFund.String(), Fraction: math.LegacyNewDecWithPrec(15, 2)},
+		{Recipient: "dev", Fraction: math.LegacyNewDecWithPrec(5, 2)},
+	}
+	s.Require().NoError(s.mintKeeper.Params.Set(s.ctx, params))
+
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(amount)))
+	}
+
+	// the share of the burn recipient is not minted
+	s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, coins(800)).Return(nil)
+	s.accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(mintAddr)
+	s.distrKeeper.EXPECT().FundCommunityPool(s.ctx, coins(100), mintAddr).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, types.ModuleName, devFund, coins(150)).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, "dev", coins(50)).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(s.ctx, types.ModuleName, authtypes.FeeCollectorName, coins(500)).Return(nil)
+
+	minted, err := s.mintKeeper.MintProvision(s.ctx, sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000)))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(800)), minted)
+
+	recipients := map[string]string{}
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type != types.EventTypeMintDistribution {
+			continue
+		}
+
+		var recipient, amount string
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case types.AttributeKeyRecipient:
+				recipient = attr.Value
+			case sdk.AttributeKeyAmount:
+				amount = attr.Value
+			}
+		}
+		recipients[recipient] = amount
+	}
+
+	s.Require().Equal(map[string]string{
+		types.BurnRecipient:          "200stake",
+		types.CommunityPoolRecipient: "100stake",
+		devFund.String():             "150stake",
+		"dev":                        "50stake",
+		authtypes.FeeCollectorName:   "500stake",
+	}, recipients)
+}
@@ -11,6 +11,10 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, ak types.AccountKeeper, data *
 		panic(err)
 	}
 
+	if err := keeper.ValidateDistributionTargets(data.Params.DistributionTargets); err != nil {
+		panic(err)
+	}
+
 	if err := keeper.Params.Set(ctx, data.Params); err != nil {
 		panic(err)
 	}
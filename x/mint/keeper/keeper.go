@@ -20,7 +20,9 @@ type Keeper struct {
 	cdc              codec.BinaryCodec
 	storeService     storetypes.KVStoreService
 	stakingKeeper    types.StakingKeeper
+	accountKeeper    types.AccountKeeper
 	bankKeeper       types.BankKeeper
+	distrKeeper      types.DistributionKeeper
 	feeCollectorName string
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
@@ -52,6 +54,7 @@ func NewKeeper(
 		cdc:              cdc,
 		storeService:     storeService,
 		stakingKeeper:    sk,
+		accountKeeper:    ak,
 		bankKeeper:       bk,
 		feeCollectorName: feeCollectorName,
 		authority:        authority,
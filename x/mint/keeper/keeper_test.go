@@ -28,7 +28,9 @@ type IntegrationTestSuite struct {
 	ctx           sdk.Context
 	msgServer     types.MsgServer
 	stakingKeeper *minttestutil.MockStakingKeeper
+	accountKeeper *minttestutil.MockAccountKeeper
 	bankKeeper    *minttestutil.MockBankKeeper
+	distrKeeper   *minttestutil.MockDistributionKeeper
 }
 
 func TestKeeperTestSuite(t *testing.T) {
@@ -47,6 +49,7 @@ func (s *IntegrationTestSuite) SetupTest() {
 	accountKeeper := minttestutil.NewMockAccountKeeper(ctrl)
 	bankKeeper := minttestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := minttestutil.NewMockStakingKeeper(ctrl)
+	distrKeeper := minttestutil.NewMockDistributionKeeper(ctrl)
 
 	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress{})
 
@@ -59,8 +62,11 @@ func (s *IntegrationTestSuite) SetupTest() {
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	s.mintKeeper.SetDistributionKeeper(distrKeeper)
 	s.stakingKeeper = stakingKeeper
+	s.accountKeeper = accountKeeper
 	s.bankKeeper = bankKeeper
+	s.distrKeeper = distrKeeper
 
 	s.Require().Equal(testCtx.Ctx.Logger().With("module", "x/"+types.ModuleName),
 		s.mintKeeper.Logger(testCtx.Ctx))
@@ -34,6 +34,10 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 		return nil, err
 	}
 
+	if err := ms.ValidateDistributionTargets(msg.Params.DistributionTargets); err != nil {
+		return nil, err
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if err := ms.Params.Set(ctx, msg.Params); err != nil {
 		return nil, err
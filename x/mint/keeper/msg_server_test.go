@@ -42,6 +42,45 @@ func (s *IntegrationTestSuite) TestUpdateParams() {
 			},
 			expectErr: true,
 		},
+		{
+			name: "set invalid distribution targets",
+			request: &types.MsgUpdateParams{
+				Authority: s.mintKeeper.GetAuthority(),
+				Params: types.Params{
+					MintDenom:           sdk.DefaultBondDenom,
+					InflationRateChange: sdkmath.LegacyNewDecWithPrec(8, 2),
+					InflationMax:        sdkmath.LegacyNewDecWithPrec(20, 2),
+					InflationMin:        sdkmath.LegacyNewDecWithPrec(2, 2),
+					GoalBonded:          sdkmath.LegacyNewDecWithPrec(37, 2),
+					BlocksPerYear:       uint64(60 * 60 * 8766 / 5),
+					DistributionTargets: []types.DistributionTarget{
+						{Recipient: types.CommunityPoolRecipient, Fraction: sdkmath.LegacyNewDecWithPrec(6, 1)},
+						{Recipient: types.BurnRecipient, Fraction: sdkmath.LegacyNewDecWithPrec(6, 1)},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "set params with distribution targets and epochs",
+			request: &types.MsgUpdateParams{
+				Authority: s.mintKeeper.GetAuthority(),
+				Params: types.Params{
+					MintDenom:           sdk.DefaultBondDenom,
+					InflationRateChange: sdkmath.LegacyNewDecWithPrec(8, 2),
+					InflationMax:        sdkmath.LegacyNewDecWithPrec(20, 2),
+					InflationMin:        sdkmath.LegacyNewDecWithPrec(2, 2),
+					GoalBonded:          sdkmath.LegacyNewDecWithPrec(37, 2),
+					BlocksPerYear:       uint64(60 * 60 * 8766 / 5),
+					DistributionTargets: []types.DistributionTarget{
+						{Recipient: types.CommunityPoolRecipient, Fraction: sdkmath.LegacyNewDecWithPrec(1, 1)},
+						{Recipient: types.BurnRecipient, Fraction: sdkmath.LegacyNewDecWithPrec(1, 1)},
+					},
+					EpochLength: 100,
+				},
+			},
+			expectErr: false,
+		},
 		{
 			name: "set full valid params",
 			request: &types.MsgUpdateParams{
@@ -227,9 +227,10 @@ type ModuleInputs struct {
 	// LegacySubspace is used solely for migration of x/params managed parameters
 	LegacySubspace exported.Subspace `optional:"true"`
 
-	AccountKeeper types.AccountKeeper
-	BankKeeper    types.BankKeeper
-	StakingKeeper types.StakingKeeper
+	AccountKeeper      types.AccountKeeper
+	BankKeeper         types.BankKeeper
+	StakingKeeper      types.StakingKeeper
+	DistributionKeeper types.DistributionKeeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -260,6 +261,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		feeCollectorName,
 		authority.String(),
 	)
+	if in.DistributionKeeper != nil {
+		k.SetDistributionKeeper(in.DistributionKeeper)
+	}
 
 	// when no inflation calculation function is provided it will use the default types.DefaultInflationCalculationFn
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.InflationCalculationFn, in.LegacySubspace)
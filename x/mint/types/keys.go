@@ -14,4 +14,12 @@ const (
 
 	// StoreKey is the default store key for mint
 	StoreKey = ModuleName
+
+	// CommunityPoolRecipient is the distribution target recipient funding the
+	// community pool of x/distribution.
+	CommunityPoolRecipient = "community_pool"
+
+	// BurnRecipient is the distribution target recipient whose fraction of the
+	// provisions is not minted.
+	BurnRecipient = "burn"
 )
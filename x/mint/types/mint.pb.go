@@ -81,6 +81,13 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// distribution_targets are the recipients of a fraction of the minted
+	// provisions, the remainder being sent to the fee collector.
+	DistributionTargets []DistributionTarget `protobuf:"bytes,7,rep,name=distribution_targets,json=distributionTargets,proto3" json:"distribution_targets"`
+	// epoch_length is the number of blocks of an epoch. When greater than one,
+	// the provisions of the blocks of an epoch are minted at once at the end of
+	// the epoch instead of every block.
+	EpochLength uint64 `protobuf:"varint,8,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -130,42 +137,114 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetDistributionTargets() []DistributionTarget {
+	if m != nil {
+		return m.DistributionTargets
+	}
+	return nil
+}
+
+func (m *Params) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+// DistributionTarget defines a recipient of a fraction of the minted
+// provisions.
+type DistributionTarget struct {
+	// recipient is the name of a module account, the bech32 address of an
+	// account, or one of the special recipients "community_pool", funding the
+	// community pool of x/distribution, and "burn", whose fraction is not minted.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// fraction of the minted provisions sent to the recipient.
+	Fraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=fraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraction"`
+}
+
+func (m *DistributionTarget) Reset()         { *m = DistributionTarget{} }
+func (m *DistributionTarget) String() string { return proto.CompactTextString(m) }
+func (*DistributionTarget) ProtoMessage()    {}
+func (*DistributionTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *DistributionTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionTarget.Merge(m, src)
+}
+func (m *DistributionTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionTarget proto.InternalMessageInfo
+
+func (m *DistributionTarget) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*DistributionTarget)(nil), "cosmos.mint.v1beta1.DistributionTarget")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 423 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x4f, 0x8f, 0xd2, 0x40,
-	0x18, 0xc6, 0x3b, 0x8a, 0x4d, 0x18, 0xdd, 0xe8, 0xce, 0x6a, 0x52, 0x37, 0xd9, 0xee, 0x66, 0x0f,
-	0x04, 0x49, 0x68, 0x43, 0xbc, 0x19, 0x2f, 0x02, 0x57, 0x12, 0xd2, 0x9b, 0x5c, 0x9a, 0x69, 0x3b,
-	0x94, 0x09, 0xed, 0x0c, 0x99, 0x19, 0x08, 0x7c, 0x05, 0x4f, 0x5e, 0xfd, 0x06, 0x1e, 0x39, 0xf8,
-	0x21, 0xb8, 0x49, 0x3c, 0x19, 0x0f, 0xc4, 0xc0, 0x81, 0xaf, 0x61, 0x3a, 0xd3, 0x14, 0xe3, 0xc1,
-	0x8b, 0x78, 0xe9, 0x9f, 0xe7, 0x79, 0xfb, 0x7b, 0x9e, 0x36, 0x7d, 0xa1, 0x1b, 0x73, 0x99, 0x73,
-	0xe9, 0xe7, 0x94, 0x29, 0x7f, 0xd1, 0x89, 0x88, 0xc2, 0x1d, 0x7d, 0xe3, 0xcd, 0x04, 0x57, 0x1c,
-	0x5d, 0x19, 0xdf, 0xd3, 0x52, 0xe9, 0x5f, 0x3f, 0x4f, 0x79, 0xca, 0xb5, 0xef, 0x17, 0x57, 0x66,
-	0xf4, 0xfa, 0xa5, 0x19, 0x0d, 0x8d, 0x51, 0x3e, 0x67, 0xac, 0x4b, 0x9c, 0x53, 0xc6, 0x7d, 0x7d,
-	0x34, 0xd2, 0xfd, 0x57, 0x00, 0xed, 0x01, 0x65, 0x8a, 0x08, 0x34, 0x82, 0x75, 0xca, 0xc6, 0x19,
-	0x56, 0x94, 0x33, 0x07, 0xdc, 0x81, 0x66, 0xbd, 0xfb, 0x76, 0xb3, 0xbb, 0xb5, 0x7e, 0xec, 0x6e,
-	0x1b, 0x29, 0x55, 0x93, 0x79, 0xe4, 0xc5, 0x3c, 0x2f, 0x89, 0xe5, 0xa9, 0x2d, 0x93, 0xa9, 0xaf,
-	0x56, 0x33, 0x22, 0xbd, 0x3e, 0x89, 0xbf, 0x7d, 0x69, 0xc3, 0x32, 0xb0, 0x4f, 0xe2, 0xe0, 0x84,
-	0x43, 0x14, 0x5e, 0x62, 0xc6, 0xe6, 0x38, 0x2b, 0x6a, 0x2d, 0xa8, 0xa4, 0x9c, 0x49, 0xe7, 0xc1,
-	0x19, 0x32, 0x9e, 0x19, 0xec, 0xb0, 0xa2, 0xde, 0x7f, 0xaa, 0x41, 0x7b, 0x88, 0x05, 0xce, 0x25,
-	0xba, 0x81, 0xb0, 0xf8, 0x60, 0x61, 0x42, 0x18, 0xcf, 0xcd, 0x2b, 0x05, 0xf5, 0x42, 0xe9, 0x17,
-	0x02, 0x9a, 0xc3, 0x17, 0x55, 0xc3, 0x50, 0x60, 0x45, 0xc2, 0x78, 0x82, 0x59, 0x4a, 0xca, 0x62,
-	0xef, 0xfe, 0xa5, 0xd8, 0xe7, 0xe3, 0xba, 0x05, 0x82, 0xab, 0x8a, 0x1f, 0x60, 0x45, 0x7a, 0x9a,
-	0x8e, 0xc6, 0xf0, 0xe2, 0x14, 0x9b, 0xe3, 0xa5, 0xf3, 0xf0, 0x5c, 0x71, 0x4f, 0x2a, 0xee, 0x00,
-	0x2f, 0xff, 0xc8, 0xa1, 0xcc, 0xa9, 0xfd, 0x87, 0x1c, 0xca, 0x50, 0x04, 0x1f, 0xa7, 0x1c, 0x67,
-	0x61, 0xc4, 0x59, 0x42, 0x12, 0xe7, 0xd1, 0xb9, 0x52, 0x60, 0x41, 0xed, 0x6a, 0x28, 0x6a, 0xc0,
-	0xa7, 0x51, 0xc6, 0xe3, 0xa9, 0x0c, 0x67, 0x44, 0x84, 0x2b, 0x82, 0x85, 0x63, 0xdf, 0x81, 0x66,
-	0x2d, 0xb8, 0x30, 0xf2, 0x90, 0x88, 0xf7, 0x04, 0x8b, 0x37, 0x37, 0x1f, 0x8e, 0xeb, 0x96, 0xf3,
-	0x5b, 0xc2, 0xd2, 0xac, 0x94, 0xf9, 0x21, 0xba, 0xbd, 0xcd, 0xde, 0x05, 0xdb, 0xbd, 0x0b, 0x7e,
-	0xee, 0x5d, 0xf0, 0xf1, 0xe0, 0x5a, 0xdb, 0x83, 0x6b, 0x7d, 0x3f, 0xb8, 0xd6, 0xe8, 0xd5, 0x5f,
-	0x7b, 0x96, 0x14, 0x5d, 0x37, 0xb2, 0xf5, 0xe6, 0xbc, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0xf6, 0x61, 0x3e, 0xb4, 0x03, 0x00, 0x00,
+	// 522 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xc1, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8d, 0x5f, 0xdb, 0xbc, 0x7a, 0xd2, 0x0a, 0x3a, 0x2d, 0x92, 0xa9, 0xa8, 0x1b, 0xb2, 0x28,
+	0xa1, 0x52, 0x6d, 0x15, 0x76, 0x88, 0x0d, 0x69, 0x96, 0x54, 0x8a, 0x2c, 0x36, 0x54, 0x42, 0xd6,
+	0xd8, 0xbe, 0x71, 0x46, 0xb5, 0x67, 0xac, 0x99, 0x49, 0x95, 0xfe, 0x02, 0x2b, 0xf8, 0x06, 0x36,
+	0x2c, 0xbb, 0xe0, 0x23, 0xba, 0xa3, 0x62, 0x85, 0x58, 0x54, 0x28, 0x59, 0xf4, 0x37, 0x90, 0x67,
+	0x8c, 0x5b, 0x51, 0xc4, 0x86, 0xb0, 0x49, 0xec, 0x73, 0xee, 0x9c, 0x73, 0xae, 0x7d, 0xaf, 0x91,
+	0x1b, 0x73, 0x99, 0x73, 0xe9, 0xe7, 0x94, 0x29, 0xff, 0x64, 0x3f, 0x02, 0x45, 0xf6, 0xf5, 0x8d,
+	0x57, 0x08, 0xae, 0x38, 0x5e, 0x37, 0xbc, 0xa7, 0xa1, 0x8a, 0xdf, 0xdc, 0x48, 0x79, 0xca, 0x35,
+	0xef, 0x97, 0x57, 0xa6, 0x74, 0xf3, 0xbe, 0x29, 0x0d, 0x0d, 0x51, 0x9d, 0x33, 0xd4, 0x1a, 0xc9,
+	0x29, 0xe3, 0xbe, 0xfe, 0x35, 0x50, 0xe7, 0xb3, 0x85, 0x9a, 0x87, 0x94, 0x29, 0x10, 0xf8, 0x08,
+	0xd9, 0x94, 0x0d, 0x33, 0xa2, 0x28, 0x67, 0x8e, 0xd5, 0xb6, 0xba, 0x76, 0xef, 0xf9, 0xf9, 0xe5,
+	0x76, 0xe3, 0xdb, 0xe5, 0xf6, 0x4e, 0x4a, 0xd5, 0x68, 0x1c, 0x79, 0x31, 0xcf, 0x2b, 0xc5, 0xea,
+	0x6f, 0x4f, 0x26, 0xc7, 0xbe, 0x3a, 0x2d, 0x40, 0x7a, 0x7d, 0x88, 0xbf, 0x7c, 0xda, 0x43, 0x95,
+	0x61, 0x1f, 0xe2, 0xe0, 0x5a, 0x0e, 0x53, 0xb4, 0x46, 0x18, 0x1b, 0x93, 0xac, 0x8c, 0x75, 0x42,
+	0x25, 0xe5, 0x4c, 0x3a, 0xff, 0xcd, 0xc1, 0xe3, 0xae, 0x91, 0x1d, 0xd4, 0xaa, 0x9d, 0x0f, 0x4b,
+	0xa8, 0x39, 0x20, 0x82, 0xe4, 0x12, 0x6f, 0x21, 0x54, 0x3e, 0xb0, 0x30, 0x01, 0xc6, 0x73, 0xd3,
+	0x52, 0x60, 0x97, 0x48, 0xbf, 0x04, 0xf0, 0x18, 0xdd, 0xab, 0x13, 0x86, 0x82, 0x28, 0x08, 0xe3,
+	0x11, 0x61, 0x29, 0x54, 0xc1, 0x5e, 0xfc, 0x4d, 0xb0, 0x8f, 0x57, 0x67, 0xbb, 0x56, 0xb0, 0x5e,
+	0xeb, 0x07, 0x44, 0xc1, 0x81, 0x56, 0xc7, 0x43, 0xb4, 0x7a, 0x6d, 0x9b, 0x93, 0x89, 0xb3, 0x30,
+	0x2f, 0xbb, 0x95, 0x5a, 0xf7, 0x90, 0x4c, 0x7e, 0xf1, 0xa1, 0xcc, 0x59, 0xfc, 0x07, 0x3e, 0x94,
+	0xe1, 0x08, 0xb5, 0x52, 0x4e, 0xb2, 0x30, 0xe2, 0x2c, 0x81, 0xc4, 0x59, 0x9a, 0x97, 0x0b, 0x2a,
+	0x55, 0x7b, 0x5a, 0x14, 0xef, 0xa0, 0x3b, 0x51, 0xc6, 0xe3, 0x63, 0x19, 0x16, 0x20, 0xc2, 0x53,
+	0x20, 0xc2, 0x69, 0xb6, 0xad, 0xee, 0x62, 0xb0, 0x6a, 0xe0, 0x01, 0x88, 0xd7, 0x40, 0x04, 0x06,
+	0xb4, 0x91, 0x50, 0xa9, 0x04, 0x8d, 0xc6, 0xba, 0x6d, 0x45, 0x44, 0x0a, 0x4a, 0x3a, 0xff, 0xb7,
+	0x17, 0xba, 0xad, 0x27, 0x8f, 0xbc, 0xdf, 0xac, 0x91, 0xd7, 0xbf, 0x71, 0xe0, 0x95, 0xae, 0xef,
+	0xd9, 0x65, 0xfa, 0xea, 0x15, 0x26, 0xb7, 0x68, 0x89, 0x1f, 0xa2, 0x15, 0x28, 0x78, 0x3c, 0x0a,
+	0x33, 0x60, 0xa9, 0x1a, 0x39, 0xcb, 0x3a, 0x4b, 0x4b, 0x63, 0x2f, 0x35, 0xf4, 0x6c, 0xeb, 0xed,
+	0xd5, 0xd9, 0xae, 0x73, 0xa3, 0xd7, 0x89, 0x59, 0x6e, 0x33, 0x9a, 0x9d, 0xf7, 0x16, 0xc2, 0xb7,
+	0x8d, 0xf1, 0x03, 0x64, 0x0b, 0x88, 0x69, 0x41, 0x81, 0xa9, 0x9f, 0x03, 0x5b, 0x03, 0xf8, 0x0d,
+	0x5a, 0x1e, 0x0a, 0x12, 0xeb, 0x05, 0x9d, 0xdb, 0x8c, 0xd6, 0x92, 0xbd, 0x83, 0xf3, 0xa9, 0x6b,
+	0x5d, 0x4c, 0x5d, 0xeb, 0xfb, 0xd4, 0xb5, 0xde, 0xcd, 0xdc, 0xc6, 0xc5, 0xcc, 0x6d, 0x7c, 0x9d,
+	0xb9, 0x8d, 0xa3, 0xc7, 0x7f, 0x94, 0xaf, 0x3a, 0xd3, 0x2e, 0x51, 0x53, 0x7f, 0x57, 0x9e, 0xfe,
+	0x18, 0x00, 0x9d, 0x7b, 0xe7, 0x79, 0xd2, 0x04, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -231,6 +310,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EpochLength != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.DistributionTargets) > 0 {
+		for iNdEx := len(m.DistributionTargets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionTargets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -286,6 +384,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DistributionTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -331,6 +469,30 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	if len(m.DistributionTargets) > 0 {
+		for _, e := range m.DistributionTargets {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovMint(uint64(m.EpochLength))
+	}
+	return n
+}
+
+func (m *DistributionTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -674,6 +836,175 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTargets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionTargets = append(m.DistributionTargets, DistributionTarget{})
+			if err := m.DistributionTargets[len(m.DistributionTargets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -80,3 +80,14 @@ func (m Minter) BlockProvision(params Params) sdk.Coin {
 	provisionAmt := m.AnnualProvisions.QuoInt(math.NewInt(int64(params.BlocksPerYear)))
 	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
 }
+
+// EpochProvision returns the provisions for an epoch based on the annual
+// provisions rate, i.e. the provisions for a block if epochs are disabled.
+func (m Minter) EpochProvision(params Params) sdk.Coin {
+	if params.EpochLength <= 1 {
+		return m.BlockProvision(params)
+	}
+
+	provisionAmt := m.AnnualProvisions.MulInt64(int64(params.EpochLength)).QuoInt(math.NewInt(int64(params.BlocksPerYear)))
+	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
+}
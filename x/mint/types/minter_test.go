@@ -87,6 +87,37 @@ func TestBlockProvision(t *testing.T) {
 	}
 }
 
+func TestEpochProvision(t *testing.T) {
+	minter := InitialMinter(math.LegacyNewDecWithPrec(1, 1))
+	params := DefaultParams()
+
+	secondsPerYear := int64(60 * 60 * 8766)
+
+	tests := []struct {
+		epochLength      uint64
+		annualProvisions int64
+		expProvisions    int64
+	}{
+		{0, secondsPerYear / 5, 1},
+		{1, (secondsPerYear / 5) * 2, 2},
+		{10, secondsPerYear / 5, 10},
+		// the provisions of the blocks of the epoch are not truncated separately
+		{10, (secondsPerYear / 5) / 2, 5},
+	}
+	for i, tc := range tests {
+		params.EpochLength = tc.epochLength
+		minter.AnnualProvisions = math.LegacyNewDec(tc.annualProvisions)
+		provisions := minter.EpochProvision(params)
+
+		expProvisions := sdk.NewCoin(params.MintDenom,
+			math.NewInt(tc.expProvisions))
+
+		require.True(t, expProvisions.IsEqual(provisions),
+			"test: %v\n\tExp: %v\n\tGot: %v\n",
+			i, tc.expProvisions, provisions)
+	}
+}
+
 // Benchmarking :)
 // previously using math.Int operations:
 // BenchmarkBlockProvision-4 5000000 220 ns/op
@@ -54,6 +54,9 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateDistributionTargets(p.DistributionTargets); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -168,3 +171,36 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateDistributionTargets(i interface{}) error {
+	v, ok := i.([]DistributionTarget)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	total := math.LegacyZeroDec()
+	recipients := make(map[string]bool, len(v))
+	for _, target := range v {
+		if strings.TrimSpace(target.Recipient) == "" {
+			return errors.New("distribution target recipient cannot be blank")
+		}
+		if recipients[target.Recipient] {
+			return fmt.Errorf("duplicate distribution target recipient: %s", target.Recipient)
+		}
+		recipients[target.Recipient] = true
+
+		if target.Fraction.IsNil() {
+			return fmt.Errorf("distribution target fraction cannot be nil: %s", target.Recipient)
+		}
+		if !target.Fraction.IsPositive() {
+			return fmt.Errorf("distribution target fraction must be positive: %s", target.Fraction)
+		}
+		total = total.Add(target.Fraction)
+	}
+
+	if total.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("distribution target fractions cannot sum to more than one: %s", total)
+	}
+
+	return nil
+}
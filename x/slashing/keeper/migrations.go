@@ -7,6 +7,7 @@ import (
 	v2 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v2"
 	v3 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v3"
 	v4 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v4"
+	v5 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v5"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -45,3 +46,11 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 	}
 	return v4.Migrate(ctx, m.keeper.cdc, store, params)
 }
+
+// Migrate4to5 migrates the x/slashing module state from the consensus
+// version 4 to version 5. Specifically, it sets the correlated double sign
+// penalty params to their defaults.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+	return v5.Migrate(ctx, m.keeper.cdc, store)
+}
@@ -115,16 +115,69 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			expectErr: true,
 			expErrMsg: "downtime slash fraction cannot be negative",
 		},
+		{
+			name: "set invalid correlated slash window",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:        int64(750),
+					MinSignedPerWindow:        minSignedPerWindow,
+					DowntimeJailDuration:      time.Duration(10),
+					SlashFractionDoubleSign:   slashFractionDoubleSign,
+					SlashFractionDowntime:     slashFractionDowntime,
+					CorrelatedSlashWindow:     int64(-10),
+					CorrelatedSlashMultiplier: slashingtypes.DefaultCorrelatedSlashMultiplier,
+				},
+			},
+			expectErr: true,
+			expErrMsg: "correlated slash window cannot be negative",
+		},
+		{
+			name: "set invalid correlated slash multiplier",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:        int64(750),
+					MinSignedPerWindow:        minSignedPerWindow,
+					DowntimeJailDuration:      time.Duration(10),
+					SlashFractionDoubleSign:   slashFractionDoubleSign,
+					SlashFractionDowntime:     slashFractionDowntime,
+					CorrelatedSlashMultiplier: invalidVal,
+				},
+			},
+			expectErr: true,
+			expErrMsg: "correlated slash multiplier cannot be negative",
+		},
+		{
+			name: "set invalid double sign jail duration",
+			request: &slashingtypes.MsgUpdateParams{
+				Authority: s.slashingKeeper.GetAuthority(),
+				Params: slashingtypes.Params{
+					SignedBlocksWindow:        int64(750),
+					MinSignedPerWindow:        minSignedPerWindow,
+					DowntimeJailDuration:      time.Duration(10),
+					SlashFractionDoubleSign:   slashFractionDoubleSign,
+					SlashFractionDowntime:     slashFractionDowntime,
+					CorrelatedSlashMultiplier: slashingtypes.DefaultCorrelatedSlashMultiplier,
+					DoubleSignJailDuration:    time.Duration(-10),
+				},
+			},
+			expectErr: true,
+			expErrMsg: "double sign jail duration cannot be negative",
+		},
 		{
 			name: "set full valid params",
 			request: &slashingtypes.MsgUpdateParams{
 				Authority: s.slashingKeeper.GetAuthority(),
 				Params: slashingtypes.Params{
-					SignedBlocksWindow:      int64(750),
-					MinSignedPerWindow:      minSignedPerWindow,
-					DowntimeJailDuration:    time.Duration(34800000000000),
-					SlashFractionDoubleSign: slashFractionDoubleSign,
-					SlashFractionDowntime:   slashFractionDowntime,
+					SignedBlocksWindow:        int64(750),
+					MinSignedPerWindow:        minSignedPerWindow,
+					DowntimeJailDuration:      time.Duration(34800000000000),
+					SlashFractionDoubleSign:   slashFractionDoubleSign,
+					SlashFractionDowntime:     slashFractionDowntime,
+					CorrelatedSlashWindow:     int64(100),
+					CorrelatedSlashMultiplier: slashingtypes.DefaultCorrelatedSlashMultiplier,
+					DoubleSignJailDuration:    time.Duration(34800000000000),
 				},
 			},
 			expectErr: false,
@@ -48,6 +48,27 @@ func (k Keeper) SlashFractionDowntime(ctx context.Context) (sdkmath.LegacyDec, e
 	return params.SlashFractionDowntime, err
 }
 
+// CorrelatedSlashWindow - number of blocks around a double sign within which
+// the equivocations are correlated, zero if the penalty is not correlated
+func (k Keeper) CorrelatedSlashWindow(ctx context.Context) (int64, error) {
+	params, err := k.GetParams(ctx)
+	return params.CorrelatedSlashWindow, err
+}
+
+// CorrelatedSlashMultiplier - multiplier of the fraction of the voting power
+// which equivocated within the correlated slash window
+func (k Keeper) CorrelatedSlashMultiplier(ctx context.Context) (sdkmath.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	return params.CorrelatedSlashMultiplier, err
+}
+
+// DoubleSignJailDuration - Double sign jail duration, zero if double signing
+// validators are tombstoned
+func (k Keeper) DoubleSignJailDuration(ctx context.Context) (time.Duration, error) {
+	params, err := k.GetParams(ctx)
+	return params.DoubleSignJailDuration, err
+}
+
 // GetParams returns the current x/slashing module parameters.
 func (k Keeper) GetParams(ctx context.Context) (params types.Params, err error) {
 	store := k.storeService.OpenKVStore(ctx)
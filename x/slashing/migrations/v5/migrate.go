@@ -0,0 +1,38 @@
+package v5
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// Migrate migrates state to consensus version 5. Specifically, the migration
+// sets the correlated double sign penalty params, which are missing from the
+// stored params, to their defaults.
+func Migrate(_ sdk.Context, cdc codec.BinaryCodec, store storetypes.KVStore) error {
+	var params types.Params
+	bz := store.Get(types.ParamsKey)
+	if bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	if params.CorrelatedSlashMultiplier.IsNil() {
+		params.CorrelatedSlashMultiplier = types.DefaultCorrelatedSlashMultiplier
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
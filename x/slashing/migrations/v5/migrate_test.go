@@ -0,0 +1,63 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	v5 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v5"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(slashing.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(slashingtypes.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// params stored before the correlated double sign penalty was introduced
+	oldParams := slashingtypes.DefaultParams()
+	oldParams.SignedBlocksWindow = 42
+	store.Set(slashingtypes.ParamsKey, removeFields(t, cdc.MustMarshal(&oldParams), 6, 7, 8))
+
+	require.NoError(t, v5.Migrate(ctx, cdc, store))
+
+	var params slashingtypes.Params
+	cdc.MustUnmarshal(store.Get(slashingtypes.ParamsKey), &params)
+	require.Equal(t, int64(42), params.SignedBlocksWindow)
+	require.Equal(t, slashingtypes.DefaultCorrelatedSlashWindow, params.CorrelatedSlashWindow)
+	require.Equal(t, slashingtypes.DefaultCorrelatedSlashMultiplier, params.CorrelatedSlashMultiplier)
+	require.Equal(t, slashingtypes.DefaultDoubleSignJailDuration, params.DoubleSignJailDuration)
+}
+
+// removeFields removes the given fields from an encoded proto message.
+func removeFields(t *testing.T, bz []byte, fields ...protowire.Number) []byte {
+	t.Helper()
+
+	var res []byte
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		require.GreaterOrEqual(t, n, 0)
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		require.GreaterOrEqual(t, m, 0)
+
+		removed := false
+		for _, field := range fields {
+			removed = removed || num == field
+		}
+		if !removed {
+			res = append(res, bz[:n+m]...)
+		}
+
+		bz = bz[n+m:]
+	}
+
+	return res
+}
@@ -20,6 +20,10 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+
+	CorrelatedSlashWindow     = "correlated_slash_window"
+	CorrelatedSlashMultiplier = "correlated_slash_multiplier"
+	DoubleSignJailDuration    = "double_sign_jail_duration"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -47,6 +51,31 @@ func GenSlashFractionDowntime(r *rand.Rand) math.LegacyDec {
 	return math.LegacyNewDec(1).Quo(math.LegacyNewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenCorrelatedSlashWindow randomized CorrelatedSlashWindow, the penalty not
+// being correlated half of the time
+func GenCorrelatedSlashWindow(r *rand.Rand) int64 {
+	if r.Intn(2) == 0 {
+		return types.DefaultCorrelatedSlashWindow
+	}
+
+	return int64(simulation.RandIntBetween(r, 1, 1000))
+}
+
+// GenCorrelatedSlashMultiplier randomized CorrelatedSlashMultiplier
+func GenCorrelatedSlashMultiplier(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDec(int64(simulation.RandIntBetween(r, 1, 10)))
+}
+
+// GenDoubleSignJailDuration randomized DoubleSignJailDuration, double signing
+// validators being tombstoned half of the time
+func GenDoubleSignJailDuration(r *rand.Rand) time.Duration {
+	if r.Intn(2) == 0 {
+		return types.DefaultDoubleSignJailDuration
+	}
+
+	return time.Duration(simulation.RandIntBetween(r, 60*60*24, 60*60*24*365)) * time.Second
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -64,10 +93,22 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var slashFractionDowntime math.LegacyDec
 	simState.AppParams.GetOrGenerate(SlashFractionDowntime, &slashFractionDowntime, simState.Rand, func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) })
 
+	var correlatedSlashWindow int64
+	simState.AppParams.GetOrGenerate(CorrelatedSlashWindow, &correlatedSlashWindow, simState.Rand, func(r *rand.Rand) { correlatedSlashWindow = GenCorrelatedSlashWindow(r) })
+
+	var correlatedSlashMultiplier math.LegacyDec
+	simState.AppParams.GetOrGenerate(CorrelatedSlashMultiplier, &correlatedSlashMultiplier, simState.Rand, func(r *rand.Rand) { correlatedSlashMultiplier = GenCorrelatedSlashMultiplier(r) })
+
+	var doubleSignJailDuration time.Duration
+	simState.AppParams.GetOrGenerate(DoubleSignJailDuration, &doubleSignJailDuration, simState.Rand, func(r *rand.Rand) { doubleSignJailDuration = GenDoubleSignJailDuration(r) })
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
 		slashFractionDoubleSign, slashFractionDowntime,
 	)
+	params.CorrelatedSlashWindow = correlatedSlashWindow
+	params.CorrelatedSlashMultiplier = correlatedSlashMultiplier
+	params.DoubleSignJailDuration = doubleSignJailDuration
 
 	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
 
@@ -50,6 +50,9 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, dec3, slashingGenesis.Params.SlashFractionDowntime)
 	require.Equal(t, int64(720), slashingGenesis.Params.SignedBlocksWindow)
 	require.Equal(t, time.Duration(34800000000000), slashingGenesis.Params.DowntimeJailDuration)
+	require.Equal(t, int64(0), slashingGenesis.Params.CorrelatedSlashWindow)
+	require.Equal(t, sdkmath.LegacyNewDec(3), slashingGenesis.Params.CorrelatedSlashMultiplier)
+	require.Equal(t, time.Duration(0), slashingGenesis.Params.DoubleSignJailDuration)
 	require.Len(t, slashingGenesis.MissedBlocks, 0)
 	require.Len(t, slashingGenesis.SigningInfos, 0)
 }
@@ -42,6 +42,9 @@ func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account)
 	params.MinSignedPerWindow = sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 2)
 	params.SlashFractionDoubleSign = sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 2)
 	params.SlashFractionDowntime = sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 100)), 2)
+	params.CorrelatedSlashWindow = int64(simtypes.RandIntBetween(r, 0, 1000))
+	params.CorrelatedSlashMultiplier = sdkmath.LegacyNewDec(int64(simtypes.RandIntBetween(r, 1, 10)))
+	params.DoubleSignJailDuration = time.Duration(simtypes.RandIntBetween(r, 0, 60*60*24*365)) * time.Second
 
 	return &types.MsgUpdateParams{
 		Authority: authority.String(),
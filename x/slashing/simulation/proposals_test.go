@@ -44,4 +44,7 @@ func TestProposalMsgs(t *testing.T) {
 	assert.DeepEqual(t, sdkmath.LegacyNewDecWithPrec(60, 2), msgUpdateParams.Params.SlashFractionDoubleSign)
 	assert.DeepEqual(t, sdkmath.LegacyNewDecWithPrec(89, 2), msgUpdateParams.Params.SlashFractionDowntime)
 	assert.Equal(t, 3313479009*time.Second, msgUpdateParams.Params.DowntimeJailDuration)
+	assert.Equal(t, int64(162), msgUpdateParams.Params.CorrelatedSlashWindow)
+	assert.DeepEqual(t, sdkmath.LegacyNewDec(3), msgUpdateParams.Params.CorrelatedSlashMultiplier)
+	assert.Equal(t, 20768728*time.Second, msgUpdateParams.Params.DoubleSignJailDuration)
 }
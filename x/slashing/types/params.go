@@ -11,15 +11,27 @@ import (
 const (
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+
+	// DefaultCorrelatedSlashWindow is set to 0, i.e. the double sign penalty is
+	// not correlated
+	DefaultCorrelatedSlashWindow = int64(0)
+	// DefaultDoubleSignJailDuration is set to 0, i.e. double signing validators
+	// are tombstoned
+	DefaultDoubleSignJailDuration = time.Duration(0)
 )
 
 var (
 	DefaultMinSignedPerWindow      = math.LegacyNewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = math.LegacyNewDec(1).Quo(math.LegacyNewDec(20))
 	DefaultSlashFractionDowntime   = math.LegacyNewDec(1).Quo(math.LegacyNewDec(100))
+
+	// DefaultCorrelatedSlashMultiplier slashes all the stake of the validators
+	// once a third of the voting power equivocated
+	DefaultCorrelatedSlashMultiplier = math.LegacyNewDec(3)
 )
 
-// NewParams creates a new Params object
+// NewParams creates a new Params object. The correlated double sign penalty
+// and the double sign jail duration are set to their default values.
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow math.LegacyDec, downtimeJailDuration time.Duration,
 	slashFractionDoubleSign, slashFractionDowntime math.LegacyDec,
@@ -30,6 +42,10 @@ func NewParams(
 		DowntimeJailDuration:    downtimeJailDuration,
 		SlashFractionDoubleSign: slashFractionDoubleSign,
 		SlashFractionDowntime:   slashFractionDowntime,
+
+		CorrelatedSlashWindow:     DefaultCorrelatedSlashWindow,
+		CorrelatedSlashMultiplier: DefaultCorrelatedSlashMultiplier,
+		DoubleSignJailDuration:    DefaultDoubleSignJailDuration,
 	}
 }
 
@@ -61,6 +77,15 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateCorrelatedSlashWindow(p.CorrelatedSlashWindow); err != nil {
+		return err
+	}
+	if err := validateCorrelatedSlashMultiplier(p.CorrelatedSlashMultiplier); err != nil {
+		return err
+	}
+	if err := validateDoubleSignJailDuration(p.DoubleSignJailDuration); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -146,3 +171,45 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateCorrelatedSlashWindow(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("correlated slash window cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateCorrelatedSlashMultiplier(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("correlated slash multiplier cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("correlated slash multiplier cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateDoubleSignJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("double sign jail duration cannot be negative: %s", v)
+	}
+
+	return nil
+}
@@ -134,6 +134,21 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// correlated_slash_window is the number of blocks around the infraction
+	// height within which the equivocations of all validators are correlated.
+	// When non-zero, the double sign slash fraction scales with the total voting
+	// power which equivocated within the window. Zero disables the correlated
+	// penalty.
+	CorrelatedSlashWindow int64 `protobuf:"varint,6,opt,name=correlated_slash_window,json=correlatedSlashWindow,proto3" json:"correlated_slash_window,omitempty"`
+	// correlated_slash_multiplier is multiplied by the fraction of the total
+	// voting power which equivocated within the window to compute the double
+	// sign slash fraction, which is at least slash_fraction_double_sign and at
+	// most 1.
+	CorrelatedSlashMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=correlated_slash_multiplier,json=correlatedSlashMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"correlated_slash_multiplier"`
+	// double_sign_jail_duration is the duration a validator is jailed for after
+	// a double sign. Zero tombstones the validator, which can then never be
+	// unjailed.
+	DoubleSignJailDuration time.Duration `protobuf:"bytes,8,opt,name=double_sign_jail_duration,json=doubleSignJailDuration,proto3,stdduration" json:"double_sign_jail_duration"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -183,6 +198,20 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetCorrelatedSlashWindow() int64 {
+	if m != nil {
+		return m.CorrelatedSlashWindow
+	}
+	return 0
+}
+
+func (m *Params) GetDoubleSignJailDuration() time.Duration {
+	if m != nil {
+		return m.DoubleSignJailDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
@@ -193,47 +222,52 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x4f, 0x14, 0x4f,
-	0x18, 0xbe, 0x01, 0x7e, 0xfc, 0x74, 0x0e, 0x13, 0x5d, 0x0f, 0x59, 0x2e, 0xba, 0x77, 0x50, 0x90,
-	0x0b, 0x09, 0xbb, 0x82, 0x1d, 0x56, 0x1e, 0xc4, 0xe0, 0x9f, 0x44, 0x72, 0xf8, 0x27, 0xb1, 0x70,
-	0x32, 0xbb, 0x33, 0xb7, 0x37, 0xb2, 0x3b, 0x73, 0xd9, 0x99, 0x15, 0x88, 0x8d, 0x8d, 0x8d, 0x15,
-	0xa5, 0xb1, 0xb2, 0xa4, 0xa4, 0xf0, 0x0b, 0x58, 0x98, 0x50, 0x12, 0x2b, 0x63, 0x81, 0xe6, 0x28,
-	0xf0, 0x63, 0x98, 0x9d, 0x99, 0x05, 0x02, 0x89, 0x15, 0xcd, 0xdd, 0xee, 0xf3, 0x3c, 0xef, 0xfb,
-	0xbc, 0xf3, 0xcc, 0x9b, 0x85, 0x33, 0x91, 0x90, 0xa9, 0x90, 0x81, 0x4c, 0xb0, 0xec, 0x31, 0x1e,
-	0x07, 0x6f, 0xe6, 0x43, 0xaa, 0xf0, 0xfc, 0x31, 0xe0, 0xf7, 0x33, 0xa1, 0x84, 0x33, 0x61, 0x74,
-	0xfe, 0x31, 0x6c, 0x75, 0xf5, 0x5a, 0x2c, 0x62, 0xa1, 0x35, 0x41, 0xf1, 0x64, 0xe4, 0x75, 0x2f,
-	0x16, 0x22, 0x4e, 0x68, 0xa0, 0xdf, 0xc2, 0xbc, 0x1b, 0x90, 0x3c, 0xc3, 0x8a, 0x09, 0x6e, 0xf9,
-	0xc6, 0x59, 0x5e, 0xb1, 0x94, 0x4a, 0x85, 0xd3, 0xbe, 0x15, 0x4c, 0x1a, 0x3f, 0x64, 0x3a, 0x5b,
-	0x73, 0x43, 0x5d, 0xc3, 0x29, 0xe3, 0x22, 0xd0, 0xbf, 0x06, 0x9a, 0xfe, 0x3a, 0x04, 0x6b, 0xcf,
-	0x71, 0xc2, 0x08, 0x56, 0x22, 0x5b, 0x63, 0x31, 0x67, 0x3c, 0x7e, 0xc0, 0xbb, 0xc2, 0xb9, 0x0b,
-	0xff, 0xc7, 0x84, 0x64, 0x54, 0x4a, 0x17, 0x34, 0x41, 0xeb, 0x72, 0x7b, 0xea, 0xfb, 0x97, 0xb9,
-	0x5b, 0xb6, 0xdd, 0x92, 0xe0, 0x92, 0x72, 0x99, 0xcb, 0x7b, 0x46, 0xb2, 0xa6, 0x32, 0xc6, 0xe3,
-	0x4e, 0x59, 0xe1, 0x4c, 0xc1, 0x31, 0xa9, 0x70, 0xa6, 0x50, 0x8f, 0xb2, 0xb8, 0xa7, 0xdc, 0xa1,
-	0x26, 0x68, 0x0d, 0x77, 0xaa, 0x1a, 0x5b, 0xd1, 0x50, 0x21, 0x61, 0x9c, 0xd0, 0x4d, 0x24, 0xba,
-	0x5d, 0x49, 0x95, 0x3b, 0x6c, 0x24, 0x1a, 0x7b, 0xa2, 0x21, 0xe7, 0x31, 0x1c, 0x7b, 0x8d, 0x59,
-	0x42, 0x09, 0xca, 0xb9, 0x62, 0x89, 0x3b, 0xd2, 0x04, 0xad, 0xea, 0x42, 0xdd, 0x37, 0x09, 0xf8,
-	0x65, 0x02, 0xfe, 0xd3, 0x32, 0x81, 0xf6, 0x95, 0xbd, 0x83, 0x46, 0x65, 0xfb, 0x57, 0x03, 0xec,
-	0x1c, 0xed, 0xce, 0x82, 0x4e, 0xd5, 0x94, 0x3f, 0x2b, 0xaa, 0x1d, 0x0f, 0x42, 0x25, 0xd2, 0x50,
-	0x2a, 0xc1, 0x29, 0x71, 0xff, 0x6b, 0x82, 0xd6, 0xa5, 0xce, 0x29, 0xc4, 0x59, 0x80, 0xe3, 0x29,
-	0x93, 0x92, 0x12, 0x14, 0x26, 0x22, 0x5a, 0x97, 0x28, 0x12, 0x39, 0x57, 0x34, 0x73, 0x47, 0xf5,
-	0x64, 0xd7, 0x0d, 0xd9, 0xd6, 0xdc, 0x92, 0xa1, 0x16, 0x47, 0xfe, 0x7c, 0x6e, 0x80, 0xe9, 0x6f,
-	0x23, 0x70, 0x74, 0x15, 0x67, 0x38, 0x95, 0xce, 0x6d, 0x58, 0x93, 0x2c, 0xe6, 0x27, 0x4d, 0x36,
-	0x18, 0x27, 0x62, 0x43, 0x47, 0x38, 0xdc, 0x71, 0x0c, 0x67, 0x7a, 0xbc, 0xd0, 0x8c, 0xf3, 0xb6,
-	0xb0, 0xe5, 0xc8, 0x56, 0xf5, 0x69, 0x56, 0x96, 0x14, 0x99, 0x8d, 0xb5, 0x57, 0x8a, 0x13, 0xfd,
-	0x3c, 0x68, 0xcc, 0xc4, 0x4c, 0xf5, 0xf2, 0xd0, 0x8f, 0x44, 0x6a, 0xef, 0xd4, 0xfe, 0xcd, 0x49,
-	0xb2, 0x1e, 0xa8, 0xad, 0x3e, 0x95, 0xfe, 0x32, 0x8d, 0x3e, 0x1d, 0xed, 0xce, 0x5e, 0xb5, 0x0b,
-	0x40, 0x68, 0x84, 0xc2, 0x2d, 0x45, 0xa5, 0x09, 0xc3, 0x49, 0x19, 0x5f, 0xd3, 0x2e, 0xab, 0x34,
-	0xb3, 0xe6, 0xaf, 0xe0, 0x0d, 0x22, 0x36, 0x78, 0xb1, 0x42, 0xa8, 0xc8, 0x0a, 0x95, 0xcb, 0xa6,
-	0xaf, 0xa3, 0xba, 0x30, 0x79, 0x2e, 0xeb, 0x65, 0x2b, 0x30, 0x51, 0x7f, 0x3c, 0x8e, 0xba, 0x56,
-	0xf6, 0x79, 0x88, 0x59, 0x52, 0x8a, 0x9c, 0xf7, 0x00, 0xd6, 0xf5, 0xde, 0xa3, 0x6e, 0x86, 0xa3,
-	0x02, 0x42, 0x44, 0xe4, 0x61, 0x42, 0xf5, 0x79, 0xf5, 0x85, 0x5e, 0xe4, 0x11, 0x27, 0xb4, 0xd7,
-	0x7d, 0x6b, 0xb5, 0xac, 0x9d, 0x8a, 0x23, 0x3b, 0xef, 0x00, 0x9c, 0x38, 0x37, 0x87, 0x99, 0x57,
-	0x6f, 0xc2, 0x45, 0x0e, 0x31, 0x7e, 0x66, 0x08, 0x63, 0xb3, 0x38, 0xf5, 0xe1, 0x68, 0x77, 0xf6,
-	0xe6, 0xa9, 0x5e, 0x9b, 0x27, 0x5f, 0x0e, 0xb3, 0x3c, 0xed, 0x47, 0x3b, 0x03, 0x0f, 0xec, 0x0d,
-	0x3c, 0xb0, 0x3f, 0xf0, 0xc0, 0xef, 0x81, 0x07, 0xb6, 0x0f, 0xbd, 0xca, 0xfe, 0xa1, 0x57, 0xf9,
-	0x71, 0xe8, 0x55, 0x5e, 0xce, 0xfd, 0x73, 0xb2, 0x53, 0xdd, 0xf4, 0x90, 0xe1, 0xa8, 0xbe, 0xb2,
-	0x3b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x8c, 0xa5, 0x44, 0xa7, 0x04, 0x00, 0x00,
+	// 705 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xbb, 0x6e, 0x13, 0x41,
+	0x14, 0xf5, 0xe4, 0xe1, 0x84, 0x71, 0x90, 0x60, 0x71, 0xe2, 0xb5, 0x81, 0xb5, 0x93, 0x22, 0xb2,
+	0x22, 0xc5, 0x26, 0x41, 0xa2, 0x08, 0x15, 0x4e, 0x84, 0xc2, 0x4b, 0x44, 0x36, 0x0f, 0x89, 0x82,
+	0xd5, 0xec, 0xce, 0x78, 0x3d, 0x64, 0x77, 0xc6, 0xda, 0x99, 0x25, 0x89, 0x68, 0x68, 0x90, 0x10,
+	0x55, 0x4a, 0x44, 0x45, 0x99, 0x32, 0x05, 0x3f, 0x40, 0x97, 0x32, 0xa2, 0x42, 0x14, 0x01, 0x39,
+	0x45, 0x68, 0xf8, 0x07, 0xb4, 0x33, 0xbb, 0x8e, 0xe5, 0x48, 0x48, 0x48, 0x69, 0xf2, 0xb8, 0xe7,
+	0xdc, 0x7b, 0xce, 0x9c, 0x7b, 0x6d, 0x38, 0xef, 0x72, 0x11, 0x70, 0x51, 0x17, 0x3e, 0x12, 0x1d,
+	0xca, 0xbc, 0xfa, 0xeb, 0x25, 0x87, 0x48, 0xb4, 0xd4, 0x2f, 0xd4, 0xba, 0x21, 0x97, 0xdc, 0x28,
+	0x68, 0x5e, 0xad, 0x5f, 0x4e, 0x78, 0xa5, 0xbc, 0xc7, 0x3d, 0xae, 0x38, 0xf5, 0xf8, 0x2f, 0x4d,
+	0x2f, 0x59, 0x1e, 0xe7, 0x9e, 0x4f, 0xea, 0xea, 0x3f, 0x27, 0x6a, 0xd7, 0x71, 0x14, 0x22, 0x49,
+	0x39, 0x4b, 0xf0, 0xf2, 0x30, 0x2e, 0x69, 0x40, 0x84, 0x44, 0x41, 0x37, 0x21, 0x14, 0xb5, 0x9e,
+	0xad, 0x27, 0x27, 0xe2, 0x1a, 0xba, 0x8c, 0x02, 0xca, 0x78, 0x5d, 0xfd, 0xd4, 0xa5, 0xb9, 0xaf,
+	0x23, 0x30, 0xff, 0x0c, 0xf9, 0x14, 0x23, 0xc9, 0xc3, 0x16, 0xf5, 0x18, 0x65, 0xde, 0x3d, 0xd6,
+	0xe6, 0xc6, 0x6d, 0x38, 0x81, 0x30, 0x0e, 0x89, 0x10, 0x26, 0xa8, 0x80, 0xea, 0x85, 0xc6, 0xec,
+	0xb7, 0x2f, 0x8b, 0xd7, 0x93, 0x71, 0xab, 0x9c, 0x09, 0xc2, 0x44, 0x24, 0xee, 0x68, 0x4a, 0x4b,
+	0x86, 0x94, 0x79, 0xcd, 0xb4, 0xc3, 0x98, 0x85, 0x53, 0x42, 0xa2, 0x50, 0xda, 0x1d, 0x42, 0xbd,
+	0x8e, 0x34, 0x47, 0x2a, 0xa0, 0x3a, 0xda, 0xcc, 0xa9, 0xda, 0xba, 0x2a, 0xc5, 0x14, 0xca, 0x30,
+	0xd9, 0xb6, 0x79, 0xbb, 0x2d, 0x88, 0x34, 0x47, 0x35, 0x45, 0xd5, 0x1e, 0xab, 0x92, 0xf1, 0x10,
+	0x4e, 0xbd, 0x42, 0xd4, 0x27, 0xd8, 0x8e, 0x98, 0xa4, 0xbe, 0x39, 0x56, 0x01, 0xd5, 0xdc, 0x72,
+	0xa9, 0xa6, 0x13, 0xa8, 0xa5, 0x09, 0xd4, 0x9e, 0xa4, 0x09, 0x34, 0x2e, 0x1e, 0x1c, 0x95, 0x33,
+	0xbb, 0x3f, 0xcb, 0x60, 0xef, 0x64, 0x7f, 0x01, 0x34, 0x73, 0xba, 0xfd, 0x69, 0xdc, 0x6d, 0x58,
+	0x10, 0x4a, 0x1e, 0x38, 0x42, 0x72, 0x46, 0xb0, 0x39, 0x5e, 0x01, 0xd5, 0xc9, 0xe6, 0x40, 0xc5,
+	0x58, 0x86, 0xd3, 0x01, 0x15, 0x82, 0x60, 0xdb, 0xf1, 0xb9, 0xbb, 0x29, 0x6c, 0x97, 0x47, 0x4c,
+	0x92, 0xd0, 0xcc, 0x2a, 0x67, 0x57, 0x34, 0xd8, 0x50, 0xd8, 0xaa, 0x86, 0x56, 0xc6, 0x7e, 0x7f,
+	0x2e, 0x83, 0xb9, 0x3f, 0x59, 0x98, 0xdd, 0x40, 0x21, 0x0a, 0x84, 0x71, 0x03, 0xe6, 0x05, 0xf5,
+	0xd8, 0xe9, 0x90, 0x2d, 0xca, 0x30, 0xdf, 0x52, 0x11, 0x8e, 0x36, 0x0d, 0x8d, 0xe9, 0x19, 0xcf,
+	0x15, 0x62, 0xbc, 0x89, 0x65, 0x99, 0x9d, 0x74, 0x75, 0x49, 0x98, 0xb6, 0xc4, 0x99, 0x4d, 0x35,
+	0xd6, 0xe3, 0x17, 0xfd, 0x38, 0x2a, 0xcf, 0x7b, 0x54, 0x76, 0x22, 0xa7, 0xe6, 0xf2, 0x20, 0xd9,
+	0x69, 0xf2, 0x6b, 0x51, 0xe0, 0xcd, 0xba, 0xdc, 0xe9, 0x12, 0x51, 0x5b, 0x23, 0xee, 0xa7, 0x93,
+	0xfd, 0x85, 0x4b, 0x1a, 0xb0, 0x31, 0x71, 0x6d, 0x67, 0x47, 0x12, 0xa1, 0xc3, 0x30, 0x02, 0xca,
+	0x5a, 0x4a, 0x65, 0x83, 0x84, 0x89, 0xf8, 0x4b, 0x38, 0x83, 0xf9, 0x16, 0x8b, 0x4f, 0xc8, 0x8e,
+	0xb3, 0xb2, 0xd3, 0x63, 0x53, 0xeb, 0xc8, 0x2d, 0x17, 0xcf, 0x64, 0xbd, 0x96, 0x10, 0x74, 0xd4,
+	0x1f, 0xfb, 0x51, 0xe7, 0xd3, 0x39, 0xf7, 0x11, 0xf5, 0x53, 0x92, 0xf1, 0x0e, 0xc0, 0x92, 0xba,
+	0x7b, 0xbb, 0x1d, 0x22, 0x37, 0x2e, 0xd9, 0x98, 0x47, 0x8e, 0x4f, 0xd4, 0x7b, 0xcd, 0xb1, 0x73,
+	0x7e, 0x62, 0x41, 0x69, 0xdd, 0x4d, 0xa4, 0xd6, 0x94, 0x52, 0xfc, 0x64, 0xe3, 0x2d, 0x80, 0x85,
+	0x33, 0x3e, 0xb4, 0x5f, 0x73, 0xfc, 0x9c, 0x4d, 0x4c, 0x0f, 0x99, 0xd0, 0x32, 0xc6, 0x2d, 0x58,
+	0x70, 0x79, 0x18, 0x12, 0x1f, 0x49, 0x82, 0x6d, 0x6d, 0x26, 0xd9, 0xb4, 0x3e, 0xb0, 0xe9, 0x53,
+	0xb8, 0x15, 0xa3, 0xc9, 0x8a, 0xde, 0x03, 0x78, 0xf5, 0x4c, 0x63, 0x10, 0xf9, 0x92, 0x76, 0x7d,
+	0x4a, 0x42, 0x73, 0xe2, 0x9c, 0xed, 0x17, 0x87, 0x6c, 0x3c, 0xea, 0x4b, 0x19, 0x2e, 0x2c, 0x0e,
+	0x6c, 0x6f, 0xe8, 0x60, 0x26, 0xff, 0xf3, 0x60, 0x66, 0x70, 0x7f, 0x3d, 0x83, 0x27, 0xb3, 0x32,
+	0xfb, 0xe1, 0x64, 0x7f, 0xe1, 0xda, 0x80, 0xe9, 0xed, 0xd3, 0x6f, 0x58, 0xfd, 0x21, 0x6b, 0x3c,
+	0xd8, 0xeb, 0x59, 0xe0, 0xa0, 0x67, 0x81, 0xc3, 0x9e, 0x05, 0x7e, 0xf5, 0x2c, 0xb0, 0x7b, 0x6c,
+	0x65, 0x0e, 0x8f, 0xad, 0xcc, 0xf7, 0x63, 0x2b, 0xf3, 0x62, 0xf1, 0x9f, 0x11, 0x0c, 0x4c, 0x53,
+	0x69, 0x38, 0x59, 0xe5, 0xf4, 0xe6, 0xdf, 0x01, 0x00, 0x37, 0x19, 0x4a, 0x30, 0xcf, 0x05, 0x00,
+	0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -309,6 +343,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.CorrelatedSlashWindow != that1.CorrelatedSlashWindow {
+		return false
+	}
+	if !this.CorrelatedSlashMultiplier.Equal(that1.CorrelatedSlashMultiplier) {
+		return false
+	}
+	if this.DoubleSignJailDuration != that1.DoubleSignJailDuration {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -394,6 +437,29 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DoubleSignJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DoubleSignJailDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.CorrelatedSlashMultiplier.Size()
+		i -= size
+		if _, err := m.CorrelatedSlashMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.CorrelatedSlashWindow != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.CorrelatedSlashWindow))
+		i--
+		dAtA[i] = 0x30
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -414,12 +480,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -495,6 +561,13 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	if m.CorrelatedSlashWindow != 0 {
+		n += 1 + sovSlashing(uint64(m.CorrelatedSlashWindow))
+	}
+	l = m.CorrelatedSlashMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DoubleSignJailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -876,6 +949,91 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelatedSlashWindow", wireType)
+			}
+			m.CorrelatedSlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CorrelatedSlashWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelatedSlashMultiplier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CorrelatedSlashMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleSignJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DoubleSignJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
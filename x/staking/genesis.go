@@ -45,6 +45,14 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateQueuedMessages(data.QueuedMessages, data.LastQueuedMessageId); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
@@ -85,3 +93,47 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 
 	return nil
 }
+
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastRecordID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+
+		if record.Id > lastRecordID {
+			return fmt.Errorf("tokenize share record id %d is greater than the last tokenize share record id %d", record.Id, lastRecordID)
+		}
+
+		ids[record.Id] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateQueuedMessages(msgs []types.QueuedMessage, lastMsgID uint64) error {
+	ids := make(map[uint64]bool, len(msgs))
+
+	for _, msg := range msgs {
+		if err := msg.Validate(); err != nil {
+			return err
+		}
+
+		if ids[msg.Id] {
+			return fmt.Errorf("duplicate queued message in genesis state: id %d", msg.Id)
+		}
+
+		if msg.Id > lastMsgID {
+			return fmt.Errorf("queued message id %d is greater than the last queued message id %d", msg.Id, lastMsgID)
+		}
+
+		ids[msg.Id] = true
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,6 +21,20 @@ func TestValidateGenesis(t *testing.T) {
 	genValidators1[0].Tokens = math.OneInt()
 	genValidators1[0].DelegatorShares = math.LegacyOneDec()
 
+	tokenizeShareRecord := types.TokenizeShareRecord{
+		Id:            1,
+		Owner:         sdk.AccAddress(pk.Address()).String(),
+		ModuleAccount: types.TokenizeShareRecordModuleAccountName(1),
+		Validator:     sdk.ValAddress(pk.Address()).String(),
+	}
+
+	queuedMessage, err := types.NewQueuedMessage(1, 5, &types.MsgDelegate{
+		DelegatorAddress: sdk.AccAddress(pk.Address()).String(),
+		ValidatorAddress: sdk.ValAddress(pk.Address()).String(),
+		Amount:           sdk.NewInt64Coin(sdk.DefaultBondDenom, 10),
+	})
+	require.NoError(t, err)
+
 	tests := []struct {
 		name    string
 		mutate  func(*types.GenesisState)
@@ -40,6 +55,42 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate genesis tokenize share records
+		{"valid tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{tokenizeShareRecord}
+			data.LastTokenizeShareRecordId = 1
+		}, false},
+		{"duplicate tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{tokenizeShareRecord, tokenizeShareRecord}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"tokenize share record id above last id", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{tokenizeShareRecord}
+		}, true},
+		{"invalid tokenize share record module account", func(data *types.GenesisState) {
+			record := tokenizeShareRecord
+			record.ModuleAccount = "foo"
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		// validate genesis queued messages
+		{"valid queued message", func(data *types.GenesisState) {
+			data.QueuedMessages = []types.QueuedMessage{queuedMessage}
+			data.LastQueuedMessageId = 1
+		}, false},
+		{"duplicate queued message", func(data *types.GenesisState) {
+			data.QueuedMessages = []types.QueuedMessage{queuedMessage, queuedMessage}
+			data.LastQueuedMessageId = 1
+		}, true},
+		{"queued message id above last id", func(data *types.GenesisState) {
+			data.QueuedMessages = []types.QueuedMessage{queuedMessage}
+		}, true},
+		{"queued message delegator mismatch", func(data *types.GenesisState) {
+			msg := queuedMessage
+			msg.DelegatorAddress = sdk.AccAddress([]byte("other")).String()
+			data.QueuedMessages = []types.QueuedMessage{msg}
+			data.LastQueuedMessageId = 1
+		}, true},
 	}
 
 	for _, tt := range tests {
@@ -961,6 +961,11 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, types.ErrMaxRedelegationEntries
 	}
 
+	tokens := srcValidator.TokensFromShares(sharesAmount).TruncateInt()
+	if err := k.CheckDelegationCaps(ctx, dstValidator, delAddr, tokens, srcValidator.GetStatus()); err != nil {
+		return time.Time{}, err
+	}
+
 	returnAmount, err := k.Unbond(ctx, delAddr, valSrcAddr, sharesAmount)
 	if err != nil {
 		return time.Time{}, err
@@ -0,0 +1,349 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/armon/go-metrics"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// IsEpochEnd returns true if the current block is the last block of an epoch.
+// When epochs are disabled every block ends an epoch.
+func (k Keeper) IsEpochEnd(ctx sdk.Context) bool {
+	epochLength := k.EpochLength(ctx)
+	if epochLength == 0 {
+		return true
+	}
+
+	return uint64(ctx.BlockHeight())%epochLength == 0
+}
+
+// GetEpochEndHeight returns the height of the last block of the current epoch,
+// or zero when epochs are disabled.
+func (k Keeper) GetEpochEndHeight(ctx sdk.Context) int64 {
+	epochLength := int64(k.EpochLength(ctx))
+	if epochLength == 0 {
+		return 0
+	}
+
+	height := ctx.BlockHeight()
+	if rem := height % epochLength; rem != 0 {
+		height += epochLength - rem
+	}
+
+	return height
+}
+
+// GetLastQueuedMessageID returns the id of the last queued message.
+func (k Keeper) GetLastQueuedMessageID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastQueuedMessageIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastQueuedMessageID sets the id of the last queued message.
+func (k Keeper) SetLastQueuedMessageID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastQueuedMessageIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SetQueuedMessage stores a message queued until the end of the epoch.
+func (k Keeper) SetQueuedMessage(ctx sdk.Context, msg types.QueuedMessage) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetQueuedMessageKey(msg.Id), k.cdc.MustMarshal(&msg))
+}
+
+// DeleteQueuedMessage removes a queued message.
+func (k Keeper) DeleteQueuedMessage(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetQueuedMessageKey(id))
+}
+
+// GetAllQueuedMessages returns all the queued messages ordered by id.
+func (k Keeper) GetAllQueuedMessages(ctx sdk.Context) (msgs []types.QueuedMessage) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.QueuedMessageKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var msg types.QueuedMessage
+		k.cdc.MustUnmarshal(iterator.Value(), &msg)
+
+		msgs = append(msgs, msg)
+	}
+
+	return msgs
+}
+
+// ApplyQueuedMessages executes all the messages queued during the epoch in the
+// order they were received. The escrowed funds or shares of each message are
+// given back to its delegator before it is executed, so that they are refunded
+// if it fails. A message that fails is dropped without affecting the other ones.
+func (k *Keeper) ApplyQueuedMessages(ctx sdk.Context) {
+	ms := msgServer{Keeper: k}
+
+	for _, queued := range k.GetAllQueuedMessages(ctx) {
+		k.DeleteQueuedMessage(ctx, queued.Id)
+
+		msg, err := queued.UnpackMsg()
+		if err == nil {
+			cacheCtx, write := ctx.CacheContext()
+			if err = k.releaseQueuedMessage(cacheCtx, queued, msg); err == nil {
+				write()
+			}
+		}
+
+		if err == nil {
+			cacheCtx, write := ctx.CacheContext()
+			if err = ms.executeQueuedMessage(cacheCtx, msg); err == nil {
+				write()
+				recordMsgTelemetry(msg)
+			}
+		}
+
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyQueuedMessageID, strconv.FormatUint(queued.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDelegator, queued.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+		}
+		if queued.Msg != nil {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyMsgType, queued.Msg.TypeUrl))
+		}
+		if err != nil {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeApplyQueuedMessage, attrs...))
+	}
+}
+
+// queueMessage stores a staking message until the end of the current epoch.
+// The message is first executed against a discarded cached context so that it
+// fails at submission time if it would fail given the current state. Its funds
+// or delegation shares are then escrowed until it is executed.
+func (k msgServer) queueMessage(ctx sdk.Context, msg sdk.Msg) error {
+	cacheCtx, _ := ctx.CacheContext()
+	if err := k.executeQueuedMessage(cacheCtx, msg); err != nil {
+		return err
+	}
+
+	id := k.GetLastQueuedMessageID(ctx) + 1
+
+	queued, err := types.NewQueuedMessage(id, ctx.BlockHeight(), msg)
+	if err != nil {
+		return err
+	}
+
+	if err := k.escrowQueuedMessage(ctx, queued, msg); err != nil {
+		return err
+	}
+
+	k.SetQueuedMessage(ctx, queued)
+	k.SetLastQueuedMessageID(ctx, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueueMessage,
+			sdk.NewAttribute(types.AttributeKeyQueuedMessageID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyMsgType, sdk.MsgTypeURL(msg)),
+			sdk.NewAttribute(types.AttributeKeyDelegator, queued.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEpochEndHeight, strconv.FormatInt(k.GetEpochEndHeight(ctx), 10)),
+		),
+	)
+
+	return nil
+}
+
+// executeQueuedMessage executes a message which can be queued until the end of
+// the epoch.
+func (k msgServer) executeQueuedMessage(ctx sdk.Context, msg sdk.Msg) (err error) {
+	switch msg := msg.(type) {
+	case *types.MsgDelegate:
+		_, err = k.delegate(ctx, msg)
+	case *types.MsgUndelegate:
+		_, err = k.undelegate(ctx, msg)
+	case *types.MsgBeginRedelegate:
+		_, err = k.beginRedelegate(ctx, msg)
+	default:
+		err = fmt.Errorf("message %s cannot be queued", sdk.MsgTypeURL(msg))
+	}
+
+	return err
+}
+
+// escrowQueuedMessage moves the funds of a queued delegation to the epoch
+// escrow pool, and the delegation shares of a queued undelegation or
+// redelegation to the escrow module account of the message, so that they
+// cannot be spent or undelegated again until the message is executed.
+func (k Keeper) escrowQueuedMessage(ctx sdk.Context, queued types.QueuedMessage, msg sdk.Msg) error {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(queued.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	switch msg := msg.(type) {
+	case *types.MsgDelegate:
+		return k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, delegatorAddress, types.EpochEscrowPoolName, sdk.NewCoins(msg.Amount))
+	case *types.MsgUndelegate:
+		return k.escrowShares(ctx, delegatorAddress, queued.GetEscrowAddress(), msg.ValidatorAddress, msg.Amount.Amount)
+	case *types.MsgBeginRedelegate:
+		return k.escrowShares(ctx, delegatorAddress, queued.GetEscrowAddress(), msg.ValidatorSrcAddress, msg.Amount.Amount)
+	default:
+		return fmt.Errorf("message %s cannot be queued", sdk.MsgTypeURL(msg))
+	}
+}
+
+// releaseQueuedMessage gives the escrowed funds or delegation shares of a
+// queued message back to its delegator.
+func (k Keeper) releaseQueuedMessage(ctx sdk.Context, queued types.QueuedMessage, msg sdk.Msg) error {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(queued.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	switch msg := msg.(type) {
+	case *types.MsgDelegate:
+		return k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.EpochEscrowPoolName, delegatorAddress, sdk.NewCoins(msg.Amount))
+	case *types.MsgUndelegate:
+		return k.releaseShares(ctx, queued.GetEscrowAddress(), delegatorAddress, msg.ValidatorAddress)
+	case *types.MsgBeginRedelegate:
+		return k.releaseShares(ctx, queued.GetEscrowAddress(), delegatorAddress, msg.ValidatorSrcAddress)
+	default:
+		return fmt.Errorf("message %s cannot be queued", sdk.MsgTypeURL(msg))
+	}
+}
+
+// escrowShares moves the delegation shares matching the given amount of
+// tokens from the delegator to the escrow account.
+func (k Keeper) escrowShares(
+	ctx sdk.Context, delAddr, escrowAddr sdk.AccAddress, validatorAddr string, amount math.Int,
+) error {
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
+	if err != nil {
+		return err
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return err
+	}
+
+	return k.transferDelegationShares(ctx, delAddr, escrowAddr, valAddr, shares)
+}
+
+// releaseShares moves all the delegation shares of the escrow account back to
+// the delegator, along with the rewards they earned while escrowed.
+func (k Keeper) releaseShares(ctx sdk.Context, escrowAddr, delAddr sdk.AccAddress, validatorAddr string) error {
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddr)
+	if err != nil {
+		return err
+	}
+
+	if delegation, found := k.GetDelegation(ctx, escrowAddr, valAddr); found {
+		if err := k.transferDelegationShares(ctx, escrowAddr, delAddr, valAddr, delegation.Shares); err != nil {
+			return err
+		}
+	}
+
+	// the rewards of the escrowed shares are withdrawn to the escrow account
+	// by the hooks when its delegation is removed
+	rewards := k.bankKeeper.GetAllBalances(ctx, escrowAddr)
+	if rewards.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoins(ctx, escrowAddr, delAddr, rewards)
+}
+
+// transferDelegationShares moves delegation shares of a validator from a
+// delegator to another one. The tokens of the validator do not change.
+func (k Keeper) transferDelegationShares(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec,
+) error {
+	from, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return types.ErrNoDelegatorForAddress
+	}
+
+	if from.Shares.LT(shares) {
+		return errorsmod.Wrap(types.ErrNotEnoughDelegationShares, from.Shares.String())
+	}
+
+	if err := k.Hooks().BeforeDelegationSharesModified(ctx, fromAddr, valAddr); err != nil {
+		return err
+	}
+
+	from.Shares = from.Shares.Sub(shares)
+	if from.Shares.IsZero() {
+		if err := k.RemoveDelegation(ctx, from); err != nil {
+			return err
+		}
+	} else {
+		k.SetDelegation(ctx, from)
+		if err := k.Hooks().AfterDelegationModified(ctx, fromAddr, valAddr); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	to, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		err = k.Hooks().BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		to = types.NewDelegation(toAddr, valAddr, math.LegacyZeroDec())
+		err = k.Hooks().BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	to.Shares = to.Shares.Add(shares)
+	k.SetDelegation(ctx, to)
+
+	return k.Hooks().AfterDelegationModified(ctx, toAddr, valAddr)
+}
+
+// recordMsgTelemetry records the telemetry of an executed delegation,
+// undelegation or redelegation.
+func recordMsgTelemetry(msg sdk.Msg) {
+	var (
+		name   string
+		amount sdk.Coin
+	)
+
+	switch msg := msg.(type) {
+	case *types.MsgDelegate:
+		name, amount = "delegate", msg.Amount
+	case *types.MsgUndelegate:
+		name, amount = "undelegate", msg.Amount
+	case *types.MsgBeginRedelegate:
+		name, amount = "redelegate", msg.Amount
+	default:
+		return
+	}
+
+	if !amount.Amount.IsInt64() {
+		return
+	}
+
+	telemetry.IncrCounter(1, types.ModuleName, name)
+	telemetry.SetGaugeWithLabels(
+		[]string{"tx", "msg", sdk.MsgTypeURL(msg)},
+		float32(amount.Amount.Int64()),
+		[]metrics.Label{telemetry.NewLabel("denom", amount.Denom)},
+	)
+}
@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestEpochEndHeight() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	// epochs are disabled by default
+	ctx = ctx.WithBlockHeight(7)
+	require.True(keeper.IsEpochEnd(ctx))
+	require.Equal(int64(0), keeper.GetEpochEndHeight(ctx))
+
+	params := stakingtypes.DefaultParams()
+	params.EpochLength = 10
+	require.NoError(keeper.SetParams(ctx, params))
+
+	require.False(keeper.IsEpochEnd(ctx))
+	require.Equal(int64(10), keeper.GetEpochEndHeight(ctx))
+
+	ctx = ctx.WithBlockHeight(10)
+	require.True(keeper.IsEpochEnd(ctx))
+	require.Equal(int64(10), keeper.GetEpochEndHeight(ctx))
+
+	ctx = ctx.WithBlockHeight(11)
+	require.False(keeper.IsEpochEnd(ctx))
+	require.Equal(int64(20), keeper.GetEpochEndHeight(ctx))
+}
+
+func (s *KeeperTestSuite) TestQueuedMessages() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	comm := stakingtypes.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	createMsg, err := stakingtypes.NewMsgCreateValidator(ValAddr, ed25519.GenPrivKey().PubKey(), sdk.NewCoin("stake", sdk.NewInt(10)), stakingtypes.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, createMsg)
+	require.NoError(err)
+
+	params := stakingtypes.DefaultParams()
+	params.EpochLength = 10
+	require.NoError(keeper.SetParams(ctx, params))
+	ctx = ctx.WithBlockHeight(5)
+
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, keeper.TokensFromConsensusPower(ctx, 100))
+
+	// a message which would fail is rejected instead of being queued
+	_, err = msgServer.Delegate(ctx, &stakingtypes.MsgDelegate{
+		DelegatorAddress: Addr.String(),
+		ValidatorAddress: sdk.ValAddress([]byte("val")).String(),
+		Amount:           amount,
+	})
+	require.ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+	require.Empty(keeper.GetAllQueuedMessages(ctx))
+
+	delegation, found := keeper.GetDelegation(ctx, Addr, ValAddr)
+	require.True(found)
+	shares := delegation.Shares
+
+	// the delegated funds are escrowed until the end of the epoch
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), Addr, stakingtypes.EpochEscrowPoolName, sdk.NewCoins(amount)).Times(1)
+	_, err = msgServer.Delegate(ctx, &stakingtypes.MsgDelegate{
+		DelegatorAddress: Addr.String(),
+		ValidatorAddress: ValAddr.String(),
+		Amount:           amount,
+	})
+	require.NoError(err)
+
+	// the delegation is not applied until the end of the epoch
+	delegation, _ = keeper.GetDelegation(ctx, Addr, ValAddr)
+	require.Equal(shares, delegation.Shares)
+
+	queued := keeper.GetAllQueuedMessages(ctx)
+	require.Len(queued, 1)
+	require.Equal(uint64(1), queued[0].Id)
+	require.Equal(int64(5), queued[0].Height)
+	require.Equal(Addr.String(), queued[0].DelegatorAddress)
+	require.Equal(uint64(1), keeper.GetLastQueuedMessageID(ctx))
+
+	querier := stakingkeeper.Querier{Keeper: keeper}
+	res, err := querier.QueuedMessages(ctx, &stakingtypes.QueryQueuedMessagesRequest{DelegatorAddr: Addr.String()})
+	require.NoError(err)
+	require.Len(res.Messages, 1)
+	require.Equal(int64(10), res.EpochEndHeight)
+
+	ctx = ctx.WithBlockHeight(10)
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.EpochEscrowPoolName, Addr, sdk.NewCoins(amount)).Times(1)
+	keeper.ApplyQueuedMessages(ctx)
+
+	delegation, _ = keeper.GetDelegation(ctx, Addr, ValAddr)
+	require.Equal(shares.Add(math.LegacyNewDecFromInt(amount.Amount)), delegation.Shares)
+	require.Empty(keeper.GetAllQueuedMessages(ctx))
+}
+
+func (s *KeeperTestSuite) TestQueuedUndelegationEscrow() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	comm := stakingtypes.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	selfBond := sdk.NewCoin(sdk.DefaultBondDenom, keeper.TokensFromConsensusPower(ctx, 100))
+	createMsg, err := stakingtypes.NewMsgCreateValidator(ValAddr, ed25519.GenPrivKey().PubKey(), selfBond, stakingtypes.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, createMsg)
+	require.NoError(err)
+
+	params := stakingtypes.DefaultParams()
+	params.EpochLength = 10
+	params.MaxEntries = 1
+	require.NoError(keeper.SetParams(ctx, params))
+	ctx = ctx.WithBlockHeight(5)
+
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, keeper.TokensFromConsensusPower(ctx, 10))
+	msg := &stakingtypes.MsgUndelegate{
+		DelegatorAddress: Addr.String(),
+		ValidatorAddress: ValAddr.String(),
+		Amount:           amount,
+	}
+
+	// the undelegated shares are escrowed until the end of the epoch
+	_, err = msgServer.Undelegate(ctx, msg)
+	require.NoError(err)
+	_, err = msgServer.Undelegate(ctx, msg)
+	require.NoError(err)
+
+	queued := keeper.GetAllQueuedMessages(ctx)
+	require.Len(queued, 2)
+
+	delegation, found := keeper.GetDelegation(ctx, Addr, ValAddr)
+	require.True(found)
+	require.Equal(math.LegacyNewDecFromInt(keeper.TokensFromConsensusPower(ctx, 80)), delegation.Shares)
+	for _, q := range queued {
+		escrowed, found := keeper.GetDelegation(ctx, q.GetEscrowAddress(), ValAddr)
+		require.True(found)
+		require.Equal(math.LegacyNewDecFromInt(amount.Amount), escrowed.Shares)
+	}
+
+	// the undelegated shares cannot be undelegated again
+	_, err = msgServer.Undelegate(ctx, &stakingtypes.MsgUndelegate{
+		DelegatorAddress: Addr.String(),
+		ValidatorAddress: ValAddr.String(),
+		Amount:           sdk.NewCoin(sdk.DefaultBondDenom, keeper.TokensFromConsensusPower(ctx, 90)),
+	})
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// the second undelegation exceeds the max entries and fails, its shares
+	// are given back to the delegator along with the rewards they earned
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 7))
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), queued[0].GetEscrowAddress()).Return(sdk.NewCoins())
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), queued[1].GetEscrowAddress()).Return(rewards)
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), queued[1].GetEscrowAddress(), Addr, rewards).Times(1)
+
+	ctx = ctx.WithBlockHeight(10)
+	keeper.ApplyQueuedMessages(ctx)
+
+	delegation, found = keeper.GetDelegation(ctx, Addr, ValAddr)
+	require.True(found)
+	require.Equal(math.LegacyNewDecFromInt(keeper.TokensFromConsensusPower(ctx, 90)), delegation.Shares)
+	for _, q := range queued {
+		_, found := keeper.GetDelegation(ctx, q.GetEscrowAddress(), ValAddr)
+		require.False(found)
+	}
+
+	ubd, found := keeper.GetUnbondingDelegation(ctx, Addr, ValAddr)
+	require.True(found)
+	require.Len(ubd.Entries, 1)
+	require.Equal(amount.Amount, ubd.Entries[0].Balance)
+}
+
+func (s *KeeperTestSuite) TestRemovedValidatorUpdatesDuringEpoch() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	var validators [2]stakingtypes.Validator
+	for i := range validators {
+		validators[i] = testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validators[i], _ = validators[i].AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 100))
+
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+		validators[i] = stakingkeeper.TestingUpdateValidator(keeper, ctx, validators[i], true)
+		keeper.SetValidatorByConsAddr(ctx, validators[i])
+	}
+	require.Equal(math.NewInt(200), keeper.GetLastTotalPower(ctx))
+
+	params := stakingtypes.DefaultParams()
+	params.EpochLength = 10
+	require.NoError(keeper.SetParams(ctx, params))
+	ctx = ctx.WithBlockHeight(5)
+
+	// decrease the power of the first validator and jail the second one
+	validators[0], _ = validators[0].RemoveDelShares(math.LegacyNewDecFromInt(keeper.TokensFromConsensusPower(ctx, 20)))
+	validators[0] = stakingkeeper.TestingUpdateValidator(keeper, ctx, validators[0], false)
+	consAddr, err := validators[1].GetConsAddr()
+	require.NoError(err)
+	keeper.Jail(ctx, consAddr)
+
+	// the jailed validator leaves the set right away, the power decrease is
+	// deferred to the end of the epoch
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, gomock.Any())
+	updates := keeper.BlockValidatorUpdates(ctx)
+	require.Len(updates, 1)
+	validators[1], _ = keeper.GetValidator(ctx, validators[1].GetOperator())
+	require.Equal(validators[1].ABCIValidatorUpdateZero(), updates[0])
+	require.Equal(stakingtypes.Unbonding, validators[1].Status)
+	require.Equal(math.NewInt(100), keeper.GetLastTotalPower(ctx))
+	require.Equal(int64(100), keeper.GetLastValidatorPower(ctx, validators[0].GetOperator()))
+
+	require.Empty(keeper.BlockValidatorUpdates(ctx.WithBlockHeight(6)))
+
+	ctx = ctx.WithBlockHeight(10)
+	updates = keeper.BlockValidatorUpdates(ctx)
+	require.Len(updates, 1)
+	require.Equal(validators[0].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[0])
+	require.Equal(math.NewInt(80), keeper.GetLastTotalPower(ctx))
+}
@@ -103,6 +103,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	k.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.SetTokenizeShareRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+	k.initLiquidStake(ctx, data.TokenizeShareRecords)
+
+	k.SetLastQueuedMessageID(ctx, data.LastQueuedMessageId)
+	for _, msg := range data.QueuedMessages {
+		k.SetQueuedMessage(ctx, msg)
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -197,13 +210,45 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Params:               k.GetParams(ctx),
-		LastTotalPower:       k.GetLastTotalPower(ctx),
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           k.GetAllValidators(ctx),
-		Delegations:          k.GetAllDelegations(ctx),
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    k.GetParams(ctx),
+		LastTotalPower:            k.GetLastTotalPower(ctx),
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                k.GetAllValidators(ctx),
+		Delegations:               k.GetAllDelegations(ctx),
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		TokenizeShareRecords:      k.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordId: k.GetLastTokenizeShareRecordID(ctx),
+		QueuedMessages:            k.GetAllQueuedMessages(ctx),
+		LastQueuedMessageId:       k.GetLastQueuedMessageID(ctx),
 	}
 }
+
+// initLiquidStake computes the liquid staked tokens and the liquid shares of
+// each validator from the delegations of the given tokenize share records.
+func (k Keeper) initLiquidStake(ctx sdk.Context, records []types.TokenizeShareRecord) {
+	totalLiquidStaked := math.LegacyZeroDec()
+
+	for _, record := range records {
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			panic(err)
+		}
+
+		delegation, found := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+		if !found {
+			continue
+		}
+
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			panic(fmt.Sprintf("validator %s of tokenize share record %d not found", record.Validator, record.Id))
+		}
+
+		totalLiquidStaked = totalLiquidStaked.Add(validator.TokensFromShares(delegation.Shares))
+		k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Add(delegation.Shares))
+	}
+
+	k.SetTotalLiquidStakedTokens(ctx, totalLiquidStaked.TruncateInt())
+}
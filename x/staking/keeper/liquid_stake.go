@@ -0,0 +1,277 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// vestingAccount is the subset of the vesting account interface needed to
+// prevent vesting tokens from being tokenized.
+type vestingAccount interface {
+	GetDelegatedFree() sdk.Coins
+}
+
+// CheckExceedsGlobalLiquidStakingCap returns an error if tokenizing the given
+// amount of tokens would bring the fraction of liquid staked tokens above the
+// global liquid staking cap.
+func (k Keeper) CheckExceedsGlobalLiquidStakingCap(ctx sdk.Context, tokens math.Int) error {
+	liquidStakingCap := k.GetParams(ctx).GlobalLiquidStakingCap
+	if liquidStakingCap.GTE(math.LegacyOneDec()) {
+		return nil
+	}
+
+	totalBonded := k.TotalBondedTokens(ctx)
+	if !totalBonded.IsPositive() {
+		return types.ErrGlobalLiquidStakingCapExceeded
+	}
+
+	totalLiquidStaked := k.GetTotalLiquidStakedTokens(ctx).Add(tokens)
+	liquidStakePercent := math.LegacyNewDecFromInt(totalLiquidStaked).QuoInt(totalBonded)
+	if liquidStakePercent.GT(liquidStakingCap) {
+		return types.ErrGlobalLiquidStakingCapExceeded
+	}
+
+	return nil
+}
+
+// CheckExceedsValidatorLiquidStakingCap returns an error if tokenizing the
+// given amount of shares would bring the fraction of liquid shares of the
+// validator above the validator liquid staking cap.
+func (k Keeper) CheckExceedsValidatorLiquidStakingCap(ctx sdk.Context, validator types.Validator, shares math.LegacyDec) error {
+	liquidStakingCap := k.GetParams(ctx).ValidatorLiquidStakingCap
+	if liquidStakingCap.GTE(math.LegacyOneDec()) {
+		return nil
+	}
+
+	if !validator.DelegatorShares.IsPositive() {
+		return types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	liquidShares := k.GetValidatorLiquidShares(ctx, validator.GetOperator()).Add(shares)
+	liquidSharePercent := liquidShares.Quo(validator.DelegatorShares)
+	if liquidSharePercent.GT(liquidStakingCap) {
+		return types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	return nil
+}
+
+// increaseLiquidStake records the tokens and shares of a validator which
+// became liquid.
+func (k Keeper) increaseLiquidStake(ctx sdk.Context, valAddr sdk.ValAddress, tokens math.Int, shares math.LegacyDec) {
+	k.SetTotalLiquidStakedTokens(ctx, k.GetTotalLiquidStakedTokens(ctx).Add(tokens))
+	k.SetValidatorLiquidShares(ctx, valAddr, k.GetValidatorLiquidShares(ctx, valAddr).Add(shares))
+}
+
+// decreaseLiquidStake records the tokens and shares of a validator which are
+// no longer liquid. The tracked amounts cannot become negative, as slashing
+// may have reduced the liquid staked tokens in the meantime.
+func (k Keeper) decreaseLiquidStake(ctx sdk.Context, valAddr sdk.ValAddress, tokens math.Int, shares math.LegacyDec) {
+	totalLiquidStaked := math.MaxInt(k.GetTotalLiquidStakedTokens(ctx).Sub(tokens), math.ZeroInt())
+	k.SetTotalLiquidStakedTokens(ctx, totalLiquidStaked)
+
+	liquidShares := math.LegacyMaxDec(k.GetValidatorLiquidShares(ctx, valAddr).Sub(shares), math.LegacyZeroDec())
+	k.SetValidatorLiquidShares(ctx, valAddr, liquidShares)
+}
+
+// decreaseLiquidStakeOnSlash removes from the total liquid staked tokens the
+// part of a validator slash which is borne by its liquid shares.
+func (k Keeper) decreaseLiquidStakeOnSlash(ctx sdk.Context, validator types.Validator, tokensToBurn math.Int) {
+	liquidShares := k.GetValidatorLiquidShares(ctx, validator.GetOperator())
+	if liquidShares.IsZero() || !validator.DelegatorShares.IsPositive() {
+		return
+	}
+
+	slashedLiquidTokens := liquidShares.MulInt(tokensToBurn).Quo(validator.DelegatorShares).TruncateInt()
+	totalLiquidStaked := math.MaxInt(k.GetTotalLiquidStakedTokens(ctx).Sub(slashedLiquidTokens), math.ZeroInt())
+	k.SetTotalLiquidStakedTokens(ctx, totalLiquidStaked)
+}
+
+// TokenizeShares converts the given amount of tokens of a delegation into
+// share tokens of a new tokenize share record owned by tokenizedShareOwner.
+// The delegation is moved to the module account of the record, and the share
+// tokens are minted to the delegator.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int, tokenizedShareOwner sdk.AccAddress,
+) (shareToken sdk.Coin, recordID uint64, err error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return shareToken, 0, types.ErrNoValidatorFound
+	}
+
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return shareToken, 0, types.ErrRedelegationInProgress
+	}
+
+	// only tokens which are free from vesting can be tokenized, as share tokens
+	// are freely transferable
+	if acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestingAccount); ok {
+		delegatedFree := acc.GetDelegatedFree().AmountOf(k.BondDenom(ctx))
+		if amount.GT(delegatedFree) {
+			return shareToken, 0, errorsmod.Wrapf(types.ErrExceedingFreeVestingDelegations, "%s > %s", amount, delegatedFree)
+		}
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return shareToken, 0, err
+	}
+
+	if err := k.CheckExceedsGlobalLiquidStakingCap(ctx, amount); err != nil {
+		return shareToken, 0, err
+	}
+
+	if err := k.CheckExceedsValidatorLiquidStakingCap(ctx, validator, shares); err != nil {
+		return shareToken, 0, err
+	}
+
+	recordID = k.GetLastTokenizeShareRecordID(ctx) + 1
+	k.SetLastTokenizeShareRecordID(ctx, recordID)
+
+	record := types.TokenizeShareRecord{
+		Id:            recordID,
+		Owner:         tokenizedShareOwner.String(),
+		ModuleAccount: types.TokenizeShareRecordModuleAccountName(recordID),
+		Validator:     valAddr.String(),
+	}
+	if err := k.SetTokenizeShareRecord(ctx, record); err != nil {
+		return shareToken, 0, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return shareToken, 0, err
+	}
+
+	// the validator is removed if the delegation was its last one and it is
+	// unbonded
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return shareToken, 0, types.ErrNoValidatorFound
+	}
+
+	// the tokens stay in the pool matching the validator status, as they are
+	// delegated back to the same validator
+	newShares, err := k.Delegate(ctx, record.GetModuleAddress(), returnAmount, validator.GetStatus(), validator, false)
+	if err != nil {
+		return shareToken, 0, err
+	}
+
+	shareToken = sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if !shareToken.IsPositive() {
+		return shareToken, 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount is too small to be tokenized")
+	}
+
+	shareTokens := sdk.NewCoins(shareToken)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, shareTokens); err != nil {
+		return shareToken, 0, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, shareTokens); err != nil {
+		return shareToken, 0, err
+	}
+
+	k.increaseLiquidStake(ctx, valAddr, returnAmount, newShares)
+
+	return shareToken, recordID, nil
+}
+
+// RedeemTokensForShares burns the given share tokens and delegates the
+// matching tokens of the tokenize share record back to the delegator. Once
+// all the share tokens are redeemed, the record is removed.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress, shareToken sdk.Coin) (sdk.Coin, error) {
+	record, found := k.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	if !found {
+		return sdk.Coin{}, types.ErrNoTokenizeShareRecord
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, delAddr, shareToken.Denom)
+	if balance.Amount.LT(shareToken.Amount) {
+		return sdk.Coin{}, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s < %s", balance, shareToken)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	moduleAddr := record.GetModuleAddress()
+	delegation, found := k.GetDelegation(ctx, moduleAddr, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoDelegation
+	}
+
+	// the fractional shares which could not be tokenized are redeemed along
+	// with the last share tokens
+	shares := math.LegacyNewDecFromInt(shareToken.Amount)
+	if shareToken.Amount.Equal(k.bankKeeper.GetSupply(ctx, shareToken.Denom).Amount) || shares.GT(delegation.Shares) {
+		shares = delegation.Shares
+	}
+
+	shareTokens := sdk.NewCoins(shareToken)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, shareTokens); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, shareTokens); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, moduleAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.decreaseLiquidStake(ctx, valAddr, returnAmount, shares)
+
+	returnCoin := sdk.NewCoin(k.BondDenom(ctx), returnAmount)
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if found {
+		if _, err := k.Delegate(ctx, delAddr, returnAmount, validator.GetStatus(), validator, false); err != nil {
+			return sdk.Coin{}, err
+		}
+	} else if returnAmount.IsPositive() {
+		// the validator was unbonded and removed along with its last
+		// delegation, hence the tokens are returned right away
+		if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, sdk.NewCoins(returnCoin)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if _, found := k.GetDelegation(ctx, moduleAddr, valAddr); !found {
+		if err := k.Hooks().BeforeTokenizeShareRecordRemoved(ctx, record.Id); err != nil {
+			return sdk.Coin{}, err
+		}
+
+		if err := k.DeleteTokenizeShareRecord(ctx, record.Id); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	return returnCoin, nil
+}
+
+// TransferTokenizeShareRecord transfers the ownership of a tokenize share
+// record, and thus of its rewards, from sender to newOwner.
+func (k Keeper) TransferTokenizeShareRecord(ctx sdk.Context, recordID uint64, sender, newOwner sdk.AccAddress) error {
+	record, found := k.GetTokenizeShareRecord(ctx, recordID)
+	if !found {
+		return types.ErrNoTokenizeShareRecord
+	}
+
+	if record.Owner != sender.String() {
+		return types.ErrNotTokenizeShareRecordOwner
+	}
+
+	if err := k.DeleteTokenizeShareRecord(ctx, recordID); err != nil {
+		return err
+	}
+
+	record.Owner = newOwner.String()
+	return k.SetTokenizeShareRecord(ctx, record)
+}
@@ -0,0 +1,283 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupLiquidStakeValidator creates a bonded validator with a delegation of
+// the given tokens from delAddr, and returns the validator.
+func (s *KeeperTestSuite) setupLiquidStakeValidator(delAddr sdk.AccAddress, valAddr sdk.ValAddress, tokens math.Int) stakingtypes.Validator {
+	ctx, keeper := s.ctx, s.stakingKeeper
+
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(tokens)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	validator = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddr, valAddr, issuedShares))
+
+	return validator
+}
+
+// expectTotalBondedTokens mocks the balance of the bonded pool.
+func (s *KeeperTestSuite) expectTotalBondedTokens(tokens math.Int) {
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), stakingtypes.BondedPoolName).Return(bondedAcc).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedAcc.GetAddress(), sdk.DefaultBondDenom).Return(sdk.NewCoin(sdk.DefaultBondDenom, tokens)).AnyTimes()
+}
+
+func (s *KeeperTestSuite) TestTokenizeShareRecords() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(3)
+
+	record1 := stakingtypes.TokenizeShareRecord{
+		Id:            1,
+		Owner:         addrs[0].String(),
+		ModuleAccount: stakingtypes.TokenizeShareRecordModuleAccountName(1),
+		Validator:     valAddrs[0].String(),
+	}
+	record2 := stakingtypes.TokenizeShareRecord{
+		Id:            2,
+		Owner:         addrs[0].String(),
+		ModuleAccount: stakingtypes.TokenizeShareRecordModuleAccountName(2),
+		Validator:     valAddrs[1].String(),
+	}
+	record3 := stakingtypes.TokenizeShareRecord{
+		Id:            3,
+		Owner:         addrs[1].String(),
+		ModuleAccount: stakingtypes.TokenizeShareRecordModuleAccountName(3),
+		Validator:     valAddrs[0].String(),
+	}
+
+	for _, record := range []stakingtypes.TokenizeShareRecord{record1, record2, record3} {
+		require.NoError(record.Validate())
+		require.NoError(keeper.SetTokenizeShareRecord(ctx, record))
+	}
+	keeper.SetLastTokenizeShareRecordID(ctx, 3)
+	require.Equal(uint64(3), keeper.GetLastTokenizeShareRecordID(ctx))
+
+	record, found := keeper.GetTokenizeShareRecord(ctx, 2)
+	require.True(found)
+	require.Equal(record2, record)
+
+	record, found = keeper.GetTokenizeShareRecordByDenom(ctx, record3.GetShareTokenDenom())
+	require.True(found)
+	require.Equal(record3, record)
+
+	require.Equal([]stakingtypes.TokenizeShareRecord{record1, record2}, keeper.GetTokenizeShareRecordsByOwner(ctx, addrs[0]))
+	require.Equal([]stakingtypes.TokenizeShareRecord{record3}, keeper.GetTokenizeShareRecordsByOwner(ctx, addrs[1]))
+	require.Len(keeper.GetAllTokenizeShareRecords(ctx), 3)
+
+	// only the owner can transfer a record
+	err := keeper.TransferTokenizeShareRecord(ctx, 1, addrs[1], addrs[2])
+	require.ErrorIs(err, stakingtypes.ErrNotTokenizeShareRecordOwner)
+
+	require.NoError(keeper.TransferTokenizeShareRecord(ctx, 1, addrs[0], addrs[2]))
+	require.Equal([]stakingtypes.TokenizeShareRecord{record2}, keeper.GetTokenizeShareRecordsByOwner(ctx, addrs[0]))
+	record, found = keeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(found)
+	require.Equal(addrs[2].String(), record.Owner)
+	require.Equal([]stakingtypes.TokenizeShareRecord{record}, keeper.GetTokenizeShareRecordsByOwner(ctx, addrs[2]))
+
+	err = keeper.TransferTokenizeShareRecord(ctx, 4, addrs[0], addrs[2])
+	require.ErrorIs(err, stakingtypes.ErrNoTokenizeShareRecord)
+
+	require.NoError(keeper.DeleteTokenizeShareRecord(ctx, 3))
+	_, found = keeper.GetTokenizeShareRecord(ctx, 3)
+	require.False(found)
+	_, found = keeper.GetTokenizeShareRecordByDenom(ctx, record3.GetShareTokenDenom())
+	require.False(found)
+	require.Empty(keeper.GetTokenizeShareRecordsByOwner(ctx, addrs[1]))
+	require.ErrorIs(keeper.DeleteTokenizeShareRecord(ctx, 3), stakingtypes.ErrNoTokenizeShareRecord)
+}
+
+func (s *KeeperTestSuite) TestTokenizeAndRedeemShares() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(3)
+	delAddr, owner, valAddr := addrs[1], addrs[2], valAddrs[0]
+
+	startTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	s.setupLiquidStakeValidator(delAddr, valAddr, startTokens)
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), delAddr).Return(authtypes.NewBaseAccountWithAddress(delAddr)).AnyTimes()
+
+	// tokenize a part of the delegation
+	tokenizeAmount := keeper.TokensFromConsensusPower(ctx, 4)
+	shareDenom := (stakingtypes.TokenizeShareRecord{Id: 1, Validator: valAddr.String()}).GetShareTokenDenom()
+	shareTokens := sdk.NewCoins(sdk.NewCoin(shareDenom, tokenizeAmount))
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), stakingtypes.ModuleName, shareTokens).Return(nil)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), stakingtypes.ModuleName, delAddr, shareTokens).Return(nil)
+
+	res, err := msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(sdk.DefaultBondDenom, tokenizeAmount), owner))
+	require.NoError(err)
+	require.Equal(sdk.NewCoin(shareDenom, tokenizeAmount), res.Amount)
+
+	record, found := keeper.GetTokenizeShareRecordByDenom(ctx, shareDenom)
+	require.True(found)
+	require.Equal(uint64(1), record.Id)
+	require.Equal(owner.String(), record.Owner)
+	require.Equal(valAddr.String(), record.Validator)
+
+	delegation, found := keeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(found)
+	require.Equal(startTokens.Sub(tokenizeAmount), delegation.Shares.RoundInt())
+
+	delegation, found = keeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.True(found)
+	require.Equal(tokenizeAmount, delegation.Shares.RoundInt())
+
+	require.Equal(tokenizeAmount, keeper.GetTotalLiquidStakedTokens(ctx))
+	require.Equal(math.LegacyNewDecFromInt(tokenizeAmount), keeper.GetValidatorLiquidShares(ctx, valAddr))
+
+	// redeem half of the share tokens
+	redeemAmount := tokenizeAmount.QuoRaw(2)
+	redeemTokens := sdk.NewCoins(sdk.NewCoin(shareDenom, redeemAmount))
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), delAddr, shareDenom).Return(sdk.NewCoin(shareDenom, tokenizeAmount))
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), shareDenom).Return(sdk.NewCoin(shareDenom, tokenizeAmount))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), delAddr, stakingtypes.ModuleName, redeemTokens).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.ModuleName, redeemTokens).Return(nil)
+
+	redeemRes, err := msgServer.RedeemTokensForShares(ctx, stakingtypes.NewMsgRedeemTokensForShares(delAddr, sdk.NewCoin(shareDenom, redeemAmount)))
+	require.NoError(err)
+	require.Equal(sdk.NewCoin(sdk.DefaultBondDenom, redeemAmount), redeemRes.Amount)
+
+	_, found = keeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.True(found)
+	require.Equal(tokenizeAmount.Sub(redeemAmount), keeper.GetTotalLiquidStakedTokens(ctx))
+
+	// redeem the remaining share tokens, which removes the record
+	redeemTokens = sdk.NewCoins(sdk.NewCoin(shareDenom, tokenizeAmount.Sub(redeemAmount)))
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), delAddr, shareDenom).Return(redeemTokens[0])
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), shareDenom).Return(redeemTokens[0])
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), delAddr, stakingtypes.ModuleName, redeemTokens).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.ModuleName, redeemTokens).Return(nil)
+
+	_, err = msgServer.RedeemTokensForShares(ctx, stakingtypes.NewMsgRedeemTokensForShares(delAddr, redeemTokens[0]))
+	require.NoError(err)
+
+	_, found = keeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.False(found)
+	_, found = keeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.False(found)
+
+	delegation, found = keeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(found)
+	require.Equal(startTokens, delegation.Shares.RoundInt())
+
+	require.True(keeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+	require.True(keeper.GetValidatorLiquidShares(ctx, valAddr).IsZero())
+
+	// share tokens of unknown records cannot be redeemed
+	_, err = msgServer.RedeemTokensForShares(ctx, stakingtypes.NewMsgRedeemTokensForShares(delAddr, sdk.NewCoin(shareDenom, redeemAmount)))
+	require.ErrorIs(err, stakingtypes.ErrNoTokenizeShareRecord)
+}
+
+func (s *KeeperTestSuite) TestTokenizeSharesInvalid() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(3)
+	delAddr, owner, valAddr := addrs[1], addrs[2], valAddrs[0]
+
+	startTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	s.setupLiquidStakeValidator(delAddr, valAddr, startTokens)
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(authtypes.NewBaseAccountWithAddress(delAddr)).AnyTimes()
+	s.expectTotalBondedTokens(startTokens)
+
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, keeper.TokensFromConsensusPower(ctx, 4))
+
+	testCases := []struct {
+		name     string
+		msg      *stakingtypes.MsgTokenizeShares
+		malleate func(ctx sdk.Context)
+		expErr   error
+	}{
+		{
+			name:   "invalid denom",
+			msg:    stakingtypes.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin("foo", amount.Amount), owner),
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:   "no delegation",
+			msg:    stakingtypes.NewMsgTokenizeShares(owner, valAddr, amount, owner),
+			expErr: stakingtypes.ErrNoDelegation,
+		},
+		{
+			name: "global liquid staking cap exceeded",
+			msg:  stakingtypes.NewMsgTokenizeShares(delAddr, valAddr, amount, owner),
+			malleate: func(ctx sdk.Context) {
+				params := stakingtypes.DefaultParams()
+				params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(25, 2)
+				require.NoError(keeper.SetParams(ctx, params))
+			},
+			expErr: stakingtypes.ErrGlobalLiquidStakingCapExceeded,
+		},
+		{
+			name: "validator liquid staking cap exceeded",
+			msg:  stakingtypes.NewMsgTokenizeShares(delAddr, valAddr, amount, owner),
+			malleate: func(ctx sdk.Context) {
+				params := stakingtypes.DefaultParams()
+				params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(25, 2)
+				require.NoError(keeper.SetParams(ctx, params))
+			},
+			expErr: stakingtypes.ErrValidatorLiquidStakingCapExceeded,
+		},
+		{
+			name: "redelegation in progress",
+			msg:  stakingtypes.NewMsgTokenizeShares(delAddr, valAddr, amount, owner),
+			malleate: func(ctx sdk.Context) {
+				keeper.SetRedelegation(ctx, stakingtypes.NewRedelegation(delAddr, valAddrs[1], valAddr, 0,
+					time.Unix(0, 0).UTC(), amount.Amount, math.LegacyNewDecFromInt(amount.Amount), 1))
+			},
+			expErr: stakingtypes.ErrRedelegationInProgress,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cacheCtx, _ := ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(cacheCtx)
+			}
+
+			_, err := msgServer.TokenizeShares(cacheCtx, tc.msg)
+			require.ErrorIs(err, tc.expErr)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestTokenizeVestingDelegation() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(3)
+	delAddr, owner, valAddr := addrs[1], addrs[2], valAddrs[0]
+
+	startTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	s.setupLiquidStakeValidator(delAddr, valAddr, startTokens)
+
+	// only a part of the delegated tokens is free from vesting
+	freeTokens := keeper.TokensFromConsensusPower(ctx, 2)
+	vestingAcc := vestingtypes.NewContinuousVestingAccount(authtypes.NewBaseAccountWithAddress(delAddr),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, startTokens)), ctx.BlockTime().Unix(), ctx.BlockTime().Unix()+1000)
+	vestingAcc.DelegatedFree = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, freeTokens))
+	vestingAcc.DelegatedVesting = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, startTokens.Sub(freeTokens)))
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), delAddr).Return(vestingAcc).AnyTimes()
+
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, freeTokens.AddRaw(1))
+	_, err := msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(delAddr, valAddr, amount, owner))
+	require.ErrorIs(err, stakingtypes.ErrExceedingFreeVestingDelegations)
+}
@@ -36,6 +36,11 @@ func (k Keeper) BondDenom(ctx sdk.Context) string {
 	return k.GetParams(ctx).BondDenom
 }
 
+// EpochLength - Number of blocks per epoch, zero if epochs are disabled
+func (k Keeper) EpochLength(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).EpochLength
+}
+
 // PowerReduction - is the amount of staking tokens required for 1 unit of consensus-engine power.
 // Currently, this returns a global variable that the app developer can tweak.
 // TODO: we might turn this into an on-chain param:
@@ -130,6 +130,9 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		}
 	}
 
+	// The liquid shares of the validator bear their part of the slash.
+	k.decreaseLiquidStakeOnSlash(ctx, validator, tokensToBurn)
+
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
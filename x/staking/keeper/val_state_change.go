@@ -15,8 +15,15 @@ import (
 )
 
 // BlockValidatorUpdates calculates the ValidatorUpdates for the current block
-// Called in each EndBlock
+// Called in each EndBlock. When epochs are enabled, the queued staking messages
+// are applied and the validator set is updated only at the end of an epoch,
+// except for the jailed or removed validators which leave the set right away.
 func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
+	var (
+		validatorUpdates []abci.ValidatorUpdate
+		err              error
+	)
+
 	// Calculate validator set changes.
 	//
 	// NOTE: ApplyAndReturnValidatorSetUpdates has to come before
@@ -26,7 +33,13 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	// unbonded after the Endblocker (go from Bonded -> Unbonding during
 	// ApplyAndReturnValidatorSetUpdates and then Unbonding -> Unbonded during
 	// UnbondAllMatureValidatorQueue).
-	validatorUpdates, err := k.ApplyAndReturnValidatorSetUpdates(ctx)
+	if k.IsEpochEnd(ctx) {
+		k.ApplyQueuedMessages(ctx)
+
+		validatorUpdates, err = k.ApplyAndReturnValidatorSetUpdates(ctx)
+	} else {
+		validatorUpdates, err = k.ApplyAndReturnRemovedValidatorUpdates(ctx)
+	}
 	if err != nil {
 		panic(err)
 	}
@@ -232,6 +245,65 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	return updates, err
 }
 
+// ApplyAndReturnRemovedValidatorUpdates removes the jailed validators and the
+// validators without any power left from the bonded validator set, and returns
+// their zero-power updates. It is called instead of
+// ApplyAndReturnValidatorSetUpdates at the EndBlock of the blocks which do not
+// end an epoch, so that the other power changes are deferred to the epoch end.
+func (k Keeper) ApplyAndReturnRemovedValidatorUpdates(ctx sdk.Context) (updates []abci.ValidatorUpdate, err error) {
+	powerReduction := k.PowerReduction(ctx)
+	amtFromBondedToNotBonded, removedPower := math.ZeroInt(), math.ZeroInt()
+
+	last, err := k.getLastValidatorsByAddr(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	removed := make(validatorsByAddr)
+	for valAddrStr, powerBytes := range last {
+		valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+		if err != nil {
+			return nil, err
+		}
+
+		validator := k.mustGetValidator(ctx, valAddr)
+		if validator.Jailed || validator.PotentialConsensusPower(powerReduction) == 0 {
+			removed[valAddrStr] = powerBytes
+		}
+	}
+
+	noLongerBonded, err := sortNoLongerBonded(removed)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, valAddrBytes := range noLongerBonded {
+		validator := k.mustGetValidator(ctx, sdk.ValAddress(valAddrBytes))
+
+		removedPower = removedPower.Add(math.NewInt(k.GetLastValidatorPower(ctx, validator.GetOperator())))
+
+		validator, err = k.bondedToUnbonding(ctx, validator)
+		if err != nil {
+			return
+		}
+		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.GetTokens())
+		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
+		updates = append(updates, validator.ABCIValidatorUpdateZero())
+	}
+
+	if amtFromBondedToNotBonded.IsPositive() {
+		k.bondedTokensToNotBonded(ctx, amtFromBondedToNotBonded)
+	}
+
+	if len(updates) > 0 {
+		k.SetLastTotalPower(ctx, k.GetLastTotalPower(ctx).Sub(removedPower))
+	}
+
+	k.SetValidatorUpdates(ctx, updates)
+
+	return updates, nil
+}
+
 // Validator state transitions
 
 func (k Keeper) bondedToUnbonding(ctx sdk.Context, validator types.Validator) (types.Validator, error) {
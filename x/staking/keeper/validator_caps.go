@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// CheckExceedsSelfBondMultiplier returns an error if delegating the given
+// amount of tokens would bring the tokens of the validator above its
+// self-delegation times the max self-bond multiplier. Self-delegations are
+// not limited.
+func (k Keeper) CheckExceedsSelfBondMultiplier(ctx sdk.Context, validator types.Validator, delAddr sdk.AccAddress, tokens math.Int) error {
+	multiplier := k.GetParams(ctx).MaxSelfBondMultiplier
+	if !multiplier.IsPositive() {
+		return nil
+	}
+
+	valAddr := validator.GetOperator()
+	if delAddr.Equals(sdk.AccAddress(valAddr)) {
+		return nil
+	}
+
+	selfBond := math.LegacyZeroDec()
+	if delegation, found := k.GetDelegation(ctx, sdk.AccAddress(valAddr), valAddr); found {
+		selfBond = validator.TokensFromShares(delegation.Shares)
+	}
+
+	maxTokens := selfBond.Mul(multiplier)
+	if math.LegacyNewDecFromInt(validator.Tokens.Add(tokens)).GT(maxTokens) {
+		return errorsmod.Wrapf(
+			types.ErrSelfBondMultiplierExceeded, "validator %s can hold at most %s tokens", valAddr, maxTokens.TruncateInt(),
+		)
+	}
+
+	return nil
+}
+
+// CheckExceedsMaxValidatorPower returns an error if delegating the given
+// amount of tokens, coming from tokens with the tokenSrc status, would bring
+// the tokens of the validator above the max validator power percent of the
+// total bonded tokens. The tokens of an unbonded validator are counted as if
+// it were bonded.
+func (k Keeper) CheckExceedsMaxValidatorPower(ctx sdk.Context, validator types.Validator, tokens math.Int, tokenSrc types.BondStatus) error {
+	maxPower := k.GetParams(ctx).MaxValidatorPowerPercent
+	if maxPower.GTE(math.LegacyOneDec()) {
+		return nil
+	}
+
+	// there is no meaningful power distribution before the first validators
+	// are bonded, e.g. while delivering the genesis transactions
+	totalBonded := k.TotalBondedTokens(ctx)
+	if !totalBonded.IsPositive() {
+		return nil
+	}
+
+	if !validator.IsBonded() {
+		totalBonded = totalBonded.Add(validator.Tokens)
+	}
+
+	if tokenSrc != types.Bonded {
+		totalBonded = totalBonded.Add(tokens)
+	}
+
+	validatorTokens := validator.Tokens.Add(tokens)
+	power := math.LegacyNewDecFromInt(validatorTokens).QuoInt(totalBonded)
+	if power.GT(maxPower) {
+		return errorsmod.Wrapf(
+			types.ErrMaxValidatorPowerExceeded, "validator %s would hold %s of the bonded tokens, max is %s", validator.GetOperator(), power, maxPower,
+		)
+	}
+
+	return nil
+}
+
+// CheckDelegationCaps returns an error if delegating the given amount of
+// tokens, coming from tokens with the tokenSrc status, to the validator would
+// exceed either the max self-bond multiplier or the max validator power.
+func (k Keeper) CheckDelegationCaps(
+	ctx sdk.Context, validator types.Validator, delAddr sdk.AccAddress, tokens math.Int, tokenSrc types.BondStatus,
+) error {
+	if err := k.CheckExceedsSelfBondMultiplier(ctx, validator, delAddr, tokens); err != nil {
+		return err
+	}
+
+	return k.CheckExceedsMaxValidatorPower(ctx, validator, tokens, tokenSrc)
+}
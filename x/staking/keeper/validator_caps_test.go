@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupSelfBondedValidator creates a bonded validator with a self-delegation
+// of the given tokens, and returns the validator.
+func (s *KeeperTestSuite) setupSelfBondedValidator(valAddr sdk.ValAddress, pkIndex int, tokens math.Int) stakingtypes.Validator {
+	ctx, keeper := s.ctx, s.stakingKeeper
+
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[pkIndex])
+	validator, issuedShares := validator.AddTokensFromDel(tokens)
+
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	validator = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(sdk.AccAddress(valAddr), valAddr, issuedShares))
+
+	return validator
+}
+
+func (s *KeeperTestSuite) TestCheckExceedsSelfBondMultiplier() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(3)
+	selfBond := keeper.TokensFromConsensusPower(ctx, 10)
+	validator := s.setupSelfBondedValidator(valAddrs[0], 0, selfBond)
+
+	// the cap is disabled by default
+	require.NoError(keeper.CheckExceedsSelfBondMultiplier(ctx, validator, addrs[2], selfBond.MulRaw(100)))
+
+	params := stakingtypes.DefaultParams()
+	params.MaxSelfBondMultiplier = math.LegacyNewDec(3)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	require.NoError(keeper.CheckExceedsSelfBondMultiplier(ctx, validator, addrs[2], selfBond.MulRaw(2)))
+
+	err := keeper.CheckExceedsSelfBondMultiplier(ctx, validator, addrs[2], selfBond.MulRaw(2).AddRaw(1))
+	require.ErrorIs(err, stakingtypes.ErrSelfBondMultiplierExceeded)
+
+	// self-delegations are not limited
+	require.NoError(keeper.CheckExceedsSelfBondMultiplier(ctx, validator, sdk.AccAddress(valAddrs[0]), selfBond.MulRaw(100)))
+
+	// a validator without self-delegation cannot receive delegations
+	otherValidator := testutil.NewValidator(s.T(), valAddrs[1], PKs[1])
+	err = keeper.CheckExceedsSelfBondMultiplier(ctx, otherValidator, addrs[2], math.OneInt())
+	require.ErrorIs(err, stakingtypes.ErrSelfBondMultiplierExceeded)
+}
+
+func (s *KeeperTestSuite) TestCheckExceedsMaxValidatorPower() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	_, valAddrs := createValAddrs(1)
+	tokens := keeper.TokensFromConsensusPower(ctx, 10)
+	validator := s.setupSelfBondedValidator(valAddrs[0], 0, tokens)
+	s.expectTotalBondedTokens(tokens.MulRaw(4))
+
+	// the cap is disabled by default
+	require.NoError(keeper.CheckExceedsMaxValidatorPower(ctx, validator, tokens.MulRaw(100), stakingtypes.Unbonded))
+
+	params := stakingtypes.DefaultParams()
+	params.MaxValidatorPowerPercent = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	// unbonded tokens are added to the total bonded tokens
+	require.NoError(keeper.CheckExceedsMaxValidatorPower(ctx, validator, tokens.MulRaw(2), stakingtypes.Unbonded))
+	err := keeper.CheckExceedsMaxValidatorPower(ctx, validator, tokens.MulRaw(2).AddRaw(1), stakingtypes.Unbonded)
+	require.ErrorIs(err, stakingtypes.ErrMaxValidatorPowerExceeded)
+
+	// bonded tokens, e.g. redelegated from a bonded validator, are already
+	// part of the total bonded tokens
+	require.NoError(keeper.CheckExceedsMaxValidatorPower(ctx, validator, tokens, stakingtypes.Bonded))
+	err = keeper.CheckExceedsMaxValidatorPower(ctx, validator, tokens.AddRaw(1), stakingtypes.Bonded)
+	require.ErrorIs(err, stakingtypes.ErrMaxValidatorPowerExceeded)
+}
+
+func (s *KeeperTestSuite) TestDelegationCapsExceeded() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	addrs, valAddrs := createValAddrs(3)
+	delAddr := addrs[2]
+	tokens := keeper.TokensFromConsensusPower(ctx, 10)
+	srcValidator := s.setupSelfBondedValidator(valAddrs[0], 0, tokens)
+	s.setupSelfBondedValidator(valAddrs[1], 1, tokens)
+	s.expectTotalBondedTokens(tokens.MulRaw(2))
+
+	delShares := math.LegacyNewDecFromInt(tokens)
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddr, valAddrs[0], delShares))
+	srcValidator, _ = srcValidator.AddTokensFromDel(tokens)
+	keeper.SetValidator(ctx, srcValidator)
+
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, tokens)
+
+	testCases := []struct {
+		name   string
+		params func(params *stakingtypes.Params)
+		expErr error
+	}{
+		{
+			name: "self-bond multiplier exceeded",
+			params: func(params *stakingtypes.Params) {
+				params.MaxSelfBondMultiplier = math.LegacyNewDecWithPrec(15, 1)
+			},
+			expErr: stakingtypes.ErrSelfBondMultiplierExceeded,
+		},
+		{
+			name: "max validator power exceeded",
+			params: func(params *stakingtypes.Params) {
+				params.MaxValidatorPowerPercent = math.LegacyNewDecWithPrec(55, 2)
+			},
+			expErr: stakingtypes.ErrMaxValidatorPowerExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cacheCtx, _ := ctx.CacheContext()
+			params := stakingtypes.DefaultParams()
+			tc.params(&params)
+			require.NoError(keeper.SetParams(cacheCtx, params))
+
+			_, err := msgServer.Delegate(cacheCtx, stakingtypes.NewMsgDelegate(delAddr, valAddrs[1], amount))
+			require.ErrorIs(err, tc.expErr)
+
+			_, err = keeper.BeginRedelegation(cacheCtx, delAddr, valAddrs[0], valAddrs[1], delShares)
+			require.ErrorIs(err, tc.expErr)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package v4
 
 import (
+	"reflect"
 	"sort"
 
 	storetypes "cosmossdk.io/store/types"
@@ -33,7 +34,7 @@ func migrateParams(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCo
 	var legacyParams types.Params
 	legacySubspace.GetParamSet(ctx, &legacyParams)
 
-	if err := legacyParams.Validate(); err != nil {
+	if err := validateLegacyParams(&legacyParams); err != nil {
 		return err
 	}
 
@@ -42,6 +43,18 @@ func migrateParams(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCo
 	return nil
 }
 
+// validateLegacyParams validates the params managed by x/params. The params
+// introduced after this migration are set and validated by later migrations.
+func validateLegacyParams(params *types.Params) error {
+	for _, pair := range params.ParamSetPairs() {
+		if err := pair.ValidatorFn(reflect.ValueOf(pair.Value).Elem().Interface()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // migrateUBDEntries will remove the ubdEntries with same creation_height
 // and create a new ubdEntry with updated balance and initial_balance
 func migrateUBDEntries(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec, legacySubspace exported.Subspace) error {
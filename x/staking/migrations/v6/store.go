@@ -0,0 +1,42 @@
+package v6
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6.
+// The migration includes:
+//
+// - Setting the global and validator liquid staking caps to their defaults.
+// - Setting the max self-bond multiplier and max validator power percent to
+// their defaults.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	return migrateParams(store, cdc)
+}
+
+// migrateParams sets the liquid staking caps and the validator caps, which
+// did not exist before this version, to their defaults.
+func migrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	bz := store.Get(types.ParamsKey)
+	if bz != nil {
+		cdc.MustUnmarshal(bz, &params)
+	}
+
+	params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	params.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+	params.MaxSelfBondMultiplier = types.DefaultMaxSelfBondMultiplier
+	params.MaxValidatorPowerPercent = types.DefaultMaxValidatorPowerPercent
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+	return nil
+}
@@ -0,0 +1,78 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v6 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v6"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+	storeKey := storetypes.NewKVStoreKey(types.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// params stored before the liquid staking caps and the validator caps were
+	// introduced
+	oldParams := types.DefaultParams()
+	oldParams.MaxValidators = 42
+	store.Set(types.ParamsKey, removeFields(t, cdc.MustMarshal(&oldParams), 7, 8, 9, 10))
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.Equal(t, uint32(42), params.MaxValidators)
+	require.Equal(t, types.DefaultGlobalLiquidStakingCap, params.GlobalLiquidStakingCap)
+	require.Equal(t, types.DefaultValidatorLiquidStakingCap, params.ValidatorLiquidStakingCap)
+	require.Equal(t, types.DefaultMaxSelfBondMultiplier, params.MaxSelfBondMultiplier)
+	require.Equal(t, types.DefaultMaxValidatorPowerPercent, params.MaxValidatorPowerPercent)
+
+	// the zero caps stored by the v4 migration of the legacy params are
+	// replaced as well
+	params.GlobalLiquidStakingCap = sdkmath.LegacyZeroDec()
+	params.MaxValidatorPowerPercent = sdkmath.LegacyZeroDec()
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.Equal(t, types.DefaultGlobalLiquidStakingCap, params.GlobalLiquidStakingCap)
+	require.Equal(t, types.DefaultMaxValidatorPowerPercent, params.MaxValidatorPowerPercent)
+}
+
+// removeFields removes the given fields from an encoded proto message.
+func removeFields(t *testing.T, bz []byte, fields ...protowire.Number) []byte {
+	t.Helper()
+
+	var res []byte
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		require.GreaterOrEqual(t, n, 0)
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		require.GreaterOrEqual(t, m, 0)
+
+		removed := false
+		for _, field := range fields {
+			removed = removed || num == field
+		}
+		if !removed {
+			res = append(res, bz[:n+m]...)
+		}
+
+		bz = bz[n+m:]
+	}
+
+	return res
+}
@@ -16,9 +16,12 @@ import (
 
 // Simulation parameter constants
 const (
-	unbondingTime     = "unbonding_time"
-	maxValidators     = "max_validators"
-	historicalEntries = "historical_entries"
+	unbondingTime      = "unbonding_time"
+	maxValidators      = "max_validators"
+	historicalEntries  = "historical_entries"
+	selfBondMultiplier = "max_self_bond_multiplier"
+	maxValidatorPower  = "max_validator_power_percent"
+	epochLength        = "epoch_length"
 )
 
 // genUnbondingTime returns randomized UnbondingTime
@@ -36,6 +39,32 @@ func getHistEntries(r *rand.Rand) uint32 {
 	return uint32(r.Intn(int(types.DefaultHistoricalEntries + 1)))
 }
 
+// genMaxSelfBondMultiplier returns a randomized MaxSelfBondMultiplier, which
+// is disabled half of the time.
+func genMaxSelfBondMultiplier(r *rand.Rand) sdkmath.LegacyDec {
+	if r.Intn(2) == 0 {
+		return types.DefaultMaxSelfBondMultiplier
+	}
+
+	return sdkmath.LegacyNewDec(int64(simulation.RandIntBetween(r, 2, 20)))
+}
+
+// genMaxValidatorPowerPercent returns a randomized MaxValidatorPowerPercent
+// between 25% and 100%.
+func genMaxValidatorPowerPercent(r *rand.Rand) sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDecWithPrec(int64(simulation.RandIntBetween(r, 25, 101)), 2)
+}
+
+// genEpochLength returns a randomized EpochLength between 1 and 20 blocks,
+// epochs being disabled two thirds of the time.
+func genEpochLength(r *rand.Rand) uint64 {
+	if r.Intn(3) != 0 {
+		return types.DefaultEpochLength
+	}
+
+	return uint64(simulation.RandIntBetween(r, 1, 21))
+}
+
 // RandomizedGenState generates a random GenesisState for staking
 func RandomizedGenState(simState *module.SimulationState) {
 	// params
@@ -44,6 +73,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 		maxVals           uint32
 		histEntries       uint32
 		minCommissionRate sdkmath.LegacyDec
+		selfBondMult      sdkmath.LegacyDec
+		maxValPower       sdkmath.LegacyDec
+		epochLen          uint64
 	)
 
 	simState.AppParams.GetOrGenerate(unbondingTime, &unbondTime, simState.Rand, func(r *rand.Rand) { unbondTime = genUnbondingTime(r) })
@@ -52,10 +84,19 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 	simState.AppParams.GetOrGenerate(historicalEntries, &histEntries, simState.Rand, func(r *rand.Rand) { histEntries = getHistEntries(r) })
 
+	simState.AppParams.GetOrGenerate(selfBondMultiplier, &selfBondMult, simState.Rand, func(r *rand.Rand) { selfBondMult = genMaxSelfBondMultiplier(r) })
+
+	simState.AppParams.GetOrGenerate(maxValidatorPower, &maxValPower, simState.Rand, func(r *rand.Rand) { maxValPower = genMaxValidatorPowerPercent(r) })
+
+	simState.AppParams.GetOrGenerate(epochLength, &epochLen, simState.Rand, func(r *rand.Rand) { epochLen = genEpochLength(r) })
+
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
 	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, simState.BondDenom, minCommissionRate)
+	params.MaxSelfBondMultiplier = selfBondMult
+	params.MaxValidatorPowerPercent = maxValPower
+	params.EpochLength = epochLen
 
 	// validators & delegations
 	var (
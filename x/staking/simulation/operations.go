@@ -137,6 +137,15 @@ func SimulateMsgCreateValidator(
 			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate positive amount"), nil, err
 		}
 
+		validator, err := types.NewValidator(address, simAccount.ConsKey.PubKey(), types.Description{})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to create validator"), nil, err
+		}
+
+		if err := k.CheckExceedsMaxValidatorPower(ctx, validator, amount, types.Unbonded); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "self-delegation exceeds the max validator power"), nil, nil
+		}
+
 		selfDelegation := sdk.NewCoin(denom, amount)
 
 		account := ak.GetAccount(ctx, simAccount.Address)
@@ -290,6 +299,10 @@ func SimulateMsgDelegate(
 			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate positive amount"), nil, err
 		}
 
+		if err := k.CheckDelegationCaps(ctx, val, simAccount.Address, amount, types.Unbonded); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "delegation exceeds the validator caps"), nil, nil
+		}
+
 		bondAmt := sdk.NewCoin(denom, amount)
 
 		account := ak.GetAccount(ctx, simAccount.Address)
@@ -469,6 +482,10 @@ func SimulateMsgCancelUnbondingDelegate(
 			return simtypes.NoOpMsg(types.ModuleName, msgType, "cancelBondAmt amount is zero"), nil, nil
 		}
 
+		if err := k.CheckDelegationCaps(ctx, val, simAccount.Address, cancelBondAmt, types.Unbonding); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "delegation exceeds the validator caps"), nil, nil
+		}
+
 		msg := types.NewMsgCancelUnbondingDelegation(
 			simAccount.Address, valAddr, unbondingDelegationEntry.CreationHeight, sdk.NewCoin(k.BondDenom(ctx), cancelBondAmt),
 		)
@@ -560,6 +577,14 @@ func SimulateMsgBeginRedelegate(
 			return simtypes.NoOpMsg(types.ModuleName, msgType, "shares truncate to zero"), nil, nil // skip
 		}
 
+		if err := k.CheckDelegationCaps(ctx, destVal, delAddr, srcVal.TokensFromShares(shares).TruncateInt(), srcVal.GetStatus()); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "redelegation exceeds the validator caps"), nil, nil
+		}
+
+		if err := k.CheckDelegationCaps(ctx, destVal, delAddr, srcVal.TokensFromShares(shares).TruncateInt(), srcVal.GetStatus()); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "redelegation exceeds the validator caps"), nil, nil
+		}
+
 		// need to retrieve the simulation account associated with delegation to retrieve PrivKey
 		var simAccount simtypes.Account
 
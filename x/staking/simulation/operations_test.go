@@ -277,6 +277,28 @@ func (s *SimTestSuite) TestSimulateMsgDelegate() {
 	require.Len(futureOperations, 0)
 }
 
+// TestSimulateMsgDelegateExceedsCaps tests that no delegation is delivered when
+// it would exceed the validator caps.
+func (s *SimTestSuite) TestSimulateMsgDelegateExceedsCaps() {
+	require := s.Require()
+	blockTime := time.Now().UTC()
+	ctx := s.ctx.WithBlockTime(blockTime)
+
+	// the genesis validator has no self-delegation, thus it cannot receive
+	// delegations once the self-bond multiplier cap is enabled
+	params := s.stakingKeeper.GetParams(ctx)
+	params.MaxSelfBondMultiplier = math.LegacyNewDec(2)
+	require.NoError(s.stakingKeeper.SetParams(ctx, params))
+
+	// execute operation
+	op := simulation.SimulateMsgDelegate(s.txConfig, s.accountKeeper, s.bankKeeper, s.stakingKeeper)
+	operationMsg, futureOperations, err := op(s.r, s.app.BaseApp, ctx, s.accounts[1:], "")
+	require.NoError(err)
+	require.False(operationMsg.OK)
+	require.Equal("delegation exceeds the validator caps", operationMsg.Comment)
+	require.Len(futureOperations, 0)
+}
+
 // TestSimulateMsgUndelegate tests the normal scenario of a valid message of type TypeMsgUndelegate.
 // Abonormal scenarios, where the message is created by an errors are not tested here.
 func (s *SimTestSuite) TestSimulateMsgUndelegate() {
@@ -43,6 +43,9 @@ func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account)
 	params.MaxValidators = uint32(simtypes.RandIntBetween(r, 1, 1000))
 	params.UnbondingTime = time.Duration(simtypes.RandTimestamp(r).UnixNano())
 	params.MinCommissionRate = simtypes.RandomDecAmount(r, sdkmath.LegacyNewDec(1))
+	params.MaxSelfBondMultiplier = sdkmath.LegacyNewDec(int64(simtypes.RandIntBetween(r, 1, 100)))
+	params.MaxValidatorPowerPercent = sdkmath.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 101)), 2)
+	params.EpochLength = uint64(simtypes.RandIntBetween(r, 0, 100))
 
 	return &types.MsgUpdateParams{
 		Authority: authority.String(),
@@ -45,4 +45,7 @@ func TestProposalMsgs(t *testing.T) {
 	assert.Equal(t, uint32(539), msgUpdateParams.Params.MaxValidators)
 	assert.Equal(t, 8898194435*time.Second, msgUpdateParams.Params.UnbondingTime)
 	assert.DeepEqual(t, sdkmath.LegacyNewDecWithPrec(579040435581502128, 18), msgUpdateParams.Params.MinCommissionRate)
+	assert.DeepEqual(t, sdkmath.LegacyNewDec(19), msgUpdateParams.Params.MaxSelfBondMultiplier)
+	assert.DeepEqual(t, sdkmath.LegacyNewDecWithPrec(46, 2), msgUpdateParams.Params.MaxValidatorPowerPercent)
+	assert.Equal(t, uint64(37), msgUpdateParams.Params.EpochLength)
 }
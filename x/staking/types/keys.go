@@ -55,6 +55,16 @@ var (
 	ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
 
 	DelegationByValIndexKey = []byte{0x71} // key for delegations by a validator
+
+	TokenizeShareRecordPrefix          = []byte{0x81} // key for tokenize share records
+	TokenizeShareRecordIDByOwnerPrefix = []byte{0x82} // prefix for each key for a tokenize share record id, by owner
+	TokenizeShareRecordIDByDenomPrefix = []byte{0x83} // prefix for each key for a tokenize share record id, by share denom
+	LastTokenizeShareRecordIDKey       = []byte{0x84} // key for the id of the last tokenize share record
+	TotalLiquidStakedTokensKey         = []byte{0x85} // key for the total liquid staked tokens
+	ValidatorLiquidSharesPrefix        = []byte{0x86} // prefix for each key to the liquid shares of a validator
+
+	QueuedMessageKey       = []byte{0x91} // prefix for the messages queued until the end of the epoch
+	LastQueuedMessageIDKey = []byte{0x92} // key for the id of the last queued message
 )
 
 // UnbondingType defines the type of unbonding operation
@@ -422,3 +432,38 @@ func GetHistoricalInfoKey(height int64) []byte {
 	binary.BigEndian.PutUint64(heightBytes, uint64(height))
 	return append(HistoricalInfoKey, heightBytes...)
 }
+
+// GetTokenizeShareRecordKey returns the key of a tokenize share record.
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerPrefix returns the key prefix for indexing
+// the tokenize share records of an owner.
+func GetTokenizeShareRecordIDsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey returns the key for indexing a
+// tokenize share record by its owner.
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey returns the key for indexing a tokenize
+// share record by its share denom.
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
+}
+
+// GetValidatorLiquidSharesKey returns the key of the liquid shares of a
+// validator.
+func GetValidatorLiquidSharesKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesPrefix, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetQueuedMessageKey returns the key of a message queued until the end of the
+// epoch.
+func GetQueuedMessageKey(id uint64) []byte {
+	return append(QueuedMessageKey, sdk.Uint64ToBigEndian(id)...)
+}
@@ -29,20 +29,43 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultEpochLength is set to 0, i.e. epoching is disabled
+	DefaultEpochLength uint64 = 0
 )
 
-// DefaultMinCommissionRate is set to 0%
-var DefaultMinCommissionRate = math.LegacyZeroDec()
+var (
+	// DefaultMinCommissionRate is set to 0%
+	DefaultMinCommissionRate = math.LegacyZeroDec()
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, i.e. no cap
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%, i.e. no cap
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultMaxSelfBondMultiplier is set to 0, i.e. no cap
+	DefaultMaxSelfBondMultiplier = math.LegacyZeroDec()
+
+	// DefaultMaxValidatorPowerPercent is set to 100%, i.e. no cap
+	DefaultMaxValidatorPowerPercent = math.LegacyOneDec()
+)
 
-// NewParams creates a new Params instance
+// NewParams creates a new Params instance. The liquid staking caps, the
+// validator caps and the epoch length are set to their default values.
 func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate math.LegacyDec) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
+		UnbondingTime:             unbondingTime,
+		MaxValidators:             maxValidators,
+		MaxEntries:                maxEntries,
+		HistoricalEntries:         historicalEntries,
+		BondDenom:                 bondDenom,
+		MinCommissionRate:         minCommissionRate,
+		GlobalLiquidStakingCap:    DefaultGlobalLiquidStakingCap,
+		ValidatorLiquidStakingCap: DefaultValidatorLiquidStakingCap,
+		MaxSelfBondMultiplier:     DefaultMaxSelfBondMultiplier,
+		MaxValidatorPowerPercent:  DefaultMaxValidatorPowerPercent,
+		EpochLength:               DefaultEpochLength,
 	}
 }
 
@@ -104,6 +127,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateLiquidStakingCap("global", p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap("validator", p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateMaxSelfBondMultiplier(p.MaxSelfBondMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateMaxValidatorPowerPercent(p.MaxValidatorPowerPercent); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -203,3 +242,45 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validateLiquidStakingCap(name string, v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("%s liquid staking cap cannot be nil: %s", name, v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("%s liquid staking cap cannot be negative: %s", name, v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s liquid staking cap cannot be greater than 100%%: %s", name, v)
+	}
+
+	return nil
+}
+
+func validateMaxSelfBondMultiplier(v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("max self-bond multiplier cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("max self-bond multiplier cannot be negative: %s", v)
+	}
+	if v.IsPositive() && v.LT(math.LegacyOneDec()) {
+		return fmt.Errorf("max self-bond multiplier cannot be lower than 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxValidatorPowerPercent(v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("max validator power percent cannot be nil: %s", v)
+	}
+	if !v.IsPositive() {
+		return fmt.Errorf("max validator power percent must be positive: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max validator power percent cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
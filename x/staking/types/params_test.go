@@ -35,4 +35,40 @@ func TestValidateParams(t *testing.T) {
 
 	params.MinCommissionRate = math.LegacyNewDec(2)
 	require.Error(t, params.Validate())
+
+	// validate liquid staking caps
+	params = types.DefaultParams()
+	params.GlobalLiquidStakingCap = math.LegacyNewDec(-1)
+	require.Error(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = math.LegacyNewDec(2)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ValidatorLiquidStakingCap = math.LegacyDec{}
+	require.Error(t, params.Validate())
+
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, params.Validate())
+
+	// validate validator caps
+	params = types.DefaultParams()
+	params.MaxSelfBondMultiplier = math.LegacyNewDec(-1)
+	require.Error(t, params.Validate())
+
+	params.MaxSelfBondMultiplier = math.LegacyNewDecWithPrec(5, 1)
+	require.Error(t, params.Validate())
+
+	params.MaxSelfBondMultiplier = math.LegacyNewDec(10)
+	require.NoError(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MaxValidatorPowerPercent = math.LegacyZeroDec()
+	require.Error(t, params.Validate())
+
+	params.MaxValidatorPowerPercent = math.LegacyNewDec(2)
+	require.Error(t, params.Validate())
+
+	params.MaxValidatorPowerPercent = math.LegacyNewDecWithPrec(2, 1)
+	require.NoError(t, params.Validate())
 }
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = QueuedMessage{}
+
+// NewQueuedMessage creates a new QueuedMessage instance.
+func NewQueuedMessage(id uint64, height int64, msg sdk.Msg) (QueuedMessage, error) {
+	delegator, err := QueuedMessageDelegator(msg)
+	if err != nil {
+		return QueuedMessage{}, err
+	}
+
+	anyMsg, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return QueuedMessage{}, err
+	}
+
+	return QueuedMessage{
+		Id:               id,
+		Height:           height,
+		DelegatorAddress: delegator,
+		Msg:              anyMsg,
+	}, nil
+}
+
+// QueuedMessageModuleAccountName returns the name of the module account
+// holding the delegation shares escrowed by the queued message with the given
+// id.
+func QueuedMessageModuleAccountName(id uint64) string {
+	return fmt.Sprintf("queuedmessage_%d", id)
+}
+
+// GetEscrowAddress returns the address of the module account holding the
+// delegation shares escrowed by the queued undelegation or redelegation.
+func (m QueuedMessage) GetEscrowAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(QueuedMessageModuleAccountName(m.Id))
+}
+
+// QueuedMessageDelegator returns the delegator address of a message which can
+// be queued until the end of the epoch, i.e. a MsgDelegate, MsgUndelegate or
+// MsgBeginRedelegate.
+func QueuedMessageDelegator(msg sdk.Msg) (string, error) {
+	switch msg := msg.(type) {
+	case *MsgDelegate:
+		return msg.DelegatorAddress, nil
+	case *MsgUndelegate:
+		return msg.DelegatorAddress, nil
+	case *MsgBeginRedelegate:
+		return msg.DelegatorAddress, nil
+	default:
+		return "", fmt.Errorf("message %s cannot be queued", sdk.MsgTypeURL(msg))
+	}
+}
+
+// UnpackMsg returns the cached sdk.Msg of the queued message.
+func (m QueuedMessage) UnpackMsg() (sdk.Msg, error) {
+	if m.Msg == nil {
+		return nil, fmt.Errorf("queued message %d is empty", m.Id)
+	}
+
+	msg, ok := m.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, fmt.Errorf("queued message %d: expected sdk.Msg, got %T", m.Id, m.Msg.GetCachedValue())
+	}
+
+	return msg, nil
+}
+
+// Validate performs a stateless validation of the queued message.
+func (m QueuedMessage) Validate() error {
+	if m.Id == 0 {
+		return fmt.Errorf("queued message id cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.DelegatorAddress); err != nil {
+		return fmt.Errorf("invalid queued message delegator: %w", err)
+	}
+
+	msg, err := m.UnpackMsg()
+	if err != nil {
+		return err
+	}
+
+	delegator, err := QueuedMessageDelegator(msg)
+	if err != nil {
+		return err
+	}
+
+	if delegator != m.DelegatorAddress {
+		return fmt.Errorf("queued message %d delegator mismatch: expected %s, got %s", m.Id, m.DelegatorAddress, delegator)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueuedMessage) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(m.Msg, &msg)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryQueuedMessagesResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, m := range r.Messages {
+		if err := m.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -932,6 +932,23 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// global_liquid_staking_cap is the maximum fraction of the total bonded
+	// tokens that can be liquid staked, i.e. tokenized.
+	GlobalLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of the delegator
+	// shares of a validator that can be liquid staked, i.e. tokenized.
+	ValidatorLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_liquid_staking_cap"`
+	// max_self_bond_multiplier is the maximum ratio of the tokens of a validator
+	// to the tokens of its self-delegation. Zero disables the cap.
+	MaxSelfBondMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=max_self_bond_multiplier,json=maxSelfBondMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_self_bond_multiplier"`
+	// max_validator_power_percent is the maximum fraction of the total bonded
+	// tokens that a single validator can hold. One disables the cap.
+	MaxValidatorPowerPercent github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=max_validator_power_percent,json=maxValidatorPowerPercent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_validator_power_percent"`
+	// epoch_length is the number of blocks of an epoch. When positive, the
+	// delegation, undelegation and redelegation messages are queued and applied
+	// at the end of the epoch, and the validator updates are only emitted at the
+	// end of the epoch. Zero disables epoching.
+	EpochLength uint64 `protobuf:"varint,11,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1002,6 +1019,164 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+// QueuedMessage is a delegation, undelegation or redelegation message queued
+// until the end of the current epoch.
+type QueuedMessage struct {
+	// id is the unique id of the queued message, in the order of submission.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// height is the height at which the message was queued.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// delegator_address is the address of the delegator of the message.
+	DelegatorAddress string `protobuf:"bytes,3,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// msg is the queued message.
+	Msg *types1.Any `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *QueuedMessage) Reset()         { *m = QueuedMessage{} }
+func (m *QueuedMessage) String() string { return proto.CompactTextString(m) }
+func (*QueuedMessage) ProtoMessage()    {}
+func (*QueuedMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{16}
+}
+func (m *QueuedMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedMessage.Merge(m, src)
+}
+func (m *QueuedMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedMessage proto.InternalMessageInfo
+
+func (m *QueuedMessage) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueuedMessage) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueuedMessage) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *QueuedMessage) GetMsg() *types1.Any {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+// TokenizeShareRecord represents delegation shares tokenized into the share
+// denom `{validator}/{id}`. The tokenized delegation is held by the record
+// module account, and its rewards are claimed by the record owner.
+type TokenizeShareRecord struct {
+	// id is the unique id of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address receiving the rewards of the tokenized delegation.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the tokenized
+	// delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the operator address of the validator of the tokenized
+	// delegation.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{17}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1013,7 +1188,7 @@ func (m *DelegationResponse) Reset()         { *m = DelegationResponse{} }
 func (m *DelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*DelegationResponse) ProtoMessage()    {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{16}
+	return fileDescriptor_64c30c6cf92913c9, []int{18}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1068,7 +1243,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{17}
+	return fileDescriptor_64c30c6cf92913c9, []int{19}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1116,7 +1291,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{18}
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1170,7 +1345,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{19}
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1209,7 +1384,7 @@ func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
 func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdates) ProtoMessage()    {}
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{20}
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
 }
 func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1264,6 +1439,8 @@ func init() {
 	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.v1beta1.RedelegationEntry")
 	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.v1beta1.Redelegation")
 	proto.RegisterType((*Params)(nil), "cosmos.staking.v1beta1.Params")
+	proto.RegisterType((*QueuedMessage)(nil), "cosmos.staking.v1beta1.QueuedMessage")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
 	proto.RegisterType((*DelegationResponse)(nil), "cosmos.staking.v1beta1.DelegationResponse")
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
@@ -1276,125 +1453,142 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1883 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x3d, 0x6c, 0x23, 0xc7,
-	0x15, 0xd6, 0x92, 0x32, 0x25, 0x3d, 0x4a, 0x22, 0x35, 0x27, 0xdf, 0xf1, 0x78, 0x89, 0x48, 0xd3,
-	0x17, 0x5b, 0x3e, 0xf8, 0xa8, 0x9c, 0x02, 0xa4, 0x50, 0x8c, 0x04, 0xa2, 0xa8, 0xf3, 0xd1, 0xb1,
-	0x25, 0x61, 0x29, 0x29, 0x71, 0x7e, 0xb0, 0x18, 0xee, 0x8e, 0xa8, 0x89, 0xb8, 0xb3, 0xc4, 0xce,
-	0xf0, 0x2c, 0xb6, 0x41, 0x0a, 0x43, 0x45, 0x62, 0x20, 0x4d, 0x9a, 0x03, 0x0e, 0x48, 0x11, 0xa7,
-	0x73, 0x61, 0xc4, 0x45, 0x90, 0x22, 0x9d, 0x93, 0x34, 0x07, 0x57, 0x41, 0x0a, 0x25, 0xb8, 0x2b,
-	0x6c, 0xa4, 0x0a, 0xd2, 0x25, 0x55, 0x30, 0xb3, 0xb3, 0x3f, 0x14, 0xc5, 0x93, 0x74, 0x50, 0x0c,
-	0x03, 0x6e, 0xc8, 0x9d, 0x99, 0xf7, 0xbe, 0x99, 0xf7, 0xbd, 0x9f, 0xf9, 0x81, 0x9b, 0xb6, 0xc7,
-	0x5d, 0x8f, 0x2f, 0x71, 0x81, 0x0f, 0x28, 0x6b, 0x2f, 0xdd, 0xbf, 0xd3, 0x22, 0x02, 0xdf, 0x09,
-	0xdb, 0xd5, 0xae, 0xef, 0x09, 0x0f, 0x5d, 0x0d, 0xa4, 0xaa, 0x61, 0xaf, 0x96, 0x2a, 0xce, 0xb7,
-	0xbd, 0xb6, 0xa7, 0x44, 0x96, 0xe4, 0x57, 0x20, 0x5d, 0xbc, 0xde, 0xf6, 0xbc, 0x76, 0x87, 0x2c,
-	0xa9, 0x56, 0xab, 0xb7, 0xb7, 0x84, 0x59, 0x5f, 0x0f, 0x2d, 0x9c, 0x1c, 0x72, 0x7a, 0x3e, 0x16,
-	0xd4, 0x63, 0x7a, 0xbc, 0x74, 0x72, 0x5c, 0x50, 0x97, 0x70, 0x81, 0xdd, 0x6e, 0x88, 0x1d, 0xac,
-	0xc4, 0x0a, 0x26, 0xd5, 0xcb, 0xd2, 0xd8, 0xda, 0x94, 0x16, 0xe6, 0x24, 0xb2, 0xc3, 0xf6, 0x68,
-	0x88, 0x3d, 0x87, 0x5d, 0xca, 0xbc, 0x25, 0xf5, 0xab, 0xbb, 0xbe, 0x22, 0x08, 0x73, 0x88, 0xef,
-	0x52, 0x26, 0x96, 0x44, 0xbf, 0x4b, 0x78, 0xf0, 0xab, 0x47, 0x6f, 0x24, 0x46, 0x71, 0xcb, 0xa6,
-	0xc9, 0xc1, 0xca, 0x2f, 0x0d, 0x98, 0xbd, 0x47, 0xb9, 0xf0, 0x7c, 0x6a, 0xe3, 0x4e, 0x83, 0xed,
-	0x79, 0xe8, 0x5b, 0x90, 0xd9, 0x27, 0xd8, 0x21, 0x7e, 0xc1, 0x28, 0x1b, 0x8b, 0xd9, 0xe5, 0x42,
-	0x35, 0x06, 0xa8, 0x06, 0xba, 0xf7, 0xd4, 0x78, 0x6d, 0xea, 0xe3, 0xe3, 0xd2, 0xd8, 0xfb, 0x9f,
-	0x7e, 0x70, 0xcb, 0x30, 0xb5, 0x0a, 0xaa, 0x43, 0xe6, 0x3e, 0xee, 0x70, 0x22, 0x0a, 0xa9, 0x72,
-	0x7a, 0x31, 0xbb, 0xfc, 0x42, 0xf5, 0x74, 0xce, 0xab, 0xbb, 0xb8, 0x43, 0x1d, 0x2c, 0xbc, 0x41,
-	0x94, 0x40, 0xb7, 0xf2, 0x51, 0x0a, 0x72, 0x6b, 0x9e, 0xeb, 0x52, 0xce, 0xa9, 0xc7, 0x4c, 0x2c,
-	0x08, 0x47, 0x3b, 0x30, 0xee, 0x63, 0x41, 0xd4, 0xa2, 0xa6, 0x6a, 0xab, 0x52, 0xe9, 0x6f, 0xc7,
-	0xa5, 0x97, 0xda, 0x54, 0xec, 0xf7, 0x5a, 0x55, 0xdb, 0x73, 0x35, 0x8d, 0xfa, 0xef, 0x36, 0x77,
-	0x0e, 0xb4, 0xa5, 0x75, 0x62, 0x7f, 0xf2, 0xe1, 0x6d, 0xd0, 0x0b, 0xa9, 0x13, 0x3b, 0x98, 0x4c,
-	0xc1, 0xa1, 0x1f, 0xc1, 0xa4, 0x8b, 0x0f, 0x2d, 0x05, 0x9d, 0xba, 0x2c, 0xe8, 0x09, 0x17, 0x1f,
-	0xca, 0x55, 0x23, 0x0a, 0x39, 0x89, 0x6e, 0xef, 0x63, 0xd6, 0x26, 0xc1, 0x24, 0xe9, 0xcb, 0x9a,
-	0x64, 0xc6, 0xc5, 0x87, 0x6b, 0x0a, 0x58, 0x4e, 0xb5, 0x32, 0xfe, 0xd9, 0xc3, 0x92, 0x51, 0xf9,
-	0xa3, 0x01, 0x10, 0x33, 0x87, 0x30, 0xe4, 0xed, 0xa8, 0xa5, 0xe6, 0xe7, 0xda, 0xab, 0x2f, 0x8f,
-	0x72, 0xcc, 0x09, 0xde, 0x6b, 0x33, 0x72, 0xa5, 0x8f, 0x8e, 0x4b, 0x46, 0x30, 0x6b, 0xce, 0x3e,
-	0xe1, 0x97, 0x37, 0x20, 0xdb, 0xeb, 0x3a, 0x58, 0x10, 0x4b, 0x06, 0xb9, 0xe2, 0x30, 0xbb, 0x5c,
-	0xac, 0x06, 0x19, 0x50, 0x0d, 0x33, 0xa0, 0xba, 0x1d, 0x66, 0x40, 0x00, 0xf8, 0xde, 0xdf, 0x43,
-	0x40, 0x08, 0xb4, 0xe5, 0xb8, 0xb6, 0xe1, 0x7d, 0x03, 0xb2, 0x75, 0xc2, 0x6d, 0x9f, 0x76, 0x65,
-	0x4e, 0xa1, 0x02, 0x4c, 0xb8, 0x1e, 0xa3, 0x07, 0x3a, 0x22, 0xa7, 0xcc, 0xb0, 0x89, 0x8a, 0x30,
-	0x49, 0x1d, 0xc2, 0x04, 0x15, 0xfd, 0xc0, 0x79, 0x66, 0xd4, 0x96, 0x5a, 0xef, 0x90, 0x16, 0xa7,
-	0x21, 0xe5, 0x66, 0xd8, 0x44, 0xaf, 0x40, 0x9e, 0x13, 0xbb, 0xe7, 0x53, 0xd1, 0xb7, 0x6c, 0x8f,
-	0x09, 0x6c, 0x8b, 0xc2, 0xb8, 0x12, 0xc9, 0x85, 0xfd, 0x6b, 0x41, 0xb7, 0x04, 0x71, 0x88, 0xc0,
-	0xb4, 0xc3, 0x0b, 0xcf, 0x05, 0x20, 0xba, 0xa9, 0x97, 0xfa, 0xd1, 0x04, 0x4c, 0x45, 0x91, 0x8c,
-	0xd6, 0x20, 0xef, 0x75, 0x89, 0x2f, 0xbf, 0x2d, 0xec, 0x38, 0x3e, 0xe1, 0x5c, 0x87, 0x6b, 0xe1,
-	0x93, 0x0f, 0x6f, 0xcf, 0x6b, 0xc2, 0x57, 0x83, 0x91, 0xa6, 0xf0, 0x29, 0x6b, 0x9b, 0xb9, 0x50,
-	0x43, 0x77, 0xa3, 0xb7, 0xa5, 0xcb, 0x18, 0x27, 0x8c, 0xf7, 0xb8, 0xd5, 0xed, 0xb5, 0x0e, 0x48,
-	0x5f, 0x93, 0x3a, 0x3f, 0x44, 0xea, 0x2a, 0xeb, 0xd7, 0x0a, 0x7f, 0x8e, 0xa1, 0x6d, 0xbf, 0xdf,
-	0x15, 0x5e, 0x75, 0xab, 0xd7, 0xfa, 0x2e, 0xe9, 0x4b, 0x57, 0x69, 0x9c, 0x2d, 0x05, 0x83, 0xae,
-	0x42, 0xe6, 0x27, 0x98, 0x76, 0x88, 0xa3, 0x18, 0x99, 0x34, 0x75, 0x0b, 0xad, 0x40, 0x86, 0x0b,
-	0x2c, 0x7a, 0x5c, 0xd1, 0x30, 0xbb, 0x5c, 0x19, 0x15, 0x1b, 0x35, 0x8f, 0x39, 0x4d, 0x25, 0x69,
-	0x6a, 0x0d, 0xb4, 0x0d, 0x19, 0xe1, 0x1d, 0x10, 0xa6, 0x09, 0xaa, 0xbd, 0x76, 0x81, 0xc0, 0x6e,
-	0x30, 0x91, 0x08, 0xec, 0x06, 0x13, 0xa6, 0xc6, 0x42, 0x6d, 0xc8, 0x3b, 0xa4, 0x43, 0xda, 0x8a,
-	0x4a, 0xbe, 0x8f, 0x7d, 0xc2, 0x0b, 0x99, 0x0b, 0xe3, 0x0f, 0x25, 0x8e, 0x99, 0x8b, 0x50, 0x9b,
-	0x0a, 0x14, 0x6d, 0x41, 0xd6, 0x89, 0x43, 0xad, 0x30, 0xa1, 0x88, 0x7e, 0x71, 0x94, 0xfd, 0x89,
-	0xa8, 0x4c, 0x96, 0xad, 0x24, 0x84, 0x8c, 0xae, 0x1e, 0x6b, 0x79, 0xcc, 0xa1, 0xac, 0x6d, 0xed,
-	0x13, 0xda, 0xde, 0x17, 0x85, 0xc9, 0xb2, 0xb1, 0x98, 0x36, 0x73, 0x51, 0xff, 0x3d, 0xd5, 0x8d,
-	0xb6, 0x60, 0x36, 0x16, 0x55, 0xd9, 0x33, 0x75, 0xd1, 0xec, 0x99, 0x89, 0x00, 0xa4, 0x08, 0x7a,
-	0x0b, 0x20, 0xce, 0xcf, 0x02, 0x28, 0xb4, 0xca, 0xd9, 0x99, 0x9e, 0x34, 0x26, 0x01, 0x80, 0x3a,
-	0x70, 0xc5, 0xa5, 0xcc, 0xe2, 0xa4, 0xb3, 0x67, 0x69, 0xe6, 0x24, 0x6e, 0xf6, 0x12, 0x3c, 0x3d,
-	0xe7, 0x52, 0xd6, 0x24, 0x9d, 0xbd, 0x7a, 0x04, 0x8b, 0x5e, 0x83, 0x1b, 0x31, 0x1d, 0x1e, 0xb3,
-	0xf6, 0xbd, 0x8e, 0x63, 0xf9, 0x64, 0xcf, 0xb2, 0xbd, 0x1e, 0x13, 0x85, 0x69, 0x45, 0xe2, 0xb5,
-	0x48, 0x64, 0x93, 0xdd, 0xf3, 0x3a, 0x8e, 0x49, 0xf6, 0xd6, 0xe4, 0x30, 0x7a, 0x11, 0x62, 0x2e,
-	0x2c, 0xea, 0xf0, 0xc2, 0x4c, 0x39, 0xbd, 0x38, 0x6e, 0x4e, 0x47, 0x9d, 0x0d, 0x87, 0xaf, 0x4c,
-	0xbe, 0xfb, 0xb0, 0x34, 0xf6, 0xd9, 0xc3, 0xd2, 0x58, 0xe5, 0x2e, 0x4c, 0xef, 0xe2, 0x8e, 0x4e,
-	0x3a, 0xc2, 0xd1, 0x37, 0x61, 0x0a, 0x87, 0x8d, 0x82, 0x51, 0x4e, 0x3f, 0x35, 0x69, 0x63, 0xd1,
-	0xca, 0x43, 0x03, 0x32, 0xf5, 0xdd, 0x2d, 0x4c, 0x7d, 0xb4, 0x0e, 0x73, 0x71, 0xd0, 0x9e, 0x37,
-	0xff, 0xe3, 0x38, 0x0f, 0x0b, 0xc0, 0x3a, 0xcc, 0xdd, 0x0f, 0x4b, 0x4a, 0x04, 0x93, 0x3a, 0x0b,
-	0x26, 0x52, 0xd1, 0xfd, 0x09, 0x53, 0xdf, 0x80, 0x89, 0x60, 0x85, 0x1c, 0x7d, 0x07, 0x9e, 0xeb,
-	0xca, 0x0f, 0x65, 0x61, 0x76, 0x79, 0x61, 0x64, 0xa0, 0x2b, 0xf9, 0x64, 0x58, 0x04, 0x7a, 0x95,
-	0xff, 0x18, 0x00, 0xf5, 0xdd, 0xdd, 0x6d, 0x9f, 0x76, 0x3b, 0x44, 0x5c, 0x96, 0xc9, 0x6f, 0xc2,
-	0xf3, 0xb1, 0xc9, 0xdc, 0xb7, 0xcf, 0x6d, 0xf6, 0x95, 0x48, 0xad, 0xe9, 0xdb, 0xa7, 0xa2, 0x39,
-	0x5c, 0x44, 0x68, 0xe9, 0x73, 0xa3, 0xd5, 0xb9, 0x18, 0xe6, 0xf1, 0xfb, 0x90, 0x8d, 0x4d, 0xe7,
-	0xa8, 0x01, 0x93, 0x42, 0x7f, 0x6b, 0x3a, 0x2b, 0xa3, 0xe9, 0x0c, 0xd5, 0x92, 0x94, 0x46, 0xea,
-	0x95, 0xff, 0x4a, 0x56, 0xe3, 0x44, 0xf8, 0x42, 0x05, 0x92, 0xac, 0xf0, 0xba, 0x02, 0xa7, 0x2f,
-	0xa1, 0x02, 0x6b, 0xac, 0x04, 0xad, 0x3f, 0x4b, 0xc1, 0x95, 0x9d, 0x30, 0x49, 0xbf, 0xb0, 0x2c,
-	0xec, 0xc0, 0x04, 0x61, 0xc2, 0xa7, 0x8a, 0x06, 0xe9, 0xec, 0xaf, 0x8f, 0x72, 0xf6, 0x29, 0xb6,
-	0xac, 0x33, 0xe1, 0xf7, 0x93, 0xae, 0x0f, 0xb1, 0x12, 0x34, 0xfc, 0x21, 0x0d, 0x85, 0x51, 0xaa,
-	0xe8, 0x65, 0xc8, 0xd9, 0x3e, 0x51, 0x1d, 0xe1, 0x9e, 0x62, 0xa8, 0x72, 0x38, 0x1b, 0x76, 0xeb,
-	0x2d, 0xc5, 0x04, 0x79, 0x40, 0x93, 0x51, 0x25, 0x45, 0x9f, 0xed, 0x44, 0x36, 0x1b, 0x23, 0xa8,
-	0x4d, 0x85, 0x40, 0x8e, 0x32, 0x2a, 0x28, 0xee, 0x58, 0x2d, 0xdc, 0xc1, 0xcc, 0x26, 0xcf, 0x10,
-	0x09, 0xc3, 0x3b, 0xc0, 0xac, 0x06, 0xad, 0x05, 0x98, 0x68, 0x17, 0x26, 0x42, 0xf8, 0xf1, 0x4b,
-	0x80, 0x0f, 0xc1, 0xd0, 0x0b, 0x30, 0x9d, 0xdc, 0x18, 0xd4, 0x39, 0x65, 0xdc, 0xcc, 0x26, 0xf6,
-	0x85, 0xb3, 0x76, 0x9e, 0xcc, 0x53, 0x77, 0x1e, 0x7d, 0x14, 0xfc, 0x7d, 0x1a, 0xe6, 0x4c, 0xe2,
-	0x7c, 0x09, 0x1d, 0xf7, 0x43, 0x80, 0x20, 0xa9, 0x65, 0xb1, 0x7d, 0x06, 0xdf, 0x0d, 0x17, 0x89,
-	0xa9, 0x00, 0xaf, 0xce, 0xc5, 0xe7, 0xe5, 0xbd, 0xbf, 0xa4, 0x60, 0x3a, 0xe9, 0xbd, 0x2f, 0xc1,
-	0xce, 0x86, 0x36, 0xe2, 0x92, 0x36, 0xae, 0x4a, 0xda, 0x2b, 0xa3, 0x4a, 0xda, 0x50, 0x5c, 0x9f,
-	0x51, 0xcb, 0x7e, 0x93, 0x86, 0xcc, 0x16, 0xf6, 0xb1, 0xcb, 0xd1, 0xe6, 0xd0, 0x19, 0x37, 0xb8,
-	0x7f, 0x5e, 0x1f, 0x0a, 0xeb, 0xba, 0x7e, 0x43, 0x09, 0xa2, 0xfa, 0x57, 0xa3, 0x8e, 0xb8, 0x5f,
-	0x83, 0x59, 0x79, 0xa5, 0x8e, 0x0c, 0x0a, 0xa8, 0x9c, 0x51, 0xd7, 0xe1, 0xe8, 0x2a, 0xc6, 0x51,
-	0x09, 0xb2, 0x52, 0x2c, 0xae, 0xd9, 0x52, 0x06, 0x5c, 0x7c, 0xb8, 0x1e, 0xf4, 0xa0, 0xdb, 0x80,
-	0xf6, 0xa3, 0x87, 0x0f, 0x2b, 0x26, 0x42, 0xca, 0xcd, 0xc5, 0x23, 0xa1, 0xf8, 0x57, 0x01, 0xe4,
-	0x2a, 0x2c, 0x87, 0x30, 0xcf, 0xd5, 0x97, 0xc1, 0x29, 0xd9, 0x53, 0x97, 0x1d, 0xe8, 0x17, 0x46,
-	0x70, 0x54, 0x3e, 0x71, 0xdb, 0xd6, 0x97, 0x16, 0xeb, 0x62, 0xd9, 0xf0, 0xef, 0xe3, 0x52, 0xb1,
-	0x8f, 0xdd, 0xce, 0x4a, 0xe5, 0x14, 0xc8, 0xca, 0x69, 0x6f, 0x01, 0xf2, 0x34, 0x3d, 0x78, 0x71,
-	0x5f, 0xb9, 0x29, 0xe3, 0xfa, 0xe8, 0xd3, 0x0f, 0x6e, 0xdd, 0x48, 0x4c, 0x71, 0x18, 0xbd, 0x90,
-	0x05, 0xee, 0xa9, 0xfc, 0xd6, 0x00, 0x14, 0x6f, 0x36, 0x26, 0xe1, 0x5d, 0x79, 0x67, 0x94, 0xf7,
-	0x88, 0xc4, 0x79, 0xdf, 0x78, 0xfa, 0x3d, 0x22, 0xd6, 0x1f, 0xb8, 0x47, 0x24, 0x92, 0xe9, 0xdb,
-	0x71, 0x69, 0x4f, 0x69, 0xef, 0x6b, 0xac, 0x16, 0xe6, 0x24, 0x71, 0x21, 0xa1, 0x03, 0x10, 0xa1,
-	0x92, 0xca, 0xd1, 0xb1, 0xca, 0xb1, 0x01, 0xd7, 0x87, 0x22, 0x31, 0x5a, 0xb2, 0x0d, 0xc8, 0x4f,
-	0x0c, 0x2a, 0x8f, 0xf6, 0xf5, 0xd2, 0x9f, 0x2d, 0xb0, 0xe7, 0xfc, 0xa1, 0x72, 0xfe, 0x7f, 0xda,
-	0xa3, 0x74, 0x11, 0xfa, 0x93, 0x01, 0xf3, 0xc9, 0x15, 0x45, 0xb6, 0x35, 0x61, 0x3a, 0xb9, 0x16,
-	0x6d, 0xd5, 0xcd, 0xf3, 0x58, 0x95, 0x34, 0x68, 0x00, 0x44, 0xda, 0x12, 0x46, 0x7d, 0xf0, 0x56,
-	0x77, 0xe7, 0xdc, 0x2c, 0x85, 0x0b, 0x3b, 0xb5, 0x0c, 0x04, 0xce, 0xfa, 0x79, 0x0a, 0xc6, 0xb7,
-	0x3c, 0xaf, 0x83, 0x7e, 0x6a, 0xc0, 0x1c, 0xf3, 0x84, 0x25, 0x73, 0x85, 0x38, 0x96, 0x7e, 0x2c,
-	0x08, 0x2a, 0xe9, 0xee, 0xc5, 0xd8, 0xfb, 0xe7, 0x71, 0x69, 0x18, 0x6a, 0x90, 0x52, 0xfd, 0x48,
-	0xc5, 0x3c, 0x51, 0x53, 0x42, 0xdb, 0xc1, 0x7b, 0xc2, 0x3b, 0x30, 0x33, 0x38, 0x7f, 0x50, 0x7e,
-	0xcd, 0x0b, 0xcf, 0x3f, 0x73, 0xe6, 0xdc, 0xd3, 0xad, 0xc4, 0xc4, 0x2b, 0x93, 0xd2, 0xb1, 0xff,
-	0x92, 0xce, 0x7d, 0x1b, 0xf2, 0x51, 0x79, 0xda, 0x51, 0x4f, 0x5e, 0xf2, 0x6c, 0x3a, 0x11, 0xbc,
-	0x7e, 0x85, 0x37, 0x88, 0x72, 0xf2, 0xad, 0x15, 0xb7, 0x6c, 0x5a, 0x3d, 0xa1, 0x33, 0xc0, 0xb8,
-	0xd6, 0xbd, 0xf5, 0x3b, 0x03, 0x20, 0x7e, 0x9a, 0x41, 0xaf, 0xc2, 0xb5, 0xda, 0xe6, 0x46, 0xdd,
-	0x6a, 0x6e, 0xaf, 0x6e, 0xef, 0x34, 0xad, 0x9d, 0x8d, 0xe6, 0xd6, 0xfa, 0x5a, 0xe3, 0x6e, 0x63,
-	0xbd, 0x9e, 0x1f, 0x2b, 0xe6, 0x8e, 0x1e, 0x94, 0xb3, 0x3b, 0x8c, 0x77, 0x89, 0x4d, 0xf7, 0x28,
-	0x71, 0xd0, 0x4b, 0x30, 0x3f, 0x28, 0x2d, 0x5b, 0xeb, 0xf5, 0xbc, 0x51, 0x9c, 0x3e, 0x7a, 0x50,
-	0x9e, 0x0c, 0x8e, 0xa4, 0xc4, 0x41, 0x8b, 0xf0, 0xfc, 0xb0, 0x5c, 0x63, 0xe3, 0xf5, 0x7c, 0xaa,
-	0x38, 0x73, 0xf4, 0xa0, 0x3c, 0x15, 0x9d, 0x5d, 0x51, 0x05, 0x50, 0x52, 0x52, 0xe3, 0xa5, 0x8b,
-	0x70, 0xf4, 0xa0, 0x9c, 0x09, 0xdc, 0x52, 0x1c, 0x7f, 0xf7, 0xd7, 0x0b, 0x63, 0xb7, 0x7e, 0x0c,
-	0xd0, 0x60, 0x7b, 0x3e, 0xb6, 0x55, 0x40, 0x16, 0xe1, 0x6a, 0x63, 0xe3, 0xae, 0xb9, 0xba, 0xb6,
-	0xdd, 0xd8, 0xdc, 0x18, 0x5c, 0xf6, 0x89, 0xb1, 0xfa, 0xe6, 0x4e, 0xed, 0xcd, 0x75, 0xab, 0xd9,
-	0x78, 0x7d, 0x23, 0x6f, 0xa0, 0x6b, 0x70, 0x65, 0x60, 0xec, 0x7b, 0x1b, 0xdb, 0x8d, 0xb7, 0xd6,
-	0xf3, 0xa9, 0xda, 0xdd, 0x8f, 0x1f, 0x2f, 0x18, 0x8f, 0x1e, 0x2f, 0x18, 0xff, 0x78, 0xbc, 0x60,
-	0xbc, 0xf7, 0x64, 0x61, 0xec, 0xd1, 0x93, 0x85, 0xb1, 0xbf, 0x3e, 0x59, 0x18, 0xfb, 0xc1, 0xab,
-	0x4f, 0x75, 0x78, 0x5c, 0x25, 0x95, 0xeb, 0x5b, 0x19, 0xb5, 0x47, 0x7d, 0xe3, 0x7f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x93, 0xd7, 0xc2, 0x0d, 0x66, 0x18, 0x00, 0x00,
+	// 2156 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xd6, 0x92, 0xb4, 0x24, 0x3e, 0x4a, 0xa2, 0x34, 0xfe, 0x5b, 0xd3, 0x89, 0x24, 0x33, 0x6e,
+	0xe2, 0x18, 0x11, 0x55, 0xbb, 0x40, 0x0f, 0x6a, 0xd0, 0x40, 0x14, 0xe5, 0x98, 0xa9, 0x2d, 0xab,
+	0x4b, 0x49, 0x6d, 0xfa, 0x83, 0xc5, 0x70, 0x77, 0x44, 0x4d, 0xbd, 0xdc, 0x65, 0x77, 0x86, 0xb6,
+	0x18, 0xf4, 0x50, 0x14, 0x3d, 0x04, 0x3e, 0xb4, 0x01, 0x7a, 0xe9, 0xc5, 0x80, 0x81, 0x5e, 0xd2,
+	0x5b, 0x0e, 0x46, 0x73, 0x28, 0x7a, 0x28, 0x7a, 0x49, 0xd3, 0x1e, 0x0c, 0x9f, 0x8a, 0x1e, 0xd4,
+	0xc2, 0x3e, 0x24, 0xe8, 0xa9, 0xe8, 0xad, 0x3d, 0x15, 0xf3, 0xb3, 0x3f, 0x14, 0x25, 0xcb, 0x0a,
+	0xd8, 0x22, 0x40, 0x2e, 0x36, 0x77, 0xe6, 0xbd, 0xef, 0xcd, 0xfb, 0x9f, 0x37, 0x82, 0x8b, 0x4e,
+	0xc0, 0xda, 0x01, 0x5b, 0x64, 0x1c, 0xdf, 0xa6, 0x7e, 0x6b, 0xf1, 0xce, 0x95, 0x26, 0xe1, 0xf8,
+	0x4a, 0xf4, 0x5d, 0xe9, 0x84, 0x01, 0x0f, 0xd0, 0x19, 0x45, 0x55, 0x89, 0x56, 0x35, 0x55, 0xe9,
+	0x54, 0x2b, 0x68, 0x05, 0x92, 0x64, 0x51, 0xfc, 0x52, 0xd4, 0xa5, 0x73, 0xad, 0x20, 0x68, 0x79,
+	0x64, 0x51, 0x7e, 0x35, 0xbb, 0xdb, 0x8b, 0xd8, 0xef, 0xe9, 0xad, 0xd9, 0xfd, 0x5b, 0x6e, 0x37,
+	0xc4, 0x9c, 0x06, 0xbe, 0xde, 0x9f, 0xdb, 0xbf, 0xcf, 0x69, 0x9b, 0x30, 0x8e, 0xdb, 0x9d, 0x08,
+	0x5b, 0x9d, 0xc4, 0x56, 0x42, 0xf5, 0xb1, 0x34, 0xb6, 0x56, 0xa5, 0x89, 0x19, 0x89, 0xf5, 0x70,
+	0x02, 0x1a, 0x61, 0xcf, 0xe0, 0x36, 0xf5, 0x83, 0x45, 0xf9, 0xaf, 0x5e, 0x7a, 0x81, 0x13, 0xdf,
+	0x25, 0x61, 0x9b, 0xfa, 0x7c, 0x91, 0xf7, 0x3a, 0x84, 0xa9, 0x7f, 0xf5, 0xee, 0xf9, 0xd4, 0x2e,
+	0x6e, 0x3a, 0x34, 0xbd, 0x59, 0xfe, 0x85, 0x01, 0x53, 0xd7, 0x29, 0xe3, 0x41, 0x48, 0x1d, 0xec,
+	0xd5, 0xfd, 0xed, 0x00, 0x7d, 0x0d, 0x46, 0x77, 0x08, 0x76, 0x49, 0x68, 0x1a, 0xf3, 0xc6, 0xa5,
+	0xc2, 0x55, 0xb3, 0x92, 0x00, 0x54, 0x14, 0xef, 0x75, 0xb9, 0x5f, 0xcd, 0x7f, 0xb4, 0x37, 0x37,
+	0xf2, 0xfe, 0x27, 0x1f, 0x5c, 0x36, 0x2c, 0xcd, 0x82, 0x6a, 0x30, 0x7a, 0x07, 0x7b, 0x8c, 0x70,
+	0x33, 0x33, 0x9f, 0xbd, 0x54, 0xb8, 0x7a, 0xa1, 0x72, 0xb0, 0xcd, 0x2b, 0x5b, 0xd8, 0xa3, 0x2e,
+	0xe6, 0x41, 0x3f, 0x8a, 0xe2, 0x2d, 0x7f, 0x98, 0x81, 0xe2, 0x4a, 0xd0, 0x6e, 0x53, 0xc6, 0x68,
+	0xe0, 0x5b, 0x98, 0x13, 0x86, 0x36, 0x21, 0x17, 0x62, 0x4e, 0xe4, 0xa1, 0xf2, 0xd5, 0x65, 0xc1,
+	0xf4, 0xd7, 0xbd, 0xb9, 0x97, 0x5b, 0x94, 0xef, 0x74, 0x9b, 0x15, 0x27, 0x68, 0x6b, 0x33, 0xea,
+	0xff, 0x16, 0x98, 0x7b, 0x5b, 0x6b, 0x5a, 0x23, 0xce, 0xe3, 0x87, 0x0b, 0xa0, 0x0f, 0x52, 0x23,
+	0x8e, 0x12, 0x26, 0xe1, 0xd0, 0xf7, 0x60, 0xbc, 0x8d, 0x77, 0x6d, 0x09, 0x9d, 0x19, 0x16, 0xf4,
+	0x58, 0x1b, 0xef, 0x8a, 0x53, 0x23, 0x0a, 0x45, 0x81, 0xee, 0xec, 0x60, 0xbf, 0x45, 0x94, 0x90,
+	0xec, 0xb0, 0x84, 0x4c, 0xb6, 0xf1, 0xee, 0x8a, 0x04, 0x16, 0xa2, 0x96, 0x72, 0x9f, 0x3e, 0x98,
+	0x33, 0xca, 0xbf, 0x37, 0x00, 0x12, 0xcb, 0x21, 0x0c, 0xd3, 0x4e, 0xfc, 0x25, 0xe5, 0x33, 0xed,
+	0xd5, 0x57, 0x0e, 0x73, 0xcc, 0x3e, 0xbb, 0x57, 0x27, 0xc5, 0x49, 0x1f, 0xed, 0xcd, 0x19, 0x4a,
+	0x6a, 0xd1, 0xd9, 0xe7, 0x97, 0xb7, 0xa0, 0xd0, 0xed, 0xb8, 0x98, 0x13, 0x5b, 0x04, 0xb9, 0xb4,
+	0x61, 0xe1, 0x6a, 0xa9, 0xa2, 0x32, 0xa0, 0x12, 0x65, 0x40, 0x65, 0x23, 0xca, 0x00, 0x05, 0xf8,
+	0xde, 0xdf, 0x22, 0x40, 0x50, 0xdc, 0x62, 0x5f, 0xeb, 0xf0, 0xbe, 0x01, 0x85, 0x1a, 0x61, 0x4e,
+	0x48, 0x3b, 0x22, 0xa7, 0x90, 0x09, 0x63, 0xed, 0xc0, 0xa7, 0xb7, 0x75, 0x44, 0xe6, 0xad, 0xe8,
+	0x13, 0x95, 0x60, 0x9c, 0xba, 0xc4, 0xe7, 0x94, 0xf7, 0x94, 0xf3, 0xac, 0xf8, 0x5b, 0x70, 0xdd,
+	0x25, 0x4d, 0x46, 0x23, 0x93, 0x5b, 0xd1, 0x27, 0x7a, 0x15, 0xa6, 0x19, 0x71, 0xba, 0x21, 0xe5,
+	0x3d, 0xdb, 0x09, 0x7c, 0x8e, 0x1d, 0x6e, 0xe6, 0x24, 0x49, 0x31, 0x5a, 0x5f, 0x51, 0xcb, 0x02,
+	0xc4, 0x25, 0x1c, 0x53, 0x8f, 0x99, 0x27, 0x14, 0x88, 0xfe, 0xd4, 0x47, 0xfd, 0x70, 0x0c, 0xf2,
+	0x71, 0x24, 0xa3, 0x15, 0x98, 0x0e, 0x3a, 0x24, 0x14, 0xbf, 0x6d, 0xec, 0xba, 0x21, 0x61, 0x4c,
+	0x87, 0xab, 0xf9, 0xf8, 0xe1, 0xc2, 0x29, 0x6d, 0xf0, 0x65, 0xb5, 0xd3, 0xe0, 0x21, 0xf5, 0x5b,
+	0x56, 0x31, 0xe2, 0xd0, 0xcb, 0xe8, 0x6d, 0xe1, 0x32, 0x9f, 0x11, 0x9f, 0x75, 0x99, 0xdd, 0xe9,
+	0x36, 0x6f, 0x93, 0x9e, 0x36, 0xea, 0xa9, 0x01, 0xa3, 0x2e, 0xfb, 0xbd, 0xaa, 0xf9, 0x71, 0x02,
+	0xed, 0x84, 0xbd, 0x0e, 0x0f, 0x2a, 0xeb, 0xdd, 0xe6, 0x37, 0x48, 0xcf, 0x2a, 0xc6, 0x38, 0xeb,
+	0x12, 0x06, 0x9d, 0x81, 0xd1, 0x1f, 0x60, 0xea, 0x11, 0x57, 0x5a, 0x64, 0xdc, 0xd2, 0x5f, 0x68,
+	0x09, 0x46, 0x19, 0xc7, 0xbc, 0xcb, 0xa4, 0x19, 0xa6, 0xae, 0x96, 0x0f, 0x8b, 0x8d, 0x6a, 0xe0,
+	0xbb, 0x0d, 0x49, 0x69, 0x69, 0x0e, 0xb4, 0x01, 0xa3, 0x3c, 0xb8, 0x4d, 0x7c, 0x6d, 0xa0, 0xea,
+	0xeb, 0xc7, 0x08, 0xec, 0xba, 0xcf, 0x53, 0x81, 0x5d, 0xf7, 0xb9, 0xa5, 0xb1, 0x50, 0x0b, 0xa6,
+	0x5d, 0xe2, 0x91, 0x96, 0x34, 0x25, 0xdb, 0xc1, 0x21, 0x61, 0xe6, 0xe8, 0xb1, 0xf1, 0x07, 0x12,
+	0xc7, 0x2a, 0xc6, 0xa8, 0x0d, 0x09, 0x8a, 0xd6, 0xa1, 0xe0, 0x26, 0xa1, 0x66, 0x8e, 0x49, 0x43,
+	0xbf, 0x74, 0x98, 0xfe, 0xa9, 0xa8, 0x4c, 0x97, 0xad, 0x34, 0x84, 0x88, 0xae, 0xae, 0xdf, 0x0c,
+	0x7c, 0x97, 0xfa, 0x2d, 0x7b, 0x87, 0xd0, 0xd6, 0x0e, 0x37, 0xc7, 0xe7, 0x8d, 0x4b, 0x59, 0xab,
+	0x18, 0xaf, 0x5f, 0x97, 0xcb, 0x68, 0x1d, 0xa6, 0x12, 0x52, 0x99, 0x3d, 0xf9, 0xe3, 0x66, 0xcf,
+	0x64, 0x0c, 0x20, 0x48, 0xd0, 0x4d, 0x80, 0x24, 0x3f, 0x4d, 0x90, 0x68, 0xe5, 0xa3, 0x33, 0x3d,
+	0xad, 0x4c, 0x0a, 0x00, 0x79, 0x70, 0xb2, 0x4d, 0x7d, 0x9b, 0x11, 0x6f, 0xdb, 0xd6, 0x96, 0x13,
+	0xb8, 0x85, 0x21, 0x78, 0x7a, 0xa6, 0x4d, 0xfd, 0x06, 0xf1, 0xb6, 0x6b, 0x31, 0x2c, 0x7a, 0x1d,
+	0xce, 0x27, 0xe6, 0x08, 0x7c, 0x7b, 0x27, 0xf0, 0x5c, 0x3b, 0x24, 0xdb, 0xb6, 0x13, 0x74, 0x7d,
+	0x6e, 0x4e, 0x48, 0x23, 0x9e, 0x8d, 0x49, 0x6e, 0xf9, 0xd7, 0x03, 0xcf, 0xb5, 0xc8, 0xf6, 0x8a,
+	0xd8, 0x46, 0x2f, 0x41, 0x62, 0x0b, 0x9b, 0xba, 0xcc, 0x9c, 0x9c, 0xcf, 0x5e, 0xca, 0x59, 0x13,
+	0xf1, 0x62, 0xdd, 0x65, 0x4b, 0xe3, 0xef, 0x3e, 0x98, 0x1b, 0xf9, 0xf4, 0xc1, 0xdc, 0x48, 0xf9,
+	0x1a, 0x4c, 0x6c, 0x61, 0x4f, 0x27, 0x1d, 0x61, 0xe8, 0xab, 0x90, 0xc7, 0xd1, 0x87, 0x69, 0xcc,
+	0x67, 0x9f, 0x99, 0xb4, 0x09, 0x69, 0xf9, 0x81, 0x01, 0xa3, 0xb5, 0xad, 0x75, 0x4c, 0x43, 0xb4,
+	0x0a, 0x33, 0x49, 0xd0, 0x3e, 0x6f, 0xfe, 0x27, 0x71, 0xae, 0xd7, 0x05, 0xcc, 0x9d, 0xa8, 0xa4,
+	0xc4, 0x30, 0x99, 0xa3, 0x60, 0x62, 0x16, 0xbd, 0x9e, 0x52, 0xf5, 0x2d, 0x18, 0x53, 0x27, 0x64,
+	0xe8, 0x0d, 0x38, 0xd1, 0x11, 0x3f, 0xa4, 0x86, 0x85, 0xab, 0xb3, 0x87, 0x06, 0xba, 0xa4, 0x4f,
+	0x87, 0x85, 0xe2, 0x2b, 0xff, 0xdb, 0x00, 0xa8, 0x6d, 0x6d, 0x6d, 0x84, 0xb4, 0xe3, 0x11, 0x3e,
+	0x2c, 0x95, 0x6f, 0xc0, 0xe9, 0x44, 0x65, 0x16, 0x3a, 0xcf, 0xad, 0xf6, 0xc9, 0x98, 0xad, 0x11,
+	0x3a, 0x07, 0xa2, 0xb9, 0x8c, 0xc7, 0x68, 0xd9, 0xe7, 0x46, 0xab, 0x31, 0x3e, 0x68, 0xc7, 0x6f,
+	0x43, 0x21, 0x51, 0x9d, 0xa1, 0x3a, 0x8c, 0x73, 0xfd, 0x5b, 0x9b, 0xb3, 0x7c, 0xb8, 0x39, 0x23,
+	0xb6, 0xb4, 0x49, 0x63, 0xf6, 0xf2, 0x7f, 0x84, 0x55, 0x93, 0x44, 0xf8, 0x5c, 0x05, 0x92, 0xa8,
+	0xf0, 0xba, 0x02, 0x67, 0x87, 0x50, 0x81, 0x35, 0x56, 0xca, 0xac, 0x3f, 0xcd, 0xc0, 0xc9, 0xcd,
+	0x28, 0x49, 0x3f, 0xb7, 0x56, 0xd8, 0x84, 0x31, 0xe2, 0xf3, 0x90, 0x4a, 0x33, 0x08, 0x67, 0x7f,
+	0xf9, 0x30, 0x67, 0x1f, 0xa0, 0xcb, 0xaa, 0xcf, 0xc3, 0x5e, 0xda, 0xf5, 0x11, 0x56, 0xca, 0x0c,
+	0xbf, 0xcb, 0x82, 0x79, 0x18, 0x2b, 0x7a, 0x05, 0x8a, 0x4e, 0x48, 0xe4, 0x42, 0xd4, 0x53, 0x0c,
+	0x59, 0x0e, 0xa7, 0xa2, 0x65, 0xdd, 0x52, 0x2c, 0x10, 0x17, 0x34, 0x11, 0x55, 0x82, 0xf4, 0xb3,
+	0xdd, 0xc8, 0xa6, 0x12, 0x04, 0xd9, 0x54, 0x08, 0x14, 0xa9, 0x4f, 0x39, 0xc5, 0x9e, 0xdd, 0xc4,
+	0x1e, 0xf6, 0x1d, 0x62, 0x66, 0x87, 0xd0, 0x01, 0xa6, 0x34, 0x68, 0x55, 0x61, 0xa2, 0x2d, 0x18,
+	0x8b, 0xe0, 0x73, 0x43, 0x80, 0x8f, 0xc0, 0xd0, 0x05, 0x98, 0x48, 0x37, 0x06, 0x79, 0x4f, 0xc9,
+	0x59, 0x85, 0x54, 0x5f, 0x38, 0xaa, 0xf3, 0x8c, 0x3e, 0xb3, 0xf3, 0xe8, 0xab, 0xe0, 0x6f, 0xb3,
+	0x30, 0x63, 0x11, 0xf7, 0x0b, 0xe8, 0xb8, 0xef, 0x02, 0xa8, 0xa4, 0x16, 0xc5, 0xd6, 0xcc, 0x0d,
+	0xa1, 0x48, 0xe4, 0x15, 0x5e, 0x8d, 0xf1, 0xff, 0x97, 0xf7, 0xfe, 0x94, 0x81, 0x89, 0xb4, 0xf7,
+	0xbe, 0x00, 0x9d, 0x0d, 0xad, 0x25, 0x25, 0x2d, 0x27, 0x4b, 0xda, 0xab, 0x87, 0x95, 0xb4, 0x81,
+	0xb8, 0x3e, 0xa2, 0x96, 0xfd, 0x79, 0x0c, 0x46, 0xd7, 0x71, 0x88, 0xdb, 0x0c, 0xdd, 0x1a, 0xb8,
+	0xe3, 0xaa, 0xf9, 0xf3, 0xdc, 0x40, 0x58, 0xd7, 0xf4, 0x1b, 0x8a, 0x8a, 0xea, 0x5f, 0x1e, 0x76,
+	0xc5, 0xfd, 0x12, 0x4c, 0x89, 0x91, 0x3a, 0x56, 0x48, 0x99, 0x72, 0x52, 0x8e, 0xc3, 0xf1, 0x28,
+	0xc6, 0xd0, 0x1c, 0x14, 0x04, 0x59, 0x52, 0xb3, 0x05, 0x0d, 0xb4, 0xf1, 0xee, 0xaa, 0x5a, 0x41,
+	0x0b, 0x80, 0x76, 0xe2, 0x87, 0x0f, 0x3b, 0x31, 0x84, 0xa0, 0x9b, 0x49, 0x76, 0x22, 0xf2, 0x17,
+	0x01, 0xc4, 0x29, 0x6c, 0x97, 0xf8, 0x41, 0x5b, 0x0f, 0x83, 0x79, 0xb1, 0x52, 0x13, 0x0b, 0xe8,
+	0xe7, 0x86, 0xba, 0x2a, 0xef, 0x9b, 0xb6, 0xf5, 0xd0, 0x62, 0x1f, 0x2f, 0x1b, 0xfe, 0xb5, 0x37,
+	0x57, 0xea, 0xe1, 0xb6, 0xb7, 0x54, 0x3e, 0x00, 0xb2, 0x7c, 0xd0, 0x5b, 0x80, 0xb8, 0x4d, 0xf7,
+	0x0f, 0xee, 0xe8, 0x47, 0x70, 0xae, 0xe5, 0x05, 0x4d, 0xec, 0xd9, 0x1e, 0xfd, 0x61, 0x97, 0xba,
+	0xb6, 0x76, 0xaa, 0xed, 0xe0, 0x8e, 0x39, 0x36, 0xac, 0x47, 0x88, 0x33, 0x4a, 0xc6, 0x0d, 0x29,
+	0xa2, 0xa1, 0x24, 0xac, 0xe0, 0x0e, 0xfa, 0x89, 0x01, 0x2f, 0x24, 0xa1, 0x7a, 0xc0, 0x09, 0xc6,
+	0x87, 0x75, 0x82, 0x73, 0xb1, 0x98, 0x81, 0x43, 0xbc, 0x03, 0xa6, 0x88, 0x01, 0x39, 0xbe, 0x48,
+	0xe7, 0xb5, 0xbb, 0x1e, 0xa7, 0x1d, 0x8f, 0x92, 0xd0, 0xcc, 0x0f, 0x4b, 0xfe, 0xe9, 0x36, 0xde,
+	0x15, 0x83, 0x8c, 0x98, 0x8d, 0x6f, 0xc6, 0xf8, 0xe8, 0xc7, 0x06, 0x9c, 0xef, 0x8b, 0x53, 0xbb,
+	0x13, 0xdc, 0x25, 0xa1, 0xdd, 0x21, 0xa1, 0x43, 0x7c, 0x6e, 0xc2, 0xb0, 0xe4, 0x9b, 0xe9, 0xb8,
+	0x5f, 0x17, 0x32, 0xd6, 0x95, 0x08, 0x51, 0x3a, 0x49, 0x27, 0x70, 0x76, 0x6c, 0x8f, 0xf8, 0x2d,
+	0xbe, 0x23, 0xc7, 0xb6, 0x9c, 0x55, 0x90, 0x6b, 0x37, 0xe4, 0xd2, 0xd2, 0x45, 0x51, 0xfc, 0xee,
+	0x7d, 0xf2, 0xc1, 0xe5, 0xf3, 0x29, 0x71, 0xbb, 0xf1, 0x33, 0xaa, 0xca, 0xe1, 0xf2, 0x1f, 0x0c,
+	0x98, 0xfc, 0x66, 0x97, 0x74, 0x89, 0x7b, 0x93, 0x30, 0x86, 0x5b, 0x04, 0x4d, 0x41, 0x86, 0xba,
+	0x32, 0x93, 0x73, 0x56, 0x86, 0xba, 0xe2, 0x65, 0x41, 0x77, 0xb7, 0x8c, 0xac, 0xb6, 0xfa, 0xeb,
+	0xe0, 0x2a, 0x9a, 0x3d, 0x76, 0x15, 0x5d, 0x81, 0x6c, 0x9b, 0xb5, 0xcc, 0xdc, 0x33, 0x9e, 0x41,
+	0xce, 0x7f, 0xfc, 0x70, 0xe1, 0xac, 0x86, 0x13, 0x4f, 0xa7, 0x71, 0xed, 0xba, 0xc9, 0x5a, 0x96,
+	0xe0, 0x16, 0x5a, 0x9c, 0xdc, 0x10, 0xcf, 0x0b, 0xf4, 0x1d, 0x22, 0xa7, 0x7f, 0x8b, 0x38, 0x41,
+	0xe8, 0x0e, 0xe8, 0x52, 0x81, 0x13, 0xc1, 0x5d, 0x9f, 0x84, 0x47, 0x96, 0x68, 0x45, 0x26, 0x0b,
+	0x52, 0xe0, 0x76, 0x3d, 0x62, 0x63, 0x47, 0x75, 0x1c, 0xf5, 0xde, 0x34, 0xa9, 0x56, 0x97, 0xd5,
+	0x22, 0x7a, 0x03, 0xf2, 0x71, 0x2c, 0xe8, 0x26, 0x79, 0xe1, 0xf1, 0xc3, 0x85, 0x17, 0x35, 0xf4,
+	0xd6, 0xbe, 0x0b, 0x67, 0x34, 0x69, 0xc6, 0x3c, 0xba, 0x51, 0xfd, 0xda, 0x00, 0x94, 0xdc, 0x0e,
+	0x2d, 0xc2, 0x3a, 0x81, 0xcf, 0xe4, 0xe0, 0x9f, 0x1a, 0xd0, 0x8d, 0x67, 0x0f, 0xfe, 0x09, 0x7f,
+	0xdf, 0xe0, 0x9f, 0xea, 0x7e, 0x5f, 0x4f, 0xee, 0x62, 0x19, 0x5d, 0xae, 0x0f, 0xb2, 0xed, 0x4a,
+	0x40, 0xfb, 0x20, 0x22, 0x26, 0x79, 0xd6, 0x91, 0xf2, 0x9e, 0x01, 0xe7, 0x06, 0x5a, 0x47, 0x7c,
+	0x64, 0x07, 0x50, 0x98, 0xda, 0x94, 0x25, 0xb8, 0xa7, 0x8f, 0xfe, 0xd9, 0x3a, 0xd1, 0x4c, 0xb8,
+	0x7f, 0xf7, 0x7f, 0x75, 0xa9, 0xd4, 0xce, 0xf8, 0xa3, 0x01, 0xa7, 0xd2, 0x27, 0x8a, 0x75, 0x6b,
+	0xc0, 0x44, 0xfa, 0x2c, 0x5a, 0xab, 0x8b, 0xcf, 0xa3, 0x55, 0x5a, 0xa1, 0x3e, 0x10, 0xa1, 0x4b,
+	0xd4, 0xa6, 0xd4, 0xe3, 0xfa, 0x95, 0xe7, 0xb6, 0x52, 0x74, 0xb0, 0x03, 0xfb, 0xb6, 0x72, 0xd6,
+	0xcf, 0x32, 0x90, 0x5b, 0x0f, 0x02, 0x4f, 0x94, 0xee, 0x19, 0x3f, 0xe0, 0xb2, 0x62, 0x12, 0xd7,
+	0xd6, 0xaf, 0x7b, 0xea, 0xea, 0xb3, 0x75, 0x3c, 0xeb, 0xfd, 0x63, 0x6f, 0x6e, 0x10, 0xaa, 0xdf,
+	0xa4, 0xfa, 0x55, 0xd9, 0x0f, 0x78, 0x55, 0x12, 0xc9, 0x0c, 0x65, 0xe8, 0x2e, 0x4c, 0xf6, 0xcb,
+	0x57, 0xc9, 0x68, 0x1d, 0x5b, 0xfe, 0xe4, 0x91, 0xb2, 0x27, 0x9a, 0x29, 0xc1, 0x4b, 0xe3, 0xc2,
+	0xb1, 0xff, 0x14, 0xce, 0x7d, 0x1b, 0xa6, 0xe3, 0xa4, 0xdc, 0x94, 0x6f, 0xd4, 0x62, 0x98, 0x1c,
+	0x53, 0xcf, 0xd5, 0xd1, 0xc8, 0x3f, 0x9f, 0xfe, 0xe3, 0x88, 0xf8, 0xeb, 0x4a, 0x65, 0x1f, 0x4f,
+	0x9f, 0xc5, 0x35, 0xef, 0xe5, 0xdf, 0x18, 0x00, 0xc9, 0x5b, 0x2a, 0x7a, 0x0d, 0xce, 0x56, 0x6f,
+	0xad, 0xd5, 0xec, 0xc6, 0xc6, 0xf2, 0xc6, 0x66, 0xc3, 0xde, 0x5c, 0x6b, 0xac, 0xaf, 0xae, 0xd4,
+	0xaf, 0xd5, 0x57, 0x6b, 0xd3, 0x23, 0xa5, 0xe2, 0xbd, 0xfb, 0xf3, 0x85, 0x4d, 0x9f, 0x75, 0x88,
+	0x43, 0xb7, 0x29, 0x71, 0xd1, 0xcb, 0x70, 0xaa, 0x9f, 0x5a, 0x7c, 0xad, 0xd6, 0xa6, 0x8d, 0xd2,
+	0xc4, 0xbd, 0xfb, 0xf3, 0xe3, 0x6a, 0x86, 0x24, 0x2e, 0xba, 0x04, 0xa7, 0x07, 0xe9, 0xea, 0x6b,
+	0x6f, 0x4e, 0x67, 0x4a, 0x93, 0xf7, 0xee, 0xcf, 0xe7, 0xe3, 0x61, 0x13, 0x95, 0x01, 0xa5, 0x29,
+	0x35, 0x5e, 0xb6, 0x04, 0xf7, 0xee, 0xcf, 0x8f, 0x2a, 0xb7, 0x94, 0x72, 0xef, 0xfe, 0x6a, 0x76,
+	0xe4, 0xf2, 0xf7, 0x01, 0xea, 0xfe, 0x76, 0x88, 0x1d, 0x19, 0x90, 0x25, 0x38, 0x53, 0x5f, 0xbb,
+	0x66, 0x2d, 0xaf, 0x6c, 0xd4, 0x6f, 0xad, 0xf5, 0x1f, 0x7b, 0xdf, 0x5e, 0xed, 0xd6, 0x66, 0xf5,
+	0xc6, 0xaa, 0xdd, 0xa8, 0xbf, 0xb9, 0x36, 0x6d, 0xa0, 0xb3, 0x70, 0xb2, 0x6f, 0xef, 0x5b, 0x6b,
+	0x1b, 0xf5, 0x9b, 0xab, 0xd3, 0x99, 0xea, 0xb5, 0x8f, 0x9e, 0xcc, 0x1a, 0x8f, 0x9e, 0xcc, 0x1a,
+	0x7f, 0x7f, 0x32, 0x6b, 0xbc, 0xf7, 0x74, 0x76, 0xe4, 0xd1, 0xd3, 0xd9, 0x91, 0xbf, 0x3c, 0x9d,
+	0x1d, 0xf9, 0xce, 0x6b, 0xcf, 0x74, 0x78, 0xd2, 0xb1, 0xa4, 0xeb, 0x9b, 0xa3, 0xb2, 0x35, 0x7c,
+	0xe5, 0xbf, 0x03, 0x00, 0xda, 0xcd, 0x2e, 0x57, 0x17, 0x1c, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1403,706 +1597,719 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 11178 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x69, 0x90, 0x1c, 0x47,
-		0x76, 0x1f, 0x3e, 0x7d, 0x4c, 0x1f, 0xaf, 0xaf, 0x9a, 0x9c, 0x01, 0x30, 0x68, 0x90, 0x33, 0xc3,
-		0xe2, 0x92, 0x00, 0x41, 0x72, 0x40, 0x82, 0x04, 0x48, 0x0e, 0x96, 0xcb, 0x7f, 0x77, 0x4f, 0x63,
-		0xd0, 0xe0, 0x5c, 0xac, 0xee, 0x01, 0x0f, 0x1d, 0xa5, 0x9a, 0xea, 0x9c, 0x9e, 0x22, 0xba, 0xab,
-		0x7a, 0xab, 0xaa, 0x81, 0x19, 0xc6, 0x3f, 0x1c, 0x2b, 0xad, 0x64, 0xaf, 0x60, 0x59, 0x5a, 0x1d,
-		0x61, 0xad, 0xa4, 0x85, 0xcc, 0x95, 0x2c, 0xed, 0x4a, 0xbe, 0x74, 0xac, 0xb5, 0x92, 0x15, 0x92,
-		0x25, 0x87, 0x0f, 0x49, 0x76, 0x38, 0xd6, 0xfa, 0x60, 0x2b, 0x1c, 0x61, 0x5a, 0xe2, 0x2a, 0xa4,
-		0xf5, 0x6a, 0x65, 0x5d, 0x94, 0x6d, 0x79, 0xc3, 0x0a, 0x47, 0x5e, 0x75, 0xf4, 0x31, 0xdd, 0x03,
-		0x91, 0xd4, 0xda, 0xf2, 0x17, 0xa0, 0x2b, 0xf3, 0xbd, 0x5f, 0x65, 0xbe, 0x7c, 0xf9, 0xf2, 0xbd,
-		0x97, 0x59, 0x39, 0xf0, 0x5b, 0x65, 0x58, 0x6a, 0x59, 0x56, 0xab, 0x8d, 0x2f, 0x74, 0x6d, 0xcb,
-		0xb5, 0x76, 0x7b, 0x7b, 0x17, 0x9a, 0xd8, 0xd1, 0x6d, 0xa3, 0xeb, 0x5a, 0xf6, 0x32, 0x2d, 0x43,
-		0x05, 0x46, 0xb1, 0x2c, 0x28, 0xe4, 0x0d, 0x98, 0xb9, 0x6a, 0xb4, 0xf1, 0xaa, 0x47, 0x58, 0xc7,
-		0x2e, 0x7a, 0x16, 0xe2, 0x7b, 0x46, 0x1b, 0xcf, 0x47, 0x96, 0x62, 0xe7, 0x32, 0x17, 0x3f, 0xb0,
-		0xdc, 0xc7, 0xb4, 0x1c, 0xe6, 0xd8, 0x26, 0xc5, 0x0a, 0xe5, 0x90, 0xff, 0x3c, 0x0e, 0xb3, 0x43,
-		0x6a, 0x11, 0x82, 0xb8, 0xa9, 0x75, 0x08, 0x62, 0xe4, 0x5c, 0x5a, 0xa1, 0xbf, 0xd1, 0x3c, 0x24,
-		0xbb, 0x9a, 0x7e, 0x53, 0x6b, 0xe1, 0xf9, 0x28, 0x2d, 0x16, 0x8f, 0x68, 0x01, 0xa0, 0x89, 0xbb,
-		0xd8, 0x6c, 0x62, 0x53, 0x3f, 0x9c, 0x8f, 0x2d, 0xc5, 0xce, 0xa5, 0x95, 0x40, 0x09, 0x7a, 0x14,
-		0x66, 0xba, 0xbd, 0xdd, 0xb6, 0xa1, 0xab, 0x01, 0x32, 0x58, 0x8a, 0x9d, 0x9b, 0x56, 0x24, 0x56,
-		0xb1, 0xea, 0x13, 0x9f, 0x85, 0xc2, 0x6d, 0xac, 0xdd, 0x0c, 0x92, 0x66, 0x28, 0x69, 0x9e, 0x14,
-		0x07, 0x08, 0x2b, 0x90, 0xed, 0x60, 0xc7, 0xd1, 0x5a, 0x58, 0x75, 0x0f, 0xbb, 0x78, 0x3e, 0x4e,
-		0x7b, 0xbf, 0x34, 0xd0, 0xfb, 0xfe, 0x9e, 0x67, 0x38, 0x57, 0xe3, 0xb0, 0x8b, 0x51, 0x09, 0xd2,
-		0xd8, 0xec, 0x75, 0x18, 0xc2, 0xf4, 0x08, 0xf9, 0x55, 0xcd, 0x5e, 0xa7, 0x1f, 0x25, 0x45, 0xd8,
-		0x38, 0x44, 0xd2, 0xc1, 0xf6, 0x2d, 0x43, 0xc7, 0xf3, 0x09, 0x0a, 0x70, 0x76, 0x00, 0xa0, 0xce,
-		0xea, 0xfb, 0x31, 0x04, 0x1f, 0xaa, 0x40, 0x1a, 0x1f, 0xb8, 0xd8, 0x74, 0x0c, 0xcb, 0x9c, 0x4f,
-		0x52, 0x90, 0x87, 0x86, 0x8c, 0x22, 0x6e, 0x37, 0xfb, 0x21, 0x7c, 0x3e, 0x74, 0x19, 0x92, 0x56,
-		0xd7, 0x35, 0x2c, 0xd3, 0x99, 0x4f, 0x2d, 0x45, 0xce, 0x65, 0x2e, 0xde, 0x37, 0x54, 0x11, 0xb6,
-		0x18, 0x8d, 0x22, 0x88, 0x51, 0x0d, 0x24, 0xc7, 0xea, 0xd9, 0x3a, 0x56, 0x75, 0xab, 0x89, 0x55,
-		0xc3, 0xdc, 0xb3, 0xe6, 0xd3, 0x14, 0x60, 0x71, 0xb0, 0x23, 0x94, 0xb0, 0x62, 0x35, 0x71, 0xcd,
-		0xdc, 0xb3, 0x94, 0xbc, 0x13, 0x7a, 0x46, 0x27, 0x21, 0xe1, 0x1c, 0x9a, 0xae, 0x76, 0x30, 0x9f,
-		0xa5, 0x1a, 0xc2, 0x9f, 0x88, 0xea, 0xe0, 0xa6, 0x41, 0x5e, 0x37, 0x9f, 0x63, 0xaa, 0xc3, 0x1f,
-		0xe5, 0x9f, 0x4b, 0x40, 0x61, 0x12, 0xe5, 0xbb, 0x02, 0xd3, 0x7b, 0xa4, 0xff, 0xf3, 0xd1, 0xe3,
-		0x48, 0x87, 0xf1, 0x84, 0xc5, 0x9b, 0xb8, 0x47, 0xf1, 0x96, 0x20, 0x63, 0x62, 0xc7, 0xc5, 0x4d,
-		0xa6, 0x2b, 0xb1, 0x09, 0xb5, 0x0d, 0x18, 0xd3, 0xa0, 0xb2, 0xc5, 0xef, 0x49, 0xd9, 0x5e, 0x81,
-		0x82, 0xd7, 0x24, 0xd5, 0xd6, 0xcc, 0x96, 0xd0, 0xda, 0x0b, 0xe3, 0x5a, 0xb2, 0x5c, 0x15, 0x7c,
-		0x0a, 0x61, 0x53, 0xf2, 0x38, 0xf4, 0x8c, 0x56, 0x01, 0x2c, 0x13, 0x5b, 0x7b, 0x6a, 0x13, 0xeb,
-		0xed, 0xf9, 0xd4, 0x08, 0x29, 0x6d, 0x11, 0x92, 0x01, 0x29, 0x59, 0xac, 0x54, 0x6f, 0xa3, 0xe7,
-		0x7c, 0x25, 0x4c, 0x8e, 0xd0, 0xa1, 0x0d, 0x36, 0xfd, 0x06, 0xf4, 0x70, 0x07, 0xf2, 0x36, 0x26,
-		0x33, 0x02, 0x37, 0x79, 0xcf, 0xd2, 0xb4, 0x11, 0xcb, 0x63, 0x7b, 0xa6, 0x70, 0x36, 0xd6, 0xb1,
-		0x9c, 0x1d, 0x7c, 0x44, 0x0f, 0x82, 0x57, 0xa0, 0x52, 0xb5, 0x02, 0x6a, 0x9f, 0xb2, 0xa2, 0x70,
-		0x53, 0xeb, 0xe0, 0xe2, 0x1b, 0x90, 0x0f, 0x8b, 0x07, 0xcd, 0xc1, 0xb4, 0xe3, 0x6a, 0xb6, 0x4b,
-		0xb5, 0x70, 0x5a, 0x61, 0x0f, 0x48, 0x82, 0x18, 0x36, 0x9b, 0xd4, 0xfe, 0x4d, 0x2b, 0xe4, 0x27,
-		0xfa, 0xff, 0xfc, 0x0e, 0xc7, 0x68, 0x87, 0x1f, 0x1e, 0x1c, 0xd1, 0x10, 0x72, 0x7f, 0xbf, 0x8b,
-		0xcf, 0x40, 0x2e, 0xd4, 0x81, 0x49, 0x5f, 0x2d, 0xff, 0xff, 0x70, 0x62, 0x28, 0x34, 0x7a, 0x05,
-		0xe6, 0x7a, 0xa6, 0x61, 0xba, 0xd8, 0xee, 0xda, 0x98, 0x68, 0x2c, 0x7b, 0xd5, 0xfc, 0xef, 0x26,
-		0x47, 0xe8, 0xdc, 0x4e, 0x90, 0x9a, 0xa1, 0x28, 0xb3, 0xbd, 0xc1, 0xc2, 0xf3, 0xe9, 0xd4, 0x17,
-		0x93, 0xd2, 0x47, 0x3e, 0xf2, 0x91, 0x8f, 0x44, 0xe5, 0x5f, 0x4e, 0xc0, 0xdc, 0xb0, 0x39, 0x33,
-		0x74, 0xfa, 0x9e, 0x84, 0x84, 0xd9, 0xeb, 0xec, 0x62, 0x9b, 0x0a, 0x69, 0x5a, 0xe1, 0x4f, 0xa8,
-		0x04, 0xd3, 0x6d, 0x6d, 0x17, 0xb7, 0xe7, 0xe3, 0x4b, 0x91, 0x73, 0xf9, 0x8b, 0x8f, 0x4e, 0x34,
-		0x2b, 0x97, 0xd7, 0x09, 0x8b, 0xc2, 0x38, 0xd1, 0x87, 0x20, 0xce, 0x8d, 0x37, 0x41, 0x38, 0x3f,
-		0x19, 0x02, 0x99, 0x4b, 0x0a, 0xe5, 0x43, 0x67, 0x20, 0x4d, 0xfe, 0x67, 0xba, 0x91, 0xa0, 0x6d,
-		0x4e, 0x91, 0x02, 0xa2, 0x17, 0xa8, 0x08, 0x29, 0x3a, 0x4d, 0x9a, 0x58, 0x2c, 0x7a, 0xde, 0x33,
-		0x51, 0xac, 0x26, 0xde, 0xd3, 0x7a, 0x6d, 0x57, 0xbd, 0xa5, 0xb5, 0x7b, 0x98, 0x2a, 0x7c, 0x5a,
-		0xc9, 0xf2, 0xc2, 0x1b, 0xa4, 0x0c, 0x2d, 0x42, 0x86, 0xcd, 0x2a, 0xc3, 0x6c, 0xe2, 0x03, 0x6a,
-		0x57, 0xa7, 0x15, 0x36, 0xd1, 0x6a, 0xa4, 0x84, 0xbc, 0xfe, 0x75, 0xc7, 0x32, 0x85, 0x6a, 0xd2,
-		0x57, 0x90, 0x02, 0xfa, 0xfa, 0x67, 0xfa, 0x4d, 0xfa, 0xfd, 0xc3, 0xbb, 0x37, 0x30, 0x97, 0xce,
-		0x42, 0x81, 0x52, 0x3c, 0xc5, 0x87, 0x5e, 0x6b, 0xcf, 0xcf, 0x2c, 0x45, 0xce, 0xa5, 0x94, 0x3c,
-		0x2b, 0xde, 0xe2, 0xa5, 0xf2, 0xcf, 0x44, 0x21, 0x4e, 0x0d, 0x4b, 0x01, 0x32, 0x8d, 0x57, 0xb7,
-		0xab, 0xea, 0xea, 0xd6, 0x4e, 0x79, 0xbd, 0x2a, 0x45, 0x50, 0x1e, 0x80, 0x16, 0x5c, 0x5d, 0xdf,
-		0x2a, 0x35, 0xa4, 0xa8, 0xf7, 0x5c, 0xdb, 0x6c, 0x5c, 0x7e, 0x5a, 0x8a, 0x79, 0x0c, 0x3b, 0xac,
-		0x20, 0x1e, 0x24, 0x78, 0xea, 0xa2, 0x34, 0x8d, 0x24, 0xc8, 0x32, 0x80, 0xda, 0x2b, 0xd5, 0xd5,
-		0xcb, 0x4f, 0x4b, 0x89, 0x70, 0xc9, 0x53, 0x17, 0xa5, 0x24, 0xca, 0x41, 0x9a, 0x96, 0x94, 0xb7,
-		0xb6, 0xd6, 0xa5, 0x94, 0x87, 0x59, 0x6f, 0x28, 0xb5, 0xcd, 0x35, 0x29, 0xed, 0x61, 0xae, 0x29,
-		0x5b, 0x3b, 0xdb, 0x12, 0x78, 0x08, 0x1b, 0xd5, 0x7a, 0xbd, 0xb4, 0x56, 0x95, 0x32, 0x1e, 0x45,
-		0xf9, 0xd5, 0x46, 0xb5, 0x2e, 0x65, 0x43, 0xcd, 0x7a, 0xea, 0xa2, 0x94, 0xf3, 0x5e, 0x51, 0xdd,
-		0xdc, 0xd9, 0x90, 0xf2, 0x68, 0x06, 0x72, 0xec, 0x15, 0xa2, 0x11, 0x85, 0xbe, 0xa2, 0xcb, 0x4f,
-		0x4b, 0x92, 0xdf, 0x10, 0x86, 0x32, 0x13, 0x2a, 0xb8, 0xfc, 0xb4, 0x84, 0xe4, 0x0a, 0x4c, 0x53,
-		0x35, 0x44, 0x08, 0xf2, 0xeb, 0xa5, 0x72, 0x75, 0x5d, 0xdd, 0xda, 0x6e, 0xd4, 0xb6, 0x36, 0x4b,
-		0xeb, 0x52, 0xc4, 0x2f, 0x53, 0xaa, 0x2f, 0xed, 0xd4, 0x94, 0xea, 0xaa, 0x14, 0x0d, 0x96, 0x6d,
-		0x57, 0x4b, 0x8d, 0xea, 0xaa, 0x14, 0x93, 0x75, 0x98, 0x1b, 0x66, 0x50, 0x87, 0x4e, 0xa1, 0x80,
-		0x2e, 0x44, 0x47, 0xe8, 0x02, 0xc5, 0xea, 0xd7, 0x05, 0xf9, 0x0b, 0x51, 0x98, 0x1d, 0xb2, 0xa8,
-		0x0c, 0x7d, 0xc9, 0x0b, 0x30, 0xcd, 0x74, 0x99, 0x2d, 0xb3, 0x8f, 0x0c, 0x5d, 0x9d, 0xa8, 0x66,
-		0x0f, 0x2c, 0xb5, 0x94, 0x2f, 0xe8, 0x84, 0xc4, 0x46, 0x38, 0x21, 0x04, 0x62, 0x40, 0x61, 0xbf,
-		0x6e, 0xc0, 0xf8, 0xb3, 0xf5, 0xf1, 0xf2, 0x24, 0xeb, 0x23, 0x2d, 0x3b, 0xde, 0x22, 0x30, 0x3d,
-		0x64, 0x11, 0xb8, 0x02, 0x33, 0x03, 0x40, 0x13, 0x1b, 0xe3, 0x8f, 0x46, 0x60, 0x7e, 0x94, 0x70,
-		0xc6, 0x98, 0xc4, 0x68, 0xc8, 0x24, 0x5e, 0xe9, 0x97, 0xe0, 0x03, 0xa3, 0x07, 0x61, 0x60, 0xac,
-		0x3f, 0x1d, 0x81, 0x93, 0xc3, 0x9d, 0xcd, 0xa1, 0x6d, 0xf8, 0x10, 0x24, 0x3a, 0xd8, 0xdd, 0xb7,
-		0x84, 0x5b, 0xf5, 0xf0, 0x90, 0xc5, 0x9a, 0x54, 0xf7, 0x0f, 0x36, 0xe7, 0x0a, 0xae, 0xf6, 0xb1,
-		0x51, 0x1e, 0x23, 0x6b, 0xcd, 0x40, 0x4b, 0xbf, 0x35, 0x0a, 0x27, 0x86, 0x82, 0x0f, 0x6d, 0xe8,
-		0xfd, 0x00, 0x86, 0xd9, 0xed, 0xb9, 0xcc, 0x75, 0x62, 0x96, 0x38, 0x4d, 0x4b, 0xa8, 0xf1, 0x22,
-		0x56, 0xb6, 0xe7, 0x7a, 0xf5, 0x31, 0x5a, 0x0f, 0xac, 0x88, 0x12, 0x3c, 0xeb, 0x37, 0x34, 0x4e,
-		0x1b, 0xba, 0x30, 0xa2, 0xa7, 0x03, 0x8a, 0xf9, 0x04, 0x48, 0x7a, 0xdb, 0xc0, 0xa6, 0xab, 0x3a,
-		0xae, 0x8d, 0xb5, 0x8e, 0x61, 0xb6, 0xe8, 0x52, 0x93, 0x5a, 0x99, 0xde, 0xd3, 0xda, 0x0e, 0x56,
-		0x0a, 0xac, 0xba, 0x2e, 0x6a, 0x09, 0x07, 0x55, 0x20, 0x3b, 0xc0, 0x91, 0x08, 0x71, 0xb0, 0x6a,
-		0x8f, 0x43, 0xfe, 0xce, 0x34, 0x64, 0x02, 0xae, 0x39, 0x7a, 0x00, 0xb2, 0xaf, 0x6b, 0xb7, 0x34,
-		0x55, 0x84, 0x5b, 0x4c, 0x12, 0x19, 0x52, 0xb6, 0xcd, 0x43, 0xae, 0x27, 0x60, 0x8e, 0x92, 0x58,
-		0x3d, 0x17, 0xdb, 0xaa, 0xde, 0xd6, 0x1c, 0x87, 0x0a, 0x2d, 0x45, 0x49, 0x11, 0xa9, 0xdb, 0x22,
-		0x55, 0x15, 0x51, 0x83, 0x2e, 0xc1, 0x2c, 0xe5, 0xe8, 0xf4, 0xda, 0xae, 0xd1, 0x6d, 0x63, 0x95,
-		0x04, 0x80, 0x0e, 0x5d, 0x72, 0xbc, 0x96, 0xcd, 0x10, 0x8a, 0x0d, 0x4e, 0x40, 0x5a, 0xe4, 0xa0,
-		0x55, 0xb8, 0x9f, 0xb2, 0xb5, 0xb0, 0x89, 0x6d, 0xcd, 0xc5, 0x2a, 0xfe, 0x70, 0x4f, 0x6b, 0x3b,
-		0xaa, 0x66, 0x36, 0xd5, 0x7d, 0xcd, 0xd9, 0x9f, 0x9f, 0x23, 0x00, 0xe5, 0xe8, 0x7c, 0x44, 0x39,
-		0x4d, 0x08, 0xd7, 0x38, 0x5d, 0x95, 0x92, 0x95, 0xcc, 0xe6, 0x35, 0xcd, 0xd9, 0x47, 0x2b, 0x70,
-		0x92, 0xa2, 0x38, 0xae, 0x6d, 0x98, 0x2d, 0x55, 0xdf, 0xc7, 0xfa, 0x4d, 0xb5, 0xe7, 0xee, 0x3d,
-		0x3b, 0x7f, 0x26, 0xf8, 0x7e, 0xda, 0xc2, 0x3a, 0xa5, 0xa9, 0x10, 0x92, 0x1d, 0x77, 0xef, 0x59,
-		0x54, 0x87, 0x2c, 0x19, 0x8c, 0x8e, 0xf1, 0x06, 0x56, 0xf7, 0x2c, 0x9b, 0xae, 0xa1, 0xf9, 0x21,
-		0xa6, 0x29, 0x20, 0xc1, 0xe5, 0x2d, 0xce, 0xb0, 0x61, 0x35, 0xf1, 0xca, 0x74, 0x7d, 0xbb, 0x5a,
-		0x5d, 0x55, 0x32, 0x02, 0xe5, 0xaa, 0x65, 0x13, 0x85, 0x6a, 0x59, 0x9e, 0x80, 0x33, 0x4c, 0xa1,
-		0x5a, 0x96, 0x10, 0xef, 0x25, 0x98, 0xd5, 0x75, 0xd6, 0x67, 0x43, 0x57, 0x79, 0x98, 0xe6, 0xcc,
-		0x4b, 0x21, 0x61, 0xe9, 0xfa, 0x1a, 0x23, 0xe0, 0x3a, 0xee, 0xa0, 0xe7, 0xe0, 0x84, 0x2f, 0xac,
-		0x20, 0xe3, 0xcc, 0x40, 0x2f, 0xfb, 0x59, 0x2f, 0xc1, 0x6c, 0xf7, 0x70, 0x90, 0x11, 0x85, 0xde,
-		0xd8, 0x3d, 0xec, 0x67, 0x7b, 0x06, 0xe6, 0xba, 0xfb, 0xdd, 0x41, 0xbe, 0xf3, 0x41, 0x3e, 0xd4,
-		0xdd, 0xef, 0xf6, 0x33, 0x3e, 0x44, 0x63, 0x76, 0x1b, 0xeb, 0x9a, 0x8b, 0x9b, 0xf3, 0xa7, 0x82,
-		0xe4, 0x81, 0x0a, 0xb4, 0x0c, 0x92, 0xae, 0xab, 0xd8, 0xd4, 0x76, 0xdb, 0x58, 0xd5, 0x6c, 0x6c,
-		0x6a, 0xce, 0xfc, 0x22, 0x25, 0x8e, 0xbb, 0x76, 0x0f, 0x2b, 0x79, 0x5d, 0xaf, 0xd2, 0xca, 0x12,
-		0xad, 0x43, 0xe7, 0x61, 0xc6, 0xda, 0x7d, 0x5d, 0x67, 0x1a, 0xa9, 0x76, 0x6d, 0xbc, 0x67, 0x1c,
-		0xcc, 0x7f, 0x80, 0x8a, 0xb7, 0x40, 0x2a, 0xa8, 0x3e, 0x6e, 0xd3, 0x62, 0xf4, 0x08, 0x48, 0xba,
-		0xb3, 0xaf, 0xd9, 0x5d, 0x6a, 0x92, 0x9d, 0xae, 0xa6, 0xe3, 0xf9, 0x87, 0x18, 0x29, 0x2b, 0xdf,
-		0x14, 0xc5, 0x64, 0x46, 0x38, 0xb7, 0x8d, 0x3d, 0x57, 0x20, 0x9e, 0x65, 0x33, 0x82, 0x96, 0x71,
-		0xb4, 0x73, 0x20, 0x11, 0x49, 0x84, 0x5e, 0x7c, 0x8e, 0x92, 0xe5, 0xbb, 0xfb, 0xdd, 0xe0, 0x7b,
-		0x1f, 0x84, 0x1c, 0xa1, 0xf4, 0x5f, 0xfa, 0x08, 0x73, 0xdc, 0xba, 0xfb, 0x81, 0x37, 0x3e, 0x0d,
-		0x27, 0x09, 0x51, 0x07, 0xbb, 0x5a, 0x53, 0x73, 0xb5, 0x00, 0xf5, 0x63, 0x94, 0x9a, 0x88, 0x7d,
-		0x83, 0x57, 0x86, 0xda, 0x69, 0xf7, 0x76, 0x0f, 0x3d, 0xc5, 0x7a, 0x9c, 0xb5, 0x93, 0x94, 0x09,
-		0xd5, 0x7a, 0xcf, 0x9c, 0x73, 0x79, 0x05, 0xb2, 0x41, 0xbd, 0x47, 0x69, 0x60, 0x9a, 0x2f, 0x45,
-		0x88, 0x13, 0x54, 0xd9, 0x5a, 0x25, 0xee, 0xcb, 0x6b, 0x55, 0x29, 0x4a, 0xdc, 0xa8, 0xf5, 0x5a,
-		0xa3, 0xaa, 0x2a, 0x3b, 0x9b, 0x8d, 0xda, 0x46, 0x55, 0x8a, 0x05, 0x1c, 0xfb, 0xeb, 0xf1, 0xd4,
-		0xc3, 0xd2, 0x59, 0xf9, 0xe7, 0x63, 0x90, 0x0f, 0x47, 0x6a, 0xe8, 0x83, 0x70, 0x4a, 0x24, 0x5c,
-		0x1c, 0xec, 0xaa, 0xb7, 0x0d, 0x9b, 0x4e, 0xc8, 0x8e, 0xc6, 0x16, 0x47, 0x4f, 0x7f, 0xe6, 0x38,
-		0x55, 0x1d, 0xbb, 0x2f, 0x1b, 0x36, 0x99, 0x6e, 0x1d, 0xcd, 0x45, 0xeb, 0xb0, 0x68, 0x5a, 0xaa,
-		0xe3, 0x6a, 0x66, 0x53, 0xb3, 0x9b, 0xaa, 0x9f, 0xea, 0x52, 0x35, 0x5d, 0xc7, 0x8e, 0x63, 0xb1,
-		0x85, 0xd0, 0x43, 0xb9, 0xcf, 0xb4, 0xea, 0x9c, 0xd8, 0x5f, 0x21, 0x4a, 0x9c, 0xb4, 0x4f, 0x7d,
-		0x63, 0xa3, 0xd4, 0xf7, 0x0c, 0xa4, 0x3b, 0x5a, 0x57, 0xc5, 0xa6, 0x6b, 0x1f, 0x52, 0xff, 0x3c,
-		0xa5, 0xa4, 0x3a, 0x5a, 0xb7, 0x4a, 0x9e, 0xd1, 0x0d, 0x78, 0xd8, 0x27, 0x55, 0xdb, 0xb8, 0xa5,
-		0xe9, 0x87, 0x2a, 0x75, 0xc6, 0x69, 0xda, 0x40, 0xd5, 0x2d, 0x73, 0xaf, 0x6d, 0xe8, 0xae, 0x43,
-		0xed, 0x03, 0xb3, 0x71, 0xb2, 0xcf, 0xb1, 0x4e, 0x19, 0xae, 0x3b, 0x96, 0x49, 0x7d, 0xf0, 0x8a,
-		0xa0, 0x7e, 0x5f, 0xc2, 0xaf, 0xeb, 0xf1, 0x54, 0x5c, 0x9a, 0xbe, 0x1e, 0x4f, 0x4d, 0x4b, 0x89,
-		0xeb, 0xf1, 0x54, 0x42, 0x4a, 0x5e, 0x8f, 0xa7, 0x52, 0x52, 0xfa, 0x7a, 0x3c, 0x95, 0x96, 0x40,
-		0xfe, 0x5c, 0x0a, 0xb2, 0xc1, 0xc8, 0x80, 0x04, 0x5a, 0x3a, 0x5d, 0x1b, 0x23, 0xd4, 0x7a, 0x3e,
-		0x78, 0x64, 0x1c, 0xb1, 0x5c, 0x21, 0x8b, 0xe6, 0x4a, 0x82, 0xb9, 0xe1, 0x0a, 0xe3, 0x24, 0x0e,
-		0x0b, 0x51, 0x6b, 0xcc, 0xdc, 0x9e, 0x94, 0xc2, 0x9f, 0xd0, 0x1a, 0x24, 0x5e, 0x77, 0x28, 0x76,
-		0x82, 0x62, 0x7f, 0xe0, 0x68, 0xec, 0xeb, 0x75, 0x0a, 0x9e, 0xbe, 0x5e, 0x57, 0x37, 0xb7, 0x94,
-		0x8d, 0xd2, 0xba, 0xc2, 0xd9, 0xd1, 0x69, 0x88, 0xb7, 0xb5, 0x37, 0x0e, 0xc3, 0xcb, 0x2b, 0x2d,
-		0x42, 0xcb, 0x50, 0xe8, 0x99, 0xb7, 0xb0, 0x6d, 0xec, 0x19, 0x64, 0xa8, 0x08, 0x55, 0x21, 0x48,
-		0x95, 0xf7, 0x6b, 0xd7, 0x09, 0xfd, 0x84, 0xea, 0x71, 0x1a, 0xe2, 0xb7, 0xb1, 0x76, 0x33, 0xbc,
-		0x08, 0xd2, 0x22, 0x74, 0x0e, 0xb2, 0x4d, 0xbc, 0xdb, 0x6b, 0xa9, 0x36, 0x6e, 0x6a, 0xba, 0x1b,
-		0x36, 0xfd, 0x19, 0x5a, 0xa5, 0xd0, 0x1a, 0xf4, 0x22, 0xa4, 0xc9, 0x18, 0x99, 0x74, 0x8c, 0x67,
-		0xa8, 0x08, 0x1e, 0x3f, 0x5a, 0x04, 0x7c, 0x88, 0x05, 0x93, 0xe2, 0xf3, 0xa3, 0xab, 0x90, 0x70,
-		0x35, 0xbb, 0x85, 0x5d, 0x6a, 0xf9, 0xf3, 0x43, 0x92, 0x1f, 0x43, 0x90, 0x1a, 0x94, 0x83, 0xc6,
-		0xb4, 0x9c, 0xfb, 0x3d, 0xb4, 0x32, 0x17, 0x60, 0x9a, 0xaa, 0x07, 0x02, 0xe0, 0x0a, 0x22, 0x4d,
-		0xa1, 0x14, 0xc4, 0x2b, 0x5b, 0x0a, 0xb1, 0x34, 0x12, 0x64, 0x59, 0xa9, 0xba, 0x5d, 0xab, 0x56,
-		0xaa, 0x52, 0x54, 0xbe, 0x04, 0x09, 0x36, 0xe6, 0xc4, 0x0a, 0x79, 0xa3, 0x2e, 0x4d, 0xf1, 0x47,
-		0x8e, 0x11, 0x11, 0xb5, 0x3b, 0x1b, 0xe5, 0xaa, 0x22, 0x45, 0xe5, 0x1d, 0x28, 0xf4, 0xc9, 0x09,
-		0x9d, 0x80, 0x19, 0xa5, 0xda, 0xa8, 0x6e, 0x92, 0x38, 0x4b, 0xdd, 0xd9, 0x7c, 0x71, 0x73, 0xeb,
-		0xe5, 0x4d, 0x69, 0x2a, 0x5c, 0x2c, 0x4c, 0x5a, 0x04, 0xcd, 0x81, 0xe4, 0x17, 0xd7, 0xb7, 0x76,
-		0x14, 0xda, 0x9a, 0x6f, 0x8b, 0x82, 0xd4, 0x2f, 0x35, 0x74, 0x0a, 0x66, 0x1b, 0x25, 0x65, 0xad,
-		0xda, 0x50, 0x59, 0xec, 0xe8, 0x41, 0xcf, 0x81, 0x14, 0xac, 0xb8, 0x5a, 0xa3, 0xa1, 0xf1, 0x22,
-		0x9c, 0x09, 0x96, 0x56, 0x5f, 0x69, 0x54, 0x37, 0xeb, 0xf4, 0xe5, 0xa5, 0xcd, 0x35, 0x62, 0x5f,
-		0xfb, 0xf0, 0x44, 0xb4, 0x1a, 0x23, 0x4d, 0x0d, 0xe3, 0x55, 0xd7, 0x57, 0xa5, 0x78, 0x7f, 0xf1,
-		0xd6, 0x66, 0x75, 0xeb, 0xaa, 0x34, 0xdd, 0xff, 0x76, 0x1a, 0xc1, 0x26, 0x50, 0x11, 0x4e, 0xf6,
-		0x97, 0xaa, 0xd5, 0xcd, 0x86, 0xf2, 0xaa, 0x94, 0xec, 0x7f, 0x71, 0xbd, 0xaa, 0xdc, 0xa8, 0x55,
-		0xaa, 0x52, 0x0a, 0x9d, 0x04, 0x14, 0x6e, 0x51, 0xe3, 0xda, 0xd6, 0xaa, 0x94, 0x1e, 0xb0, 0x28,
-		0xb2, 0x03, 0xd9, 0x60, 0x18, 0xf9, 0xfe, 0xe4, 0x92, 0x3e, 0x11, 0x85, 0x4c, 0x20, 0x2c, 0x24,
-		0xfe, 0xbc, 0xd6, 0x6e, 0x5b, 0xb7, 0x55, 0xad, 0x6d, 0x68, 0x0e, 0xb7, 0x37, 0x40, 0x8b, 0x4a,
-		0xa4, 0x64, 0xd2, 0xf9, 0x3d, 0xb9, 0x85, 0x4f, 0x7c, 0x35, 0x5a, 0xf8, 0x69, 0x29, 0x21, 0xff,
-		0x60, 0x04, 0xa4, 0xfe, 0x78, 0xaf, 0xaf, 0xfb, 0x91, 0x51, 0xdd, 0x7f, 0x5f, 0xc6, 0xee, 0x93,
-		0x11, 0xc8, 0x87, 0x83, 0xbc, 0xbe, 0xe6, 0x3d, 0xf0, 0x97, 0xda, 0xbc, 0xdf, 0x8c, 0x42, 0x2e,
-		0x14, 0xda, 0x4d, 0xda, 0xba, 0x0f, 0xc3, 0x8c, 0xd1, 0xc4, 0x9d, 0xae, 0xe5, 0x62, 0x53, 0x3f,
-		0x54, 0xdb, 0xf8, 0x16, 0x6e, 0xcf, 0xcb, 0xd4, 0x28, 0x5f, 0x38, 0x3a, 0x78, 0x5c, 0xae, 0xf9,
-		0x7c, 0xeb, 0x84, 0x6d, 0x65, 0xb6, 0xb6, 0x5a, 0xdd, 0xd8, 0xde, 0x6a, 0x54, 0x37, 0x2b, 0xaf,
-		0x0a, 0xeb, 0xa2, 0x48, 0x46, 0x1f, 0xd9, 0x7b, 0x68, 0xb4, 0xb7, 0x41, 0xea, 0x6f, 0x14, 0xb1,
-		0x15, 0x43, 0x9a, 0x25, 0x4d, 0xa1, 0x59, 0x28, 0x6c, 0x6e, 0xa9, 0xf5, 0xda, 0x6a, 0x55, 0xad,
-		0x5e, 0xbd, 0x5a, 0xad, 0x34, 0xea, 0x2c, 0x1d, 0xe8, 0x51, 0x37, 0xa4, 0x68, 0x50, 0xc4, 0xdf,
-		0x1f, 0x83, 0xd9, 0x21, 0x2d, 0x41, 0x25, 0x1e, 0xc8, 0xb3, 0xdc, 0xc2, 0xe3, 0x93, 0xb4, 0x7e,
-		0x99, 0xb8, 0xd2, 0xdb, 0x9a, 0xed, 0xf2, 0xb8, 0xff, 0x11, 0x20, 0x52, 0x32, 0x5d, 0xb2, 0xb2,
-		0xdb, 0x3c, 0xcd, 0xca, 0xa2, 0xfb, 0x82, 0x5f, 0xce, 0x32, 0xad, 0x8f, 0x01, 0xea, 0x5a, 0x8e,
-		0xe1, 0x1a, 0xb7, 0xb0, 0x6a, 0x98, 0x22, 0x27, 0x4b, 0xa2, 0xfd, 0xb8, 0x22, 0x89, 0x9a, 0x9a,
-		0xe9, 0x7a, 0xd4, 0x26, 0x6e, 0x69, 0x7d, 0xd4, 0xc4, 0xf3, 0x88, 0x29, 0x92, 0xa8, 0xf1, 0xa8,
-		0x1f, 0x80, 0x6c, 0xd3, 0xea, 0x91, 0x10, 0x88, 0xd1, 0x11, 0x6b, 0x11, 0x51, 0x32, 0xac, 0xcc,
-		0x23, 0xe1, 0xc1, 0xad, 0x9f, 0x0c, 0xce, 0x2a, 0x19, 0x56, 0xc6, 0x48, 0xce, 0x42, 0x41, 0x6b,
-		0xb5, 0x6c, 0x02, 0x2e, 0x80, 0x58, 0xb8, 0x9e, 0xf7, 0x8a, 0x29, 0x61, 0xf1, 0x3a, 0xa4, 0x84,
-		0x1c, 0x88, 0x07, 0x4b, 0x24, 0xa1, 0x76, 0x59, 0x0e, 0x2a, 0x7a, 0x2e, 0xad, 0xa4, 0x4c, 0x51,
-		0xf9, 0x00, 0x64, 0x0d, 0x47, 0xf5, 0xf7, 0xb6, 0xa2, 0x4b, 0xd1, 0x73, 0x29, 0x25, 0x63, 0x38,
-		0xde, 0xbe, 0x80, 0xfc, 0xe9, 0x28, 0xe4, 0xc3, 0xbb, 0x76, 0x68, 0x15, 0x52, 0x6d, 0x4b, 0xd7,
-		0xa8, 0x6a, 0xb1, 0x2d, 0xe3, 0x73, 0x63, 0x36, 0xfa, 0x96, 0xd7, 0x39, 0xbd, 0xe2, 0x71, 0x16,
-		0xff, 0x6d, 0x04, 0x52, 0xa2, 0x18, 0x9d, 0x84, 0x78, 0x57, 0x73, 0xf7, 0x29, 0xdc, 0x74, 0x39,
-		0x2a, 0x45, 0x14, 0xfa, 0x4c, 0xca, 0x9d, 0xae, 0x66, 0x52, 0x15, 0xe0, 0xe5, 0xe4, 0x99, 0x8c,
-		0x6b, 0x1b, 0x6b, 0x4d, 0x9a, 0x0b, 0xb0, 0x3a, 0x1d, 0x6c, 0xba, 0x8e, 0x18, 0x57, 0x5e, 0x5e,
-		0xe1, 0xc5, 0xe8, 0x51, 0x98, 0x71, 0x6d, 0xcd, 0x68, 0x87, 0x68, 0xe3, 0x94, 0x56, 0x12, 0x15,
-		0x1e, 0xf1, 0x0a, 0x9c, 0x16, 0xb8, 0x4d, 0xec, 0x6a, 0xfa, 0x3e, 0x6e, 0xfa, 0x4c, 0x09, 0x9a,
-		0xf3, 0x3b, 0xc5, 0x09, 0x56, 0x79, 0xbd, 0xe0, 0x95, 0x3f, 0x1f, 0x85, 0x19, 0x91, 0xbd, 0x68,
-		0x7a, 0xc2, 0xda, 0x00, 0xd0, 0x4c, 0xd3, 0x72, 0x83, 0xe2, 0x1a, 0x54, 0xe5, 0x01, 0xbe, 0xe5,
-		0x92, 0xc7, 0xa4, 0x04, 0x00, 0x8a, 0xbf, 0x17, 0x01, 0xf0, 0xab, 0x46, 0xca, 0x6d, 0x11, 0x32,
-		0x7c, 0x4f, 0x96, 0x6e, 0xec, 0xb3, 0x84, 0x17, 0xb0, 0xa2, 0xab, 0x46, 0x9b, 0xa6, 0x25, 0x77,
-		0x71, 0xcb, 0x30, 0xf9, 0x7e, 0x0a, 0x7b, 0x10, 0x69, 0xc9, 0xb8, 0xbf, 0x3d, 0xa5, 0x40, 0xca,
-		0xc1, 0x1d, 0xcd, 0x74, 0x0d, 0x9d, 0xef, 0x90, 0x5c, 0x3e, 0x56, 0xe3, 0x97, 0xeb, 0x9c, 0x5b,
-		0xf1, 0x70, 0xe4, 0x73, 0x90, 0x12, 0xa5, 0xc4, 0xf1, 0xdb, 0xdc, 0xda, 0xac, 0x4a, 0x53, 0x28,
-		0x09, 0xb1, 0x7a, 0xb5, 0x21, 0x45, 0x48, 0xd8, 0x59, 0x5a, 0xaf, 0x95, 0xea, 0x52, 0xb4, 0xfc,
-		0xd7, 0x60, 0x56, 0xb7, 0x3a, 0xfd, 0x2f, 0x2c, 0x4b, 0x7d, 0x29, 0x3f, 0xe7, 0x5a, 0xe4, 0xb5,
-		0xc7, 0x39, 0x51, 0xcb, 0x6a, 0x6b, 0x66, 0x6b, 0xd9, 0xb2, 0x5b, 0xfe, 0xb1, 0x08, 0x12, 0x1d,
-		0x38, 0x81, 0xc3, 0x11, 0xdd, 0xdd, 0xff, 0x11, 0x89, 0xfc, 0x50, 0x34, 0xb6, 0xb6, 0x5d, 0xfe,
-		0xf1, 0x68, 0x71, 0x8d, 0x31, 0x6e, 0x8b, 0xee, 0x28, 0x78, 0xaf, 0x8d, 0x75, 0xd2, 0x78, 0xf8,
-		0xfd, 0x47, 0x61, 0xae, 0x65, 0xb5, 0x2c, 0x8a, 0x74, 0x81, 0xfc, 0xe2, 0xe7, 0x2a, 0xd2, 0x5e,
-		0x69, 0x71, 0xec, 0x21, 0x8c, 0x95, 0x4d, 0x98, 0xe5, 0xc4, 0x2a, 0xdd, 0xbe, 0x65, 0xc9, 0x05,
-		0x74, 0x64, 0x66, 0x7b, 0xfe, 0x27, 0x7f, 0x9b, 0x7a, 0x25, 0xca, 0x0c, 0x67, 0x25, 0x75, 0x2c,
-		0xff, 0xb0, 0xa2, 0xc0, 0x89, 0x10, 0x1e, 0xb3, 0x11, 0xd8, 0x1e, 0x83, 0xf8, 0x2f, 0x38, 0xe2,
-		0x6c, 0x00, 0xb1, 0xce, 0x59, 0x57, 0x2a, 0x90, 0x3b, 0x0e, 0xd6, 0xbf, 0xe4, 0x58, 0x59, 0x1c,
-		0x04, 0x59, 0x83, 0x02, 0x05, 0xd1, 0x7b, 0x8e, 0x6b, 0x75, 0xa8, 0x01, 0x3e, 0x1a, 0xe6, 0x5f,
-		0xfd, 0x36, 0x9b, 0xb4, 0x79, 0xc2, 0x56, 0xf1, 0xb8, 0x56, 0x56, 0x80, 0xee, 0x58, 0x37, 0xb1,
-		0xde, 0x1e, 0x83, 0xf0, 0x2b, 0xbc, 0x21, 0x1e, 0xfd, 0xca, 0x0d, 0x98, 0x23, 0xbf, 0xa9, 0x7d,
-		0x0c, 0xb6, 0x64, 0x7c, 0x1a, 0x7c, 0xfe, 0xdf, 0x7d, 0x94, 0xd9, 0x85, 0x59, 0x0f, 0x20, 0xd0,
-		0xa6, 0xc0, 0x28, 0xb6, 0xb0, 0xeb, 0x62, 0xdb, 0x51, 0xb5, 0xf6, 0xb0, 0xe6, 0x05, 0xf2, 0x88,
-		0xf3, 0xdf, 0xf7, 0xe5, 0xf0, 0x28, 0xae, 0x31, 0xce, 0x52, 0xbb, 0xbd, 0xb2, 0x03, 0xa7, 0x86,
-		0x68, 0xc5, 0x04, 0x98, 0xdf, 0xcf, 0x31, 0xe7, 0x06, 0x34, 0x83, 0xc0, 0x6e, 0x83, 0x28, 0xf7,
-		0xc6, 0x72, 0x02, 0xcc, 0x1f, 0xe0, 0x98, 0x88, 0xf3, 0x8a, 0x21, 0x25, 0x88, 0xd7, 0x61, 0xe6,
-		0x16, 0xb6, 0x77, 0x2d, 0x87, 0xe7, 0x6e, 0x27, 0x80, 0xfb, 0x24, 0x87, 0x2b, 0x70, 0x46, 0x9a,
-		0xcc, 0x25, 0x58, 0xcf, 0x41, 0x6a, 0x4f, 0xd3, 0xf1, 0x04, 0x10, 0x77, 0x39, 0x44, 0x92, 0xd0,
-		0x13, 0xd6, 0x12, 0x64, 0x5b, 0x16, 0x5f, 0x22, 0xc7, 0xb3, 0xff, 0x20, 0x67, 0xcf, 0x08, 0x1e,
-		0x0e, 0xd1, 0xb5, 0xba, 0xbd, 0x36, 0x59, 0x3f, 0xc7, 0x43, 0xfc, 0x1d, 0x01, 0x21, 0x78, 0x38,
-		0xc4, 0x31, 0xc4, 0xfa, 0xa6, 0x80, 0x70, 0x02, 0xf2, 0x7c, 0x01, 0x32, 0x96, 0xd9, 0x3e, 0xb4,
-		0xcc, 0x49, 0x1a, 0xf1, 0x29, 0x8e, 0x00, 0x9c, 0x85, 0x00, 0x5c, 0x81, 0xf4, 0xa4, 0x03, 0xf1,
-		0x23, 0x5f, 0x16, 0xd3, 0x43, 0x8c, 0xc0, 0x1a, 0x14, 0x84, 0x81, 0x32, 0x2c, 0x73, 0x02, 0x88,
-		0x1f, 0xe5, 0x10, 0xf9, 0x00, 0x1b, 0xef, 0x86, 0x8b, 0x1d, 0xb7, 0x85, 0x27, 0x01, 0xf9, 0xb4,
-		0xe8, 0x06, 0x67, 0xe1, 0xa2, 0xdc, 0xc5, 0xa6, 0xbe, 0x3f, 0x19, 0xc2, 0x67, 0x84, 0x28, 0x05,
-		0x0f, 0x81, 0xa8, 0x40, 0xae, 0xa3, 0xd9, 0xce, 0xbe, 0xd6, 0x9e, 0x68, 0x38, 0x7e, 0x8c, 0x63,
-		0x64, 0x3d, 0x26, 0x2e, 0x91, 0x9e, 0x79, 0x1c, 0x98, 0x1f, 0x17, 0x12, 0x09, 0xb0, 0xf1, 0xa9,
-		0xe7, 0xb8, 0x34, 0xd1, 0x7d, 0x1c, 0xb4, 0xbf, 0x27, 0xa6, 0x1e, 0xe3, 0xdd, 0x08, 0x22, 0x5e,
-		0x81, 0xb4, 0x63, 0xbc, 0x31, 0x11, 0xcc, 0xdf, 0x17, 0x23, 0x4d, 0x19, 0x08, 0xf3, 0xab, 0x70,
-		0x7a, 0xe8, 0x32, 0x31, 0x01, 0xd8, 0x3f, 0xe0, 0x60, 0x27, 0x87, 0x2c, 0x15, 0xdc, 0x24, 0x1c,
-		0x17, 0xf2, 0x1f, 0x0a, 0x93, 0x80, 0xfb, 0xb0, 0xb6, 0x49, 0xd0, 0xe2, 0x68, 0x7b, 0xc7, 0x93,
-		0xda, 0x3f, 0x12, 0x52, 0x63, 0xbc, 0x21, 0xa9, 0x35, 0xe0, 0x24, 0x47, 0x3c, 0xde, 0xb8, 0xfe,
-		0x84, 0x30, 0xac, 0x8c, 0x7b, 0x27, 0x3c, 0xba, 0x5f, 0x03, 0x45, 0x4f, 0x9c, 0xc2, 0x3b, 0x76,
-		0xd4, 0x8e, 0xd6, 0x9d, 0x00, 0xf9, 0x27, 0x39, 0xb2, 0xb0, 0xf8, 0x9e, 0x7b, 0xed, 0x6c, 0x68,
-		0x5d, 0x02, 0xfe, 0x0a, 0xcc, 0x0b, 0xf0, 0x9e, 0x69, 0x63, 0xdd, 0x6a, 0x99, 0xc6, 0x1b, 0xb8,
-		0x39, 0x01, 0xf4, 0x4f, 0xf5, 0x0d, 0xd5, 0x4e, 0x80, 0x9d, 0x20, 0xd7, 0x40, 0xf2, 0x7c, 0x15,
-		0xd5, 0xe8, 0x74, 0x2d, 0xdb, 0x1d, 0x83, 0xf8, 0xd3, 0x62, 0xa4, 0x3c, 0xbe, 0x1a, 0x65, 0x5b,
-		0xa9, 0x02, 0x3b, 0xfd, 0x31, 0xa9, 0x4a, 0x7e, 0x96, 0x03, 0xe5, 0x7c, 0x2e, 0x6e, 0x38, 0x74,
-		0xab, 0xd3, 0xd5, 0xec, 0x49, 0xec, 0xdf, 0x3f, 0x16, 0x86, 0x83, 0xb3, 0x70, 0xc3, 0x41, 0x3c,
-		0x3a, 0xb2, 0xda, 0x4f, 0x80, 0xf0, 0x33, 0xc2, 0x70, 0x08, 0x1e, 0x0e, 0x21, 0x1c, 0x86, 0x09,
-		0x20, 0x3e, 0x27, 0x20, 0x04, 0x0f, 0x81, 0x78, 0xc9, 0x5f, 0x68, 0x6d, 0xdc, 0x32, 0x1c, 0xd7,
-		0x66, 0x2e, 0xf9, 0xd1, 0x50, 0x3f, 0xfb, 0xe5, 0xb0, 0x13, 0xa6, 0x04, 0x58, 0x89, 0x25, 0xe2,
-		0x5b, 0x1f, 0x34, 0x64, 0x1b, 0xdf, 0xb0, 0x9f, 0x13, 0x96, 0x28, 0xc0, 0x46, 0xda, 0x16, 0xf0,
-		0x10, 0x89, 0xd8, 0x75, 0x12, 0xa8, 0x4c, 0x00, 0xf7, 0x4f, 0xfa, 0x1a, 0x57, 0x17, 0xbc, 0x04,
-		0x33, 0xe0, 0xff, 0xf4, 0xcc, 0x9b, 0xf8, 0x70, 0x22, 0xed, 0xfc, 0xf9, 0x3e, 0xff, 0x67, 0x87,
-		0x71, 0x32, 0x1b, 0x52, 0xe8, 0xf3, 0xa7, 0xd0, 0xb8, 0xb3, 0x7e, 0xf3, 0xdf, 0xf8, 0x0e, 0xef,
-		0x6f, 0xd8, 0x9d, 0x5a, 0x59, 0x27, 0x4a, 0x1e, 0x76, 0x7a, 0xc6, 0x83, 0x7d, 0xf4, 0x1d, 0x4f,
-		0xcf, 0x43, 0x3e, 0xcf, 0xca, 0x55, 0xc8, 0x85, 0x1c, 0x9e, 0xf1, 0x50, 0xdf, 0xcc, 0xa1, 0xb2,
-		0x41, 0x7f, 0x67, 0xe5, 0x12, 0xc4, 0x89, 0xf3, 0x32, 0x9e, 0xfd, 0x5b, 0x38, 0x3b, 0x25, 0x5f,
-		0x79, 0x1e, 0x52, 0xc2, 0x69, 0x19, 0xcf, 0xfa, 0xd7, 0x39, 0xab, 0xc7, 0x42, 0xd8, 0x85, 0xc3,
-		0x32, 0x9e, 0xfd, 0x6f, 0x08, 0x76, 0xc1, 0x42, 0xd8, 0x27, 0x17, 0xe1, 0x2f, 0xfd, 0xcd, 0x38,
-		0x5f, 0x74, 0x84, 0xec, 0xae, 0x40, 0x92, 0x7b, 0x2a, 0xe3, 0xb9, 0xbf, 0x95, 0xbf, 0x5c, 0x70,
-		0xac, 0x3c, 0x03, 0xd3, 0x13, 0x0a, 0xfc, 0x6f, 0x71, 0x56, 0x46, 0xbf, 0x52, 0x81, 0x4c, 0xc0,
-		0x3b, 0x19, 0xcf, 0xfe, 0xed, 0x9c, 0x3d, 0xc8, 0x45, 0x9a, 0xce, 0xbd, 0x93, 0xf1, 0x00, 0xdf,
-		0x21, 0x9a, 0xce, 0x39, 0x88, 0xd8, 0x84, 0x63, 0x32, 0x9e, 0xfb, 0xe3, 0x42, 0xea, 0x82, 0x65,
-		0xe5, 0x05, 0x48, 0x7b, 0x8b, 0xcd, 0x78, 0xfe, 0xef, 0xe4, 0xfc, 0x3e, 0x0f, 0x91, 0x40, 0x60,
-		0xb1, 0x1b, 0x0f, 0xf1, 0x5d, 0x42, 0x02, 0x01, 0x2e, 0x32, 0x8d, 0xfa, 0x1d, 0x98, 0xf1, 0x48,
-		0xdf, 0x2d, 0xa6, 0x51, 0x9f, 0xff, 0x42, 0x46, 0x93, 0xda, 0xfc, 0xf1, 0x10, 0xdf, 0x23, 0x46,
-		0x93, 0xd2, 0x93, 0x66, 0xf4, 0x7b, 0x04, 0xe3, 0x31, 0xbe, 0x57, 0x34, 0xa3, 0xcf, 0x21, 0x58,
-		0xd9, 0x06, 0x34, 0xe8, 0x0d, 0x8c, 0xc7, 0xfb, 0x04, 0xc7, 0x9b, 0x19, 0x70, 0x06, 0x56, 0x5e,
-		0x86, 0x93, 0xc3, 0x3d, 0x81, 0xf1, 0xa8, 0xdf, 0xf7, 0x4e, 0x5f, 0xec, 0x16, 0x74, 0x04, 0x56,
-		0x1a, 0xfe, 0x92, 0x12, 0xf4, 0x02, 0xc6, 0xc3, 0x7e, 0xff, 0x3b, 0x61, 0xc3, 0x1d, 0x74, 0x02,
-		0x56, 0x4a, 0x00, 0xfe, 0x02, 0x3c, 0x1e, 0xeb, 0x93, 0x1c, 0x2b, 0xc0, 0x44, 0xa6, 0x06, 0x5f,
-		0x7f, 0xc7, 0xf3, 0xdf, 0x15, 0x53, 0x83, 0x73, 0x90, 0xa9, 0x21, 0x96, 0xde, 0xf1, 0xdc, 0x3f,
-		0x28, 0xa6, 0x86, 0x60, 0x21, 0x9a, 0x1d, 0x58, 0xdd, 0xc6, 0x23, 0x7c, 0x4a, 0x68, 0x76, 0x80,
-		0x6b, 0x65, 0x13, 0x66, 0x06, 0x16, 0xc4, 0xf1, 0x50, 0x3f, 0xc4, 0xa1, 0xa4, 0xfe, 0xf5, 0x30,
-		0xb8, 0x78, 0xf1, 0xc5, 0x70, 0x3c, 0xda, 0x0f, 0xf7, 0x2d, 0x5e, 0x7c, 0x2d, 0x5c, 0xb9, 0x02,
-		0x29, 0xb3, 0xd7, 0x6e, 0x93, 0xc9, 0x83, 0x8e, 0x3e, 0x9f, 0x3b, 0xff, 0x5f, 0xbe, 0xc2, 0xa5,
-		0x23, 0x18, 0x56, 0x2e, 0xc1, 0x34, 0xee, 0xec, 0xe2, 0xe6, 0x38, 0xce, 0x2f, 0x7d, 0x45, 0x18,
-		0x4c, 0x42, 0xbd, 0xf2, 0x02, 0x00, 0x4b, 0x8d, 0xd0, 0x8d, 0xf3, 0x31, 0xbc, 0xbf, 0xf7, 0x15,
-		0x7e, 0x20, 0xce, 0x67, 0xf1, 0x01, 0xd8, 0xf1, 0xba, 0xa3, 0x01, 0xbe, 0x1c, 0x06, 0xa0, 0x23,
-		0xf2, 0x1c, 0x24, 0x5f, 0x77, 0x2c, 0xd3, 0xd5, 0x5a, 0xe3, 0xb8, 0x7f, 0x9f, 0x73, 0x0b, 0x7a,
-		0x22, 0xb0, 0x8e, 0x65, 0x63, 0x57, 0x6b, 0x39, 0xe3, 0x78, 0xff, 0x2b, 0xe7, 0xf5, 0x18, 0x08,
-		0xb3, 0xae, 0x39, 0xee, 0x24, 0xfd, 0xfe, 0x03, 0xc1, 0x2c, 0x18, 0x48, 0xa3, 0xc9, 0xef, 0x9b,
-		0xf8, 0x70, 0x1c, 0xef, 0x1f, 0x8a, 0x46, 0x73, 0xfa, 0x95, 0xe7, 0x21, 0x4d, 0x7e, 0xb2, 0x53,
-		0xae, 0x63, 0x98, 0xff, 0x88, 0x33, 0xfb, 0x1c, 0xe4, 0xcd, 0x8e, 0xdb, 0x74, 0x8d, 0xf1, 0xc2,
-		0xfe, 0x63, 0x3e, 0xd2, 0x82, 0x7e, 0xa5, 0x04, 0x19, 0xc7, 0x6d, 0x36, 0x7b, 0xdc, 0x3f, 0x1d,
-		0xc3, 0xfe, 0x27, 0x5f, 0xf1, 0x52, 0x16, 0x1e, 0x0f, 0x19, 0xed, 0xdb, 0x37, 0xdd, 0xae, 0x45,
-		0xf7, 0x5b, 0xc6, 0x21, 0xbc, 0xc3, 0x11, 0x02, 0x2c, 0x2b, 0x15, 0xc8, 0x92, 0xbe, 0xd8, 0xb8,
-		0x8b, 0xe9, 0xe6, 0xd8, 0x18, 0x88, 0x3f, 0xe5, 0x02, 0x08, 0x31, 0x95, 0xbf, 0xe1, 0x57, 0xde,
-		0x5e, 0x88, 0x7c, 0xfe, 0xed, 0x85, 0xc8, 0x6f, 0xbe, 0xbd, 0x10, 0xf9, 0xf8, 0x17, 0x16, 0xa6,
-		0x3e, 0xff, 0x85, 0x85, 0xa9, 0xdf, 0xf8, 0xc2, 0xc2, 0xd4, 0xf0, 0x2c, 0x31, 0xac, 0x59, 0x6b,
-		0x16, 0xcb, 0x0f, 0xbf, 0xf6, 0x50, 0xcb, 0x70, 0xf7, 0x7b, 0xbb, 0xcb, 0xba, 0xd5, 0xb9, 0xa0,
-		0x5b, 0x4e, 0xc7, 0x72, 0x2e, 0x84, 0xf3, 0xba, 0xf4, 0x17, 0xfc, 0xaf, 0x08, 0x89, 0x99, 0xc3,
-		0xe9, 0x5c, 0xcd, 0x3c, 0x1c, 0xf5, 0x31, 0xdd, 0x65, 0x88, 0x95, 0xcc, 0x43, 0x74, 0x9a, 0x19,
-		0x38, 0xb5, 0x67, 0xb7, 0xf9, 0x51, 0xcb, 0x24, 0x79, 0xde, 0xb1, 0xdb, 0x68, 0xce, 0x3f, 0x0f,
-		0x1d, 0x39, 0x97, 0xe5, 0x87, 0x9c, 0xcb, 0xdf, 0x1e, 0x39, 0x5e, 0x4f, 0x52, 0x25, 0xf3, 0x90,
-		0x76, 0x64, 0x3b, 0xf2, 0xda, 0x63, 0x63, 0xf3, 0xdc, 0x37, 0x4d, 0xeb, 0xb6, 0x49, 0x9a, 0xdd,
-		0xdd, 0x15, 0x39, 0xee, 0x85, 0xfe, 0x1c, 0xf7, 0xcb, 0xb8, 0xdd, 0x7e, 0x91, 0xd0, 0x35, 0x08,
-		0xcb, 0x6e, 0x82, 0x9d, 0xea, 0x87, 0xef, 0x8e, 0xc2, 0xc2, 0x40, 0x3a, 0x9b, 0x2b, 0xc1, 0x28,
-		0x21, 0xac, 0x40, 0x6a, 0x55, 0xe8, 0xd6, 0x3c, 0x24, 0x1d, 0xac, 0x5b, 0x66, 0xd3, 0xa1, 0x82,
-		0x88, 0x29, 0xe2, 0x91, 0x08, 0xc2, 0xd4, 0x4c, 0xcb, 0xe1, 0x87, 0x95, 0xd9, 0x43, 0xf9, 0x07,
-		0x8e, 0x29, 0x88, 0x9c, 0x78, 0x93, 0x90, 0xc6, 0x93, 0x13, 0x4a, 0x43, 0x74, 0x22, 0x94, 0xf9,
-		0x9f, 0x54, 0x2a, 0xdf, 0x1b, 0x85, 0xc5, 0x7e, 0xa9, 0x90, 0x99, 0xe5, 0xb8, 0x5a, 0xa7, 0x3b,
-		0x4a, 0x2c, 0x57, 0x20, 0xdd, 0x10, 0x34, 0xc7, 0x96, 0xcb, 0xdd, 0x63, 0xca, 0x25, 0xef, 0xbd,
-		0x4a, 0x08, 0xe6, 0xe2, 0x84, 0x82, 0xf1, 0xfa, 0x71, 0x4f, 0x92, 0xf9, 0xb3, 0x04, 0x9c, 0x66,
-		0xd3, 0x49, 0x65, 0x53, 0x89, 0x3d, 0x70, 0x99, 0x64, 0x83, 0x55, 0xe3, 0xf7, 0x49, 0xe4, 0x17,
-		0x61, 0xb6, 0x46, 0xac, 0x05, 0x89, 0x82, 0xfc, 0x1d, 0x9e, 0xa1, 0xe7, 0xb9, 0x97, 0x42, 0x0e,
-		0x3f, 0xdf, 0xdf, 0x0a, 0x16, 0xc9, 0xdf, 0x18, 0x01, 0xa9, 0xae, 0x6b, 0x6d, 0xcd, 0xfe, 0x8b,
-		0x42, 0xa1, 0x67, 0x00, 0xd8, 0x71, 0x0f, 0xef, 0xc3, 0xbd, 0xfc, 0xc5, 0xf9, 0xe5, 0x60, 0xe7,
-		0x96, 0xd9, 0x9b, 0xe8, 0x09, 0xaa, 0x34, 0xa5, 0x25, 0x3f, 0xcf, 0xbf, 0x02, 0xe0, 0x57, 0xa0,
-		0x33, 0x70, 0xaa, 0x5e, 0x29, 0xad, 0x97, 0x14, 0x71, 0x48, 0xa8, 0xbe, 0x5d, 0xad, 0xd4, 0xae,
-		0xd6, 0xaa, 0xab, 0xd2, 0x14, 0x3a, 0x09, 0x28, 0x58, 0xe9, 0x1d, 0x6a, 0x3a, 0x01, 0x33, 0xc1,
-		0x72, 0xf6, 0x95, 0x4a, 0x94, 0x78, 0x8a, 0x46, 0xa7, 0xdb, 0xc6, 0x74, 0xe7, 0x51, 0x35, 0x84,
-		0xd4, 0xc6, 0x3b, 0x21, 0xbf, 0xfa, 0xef, 0xd9, 0x97, 0x0b, 0xb3, 0x3e, 0xbb, 0x27, 0xf3, 0x95,
-		0x75, 0x98, 0xd1, 0x74, 0x1d, 0x77, 0x43, 0x90, 0x63, 0x4c, 0x35, 0x01, 0xa4, 0x7b, 0xa9, 0x9c,
-		0xd3, 0x47, 0x7b, 0x06, 0x12, 0x0e, 0xed, 0xfd, 0x38, 0x88, 0x5f, 0xe3, 0x10, 0x9c, 0x7c, 0xc5,
-		0x84, 0x19, 0xe2, 0xf9, 0x69, 0x36, 0x0e, 0x34, 0xe3, 0xe8, 0x3c, 0xc3, 0x2f, 0xfc, 0xf4, 0x13,
-		0x74, 0x67, 0xf5, 0x81, 0xf0, 0xb0, 0x0c, 0x51, 0x27, 0x45, 0xe2, 0xd8, 0x7e, 0x43, 0x31, 0xe4,
-		0xc5, 0xfb, 0x78, 0x83, 0x8f, 0x7e, 0xd9, 0x2f, 0xf2, 0x97, 0x2d, 0x0c, 0xd3, 0x81, 0xc0, 0x9b,
-		0x72, 0x1c, 0x95, 0x55, 0x94, 0xab, 0xa3, 0xe6, 0xf4, 0x6b, 0x8f, 0x0e, 0xae, 0x4e, 0xec, 0xbf,
-		0xc7, 0x29, 0xf2, 0x95, 0xe0, 0x6b, 0xbc, 0xb9, 0xf7, 0x03, 0x71, 0x98, 0xd1, 0x3a, 0x86, 0x69,
-		0x5d, 0xa0, 0xff, 0xf2, 0x39, 0x37, 0x4d, 0x1f, 0x26, 0xd8, 0x94, 0xbc, 0xcc, 0xa6, 0xc2, 0x78,
-		0x8d, 0xf9, 0xa3, 0x6f, 0xfb, 0xd1, 0x69, 0x7f, 0xba, 0xac, 0x6c, 0x80, 0x24, 0x0e, 0xf1, 0x62,
-		0x53, 0xb7, 0x9a, 0x13, 0x65, 0x29, 0xfe, 0x58, 0x60, 0x88, 0xfc, 0x56, 0x95, 0xb3, 0xae, 0x7c,
-		0x10, 0x52, 0x1e, 0xcc, 0x38, 0xcf, 0x44, 0x80, 0x78, 0x1c, 0xc4, 0x2f, 0x61, 0x33, 0x73, 0x12,
-		0x2f, 0xf4, 0x1d, 0xc1, 0xcf, 0x66, 0xe8, 0x26, 0xe9, 0xcd, 0x1a, 0xe4, 0x9b, 0x96, 0xe9, 0xaa,
-		0x56, 0xc7, 0x70, 0x71, 0xa7, 0xeb, 0x8e, 0xf5, 0xeb, 0xfe, 0x94, 0x81, 0xa4, 0x94, 0x1c, 0xe1,
-		0xdb, 0x12, 0x6c, 0xa4, 0x25, 0xec, 0x3b, 0xbd, 0x49, 0x5a, 0xf2, 0xdf, 0xbc, 0x96, 0x50, 0x1e,
-		0xd2, 0x92, 0x7b, 0xd2, 0x0e, 0xa7, 0x79, 0x93, 0x1b, 0x73, 0xf7, 0x80, 0x69, 0x81, 0xa7, 0x1d,
-		0x7f, 0x10, 0x83, 0x05, 0x4e, 0xbc, 0xab, 0x39, 0xf8, 0xc2, 0xad, 0x27, 0x77, 0xb1, 0xab, 0x3d,
-		0x79, 0x41, 0xb7, 0x0c, 0xb1, 0x92, 0xcf, 0x72, 0x63, 0x4d, 0xea, 0x97, 0x79, 0x7d, 0x71, 0xe8,
-		0x76, 0x77, 0x71, 0xb4, 0x91, 0x2f, 0x0e, 0xea, 0xa0, 0xfc, 0x1a, 0xc4, 0x2b, 0x96, 0x61, 0x92,
-		0xb5, 0xad, 0x89, 0x4d, 0xab, 0xc3, 0xcd, 0x2d, 0x7b, 0x40, 0x97, 0x21, 0xa1, 0x75, 0xac, 0x9e,
-		0xe9, 0x32, 0x53, 0x5b, 0x5e, 0xf8, 0x95, 0xb7, 0x16, 0xa7, 0xfe, 0xe3, 0x5b, 0x8b, 0xb1, 0x9a,
-		0xe9, 0xfe, 0xfa, 0x67, 0x1f, 0x07, 0x8e, 0x5e, 0x33, 0xdd, 0xcf, 0xfc, 0xee, 0x4f, 0x9c, 0x8f,
-		0x28, 0x9c, 0x7a, 0x25, 0xfe, 0xc5, 0x37, 0x17, 0x23, 0xf2, 0x2b, 0x90, 0x5c, 0xc5, 0xfa, 0x11,
-		0xf0, 0x4f, 0xf6, 0xc1, 0x9f, 0x16, 0xf0, 0xab, 0x58, 0x0f, 0xc0, 0xaf, 0x62, 0xbd, 0x0f, 0xf9,
-		0x19, 0x48, 0xd5, 0x4c, 0x97, 0x7d, 0x42, 0xf4, 0x28, 0xc4, 0x0c, 0x93, 0x9d, 0x4a, 0x0f, 0x20,
-		0x0c, 0x34, 0x50, 0x21, 0x54, 0x84, 0x71, 0x15, 0xeb, 0x1e, 0x63, 0x13, 0xeb, 0xfd, 0x8c, 0x83,
-		0xaf, 0x26, 0x54, 0xe5, 0xd5, 0xdf, 0xf8, 0xad, 0x85, 0xa9, 0x8f, 0xbc, 0xbd, 0x30, 0x35, 0x72,
-		0xe8, 0xe5, 0xf1, 0x43, 0xef, 0x8d, 0xf8, 0x8f, 0xc6, 0xe1, 0x7e, 0xfa, 0x65, 0xa9, 0xdd, 0x31,
-		0x4c, 0xf7, 0x82, 0x6e, 0x1f, 0x76, 0x5d, 0x8b, 0x18, 0x00, 0x6b, 0x8f, 0x0f, 0xf8, 0x8c, 0x5f,
-		0xbd, 0xcc, 0xaa, 0x87, 0x0f, 0xb7, 0xbc, 0x07, 0xd3, 0xdb, 0x84, 0x8f, 0x88, 0xd8, 0xb5, 0x5c,
-		0xad, 0xcd, 0xbd, 0x16, 0xf6, 0x40, 0x4a, 0xd9, 0xd7, 0xa8, 0x51, 0x56, 0x6a, 0x88, 0x0f, 0x51,
-		0xdb, 0x58, 0xdb, 0x63, 0x1f, 0xf5, 0xc4, 0xa8, 0xbb, 0x9b, 0x22, 0x05, 0xf4, 0xfb, 0x9d, 0x39,
-		0x98, 0xd6, 0x7a, 0xec, 0xe0, 0x4d, 0x8c, 0xf8, 0xc1, 0xf4, 0x41, 0x7e, 0x11, 0x92, 0x7c, 0xff,
-		0x1d, 0x49, 0x10, 0xbb, 0x89, 0x0f, 0xe9, 0x7b, 0xb2, 0x0a, 0xf9, 0x89, 0x96, 0x61, 0x9a, 0x36,
-		0x9e, 0x7f, 0xad, 0x38, 0xbf, 0x3c, 0xd0, 0xfa, 0x65, 0xda, 0x48, 0x85, 0x91, 0xc9, 0xd7, 0x21,
-		0xb5, 0x6a, 0x11, 0x2d, 0x0c, 0xa3, 0xa5, 0x19, 0x1a, 0x6d, 0x73, 0xb7, 0xc7, 0xb5, 0x42, 0x61,
-		0x0f, 0xe8, 0x24, 0x24, 0xd8, 0x47, 0x5e, 0xfc, 0xf0, 0x10, 0x7f, 0x92, 0x2b, 0x90, 0xa4, 0xd8,
-		0x5b, 0x5d, 0xe2, 0x32, 0x78, 0xe7, 0xde, 0xd3, 0xfc, 0x93, 0x5f, 0x0e, 0x1f, 0xf5, 0x1b, 0x8b,
-		0x20, 0xde, 0xd4, 0x5c, 0x8d, 0xf7, 0x9b, 0xfe, 0x96, 0x3f, 0x04, 0x29, 0x0e, 0xe2, 0xa0, 0x8b,
-		0x10, 0xb3, 0xba, 0x0e, 0x3f, 0xfe, 0x53, 0x1c, 0xd5, 0x95, 0xad, 0x6e, 0x39, 0x4e, 0x74, 0x46,
-		0x21, 0xc4, 0xe5, 0xcd, 0x91, 0x6a, 0xf1, 0x74, 0x48, 0x2d, 0x3a, 0xd8, 0xdd, 0xdd, 0x73, 0xfd,
-		0x1f, 0x6c, 0x38, 0x07, 0x54, 0xc1, 0x53, 0x94, 0xbb, 0x51, 0x58, 0x08, 0xd4, 0xde, 0xc2, 0xb6,
-		0x63, 0x58, 0x26, 0xd3, 0x26, 0xae, 0x29, 0x28, 0xd0, 0x40, 0x5e, 0x3f, 0x42, 0x55, 0x9e, 0x87,
-		0x58, 0xa9, 0xdb, 0x45, 0x45, 0x48, 0xd1, 0x67, 0xdd, 0x62, 0xba, 0x12, 0x57, 0xbc, 0x67, 0x52,
-		0xe7, 0x58, 0x7b, 0xee, 0x6d, 0xcd, 0xf6, 0xbe, 0x81, 0x16, 0xcf, 0xf2, 0x73, 0x90, 0xae, 0x58,
-		0xa6, 0x83, 0x4d, 0xa7, 0x47, 0x7d, 0xe1, 0xdd, 0xb6, 0xa5, 0xdf, 0xe4, 0x08, 0xec, 0x81, 0x08,
-		0x5b, 0xeb, 0x76, 0x29, 0x67, 0x5c, 0x21, 0x3f, 0xd9, 0x7c, 0x2d, 0x6f, 0x8d, 0x14, 0xcf, 0xa5,
-		0xe3, 0x89, 0x87, 0x77, 0xd0, 0x77, 0x6a, 0x23, 0x70, 0xdf, 0xe0, 0x44, 0xba, 0x89, 0x0f, 0x9d,
-		0xe3, 0xce, 0xa3, 0x57, 0x20, 0xbd, 0x4d, 0xaf, 0x27, 0x79, 0x11, 0x1f, 0xa2, 0x22, 0x24, 0x71,
-		0xf3, 0xe2, 0xa5, 0x4b, 0x4f, 0x3e, 0xc7, 0xb4, 0xfc, 0xda, 0x94, 0x22, 0x0a, 0xd0, 0x02, 0xa4,
-		0x1d, 0xac, 0x77, 0x2f, 0x5e, 0xba, 0x7c, 0xf3, 0x49, 0xa6, 0x56, 0xd7, 0xa6, 0x14, 0xbf, 0x68,
-		0x25, 0x45, 0x7a, 0xfc, 0xc5, 0x4f, 0x2d, 0x46, 0xca, 0xd3, 0x10, 0x73, 0x7a, 0x9d, 0xf7, 0x4c,
-		0x37, 0xfe, 0x30, 0x01, 0x4b, 0x81, 0x5a, 0xb6, 0xb8, 0xdc, 0xd2, 0xda, 0x46, 0x53, 0xf3, 0x2f,
-		0x95, 0x91, 0x02, 0xfd, 0xa7, 0x14, 0x23, 0x56, 0x8d, 0x23, 0xa5, 0x28, 0xff, 0x54, 0x04, 0xb2,
-		0x37, 0x04, 0x72, 0x1d, 0xbb, 0xe8, 0x0a, 0x80, 0xf7, 0x26, 0x31, 0x55, 0xce, 0x2c, 0xf7, 0xbf,
-		0x6b, 0xd9, 0xe3, 0x51, 0x02, 0xe4, 0xe8, 0x19, 0xaa, 0x80, 0x5d, 0xcb, 0xe1, 0xdf, 0xc3, 0x8e,
-		0x61, 0xf5, 0x88, 0xd1, 0x63, 0x80, 0xa8, 0x55, 0x53, 0x6f, 0x59, 0xae, 0x61, 0xb6, 0xd4, 0xae,
-		0x75, 0x9b, 0xdf, 0x32, 0x10, 0x53, 0x24, 0x5a, 0x73, 0x83, 0x56, 0x6c, 0x93, 0x72, 0xd2, 0xe8,
-		0xb4, 0x87, 0x42, 0xc2, 0x3a, 0xad, 0xd9, 0xb4, 0xb1, 0xe3, 0x70, 0xc3, 0x25, 0x1e, 0xd1, 0x15,
-		0x48, 0x76, 0x7b, 0xbb, 0xaa, 0xb0, 0x12, 0x99, 0x8b, 0xf7, 0x0d, 0x9b, 0xf3, 0x42, 0x37, 0xf8,
-		0xac, 0x4f, 0x74, 0x7b, 0xbb, 0x44, 0x53, 0x1e, 0x80, 0xec, 0x90, 0xc6, 0x64, 0x6e, 0xf9, 0xed,
-		0xa0, 0x37, 0xe2, 0xf0, 0x1e, 0xa8, 0x5d, 0xdb, 0xb0, 0x6c, 0xc3, 0x3d, 0xa4, 0xc7, 0xf6, 0x62,
-		0x8a, 0x24, 0x2a, 0xb6, 0x79, 0xb9, 0x7c, 0x13, 0x0a, 0x75, 0xea, 0xee, 0xfb, 0x2d, 0xbf, 0xe4,
-		0xb7, 0x2f, 0x32, 0xbe, 0x7d, 0x23, 0x5b, 0x16, 0x1d, 0x68, 0xd9, 0xf9, 0xff, 0x10, 0x81, 0x4c,
-		0x99, 0x4c, 0xdc, 0xda, 0xea, 0xd5, 0xb6, 0xd6, 0x42, 0x4f, 0xc2, 0x89, 0xf2, 0xfa, 0x56, 0xe5,
-		0x45, 0xb5, 0xb6, 0xaa, 0x5e, 0x5d, 0x2f, 0xad, 0xf9, 0xe7, 0x84, 0x8b, 0x27, 0xef, 0xdc, 0x5d,
-		0x42, 0x01, 0xda, 0x1d, 0x93, 0xc6, 0xa4, 0xe8, 0x02, 0xcc, 0x85, 0x59, 0x4a, 0xe5, 0x7a, 0x75,
-		0xb3, 0x21, 0x45, 0x8a, 0x27, 0xee, 0xdc, 0x5d, 0x9a, 0x09, 0x70, 0x94, 0x76, 0x1d, 0x6c, 0xba,
-		0x83, 0x0c, 0x95, 0xad, 0x8d, 0x8d, 0x5a, 0x43, 0x8a, 0x0e, 0x30, 0x54, 0xac, 0x4e, 0xc7, 0x70,
-		0xd1, 0x23, 0x30, 0x13, 0x66, 0xd8, 0xac, 0xad, 0x4b, 0xb1, 0x22, 0xba, 0x73, 0x77, 0x29, 0x1f,
-		0xa0, 0xde, 0x34, 0xda, 0xc5, 0xd4, 0xc7, 0x7e, 0x78, 0x61, 0xea, 0x33, 0x7f, 0x77, 0x21, 0x52,
-		0xde, 0x18, 0x39, 0xe7, 0x9e, 0x3a, 0xde, 0x9c, 0x0b, 0xaf, 0xdb, 0x5f, 0xb9, 0x2f, 0x64, 0x6e,
-		0xb8, 0x3f, 0x17, 0x30, 0xc6, 0x93, 0x4e, 0xb7, 0x71, 0x39, 0x8a, 0xe2, 0xd1, 0xee, 0x41, 0x71,
-		0xcc, 0xa2, 0x50, 0x1c, 0x6b, 0x18, 0xe4, 0xe7, 0x20, 0xb7, 0xad, 0xd9, 0x6e, 0x1d, 0xbb, 0xd7,
-		0xb0, 0xd6, 0xc4, 0x76, 0xd8, 0x7f, 0xc8, 0x09, 0xff, 0x01, 0x41, 0x9c, 0x3a, 0x09, 0x6c, 0xfd,
-		0xa4, 0xbf, 0xe5, 0x7d, 0x88, 0xd3, 0x13, 0xc9, 0x9e, 0x6f, 0xc1, 0x39, 0x98, 0x6f, 0x41, 0x56,
-		0x86, 0x43, 0x17, 0x3b, 0x22, 0x8d, 0x46, 0x1f, 0xd0, 0xd3, 0xc2, 0x43, 0x88, 0x1d, 0xed, 0x21,
-		0xf0, 0xe9, 0xc5, 0xfd, 0x84, 0x36, 0x24, 0xf9, 0x10, 0x7b, 0x0d, 0x89, 0xf8, 0x0d, 0x41, 0x1b,
-		0x50, 0xe8, 0x6a, 0xb6, 0x4b, 0xbf, 0x50, 0xdc, 0xa7, 0xbd, 0xe0, 0x33, 0x78, 0x71, 0xd0, 0x9e,
-		0x84, 0x3a, 0xcb, 0xdf, 0x92, 0xeb, 0x06, 0x0b, 0xe5, 0xdf, 0x89, 0x43, 0x82, 0x0b, 0xe3, 0x79,
-		0x48, 0x72, 0xb1, 0xf2, 0x39, 0x77, 0xff, 0xf2, 0xe0, 0x32, 0xbb, 0xec, 0x2d, 0x87, 0x1c, 0x4f,
-		0xf0, 0xa0, 0x87, 0x21, 0xa5, 0xef, 0x6b, 0x86, 0xa9, 0x1a, 0x4d, 0xee, 0xda, 0x66, 0xde, 0x7e,
-		0x6b, 0x31, 0x59, 0x21, 0x65, 0xb5, 0x55, 0x25, 0x49, 0x2b, 0x6b, 0x4d, 0xe2, 0xd3, 0xec, 0x63,
-		0xa3, 0xb5, 0xef, 0x72, 0xbb, 0xc1, 0x9f, 0xd0, 0xb3, 0x10, 0x27, 0x0a, 0xc1, 0xbf, 0x5f, 0x2f,
-		0x0e, 0x44, 0x28, 0x5e, 0x0a, 0xa9, 0x9c, 0x22, 0x2f, 0xfe, 0xf8, 0x7f, 0x5e, 0x8c, 0x28, 0x94,
-		0x03, 0x55, 0x20, 0xd7, 0xd6, 0x1c, 0x57, 0xa5, 0xeb, 0x31, 0x79, 0xfd, 0x34, 0x85, 0x38, 0x3d,
-		0x28, 0x10, 0x2e, 0x58, 0xde, 0xf4, 0x0c, 0xe1, 0x62, 0x45, 0x4d, 0x74, 0x0e, 0x24, 0x0a, 0xa2,
-		0xd3, 0x19, 0xc8, 0xbc, 0xc4, 0x04, 0x95, 0x7b, 0x9e, 0x94, 0xb3, 0x89, 0x49, 0x7d, 0xc5, 0x33,
-		0x90, 0xa6, 0x5f, 0xcc, 0x52, 0x12, 0x76, 0x0c, 0x3e, 0x45, 0x0a, 0x68, 0xe5, 0x59, 0x28, 0xf8,
-		0x56, 0x9f, 0x91, 0xa4, 0x18, 0x8a, 0x5f, 0x4c, 0x09, 0x9f, 0x80, 0x39, 0x13, 0x1f, 0xd0, 0x83,
-		0xf9, 0x21, 0xea, 0x34, 0xa5, 0x46, 0xa4, 0xee, 0x46, 0x98, 0xe3, 0x21, 0xc8, 0xeb, 0x42, 0xf8,
-		0x8c, 0x16, 0x28, 0x6d, 0xce, 0x2b, 0xa5, 0x64, 0xa7, 0x21, 0xa5, 0x75, 0xbb, 0x8c, 0x20, 0xc3,
-		0xad, 0x7e, 0xb7, 0x4b, 0xab, 0xce, 0xc3, 0x0c, 0xed, 0xa3, 0x8d, 0x9d, 0x5e, 0xdb, 0xe5, 0x20,
-		0x59, 0x4a, 0x53, 0x20, 0x15, 0x0a, 0x2b, 0xa7, 0xb4, 0x0f, 0x42, 0x0e, 0xdf, 0x32, 0x9a, 0xd8,
-		0xd4, 0x31, 0xa3, 0xcb, 0x51, 0xba, 0xac, 0x28, 0xa4, 0x44, 0x8f, 0x80, 0x67, 0xcd, 0x55, 0xb1,
-		0xd2, 0xe4, 0x19, 0x9e, 0x28, 0x2f, 0xb1, 0x62, 0x79, 0x1e, 0xe2, 0xab, 0x9a, 0xab, 0x11, 0x77,
-		0xc9, 0x3d, 0x60, 0xcb, 0x67, 0x56, 0x21, 0x3f, 0xe5, 0xcf, 0xc5, 0x20, 0x7e, 0xc3, 0x72, 0x31,
-		0x7a, 0x2a, 0xe0, 0xca, 0xe6, 0x87, 0xe9, 0x73, 0xdd, 0x68, 0x99, 0xb8, 0xb9, 0xe1, 0xb4, 0x02,
-		0xd7, 0xdb, 0xf8, 0xea, 0x14, 0x0d, 0xa9, 0xd3, 0x1c, 0x4c, 0xdb, 0x56, 0xcf, 0x6c, 0x8a, 0x03,
-		0xe4, 0xf4, 0x01, 0x55, 0x21, 0xe5, 0x69, 0x49, 0x7c, 0x9c, 0x96, 0x14, 0x88, 0x96, 0x10, 0x1d,
-		0xe6, 0x05, 0x4a, 0x72, 0x97, 0x2b, 0x4b, 0x19, 0xd2, 0x9e, 0xf1, 0xe2, 0xda, 0x36, 0x99, 0xc2,
-		0xfa, 0x6c, 0x64, 0x89, 0xf4, 0xc6, 0xde, 0x13, 0x1e, 0xd3, 0x38, 0xc9, 0xab, 0xe0, 0xd2, 0x0b,
-		0xa9, 0x15, 0xbf, 0x6a, 0x27, 0x49, 0xfb, 0xe5, 0xab, 0x15, 0xbb, 0x6e, 0xe7, 0x3e, 0x48, 0x3b,
-		0x46, 0xcb, 0xd4, 0xdc, 0x9e, 0x8d, 0xb9, 0xe6, 0xf9, 0x05, 0xa4, 0xd6, 0xff, 0x98, 0x82, 0x69,
-		0x5a, 0xe0, 0x06, 0xb0, 0x0b, 0x30, 0xeb, 0xdf, 0xbd, 0xe5, 0xa3, 0x30, 0x2d, 0x43, 0x5e, 0x55,
-		0x5d, 0xd4, 0xc8, 0xbf, 0x14, 0x81, 0x04, 0x5f, 0xb1, 0xfc, 0x61, 0x88, 0x0c, 0x1f, 0x86, 0xe8,
-		0xa8, 0x61, 0x88, 0xdd, 0xfb, 0x30, 0x94, 0x00, 0xbc, 0x66, 0x3a, 0xfc, 0x42, 0x95, 0x21, 0x6e,
-		0x15, 0x6b, 0x62, 0xdd, 0x68, 0xf1, 0x79, 0x1f, 0x60, 0x92, 0xff, 0x53, 0x84, 0x78, 0xf8, 0xbc,
-		0x1e, 0x95, 0x20, 0x27, 0xda, 0xa5, 0xee, 0xb5, 0xb5, 0x16, 0x57, 0xc5, 0xfb, 0x47, 0x36, 0x8e,
-		0xac, 0xc2, 0x4a, 0x86, 0xb7, 0x87, 0xfa, 0x13, 0x43, 0x87, 0x35, 0x3a, 0x62, 0x58, 0x43, 0x7a,
-		0x14, 0xbb, 0x37, 0x3d, 0x0a, 0x8d, 0x78, 0xbc, 0x6f, 0xc4, 0xe5, 0xdf, 0x8a, 0xf0, 0x9b, 0xbf,
-		0x9a, 0xec, 0x4b, 0x90, 0xbf, 0xac, 0xa1, 0x7a, 0x8d, 0xeb, 0x56, 0x13, 0x37, 0xd5, 0x81, 0x31,
-		0x7b, 0x70, 0x10, 0x31, 0xdc, 0x66, 0x7f, 0xec, 0x90, 0x40, 0xa9, 0xfb, 0x63, 0xf8, 0xd9, 0x28,
-		0xcc, 0x0c, 0xd0, 0xff, 0xdf, 0x37, 0x96, 0xe1, 0xd9, 0x3b, 0x3d, 0xe1, 0xec, 0x4d, 0x8c, 0x9c,
-		0xbd, 0x3f, 0x1d, 0xa5, 0x09, 0x80, 0xae, 0xe5, 0x68, 0xed, 0xf7, 0xc3, 0xf6, 0x9e, 0x81, 0x74,
-		0xd7, 0x6a, 0xab, 0xac, 0x86, 0x7d, 0xc2, 0x93, 0xea, 0x5a, 0x6d, 0x65, 0x40, 0xcd, 0xa6, 0xdf,
-		0x25, 0xc3, 0x9c, 0x78, 0x17, 0x06, 0x21, 0xd9, 0x3f, 0xa1, 0x6c, 0xc8, 0x32, 0x51, 0x70, 0xaf,
-		0xe9, 0x09, 0x22, 0x03, 0xea, 0x86, 0x45, 0x06, 0xbd, 0x3c, 0xd6, 0x6c, 0x46, 0xa9, 0x70, 0x3a,
-		0xc2, 0xc1, 0x9c, 0x8c, 0x61, 0x99, 0xa3, 0xa0, 0xc5, 0x52, 0x38, 0x9d, 0xfc, 0xb7, 0x23, 0x00,
-		0xeb, 0x44, 0xb2, 0xb4, 0xbf, 0xc4, 0xdf, 0x71, 0x68, 0x13, 0xd4, 0xd0, 0x9b, 0x17, 0x46, 0x0d,
-		0x1a, 0x7f, 0x7f, 0xd6, 0x09, 0xb6, 0xbb, 0x02, 0x39, 0x5f, 0xb7, 0x1d, 0x2c, 0x1a, 0xb3, 0x70,
-		0x44, 0x54, 0x5a, 0xc7, 0xae, 0x92, 0xbd, 0x15, 0x78, 0x92, 0xff, 0x59, 0x04, 0xd2, 0xb4, 0x4d,
-		0x1b, 0xd8, 0xd5, 0x42, 0x63, 0x18, 0xb9, 0xf7, 0x31, 0xbc, 0x1f, 0x80, 0xc1, 0x38, 0xc6, 0x1b,
-		0x98, 0x6b, 0x56, 0x9a, 0x96, 0xd4, 0x8d, 0x37, 0x30, 0xba, 0xec, 0x09, 0x3c, 0x76, 0xb4, 0xc0,
-		0x45, 0xd4, 0xca, 0xc5, 0x7e, 0x0a, 0x92, 0xf4, 0x6e, 0xca, 0x03, 0x87, 0x07, 0xa2, 0x09, 0xb3,
-		0xd7, 0x69, 0x1c, 0x38, 0xf2, 0xeb, 0x90, 0x6c, 0x1c, 0xb0, 0x7c, 0xe2, 0x19, 0x48, 0xdb, 0x96,
-		0xc5, 0xbd, 0x3f, 0xe6, 0x75, 0xa7, 0x48, 0x01, 0x75, 0x76, 0x44, 0x0e, 0x2d, 0xea, 0xe7, 0xd0,
-		0xfc, 0x24, 0x60, 0x6c, 0xa2, 0x24, 0x20, 0x89, 0x3e, 0x73, 0xa1, 0x99, 0x84, 0x1e, 0x83, 0x53,
-		0xf5, 0xda, 0xda, 0x66, 0x75, 0x55, 0xdd, 0xa8, 0xaf, 0xf5, 0x7d, 0x9e, 0x5f, 0x2c, 0xdc, 0xb9,
-		0xbb, 0x94, 0xe1, 0x61, 0xe7, 0x28, 0xea, 0x6d, 0xa5, 0x7a, 0x63, 0xab, 0x51, 0x95, 0x22, 0x8c,
-		0x7a, 0xdb, 0xc6, 0xb7, 0x2c, 0x97, 0x5d, 0xfe, 0xfa, 0x04, 0x9c, 0x1e, 0x42, 0xed, 0x05, 0x9f,
-		0x33, 0x77, 0xee, 0x2e, 0xe5, 0xb6, 0x6d, 0xcc, 0xb4, 0x8c, 0x72, 0x2c, 0xc3, 0xfc, 0x20, 0xc7,
-		0xd6, 0xf6, 0x56, 0xbd, 0xb4, 0x2e, 0x2d, 0x15, 0xa5, 0x3b, 0x77, 0x97, 0xb2, 0xc2, 0x64, 0x10,
-		0xfa, 0xf7, 0x3e, 0xfa, 0xfc, 0xd6, 0x54, 0x28, 0x6b, 0xcc, 0xe2, 0xba, 0xae, 0x66, 0x6b, 0x9d,
-		0xe3, 0x86, 0x9f, 0x63, 0x0e, 0x0e, 0xc8, 0x3f, 0x15, 0x85, 0x82, 0x17, 0xdc, 0x6c, 0xd3, 0x37,
-		0xa0, 0xa7, 0x82, 0x19, 0xbf, 0xcc, 0xc8, 0xb5, 0x83, 0x51, 0x8b, 0x84, 0xe0, 0x07, 0x21, 0x25,
-		0x9c, 0x64, 0x3e, 0xa9, 0x96, 0x86, 0xac, 0x6f, 0x9c, 0x82, 0xb3, 0x7a, 0x1c, 0xe8, 0x05, 0x48,
-		0x7b, 0x53, 0xcc, 0xbb, 0x20, 0x6d, 0xf4, 0x9c, 0xe4, 0xfc, 0x3e, 0x0f, 0x7a, 0xce, 0x0f, 0xe3,
-		0xe2, 0xa3, 0x02, 0xc3, 0x1b, 0x8c, 0x80, 0x33, 0x7b, 0x21, 0xdc, 0x13, 0x10, 0xd7, 0x76, 0x75,
-		0x83, 0x1b, 0xe0, 0xfb, 0x06, 0xf9, 0x4a, 0xe5, 0x4a, 0x8d, 0x33, 0x51, 0x4a, 0xb9, 0xc6, 0x93,
-		0x29, 0x5c, 0x5e, 0xf4, 0x3a, 0x9a, 0x03, 0x95, 0xc5, 0xc2, 0xcc, 0xbb, 0x48, 0x75, 0xb4, 0x83,
-		0x32, 0x0d, 0x87, 0x4f, 0x41, 0x92, 0x54, 0xb6, 0xf8, 0x85, 0x07, 0x31, 0x25, 0xd1, 0xd1, 0x0e,
-		0xd6, 0x34, 0xe7, 0x7a, 0x3c, 0x15, 0x93, 0xe2, 0xf2, 0x8f, 0x11, 0x4f, 0x25, 0x24, 0x15, 0xf4,
-		0x28, 0x20, 0xc2, 0xa1, 0xb5, 0xb0, 0x4a, 0xa6, 0x2e, 0x15, 0xaf, 0xc0, 0x2d, 0x74, 0xb4, 0x83,
-		0x52, 0x0b, 0x6f, 0xf6, 0x3a, 0xb4, 0x01, 0x0e, 0xda, 0x00, 0x49, 0x10, 0x8b, 0x91, 0xe5, 0xe2,
-		0x3f, 0x3d, 0x78, 0xc1, 0x2a, 0x27, 0x60, 0x0b, 0xc0, 0x27, 0xc8, 0x02, 0x90, 0x67, 0x78, 0xde,
-		0x51, 0x91, 0x50, 0x57, 0x62, 0xe1, 0xae, 0xc8, 0x2f, 0x40, 0xa1, 0x6f, 0x04, 0x90, 0x0c, 0x39,
-		0x9e, 0xb1, 0xa2, 0xdb, 0xf0, 0x2c, 0xc2, 0x49, 0x2b, 0x19, 0x96, 0x99, 0xa2, 0xc7, 0x12, 0x56,
-		0x52, 0x3f, 0xfb, 0xe6, 0x62, 0x84, 0x6e, 0xe6, 0x3c, 0x0a, 0xb9, 0xd0, 0x18, 0x88, 0x2c, 0x72,
-		0xc4, 0xcf, 0x22, 0xfb, 0xc4, 0xaf, 0x41, 0x96, 0x18, 0x20, 0xdc, 0xe4, 0xb4, 0x0f, 0x43, 0x81,
-		0x19, 0xc8, 0x7e, 0x59, 0x33, 0x87, 0x67, 0x43, 0x08, 0x5c, 0x16, 0x1e, 0x50, 0x58, 0xec, 0x19,
-		0x41, 0xb5, 0xa6, 0x39, 0xf2, 0x16, 0x80, 0x3f, 0xa8, 0xa8, 0x04, 0xf7, 0x13, 0xe3, 0x11, 0x3c,
-		0x36, 0xca, 0xaf, 0xc6, 0x0a, 0x79, 0x8c, 0x45, 0x42, 0xe4, 0x1f, 0x0d, 0x65, 0x17, 0x64, 0x5d,
-		0xa3, 0x14, 0xe5, 0x97, 0x3e, 0xf3, 0xf6, 0x42, 0xe4, 0xbd, 0xb1, 0x05, 0xdf, 0xf5, 0x32, 0x9c,
-		0x09, 0x54, 0x12, 0x05, 0x0c, 0x25, 0xa2, 0x0a, 0x01, 0x7d, 0x25, 0x95, 0xe3, 0x12, 0x4a, 0x47,
-		0xe6, 0x7f, 0x8b, 0x47, 0x9b, 0x9d, 0xf1, 0xd9, 0xa6, 0xf1, 0xf9, 0xae, 0xe1, 0x49, 0xf9, 0x7f,
-		0x9a, 0x86, 0xa4, 0x82, 0x3f, 0xdc, 0xc3, 0x8e, 0x8b, 0x2e, 0x42, 0x1c, 0xeb, 0xfb, 0xd6, 0xb0,
-		0x1c, 0x28, 0xe9, 0xe0, 0x32, 0xa7, 0xab, 0xea, 0xfb, 0xd6, 0xb5, 0x29, 0x85, 0xd2, 0xa2, 0x4b,
-		0x30, 0xbd, 0xd7, 0xee, 0xf1, 0xf4, 0x55, 0x9f, 0xcd, 0x0a, 0x32, 0x5d, 0x25, 0x44, 0xd7, 0xa6,
-		0x14, 0x46, 0x4d, 0x5e, 0x45, 0x6f, 0xc6, 0x8e, 0x1d, 0xfd, 0xaa, 0x9a, 0xb9, 0x47, 0x5f, 0x45,
-		0x68, 0x51, 0x19, 0xc0, 0x30, 0x0d, 0x57, 0xa5, 0xa9, 0x1d, 0x6e, 0x35, 0x1e, 0x18, 0xcd, 0x69,
-		0xb8, 0x34, 0x19, 0x74, 0x6d, 0x4a, 0x49, 0x1b, 0xe2, 0x81, 0x34, 0xf7, 0xc3, 0x3d, 0x6c, 0x1f,
-		0x72, 0x6f, 0x6d, 0x64, 0x73, 0x5f, 0x22, 0x44, 0xa4, 0xb9, 0x94, 0x9a, 0x18, 0x59, 0x76, 0xc9,
-		0x9e, 0x7b, 0xc0, 0xaf, 0x8e, 0x5d, 0x1c, 0xc5, 0x49, 0x6f, 0xda, 0x6b, 0x1c, 0x5c, 0x9b, 0x52,
-		0x92, 0x3a, 0xfb, 0x89, 0x9e, 0xf5, 0x5c, 0xb0, 0xcc, 0xa0, 0xd7, 0x13, 0xe2, 0x65, 0x69, 0x9f,
-		0x29, 0xe1, 0x8a, 0xa1, 0x4d, 0xc8, 0xb7, 0x0d, 0xc7, 0x55, 0x1d, 0x53, 0xeb, 0x3a, 0xfb, 0x96,
-		0xeb, 0xd0, 0xfc, 0x49, 0xe6, 0xe2, 0x43, 0xa3, 0x10, 0xd6, 0x0d, 0xc7, 0xad, 0x0b, 0xe2, 0x6b,
-		0x53, 0x4a, 0xae, 0x1d, 0x2c, 0x20, 0x78, 0xd6, 0xde, 0x1e, 0xb6, 0x3d, 0x40, 0x9a, 0x67, 0x39,
-		0x02, 0x6f, 0x8b, 0x50, 0x0b, 0x7e, 0x82, 0x67, 0x05, 0x0b, 0xd0, 0xd7, 0xc0, 0x6c, 0xdb, 0xd2,
-		0x9a, 0x1e, 0x9c, 0xaa, 0xef, 0xf7, 0xcc, 0x9b, 0x34, 0x29, 0x93, 0xb9, 0xf8, 0xc8, 0xc8, 0x46,
-		0x5a, 0x5a, 0x53, 0x40, 0x54, 0x08, 0xc3, 0xb5, 0x29, 0x65, 0xa6, 0xdd, 0x5f, 0x88, 0xbe, 0x1e,
-		0xe6, 0xb4, 0x6e, 0xb7, 0x7d, 0xd8, 0x8f, 0x5e, 0xa0, 0xe8, 0xe7, 0x47, 0xa1, 0x97, 0x08, 0x4f,
-		0x3f, 0x3c, 0xd2, 0x06, 0x4a, 0x51, 0x03, 0xa4, 0xae, 0x8d, 0xe9, 0x97, 0x57, 0x5d, 0xee, 0x65,
-		0xd0, 0x7b, 0xa9, 0x32, 0x17, 0xcf, 0x8e, 0xc2, 0xde, 0x66, 0xf4, 0xc2, 0x29, 0xb9, 0x36, 0xa5,
-		0x14, 0xba, 0xe1, 0x22, 0x86, 0x6a, 0xe9, 0x98, 0x5e, 0x9b, 0xc7, 0x51, 0x67, 0xc6, 0xa1, 0x52,
-		0xfa, 0x30, 0x6a, 0xa8, 0x08, 0x55, 0x21, 0xc3, 0x42, 0x51, 0x95, 0x18, 0x43, 0x7a, 0x9b, 0x55,
-		0xe6, 0xa2, 0x3c, 0x72, 0x86, 0x52, 0xd2, 0x1b, 0x96, 0x8b, 0xaf, 0x4d, 0x29, 0x80, 0xbd, 0x27,
-		0xa4, 0xc1, 0x09, 0x7a, 0xb1, 0xd7, 0xa1, 0x1a, 0x36, 0xbc, 0xf3, 0xb3, 0x14, 0xf0, 0xd1, 0x51,
-		0x80, 0x37, 0x28, 0xd3, 0x8d, 0xa0, 0x1d, 0xbe, 0x36, 0xa5, 0xcc, 0xde, 0x1a, 0x2c, 0x26, 0x2a,
-		0xb6, 0x67, 0x98, 0x5a, 0xdb, 0x78, 0x03, 0xb3, 0x25, 0x94, 0x5e, 0x69, 0x79, 0x84, 0x8a, 0x5d,
-		0xe5, 0xd4, 0x74, 0x61, 0x25, 0x2a, 0xb6, 0x17, 0x2c, 0x28, 0x27, 0xf9, 0x99, 0x51, 0xef, 0x8a,
-		0xb6, 0xa4, 0x94, 0x62, 0xd7, 0xb2, 0x5d, 0x8f, 0xa7, 0x40, 0xca, 0xc8, 0x67, 0x21, 0x13, 0x30,
-		0x4c, 0x68, 0x1e, 0x92, 0xfc, 0x54, 0x8d, 0x38, 0x7d, 0xca, 0x1f, 0xe5, 0x3c, 0x64, 0x83, 0xc6,
-		0x48, 0xfe, 0x78, 0xc4, 0xe3, 0xa4, 0x77, 0x53, 0xcc, 0x87, 0x33, 0xd2, 0x69, 0xdf, 0x53, 0x79,
-		0x50, 0x2c, 0x6d, 0xa2, 0x9e, 0x6d, 0xbf, 0x66, 0x69, 0x21, 0x5f, 0x59, 0xd1, 0x22, 0x64, 0xba,
-		0x17, 0xbb, 0x1e, 0x49, 0x8c, 0x92, 0x40, 0xf7, 0x62, 0x57, 0x10, 0x3c, 0x00, 0x59, 0xd2, 0x6f,
-		0x35, 0xe8, 0x2f, 0xa5, 0x95, 0x0c, 0x29, 0xe3, 0x24, 0xf2, 0xbf, 0x89, 0x82, 0xd4, 0x6f, 0xc0,
-		0xbc, 0x54, 0x75, 0xe4, 0xd8, 0xa9, 0xea, 0xd3, 0xfd, 0x49, 0x72, 0x3f, 0x2f, 0xbe, 0x0e, 0x92,
-		0x9f, 0xde, 0x65, 0x4b, 0xcd, 0x68, 0xff, 0xaf, 0xcf, 0x51, 0x55, 0x0a, 0x7a, 0x9f, 0xe7, 0x7a,
-		0x35, 0xb4, 0x59, 0x29, 0xfe, 0x74, 0x44, 0xff, 0x80, 0x7b, 0x4e, 0xcc, 0x4e, 0xb7, 0xa9, 0xb9,
-		0x58, 0xe4, 0xc7, 0x02, 0xfb, 0x96, 0x0f, 0x43, 0x41, 0xeb, 0x76, 0x55, 0xc7, 0xd5, 0x5c, 0xcc,
-		0x7d, 0x0d, 0x96, 0x79, 0xc8, 0x69, 0xdd, 0x6e, 0x9d, 0x94, 0x32, 0x5f, 0xe3, 0x21, 0xc8, 0x13,
-		0x9b, 0x6e, 0x68, 0x6d, 0xe1, 0x2a, 0x24, 0x98, 0x4b, 0xc2, 0x4b, 0x99, 0x77, 0x20, 0x37, 0xbd,
-		0x11, 0xa7, 0xf6, 0xdc, 0x8b, 0xa9, 0x22, 0x81, 0x98, 0x0a, 0xf1, 0x3b, 0x43, 0x98, 0x7c, 0xc4,
-		0x3d, 0x2b, 0xc3, 0x37, 0x0d, 0xe6, 0x68, 0xfc, 0x75, 0x8b, 0x25, 0x4b, 0x52, 0x0a, 0x7b, 0x90,
-		0x15, 0xc8, 0x87, 0x6d, 0x3f, 0xca, 0x43, 0xd4, 0x3d, 0xe0, 0x6f, 0x89, 0xba, 0x07, 0xc4, 0xd3,
-		0xf5, 0x6e, 0xda, 0xcd, 0x0f, 0x59, 0xed, 0x38, 0x9f, 0x9f, 0xeb, 0x90, 0x0b, 0x90, 0x0b, 0xad,
-		0x09, 0xf2, 0x49, 0x98, 0x1b, 0x66, 0xe2, 0xe5, 0x7d, 0xaf, 0x3c, 0x64, 0xaa, 0xd1, 0x25, 0x48,
-		0x79, 0x36, 0x7e, 0x48, 0x74, 0x4c, 0x5f, 0x2b, 0x88, 0x15, 0x8f, 0x34, 0x94, 0xce, 0x8f, 0x86,
-		0xd2, 0xf9, 0xf2, 0x37, 0xc0, 0xfc, 0x28, 0xfb, 0xdd, 0x97, 0xe4, 0x8b, 0x7b, 0x02, 0x3b, 0x09,
-		0x09, 0x7e, 0xa5, 0x65, 0x94, 0x6e, 0x60, 0xf1, 0x27, 0x22, 0x48, 0x66, 0xcb, 0x63, 0x6c, 0x5f,
-		0x8b, 0x3e, 0xc8, 0x2a, 0x9c, 0x1e, 0x69, 0xc3, 0x47, 0x6f, 0x85, 0x31, 0x20, 0xbe, 0x15, 0xa6,
-		0x8b, 0xe6, 0x38, 0xb4, 0xaf, 0xe2, 0x20, 0x0b, 0x7b, 0x92, 0x3f, 0x11, 0x83, 0x93, 0xc3, 0x2d,
-		0x39, 0x5a, 0x82, 0x2c, 0xf1, 0x5b, 0xdd, 0xb0, 0x8b, 0x0b, 0x1d, 0xed, 0xa0, 0xc1, 0xfd, 0x5b,
-		0xbe, 0x95, 0x10, 0xf5, 0xb6, 0x12, 0xd0, 0x0e, 0xcc, 0xb4, 0x2d, 0x5d, 0x6b, 0xab, 0x81, 0xad,
-		0x1c, 0x3e, 0x89, 0x1e, 0x1c, 0x10, 0x76, 0x38, 0x65, 0x48, 0x0c, 0x0e, 0xd7, 0xff, 0x02, 0xc5,
-		0x58, 0xf7, 0x76, 0x7d, 0xd0, 0x2a, 0x64, 0x3a, 0x86, 0xb3, 0x8b, 0xf7, 0xb5, 0x5b, 0x86, 0x65,
-		0xf3, 0xd9, 0x34, 0xa8, 0x34, 0x1b, 0x3e, 0x8d, 0xd8, 0x61, 0x0a, 0xb0, 0x05, 0x86, 0x64, 0x7a,
-		0xe8, 0xc6, 0x57, 0xe2, 0xd8, 0xd6, 0x64, 0xd4, 0x1e, 0x52, 0x72, 0xe4, 0x1e, 0xd2, 0xb0, 0x0d,
-		0x9b, 0xd4, 0xf0, 0x0d, 0x9b, 0x8f, 0x05, 0x87, 0x26, 0xbc, 0xf6, 0x0d, 0xec, 0xe1, 0xa0, 0x3a,
-		0xcc, 0x71, 0xfe, 0x66, 0x48, 0xf6, 0x43, 0x8e, 0x3a, 0xb0, 0xf9, 0xd5, 0x2f, 0x73, 0x24, 0xd8,
-		0x47, 0x8b, 0x3d, 0x76, 0x6f, 0x62, 0x17, 0x9b, 0xa8, 0xf1, 0xc0, 0x26, 0xea, 0xff, 0x61, 0x43,
-		0xf1, 0x02, 0xcc, 0x0c, 0xf8, 0x11, 0x43, 0x37, 0x87, 0x47, 0x24, 0x64, 0xe5, 0x4f, 0x46, 0xa0,
-		0x38, 0xda, 0x71, 0x18, 0x0a, 0x75, 0xac, 0xdc, 0xf7, 0x28, 0xf3, 0xfc, 0x10, 0xe4, 0xfb, 0xdc,
-		0x1a, 0x36, 0x0a, 0xb9, 0x50, 0x00, 0x29, 0x7f, 0x73, 0xcc, 0xb3, 0x99, 0x21, 0xdf, 0x63, 0x88,
-		0xa2, 0xbd, 0x04, 0xb3, 0x4d, 0xac, 0x1b, 0xcd, 0x7b, 0xd5, 0xb3, 0x19, 0xce, 0xfd, 0xff, 0xd4,
-		0x6c, 0x50, 0xcd, 0xbe, 0x07, 0x20, 0xa5, 0x60, 0xa7, 0x4b, 0x5c, 0x09, 0x54, 0x86, 0x34, 0x3e,
-		0xd0, 0x71, 0xd7, 0xf5, 0xcf, 0x03, 0x0c, 0xf3, 0x6e, 0x19, 0x75, 0x55, 0x50, 0x92, 0xd8, 0xce,
-		0x63, 0x43, 0x4f, 0xf1, 0xf0, 0x75, 0x74, 0x24, 0xca, 0xd9, 0x83, 0xf1, 0xeb, 0x65, 0x11, 0xbf,
-		0xc6, 0x46, 0x86, 0x66, 0x8c, 0xab, 0x2f, 0x80, 0x7d, 0x8a, 0x07, 0xb0, 0xf1, 0x31, 0x2f, 0x0b,
-		0x45, 0xb0, 0x95, 0x50, 0x04, 0x9b, 0x18, 0xd3, 0xcd, 0x11, 0x21, 0xec, 0x65, 0x11, 0xc2, 0x26,
-		0xc7, 0xb4, 0xb8, 0x2f, 0x86, 0x7d, 0x3e, 0x10, 0xc3, 0xa6, 0x07, 0x13, 0x85, 0x21, 0xd6, 0x21,
-		0x41, 0xec, 0x73, 0x5e, 0x10, 0x9b, 0x1d, 0x19, 0x00, 0x73, 0xe6, 0xfe, 0x28, 0x76, 0x6b, 0x20,
-		0x8a, 0xcd, 0xf1, 0xbf, 0xed, 0x33, 0x0a, 0x62, 0x4c, 0x18, 0xbb, 0x35, 0x10, 0xc6, 0xe6, 0xc7,
-		0x00, 0x8e, 0x89, 0x63, 0xbf, 0x76, 0x78, 0x1c, 0x3b, 0x3a, 0xd2, 0xe4, 0xcd, 0x9c, 0x2c, 0x90,
-		0x55, 0x47, 0x04, 0xb2, 0xd2, 0xc8, 0xa0, 0x8b, 0xc1, 0x4f, 0x1c, 0xc9, 0xee, 0x0c, 0x89, 0x64,
-		0x59, 0xcc, 0x79, 0x6e, 0x24, 0xf8, 0x04, 0xa1, 0xec, 0xce, 0x90, 0x50, 0x16, 0x8d, 0x85, 0x1d,
-		0x1b, 0xcb, 0x5e, 0x0d, 0xc7, 0xb2, 0xb3, 0x23, 0x1c, 0x26, 0x7f, 0xb6, 0x8f, 0x08, 0x66, 0x77,
-		0x47, 0x05, 0xb3, 0x2c, 0xe0, 0x7c, 0x6c, 0x24, 0xe2, 0x31, 0xa2, 0xd9, 0xad, 0x81, 0x68, 0xf6,
-		0xc4, 0x18, 0x4d, 0x9b, 0x3c, 0x9c, 0x65, 0x77, 0x8d, 0xb3, 0x5b, 0xc6, 0x41, 0xca, 0x5c, 0x8f,
-		0xa7, 0x32, 0x52, 0x56, 0x7e, 0x84, 0xac, 0xbe, 0x7d, 0x76, 0x8e, 0xb8, 0xb9, 0xd8, 0xb6, 0x2d,
-		0x5b, 0x1c, 0xee, 0xa7, 0x0f, 0xf2, 0x39, 0x12, 0xde, 0xf8, 0x36, 0xed, 0x88, 0xd0, 0x97, 0x86,
-		0x13, 0x01, 0x3b, 0x26, 0xff, 0x6c, 0xc4, 0xe7, 0xa5, 0xc1, 0x6f, 0x30, 0x34, 0x4a, 0xf3, 0xd0,
-		0x28, 0x10, 0x10, 0x47, 0xc3, 0x01, 0xf1, 0x22, 0x64, 0x48, 0x98, 0xd0, 0x17, 0xeb, 0x6a, 0x5d,
-		0x2f, 0xd6, 0x15, 0x67, 0x7f, 0x58, 0xd8, 0xcc, 0x97, 0x25, 0xb6, 0x11, 0x56, 0xf0, 0xce, 0x41,
-		0xb1, 0x28, 0x0d, 0x3d, 0x0e, 0xb3, 0x01, 0x5a, 0x2f, 0xfc, 0x60, 0x81, 0x9f, 0xe4, 0x51, 0x97,
-		0x78, 0x1c, 0xf2, 0xcf, 0x23, 0xbe, 0x84, 0xfc, 0x20, 0x79, 0x58, 0x3c, 0x1b, 0x79, 0x97, 0xe2,
-		0xd9, 0xe8, 0x3d, 0xc7, 0xb3, 0xc1, 0x70, 0x2a, 0x16, 0x0e, 0xa7, 0xfe, 0x7b, 0xc4, 0x1f, 0x13,
-		0x2f, 0x3a, 0xd5, 0xad, 0x26, 0xe6, 0x01, 0x0e, 0xfd, 0x4d, 0x5c, 0x92, 0xb6, 0xd5, 0xe2, 0x61,
-		0x0c, 0xf9, 0x49, 0xa8, 0xbc, 0x85, 0x27, 0xcd, 0xd7, 0x15, 0x2f, 0x36, 0x9a, 0x0e, 0x7e, 0x82,
-		0xc0, 0xcf, 0xe5, 0x27, 0xfc, 0x73, 0xf9, 0xde, 0xf7, 0xb7, 0xc9, 0xc0, 0xf7, 0xb7, 0xe8, 0x59,
-		0x48, 0xd3, 0x3c, 0xb5, 0x6a, 0x75, 0xc5, 0x1f, 0xc6, 0x3a, 0x33, 0xfa, 0x4c, 0xbe, 0x43, 0x4f,
-		0x0b, 0xb3, 0x73, 0xfc, 0xbe, 0xc7, 0x91, 0x0e, 0x79, 0x1c, 0xf7, 0x41, 0x9a, 0xb4, 0x9e, 0xfd,
-		0x81, 0x07, 0xe0, 0x1f, 0x6f, 0x8b, 0x02, 0xf9, 0xc7, 0xa3, 0x50, 0xe8, 0x5b, 0x68, 0x86, 0xf6,
-		0x7d, 0xd8, 0x0e, 0xe8, 0x64, 0xf2, 0x58, 0x00, 0x68, 0x69, 0x8e, 0x7a, 0x5b, 0x33, 0x5d, 0xdc,
-		0xe4, 0x42, 0x09, 0x94, 0xa0, 0x22, 0xa4, 0xc8, 0x53, 0xcf, 0xc1, 0x4d, 0x9e, 0x38, 0xf0, 0x9e,
-		0xd1, 0x35, 0x48, 0xe0, 0x5b, 0xf4, 0x82, 0x53, 0x76, 0x4d, 0xf0, 0xc9, 0xc1, 0x48, 0x8e, 0x54,
-		0x97, 0xe7, 0xc9, 0x60, 0x7f, 0xe9, 0xad, 0x45, 0x89, 0x51, 0x3f, 0xe6, 0x7d, 0x33, 0xa5, 0x70,
-		0xfe, 0xb0, 0x14, 0x52, 0x7d, 0x52, 0xa0, 0x29, 0xac, 0xac, 0x88, 0x4c, 0x89, 0x4c, 0xd9, 0xf1,
-		0x64, 0x25, 0xd7, 0xc1, 0x9d, 0xae, 0x65, 0xb5, 0x55, 0x36, 0xc7, 0x4b, 0x90, 0x0f, 0xaf, 0xab,
-		0xec, 0x8f, 0x2f, 0xb9, 0x9a, 0x61, 0xaa, 0x21, 0x27, 0x38, 0xcb, 0x0a, 0xd9, 0x9c, 0xba, 0x1e,
-		0x4f, 0x45, 0xa4, 0xe8, 0xf5, 0x78, 0x2a, 0x2a, 0xc5, 0xe4, 0x6d, 0x38, 0x31, 0x74, 0x5d, 0x45,
-		0xcf, 0x40, 0xda, 0x5f, 0x92, 0xd9, 0x09, 0xf3, 0x23, 0x92, 0x04, 0x3e, 0xad, 0xfc, 0x8b, 0x11,
-		0x1f, 0x32, 0x9c, 0x76, 0xa8, 0x42, 0x82, 0x1d, 0xf7, 0xe3, 0x47, 0x3b, 0x1e, 0x9f, 0x6c, 0x45,
-		0x5e, 0x66, 0x67, 0x01, 0x15, 0xce, 0x2c, 0x7f, 0x3d, 0x24, 0x58, 0x09, 0xca, 0x40, 0xd2, 0xbf,
-		0x4a, 0x19, 0x20, 0x51, 0xaa, 0x54, 0xaa, 0xdb, 0xe2, 0x5e, 0xd4, 0xf2, 0x96, 0xd2, 0x90, 0xa2,
-		0xa4, 0x58, 0xa9, 0x5e, 0xaf, 0x56, 0x1a, 0x52, 0x0c, 0xcd, 0x40, 0x8e, 0xfd, 0x56, 0xaf, 0x6e,
-		0x29, 0x1b, 0xa5, 0x86, 0x14, 0x0f, 0x14, 0xd5, 0xab, 0x9b, 0xab, 0x55, 0x45, 0x9a, 0x96, 0x9f,
-		0x84, 0xd3, 0x23, 0xd7, 0x70, 0x3f, 0xa7, 0x10, 0x09, 0xe4, 0x14, 0xe4, 0x4f, 0x44, 0x49, 0x50,
-		0x33, 0x6a, 0x61, 0x46, 0xd7, 0xfb, 0x3a, 0x7e, 0xf1, 0x18, 0xab, 0x7a, 0x5f, 0xef, 0x49, 0x1c,
-		0x63, 0xe3, 0x3d, 0xec, 0xea, 0xfb, 0xcc, 0x51, 0x60, 0x16, 0x28, 0xa7, 0xe4, 0x78, 0x29, 0x65,
-		0x72, 0x18, 0xd9, 0xeb, 0x58, 0x77, 0x55, 0xa6, 0x44, 0x0e, 0xff, 0x5b, 0xb1, 0x39, 0x56, 0x5a,
-		0x67, 0x85, 0xf2, 0x37, 0x1c, 0x4b, 0x96, 0x69, 0x98, 0x56, 0xaa, 0x0d, 0xe5, 0x55, 0x29, 0x86,
-		0x10, 0xe4, 0xe9, 0x4f, 0xb5, 0xbe, 0x59, 0xda, 0xae, 0x5f, 0xdb, 0x22, 0xb2, 0x9c, 0x85, 0x82,
-		0x90, 0xa5, 0x28, 0x9c, 0x96, 0x1f, 0x85, 0x53, 0x23, 0xbc, 0x8a, 0x21, 0xe7, 0x2f, 0x3f, 0x15,
-		0x09, 0x52, 0x87, 0x3d, 0x83, 0x2d, 0x48, 0x38, 0xae, 0xe6, 0xf6, 0x1c, 0x2e, 0xc4, 0x67, 0x26,
-		0x75, 0x33, 0x96, 0xc5, 0x8f, 0x3a, 0x65, 0x57, 0x38, 0x8c, 0x7c, 0x09, 0xf2, 0xe1, 0x9a, 0xd1,
-		0x32, 0xf0, 0x95, 0x28, 0x2a, 0x5f, 0x01, 0x34, 0xe8, 0x7d, 0x0c, 0x09, 0x2f, 0x23, 0xc3, 0xc2,
-		0xcb, 0x1f, 0x89, 0xc0, 0x99, 0x23, 0x3c, 0x0d, 0xf4, 0x52, 0x5f, 0x27, 0x9f, 0x3b, 0x8e, 0x9f,
-		0xb2, 0xcc, 0xca, 0xfa, 0xba, 0xf9, 0x14, 0x64, 0x83, 0xe5, 0x93, 0x75, 0xf2, 0x4b, 0x51, 0x7f,
-		0x12, 0x87, 0xe3, 0x60, 0xdf, 0x04, 0x46, 0xfe, 0x82, 0x26, 0xf0, 0x83, 0x00, 0xee, 0x81, 0x38,
-		0x00, 0xcc, 0xd7, 0xd1, 0xfb, 0x87, 0xa4, 0xc6, 0xb0, 0xde, 0x38, 0xe0, 0x93, 0x20, 0xed, 0xf2,
-		0x5f, 0x0e, 0xaa, 0x07, 0x93, 0x02, 0x3d, 0xba, 0xc6, 0x3a, 0xde, 0x5f, 0x8a, 0x9d, 0x6c, 0x31,
-		0xf6, 0x93, 0x07, 0xac, 0xd8, 0x41, 0xaf, 0xc2, 0xa9, 0x3e, 0x47, 0xc1, 0x83, 0x8e, 0x4f, 0xea,
-		0x2f, 0x9c, 0x08, 0xfb, 0x0b, 0x02, 0x3a, 0xb8, 0xda, 0x4f, 0x87, 0x57, 0xfb, 0x57, 0x01, 0xfc,
-		0xe4, 0x80, 0x7f, 0x62, 0x2d, 0x12, 0x3c, 0xb1, 0x76, 0x09, 0xa6, 0x89, 0x26, 0x09, 0x39, 0x0d,
-		0x9a, 0x62, 0xa2, 0x09, 0x81, 0xe4, 0x02, 0xa3, 0x96, 0x0d, 0x40, 0x83, 0xb9, 0xc5, 0x11, 0xaf,
-		0x78, 0x3e, 0xfc, 0x8a, 0x07, 0x46, 0x66, 0x29, 0x87, 0xbf, 0xea, 0x0d, 0x98, 0xa6, 0x23, 0x3f,
-		0xf4, 0x33, 0xc0, 0xaf, 0x03, 0xd0, 0x5c, 0xd7, 0x36, 0x76, 0x7b, 0xfe, 0x0b, 0x16, 0x87, 0x6b,
-		0x4e, 0x49, 0xd0, 0x95, 0xef, 0xe3, 0x2a, 0x34, 0xe7, 0xb3, 0x06, 0xd4, 0x28, 0x00, 0x28, 0x6f,
-		0x42, 0x3e, 0xcc, 0x3b, 0xfc, 0xb3, 0x46, 0xff, 0x7e, 0x91, 0xb4, 0xf0, 0x6f, 0x3c, 0xef, 0x88,
-		0xdf, 0xf9, 0x43, 0x1f, 0xe4, 0x3f, 0x8f, 0x40, 0x36, 0xa8, 0x78, 0xef, 0xb2, 0x0b, 0x72, 0xff,
-		0x10, 0x17, 0x24, 0xdd, 0xd2, 0x9c, 0x97, 0x99, 0x07, 0x72, 0x7a, 0xc0, 0x03, 0x49, 0xb6, 0x34,
-		0x67, 0xe7, 0x7d, 0x74, 0x40, 0xe4, 0x6f, 0x89, 0x40, 0xca, 0xeb, 0xfc, 0x11, 0xa7, 0x74, 0xfd,
-		0x8f, 0x5b, 0xbd, 0xac, 0x3b, 0xdb, 0xdf, 0x88, 0x79, 0xfb, 0x1b, 0x57, 0xbc, 0xc5, 0x6f, 0x54,
-		0x3a, 0x24, 0x28, 0x69, 0x71, 0x52, 0x8e, 0xaf, 0xf5, 0x57, 0x26, 0xfb, 0x86, 0x6c, 0x0e, 0xa6,
-		0x83, 0xdf, 0x7f, 0xb1, 0x07, 0xb9, 0x19, 0x38, 0x1a, 0xc3, 0xa6, 0x61, 0xf0, 0x63, 0xb3, 0xc8,
-		0xb1, 0x3f, 0x36, 0xf3, 0xde, 0x12, 0x0d, 0xbe, 0xe5, 0xbb, 0x23, 0x90, 0x12, 0x33, 0x02, 0x7d,
-		0x28, 0x78, 0x64, 0x4a, 0xec, 0xc9, 0x8d, 0xb4, 0x46, 0x1c, 0x3f, 0x70, 0x62, 0x6a, 0xe0, 0xa4,
-		0x70, 0xec, 0xb8, 0x27, 0x85, 0xb9, 0x5f, 0xf7, 0x27, 0x11, 0x90, 0xfa, 0xe7, 0xeb, 0x5f, 0xb8,
-		0x75, 0x83, 0x8b, 0x5c, 0x6c, 0xc8, 0x22, 0x37, 0xea, 0xf8, 0x6f, 0x7c, 0xd4, 0xf1, 0xdf, 0xc1,
-		0x5e, 0x4f, 0xdf, 0x63, 0xaf, 0x3f, 0x1a, 0x85, 0x4c, 0x20, 0x39, 0x8a, 0x9e, 0x0e, 0x1d, 0x25,
-		0x5e, 0x3a, 0x2a, 0x91, 0x1a, 0x38, 0x4b, 0x1c, 0x12, 0x53, 0xf4, 0xf8, 0x62, 0x7a, 0xf7, 0x3f,
-		0x2b, 0x1a, 0xfe, 0xe5, 0xe5, 0xf4, 0x88, 0x2f, 0x2f, 0xbf, 0x29, 0x02, 0x29, 0xcf, 0xe9, 0x3e,
-		0xee, 0xee, 0xdb, 0x49, 0x48, 0x70, 0xbf, 0x92, 0x6d, 0xbf, 0xf1, 0xa7, 0xa1, 0x49, 0xe5, 0x22,
-		0xa4, 0xc4, 0x1f, 0xed, 0xe3, 0x6b, 0x9a, 0xf7, 0x7c, 0xfe, 0x39, 0xc8, 0x04, 0x76, 0x2e, 0x89,
-		0x61, 0xdc, 0xac, 0xbe, 0x2c, 0x4d, 0x15, 0x93, 0x77, 0xee, 0x2e, 0xc5, 0x36, 0xf1, 0x6d, 0x32,
-		0x9b, 0x95, 0x6a, 0xe5, 0x5a, 0xb5, 0xf2, 0xa2, 0x14, 0x29, 0x66, 0xee, 0xdc, 0x5d, 0x4a, 0x2a,
-		0x98, 0xe6, 0x13, 0xcf, 0xbf, 0x08, 0x85, 0xbe, 0x81, 0x09, 0x3b, 0x2d, 0x08, 0xf2, 0xab, 0x3b,
-		0xdb, 0xeb, 0xb5, 0x4a, 0xa9, 0x51, 0x55, 0xd9, 0x81, 0x53, 0x74, 0x0a, 0x66, 0xd7, 0x6b, 0x6b,
-		0xd7, 0x1a, 0x6a, 0x65, 0xbd, 0x56, 0xdd, 0x6c, 0xa8, 0xa5, 0x46, 0xa3, 0x54, 0x79, 0x51, 0x8a,
-		0x5e, 0xbc, 0x9b, 0x81, 0x78, 0xa9, 0x5c, 0xa9, 0xa1, 0x0a, 0xc4, 0x69, 0x22, 0xe4, 0xc8, 0xa3,
-		0x4b, 0xc5, 0xa3, 0x33, 0xc3, 0xe8, 0x2a, 0x4c, 0xd3, 0x1c, 0x09, 0x3a, 0xfa, 0x2c, 0x53, 0x71,
-		0x4c, 0xaa, 0x98, 0x34, 0x86, 0xce, 0xc8, 0x23, 0x0f, 0x37, 0x15, 0x8f, 0xce, 0x1c, 0xa3, 0x75,
-		0x48, 0x8a, 0x10, 0x79, 0xdc, 0x89, 0xa3, 0xe2, 0xd8, 0x74, 0x2e, 0xe9, 0x1a, 0x4b, 0x35, 0x1c,
-		0x7d, 0xee, 0xa9, 0x38, 0x26, 0xa7, 0x8c, 0x6a, 0xde, 0x87, 0x38, 0x63, 0x8e, 0x32, 0x15, 0xc7,
-		0x65, 0x89, 0x91, 0x02, 0x69, 0x3f, 0x89, 0x33, 0xfe, 0x34, 0x57, 0x71, 0x82, 0x74, 0x39, 0xfa,
-		0x7a, 0xc8, 0x85, 0x03, 0xdd, 0xc9, 0x8e, 0x4b, 0x15, 0x27, 0xcc, 0x47, 0x13, 0xfc, 0x70, 0xd4,
-		0x3b, 0xd9, 0xf1, 0xa9, 0xe2, 0x84, 0xe9, 0x69, 0xf4, 0x3a, 0xcc, 0x0c, 0x46, 0xa5, 0x93, 0x9f,
-		0xa6, 0x2a, 0x1e, 0x23, 0x61, 0x8d, 0x3a, 0x80, 0x86, 0x44, 0xb3, 0xc7, 0x38, 0x5c, 0x55, 0x3c,
-		0x4e, 0xfe, 0x1a, 0x35, 0xa1, 0xd0, 0x1f, 0x22, 0x4e, 0x7a, 0xd8, 0xaa, 0x38, 0x71, 0x2e, 0x9b,
-		0xbd, 0x25, 0x1c, 0x5a, 0x4e, 0x7a, 0xf8, 0xaa, 0x38, 0x71, 0x6a, 0x1b, 0xed, 0x00, 0x04, 0xa2,
-		0xc3, 0x09, 0x0e, 0x63, 0x15, 0x27, 0x49, 0x72, 0xa3, 0x2e, 0xcc, 0x0e, 0x0b, 0x1b, 0x8f, 0x73,
-		0x36, 0xab, 0x78, 0xac, 0xdc, 0x37, 0xd1, 0xe7, 0x70, 0x00, 0x38, 0xd9, 0x59, 0xad, 0xe2, 0x84,
-		0x49, 0xf0, 0x72, 0x69, 0xe4, 0x01, 0xdd, 0xb3, 0x47, 0x1e, 0xd0, 0xf5, 0x8f, 0xdc, 0x7a, 0x87,
-		0x72, 0x7f, 0xe7, 0x09, 0xf8, 0x00, 0xbf, 0xfa, 0xc5, 0x71, 0xb5, 0x9b, 0x86, 0xd9, 0xf2, 0xee,
-		0xf2, 0xe1, 0xcf, 0xfc, 0x74, 0xee, 0x49, 0x7e, 0xbd, 0x8c, 0x28, 0x1d, 0x73, 0xa3, 0xcf, 0xc8,
-		0x6b, 0x0e, 0xc7, 0x1d, 0xe4, 0x1f, 0x7f, 0xee, 0xf6, 0x88, 0xdb, 0x82, 0xc6, 0xdc, 0x49, 0x34,
-		0xe4, 0x36, 0xa1, 0xe2, 0x91, 0x1f, 0xc7, 0x17, 0x8f, 0x3a, 0xb0, 0x4c, 0xbc, 0xd8, 0xfc, 0x35,
-		0xc3, 0x71, 0x2d, 0xdb, 0xd0, 0xb5, 0x36, 0x5d, 0x5e, 0xae, 0x4c, 0xfa, 0x39, 0x51, 0x39, 0x4d,
-		0xdc, 0x15, 0x7e, 0x05, 0x11, 0xff, 0xc4, 0x65, 0x15, 0x12, 0xb7, 0xb4, 0x36, 0xfb, 0x98, 0x27,
-		0x78, 0xdb, 0x58, 0xbf, 0xcc, 0x03, 0x7e, 0x54, 0x10, 0x85, 0xf1, 0xca, 0x9f, 0xa3, 0x1f, 0x42,
-		0x74, 0x3a, 0x86, 0x43, 0x74, 0x51, 0xa1, 0x81, 0xf4, 0x0e, 0xc4, 0x6d, 0xcd, 0xe5, 0xe1, 0x65,
-		0xb9, 0xc4, 0x2f, 0x0e, 0x7a, 0x78, 0xfc, 0xf5, 0x3f, 0xcb, 0x03, 0x77, 0x0b, 0xb1, 0x97, 0x51,
-		0x38, 0xf4, 0xb5, 0x90, 0xea, 0x68, 0x07, 0x2a, 0x85, 0x8e, 0xbe, 0x5b, 0xd0, 0xc9, 0x8e, 0x76,
-		0x40, 0x5a, 0x8d, 0x0c, 0x28, 0x10, 0x74, 0x7d, 0x5f, 0x33, 0x5b, 0x98, 0xbd, 0x24, 0xf6, 0x6e,
-		0xbd, 0x24, 0xd7, 0xd1, 0x0e, 0x2a, 0x14, 0x98, 0xbc, 0x8a, 0x5f, 0xd1, 0xf4, 0xcb, 0x11, 0x9e,
-		0x54, 0xa0, 0x92, 0x43, 0x1a, 0x48, 0xba, 0xf7, 0x44, 0xdf, 0x2f, 0x76, 0x40, 0xce, 0x8e, 0x1a,
-		0x98, 0x3e, 0xb9, 0x97, 0x73, 0xa4, 0xa5, 0x9f, 0x7f, 0x6b, 0x31, 0xc2, 0xde, 0x5a, 0xd0, 0xfb,
-		0xc6, 0xe5, 0x3a, 0x64, 0x58, 0xae, 0x44, 0xa5, 0x4e, 0x6e, 0x74, 0xac, 0x93, 0x9b, 0x13, 0x4e,
-		0x2e, 0x03, 0x04, 0xc6, 0x4d, 0xea, 0x79, 0x1f, 0x3e, 0x13, 0x81, 0xcc, 0x6a, 0xe0, 0x72, 0xc1,
-		0x79, 0x48, 0x76, 0x2c, 0xd3, 0xb8, 0x89, 0x6d, 0x6f, 0x0b, 0x8b, 0x3d, 0x12, 0x47, 0x94, 0xfd,
-		0x8d, 0x3a, 0xf7, 0x50, 0xdc, 0x9b, 0x23, 0x9e, 0x09, 0xd7, 0x6d, 0xbc, 0xeb, 0x18, 0x42, 0xe4,
-		0x8a, 0x78, 0x44, 0x8f, 0x80, 0xe4, 0x60, 0xbd, 0x67, 0x1b, 0xee, 0xa1, 0xaa, 0x5b, 0xa6, 0xab,
-		0xe9, 0x2e, 0x0f, 0xd3, 0x0b, 0xa2, 0xbc, 0xc2, 0x8a, 0x09, 0x48, 0x13, 0xbb, 0x9a, 0xd1, 0x66,
-		0x67, 0x0e, 0xd3, 0x8a, 0x78, 0xe4, 0x4d, 0xfd, 0x5c, 0x32, 0x18, 0xa8, 0x56, 0x40, 0xb2, 0xba,
-		0xd8, 0x0e, 0x9d, 0x57, 0x61, 0xea, 0x3a, 0xff, 0xeb, 0x9f, 0x7d, 0x7c, 0x8e, 0x0b, 0x9c, 0x9f,
-		0x75, 0x60, 0x77, 0xe9, 0x2b, 0x05, 0xc1, 0x21, 0x0e, 0xb2, 0xbc, 0x1a, 0xda, 0xb4, 0xea, 0xed,
-		0xfa, 0x17, 0xa4, 0xcc, 0x0d, 0x08, 0xb5, 0x64, 0x1e, 0x96, 0xe7, 0x7f, 0xcd, 0x87, 0xf6, 0x03,
-		0xd9, 0x17, 0xf1, 0x61, 0x70, 0x07, 0x8b, 0xc2, 0x10, 0x1f, 0xff, 0x75, 0xcd, 0x68, 0x8b, 0x3f,
-		0xe7, 0xa9, 0xf0, 0x27, 0xb4, 0xe2, 0x65, 0x1f, 0xe3, 0x34, 0x60, 0x92, 0x47, 0xe9, 0x46, 0xd9,
-		0x32, 0x9b, 0xe1, 0x34, 0x23, 0x6a, 0x40, 0xc2, 0xb5, 0x6e, 0x62, 0x93, 0x0b, 0xa8, 0xfc, 0xc1,
-		0x63, 0x28, 0xf6, 0xe0, 0x6d, 0x61, 0x1c, 0x0b, 0xb5, 0x40, 0x6a, 0xe2, 0x36, 0x6e, 0xb1, 0x4f,
-		0x03, 0xf7, 0x35, 0x1b, 0xb3, 0x0f, 0xd3, 0x8f, 0x87, 0x3f, 0x78, 0xa9, 0x58, 0xc1, 0x43, 0xad,
-		0x53, 0x50, 0xb4, 0x1d, 0xbe, 0xda, 0x32, 0xc9, 0xf7, 0x9d, 0x47, 0xf4, 0x3f, 0xa0, 0x95, 0x41,
-		0xb3, 0x15, 0xba, 0x0a, 0xf3, 0x11, 0x90, 0x7a, 0xe6, 0xae, 0x65, 0xd2, 0xbf, 0x90, 0xc7, 0x43,
-		0xb0, 0x14, 0xdb, 0xe4, 0xf4, 0xca, 0xf9, 0x26, 0xe7, 0x36, 0xe4, 0x7d, 0x52, 0x3a, 0x7b, 0xd2,
-		0xc7, 0x9d, 0x3d, 0x39, 0x0f, 0x80, 0x90, 0xa0, 0x0d, 0x00, 0x7f, 0x7e, 0xd2, 0x5d, 0xb6, 0xcc,
-		0xe8, 0xd1, 0xf4, 0x67, 0x7a, 0xb0, 0x33, 0x01, 0x00, 0xd4, 0x86, 0xd9, 0x8e, 0x61, 0xaa, 0x0e,
-		0x6e, 0xef, 0xa9, 0x5c, 0x72, 0x04, 0x37, 0xf3, 0x2e, 0x8c, 0xf4, 0x4c, 0xc7, 0x30, 0xeb, 0xb8,
-		0xbd, 0xb7, 0xea, 0xc1, 0xa2, 0x0f, 0xc2, 0x19, 0x5f, 0x1c, 0x96, 0xa9, 0xee, 0x5b, 0xed, 0xa6,
-		0x6a, 0xe3, 0x3d, 0x55, 0xa7, 0x97, 0xd5, 0x65, 0xa9, 0x10, 0x4f, 0x79, 0x24, 0x5b, 0xe6, 0x35,
-		0xab, 0xdd, 0x54, 0xf0, 0x5e, 0x85, 0x54, 0xa3, 0x07, 0xc1, 0x97, 0x85, 0x6a, 0x34, 0x9d, 0xf9,
-		0xdc, 0x52, 0xec, 0x5c, 0x5c, 0xc9, 0x7a, 0x85, 0xb5, 0xa6, 0xb3, 0x92, 0xfa, 0xd8, 0x9b, 0x8b,
-		0x53, 0x5f, 0x7c, 0x73, 0x71, 0x4a, 0xbe, 0x4a, 0xaf, 0x56, 0xe2, 0x93, 0x0e, 0x3b, 0xe8, 0x32,
-		0xa4, 0x35, 0xf1, 0xc0, 0x3e, 0x9c, 0x3a, 0x62, 0xd2, 0xfa, 0xa4, 0xf2, 0x9b, 0x11, 0x48, 0xac,
-		0xde, 0xd8, 0xd6, 0x0c, 0x1b, 0x55, 0x61, 0xc6, 0x57, 0xda, 0x49, 0xe7, 0xbf, 0xaf, 0xe7, 0xc2,
-		0x00, 0x54, 0x47, 0x1d, 0x7b, 0x3b, 0x12, 0xa6, 0xff, 0x40, 0x5c, 0xa0, 0xab, 0xd7, 0x21, 0xc9,
-		0x5a, 0xe8, 0xa0, 0x17, 0x60, 0xba, 0x4b, 0x7e, 0xf0, 0x24, 0xfe, 0xc2, 0x48, 0x45, 0xa7, 0xf4,
-		0x41, 0xb5, 0x60, 0x7c, 0xf2, 0x9f, 0x45, 0x00, 0x56, 0x6f, 0xdc, 0x68, 0xd8, 0x46, 0xb7, 0x8d,
-		0xdd, 0x77, 0xab, 0xcb, 0xeb, 0x70, 0x22, 0xf0, 0x25, 0xb0, 0xad, 0x4f, 0xdc, 0xed, 0x59, 0xff,
-		0x5b, 0x60, 0x5b, 0x1f, 0x8a, 0xd6, 0x74, 0x5c, 0x0f, 0x2d, 0x36, 0x31, 0xda, 0xaa, 0xe3, 0x0e,
-		0xca, 0xf1, 0x15, 0xc8, 0xf8, 0x5d, 0x77, 0x50, 0x0d, 0x52, 0x2e, 0xff, 0xcd, 0xc5, 0x29, 0x8f,
-		0x16, 0xa7, 0x60, 0x0b, 0x8a, 0xd4, 0x63, 0x97, 0xff, 0x27, 0x91, 0xaa, 0x3f, 0x11, 0xbe, 0xaa,
-		0x14, 0x89, 0x58, 0x78, 0x6e, 0x81, 0x63, 0xef, 0x82, 0x05, 0xe6, 0x58, 0x01, 0xb1, 0x7e, 0x73,
-		0x14, 0x66, 0x77, 0xc4, 0x24, 0xfd, 0xaa, 0x95, 0xc2, 0x0e, 0x24, 0xb1, 0xe9, 0xda, 0x86, 0xb7,
-		0xdb, 0xf4, 0xc4, 0xa8, 0xc1, 0x1e, 0xd2, 0x97, 0xaa, 0xe9, 0xda, 0x87, 0xc1, 0xa1, 0x17, 0x58,
-		0x01, 0x31, 0xfc, 0x42, 0x0c, 0xe6, 0x47, 0xb1, 0xa2, 0xb3, 0x50, 0xd0, 0x6d, 0x4c, 0x0b, 0xc2,
-		0xdf, 0x41, 0xe6, 0x45, 0x31, 0x5f, 0x52, 0x14, 0x20, 0x0e, 0x1a, 0xd1, 0x2a, 0x42, 0x7a, 0x6f,
-		0x1e, 0x59, 0xde, 0x47, 0xa0, 0x8b, 0x0a, 0x86, 0x82, 0xf8, 0xb0, 0x62, 0x57, 0x6b, 0x6b, 0xa6,
-		0x8e, 0xef, 0x41, 0x13, 0x06, 0x57, 0x00, 0xf1, 0xb5, 0x46, 0x99, 0x61, 0xa2, 0x1b, 0x90, 0x14,
-		0xf0, 0xf1, 0x77, 0x01, 0x5e, 0x80, 0xa1, 0x07, 0x20, 0x1b, 0x5c, 0x18, 0xa8, 0x9f, 0x12, 0x57,
-		0x32, 0x81, 0x75, 0x61, 0xdc, 0xca, 0x93, 0x38, 0x72, 0xe5, 0xe1, 0xae, 0xe0, 0xcf, 0xc7, 0x60,
-		0x46, 0xc1, 0xcd, 0xbf, 0x82, 0x03, 0xf7, 0x35, 0x00, 0x6c, 0x52, 0x13, 0x63, 0x7b, 0x0f, 0x63,
-		0x37, 0x68, 0x24, 0xd2, 0x0c, 0x6f, 0xd5, 0x71, 0xdf, 0xaf, 0xd1, 0xfb, 0xd7, 0x51, 0xc8, 0x06,
-		0x47, 0xef, 0xaf, 0xc0, 0xca, 0x86, 0x36, 0x7d, 0x93, 0xc6, 0xbe, 0x27, 0x79, 0x64, 0x94, 0x49,
-		0x1b, 0xd0, 0xeb, 0x31, 0xb6, 0xec, 0xd3, 0x31, 0x48, 0xf0, 0x53, 0x73, 0x5b, 0x03, 0x3e, 0x6e,
-		0x64, 0xdc, 0x17, 0xf1, 0x39, 0xf1, 0x45, 0xfc, 0x50, 0x17, 0xf7, 0x21, 0xc8, 0x93, 0x90, 0x3a,
-		0x74, 0x14, 0x2f, 0x72, 0x2e, 0x47, 0xc3, 0x61, 0xff, 0xe0, 0x39, 0x5a, 0x84, 0x0c, 0x21, 0xf3,
-		0x6d, 0x36, 0xa1, 0x81, 0x8e, 0x76, 0x50, 0x65, 0x25, 0xe8, 0x71, 0x40, 0xfb, 0x5e, 0xe2, 0x43,
-		0xf5, 0x05, 0x41, 0xe8, 0x66, 0xfc, 0x1a, 0x41, 0x7e, 0x3f, 0x00, 0x69, 0x85, 0xca, 0xee, 0x53,
-		0xe6, 0x77, 0x53, 0x93, 0x92, 0x55, 0x7a, 0xa7, 0xf2, 0x77, 0x44, 0x98, 0xab, 0xdc, 0x17, 0x6d,
-		0xf3, 0xa0, 0x45, 0x3d, 0xde, 0x6c, 0xf8, 0xe3, 0xb7, 0x16, 0x8b, 0x87, 0x5a, 0xa7, 0xbd, 0x22,
-		0x0f, 0x81, 0x94, 0x87, 0xe5, 0x02, 0x88, 0x37, 0x1d, 0x0e, 0xdc, 0x57, 0x3e, 0x40, 0xf4, 0xfa,
-		0xce, 0xef, 0xfe, 0xc4, 0xf9, 0x33, 0x81, 0x57, 0x1c, 0x78, 0x19, 0x32, 0x36, 0x3c, 0xf2, 0x8f,
-		0x45, 0x00, 0xf9, 0x8b, 0x8d, 0x77, 0xf4, 0x7e, 0x03, 0x20, 0xe0, 0xef, 0x47, 0x8e, 0x8e, 0x23,
-		0x7c, 0xfe, 0x50, 0x1c, 0x11, 0x98, 0x4c, 0x1f, 0xf2, 0x4d, 0xbb, 0xb8, 0x0f, 0x61, 0xc8, 0xcd,
-		0xda, 0xcb, 0x15, 0xcb, 0x08, 0x41, 0x08, 0x26, 0x3a, 0x47, 0xa7, 0xe4, 0xb7, 0x22, 0x70, 0x7a,
-		0x40, 0x13, 0xbd, 0x26, 0xeb, 0x80, 0xec, 0x40, 0x25, 0x1d, 0x51, 0xb1, 0xdb, 0x7b, 0x6f, 0x8a,
-		0x3d, 0x63, 0x0f, 0x98, 0xf3, 0xf7, 0x68, 0x8d, 0xe2, 0x46, 0xe8, 0x57, 0x23, 0x30, 0x17, 0x6c,
-		0x91, 0xd7, 0xb7, 0x3a, 0x64, 0x83, 0x6d, 0xe1, 0xbd, 0xfa, 0xc0, 0x24, 0xbd, 0x0a, 0x76, 0x28,
-		0x04, 0x42, 0xfa, 0x22, 0xb4, 0x9e, 0xe5, 0xea, 0x9e, 0x9c, 0x58, 0x4a, 0xa2, 0x61, 0x43, 0xcd,
-		0x00, 0x1b, 0xac, 0x6f, 0x8f, 0x42, 0x7c, 0xdb, 0xb2, 0xda, 0xe8, 0x9b, 0x22, 0x30, 0x63, 0x5a,
-		0xae, 0x4a, 0xe6, 0x0a, 0x6e, 0xaa, 0x3c, 0x59, 0xc0, 0x2c, 0xe9, 0x8d, 0xe3, 0x49, 0xef, 0x4b,
-		0x6f, 0x2d, 0x0e, 0x42, 0x0d, 0xbb, 0x10, 0xbd, 0x60, 0x5a, 0x6e, 0x99, 0x12, 0x35, 0x58, 0x3e,
-		0xe1, 0x36, 0xe4, 0xc2, 0xef, 0x67, 0xe6, 0x57, 0x39, 0xf6, 0xfb, 0x73, 0x63, 0xdf, 0x9d, 0xdd,
-		0x0d, 0xbc, 0x98, 0x5d, 0x4b, 0xfc, 0x87, 0x64, 0x70, 0x5f, 0x05, 0xe9, 0x46, 0xff, 0xb9, 0xa3,
-		0x2a, 0x24, 0xc5, 0x39, 0xa3, 0xc8, 0x84, 0x47, 0x98, 0x82, 0x12, 0xe7, 0xbc, 0xe7, 0x7f, 0x26,
-		0x02, 0xe0, 0xa7, 0x66, 0xd0, 0x63, 0x70, 0xaa, 0xbc, 0xb5, 0xb9, 0xaa, 0xd6, 0x1b, 0xa5, 0xc6,
-		0x4e, 0x3d, 0xfc, 0x57, 0x34, 0xc4, 0x7d, 0x3e, 0x4e, 0x17, 0xeb, 0xc6, 0x9e, 0x81, 0x9b, 0xe8,
-		0x61, 0x98, 0x0b, 0x53, 0x93, 0xa7, 0xea, 0xaa, 0x14, 0x29, 0x66, 0xef, 0xdc, 0x5d, 0x4a, 0x31,
-		0x97, 0x14, 0x37, 0xd1, 0x39, 0x38, 0x31, 0x48, 0x57, 0xdb, 0x5c, 0x93, 0xa2, 0xc5, 0xdc, 0x9d,
-		0xbb, 0x4b, 0x69, 0xcf, 0x77, 0x45, 0x32, 0xa0, 0x20, 0x25, 0xc7, 0x8b, 0x15, 0xe1, 0xce, 0xdd,
-		0xa5, 0x04, 0x1b, 0x96, 0x62, 0xfc, 0x63, 0x3f, 0xbc, 0x30, 0x75, 0xfe, 0xeb, 0x00, 0x6a, 0xe6,
-		0x9e, 0xad, 0xd1, 0x3f, 0x25, 0x8f, 0x8a, 0x70, 0xb2, 0xb6, 0x79, 0x55, 0x29, 0x55, 0x1a, 0xb5,
-		0xad, 0xcd, 0xbe, 0x3f, 0xfe, 0x11, 0xae, 0x5b, 0xdd, 0xda, 0x29, 0xaf, 0x57, 0xd5, 0x7a, 0x6d,
-		0x6d, 0x93, 0xed, 0x01, 0x87, 0xea, 0x5e, 0xde, 0x6c, 0xd4, 0x36, 0xaa, 0x52, 0xb4, 0x7c, 0x75,
-		0xe4, 0x1e, 0xc3, 0x63, 0x47, 0x0e, 0xb8, 0x6f, 0x25, 0x43, 0x1b, 0x0d, 0xff, 0x3b, 0x00, 0x00,
-		0xff, 0xff, 0xd6, 0xf2, 0x1e, 0x47, 0x50, 0xa0, 0x00, 0x00,
+		// 11390 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x90, 0x1c, 0xd7,
+		0x75, 0x18, 0xbc, 0xf3, 0xdc, 0x99, 0x33, 0x33, 0xbb, 0xbd, 0xbd, 0x0b, 0x60, 0x30, 0x20, 0x80,
+		0xe5, 0xf0, 0x05, 0x82, 0xc4, 0x82, 0x04, 0x09, 0x90, 0x5c, 0x88, 0xe2, 0x37, 0x3b, 0x3b, 0x00,
+		0x16, 0xdc, 0x17, 0x7b, 0x76, 0xc1, 0x87, 0x1f, 0xed, 0xde, 0x99, 0xbb, 0xb3, 0x4d, 0xcc, 0x74,
+		0xb7, 0xa6, 0x7b, 0x80, 0x5d, 0x96, 0xeb, 0x2b, 0xda, 0xb2, 0x13, 0x1b, 0x71, 0x6c, 0xf9, 0x51,
+		0xb1, 0xfc, 0x80, 0x23, 0xd9, 0xb1, 0x2d, 0x3b, 0x2f, 0x59, 0x62, 0x24, 0x39, 0x2a, 0x3b, 0x76,
+		0x9e, 0xb2, 0xe2, 0x4a, 0x29, 0xfa, 0x91, 0xb8, 0x52, 0x15, 0xc6, 0xa2, 0x5c, 0x91, 0x22, 0xcb,
+		0xb1, 0x2c, 0xd3, 0x49, 0x1c, 0x55, 0x5c, 0xa9, 0x73, 0x1f, 0xfd, 0x98, 0xe9, 0xd9, 0x9e, 0x85,
+		0x40, 0x5a, 0x89, 0xf3, 0x07, 0x98, 0x3e, 0xf7, 0x9c, 0x73, 0xef, 0x3d, 0xf7, 0xdc, 0x73, 0xcf,
+		0x39, 0xf7, 0xb1, 0xf0, 0x85, 0x05, 0x98, 0x6d, 0x99, 0x66, 0xab, 0x4d, 0xce, 0x5a, 0x5d, 0xd3,
+		0x31, 0xb7, 0x7a, 0xdb, 0x67, 0x9b, 0xc4, 0x6e, 0x74, 0x75, 0xcb, 0x31, 0xbb, 0x73, 0x14, 0x26,
+		0x4f, 0x32, 0x8c, 0x39, 0x81, 0x51, 0x5e, 0x81, 0xa9, 0x4b, 0x7a, 0x9b, 0x2c, 0xba, 0x88, 0x75,
+		0xe2, 0xc8, 0x4f, 0x43, 0x72, 0x5b, 0x6f, 0x93, 0x62, 0x6c, 0x36, 0x71, 0x2a, 0x77, 0xee, 0xfe,
+		0xb9, 0x3e, 0xa2, 0xb9, 0x20, 0xc5, 0x3a, 0x82, 0x15, 0x4a, 0x51, 0xfe, 0x8b, 0x24, 0x4c, 0x87,
+		0x94, 0xca, 0x32, 0x24, 0x0d, 0xad, 0x83, 0x1c, 0x63, 0xa7, 0xb2, 0x0a, 0xfd, 0x2d, 0x17, 0x61,
+		0xdc, 0xd2, 0x1a, 0xd7, 0xb5, 0x16, 0x29, 0xc6, 0x29, 0x58, 0x7c, 0xca, 0x27, 0x00, 0x9a, 0xc4,
+		0x22, 0x46, 0x93, 0x18, 0x8d, 0xbd, 0x62, 0x62, 0x36, 0x71, 0x2a, 0xab, 0xf8, 0x20, 0xf2, 0x23,
+		0x30, 0x65, 0xf5, 0xb6, 0xda, 0x7a, 0x43, 0xf5, 0xa1, 0xc1, 0x6c, 0xe2, 0x54, 0x4a, 0x91, 0x58,
+		0xc1, 0xa2, 0x87, 0xfc, 0x10, 0x4c, 0xde, 0x24, 0xda, 0x75, 0x3f, 0x6a, 0x8e, 0xa2, 0x4e, 0x20,
+		0xd8, 0x87, 0x58, 0x85, 0x7c, 0x87, 0xd8, 0xb6, 0xd6, 0x22, 0xaa, 0xb3, 0x67, 0x91, 0x62, 0x92,
+		0xf6, 0x7e, 0x76, 0xa0, 0xf7, 0xfd, 0x3d, 0xcf, 0x71, 0xaa, 0x8d, 0x3d, 0x8b, 0xc8, 0x15, 0xc8,
+		0x12, 0xa3, 0xd7, 0x61, 0x1c, 0x52, 0x43, 0xe4, 0x57, 0x33, 0x7a, 0x9d, 0x7e, 0x2e, 0x19, 0x24,
+		0xe3, 0x2c, 0xc6, 0x6d, 0xd2, 0xbd, 0xa1, 0x37, 0x48, 0x31, 0x4d, 0x19, 0x3c, 0x34, 0xc0, 0xa0,
+		0xce, 0xca, 0xfb, 0x79, 0x08, 0x3a, 0xb9, 0x0a, 0x59, 0xb2, 0xeb, 0x10, 0xc3, 0xd6, 0x4d, 0xa3,
+		0x38, 0x4e, 0x99, 0x3c, 0x10, 0x32, 0x8a, 0xa4, 0xdd, 0xec, 0x67, 0xe1, 0xd1, 0xc9, 0x17, 0x60,
+		0xdc, 0xb4, 0x1c, 0xdd, 0x34, 0xec, 0x62, 0x66, 0x36, 0x76, 0x2a, 0x77, 0xee, 0x9e, 0x50, 0x45,
+		0x58, 0x63, 0x38, 0x8a, 0x40, 0x96, 0x97, 0x40, 0xb2, 0xcd, 0x5e, 0xb7, 0x41, 0xd4, 0x86, 0xd9,
+		0x24, 0xaa, 0x6e, 0x6c, 0x9b, 0xc5, 0x2c, 0x65, 0x70, 0x72, 0xb0, 0x23, 0x14, 0xb1, 0x6a, 0x36,
+		0xc9, 0x92, 0xb1, 0x6d, 0x2a, 0x13, 0x76, 0xe0, 0x5b, 0x3e, 0x0c, 0x69, 0x7b, 0xcf, 0x70, 0xb4,
+		0xdd, 0x62, 0x9e, 0x6a, 0x08, 0xff, 0x42, 0xd5, 0x21, 0x4d, 0x1d, 0xab, 0x2b, 0x16, 0x98, 0xea,
+		0xf0, 0xcf, 0xf2, 0xaf, 0xa7, 0x61, 0x72, 0x14, 0xe5, 0xbb, 0x08, 0xa9, 0x6d, 0xec, 0x7f, 0x31,
+		0x7e, 0x10, 0xe9, 0x30, 0x9a, 0xa0, 0x78, 0xd3, 0x77, 0x28, 0xde, 0x0a, 0xe4, 0x0c, 0x62, 0x3b,
+		0xa4, 0xc9, 0x74, 0x25, 0x31, 0xa2, 0xb6, 0x01, 0x23, 0x1a, 0x54, 0xb6, 0xe4, 0x1d, 0x29, 0xdb,
+		0x4b, 0x30, 0xe9, 0x36, 0x49, 0xed, 0x6a, 0x46, 0x4b, 0x68, 0xed, 0xd9, 0xa8, 0x96, 0xcc, 0xd5,
+		0x04, 0x9d, 0x82, 0x64, 0xca, 0x04, 0x09, 0x7c, 0xcb, 0x8b, 0x00, 0xa6, 0x41, 0xcc, 0x6d, 0xb5,
+		0x49, 0x1a, 0xed, 0x62, 0x66, 0x88, 0x94, 0xd6, 0x10, 0x65, 0x40, 0x4a, 0x26, 0x83, 0x36, 0xda,
+		0xf2, 0x33, 0x9e, 0x12, 0x8e, 0x0f, 0xd1, 0xa1, 0x15, 0x36, 0xfd, 0x06, 0xf4, 0x70, 0x13, 0x26,
+		0xba, 0x04, 0x67, 0x04, 0x69, 0xf2, 0x9e, 0x65, 0x69, 0x23, 0xe6, 0x22, 0x7b, 0xa6, 0x70, 0x32,
+		0xd6, 0xb1, 0x42, 0xd7, 0xff, 0x29, 0xdf, 0x07, 0x2e, 0x40, 0xa5, 0x6a, 0x05, 0xd4, 0x3e, 0xe5,
+		0x05, 0x70, 0x55, 0xeb, 0x90, 0xd2, 0x6b, 0x30, 0x11, 0x14, 0x8f, 0x3c, 0x03, 0x29, 0xdb, 0xd1,
+		0xba, 0x0e, 0xd5, 0xc2, 0x94, 0xc2, 0x3e, 0x64, 0x09, 0x12, 0xc4, 0x68, 0x52, 0xfb, 0x97, 0x52,
+		0xf0, 0xa7, 0xfc, 0xff, 0x79, 0x1d, 0x4e, 0xd0, 0x0e, 0x3f, 0x38, 0x38, 0xa2, 0x01, 0xce, 0xfd,
+		0xfd, 0x2e, 0x3d, 0x05, 0x85, 0x40, 0x07, 0x46, 0xad, 0xba, 0xfc, 0xdd, 0x70, 0x28, 0x94, 0xb5,
+		0xfc, 0x12, 0xcc, 0xf4, 0x0c, 0xdd, 0x70, 0x48, 0xd7, 0xea, 0x12, 0xd4, 0x58, 0x56, 0x55, 0xf1,
+		0x4b, 0xe3, 0x43, 0x74, 0x6e, 0xd3, 0x8f, 0xcd, 0xb8, 0x28, 0xd3, 0xbd, 0x41, 0xe0, 0xe9, 0x6c,
+		0xe6, 0xcb, 0xe3, 0xd2, 0xeb, 0xaf, 0xbf, 0xfe, 0x7a, 0xbc, 0xfc, 0xdb, 0x69, 0x98, 0x09, 0x9b,
+		0x33, 0xa1, 0xd3, 0xf7, 0x30, 0xa4, 0x8d, 0x5e, 0x67, 0x8b, 0x74, 0xa9, 0x90, 0x52, 0x0a, 0xff,
+		0x92, 0x2b, 0x90, 0x6a, 0x6b, 0x5b, 0xa4, 0x5d, 0x4c, 0xce, 0xc6, 0x4e, 0x4d, 0x9c, 0x7b, 0x64,
+		0xa4, 0x59, 0x39, 0xb7, 0x8c, 0x24, 0x0a, 0xa3, 0x94, 0xdf, 0x0b, 0x49, 0x6e, 0xbc, 0x91, 0xc3,
+		0xe9, 0xd1, 0x38, 0xe0, 0x5c, 0x52, 0x28, 0x9d, 0x7c, 0x0c, 0xb2, 0xf8, 0x3f, 0xd3, 0x8d, 0x34,
+		0x6d, 0x73, 0x06, 0x01, 0xa8, 0x17, 0x72, 0x09, 0x32, 0x74, 0x9a, 0x34, 0x89, 0x58, 0xf4, 0xdc,
+		0x6f, 0x54, 0xac, 0x26, 0xd9, 0xd6, 0x7a, 0x6d, 0x47, 0xbd, 0xa1, 0xb5, 0x7b, 0x84, 0x2a, 0x7c,
+		0x56, 0xc9, 0x73, 0xe0, 0x35, 0x84, 0xc9, 0x27, 0x21, 0xc7, 0x66, 0x95, 0x6e, 0x34, 0xc9, 0x2e,
+		0xb5, 0xab, 0x29, 0x85, 0x4d, 0xb4, 0x25, 0x84, 0x60, 0xf5, 0xaf, 0xda, 0xa6, 0x21, 0x54, 0x93,
+		0x56, 0x81, 0x00, 0x5a, 0xfd, 0x53, 0xfd, 0x26, 0xfd, 0x78, 0x78, 0xf7, 0x06, 0xe6, 0xd2, 0x43,
+		0x30, 0x49, 0x31, 0x9e, 0xe0, 0x43, 0xaf, 0xb5, 0x8b, 0x53, 0xb3, 0xb1, 0x53, 0x19, 0x65, 0x82,
+		0x81, 0xd7, 0x38, 0xb4, 0xfc, 0x89, 0x38, 0x24, 0xa9, 0x61, 0x99, 0x84, 0xdc, 0xc6, 0xcb, 0xeb,
+		0x35, 0x75, 0x71, 0x6d, 0x73, 0x61, 0xb9, 0x26, 0xc5, 0xe4, 0x09, 0x00, 0x0a, 0xb8, 0xb4, 0xbc,
+		0x56, 0xd9, 0x90, 0xe2, 0xee, 0xf7, 0xd2, 0xea, 0xc6, 0x85, 0x27, 0xa5, 0x84, 0x4b, 0xb0, 0xc9,
+		0x00, 0x49, 0x3f, 0xc2, 0x13, 0xe7, 0xa4, 0x94, 0x2c, 0x41, 0x9e, 0x31, 0x58, 0x7a, 0xa9, 0xb6,
+		0x78, 0xe1, 0x49, 0x29, 0x1d, 0x84, 0x3c, 0x71, 0x4e, 0x1a, 0x97, 0x0b, 0x90, 0xa5, 0x90, 0x85,
+		0xb5, 0xb5, 0x65, 0x29, 0xe3, 0xf2, 0xac, 0x6f, 0x28, 0x4b, 0xab, 0x97, 0xa5, 0xac, 0xcb, 0xf3,
+		0xb2, 0xb2, 0xb6, 0xb9, 0x2e, 0x81, 0xcb, 0x61, 0xa5, 0x56, 0xaf, 0x57, 0x2e, 0xd7, 0xa4, 0x9c,
+		0x8b, 0xb1, 0xf0, 0xf2, 0x46, 0xad, 0x2e, 0xe5, 0x03, 0xcd, 0x7a, 0xe2, 0x9c, 0x54, 0x70, 0xab,
+		0xa8, 0xad, 0x6e, 0xae, 0x48, 0x13, 0xf2, 0x14, 0x14, 0x58, 0x15, 0xa2, 0x11, 0x93, 0x7d, 0xa0,
+		0x0b, 0x4f, 0x4a, 0x92, 0xd7, 0x10, 0xc6, 0x65, 0x2a, 0x00, 0xb8, 0xf0, 0xa4, 0x24, 0x97, 0xab,
+		0x90, 0xa2, 0x6a, 0x28, 0xcb, 0x30, 0xb1, 0x5c, 0x59, 0xa8, 0x2d, 0xab, 0x6b, 0xeb, 0x1b, 0x4b,
+		0x6b, 0xab, 0x95, 0x65, 0x29, 0xe6, 0xc1, 0x94, 0xda, 0x0b, 0x9b, 0x4b, 0x4a, 0x6d, 0x51, 0x8a,
+		0xfb, 0x61, 0xeb, 0xb5, 0xca, 0x46, 0x6d, 0x51, 0x4a, 0x94, 0x1b, 0x30, 0x13, 0x66, 0x50, 0x43,
+		0xa7, 0x90, 0x4f, 0x17, 0xe2, 0x43, 0x74, 0x81, 0xf2, 0xea, 0xd7, 0x85, 0xf2, 0x17, 0xe3, 0x30,
+		0x1d, 0xb2, 0xa8, 0x84, 0x56, 0xf2, 0x1c, 0xa4, 0x98, 0x2e, 0xb3, 0x65, 0xf6, 0xe1, 0xd0, 0xd5,
+		0x89, 0x6a, 0xf6, 0xc0, 0x52, 0x4b, 0xe9, 0xfc, 0x4e, 0x48, 0x62, 0x88, 0x13, 0x82, 0x2c, 0x06,
+		0x14, 0xf6, 0x3b, 0x06, 0x8c, 0x3f, 0x5b, 0x1f, 0x2f, 0x8c, 0xb2, 0x3e, 0x52, 0xd8, 0xc1, 0x16,
+		0x81, 0x54, 0xc8, 0x22, 0x70, 0x11, 0xa6, 0x06, 0x18, 0x8d, 0x6c, 0x8c, 0xdf, 0x1f, 0x83, 0xe2,
+		0x30, 0xe1, 0x44, 0x98, 0xc4, 0x78, 0xc0, 0x24, 0x5e, 0xec, 0x97, 0xe0, 0xbd, 0xc3, 0x07, 0x61,
+		0x60, 0xac, 0x7f, 0x39, 0x06, 0x87, 0xc3, 0x9d, 0xcd, 0xd0, 0x36, 0xbc, 0x17, 0xd2, 0x1d, 0xe2,
+		0xec, 0x98, 0xc2, 0xad, 0x7a, 0x30, 0x64, 0xb1, 0xc6, 0xe2, 0xfe, 0xc1, 0xe6, 0x54, 0xf2, 0x33,
+		0xfd, 0x6d, 0x3d, 0x39, 0xcc, 0xf5, 0x1d, 0x68, 0xe9, 0x0f, 0xc6, 0xe1, 0x50, 0x28, 0xf3, 0xd0,
+		0x86, 0x1e, 0x07, 0xd0, 0x0d, 0xab, 0xe7, 0x30, 0xd7, 0x89, 0x59, 0xe2, 0x2c, 0x85, 0x50, 0xe3,
+		0x85, 0x56, 0xb6, 0xe7, 0xb8, 0xe5, 0x09, 0x5a, 0x0e, 0x0c, 0x44, 0x11, 0x9e, 0xf6, 0x1a, 0x9a,
+		0xa4, 0x0d, 0x3d, 0x31, 0xa4, 0xa7, 0x03, 0x8a, 0xf9, 0x18, 0x48, 0x8d, 0xb6, 0x4e, 0x0c, 0x47,
+		0xb5, 0x9d, 0x2e, 0xd1, 0x3a, 0xba, 0xd1, 0xa2, 0x4b, 0x4d, 0x66, 0x3e, 0xb5, 0xad, 0xb5, 0x6d,
+		0xa2, 0x4c, 0xb2, 0xe2, 0xba, 0x28, 0x45, 0x0a, 0xaa, 0x40, 0x5d, 0x1f, 0x45, 0x3a, 0x40, 0xc1,
+		0x8a, 0x5d, 0x8a, 0xf2, 0x8f, 0x66, 0x21, 0xe7, 0x73, 0xcd, 0xe5, 0x7b, 0x21, 0xff, 0xaa, 0x76,
+		0x43, 0x53, 0x45, 0xb8, 0xc5, 0x24, 0x91, 0x43, 0xd8, 0x3a, 0x03, 0xc9, 0x8f, 0xc1, 0x0c, 0x45,
+		0x31, 0x7b, 0x0e, 0xe9, 0xaa, 0x8d, 0xb6, 0x66, 0xdb, 0x54, 0x68, 0x19, 0x8a, 0x2a, 0x63, 0xd9,
+		0x1a, 0x16, 0x55, 0x45, 0x89, 0x7c, 0x1e, 0xa6, 0x29, 0x45, 0xa7, 0xd7, 0x76, 0x74, 0xab, 0x4d,
+		0x54, 0x0c, 0x00, 0xed, 0x22, 0xf8, 0x5b, 0x36, 0x85, 0x18, 0x2b, 0x1c, 0x01, 0x5b, 0x64, 0xcb,
+		0x8b, 0x70, 0x9c, 0x92, 0xb5, 0x88, 0x41, 0xba, 0x9a, 0x43, 0x54, 0xf2, 0xbe, 0x9e, 0xd6, 0xb6,
+		0x55, 0xcd, 0x68, 0xaa, 0x3b, 0x9a, 0xbd, 0x53, 0x9c, 0x41, 0x06, 0x0b, 0xf1, 0x62, 0x4c, 0x39,
+		0x8a, 0x88, 0x97, 0x39, 0x5e, 0x8d, 0xa2, 0x55, 0x8c, 0xe6, 0x15, 0xcd, 0xde, 0x91, 0xe7, 0xe1,
+		0x30, 0xe5, 0x62, 0x3b, 0x5d, 0xdd, 0x68, 0xa9, 0x8d, 0x1d, 0xd2, 0xb8, 0xae, 0xf6, 0x9c, 0xed,
+		0xa7, 0x8b, 0xc7, 0xfc, 0xf5, 0xd3, 0x16, 0xd6, 0x29, 0x4e, 0x15, 0x51, 0x36, 0x9d, 0xed, 0xa7,
+		0xe5, 0x3a, 0xe4, 0x71, 0x30, 0x3a, 0xfa, 0x6b, 0x44, 0xdd, 0x36, 0xbb, 0x74, 0x0d, 0x9d, 0x08,
+		0x31, 0x4d, 0x3e, 0x09, 0xce, 0xad, 0x71, 0x82, 0x15, 0xb3, 0x49, 0xe6, 0x53, 0xf5, 0xf5, 0x5a,
+		0x6d, 0x51, 0xc9, 0x09, 0x2e, 0x97, 0xcc, 0x2e, 0x2a, 0x54, 0xcb, 0x74, 0x05, 0x9c, 0x63, 0x0a,
+		0xd5, 0x32, 0x85, 0x78, 0xcf, 0xc3, 0x74, 0xa3, 0xc1, 0xfa, 0xac, 0x37, 0x54, 0x1e, 0xa6, 0xd9,
+		0x45, 0x29, 0x20, 0xac, 0x46, 0xe3, 0x32, 0x43, 0xe0, 0x3a, 0x6e, 0xcb, 0xcf, 0xc0, 0x21, 0x4f,
+		0x58, 0x7e, 0xc2, 0xa9, 0x81, 0x5e, 0xf6, 0x93, 0x9e, 0x87, 0x69, 0x6b, 0x6f, 0x90, 0x50, 0x0e,
+		0xd4, 0x68, 0xed, 0xf5, 0x93, 0x3d, 0x05, 0x33, 0xd6, 0x8e, 0x35, 0x48, 0x77, 0xda, 0x4f, 0x27,
+		0x5b, 0x3b, 0x56, 0x3f, 0xe1, 0x03, 0x34, 0x66, 0xef, 0x92, 0x86, 0xe6, 0x90, 0x66, 0xf1, 0x88,
+		0x1f, 0xdd, 0x57, 0x20, 0xcf, 0x81, 0xd4, 0x68, 0xa8, 0xc4, 0xd0, 0xb6, 0xda, 0x44, 0xd5, 0xba,
+		0xc4, 0xd0, 0xec, 0xe2, 0x49, 0x8a, 0x9c, 0x74, 0xba, 0x3d, 0xa2, 0x4c, 0x34, 0x1a, 0x35, 0x5a,
+		0x58, 0xa1, 0x65, 0xf2, 0x69, 0x98, 0x32, 0xb7, 0x5e, 0x6d, 0x30, 0x8d, 0x54, 0xad, 0x2e, 0xd9,
+		0xd6, 0x77, 0x8b, 0xf7, 0x53, 0xf1, 0x4e, 0x62, 0x01, 0xd5, 0xc7, 0x75, 0x0a, 0x96, 0x1f, 0x06,
+		0xa9, 0x61, 0xef, 0x68, 0x5d, 0x8b, 0x9a, 0x64, 0xdb, 0xd2, 0x1a, 0xa4, 0xf8, 0x00, 0x43, 0x65,
+		0xf0, 0x55, 0x01, 0xc6, 0x19, 0x61, 0xdf, 0xd4, 0xb7, 0x1d, 0xc1, 0xf1, 0x21, 0x36, 0x23, 0x28,
+		0x8c, 0x73, 0x3b, 0x05, 0x12, 0x4a, 0x22, 0x50, 0xf1, 0x29, 0x8a, 0x36, 0x61, 0xed, 0x58, 0xfe,
+		0x7a, 0xef, 0x83, 0x82, 0xb5, 0xe3, 0xaf, 0xf4, 0x61, 0xe6, 0xb8, 0x59, 0x3b, 0xbe, 0x1a, 0x9f,
+		0x84, 0xc3, 0x88, 0xd4, 0x21, 0x8e, 0xd6, 0xd4, 0x1c, 0xcd, 0x87, 0xfd, 0x28, 0xc5, 0x46, 0xb1,
+		0xaf, 0xf0, 0xc2, 0x40, 0x3b, 0xbb, 0xbd, 0xad, 0x3d, 0x57, 0xb1, 0xce, 0xb0, 0x76, 0x22, 0x4c,
+		0xa8, 0xd6, 0x3b, 0xe6, 0x9c, 0x97, 0xe7, 0x21, 0xef, 0xd7, 0x7b, 0x39, 0x0b, 0x4c, 0xf3, 0xa5,
+		0x18, 0x3a, 0x41, 0xd5, 0xb5, 0x45, 0x74, 0x5f, 0x5e, 0xa9, 0x49, 0x71, 0x74, 0xa3, 0x96, 0x97,
+		0x36, 0x6a, 0xaa, 0xb2, 0xb9, 0xba, 0xb1, 0xb4, 0x52, 0x93, 0x12, 0x3e, 0xc7, 0xfe, 0x6a, 0x32,
+		0xf3, 0xa0, 0xf4, 0x50, 0xf9, 0xd3, 0x09, 0x98, 0x08, 0x46, 0x6a, 0xf2, 0x7b, 0xe0, 0x88, 0x48,
+		0xb8, 0xd8, 0xc4, 0x51, 0x6f, 0xea, 0x5d, 0x3a, 0x21, 0x3b, 0x1a, 0x5b, 0x1c, 0x5d, 0xfd, 0x99,
+		0xe1, 0x58, 0x75, 0xe2, 0xbc, 0xa8, 0x77, 0x71, 0xba, 0x75, 0x34, 0x47, 0x5e, 0x86, 0x93, 0x86,
+		0xa9, 0xda, 0x8e, 0x66, 0x34, 0xb5, 0x6e, 0x53, 0xf5, 0x52, 0x5d, 0xaa, 0xd6, 0x68, 0x10, 0xdb,
+		0x36, 0xd9, 0x42, 0xe8, 0x72, 0xb9, 0xc7, 0x30, 0xeb, 0x1c, 0xd9, 0x5b, 0x21, 0x2a, 0x1c, 0xb5,
+		0x4f, 0x7d, 0x13, 0xc3, 0xd4, 0xf7, 0x18, 0x64, 0x3b, 0x9a, 0xa5, 0x12, 0xc3, 0xe9, 0xee, 0x51,
+		0xff, 0x3c, 0xa3, 0x64, 0x3a, 0x9a, 0x55, 0xc3, 0x6f, 0xf9, 0x1a, 0x3c, 0xe8, 0xa1, 0xaa, 0x6d,
+		0xd2, 0xd2, 0x1a, 0x7b, 0x2a, 0x75, 0xc6, 0x69, 0xda, 0x40, 0x6d, 0x98, 0xc6, 0x76, 0x5b, 0x6f,
+		0x38, 0x76, 0x31, 0xe7, 0xda, 0xb8, 0xb2, 0x47, 0xb1, 0x4c, 0x09, 0xae, 0xda, 0xa6, 0x41, 0x7d,
+		0xf0, 0xaa, 0xc0, 0x7e, 0x57, 0xc2, 0xaf, 0xab, 0xc9, 0x4c, 0x52, 0x4a, 0x5d, 0x4d, 0x66, 0x52,
+		0x52, 0xfa, 0x6a, 0x32, 0x93, 0x96, 0xc6, 0xaf, 0x26, 0x33, 0x19, 0x29, 0x7b, 0x35, 0x99, 0xc9,
+		0x4a, 0x50, 0xfe, 0x64, 0x06, 0xf2, 0xfe, 0xc8, 0x00, 0x03, 0xad, 0x06, 0x5d, 0x1b, 0x63, 0xd4,
+		0x7a, 0xde, 0xb7, 0x6f, 0x1c, 0x31, 0x57, 0xc5, 0x45, 0x73, 0x3e, 0xcd, 0xdc, 0x70, 0x85, 0x51,
+		0xa2, 0xc3, 0x82, 0x6a, 0x4d, 0x98, 0xdb, 0x93, 0x51, 0xf8, 0x97, 0x7c, 0x19, 0xd2, 0xaf, 0xda,
+		0x94, 0x77, 0x9a, 0xf2, 0xbe, 0x7f, 0x7f, 0xde, 0x57, 0xeb, 0x94, 0x79, 0xf6, 0x6a, 0x5d, 0x5d,
+		0x5d, 0x53, 0x56, 0x2a, 0xcb, 0x0a, 0x27, 0x97, 0x8f, 0x42, 0xb2, 0xad, 0xbd, 0xb6, 0x17, 0x5c,
+		0x5e, 0x29, 0x48, 0x9e, 0x83, 0xc9, 0x9e, 0x71, 0x83, 0x74, 0xf5, 0x6d, 0x1d, 0x87, 0x0a, 0xb1,
+		0x26, 0xfd, 0x58, 0x13, 0x5e, 0xe9, 0x32, 0xe2, 0x8f, 0xa8, 0x1e, 0x47, 0x21, 0x89, 0x49, 0xc5,
+		0xe0, 0x22, 0x48, 0x41, 0xf2, 0x29, 0xc8, 0x37, 0xc9, 0x56, 0xaf, 0xa5, 0x76, 0x49, 0x53, 0x6b,
+		0x38, 0x41, 0xd3, 0x9f, 0xa3, 0x45, 0x0a, 0x2d, 0x91, 0x9f, 0x87, 0x2c, 0x8e, 0x91, 0x41, 0xc7,
+		0x78, 0x8a, 0x8a, 0xe0, 0xcc, 0xfe, 0x22, 0xe0, 0x43, 0x2c, 0x88, 0x14, 0x8f, 0x5e, 0xbe, 0x04,
+		0x69, 0x47, 0xeb, 0xb6, 0x88, 0x43, 0x2d, 0xff, 0xc4, 0xb9, 0xb9, 0x51, 0x38, 0x6d, 0x50, 0x0a,
+		0x1a, 0xd3, 0x72, 0xea, 0x77, 0xd0, 0xca, 0x9c, 0x85, 0x14, 0x55, 0x0f, 0x19, 0x80, 0x2b, 0x88,
+		0x34, 0x26, 0x67, 0x20, 0x59, 0x5d, 0x53, 0xd0, 0xd2, 0x48, 0x90, 0x67, 0x50, 0x75, 0x7d, 0xa9,
+		0x56, 0xad, 0x49, 0xf1, 0xf2, 0x79, 0x48, 0xb3, 0x31, 0x47, 0x2b, 0xe4, 0x8e, 0xba, 0x34, 0xc6,
+		0x3f, 0x39, 0x8f, 0x98, 0x28, 0xdd, 0x5c, 0x59, 0xa8, 0x29, 0x52, 0xbc, 0xbc, 0x09, 0x93, 0x7d,
+		0x72, 0x92, 0x0f, 0xc1, 0x94, 0x52, 0xdb, 0xa8, 0xad, 0x62, 0x9c, 0xa5, 0x6e, 0xae, 0x3e, 0xbf,
+		0xba, 0xf6, 0xe2, 0xaa, 0x34, 0x16, 0x04, 0x0b, 0x93, 0x16, 0x93, 0x67, 0x40, 0xf2, 0xc0, 0xf5,
+		0xb5, 0x4d, 0x85, 0xb6, 0xe6, 0x87, 0xe2, 0x20, 0xf5, 0x4b, 0x4d, 0x3e, 0x02, 0xd3, 0x1b, 0x15,
+		0xe5, 0x72, 0x6d, 0x43, 0x65, 0xb1, 0xa3, 0xcb, 0x7a, 0x06, 0x24, 0x7f, 0xc1, 0xa5, 0x25, 0x1a,
+		0x1a, 0x9f, 0x84, 0x63, 0x7e, 0x68, 0xed, 0xa5, 0x8d, 0xda, 0x6a, 0x9d, 0x56, 0x5e, 0x59, 0xbd,
+		0x8c, 0xf6, 0xb5, 0x8f, 0x9f, 0x88, 0x56, 0x13, 0xd8, 0xd4, 0x20, 0xbf, 0xda, 0xf2, 0xa2, 0x94,
+		0xec, 0x07, 0xaf, 0xad, 0xd6, 0xd6, 0x2e, 0x49, 0xa9, 0xfe, 0xda, 0x69, 0x04, 0x9b, 0x96, 0x4b,
+		0x70, 0xb8, 0x1f, 0xaa, 0xd6, 0x56, 0x37, 0x94, 0x97, 0xa5, 0xf1, 0xfe, 0x8a, 0xeb, 0x35, 0xe5,
+		0xda, 0x52, 0xb5, 0x26, 0x65, 0xe4, 0xc3, 0x20, 0x07, 0x5b, 0xb4, 0x71, 0x65, 0x6d, 0x51, 0xca,
+		0x0e, 0x58, 0x94, 0xb2, 0x0d, 0x79, 0x7f, 0x18, 0xf9, 0xee, 0xe4, 0x92, 0x3e, 0x18, 0x87, 0x9c,
+		0x2f, 0x2c, 0x44, 0x7f, 0x5e, 0x6b, 0xb7, 0xcd, 0x9b, 0xaa, 0xd6, 0xd6, 0x35, 0x9b, 0xdb, 0x1b,
+		0xa0, 0xa0, 0x0a, 0x42, 0x46, 0x9d, 0xdf, 0xa3, 0x5b, 0xf8, 0xf4, 0xb7, 0xa2, 0x85, 0x4f, 0x49,
+		0xe9, 0xf2, 0xcf, 0xc5, 0x40, 0xea, 0x8f, 0xf7, 0xfa, 0xba, 0x1f, 0x1b, 0xd6, 0xfd, 0x77, 0x65,
+		0xec, 0x7e, 0x36, 0x06, 0x13, 0xc1, 0x20, 0xaf, 0xaf, 0x79, 0xf7, 0xfe, 0xa5, 0x36, 0xef, 0xf7,
+		0xe3, 0x50, 0x08, 0x84, 0x76, 0xa3, 0xb6, 0xee, 0x7d, 0x30, 0xa5, 0x37, 0x49, 0xc7, 0x32, 0x1d,
+		0xdc, 0x6d, 0x52, 0xdb, 0xe4, 0x06, 0x69, 0x17, 0xcb, 0xd4, 0x28, 0x9f, 0xdd, 0x3f, 0x78, 0x9c,
+		0x5b, 0xf2, 0xe8, 0x96, 0x91, 0x6c, 0x7e, 0x7a, 0x69, 0xb1, 0xb6, 0xb2, 0xbe, 0xb6, 0x51, 0x5b,
+		0xad, 0xbe, 0x2c, 0xac, 0x8b, 0x22, 0xe9, 0x7d, 0x68, 0xef, 0xa0, 0xd1, 0x5e, 0x07, 0xa9, 0xbf,
+		0x51, 0x68, 0x2b, 0x42, 0x9a, 0x25, 0x8d, 0xc9, 0xd3, 0x30, 0xb9, 0xba, 0xa6, 0xd6, 0x97, 0x16,
+		0x6b, 0x6a, 0xed, 0xd2, 0xa5, 0x5a, 0x75, 0xa3, 0xce, 0xd2, 0x81, 0x2e, 0xf6, 0x86, 0x14, 0xf7,
+		0x8b, 0xf8, 0xa7, 0x13, 0x30, 0x1d, 0xd2, 0x12, 0xb9, 0xc2, 0x03, 0x79, 0x96, 0x5b, 0x38, 0x33,
+		0x4a, 0xeb, 0xe7, 0xd0, 0x95, 0x5e, 0xd7, 0xba, 0x0e, 0x8f, 0xfb, 0x1f, 0x06, 0x94, 0x92, 0xe1,
+		0xe0, 0xca, 0xde, 0xe5, 0x69, 0x56, 0x16, 0xdd, 0x4f, 0x7a, 0x70, 0x96, 0x69, 0x7d, 0x14, 0x64,
+		0xcb, 0xb4, 0x75, 0x47, 0xbf, 0x81, 0x7b, 0x58, 0x22, 0x27, 0x8b, 0xd1, 0x7e, 0x52, 0x91, 0x44,
+		0xc9, 0x92, 0xe1, 0xb8, 0xd8, 0x06, 0x69, 0x69, 0x7d, 0xd8, 0xe8, 0x79, 0x24, 0x14, 0x49, 0x94,
+		0xb8, 0xd8, 0xf7, 0x42, 0xbe, 0x69, 0xf6, 0x30, 0x04, 0x62, 0x78, 0x68, 0x2d, 0x62, 0x4a, 0x8e,
+		0xc1, 0x5c, 0x14, 0x1e, 0xdc, 0x7a, 0xc9, 0xe0, 0xbc, 0x92, 0x63, 0x30, 0x86, 0xf2, 0x10, 0x4c,
+		0x6a, 0xad, 0x56, 0x17, 0x99, 0x0b, 0x46, 0x2c, 0x5c, 0x9f, 0x70, 0xc1, 0x14, 0xb1, 0x74, 0x15,
+		0x32, 0x42, 0x0e, 0xe8, 0xc1, 0xa2, 0x24, 0x54, 0x8b, 0xe5, 0xa0, 0xe2, 0x98, 0x1f, 0x36, 0x44,
+		0xe1, 0xbd, 0x90, 0xd7, 0x6d, 0xd5, 0xdb, 0xdb, 0x8a, 0xcf, 0xc6, 0x4f, 0x65, 0x94, 0x9c, 0x6e,
+		0xbb, 0xfb, 0x02, 0xe5, 0x5f, 0x8e, 0xc3, 0x44, 0x70, 0xd7, 0x4e, 0x5e, 0x84, 0x4c, 0xdb, 0x6c,
+		0x68, 0x54, 0xb5, 0xd8, 0x96, 0xf1, 0xa9, 0x88, 0x8d, 0xbe, 0xb9, 0x65, 0x8e, 0xaf, 0xb8, 0x94,
+		0xa5, 0x7f, 0x13, 0x83, 0x8c, 0x00, 0xcb, 0x87, 0x21, 0x69, 0x69, 0xce, 0x0e, 0x65, 0x97, 0x5a,
+		0x88, 0x4b, 0x31, 0x85, 0x7e, 0x23, 0xdc, 0xb6, 0x34, 0xa3, 0x18, 0xf7, 0xe0, 0xf8, 0x8d, 0xe3,
+		0xda, 0x26, 0x5a, 0x93, 0xe6, 0x02, 0xcc, 0x4e, 0x87, 0x18, 0x8e, 0x2d, 0xc6, 0x95, 0xc3, 0xab,
+		0x1c, 0x8c, 0x9b, 0xc7, 0x4e, 0x57, 0xd3, 0xdb, 0x01, 0xdc, 0x24, 0xc5, 0x95, 0x44, 0x81, 0x8b,
+		0x3c, 0x0f, 0x47, 0x05, 0xdf, 0x26, 0x71, 0xb4, 0xc6, 0x0e, 0x69, 0x7a, 0x44, 0x69, 0x9a, 0xf3,
+		0x3b, 0xc2, 0x11, 0x16, 0x79, 0xb9, 0xa0, 0x2d, 0x7f, 0x2e, 0x0e, 0x53, 0x22, 0x7b, 0xd1, 0x74,
+		0x85, 0xb5, 0x02, 0xa0, 0x19, 0x86, 0xe9, 0xf8, 0xc5, 0x35, 0xa8, 0xca, 0x03, 0x74, 0x73, 0x15,
+		0x97, 0x48, 0xf1, 0x31, 0x28, 0xfd, 0x61, 0x0c, 0xc0, 0x2b, 0x1a, 0x2a, 0xb7, 0x93, 0x90, 0xe3,
+		0x7b, 0xb2, 0x74, 0x63, 0x9f, 0x25, 0xbc, 0x80, 0x81, 0x30, 0xcf, 0x81, 0x69, 0xc9, 0x2d, 0xd2,
+		0xd2, 0x0d, 0xbe, 0x9f, 0xc2, 0x3e, 0x44, 0x5a, 0x32, 0xe9, 0x6d, 0x4f, 0x29, 0x90, 0xb1, 0x49,
+		0x47, 0x33, 0x1c, 0xbd, 0xc1, 0x77, 0x48, 0x2e, 0x1c, 0xa8, 0xf1, 0x73, 0x75, 0x4e, 0xad, 0xb8,
+		0x7c, 0xca, 0xa7, 0x20, 0x23, 0xa0, 0xe8, 0xf8, 0xad, 0xae, 0xad, 0xd6, 0xa4, 0x31, 0x79, 0x1c,
+		0x12, 0xf5, 0xda, 0x86, 0x14, 0xc3, 0xb0, 0xb3, 0xb2, 0xbc, 0x54, 0xa9, 0x4b, 0xf1, 0x85, 0xff,
+		0x1f, 0xa6, 0x1b, 0x66, 0xa7, 0xbf, 0xc2, 0x05, 0xa9, 0x2f, 0xe5, 0x67, 0x5f, 0x89, 0xbd, 0x72,
+		0x86, 0x23, 0xb5, 0xcc, 0xb6, 0x66, 0xb4, 0xe6, 0xcc, 0x6e, 0xcb, 0x3b, 0x16, 0x81, 0xd1, 0x81,
+		0xed, 0x3b, 0x1c, 0x61, 0x6d, 0xfd, 0x8f, 0x58, 0xec, 0xe7, 0xe3, 0x89, 0xcb, 0xeb, 0x0b, 0xbf,
+		0x1a, 0x2f, 0x5d, 0x66, 0x84, 0xeb, 0xa2, 0x3b, 0x0a, 0xd9, 0x6e, 0x93, 0x06, 0x36, 0x1e, 0xfe,
+		0xe8, 0x11, 0x98, 0x69, 0x99, 0x2d, 0x93, 0x72, 0x3a, 0x8b, 0xbf, 0x58, 0x23, 0xe4, 0xac, 0x0b,
+		0x2d, 0x45, 0x1e, 0xc2, 0x98, 0x5f, 0x85, 0x69, 0x8e, 0xac, 0xd2, 0xed, 0x5b, 0x96, 0x5c, 0x90,
+		0xf7, 0xcd, 0x6c, 0x17, 0x7f, 0xed, 0x0f, 0xa8, 0x57, 0xa2, 0x4c, 0x71, 0x52, 0x2c, 0x63, 0xf9,
+		0x87, 0x79, 0x05, 0x0e, 0x05, 0xf8, 0x31, 0x1b, 0x41, 0xba, 0x11, 0x1c, 0xff, 0x05, 0xe7, 0x38,
+		0xed, 0xe3, 0x58, 0xe7, 0xa4, 0xf3, 0x55, 0x28, 0x1c, 0x84, 0xd7, 0xbf, 0xe4, 0xbc, 0xf2, 0xc4,
+		0xcf, 0xe4, 0x32, 0x4c, 0x52, 0x26, 0x8d, 0x9e, 0xed, 0x98, 0x1d, 0x6a, 0x80, 0xf7, 0x67, 0xf3,
+		0xaf, 0xfe, 0x80, 0x4d, 0xda, 0x09, 0x24, 0xab, 0xba, 0x54, 0xf3, 0xf3, 0x40, 0x77, 0xac, 0x71,
+		0x27, 0x39, 0x82, 0xc3, 0x67, 0x78, 0x43, 0x5c, 0xfc, 0xf9, 0x6b, 0x30, 0x83, 0xbf, 0xa9, 0x7d,
+		0xf4, 0xb7, 0x24, 0x3a, 0x0d, 0x5e, 0xfc, 0xb7, 0xef, 0x67, 0x76, 0x61, 0xda, 0x65, 0xe0, 0x6b,
+		0x93, 0x6f, 0x14, 0x5b, 0xc4, 0x71, 0x48, 0xd7, 0x56, 0xb5, 0x76, 0x58, 0xf3, 0x7c, 0x79, 0xc4,
+		0xe2, 0x4f, 0x7d, 0x35, 0x38, 0x8a, 0x97, 0x19, 0x65, 0xa5, 0xdd, 0x9e, 0xdf, 0x84, 0x23, 0x21,
+		0x5a, 0x31, 0x02, 0xcf, 0x9f, 0xe6, 0x3c, 0x67, 0x06, 0x34, 0x03, 0xd9, 0xae, 0x83, 0x80, 0xbb,
+		0x63, 0x39, 0x02, 0xcf, 0x9f, 0xe1, 0x3c, 0x65, 0x4e, 0x2b, 0x86, 0x14, 0x39, 0x5e, 0x85, 0xa9,
+		0x1b, 0xa4, 0xbb, 0x65, 0xda, 0x3c, 0x77, 0x3b, 0x02, 0xbb, 0x9f, 0xe5, 0xec, 0x26, 0x39, 0x21,
+		0x4d, 0xe6, 0x22, 0xaf, 0x67, 0x20, 0xb3, 0xad, 0x35, 0xc8, 0x08, 0x2c, 0x6e, 0x73, 0x16, 0xe3,
+		0x88, 0x8f, 0xa4, 0x15, 0xc8, 0xb7, 0x4c, 0xbe, 0x44, 0x46, 0x93, 0xff, 0x1c, 0x27, 0xcf, 0x09,
+		0x1a, 0xce, 0xc2, 0x32, 0xad, 0x5e, 0x1b, 0xd7, 0xcf, 0x68, 0x16, 0x7f, 0x5b, 0xb0, 0x10, 0x34,
+		0x9c, 0xc5, 0x01, 0xc4, 0xfa, 0x21, 0xc1, 0xc2, 0xf6, 0xc9, 0xf3, 0x39, 0xdc, 0xd2, 0x6d, 0xef,
+		0x99, 0xc6, 0x28, 0x8d, 0xf8, 0x30, 0xe7, 0x00, 0x9c, 0x04, 0x19, 0x5c, 0x84, 0xec, 0xa8, 0x03,
+		0xf1, 0x8b, 0x5f, 0x15, 0xd3, 0x43, 0x8c, 0xc0, 0x65, 0x98, 0x14, 0x06, 0x0a, 0x8f, 0x80, 0x44,
+		0xb3, 0xf8, 0x25, 0xce, 0x62, 0xc2, 0x47, 0xc6, 0xbb, 0xe1, 0x10, 0xdb, 0x69, 0x91, 0x51, 0x98,
+		0xfc, 0xb2, 0xe8, 0x06, 0x27, 0xe1, 0xa2, 0xdc, 0x22, 0x46, 0x63, 0x67, 0x34, 0x0e, 0x1f, 0x11,
+		0xa2, 0x14, 0x34, 0xc8, 0xa2, 0x0a, 0x85, 0x8e, 0xd6, 0xb5, 0x77, 0xb4, 0xf6, 0x48, 0xc3, 0xf1,
+		0x2b, 0x9c, 0x47, 0xde, 0x25, 0xe2, 0x12, 0xe9, 0x19, 0x07, 0x61, 0xf3, 0xab, 0x42, 0x22, 0x3d,
+		0x23, 0xc0, 0x68, 0x1d, 0x66, 0x6c, 0x87, 0x26, 0xba, 0x0f, 0xc2, 0xed, 0xef, 0x8a, 0xa9, 0xc7,
+		0x68, 0x57, 0xfc, 0x1c, 0x2f, 0x42, 0xd6, 0xd6, 0x5f, 0x1b, 0x89, 0xcd, 0xdf, 0x13, 0x23, 0x4d,
+		0x09, 0x90, 0xf8, 0x65, 0x38, 0x1a, 0xba, 0x4c, 0x8c, 0xc0, 0xec, 0xef, 0x73, 0x66, 0x87, 0x43,
+		0x96, 0x0a, 0x6e, 0x12, 0x0e, 0xca, 0xf2, 0x1f, 0x08, 0x93, 0x40, 0xfa, 0x78, 0xad, 0x63, 0xd0,
+		0x62, 0x6b, 0xdb, 0x07, 0x93, 0xda, 0x3f, 0x14, 0x52, 0x63, 0xb4, 0x01, 0xa9, 0x6d, 0xc0, 0x61,
+		0xce, 0xf1, 0x60, 0xe3, 0xfa, 0x51, 0x61, 0x58, 0x19, 0xf5, 0x66, 0x70, 0x74, 0xbf, 0x0d, 0x4a,
+		0xae, 0x38, 0x85, 0x77, 0x6c, 0xab, 0x98, 0x1d, 0x8e, 0xe6, 0xfc, 0x6b, 0x9c, 0xb3, 0xb0, 0xf8,
+		0xae, 0x7b, 0x6d, 0xaf, 0x68, 0x16, 0x32, 0x7f, 0x09, 0x8a, 0x82, 0x79, 0xcf, 0xe8, 0x92, 0x86,
+		0xd9, 0x32, 0xf4, 0xd7, 0x48, 0x73, 0x04, 0xd6, 0x1f, 0xeb, 0x1b, 0xaa, 0x4d, 0x1f, 0x39, 0x72,
+		0x5e, 0x02, 0xc9, 0xf5, 0x55, 0x54, 0xbd, 0x63, 0x99, 0x5d, 0x27, 0x82, 0xe3, 0xc7, 0xc5, 0x48,
+		0xb9, 0x74, 0x4b, 0x94, 0x6c, 0xbe, 0x06, 0xec, 0xf4, 0xc7, 0xa8, 0x2a, 0xf9, 0x06, 0x67, 0x54,
+		0xf0, 0xa8, 0xb8, 0xe1, 0x68, 0x98, 0x1d, 0x4b, 0xeb, 0x8e, 0x62, 0xff, 0xfe, 0x91, 0x30, 0x1c,
+		0x9c, 0x84, 0x1b, 0x0e, 0xf4, 0xe8, 0x70, 0xb5, 0x1f, 0x81, 0xc3, 0x27, 0x84, 0xe1, 0x10, 0x34,
+		0x9c, 0x85, 0x70, 0x18, 0x46, 0x60, 0xf1, 0x49, 0xc1, 0x42, 0xd0, 0x20, 0x8b, 0x17, 0xbc, 0x85,
+		0xb6, 0x4b, 0x5a, 0xba, 0xed, 0x74, 0x99, 0x4b, 0xbe, 0x3f, 0xab, 0x4f, 0x7d, 0x35, 0xe8, 0x84,
+		0x29, 0x3e, 0x52, 0xb4, 0x44, 0x7c, 0xeb, 0x83, 0x86, 0x6c, 0xd1, 0x0d, 0xfb, 0x75, 0x61, 0x89,
+		0x7c, 0x64, 0xd8, 0x36, 0x9f, 0x87, 0x88, 0x62, 0x6f, 0x60, 0xa0, 0x32, 0x02, 0xbb, 0x7f, 0xdc,
+		0xd7, 0xb8, 0xba, 0xa0, 0x45, 0x9e, 0x3e, 0xff, 0xa7, 0x67, 0x5c, 0x27, 0x7b, 0x23, 0x69, 0xe7,
+		0xa7, 0xfb, 0xfc, 0x9f, 0x4d, 0x46, 0xc9, 0x6c, 0xc8, 0x64, 0x9f, 0x3f, 0x25, 0x47, 0x9d, 0xf5,
+		0x2b, 0x7e, 0xcf, 0xdb, 0xbc, 0xbf, 0x41, 0x77, 0x6a, 0x7e, 0x19, 0x24, 0x0e, 0xf1, 0x1c, 0xd8,
+		0x48, 0x66, 0xef, 0x7f, 0xdb, 0xd5, 0xf3, 0x80, 0xcf, 0x33, 0x7f, 0x09, 0x0a, 0x01, 0x87, 0x27,
+		0x9a, 0xd5, 0xf7, 0x71, 0x56, 0x79, 0xbf, 0xbf, 0x33, 0x7f, 0x1e, 0x92, 0xe8, 0xbc, 0x44, 0x93,
+		0x7f, 0x3f, 0x27, 0xa7, 0xe8, 0xf3, 0xcf, 0x42, 0x46, 0x38, 0x2d, 0xd1, 0xa4, 0x7f, 0x8d, 0x93,
+		0xba, 0x24, 0x48, 0x2e, 0x1c, 0x96, 0x68, 0xf2, 0xbf, 0x2e, 0xc8, 0x05, 0x09, 0x92, 0x8f, 0x2e,
+		0xc2, 0xdf, 0xfa, 0x1b, 0x49, 0x46, 0x2e, 0x48, 0xe6, 0xf1, 0xf4, 0x09, 0xf3, 0x54, 0xa2, 0xa9,
+		0x7f, 0x90, 0x57, 0x2e, 0x28, 0xe6, 0x9f, 0x82, 0xd4, 0x88, 0x02, 0xff, 0x9b, 0x9c, 0x94, 0xe1,
+		0xcf, 0x57, 0x21, 0xe7, 0xf3, 0x4e, 0xa2, 0xc9, 0x7f, 0x98, 0x93, 0xfb, 0xa9, 0xb0, 0xe9, 0xdc,
+		0x3b, 0x89, 0x66, 0xf0, 0x23, 0xa2, 0xe9, 0x9c, 0x02, 0xc5, 0x26, 0x1c, 0x93, 0x68, 0xea, 0x0f,
+		0x08, 0xa9, 0x0b, 0x92, 0xf9, 0xe7, 0x20, 0xeb, 0x2e, 0x36, 0xd1, 0xf4, 0x3f, 0xca, 0xe9, 0x3d,
+		0x1a, 0x94, 0x40, 0xcf, 0x38, 0x00, 0x8b, 0x1f, 0x13, 0x12, 0xf0, 0x51, 0xe1, 0x34, 0xea, 0x77,
+		0x60, 0xa2, 0x39, 0xfd, 0xb8, 0x98, 0x46, 0x7d, 0xfe, 0x0b, 0x8e, 0x26, 0xb5, 0xf9, 0xd1, 0x2c,
+		0x7e, 0x42, 0x8c, 0x26, 0xc5, 0xc7, 0x66, 0xf4, 0x7b, 0x04, 0xd1, 0x3c, 0x7e, 0x52, 0x34, 0xa3,
+		0xcf, 0x21, 0x98, 0x5f, 0x07, 0x79, 0xd0, 0x1b, 0x88, 0xe6, 0xf7, 0x41, 0xce, 0x6f, 0x6a, 0xc0,
+		0x19, 0x98, 0x7f, 0x11, 0x0e, 0x87, 0x7b, 0x02, 0xd1, 0x5c, 0x7f, 0xea, 0xed, 0xbe, 0xd8, 0xcd,
+		0xef, 0x08, 0xcc, 0x6f, 0xc0, 0x4c, 0x98, 0x17, 0x10, 0xcd, 0xf6, 0xa7, 0xdf, 0x0e, 0x1a, 0x6e,
+		0xbf, 0x13, 0x30, 0x5f, 0x01, 0xf0, 0x16, 0xe0, 0x68, 0x5e, 0x3f, 0xcb, 0x79, 0xf9, 0x88, 0x70,
+		0x6a, 0xf0, 0xf5, 0x37, 0x9a, 0xfe, 0xb6, 0x98, 0x1a, 0x9c, 0x02, 0xa7, 0x86, 0x58, 0x7a, 0xa3,
+		0xa9, 0x7f, 0x4e, 0x4c, 0x0d, 0x41, 0x82, 0x9a, 0xed, 0x5b, 0xdd, 0xa2, 0x39, 0x7c, 0x58, 0x68,
+		0xb6, 0x8f, 0x6a, 0x7e, 0x15, 0xa6, 0x06, 0x16, 0xc4, 0x68, 0x56, 0x3f, 0xcf, 0x59, 0x49, 0xfd,
+		0xeb, 0xa1, 0x7f, 0xf1, 0xe2, 0x8b, 0x61, 0x34, 0xb7, 0x5f, 0xe8, 0x5b, 0xbc, 0xf8, 0x5a, 0x38,
+		0x7f, 0x11, 0x32, 0x46, 0xaf, 0xdd, 0xc6, 0xc9, 0x23, 0xef, 0x7f, 0x3e, 0xb7, 0xf8, 0x5f, 0xbe,
+		0xc1, 0xa5, 0x23, 0x08, 0xe6, 0xcf, 0x43, 0x8a, 0x74, 0xb6, 0x48, 0x33, 0x8a, 0xf2, 0x2b, 0xdf,
+		0x10, 0x06, 0x13, 0xb1, 0xe7, 0x9f, 0x03, 0x60, 0xa9, 0x11, 0xba, 0x71, 0x1e, 0x41, 0xfb, 0x87,
+		0xdf, 0xe0, 0x07, 0xe2, 0x3c, 0x12, 0x8f, 0x01, 0x3b, 0x5e, 0xb7, 0x3f, 0x83, 0xaf, 0x06, 0x19,
+		0xd0, 0x11, 0x79, 0x06, 0xc6, 0x71, 0x23, 0xcd, 0xd1, 0x5a, 0x51, 0xd4, 0x7f, 0xc4, 0xa9, 0x05,
+		0x3e, 0x0a, 0xac, 0x63, 0x76, 0x89, 0xa3, 0xb5, 0xec, 0x28, 0xda, 0xff, 0xca, 0x69, 0x5d, 0x02,
+		0x24, 0x6e, 0x68, 0xb6, 0x33, 0x4a, 0xbf, 0xff, 0x58, 0x10, 0x0b, 0x02, 0x6c, 0x34, 0xfe, 0xbe,
+		0x4e, 0xf6, 0xa2, 0x68, 0xbf, 0x26, 0x1a, 0xcd, 0xf1, 0xe7, 0x9f, 0x85, 0x2c, 0xfe, 0x64, 0xa7,
+		0x5c, 0x23, 0x88, 0xff, 0x84, 0x13, 0x7b, 0x14, 0x58, 0xb3, 0xed, 0x34, 0x1d, 0x3d, 0x5a, 0xd8,
+		0x5f, 0xe7, 0x23, 0x2d, 0xf0, 0xe7, 0x2b, 0x90, 0xb3, 0x9d, 0x66, 0xb3, 0xc7, 0xfd, 0xd3, 0x08,
+		0xf2, 0x3f, 0xfd, 0x86, 0x9b, 0xb2, 0x70, 0x69, 0x70, 0xb4, 0x6f, 0x5e, 0x77, 0x2c, 0x93, 0xee,
+		0xb7, 0x44, 0x71, 0x78, 0x9b, 0x73, 0xf0, 0x91, 0xcc, 0x57, 0x21, 0x8f, 0x7d, 0xe9, 0x12, 0x8b,
+		0xd0, 0xcd, 0xb1, 0x08, 0x16, 0x7f, 0xc6, 0x05, 0x10, 0x20, 0x5a, 0xf8, 0xae, 0xcf, 0xbc, 0x75,
+		0x22, 0xf6, 0xb9, 0xb7, 0x4e, 0xc4, 0x7e, 0xff, 0xad, 0x13, 0xb1, 0x0f, 0x7c, 0xf1, 0xc4, 0xd8,
+		0xe7, 0xbe, 0x78, 0x62, 0xec, 0xf7, 0xbe, 0x78, 0x62, 0x2c, 0x3c, 0x4b, 0x0c, 0x97, 0xcd, 0xcb,
+		0x26, 0xcb, 0x0f, 0xbf, 0xf2, 0x40, 0x4b, 0x77, 0x76, 0x7a, 0x5b, 0x73, 0x0d, 0xb3, 0x73, 0xb6,
+		0x61, 0xda, 0x1d, 0xd3, 0x3e, 0x1b, 0xcc, 0xeb, 0xd2, 0x5f, 0xf0, 0xbf, 0x62, 0x70, 0x94, 0xb1,
+		0xf1, 0xd2, 0xb9, 0x9a, 0xb1, 0x37, 0xec, 0x32, 0xdd, 0x05, 0x48, 0x54, 0x8c, 0x3d, 0xf9, 0x28,
+		0x33, 0x70, 0x6a, 0xaf, 0xdb, 0xe6, 0x47, 0x2d, 0xc7, 0xf1, 0x7b, 0xb3, 0xdb, 0xc6, 0x34, 0xbb,
+		0x38, 0x0f, 0x8d, 0xdb, 0x39, 0xec, 0x63, 0xe1, 0x87, 0x63, 0x07, 0xeb, 0x49, 0xa6, 0x62, 0xec,
+		0xd1, 0x8e, 0xac, 0xc7, 0x5e, 0x79, 0x34, 0x32, 0xcf, 0x7d, 0xdd, 0x30, 0x6f, 0x1a, 0xd8, 0x6c,
+		0x6b, 0x4b, 0xe4, 0xb8, 0x4f, 0xf4, 0xe7, 0xb8, 0x5f, 0x24, 0xed, 0xf6, 0xf3, 0x88, 0x87, 0xc7,
+		0x17, 0xec, 0xad, 0x34, 0x3b, 0xd5, 0x0f, 0x3f, 0x1e, 0x87, 0x13, 0x03, 0xe9, 0x6c, 0xae, 0x04,
+		0xc3, 0x84, 0x30, 0x0f, 0x99, 0x45, 0xa1, 0x5b, 0x45, 0xbc, 0xca, 0xd6, 0x30, 0x8d, 0xa6, 0x4d,
+		0x05, 0x91, 0x50, 0xc4, 0x27, 0x0a, 0xc2, 0xd0, 0x0c, 0xd3, 0xe6, 0x87, 0x95, 0xd9, 0xc7, 0xc2,
+		0xcf, 0x1c, 0x50, 0x10, 0x05, 0x51, 0x93, 0x90, 0xc6, 0xe3, 0x23, 0x4a, 0x43, 0x74, 0x22, 0x90,
+		0xf9, 0x1f, 0x55, 0x2a, 0x3f, 0x19, 0x87, 0x93, 0xfd, 0x52, 0xc1, 0x99, 0x65, 0x3b, 0x5a, 0xc7,
+		0x1a, 0x26, 0x96, 0x8b, 0x90, 0xdd, 0x10, 0x38, 0x07, 0x96, 0xcb, 0xed, 0x03, 0xca, 0x65, 0xc2,
+		0xad, 0x4a, 0x08, 0xe6, 0xdc, 0x88, 0x82, 0x71, 0xfb, 0x71, 0x47, 0x92, 0xf9, 0xf3, 0x34, 0x1c,
+		0x65, 0xd3, 0x49, 0x65, 0x53, 0x89, 0x7d, 0x70, 0x99, 0xe4, 0xfd, 0x45, 0xd1, 0xfb, 0x24, 0xe5,
+		0xe7, 0x61, 0x7a, 0x09, 0xad, 0x05, 0x46, 0x41, 0xde, 0x0e, 0x4f, 0xe8, 0x79, 0xee, 0xd9, 0x80,
+		0xc3, 0xcf, 0xf7, 0xb7, 0xfc, 0xa0, 0xf2, 0xf7, 0xc4, 0x40, 0xaa, 0x37, 0xb4, 0xb6, 0xd6, 0xfd,
+		0x66, 0x59, 0xc9, 0x4f, 0x01, 0xb0, 0xe3, 0x1e, 0xee, 0xc5, 0xbd, 0x89, 0x73, 0xc5, 0x39, 0x7f,
+		0xe7, 0xe6, 0x58, 0x4d, 0xf4, 0x04, 0x55, 0x96, 0xe2, 0xe2, 0xcf, 0xd3, 0x2f, 0x01, 0x78, 0x05,
+		0xf2, 0x31, 0x38, 0x52, 0xaf, 0x56, 0x96, 0x2b, 0x8a, 0x38, 0x24, 0x54, 0x5f, 0xaf, 0x55, 0x97,
+		0x2e, 0x2d, 0xd5, 0x16, 0xa5, 0x31, 0x3c, 0x5f, 0xe3, 0x2f, 0x74, 0x0f, 0x35, 0x1d, 0x82, 0x29,
+		0x3f, 0x9c, 0xdd, 0x52, 0x89, 0xa3, 0xa7, 0xa8, 0x77, 0xac, 0x36, 0xa1, 0x3b, 0x8f, 0xaa, 0x2e,
+		0xa4, 0x16, 0xed, 0x84, 0xfc, 0xce, 0xbf, 0x63, 0x37, 0x17, 0xa6, 0x3d, 0x72, 0x57, 0xe6, 0xf3,
+		0xcb, 0x30, 0x85, 0x67, 0x29, 0xad, 0x00, 0xcb, 0x08, 0x53, 0x8d, 0x0c, 0xe9, 0x5e, 0x2a, 0xa7,
+		0xf4, 0xb8, 0x3d, 0x05, 0x69, 0x9b, 0xf6, 0x3e, 0x8a, 0xc5, 0x67, 0x39, 0x0b, 0x8e, 0x3e, 0x6f,
+		0xc0, 0x14, 0x7a, 0x7e, 0x98, 0x20, 0xf2, 0x9a, 0xb1, 0x7f, 0x9e, 0xe1, 0x37, 0x3e, 0xfe, 0x18,
+		0xdd, 0x59, 0xbd, 0x37, 0x38, 0x2c, 0x21, 0xea, 0xa4, 0x48, 0x9c, 0xb7, 0xd7, 0x50, 0x02, 0x13,
+		0xa2, 0x3e, 0xde, 0xe0, 0xfd, 0x2b, 0xfb, 0x4d, 0x5e, 0xd9, 0x89, 0x30, 0x1d, 0xf0, 0xd5, 0x54,
+		0xe0, 0x5c, 0x59, 0xc1, 0x42, 0x6d, 0xd8, 0x9c, 0x7e, 0xe5, 0x91, 0xc1, 0xd5, 0x89, 0xfd, 0x77,
+		0x86, 0x72, 0xbe, 0xe8, 0xaf, 0xc6, 0x9d, 0x7b, 0x3f, 0x93, 0x84, 0x29, 0x3c, 0xfe, 0x6f, 0x9e,
+		0xa5, 0xff, 0xf2, 0x39, 0x97, 0xa2, 0x1f, 0x23, 0x6c, 0x4a, 0x5e, 0x60, 0x53, 0x21, 0x5a, 0x63,
+		0xfe, 0xe4, 0x87, 0x7e, 0x29, 0xe5, 0x4d, 0x97, 0xf9, 0x15, 0x90, 0xc4, 0x21, 0x5e, 0x62, 0x34,
+		0xcc, 0xe6, 0x48, 0x59, 0x8a, 0xaf, 0x0b, 0x1e, 0x22, 0xbf, 0x55, 0xe3, 0xa4, 0xf3, 0xef, 0xc1,
+		0x9d, 0x3e, 0xce, 0x26, 0xca, 0x33, 0x11, 0x4c, 0x5c, 0x0a, 0xf4, 0x4b, 0xd8, 0xcc, 0x1c, 0xc5,
+		0x0b, 0x7d, 0x5b, 0xd0, 0xb3, 0x19, 0x8a, 0x27, 0x24, 0xe6, 0x2f, 0xc3, 0x44, 0xd3, 0x34, 0x1c,
+		0xd5, 0xec, 0xe8, 0x0e, 0xe9, 0x58, 0x4e, 0xa4, 0x5f, 0xf7, 0x67, 0x8c, 0x49, 0x46, 0x29, 0x20,
+		0xdd, 0x9a, 0x20, 0xc3, 0x96, 0xb0, 0x7b, 0x7a, 0xa3, 0xb4, 0xe4, 0xbf, 0xb9, 0x2d, 0xa1, 0x34,
+		0xd8, 0x92, 0x3b, 0xd2, 0x0e, 0xbb, 0x79, 0x9d, 0x1b, 0x73, 0x67, 0x97, 0x69, 0x81, 0xab, 0x1d,
+		0x7f, 0x9c, 0x80, 0x13, 0x1c, 0x79, 0x4b, 0xb3, 0xc9, 0xd9, 0x1b, 0x8f, 0x6f, 0x11, 0x47, 0x7b,
+		0xfc, 0x6c, 0xc3, 0xd4, 0xc5, 0x4a, 0x3e, 0xcd, 0xca, 0xe7, 0xb0, 0x7c, 0x8e, 0x97, 0x97, 0x42,
+		0xb7, 0xbb, 0x4b, 0xc3, 0x8d, 0x7c, 0x69, 0x50, 0x07, 0xcb, 0xaf, 0x40, 0xb2, 0x6a, 0xea, 0x06,
+		0xae, 0x6d, 0x4d, 0x62, 0x98, 0x1d, 0x6e, 0x6e, 0xd9, 0x87, 0x7c, 0x01, 0xd2, 0x5a, 0xc7, 0xec,
+		0x19, 0x0e, 0x33, 0xb5, 0x0b, 0x27, 0x3e, 0xf3, 0xe6, 0xc9, 0xb1, 0xff, 0xf0, 0xe6, 0xc9, 0xc4,
+		0x92, 0xe1, 0x7c, 0xfe, 0x8d, 0x33, 0xc0, 0xb9, 0x2f, 0x19, 0xce, 0x47, 0xbe, 0xf4, 0xd1, 0xd3,
+		0x31, 0x85, 0x63, 0xcf, 0x27, 0xbf, 0xfc, 0xa1, 0x93, 0xb1, 0xf2, 0x4b, 0x30, 0xbe, 0x48, 0x1a,
+		0xfb, 0xb0, 0x7f, 0xbc, 0x8f, 0xfd, 0x51, 0xc1, 0x7e, 0x91, 0x34, 0x7c, 0xec, 0x17, 0x49, 0xa3,
+		0x8f, 0xf3, 0x53, 0x90, 0x59, 0x32, 0x1c, 0x76, 0x85, 0xe8, 0x11, 0x48, 0xe8, 0x06, 0x3b, 0x95,
+		0xee, 0xe3, 0x30, 0xd0, 0x40, 0x05, 0xb1, 0x90, 0x70, 0x91, 0x34, 0x5c, 0xc2, 0x26, 0x69, 0x14,
+		0x63, 0x51, 0x55, 0x23, 0xd6, 0xc2, 0xe2, 0xef, 0x7d, 0xe1, 0xc4, 0xd8, 0xeb, 0x6f, 0x9d, 0x18,
+		0x1b, 0x3a, 0xf4, 0xe5, 0xe8, 0xa1, 0x77, 0x47, 0xfc, 0x97, 0x92, 0x70, 0x9c, 0xde, 0x2c, 0xed,
+		0x76, 0x74, 0xc3, 0x39, 0xdb, 0xe8, 0xee, 0x59, 0x8e, 0x89, 0x06, 0xc0, 0xdc, 0xe6, 0x03, 0x3e,
+		0xe5, 0x15, 0xcf, 0xb1, 0xe2, 0xf0, 0xe1, 0x2e, 0x6f, 0x43, 0x6a, 0x1d, 0xe9, 0x50, 0xc4, 0x8e,
+		0xe9, 0x68, 0x6d, 0xee, 0xb5, 0xb0, 0x0f, 0x84, 0xb2, 0xdb, 0xa8, 0x71, 0x06, 0xd5, 0xc5, 0x45,
+		0xd4, 0x36, 0xd1, 0xb6, 0xd9, 0xa5, 0x9e, 0x04, 0x75, 0x77, 0x33, 0x08, 0xa0, 0xf7, 0x77, 0x66,
+		0x20, 0xa5, 0xf5, 0xd8, 0xc1, 0x9b, 0x04, 0xfa, 0xc1, 0xf4, 0xa3, 0xfc, 0x3c, 0x8c, 0xf3, 0xfd,
+		0x77, 0x3c, 0x79, 0x72, 0x9d, 0xec, 0xd1, 0x7a, 0xf2, 0x0a, 0xfe, 0x94, 0xe7, 0x20, 0x45, 0x1b,
+		0xcf, 0x6f, 0x2b, 0x16, 0xe7, 0x06, 0x5a, 0x3f, 0x47, 0x1b, 0xa9, 0x30, 0xb4, 0xf2, 0x55, 0xc8,
+		0x2c, 0x9a, 0xa8, 0x85, 0x41, 0x6e, 0x59, 0xc6, 0x8d, 0xb6, 0xd9, 0xea, 0x71, 0xad, 0x50, 0xd8,
+		0x07, 0x1e, 0x49, 0x67, 0x97, 0xbc, 0xf8, 0xe1, 0x21, 0xfe, 0x55, 0xae, 0xc2, 0x38, 0xe5, 0xbd,
+		0x66, 0xa1, 0xcb, 0xe0, 0x9e, 0x7b, 0xcf, 0xf2, 0x2b, 0xbf, 0x9c, 0x7d, 0xdc, 0x6b, 0xac, 0x0c,
+		0x49, 0xbc, 0xc8, 0xc1, 0xfb, 0x4d, 0x7f, 0x97, 0xdf, 0x0b, 0x19, 0xce, 0xc4, 0x96, 0xcf, 0x41,
+		0xc2, 0xb4, 0x6c, 0x7e, 0xfc, 0xa7, 0x34, 0xac, 0x2b, 0x6b, 0xd6, 0x42, 0x12, 0x75, 0x46, 0x41,
+		0xe4, 0x85, 0xd5, 0xa1, 0x6a, 0xf1, 0x64, 0x40, 0x2d, 0x3a, 0xc4, 0xd9, 0xda, 0x76, 0xbc, 0x1f,
+		0x6c, 0x38, 0x07, 0x54, 0xc1, 0x55, 0x94, 0xdb, 0x71, 0x38, 0xe1, 0x2b, 0xbd, 0x41, 0xba, 0x98,
+		0x80, 0x62, 0xda, 0xc4, 0x35, 0x45, 0xf6, 0x35, 0x90, 0x97, 0x0f, 0x51, 0x95, 0x67, 0x21, 0x51,
+		0xb1, 0x2c, 0xbc, 0xe7, 0x4c, 0xbf, 0x1b, 0x26, 0xd3, 0x95, 0xa4, 0xe2, 0x7e, 0x63, 0x99, 0x6d,
+		0x6e, 0x3b, 0x37, 0xb5, 0xae, 0x7b, 0x07, 0x5a, 0x7c, 0x97, 0x9f, 0x81, 0x6c, 0xd5, 0x34, 0x6c,
+		0x62, 0xd8, 0x3d, 0xea, 0x0b, 0x6f, 0xb5, 0xcd, 0xc6, 0x75, 0xce, 0x81, 0x7d, 0xa0, 0xb0, 0x35,
+		0xcb, 0xa2, 0x94, 0x49, 0x05, 0x7f, 0xb2, 0xf9, 0xba, 0xb0, 0x36, 0x54, 0x3c, 0xe7, 0x0f, 0x26,
+		0x1e, 0xde, 0x41, 0xcf, 0xa9, 0x8d, 0xc1, 0x3d, 0x83, 0x13, 0xe9, 0x3a, 0xd9, 0xb3, 0x0f, 0x3a,
+		0x8f, 0x5e, 0x82, 0xec, 0x3a, 0x7d, 0x9e, 0xe4, 0x79, 0xb2, 0x27, 0x97, 0xf0, 0x0d, 0x8b, 0x73,
+		0xe7, 0xcf, 0x3f, 0xfe, 0x0c, 0xd3, 0xf2, 0x2b, 0x63, 0x8a, 0x00, 0xc8, 0x27, 0x20, 0x6b, 0x93,
+		0x86, 0x75, 0xee, 0xfc, 0x85, 0xeb, 0x8f, 0x33, 0xb5, 0xba, 0x32, 0xa6, 0x78, 0xa0, 0xf9, 0x0c,
+		0xf6, 0xf8, 0xcb, 0x1f, 0x3e, 0x19, 0x5b, 0x48, 0x41, 0xc2, 0xee, 0x75, 0xde, 0x31, 0xdd, 0xf8,
+		0x5a, 0x1a, 0x66, 0x7d, 0xa5, 0x6c, 0x71, 0xb9, 0xa1, 0xb5, 0xf5, 0xa6, 0xe6, 0x3d, 0x2a, 0x23,
+		0xf9, 0xfa, 0x4f, 0x31, 0x86, 0xac, 0x1a, 0xfb, 0x4a, 0xb1, 0xfc, 0xb1, 0x18, 0xe4, 0xaf, 0x09,
+		0xce, 0xf8, 0x0a, 0xcd, 0x45, 0x00, 0xb7, 0x26, 0x31, 0x55, 0x8e, 0xcd, 0xf5, 0xd7, 0x35, 0xe7,
+		0xd2, 0x28, 0x3e, 0x74, 0xf9, 0x29, 0xaa, 0x80, 0x96, 0x69, 0xf3, 0xfb, 0xb0, 0x11, 0xa4, 0x2e,
+		0x32, 0x1e, 0xe4, 0xa4, 0x56, 0x4d, 0xbd, 0x61, 0x3a, 0x78, 0xb4, 0xc4, 0x32, 0x6f, 0xf2, 0x57,
+		0x06, 0x12, 0x8a, 0x44, 0x4b, 0xae, 0xd1, 0x82, 0x75, 0x84, 0x63, 0xa3, 0xb3, 0x2e, 0x17, 0x0c,
+		0xeb, 0xb4, 0x66, 0xb3, 0x4b, 0x6c, 0x9b, 0x1b, 0x2e, 0xf1, 0x89, 0x97, 0x70, 0xad, 0xde, 0x96,
+		0x2a, 0xac, 0x04, 0x5e, 0x63, 0x0e, 0x99, 0xf3, 0x42, 0x37, 0xf8, 0xac, 0x4f, 0x5b, 0xbd, 0x2d,
+		0xd4, 0x94, 0x7b, 0x21, 0x1f, 0xd2, 0x98, 0xdc, 0x0d, 0xaf, 0x1d, 0xf4, 0x45, 0x1c, 0xde, 0x03,
+		0xd5, 0xea, 0xea, 0x66, 0x57, 0x77, 0xf6, 0xe8, 0xb1, 0xbd, 0x84, 0x22, 0x89, 0x82, 0x75, 0x0e,
+		0x2f, 0x5f, 0x87, 0xc9, 0x3a, 0x75, 0xf7, 0xbd, 0x96, 0x9f, 0xf7, 0xda, 0x17, 0x8b, 0x6e, 0xdf,
+		0xd0, 0x96, 0xc5, 0x07, 0x5a, 0x76, 0xfa, 0xdf, 0xc7, 0x20, 0xb7, 0x80, 0x13, 0x77, 0x69, 0xf1,
+		0x52, 0x5b, 0x6b, 0xc9, 0x8f, 0xc3, 0xa1, 0x85, 0xe5, 0xb5, 0xea, 0xf3, 0xea, 0xd2, 0xa2, 0x7a,
+		0x69, 0xb9, 0x72, 0xd9, 0x3b, 0x27, 0x5c, 0x3a, 0x7c, 0xeb, 0xf6, 0xac, 0xec, 0xc3, 0xdd, 0x34,
+		0x68, 0x4c, 0x2a, 0x9f, 0x85, 0x99, 0x20, 0x49, 0x65, 0xa1, 0x8e, 0x87, 0x86, 0x63, 0xa5, 0x43,
+		0xb7, 0x6e, 0xcf, 0x4e, 0xf9, 0x28, 0x2a, 0x5b, 0x36, 0x31, 0x9c, 0x41, 0x82, 0xea, 0xda, 0xca,
+		0xca, 0xd2, 0x86, 0x14, 0x1f, 0x20, 0xc0, 0xc3, 0x9a, 0xba, 0x23, 0x3f, 0x0c, 0x53, 0x41, 0x82,
+		0xd5, 0xa5, 0x65, 0x29, 0x51, 0x92, 0x6f, 0xdd, 0x9e, 0x9d, 0xf0, 0x61, 0xaf, 0xea, 0xed, 0x52,
+		0xe6, 0x07, 0x7e, 0xe1, 0xc4, 0xd8, 0x47, 0xfe, 0xce, 0x89, 0xd8, 0xc2, 0xca, 0xd0, 0x39, 0xf7,
+		0xc4, 0xc1, 0xe6, 0x5c, 0x70, 0xdd, 0xfe, 0xc6, 0x3d, 0x70, 0x4f, 0x7f, 0x61, 0xc0, 0x18, 0x8f,
+		0x3a, 0xdd, 0xa2, 0x72, 0x14, 0xa5, 0xfd, 0xdd, 0x83, 0x52, 0xc4, 0xa2, 0x50, 0x8a, 0x34, 0x0c,
+		0xe5, 0x67, 0xa0, 0x80, 0xc7, 0x8a, 0xeb, 0xc4, 0xb9, 0x42, 0xb4, 0x26, 0xe9, 0x06, 0xfd, 0x87,
+		0x82, 0xf0, 0x1f, 0x64, 0x48, 0x52, 0x27, 0x81, 0xad, 0x9f, 0xf4, 0x77, 0x79, 0x07, 0x92, 0x48,
+		0xea, 0xf9, 0x16, 0x9c, 0x82, 0x7e, 0x20, 0x74, 0x6b, 0xcf, 0x21, 0x36, 0x27, 0x61, 0x1f, 0xf2,
+		0x93, 0xc2, 0x43, 0x48, 0xec, 0xef, 0x21, 0xf0, 0xe9, 0xc5, 0xfd, 0x84, 0x36, 0x8c, 0xf3, 0x21,
+		0x76, 0x1b, 0x12, 0xf3, 0x1a, 0x22, 0xaf, 0xc0, 0xa4, 0xa5, 0x75, 0x1d, 0x7a, 0x43, 0x71, 0x87,
+		0xf6, 0x82, 0xcf, 0xe0, 0x93, 0x83, 0xf6, 0x24, 0xd0, 0x59, 0x5e, 0x4b, 0xc1, 0xf2, 0x03, 0xcb,
+		0xff, 0x39, 0x09, 0x69, 0x2e, 0x8c, 0x67, 0x61, 0x9c, 0x8b, 0x95, 0xcf, 0xb9, 0xe3, 0x73, 0x83,
+		0xcb, 0xec, 0x9c, 0xbb, 0x1c, 0x72, 0x7e, 0x82, 0x46, 0x7e, 0x10, 0x32, 0x8d, 0x1d, 0x4d, 0x37,
+		0x54, 0xbd, 0xc9, 0x5d, 0xdb, 0xdc, 0x5b, 0x6f, 0x9e, 0x1c, 0xaf, 0x22, 0x6c, 0x69, 0x51, 0x19,
+		0xa7, 0x85, 0x4b, 0x4d, 0xf4, 0x69, 0x76, 0x88, 0xde, 0xda, 0x71, 0xb8, 0xdd, 0xe0, 0x5f, 0xf8,
+		0xc8, 0x17, 0x2a, 0x04, 0xbf, 0xbf, 0x5e, 0x1a, 0x88, 0x50, 0xdc, 0x14, 0xd2, 0x42, 0x06, 0x2b,
+		0xfe, 0xc0, 0x7f, 0x3a, 0x19, 0x53, 0x28, 0x85, 0x5c, 0x85, 0x42, 0x5b, 0xb3, 0x1d, 0x95, 0xae,
+		0xc7, 0x58, 0x7d, 0x8a, 0xb2, 0x38, 0x3a, 0x28, 0x10, 0x2e, 0x58, 0xde, 0xf4, 0x1c, 0x52, 0x31,
+		0x50, 0x13, 0xaf, 0xd7, 0x52, 0x26, 0x0d, 0x3a, 0x03, 0x99, 0x97, 0x98, 0xa6, 0x72, 0x9f, 0x40,
+		0x38, 0x9b, 0x98, 0xd4, 0x57, 0x3c, 0x06, 0x59, 0x7a, 0x63, 0x96, 0xa2, 0xb0, 0x63, 0xf0, 0x19,
+		0x04, 0xd0, 0xc2, 0x87, 0x60, 0xd2, 0xb3, 0xfa, 0x0c, 0x25, 0xc3, 0xb8, 0x78, 0x60, 0x8a, 0xf8,
+		0x18, 0xcc, 0x18, 0x64, 0xd7, 0x51, 0x3d, 0x30, 0xc3, 0xce, 0x52, 0x6c, 0x19, 0xcb, 0xae, 0x05,
+		0x29, 0x1e, 0x80, 0x89, 0x86, 0x10, 0x3e, 0xc3, 0x05, 0x8a, 0x5b, 0x70, 0xa1, 0x14, 0xed, 0x28,
+		0x64, 0x34, 0xcb, 0x62, 0x08, 0x39, 0x6e, 0xf5, 0x2d, 0x8b, 0x16, 0x9d, 0x86, 0x29, 0xda, 0xc7,
+		0x2e, 0xb1, 0x7b, 0x6d, 0x87, 0x33, 0xc9, 0x53, 0x9c, 0x49, 0x2c, 0x50, 0x18, 0x9c, 0xe2, 0xde,
+		0x07, 0x05, 0x72, 0x03, 0xef, 0x20, 0x34, 0x08, 0xc3, 0x2b, 0x50, 0xbc, 0xbc, 0x00, 0x52, 0xa4,
+		0x87, 0xc1, 0xb5, 0xe6, 0xaa, 0x58, 0x69, 0x26, 0x18, 0x3f, 0x01, 0xaf, 0x30, 0x70, 0xb9, 0x08,
+		0xc9, 0x45, 0xcd, 0xd1, 0xd0, 0x5d, 0x72, 0x76, 0xd9, 0xf2, 0x99, 0x57, 0xf0, 0x67, 0xf9, 0x93,
+		0x09, 0x48, 0x5e, 0x33, 0x1d, 0x22, 0x3f, 0xe1, 0x73, 0x65, 0x27, 0xc2, 0xf4, 0xb9, 0xae, 0xb7,
+		0x0c, 0xd2, 0x5c, 0xb1, 0x5b, 0xbe, 0xe7, 0x6d, 0x3c, 0x75, 0x8a, 0x07, 0xd4, 0x69, 0x06, 0x52,
+		0x5d, 0xb3, 0x67, 0x34, 0xc5, 0x01, 0x72, 0xfa, 0x21, 0xd7, 0x20, 0xe3, 0x6a, 0x49, 0x32, 0x4a,
+		0x4b, 0x26, 0x51, 0x4b, 0x50, 0x87, 0x39, 0x40, 0x19, 0xdf, 0xe2, 0xca, 0xb2, 0x00, 0x59, 0xd7,
+		0x78, 0x15, 0x53, 0x07, 0x50, 0x58, 0x8f, 0x0c, 0x97, 0x48, 0x77, 0xec, 0x5d, 0xe1, 0x31, 0x8d,
+		0x93, 0xdc, 0x02, 0x2e, 0xbd, 0x80, 0x5a, 0xf1, 0xa7, 0x76, 0xc6, 0x69, 0xbf, 0x3c, 0xb5, 0x62,
+		0xcf, 0xed, 0xdc, 0x83, 0x27, 0xf2, 0x5a, 0x86, 0xe6, 0xf4, 0xba, 0x84, 0x6b, 0x9e, 0x07, 0xc0,
+		0x52, 0xef, 0x32, 0x05, 0xd3, 0x34, 0x0f, 0x20, 0x9f, 0x85, 0x69, 0xf7, 0x43, 0xf5, 0xb8, 0x30,
+		0x2d, 0x93, 0xdd, 0xa2, 0xba, 0x28, 0x29, 0xff, 0x56, 0x0c, 0xd2, 0x7c, 0xc5, 0xf2, 0x86, 0x21,
+		0x16, 0x3e, 0x0c, 0xf1, 0x61, 0xc3, 0x90, 0xb8, 0xf3, 0x61, 0xa8, 0x00, 0xb8, 0xcd, 0xb4, 0xf9,
+		0x83, 0x2a, 0x21, 0x6e, 0x15, 0x6b, 0x62, 0x5d, 0x6f, 0xf1, 0x79, 0xef, 0x23, 0x2a, 0xff, 0xc7,
+		0x18, 0x64, 0xdd, 0x72, 0xb9, 0x02, 0x05, 0xd1, 0x2e, 0x75, 0xbb, 0xad, 0xb5, 0xb8, 0x2a, 0x1e,
+		0x1f, 0xda, 0x38, 0x5c, 0x85, 0x95, 0x1c, 0x6f, 0x0f, 0x7e, 0x84, 0x0f, 0x6b, 0x7c, 0xc8, 0xb0,
+		0x06, 0xf4, 0x28, 0x71, 0x67, 0x7a, 0x14, 0x18, 0xf1, 0x64, 0xdf, 0x88, 0x97, 0xbf, 0x10, 0xe3,
+		0x2f, 0x7f, 0x35, 0x49, 0xf3, 0x2f, 0x73, 0xa8, 0x5e, 0xe1, 0xba, 0xd5, 0x24, 0x4d, 0x75, 0x60,
+		0xcc, 0xee, 0x1b, 0xe4, 0x18, 0x6c, 0xb3, 0x37, 0x76, 0xb2, 0xe0, 0x52, 0xf7, 0xc6, 0xf0, 0x8d,
+		0x38, 0x4c, 0x0d, 0xe0, 0xff, 0xdf, 0x37, 0x96, 0xc1, 0xd9, 0x9b, 0x1a, 0x71, 0xf6, 0xa6, 0x87,
+		0xce, 0xde, 0x8f, 0xc7, 0x69, 0x02, 0xc0, 0x32, 0x6d, 0xad, 0xfd, 0x6e, 0xd8, 0xde, 0x63, 0x90,
+		0xb5, 0xcc, 0xb6, 0xca, 0x4a, 0xd8, 0x15, 0x9e, 0x8c, 0x65, 0xb6, 0x95, 0x01, 0x35, 0x4b, 0xdd,
+		0x25, 0xc3, 0x9c, 0xbe, 0x0b, 0x83, 0x30, 0xde, 0x3f, 0xa1, 0xba, 0x90, 0x67, 0xa2, 0xe0, 0x5e,
+		0xd3, 0x63, 0x28, 0x03, 0xfc, 0x55, 0x8c, 0x0d, 0x7a, 0x79, 0xac, 0xd9, 0x0c, 0x53, 0x49, 0xef,
+		0xb8, 0x14, 0xcc, 0xc9, 0x28, 0xc6, 0x87, 0x51, 0x30, 0x2d, 0x56, 0x38, 0x5e, 0xf9, 0x6f, 0xc5,
+		0x00, 0x96, 0x51, 0xb2, 0xb4, 0xbf, 0xe8, 0xef, 0xd8, 0xb4, 0x09, 0x6a, 0xa0, 0xe6, 0x13, 0xc3,
+		0x06, 0x8d, 0xd7, 0x9f, 0xb7, 0xfd, 0xed, 0xae, 0x42, 0xc1, 0xd3, 0x6d, 0x9b, 0x88, 0xc6, 0x9c,
+		0xd8, 0x27, 0x2a, 0xad, 0x13, 0x47, 0xc9, 0xdf, 0xf0, 0x7d, 0x95, 0xff, 0x69, 0x0c, 0xb2, 0xb4,
+		0x4d, 0xf8, 0x52, 0x48, 0x60, 0x0c, 0x63, 0x77, 0x3e, 0x86, 0xc7, 0x01, 0x18, 0x1b, 0x3c, 0x09,
+		0xc3, 0x35, 0x2b, 0x4b, 0x21, 0x78, 0xbe, 0x05, 0xf3, 0xb3, 0xbc, 0xdb, 0x89, 0xfd, 0x05, 0x2e,
+		0xa2, 0x56, 0x2e, 0xf6, 0x23, 0x30, 0x4e, 0xdf, 0xa6, 0xdc, 0xb5, 0x79, 0x20, 0x8a, 0x0f, 0x52,
+		0x6d, 0xec, 0xda, 0xe5, 0x57, 0x61, 0x7c, 0x63, 0x97, 0xe5, 0x13, 0x8f, 0x41, 0xb6, 0x6b, 0x9a,
+		0xdc, 0xfb, 0x63, 0x5e, 0x77, 0x06, 0x01, 0xd4, 0xd9, 0x11, 0x39, 0xb4, 0xb8, 0x97, 0x43, 0xf3,
+		0x92, 0x80, 0x89, 0x91, 0x92, 0x80, 0x18, 0x7d, 0x16, 0x02, 0x33, 0x49, 0x7e, 0x14, 0x8e, 0xd4,
+		0x97, 0x2e, 0xaf, 0xd6, 0x16, 0xd5, 0x95, 0xfa, 0xe5, 0xbe, 0xeb, 0xf9, 0xa5, 0xc9, 0x5b, 0xb7,
+		0x67, 0x73, 0x3c, 0xec, 0x1c, 0x86, 0xbd, 0xae, 0xd4, 0xae, 0xad, 0x6d, 0xd4, 0xa4, 0x18, 0xc3,
+		0x5e, 0xef, 0x92, 0x1b, 0xa6, 0xc3, 0x1e, 0x7f, 0x7d, 0x0c, 0x8e, 0x86, 0x60, 0xbb, 0xc1, 0xe7,
+		0xd4, 0xad, 0xdb, 0xb3, 0x85, 0xf5, 0x2e, 0x61, 0x5a, 0x46, 0x29, 0xe6, 0xa0, 0x38, 0x48, 0xb1,
+		0xb6, 0xbe, 0x56, 0xaf, 0x2c, 0x4b, 0xb3, 0x25, 0xe9, 0xd6, 0xed, 0xd9, 0xbc, 0x30, 0x19, 0x88,
+		0xff, 0xce, 0x47, 0x9f, 0x3f, 0x98, 0x81, 0xe3, 0xfd, 0x85, 0x67, 0x2d, 0xad, 0xab, 0x75, 0x0e,
+		0x1a, 0x7e, 0x46, 0x1c, 0x1c, 0x28, 0x7f, 0x2c, 0x0e, 0x93, 0x6e, 0x70, 0xb3, 0x4e, 0x6b, 0x90,
+		0x9f, 0xf0, 0x67, 0xfc, 0x72, 0x43, 0xd7, 0x0e, 0x86, 0x2d, 0x12, 0x82, 0xb8, 0x65, 0xc4, 0x9d,
+		0x64, 0x3e, 0xa9, 0x66, 0x43, 0xd6, 0x37, 0x8e, 0xc1, 0x49, 0x5d, 0x0a, 0xf9, 0x39, 0xc8, 0xba,
+		0x53, 0xcc, 0x7d, 0x20, 0x6d, 0xf8, 0x9c, 0xe4, 0xf4, 0x1e, 0x0d, 0xbe, 0x59, 0x26, 0xc2, 0xb8,
+		0xe4, 0xb0, 0xc0, 0xf0, 0x1a, 0x43, 0xe0, 0xc4, 0x02, 0x5f, 0x7e, 0x0c, 0x92, 0xda, 0x56, 0x43,
+		0x2f, 0xa6, 0x06, 0x53, 0x2e, 0x8c, 0xae, 0xb2, 0x50, 0x5d, 0xe2, 0x44, 0x14, 0xb3, 0xbc, 0xc4,
+		0x93, 0x29, 0x5c, 0x5e, 0xf4, 0x39, 0x9a, 0x5d, 0x95, 0xc5, 0xc2, 0xcc, 0xbb, 0xc8, 0x74, 0xb4,
+		0xdd, 0x05, 0xfc, 0xc6, 0x09, 0x88, 0x85, 0x2d, 0xfe, 0xe0, 0x41, 0x42, 0x49, 0x77, 0xb4, 0xdd,
+		0xcb, 0x9a, 0x7d, 0x35, 0x99, 0x49, 0x48, 0xc9, 0xf2, 0xaf, 0xa0, 0xa7, 0x12, 0x90, 0x8a, 0xfc,
+		0x08, 0xc8, 0x48, 0x81, 0x7b, 0x79, 0x38, 0x75, 0xa9, 0x78, 0x05, 0xdf, 0xc9, 0x8e, 0xb6, 0x5b,
+		0x69, 0x91, 0xd5, 0x5e, 0x87, 0x36, 0xc0, 0x96, 0x71, 0xe3, 0x8f, 0x23, 0x8b, 0x91, 0xe5, 0xe2,
+		0x3f, 0x3a, 0xf8, 0xc0, 0x2a, 0x47, 0x60, 0x0b, 0xc0, 0x07, 0x71, 0x01, 0x98, 0x60, 0xfc, 0x44,
+		0x49, 0xb0, 0x2b, 0x89, 0x60, 0x57, 0xca, 0xcf, 0xc1, 0x64, 0xdf, 0x08, 0xc8, 0x65, 0x28, 0xf0,
+		0x8c, 0x15, 0xdd, 0x86, 0x67, 0x11, 0x4e, 0x56, 0xc9, 0xb1, 0xcc, 0x14, 0x3d, 0x96, 0x30, 0x9f,
+		0xf9, 0x14, 0xa6, 0x49, 0x71, 0x33, 0xe7, 0x11, 0x28, 0x04, 0xc6, 0x40, 0x64, 0x91, 0x63, 0x5e,
+		0x16, 0xd9, 0x43, 0x7e, 0x05, 0xf2, 0x68, 0x80, 0x48, 0x93, 0xe3, 0x3e, 0x08, 0x93, 0xcc, 0x40,
+		0xf6, 0xcb, 0x9a, 0x39, 0x3c, 0x2b, 0x42, 0xe0, 0x65, 0x28, 0x78, 0x78, 0x9e, 0xd8, 0x73, 0x02,
+		0xeb, 0xb2, 0x66, 0x97, 0xd7, 0x00, 0xbc, 0x41, 0x95, 0x2b, 0x70, 0x1c, 0x8d, 0x87, 0xff, 0xd8,
+		0x28, 0x7f, 0x1a, 0x2b, 0xe0, 0x31, 0x96, 0x10, 0xc9, 0x3b, 0x1a, 0xca, 0x1e, 0xc8, 0xba, 0x42,
+		0x31, 0x16, 0x5e, 0xf8, 0xc8, 0x5b, 0x27, 0x62, 0xef, 0x8c, 0x2d, 0xf8, 0xb1, 0x17, 0xe1, 0x98,
+		0xaf, 0x10, 0x15, 0x30, 0x90, 0x88, 0x9a, 0xf4, 0x0a, 0xe7, 0xb0, 0x30, 0x2a, 0xa1, 0xb4, 0x6f,
+		0xfe, 0xb7, 0xb4, 0xbf, 0xd9, 0x89, 0xce, 0x36, 0x45, 0xe7, 0xbb, 0xc2, 0x93, 0xf2, 0xff, 0x24,
+		0x0b, 0xe3, 0x0a, 0x79, 0x5f, 0x8f, 0xd8, 0x8e, 0x7c, 0x0e, 0x92, 0xa4, 0xb1, 0x63, 0x86, 0xe5,
+		0x40, 0xb1, 0x83, 0x73, 0x1c, 0xaf, 0xd6, 0xd8, 0x31, 0xaf, 0x8c, 0x29, 0x14, 0x57, 0x3e, 0x0f,
+		0xa9, 0xed, 0x76, 0x8f, 0xa7, 0xaf, 0xfa, 0x6c, 0x96, 0x9f, 0xe8, 0x12, 0x22, 0x5d, 0x19, 0x53,
+		0x18, 0x36, 0x56, 0x45, 0x5f, 0xc6, 0x4e, 0xec, 0x5f, 0x15, 0x5e, 0x9f, 0xc6, 0xaa, 0x10, 0x57,
+		0x5e, 0x00, 0xd0, 0x0d, 0xdd, 0x51, 0x69, 0x6a, 0xa7, 0x98, 0x1a, 0x34, 0x56, 0x41, 0x4a, 0xdd,
+		0xa1, 0xc9, 0x20, 0xdc, 0x3a, 0xd0, 0xc5, 0x07, 0x36, 0xf7, 0x7d, 0x3d, 0xd2, 0xdd, 0x2b, 0xa6,
+		0xf7, 0x6f, 0xee, 0x0b, 0x88, 0x84, 0xcd, 0xa5, 0xd8, 0x68, 0x64, 0xd9, 0x23, 0x7b, 0xce, 0x6e,
+		0x31, 0x33, 0x68, 0xe6, 0xfc, 0x94, 0xf4, 0xa5, 0xbd, 0x8d, 0x5d, 0xdc, 0xcf, 0x68, 0xb0, 0x9f,
+		0xf2, 0xd3, 0xae, 0x0b, 0x96, 0x1b, 0xf4, 0x7a, 0x02, 0xb4, 0x2c, 0xed, 0x33, 0x26, 0x5c, 0x31,
+		0x79, 0x15, 0x26, 0xda, 0xba, 0xed, 0xa8, 0xb6, 0xa1, 0x59, 0xf6, 0x8e, 0xe9, 0xd8, 0x34, 0x7f,
+		0x82, 0x2f, 0x49, 0x0f, 0xe1, 0xb0, 0xac, 0xdb, 0x4e, 0x5d, 0x20, 0x5f, 0x19, 0x53, 0x0a, 0x6d,
+		0x3f, 0x00, 0xf9, 0x99, 0xdb, 0xdb, 0xa4, 0xeb, 0x32, 0x2c, 0x16, 0xf6, 0xe7, 0xb7, 0x86, 0xd8,
+		0x82, 0x1e, 0xf9, 0x99, 0x7e, 0x80, 0xfc, 0x6d, 0x30, 0xdd, 0x36, 0xb5, 0xa6, 0xcb, 0x4e, 0x6d,
+		0xec, 0xf4, 0x8c, 0xeb, 0x34, 0x29, 0x83, 0xcf, 0x9d, 0x0e, 0x6b, 0xa4, 0xa9, 0x35, 0x05, 0x8b,
+		0x2a, 0x12, 0x5c, 0x19, 0x53, 0xa6, 0xda, 0xfd, 0x40, 0xf9, 0x3b, 0x61, 0x46, 0xb3, 0xac, 0xf6,
+		0x5e, 0x3f, 0xf7, 0x49, 0xca, 0xfd, 0xf4, 0x30, 0xee, 0x15, 0xa4, 0xe9, 0x67, 0x2f, 0x6b, 0x03,
+		0x50, 0x79, 0x03, 0xd3, 0x49, 0x84, 0xde, 0xbc, 0xb2, 0xb8, 0x97, 0x41, 0xdf, 0xa5, 0xc2, 0x27,
+		0xe7, 0x87, 0xf0, 0x5e, 0x67, 0xf8, 0xc2, 0x29, 0xb9, 0x32, 0x86, 0x99, 0xa7, 0x00, 0x88, 0x71,
+		0x35, 0x1b, 0x84, 0x3e, 0x9b, 0xc7, 0xb9, 0x4e, 0x45, 0x71, 0xa5, 0xf8, 0x41, 0xae, 0x01, 0x90,
+		0x5c, 0x83, 0x1c, 0x0b, 0x45, 0x71, 0x63, 0x86, 0xd0, 0xd7, 0xac, 0x72, 0xe7, 0xca, 0x43, 0x67,
+		0x28, 0x45, 0xc5, 0x2c, 0xd7, 0x95, 0x31, 0x05, 0x88, 0xfb, 0x25, 0x6b, 0x70, 0x88, 0x3e, 0xec,
+		0xb5, 0xa7, 0x06, 0x0d, 0x6f, 0x71, 0x9a, 0x32, 0x7c, 0x64, 0x18, 0xc3, 0x6b, 0x94, 0xe8, 0x9a,
+		0xdf, 0x0e, 0x5f, 0x19, 0x53, 0xa6, 0x6f, 0x0c, 0x82, 0x51, 0xc5, 0xb6, 0x75, 0x43, 0x6b, 0xe3,
+		0xfb, 0x92, 0xcc, 0x9b, 0x99, 0xd9, 0x5f, 0xc5, 0x2e, 0x71, 0x6c, 0xba, 0xb0, 0xa2, 0x8a, 0x6d,
+		0xfb, 0x01, 0x0b, 0xe3, 0xfc, 0xcc, 0xa8, 0xfb, 0x44, 0xdb, 0xb8, 0x94, 0x61, 0xcf, 0xb2, 0x5d,
+		0x4d, 0x66, 0x40, 0xca, 0x95, 0x1f, 0x82, 0x9c, 0xcf, 0x30, 0xe1, 0x2e, 0x14, 0x3f, 0x55, 0x23,
+		0x4e, 0x9f, 0xf2, 0xcf, 0xf2, 0x04, 0xe4, 0xfd, 0xc6, 0xa8, 0xfc, 0x81, 0x18, 0xe4, 0x7c, 0x76,
+		0x06, 0x29, 0xfd, 0x19, 0xe9, 0xac, 0xe7, 0xa9, 0xdc, 0x27, 0x96, 0x36, 0x51, 0xce, 0xb6, 0x5f,
+		0xf3, 0x14, 0xc8, 0x57, 0x56, 0x7c, 0x64, 0xc2, 0x3a, 0x67, 0xb9, 0x28, 0x09, 0x8a, 0x02, 0xd6,
+		0x39, 0x4b, 0x20, 0xdc, 0x0b, 0x79, 0xec, 0xb7, 0xea, 0xf7, 0x97, 0xb2, 0x4a, 0x0e, 0x61, 0x1c,
+		0xa5, 0xfc, 0xbb, 0x71, 0x90, 0xfa, 0x0d, 0x98, 0x9b, 0xaa, 0x8e, 0x1d, 0x38, 0x55, 0x7d, 0xb4,
+		0x3f, 0x49, 0xee, 0xe5, 0xc5, 0x97, 0x41, 0xf2, 0xd2, 0xbb, 0x6c, 0xa9, 0x19, 0xee, 0xff, 0xf5,
+		0x39, 0xaa, 0xca, 0x64, 0x23, 0x08, 0x90, 0x2f, 0x05, 0x36, 0x2b, 0xc5, 0x9f, 0x8e, 0xe8, 0x1f,
+		0x70, 0xd7, 0x89, 0xd9, 0xb4, 0x9a, 0x9a, 0x43, 0x44, 0x7e, 0xcc, 0xa3, 0x44, 0x5f, 0x03, 0xb3,
+		0xc9, 0xb6, 0xa3, 0x39, 0x84, 0xfb, 0x1a, 0x2c, 0xf3, 0x50, 0xd0, 0x2c, 0xab, 0x8e, 0x50, 0xe6,
+		0x6b, 0x3c, 0x00, 0x13, 0x68, 0xd3, 0x75, 0xad, 0x2d, 0x5c, 0x85, 0x34, 0x73, 0x49, 0x38, 0x94,
+		0x79, 0x07, 0xe5, 0x26, 0xe4, 0xfd, 0xf6, 0xdc, 0x8d, 0xa9, 0x62, 0xbe, 0x98, 0x4a, 0xe6, 0x6f,
+		0x86, 0x30, 0xf9, 0x88, 0x77, 0x56, 0xc2, 0x37, 0x0d, 0x66, 0x68, 0xfc, 0x75, 0x83, 0x25, 0x4b,
+		0x32, 0x0a, 0xfb, 0x28, 0x2b, 0x30, 0x11, 0xb4, 0xfd, 0xf2, 0x04, 0xc4, 0x9d, 0x5d, 0x5e, 0x4b,
+		0xdc, 0xd9, 0x45, 0x4f, 0xd7, 0x7d, 0x69, 0x77, 0x22, 0x64, 0xb5, 0xe3, 0x74, 0x5e, 0xae, 0xa3,
+		0x3c, 0x09, 0x05, 0xc1, 0x93, 0x05, 0xe5, 0x87, 0x61, 0x26, 0xcc, 0xc4, 0x97, 0x77, 0x60, 0x26,
+		0xcc, 0x54, 0xcb, 0xe7, 0x21, 0xe3, 0xda, 0xf8, 0x90, 0xe8, 0x98, 0x56, 0x2b, 0x90, 0x15, 0x17,
+		0x35, 0x90, 0xce, 0x8f, 0x07, 0xd2, 0xf9, 0xe5, 0xef, 0x82, 0xe2, 0x30, 0xfb, 0xdd, 0x97, 0xe4,
+		0x4b, 0xba, 0x02, 0x3b, 0x0c, 0x69, 0xfe, 0xa4, 0x65, 0x9c, 0x6e, 0x60, 0xf1, 0x2f, 0x14, 0x24,
+		0xb3, 0xe5, 0x09, 0x0a, 0x66, 0x1f, 0x65, 0x15, 0x8e, 0x0e, 0xb5, 0xe1, 0xc3, 0xb7, 0xc2, 0x18,
+		0x23, 0xbe, 0x15, 0xd6, 0x10, 0xcd, 0xb1, 0x69, 0x5f, 0xc5, 0x41, 0x16, 0xf6, 0x55, 0xfe, 0x60,
+		0x02, 0x0e, 0x87, 0x5b, 0x72, 0x79, 0x16, 0xf2, 0xe8, 0xb7, 0x3a, 0x41, 0x17, 0x17, 0x3a, 0xda,
+		0xee, 0x06, 0xf7, 0x6f, 0xf9, 0x56, 0x42, 0xdc, 0xdd, 0x4a, 0x90, 0x37, 0x61, 0xaa, 0x6d, 0x36,
+		0xb4, 0xb6, 0xea, 0xdb, 0xca, 0xe1, 0x93, 0xe8, 0xbe, 0x01, 0x61, 0x07, 0x53, 0x86, 0x68, 0x70,
+		0xb8, 0xfe, 0x4f, 0x52, 0x1e, 0xcb, 0xee, 0xae, 0x8f, 0xbc, 0x08, 0xb9, 0x8e, 0x6e, 0x6f, 0x91,
+		0x1d, 0xed, 0x86, 0x6e, 0x76, 0xf9, 0x6c, 0x1a, 0x54, 0x9a, 0x15, 0x0f, 0x47, 0xec, 0x30, 0xf9,
+		0xc8, 0x7c, 0x43, 0x92, 0x0a, 0xdd, 0xf8, 0x4a, 0x1f, 0xd8, 0x9a, 0x0c, 0xdb, 0x43, 0x1a, 0x1f,
+		0xba, 0x87, 0x14, 0xb6, 0x61, 0x93, 0x09, 0xdf, 0xb0, 0xf9, 0x01, 0xff, 0xd0, 0x04, 0xd7, 0xbe,
+		0x81, 0x3d, 0x1c, 0xb9, 0x0e, 0x33, 0x9c, 0xbe, 0x19, 0x90, 0x7d, 0xc8, 0x51, 0x07, 0x36, 0xbf,
+		0xfa, 0x65, 0x2e, 0x0b, 0xf2, 0xe1, 0x62, 0x4f, 0xdc, 0x99, 0xd8, 0xc5, 0x26, 0x6a, 0xd2, 0xb7,
+		0x89, 0xfa, 0x7f, 0xd8, 0x50, 0x3c, 0x07, 0x53, 0x03, 0x7e, 0x44, 0xe8, 0xe6, 0xf0, 0x90, 0x84,
+		0x2c, 0xbe, 0x61, 0x57, 0x1a, 0xee, 0x38, 0x84, 0xb2, 0x3a, 0x50, 0xee, 0x7b, 0x98, 0x79, 0x7e,
+		0x00, 0x26, 0xfa, 0xdc, 0x1a, 0x36, 0x0a, 0x85, 0x40, 0x00, 0x59, 0xfe, 0xbe, 0x04, 0xcc, 0x84,
+		0xf9, 0x1e, 0x21, 0x8a, 0xf6, 0x02, 0x4c, 0x37, 0x49, 0x43, 0x6f, 0xde, 0xa9, 0x9e, 0x4d, 0x71,
+		0xea, 0xff, 0xa7, 0x66, 0x83, 0x6a, 0xf6, 0x13, 0x00, 0x19, 0x85, 0xd8, 0x16, 0xba, 0x12, 0x98,
+		0x49, 0x27, 0xbb, 0x0d, 0x62, 0x39, 0xde, 0x79, 0x80, 0x30, 0xef, 0x96, 0x61, 0xd7, 0x04, 0x26,
+		0xc6, 0x76, 0x2e, 0x19, 0x6e, 0x2a, 0xd0, 0xf0, 0x75, 0x78, 0x24, 0xca, 0xc9, 0xfd, 0xf1, 0xeb,
+		0x05, 0x11, 0xbf, 0x26, 0x86, 0x86, 0x66, 0x8c, 0xaa, 0x2f, 0x80, 0x7d, 0x82, 0x07, 0xb0, 0xc9,
+		0x88, 0xca, 0x02, 0x11, 0x6c, 0x35, 0x10, 0xc1, 0xa6, 0x23, 0xba, 0x39, 0x24, 0x84, 0xbd, 0x20,
+		0x42, 0xd8, 0xf1, 0x88, 0x16, 0xf7, 0xc5, 0xb0, 0xcf, 0xfa, 0x62, 0xd8, 0xec, 0x60, 0xa2, 0x30,
+		0x40, 0x1a, 0x12, 0xc4, 0x3e, 0xe3, 0x06, 0xb1, 0xf9, 0xa1, 0x01, 0x30, 0x27, 0xee, 0x8f, 0x62,
+		0xd7, 0x06, 0xa2, 0xd8, 0x02, 0xff, 0xdb, 0x3e, 0xc3, 0x58, 0x44, 0x84, 0xb1, 0x6b, 0x03, 0x61,
+		0xec, 0x44, 0x04, 0xc3, 0x88, 0x38, 0xf6, 0xdb, 0xc3, 0xe3, 0xd8, 0xe1, 0x91, 0x26, 0x6f, 0xe6,
+		0x68, 0x81, 0xac, 0x3a, 0x24, 0x90, 0x95, 0x86, 0x06, 0x5d, 0x8c, 0xfd, 0xc8, 0x91, 0xec, 0x66,
+		0x48, 0x24, 0xcb, 0x62, 0xce, 0x53, 0x43, 0x99, 0x8f, 0x10, 0xca, 0x6e, 0x86, 0x84, 0xb2, 0x72,
+		0x24, 0xdb, 0xc8, 0x58, 0xf6, 0x52, 0x30, 0x96, 0x9d, 0x1e, 0xe2, 0x30, 0x79, 0xb3, 0x7d, 0x48,
+		0x30, 0xbb, 0x35, 0x2c, 0x98, 0x65, 0x01, 0xe7, 0xa3, 0x43, 0x39, 0x1e, 0x20, 0x9a, 0x5d, 0x1b,
+		0x88, 0x66, 0x0f, 0x45, 0x68, 0xda, 0xe8, 0xe1, 0x2c, 0x7b, 0x6b, 0x9c, 0xbd, 0x32, 0x0e, 0x52,
+		0xee, 0x6a, 0x32, 0x93, 0x93, 0xf2, 0xe5, 0x87, 0x61, 0x4a, 0xb0, 0x72, 0xed, 0x1c, 0xba, 0xb9,
+		0xa4, 0xdb, 0x35, 0xbb, 0xe2, 0x70, 0x3f, 0xfd, 0x28, 0x9f, 0x82, 0xbc, 0x8b, 0xba, 0x7f, 0xe8,
+		0x4b, 0xc3, 0x09, 0x9f, 0x1d, 0x2b, 0x7f, 0x2a, 0x06, 0x79, 0xbf, 0x89, 0x0a, 0x84, 0x46, 0x59,
+		0x1e, 0x1a, 0xf9, 0x02, 0xe2, 0x78, 0x30, 0x20, 0xc6, 0x17, 0x85, 0xad, 0x81, 0x58, 0x57, 0xb3,
+		0xdc, 0x58, 0x57, 0x9c, 0xfd, 0x61, 0x61, 0x33, 0x5f, 0x96, 0xd8, 0x46, 0xd8, 0xa4, 0x7b, 0x0e,
+		0x8a, 0x45, 0x69, 0xf2, 0x19, 0x98, 0xf6, 0xe1, 0xba, 0xe1, 0x07, 0x0b, 0xfc, 0x24, 0x17, 0xbb,
+		0xc2, 0xe3, 0x90, 0x7f, 0x1e, 0x83, 0xa9, 0x01, 0x13, 0x19, 0x1a, 0xcf, 0xc6, 0xee, 0x52, 0x3c,
+		0x1b, 0xbf, 0xe3, 0x78, 0xd6, 0x1f, 0x4e, 0x25, 0x82, 0xe1, 0xd4, 0x7f, 0x8f, 0x41, 0x21, 0x60,
+		0xa9, 0x71, 0x08, 0x1a, 0x66, 0x93, 0xf0, 0x00, 0x87, 0xfe, 0x46, 0x97, 0xa4, 0x6d, 0xb6, 0x78,
+		0x18, 0x83, 0x3f, 0x11, 0xcb, 0x5d, 0x78, 0xb2, 0x7c, 0x5d, 0x71, 0x63, 0xa3, 0x94, 0xff, 0x0a,
+		0x02, 0x3f, 0x97, 0x9f, 0xf6, 0xce, 0xe5, 0xbb, 0xf7, 0x6f, 0xc7, 0x7d, 0xf7, 0x6f, 0xe5, 0xa7,
+		0x21, 0x4b, 0xf3, 0xd4, 0xaa, 0x69, 0xb1, 0xc5, 0xba, 0xcf, 0xb5, 0x09, 0x9e, 0xc9, 0xb7, 0xe9,
+		0x69, 0x61, 0xfa, 0xcb, 0xe7, 0x71, 0x64, 0x03, 0x1e, 0xc7, 0x3d, 0x90, 0xc5, 0xd6, 0xb3, 0x3f,
+		0xf0, 0x00, 0xfc, 0xf2, 0xb6, 0x00, 0x94, 0x7f, 0x35, 0x0e, 0x93, 0x7d, 0x0b, 0x4d, 0x68, 0xdf,
+		0xc3, 0x76, 0x40, 0x47, 0x93, 0xc7, 0x09, 0x80, 0x96, 0x66, 0xab, 0x37, 0x35, 0xc3, 0x21, 0x4d,
+		0x2e, 0x14, 0x1f, 0x04, 0xcf, 0xe0, 0xe3, 0x57, 0xcf, 0x26, 0x4d, 0x9e, 0x38, 0x70, 0xbf, 0xe5,
+		0x2b, 0x90, 0x26, 0x37, 0xe8, 0x03, 0xa7, 0xec, 0x99, 0xe0, 0xc3, 0x83, 0x91, 0x1c, 0x16, 0x2f,
+		0x14, 0x71, 0xb0, 0xbf, 0xf2, 0xe6, 0x49, 0x89, 0x61, 0x3f, 0xea, 0xde, 0x99, 0x52, 0x38, 0x7d,
+		0x50, 0x0a, 0x99, 0x3e, 0x29, 0xd0, 0x14, 0x56, 0x5e, 0x44, 0xa6, 0x28, 0x53, 0x76, 0x3c, 0x59,
+		0x29, 0x74, 0x48, 0xc7, 0x32, 0xcd, 0xb6, 0xca, 0xe6, 0x78, 0x05, 0x26, 0x5c, 0x59, 0xb1, 0xd5,
+		0x94, 0xfe, 0xf1, 0x25, 0x07, 0xb3, 0x3a, 0x01, 0x27, 0x38, 0xcf, 0x80, 0x6c, 0x4e, 0x5d, 0x4d,
+		0x66, 0x62, 0x12, 0xbe, 0x79, 0x1d, 0x97, 0x12, 0xe5, 0x75, 0x38, 0x14, 0xba, 0xae, 0xca, 0x4f,
+		0x41, 0xd6, 0x5b, 0x92, 0xd9, 0x09, 0xf3, 0x7d, 0x92, 0x04, 0x1e, 0x6e, 0xf9, 0x37, 0x63, 0x70,
+		0x28, 0x74, 0x65, 0x95, 0x6b, 0x90, 0x66, 0xc7, 0xfd, 0xf8, 0xd1, 0x8e, 0x33, 0xa3, 0xad, 0xc8,
+		0x73, 0xec, 0x2c, 0xa0, 0xc2, 0x89, 0xcb, 0xdf, 0x09, 0x69, 0x06, 0x91, 0x73, 0x30, 0xee, 0x3d,
+		0xa5, 0x0c, 0x90, 0xae, 0x54, 0xab, 0xb5, 0x75, 0xf1, 0x2e, 0xea, 0xc2, 0x9a, 0x82, 0x7f, 0x4b,
+		0x0d, 0x20, 0xad, 0xd4, 0xae, 0xd6, 0xaa, 0x1b, 0x52, 0x02, 0xff, 0xfa, 0x18, 0xfb, 0xad, 0x5e,
+		0xc2, 0x87, 0xf1, 0x37, 0xa4, 0xa4, 0x0f, 0x54, 0xaf, 0xad, 0x2e, 0xd6, 0x14, 0x29, 0x55, 0x7e,
+		0x1c, 0x8e, 0x8a, 0x76, 0x0c, 0x26, 0x33, 0xdc, 0x9c, 0x42, 0xcc, 0x97, 0x53, 0xc0, 0x47, 0xd5,
+		0x4b, 0xc3, 0x17, 0x66, 0xf9, 0x6a, 0x5f, 0xc7, 0xcf, 0x1d, 0x60, 0x55, 0xef, 0xeb, 0x3d, 0xc6,
+		0x31, 0x5d, 0xb2, 0x4d, 0x9c, 0xc6, 0x0e, 0x73, 0x14, 0x98, 0x05, 0x2a, 0x28, 0x05, 0x0e, 0xa5,
+		0x44, 0x36, 0x43, 0x7b, 0x95, 0x34, 0x1c, 0x95, 0x29, 0x91, 0xcd, 0xff, 0x56, 0x6c, 0x81, 0x41,
+		0xeb, 0x0c, 0x58, 0xfe, 0xae, 0x03, 0xc9, 0x32, 0x0b, 0x29, 0xa5, 0x86, 0xaf, 0xdf, 0x27, 0xf0,
+		0x4f, 0xac, 0xd1, 0x9f, 0x6a, 0x7d, 0xb5, 0xb2, 0x5e, 0xbf, 0xb2, 0x86, 0xb2, 0x9c, 0x86, 0x49,
+		0x21, 0x4b, 0x01, 0x4c, 0x95, 0x1f, 0x81, 0x23, 0x43, 0xbc, 0x8a, 0x90, 0xf3, 0x97, 0x1f, 0x8e,
+		0xf9, 0xb1, 0x83, 0x9e, 0xc1, 0x1a, 0xa4, 0x6d, 0x47, 0x73, 0x7a, 0x36, 0x17, 0xe2, 0x53, 0xa3,
+		0xba, 0x19, 0x73, 0xe2, 0x47, 0x9d, 0x92, 0x2b, 0x9c, 0x4d, 0xf9, 0x3c, 0x4c, 0x04, 0x4b, 0x86,
+		0xcb, 0xc0, 0x53, 0xa2, 0x78, 0xf9, 0x22, 0xc8, 0x83, 0xde, 0x47, 0x48, 0x78, 0x19, 0x0b, 0x0b,
+		0x2f, 0x7f, 0x31, 0x06, 0xc7, 0xf6, 0xf1, 0x34, 0xe4, 0x17, 0xfa, 0x3a, 0xf9, 0xcc, 0x41, 0xfc,
+		0x94, 0x39, 0x06, 0xeb, 0xeb, 0xe6, 0x13, 0x90, 0xf7, 0xc3, 0x47, 0xeb, 0xe4, 0x57, 0xe2, 0x70,
+		0x28, 0xd4, 0x69, 0xf1, 0x99, 0xc0, 0xd8, 0x37, 0x69, 0x02, 0xdf, 0x03, 0xe0, 0xec, 0x8a, 0x03,
+		0xc0, 0x7c, 0x1d, 0x3d, 0x1e, 0x92, 0x1a, 0x23, 0x8d, 0x8d, 0x5d, 0x3e, 0x09, 0xb2, 0x0e, 0xff,
+		0x85, 0x69, 0x1e, 0x5f, 0x52, 0xa0, 0x47, 0xd7, 0x58, 0xdb, 0xfd, 0x4b, 0xb1, 0xa3, 0x2d, 0xc6,
+		0xd2, 0x8d, 0x20, 0xd8, 0x96, 0x5f, 0x86, 0x23, 0x7d, 0x8e, 0x82, 0xcb, 0x3a, 0x39, 0xaa, 0xbf,
+		0x70, 0x28, 0xe8, 0x2f, 0x08, 0xd6, 0xfe, 0xd5, 0x3e, 0x15, 0x5c, 0xed, 0x5f, 0x06, 0xf0, 0x92,
+		0x03, 0xde, 0x89, 0xb5, 0x98, 0xff, 0xc4, 0xda, 0x79, 0x48, 0xa1, 0x26, 0x09, 0x39, 0x0d, 0x9a,
+		0x62, 0xd4, 0x04, 0x5f, 0x72, 0x81, 0x61, 0x97, 0x75, 0x90, 0x07, 0x73, 0x8b, 0x43, 0xaa, 0x78,
+		0x36, 0x58, 0xc5, 0xbd, 0x43, 0xb3, 0x94, 0xe1, 0x55, 0xbd, 0x06, 0x29, 0x3a, 0xf2, 0xa1, 0xd7,
+		0x00, 0xbf, 0x03, 0x40, 0x73, 0x9c, 0xae, 0xbe, 0xd5, 0xf3, 0x2a, 0x38, 0x19, 0xae, 0x39, 0x15,
+		0x81, 0xb7, 0x70, 0x0f, 0x57, 0xa1, 0x19, 0x8f, 0xd4, 0xa7, 0x46, 0x3e, 0x86, 0xe5, 0x55, 0x98,
+		0x08, 0xd2, 0x86, 0x5f, 0x6b, 0xf4, 0xde, 0x17, 0xc9, 0x0a, 0xff, 0xc6, 0xf5, 0x8e, 0xf8, 0x9b,
+		0x3f, 0xf4, 0xa3, 0xfc, 0x17, 0x31, 0xc8, 0xfb, 0x15, 0xef, 0x2e, 0xbb, 0x20, 0xc7, 0x43, 0x5c,
+		0x90, 0x6c, 0x4b, 0xb3, 0x5f, 0xa4, 0x00, 0x54, 0x95, 0x3e, 0x0f, 0x64, 0xbc, 0xa5, 0xd9, 0x9b,
+		0xef, 0xa2, 0x03, 0x52, 0xfe, 0xfe, 0x18, 0x64, 0xdc, 0xce, 0xef, 0x73, 0x4a, 0xd7, 0xbb, 0xdc,
+		0xea, 0x66, 0xdd, 0xd9, 0xfe, 0x46, 0xc2, 0xdd, 0xdf, 0xb8, 0xe8, 0x2e, 0x7e, 0xc3, 0xd2, 0x21,
+		0x7e, 0x49, 0x8b, 0x93, 0x72, 0x7c, 0xad, 0xbf, 0x38, 0xda, 0x1d, 0x32, 0xdc, 0x7b, 0xf1, 0xdd,
+		0xff, 0x62, 0x1f, 0xe5, 0xa6, 0xef, 0x68, 0x0c, 0x9b, 0x86, 0xfe, 0xcb, 0x66, 0xb1, 0x03, 0x5f,
+		0x36, 0x73, 0x6b, 0x89, 0xfb, 0x6b, 0xf9, 0xf1, 0x18, 0x64, 0xc4, 0x8c, 0x90, 0xdf, 0xeb, 0x3f,
+		0x32, 0x25, 0xf6, 0xe4, 0x86, 0x5a, 0x23, 0xce, 0xdf, 0x23, 0x19, 0x3c, 0x29, 0x9c, 0x38, 0xe8,
+		0x49, 0x61, 0xee, 0xd7, 0xfd, 0x29, 0xfe, 0x2d, 0x93, 0xbe, 0xf9, 0xfa, 0x4d, 0xb7, 0x6e, 0x70,
+		0x91, 0x4b, 0x84, 0x2c, 0x72, 0xc3, 0x8e, 0xff, 0x26, 0x87, 0x1d, 0xff, 0x1d, 0xec, 0x75, 0xea,
+		0x0e, 0x7b, 0xfd, 0xfe, 0x38, 0xe4, 0x7c, 0xc9, 0x51, 0xf9, 0xc9, 0xc0, 0x51, 0xe2, 0xd9, 0xfd,
+		0x12, 0xa9, 0xbe, 0xb3, 0xc4, 0x01, 0x31, 0xc5, 0x0f, 0x2e, 0xa6, 0xbb, 0x7f, 0xad, 0x28, 0xfc,
+		0xe6, 0x65, 0x6a, 0xc8, 0xcd, 0xcb, 0xef, 0x8d, 0x41, 0xc6, 0x75, 0xba, 0x0f, 0xba, 0xfb, 0x76,
+		0x18, 0xd2, 0xdc, 0xaf, 0x64, 0xdb, 0x6f, 0xfc, 0x2b, 0x34, 0xa9, 0x5c, 0x82, 0x8c, 0xf8, 0xa3,
+		0x7d, 0x7c, 0x4d, 0x73, 0xbf, 0x4f, 0x3f, 0x03, 0x39, 0xdf, 0xce, 0x25, 0x1a, 0xc6, 0xd5, 0xda,
+		0x8b, 0xd2, 0x58, 0x69, 0xfc, 0xd6, 0xed, 0xd9, 0xc4, 0x2a, 0xb9, 0x89, 0xb3, 0x59, 0xa9, 0x55,
+		0xaf, 0xd4, 0xaa, 0xcf, 0x4b, 0xb1, 0x52, 0xee, 0xd6, 0xed, 0xd9, 0x71, 0x85, 0xd0, 0x7c, 0xe2,
+		0xe9, 0xe7, 0x61, 0xb2, 0x6f, 0x60, 0x82, 0x4e, 0x8b, 0x0c, 0x13, 0x8b, 0x9b, 0xeb, 0xcb, 0x4b,
+		0xd5, 0xca, 0x46, 0x4d, 0x65, 0x07, 0x4e, 0xf1, 0x2f, 0xac, 0x2c, 0x2f, 0x5d, 0xbe, 0xb2, 0xa1,
+		0x56, 0x97, 0x97, 0x6a, 0xab, 0x1b, 0x6a, 0x65, 0x63, 0xa3, 0x52, 0x7d, 0x5e, 0x8a, 0x9f, 0xbb,
+		0x9d, 0x83, 0x24, 0x1e, 0x2b, 0x93, 0xab, 0x90, 0xa4, 0x89, 0x90, 0x7d, 0x8f, 0x2e, 0x95, 0xf6,
+		0xcf, 0x0c, 0xcb, 0x97, 0x20, 0x45, 0x73, 0x24, 0xf2, 0xfe, 0x67, 0x99, 0x4a, 0x11, 0xa9, 0x62,
+		0x6c, 0x0c, 0x9d, 0x91, 0xfb, 0x1e, 0x6e, 0x2a, 0xed, 0x9f, 0x39, 0x96, 0x97, 0x61, 0x5c, 0x84,
+		0xc8, 0x51, 0x27, 0x8e, 0x4a, 0x91, 0xe9, 0x5c, 0xec, 0x1a, 0x4b, 0x35, 0xec, 0x7f, 0xee, 0xa9,
+		0x14, 0x91, 0x53, 0x96, 0x97, 0xdc, 0x8b, 0x38, 0x11, 0x47, 0x99, 0x4a, 0x51, 0x59, 0x62, 0x59,
+		0x81, 0xac, 0x97, 0xc4, 0x89, 0x3e, 0xcd, 0x55, 0x1a, 0x21, 0x5d, 0x2e, 0x7f, 0x27, 0x14, 0x82,
+		0x81, 0xee, 0x68, 0xc7, 0xa5, 0x4a, 0x23, 0xe6, 0xa3, 0x91, 0x7f, 0x30, 0xea, 0x1d, 0xed, 0xf8,
+		0x54, 0x69, 0xc4, 0xf4, 0xb4, 0xfc, 0x2a, 0x4c, 0x0d, 0x46, 0xa5, 0xa3, 0x9f, 0xa6, 0x2a, 0x1d,
+		0x20, 0x61, 0x2d, 0x77, 0x40, 0x0e, 0x89, 0x66, 0x0f, 0x70, 0xb8, 0xaa, 0x74, 0x90, 0xfc, 0xb5,
+		0xdc, 0x84, 0xc9, 0xfe, 0x10, 0x71, 0xd4, 0xc3, 0x56, 0xa5, 0x91, 0x73, 0xd9, 0xac, 0x96, 0x60,
+		0x68, 0x39, 0xea, 0xe1, 0xab, 0xd2, 0xc8, 0xa9, 0x6d, 0x79, 0x13, 0xc0, 0x17, 0x1d, 0x8e, 0x70,
+		0x18, 0xab, 0x34, 0x4a, 0x92, 0x5b, 0xb6, 0x60, 0x3a, 0x2c, 0x6c, 0x3c, 0xc8, 0xd9, 0xac, 0xd2,
+		0x81, 0x72, 0xdf, 0xa8, 0xcf, 0xc1, 0x00, 0x70, 0xb4, 0xb3, 0x5a, 0xa5, 0x11, 0x93, 0xe0, 0x0b,
+		0x95, 0xa1, 0x07, 0x74, 0x1f, 0xda, 0xf7, 0x80, 0xae, 0x77, 0xe4, 0xd6, 0x7b, 0x7c, 0xee, 0x69,
+		0xb8, 0x9f, 0x3f, 0xfd, 0x62, 0x3b, 0xda, 0x75, 0xdd, 0x68, 0xb9, 0x6f, 0xf9, 0xf0, 0x6f, 0x7e,
+		0x3a, 0xf7, 0x30, 0xc3, 0x9a, 0x13, 0xd0, 0x88, 0x17, 0x7d, 0x86, 0x3e, 0x73, 0x18, 0x75, 0x90,
+		0x3f, 0xfa, 0xdc, 0xed, 0x3e, 0xaf, 0x05, 0x45, 0xbc, 0x49, 0x14, 0xf2, 0x9a, 0x50, 0x69, 0xdf,
+		0xcb, 0xf1, 0xa5, 0xfd, 0x0e, 0x2c, 0xa3, 0x17, 0x3b, 0x71, 0x45, 0xb7, 0x1d, 0xb3, 0xab, 0x37,
+		0xb4, 0x36, 0x5d, 0x5e, 0x2e, 0x8e, 0x7a, 0x9d, 0x68, 0x21, 0x8b, 0xee, 0x0a, 0x7f, 0x82, 0x88,
+		0x91, 0xc8, 0x8b, 0x90, 0xbe, 0xa1, 0xb5, 0xd9, 0x65, 0x1e, 0xff, 0x6b, 0x63, 0xfd, 0x32, 0xf7,
+		0xf9, 0x51, 0x7e, 0x2e, 0x8c, 0xb6, 0xfc, 0x49, 0x7a, 0x11, 0xa2, 0xd3, 0xd1, 0x6d, 0xd4, 0x45,
+		0x85, 0x06, 0xd2, 0x9b, 0x90, 0xec, 0x6a, 0x0e, 0x0f, 0x2f, 0x17, 0x2a, 0xfc, 0xe1, 0xa0, 0x07,
+		0xa3, 0x9f, 0xff, 0x99, 0x1b, 0x78, 0x5b, 0x88, 0x55, 0x46, 0xd9, 0xc9, 0xdf, 0x0e, 0x78, 0xa6,
+		0x5e, 0xa5, 0xac, 0xe3, 0x77, 0x8b, 0x35, 0xde, 0x32, 0xc0, 0x56, 0xcb, 0x3a, 0xe0, 0x25, 0x01,
+		0xdc, 0xdb, 0x35, 0x5a, 0x84, 0x55, 0x92, 0xb8, 0x5b, 0x95, 0x14, 0x3a, 0xda, 0x6e, 0x95, 0x32,
+		0xc6, 0xaa, 0xf8, 0x13, 0x4d, 0xbf, 0x1d, 0x03, 0xf0, 0x24, 0x27, 0x6b, 0xb8, 0x03, 0x22, 0xbe,
+		0x68, 0xfd, 0x62, 0x07, 0xe4, 0xa1, 0x61, 0x03, 0xd3, 0x27, 0xf7, 0x85, 0x02, 0xb6, 0xf4, 0x73,
+		0x6f, 0x9e, 0x8c, 0xb1, 0x5a, 0x27, 0x1b, 0x7d, 0xe3, 0x72, 0x15, 0x72, 0x2c, 0x57, 0xa2, 0x52,
+		0x27, 0x37, 0x1e, 0xe9, 0xe4, 0x16, 0x84, 0x93, 0xcb, 0x18, 0x02, 0xa3, 0xc6, 0x72, 0xde, 0x87,
+		0x8f, 0xc4, 0x20, 0xb7, 0xe8, 0x7b, 0x5c, 0x10, 0xb7, 0xb0, 0x4c, 0x43, 0xbf, 0x4e, 0xba, 0xee,
+		0x16, 0x16, 0xfb, 0x44, 0x47, 0x94, 0xfd, 0x8d, 0x3a, 0x67, 0x4f, 0xbc, 0x9b, 0x23, 0xbe, 0x91,
+		0xea, 0x26, 0xd9, 0xb2, 0x75, 0x21, 0x72, 0x45, 0x7c, 0xe2, 0x79, 0x04, 0x9b, 0x34, 0x7a, 0x98,
+		0x59, 0xc7, 0x3f, 0x4f, 0xe9, 0xe0, 0xdf, 0x9e, 0x65, 0x61, 0xfa, 0xa4, 0x80, 0x57, 0x19, 0x18,
+		0x99, 0x34, 0x89, 0xa3, 0xe9, 0x6d, 0x76, 0xe6, 0x30, 0xab, 0x88, 0x4f, 0xde, 0xd4, 0x4f, 0x8e,
+		0xfb, 0x03, 0xd5, 0x2a, 0x48, 0xa6, 0x45, 0xba, 0x81, 0xf3, 0x2a, 0x4c, 0x5d, 0x8b, 0x9f, 0x7f,
+		0xe3, 0xcc, 0x0c, 0x17, 0x38, 0x3f, 0xeb, 0xc0, 0xde, 0xd2, 0x57, 0x26, 0x05, 0x05, 0x07, 0xcb,
+		0x2f, 0x07, 0x36, 0xad, 0x7a, 0x5b, 0xde, 0x03, 0x29, 0x33, 0x03, 0x42, 0xad, 0x18, 0x7b, 0x0b,
+		0xc5, 0xcf, 0x7a, 0xac, 0xbd, 0x40, 0x16, 0x9f, 0x24, 0xf1, 0xed, 0x60, 0x51, 0x36, 0xe8, 0xe3,
+		0xbf, 0xaa, 0xe9, 0x6d, 0xf1, 0xe7, 0x3c, 0x15, 0xfe, 0x25, 0xcf, 0xbb, 0xd9, 0xc7, 0x24, 0x0d,
+		0x98, 0xca, 0xc3, 0x74, 0x63, 0xc1, 0x34, 0x9a, 0xc1, 0x34, 0xa3, 0xbc, 0x01, 0x69, 0xc7, 0xbc,
+		0x4e, 0x0c, 0x2e, 0xa0, 0x85, 0xf7, 0x1c, 0x40, 0xb1, 0x07, 0x5f, 0x0b, 0xe3, 0xbc, 0xe4, 0x16,
+		0x48, 0x4d, 0xd2, 0x26, 0x2d, 0x2a, 0x4a, 0xfc, 0x43, 0xe5, 0x84, 0x5d, 0x4c, 0x3f, 0x18, 0xff,
+		0xc1, 0x47, 0xc5, 0x26, 0x5d, 0xae, 0x75, 0xca, 0x54, 0x5e, 0x0f, 0x3e, 0x6d, 0x39, 0xce, 0xf7,
+		0x9d, 0x87, 0xf4, 0xdf, 0xa7, 0x95, 0x7e, 0xb3, 0xe5, 0x67, 0x81, 0xda, 0xd5, 0x33, 0xb6, 0x4c,
+		0x83, 0xfe, 0x85, 0x3c, 0x1e, 0x82, 0x65, 0xd8, 0x26, 0xa7, 0x0b, 0xe7, 0x9b, 0x9c, 0xeb, 0x30,
+		0xe1, 0xa1, 0xd2, 0xd9, 0x93, 0x3d, 0xe8, 0xec, 0x29, 0xb8, 0x0c, 0x10, 0x05, 0xff, 0x94, 0x9e,
+		0x37, 0x3f, 0x8b, 0xc0, 0x0f, 0x93, 0x44, 0xce, 0x74, 0x7f, 0x67, 0x7c, 0x0c, 0xe4, 0x36, 0x4c,
+		0x77, 0x74, 0x43, 0xb5, 0x49, 0x7b, 0x5b, 0xe5, 0x92, 0x43, 0xbe, 0xb9, 0xbb, 0x30, 0xd2, 0x53,
+		0x1d, 0xdd, 0xa8, 0x93, 0xf6, 0xf6, 0xa2, 0xcb, 0x56, 0x7e, 0x0f, 0x1c, 0xf3, 0xc4, 0x61, 0x1a,
+		0xea, 0x8e, 0xd9, 0x6e, 0xaa, 0x5d, 0xb2, 0xad, 0x36, 0xe8, 0x63, 0x75, 0x79, 0x2a, 0xc4, 0x23,
+		0x2e, 0xca, 0x9a, 0x71, 0xc5, 0x6c, 0x37, 0x15, 0xb2, 0x5d, 0xc5, 0x62, 0xdc, 0x02, 0xf3, 0xa8,
+		0xf5, 0x26, 0x9e, 0x27, 0x49, 0xe0, 0x79, 0x6c, 0x17, 0xb8, 0xd4, 0xb4, 0xe7, 0x33, 0x3f, 0xf0,
+		0xa1, 0x93, 0x63, 0x5f, 0xfe, 0xd0, 0xc9, 0xb1, 0xf2, 0x25, 0xfa, 0xb4, 0x12, 0x9f, 0x74, 0xc4,
+		0x96, 0x2f, 0x40, 0x56, 0x13, 0x1f, 0xec, 0xe2, 0xd4, 0x3e, 0x93, 0xd6, 0x43, 0x2d, 0x7f, 0x28,
+		0x06, 0xe9, 0xc5, 0x6b, 0xeb, 0x9a, 0xde, 0x95, 0x6b, 0xf8, 0x28, 0xa7, 0x50, 0xda, 0x51, 0xe7,
+		0xbf, 0xa7, 0xe7, 0x1c, 0x8e, 0x6c, 0xc2, 0x8f, 0xbd, 0xed, 0xcb, 0xa6, 0xff, 0x40, 0x9c, 0xaf,
+		0xab, 0x57, 0x61, 0x9c, 0xb5, 0xd0, 0x96, 0x9f, 0x83, 0x94, 0x85, 0x3f, 0x8a, 0xb1, 0xc0, 0xf3,
+		0x9c, 0x83, 0x8a, 0x4e, 0xf1, 0xfd, 0x6a, 0xc1, 0xe8, 0xca, 0x7f, 0x1e, 0x03, 0x58, 0xbc, 0x76,
+		0x6d, 0xa3, 0xab, 0x5b, 0x6d, 0xe2, 0xdc, 0xad, 0x2e, 0x2f, 0xc3, 0x21, 0xaf, 0xcb, 0x76, 0xb7,
+		0x31, 0x72, 0xb7, 0xa7, 0x5d, 0xb2, 0x7a, 0xb7, 0x11, 0xca, 0xad, 0x69, 0x3b, 0x2e, 0xb7, 0xc4,
+		0xc8, 0xdc, 0x16, 0x6d, 0x67, 0x50, 0x8e, 0x2f, 0x41, 0xce, 0xeb, 0xba, 0x2d, 0x2f, 0x41, 0xc6,
+		0xe1, 0xbf, 0xb9, 0x38, 0xcb, 0xc3, 0xc5, 0x29, 0xc8, 0xfc, 0x22, 0x75, 0xc9, 0xcb, 0xff, 0x13,
+		0xa5, 0xea, 0x4d, 0x84, 0x6f, 0x29, 0x45, 0x42, 0x0b, 0xcf, 0x2d, 0x70, 0xe2, 0x2e, 0x58, 0x60,
+		0xce, 0xcb, 0x27, 0xd6, 0xef, 0x8b, 0xe3, 0xdf, 0xb6, 0xe5, 0x93, 0xf4, 0x5b, 0x56, 0x0a, 0x9b,
+		0x30, 0x4e, 0x0c, 0xa7, 0xab, 0xbb, 0xbb, 0x4d, 0x8f, 0x0d, 0x1b, 0xec, 0x90, 0xbe, 0xd4, 0x0c,
+		0xa7, 0xbb, 0xe7, 0x1f, 0x7a, 0xc1, 0xcb, 0x27, 0x86, 0xdf, 0x48, 0x40, 0x71, 0x18, 0x29, 0x3e,
+		0xbe, 0xd2, 0xe8, 0x12, 0x0a, 0x08, 0xde, 0x83, 0x9c, 0x10, 0x60, 0xbe, 0xa4, 0x28, 0x80, 0x0e,
+		0x1a, 0x6a, 0x15, 0xa2, 0xde, 0x99, 0x47, 0x36, 0xe1, 0x71, 0xa0, 0x8b, 0x0a, 0x81, 0x49, 0x71,
+		0xb1, 0x62, 0x4b, 0x6b, 0x6b, 0x78, 0xa9, 0x38, 0x71, 0x17, 0x56, 0x00, 0x71, 0x5b, 0x63, 0x81,
+		0xf1, 0x94, 0xaf, 0xc1, 0xb8, 0x60, 0x9f, 0xbc, 0x0b, 0xec, 0x05, 0x33, 0xbc, 0x62, 0xe3, 0x5f,
+		0x18, 0xa8, 0x9f, 0x92, 0x54, 0x72, 0x2e, 0x6c, 0xa9, 0x19, 0xb5, 0xf2, 0xa4, 0xf7, 0x5d, 0x79,
+		0xb8, 0x2b, 0xf8, 0xe9, 0x04, 0x1e, 0x41, 0x6a, 0xfe, 0x15, 0x1c, 0xb8, 0x6f, 0x03, 0x60, 0x93,
+		0x1a, 0x8d, 0x6d, 0x31, 0x79, 0x17, 0x8c, 0x44, 0x96, 0xf1, 0x5b, 0xb4, 0x9d, 0x77, 0x6b, 0xf4,
+		0xfe, 0x75, 0x1c, 0xf2, 0xfe, 0xd1, 0xfb, 0x2b, 0xb0, 0xb2, 0xc9, 0xab, 0x9e, 0x49, 0x63, 0xf7,
+		0x49, 0x1e, 0x1e, 0x66, 0xd2, 0x06, 0xf4, 0x3a, 0xc2, 0x96, 0xfd, 0xee, 0x38, 0xa4, 0xf9, 0xa9,
+		0xb9, 0xb5, 0x01, 0x1f, 0x37, 0x16, 0x75, 0x23, 0xbe, 0x20, 0x6e, 0xc4, 0x87, 0xba, 0xb8, 0x0f,
+		0x00, 0xde, 0x93, 0x57, 0x03, 0x47, 0xf1, 0x70, 0xc3, 0x02, 0xc3, 0x61, 0xef, 0xe0, 0x39, 0x9e,
+		0x46, 0x44, 0x34, 0xcf, 0x66, 0x23, 0x0e, 0x5e, 0xdd, 0xa9, 0x31, 0x88, 0x7c, 0x06, 0xe4, 0x1d,
+		0x37, 0xf1, 0xa1, 0x7a, 0x82, 0x40, 0xbc, 0x29, 0xaf, 0x44, 0xa0, 0xe3, 0x93, 0x20, 0xa6, 0xd1,
+		0x54, 0xd9, 0x7b, 0xca, 0xfc, 0x6d, 0x6a, 0x84, 0x2c, 0x22, 0x40, 0xfe, 0x91, 0x18, 0x73, 0x95,
+		0xfb, 0xa2, 0x6d, 0x1e, 0xb4, 0xa8, 0x07, 0x9b, 0x0d, 0x5f, 0x7f, 0xf3, 0x64, 0x69, 0x4f, 0xeb,
+		0xb4, 0xe7, 0xcb, 0x21, 0x2c, 0xcb, 0x61, 0xb9, 0x00, 0xf4, 0xa6, 0x83, 0x81, 0xbb, 0xfc, 0xdd,
+		0x70, 0xb4, 0xd5, 0x36, 0xb7, 0xf0, 0x26, 0x92, 0xfe, 0xbe, 0x9e, 0xde, 0x54, 0xf9, 0xa0, 0xaa,
+		0x0d, 0xcd, 0x2a, 0x8e, 0xdf, 0xad, 0x24, 0xc4, 0x61, 0x56, 0xc7, 0x32, 0xad, 0xa2, 0xce, 0x6a,
+		0xa8, 0x6a, 0x96, 0xfc, 0xbd, 0x31, 0xb8, 0xc7, 0x53, 0xd5, 0x90, 0x16, 0x64, 0xee, 0x56, 0x0b,
+		0x8e, 0xba, 0xd5, 0x0c, 0x34, 0xe2, 0x35, 0x28, 0xa2, 0x0e, 0xd0, 0xf0, 0x85, 0x0e, 0x5e, 0xa7,
+		0xd7, 0x76, 0x74, 0xab, 0xad, 0x93, 0x6e, 0x31, 0x7b, 0xb7, 0xea, 0x3f, 0xd4, 0xd1, 0x76, 0x31,
+		0x90, 0xc1, 0xd8, 0x78, 0xc5, 0xe5, 0x2f, 0xbf, 0x1e, 0x83, 0x63, 0x01, 0x3d, 0x65, 0x9b, 0x77,
+		0xaa, 0x45, 0xba, 0x0d, 0x62, 0x38, 0x45, 0xb8, 0x5b, 0xf5, 0x17, 0xfd, 0x7a, 0x4f, 0x37, 0x02,
+		0xd7, 0x59, 0x15, 0x68, 0x3a, 0x89, 0x65, 0x36, 0x76, 0xd4, 0x36, 0x31, 0x5a, 0x0e, 0x7b, 0x8a,
+		0x2f, 0xa9, 0xe4, 0x28, 0x6c, 0x99, 0x82, 0xe6, 0xef, 0x47, 0xe3, 0x77, 0xeb, 0x4b, 0x1f, 0x3d,
+		0x7d, 0xcc, 0x57, 0xdd, 0xae, 0x9b, 0x46, 0x65, 0x73, 0xb8, 0xfc, 0xcf, 0x62, 0x50, 0x78, 0xa1,
+		0x47, 0x7a, 0xa4, 0xc9, 0x1f, 0xad, 0xc7, 0xcd, 0x7e, 0xfe, 0xc2, 0x4e, 0x52, 0x89, 0xeb, 0xcd,
+		0xa1, 0xcf, 0x30, 0x85, 0x5a, 0xd1, 0xc4, 0x81, 0xad, 0x68, 0x15, 0x12, 0x1d, 0xbb, 0x55, 0x4c,
+		0xee, 0x93, 0x06, 0x39, 0xf6, 0xd9, 0x37, 0xce, 0x1c, 0x09, 0x79, 0xae, 0x7d, 0x6e, 0xc5, 0x6e,
+		0x29, 0x48, 0x8d, 0xbd, 0x98, 0xde, 0xc0, 0xf4, 0x82, 0xfe, 0x1a, 0xa1, 0xd1, 0xbf, 0x42, 0x1a,
+		0x66, 0xb7, 0x39, 0xd0, 0x97, 0x39, 0x48, 0x99, 0x37, 0x0d, 0xbe, 0xdd, 0xbf, 0x5f, 0x3b, 0x19,
+		0x1a, 0x35, 0x48, 0x66, 0xb3, 0xd7, 0x26, 0xaa, 0xd6, 0x60, 0x2b, 0x0e, 0xcb, 0x37, 0x15, 0x18,
+		0xb4, 0xc2, 0x80, 0xc1, 0x57, 0x55, 0xd8, 0x22, 0x79, 0xef, 0xe7, 0xdf, 0x38, 0x73, 0x9c, 0xb3,
+		0xbe, 0xd6, 0xe7, 0x70, 0x8a, 0x48, 0xd3, 0xa5, 0xe1, 0x0b, 0xd5, 0xaf, 0xc4, 0x40, 0xf6, 0xbc,
+		0x43, 0xf7, 0xae, 0xcc, 0x0a, 0x80, 0x2f, 0x40, 0x8f, 0xed, 0x1f, 0xf8, 0x7b, 0xf4, 0x81, 0xc0,
+		0xdf, 0xb7, 0xfa, 0xbd, 0xd7, 0xf3, 0xc5, 0xc4, 0x03, 0x26, 0x61, 0xb2, 0xc5, 0x47, 0xe6, 0x03,
+		0x4b, 0x01, 0x27, 0xa2, 0x6d, 0x1d, 0x2b, 0xbf, 0x19, 0xc3, 0x23, 0x95, 0x7d, 0x4b, 0x87, 0xdb,
+		0xe4, 0x06, 0xc8, 0x5d, 0x5f, 0x21, 0x35, 0xc1, 0xe2, 0x78, 0xc6, 0x9d, 0xad, 0x44, 0x53, 0xdd,
+		0xfe, 0xd2, 0x77, 0xca, 0xa9, 0xe4, 0x83, 0xf1, 0x3b, 0x31, 0xbc, 0x35, 0xd6, 0x1c, 0x1c, 0x8e,
+		0x3a, 0xe4, 0xfd, 0x6d, 0xe1, 0xbd, 0xba, 0x7f, 0x94, 0x5e, 0xf9, 0x3b, 0x14, 0x60, 0x82, 0x7d,
+		0x11, 0xcb, 0x14, 0x4b, 0xae, 0x3f, 0x3e, 0xb2, 0x94, 0x44, 0xc3, 0x42, 0xd7, 0x6d, 0x36, 0x58,
+		0x3f, 0x1c, 0x87, 0xe4, 0xba, 0x69, 0xb6, 0xd1, 0x74, 0x4f, 0x19, 0xa6, 0x43, 0x2d, 0x26, 0x69,
+		0xaa, 0x3c, 0xbb, 0xc7, 0x5c, 0x9f, 0x6b, 0x07, 0x93, 0xde, 0x57, 0xde, 0x3c, 0x39, 0xc8, 0x2a,
+		0xec, 0x2f, 0x18, 0x4c, 0x1a, 0xa6, 0xb3, 0x40, 0x91, 0xe8, 0x0c, 0xb5, 0xe5, 0x9b, 0x50, 0x08,
+		0xd6, 0xcf, 0x26, 0xa3, 0x72, 0xe0, 0xfa, 0x0b, 0x91, 0x75, 0xe7, 0xb7, 0x7c, 0x15, 0xb3, 0x77,
+		0xc4, 0xbf, 0x86, 0x83, 0xfb, 0x32, 0x48, 0xd7, 0xfa, 0x0f, 0x0a, 0xd6, 0x60, 0x5c, 0x1c, 0x0c,
+		0x8c, 0x8d, 0x78, 0xe6, 0xd0, 0x2f, 0x71, 0x4e, 0x7b, 0xfa, 0x13, 0x31, 0x00, 0x2f, 0x97, 0x8a,
+		0x4f, 0x6a, 0x2d, 0xac, 0xad, 0x2e, 0xaa, 0xf5, 0x8d, 0xca, 0xc6, 0x66, 0x3d, 0xf8, 0x67, 0x6f,
+		0xc4, 0x03, 0x5c, 0xb6, 0x45, 0x1a, 0xfa, 0xb6, 0x4e, 0x9a, 0xf2, 0x83, 0x30, 0x13, 0xc4, 0xc6,
+		0xaf, 0xda, 0xa2, 0x14, 0x2b, 0xe5, 0x6f, 0xdd, 0x9e, 0xcd, 0xb0, 0x18, 0x92, 0xe0, 0x73, 0xb2,
+		0x87, 0x06, 0xf1, 0xf0, 0x4f, 0xe6, 0xc4, 0x4b, 0x85, 0x5b, 0xb7, 0x67, 0xb3, 0x6e, 0xb0, 0x29,
+		0x97, 0x41, 0xf6, 0x63, 0x72, 0x7e, 0x89, 0x12, 0xdc, 0xba, 0x3d, 0x9b, 0x66, 0xc3, 0x52, 0x4a,
+		0xe2, 0x33, 0x5b, 0xa7, 0xbf, 0x03, 0x60, 0xc9, 0xd8, 0xee, 0x6a, 0x0d, 0xaa, 0x90, 0x25, 0x38,
+		0xbc, 0xb4, 0x7a, 0x49, 0xa9, 0x54, 0x37, 0x96, 0xd6, 0x56, 0x83, 0xcd, 0xee, 0x2b, 0x5b, 0x5c,
+		0xdb, 0x5c, 0x58, 0xae, 0xa9, 0xf8, 0xb0, 0x17, 0x3b, 0xb4, 0x11, 0x28, 0x7b, 0x71, 0x75, 0x63,
+		0x69, 0xa5, 0x26, 0xc5, 0x17, 0x2e, 0x0d, 0xdd, 0x14, 0x7c, 0x74, 0xdf, 0x01, 0xf7, 0x56, 0xac,
+		0xc0, 0xce, 0xe0, 0xff, 0x1e, 0x00, 0x0a, 0x92, 0xf1, 0x00, 0x01, 0xa4, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2326,6 +2533,54 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if !this.MaxSelfBondMultiplier.Equal(that1.MaxSelfBondMultiplier) {
+		return false
+	}
+	if !this.MaxValidatorPowerPercent.Equal(that1.MaxValidatorPowerPercent) {
+		return false
+	}
+	if this.EpochLength != that1.EpochLength {
+		return false
+	}
+	return true
+}
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -3219,6 +3474,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EpochLength != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.MaxValidatorPowerPercent.Size()
+		i -= size
+		if _, err := m.MaxValidatorPowerPercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MaxSelfBondMultiplier.Size()
+		i -= size
+		if _, err := m.MaxSelfBondMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -3262,6 +3562,107 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueuedMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3831,30 +4232,88 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
-	return n
-}
-
-func (m *DelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Delegation.Size()
+	l = m.GlobalLiquidStakingCap.Size()
 	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxSelfBondMultiplier.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MaxValidatorPowerPercent.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.EpochLength != 0 {
+		n += 1 + sovStaking(uint64(m.EpochLength))
+	}
 	return n
 }
 
-func (m *RedelegationEntryResponse) Size() (n int) {
+func (m *QueuedMessage) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.RedelegationEntry.Size()
-	n += 1 + l + sovStaking(uint64(l))
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *RedelegationEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RedelegationEntry.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	l = m.Balance.Size()
 	n += 1 + l + sovStaking(uint64(l))
 	return n
@@ -6565,6 +7024,482 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSelfBondMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSelfBondMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorPowerPercent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxValidatorPowerPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types1.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TokenizeShareRecordModuleAccountName returns the name of the module account
+// holding the delegation of the tokenize share record with the given id.
+func TokenizeShareRecordModuleAccountName(id uint64) string {
+	return fmt.Sprintf("tokenizeshare_%d", id)
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// tokenized delegation of the record.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return strings.ToLower(r.Validator) + "/" + strconv.FormatUint(r.Id, 10)
+}
+
+// Validate performs a stateless validation of the record.
+func (r TokenizeShareRecord) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("tokenize share record id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return fmt.Errorf("invalid tokenize share record owner: %w", err)
+	}
+	if r.ModuleAccount != TokenizeShareRecordModuleAccountName(r.Id) {
+		return fmt.Errorf("invalid tokenize share record module account: %s", r.ModuleAccount)
+	}
+	if _, err := sdk.ValAddressFromBech32(r.Validator); err != nil {
+		return fmt.Errorf("invalid tokenize share record validator: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MintTo mints the given amount of a token factory denom to the given address.
+func (k Keeper) MintTo(ctx context.Context, amount sdk.Coin, toAddr sdk.AccAddress) error {
+	if k.bankKeeper.BlockedAddr(toAddr) {
+		return types.ErrBlockedAddress.Wrapf("%s is not allowed to receive funds", toAddr)
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, toAddr, coins)
+}
+
+// BurnFrom burns the given amount of a token factory denom from the given
+// address.
+func (k Keeper) BurnFrom(ctx context.Context, amount sdk.Coin, fromAddr sdk.AccAddress) error {
+	if k.bankKeeper.BlockedAddr(fromAddr) {
+		return types.ErrBlockedAddress.Wrapf("cannot burn from %s", fromAddr)
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, fromAddr, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
+
+// ForceTransfer transfers the given amount of a token factory denom between
+// the given addresses.
+func (k Keeper) ForceTransfer(ctx context.Context, amount sdk.Coin, fromAddr, toAddr sdk.AccAddress) error {
+	if k.bankKeeper.BlockedAddr(fromAddr) {
+		return types.ErrBlockedAddress.Wrapf("cannot force transfer from %s", fromAddr)
+	}
+
+	if k.bankKeeper.BlockedAddr(toAddr) {
+		return types.ErrBlockedAddress.Wrapf("%s is not allowed to receive funds", toAddr)
+	}
+
+	return k.bankKeeper.SendCoins(ctx, fromAddr, toAddr, sdk.NewCoins(amount))
+}
+
+// SetAdmin sets the admin of the given denom. The denom has no admin anymore
+// when the admin is empty.
+func (k Keeper) SetAdmin(ctx context.Context, denom, admin string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	metadata.Admin = admin
+	return k.DenomAuthority.Set(ctx, denom, metadata)
+}
+
+// SetBeforeSendHook sets the send restriction registered under the given name
+// as the before send hook of the given denom. The hook is removed when the
+// name is empty.
+func (k Keeper) SetBeforeSendHook(ctx context.Context, denom, hook string) error {
+	if _, err := k.GetAuthorityMetadata(ctx, denom); err != nil {
+		return err
+	}
+
+	if hook == "" {
+		return k.BeforeSendHooks.Remove(ctx, denom)
+	}
+
+	if _, ok := k.sendRestrictions[hook]; !ok {
+		return types.ErrInvalidHook.Wrapf("send restriction %s is not registered", hook)
+	}
+
+	return k.BeforeSendHooks.Set(ctx, denom, hook)
+}
+
+// GetBeforeSendHook returns the name of the before send hook of the given
+// denom, empty if none.
+func (k Keeper) GetBeforeSendHook(ctx context.Context, denom string) (string, error) {
+	hook, err := k.BeforeSendHooks.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return "", nil
+	}
+
+	return hook, err
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// CreateDenom creates the factory/{creator}/{subdenom} denom administered by
+// the creator, who pays the denom creation fee to the community pool. The bank
+// metadata of the denom is set with the denom as base and display unit.
+func (k Keeper) CreateDenom(ctx context.Context, creator, subdenom string) (string, error) {
+	creatorAddr, err := k.accountKeeper.AddressCodec().StringToBytes(creator)
+	if err != nil {
+		return "", err
+	}
+
+	denom, err := types.GetTokenDenom(creator, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	has, err := k.DenomAuthority.Has(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+
+	if has || k.bankKeeper.HasDenomMetaData(ctx, denom) {
+		return "", types.ErrDenomExists.Wrap(denom)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if !params.DenomCreationFee.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, params.DenomCreationFee, creatorAddr); err != nil {
+			return "", err
+		}
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: "token factory denom created by " + creator,
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+		Name:        denom,
+		Symbol:      subdenom,
+	})
+
+	if err := k.createDenom(ctx, denom, creator, types.DenomAuthorityMetadata{Admin: creator}); err != nil {
+		return "", err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateDenom,
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+	)
+
+	return denom, nil
+}
+
+// createDenom stores the authority metadata of the given denom and indexes it
+// by creator.
+func (k Keeper) createDenom(ctx context.Context, denom, creator string, metadata types.DenomAuthorityMetadata) error {
+	if err := k.DenomAuthority.Set(ctx, denom, metadata); err != nil {
+		return err
+	}
+
+	return k.CreatorDenoms.Set(ctx, collections.Join(creator, denom))
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis new tokenfactory genesis
+func (keeper Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	if err := keeper.Params.Set(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	for _, denom := range data.FactoryDenoms {
+		creator, _, err := types.DeconstructDenom(denom.Denom)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := keeper.createDenom(ctx, denom.Denom, creator, denom.AuthorityMetadata); err != nil {
+			panic(err)
+		}
+
+		if err := keeper.SetBeforeSendHook(ctx, denom.Denom, denom.BeforeSendHook); err != nil {
+			panic(err)
+		}
+	}
+
+	keeper.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (keeper Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	params, err := keeper.Params.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	denoms := []types.GenesisDenom{}
+	err = keeper.DenomAuthority.Walk(ctx, nil, func(denom string, metadata types.DenomAuthorityMetadata) (bool, error) {
+		hook, err := keeper.GetBeforeSendHook(ctx, denom)
+		if err != nil {
+			return true, err
+		}
+
+		denoms = append(denoms, types.GenesisDenom{
+			Denom:             denom,
+			AuthorityMetadata: metadata,
+			BeforeSendHook:    hook,
+		})
+		return false, nil
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, denoms)
+}
@@ -0,0 +1,37 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/x/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestImportExportGenesis() {
+	s.Require().Equal(types.DefaultGenesisState(), s.tokenFactoryKeeper.ExportGenesis(s.ctx))
+
+	s.tokenFactoryKeeper.RegisterSendRestriction("deny_all", denyAll)
+
+	genesisState := types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+		{
+			Denom:             "factory/" + creator.String() + "/bitcoin",
+			AuthorityMetadata: types.DenomAuthorityMetadata{Admin: recipient.String()},
+			BeforeSendHook:    "deny_all",
+		},
+		{
+			Denom:             "factory/" + recipient.String() + "/ether",
+			AuthorityMetadata: types.DenomAuthorityMetadata{},
+		},
+	})
+
+	s.accountKeeper.EXPECT().GetModuleAccount(s.ctx, types.ModuleName)
+	s.tokenFactoryKeeper.InitGenesis(s.ctx, genesisState)
+
+	denoms, err := s.tokenFactoryKeeper.GetDenomsFromCreator(s.ctx, creator.String())
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"factory/" + creator.String() + "/bitcoin"}, denoms)
+
+	s.Require().ElementsMatch(genesisState.FactoryDenoms, s.tokenFactoryKeeper.ExportGenesis(s.ctx).FactoryDenoms)
+	s.Require().Equal(genesisState.Params, s.tokenFactoryKeeper.ExportGenesis(s.ctx).Params)
+
+	// the before send hooks must be registered
+	s.SetupTest()
+	s.Require().Panics(func() { s.tokenFactoryKeeper.InitGenesis(s.ctx, genesisState) })
+}
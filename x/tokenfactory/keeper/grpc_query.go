@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/tokenfactory/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k}
+}
+
+type queryServer struct {
+	k Keeper
+}
+
+// Params returns params of the tokenfactory module.
+func (q queryServer) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// DenomAuthorityMetadata returns the authority metadata of a denom.
+func (q queryServer) DenomAuthorityMetadata(ctx context.Context, req *types.QueryDenomAuthorityMetadataRequest) (*types.QueryDenomAuthorityMetadataResponse, error) {
+	metadata, err := q.k.GetAuthorityMetadata(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomAuthorityMetadataResponse{AuthorityMetadata: metadata}, nil
+}
+
+// DenomsFromCreator returns the denoms created by an account.
+func (q queryServer) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
+	denoms, err := q.k.GetDenomsFromCreator(ctx, req.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomsFromCreatorResponse{Denoms: denoms}, nil
+}
+
+// BeforeSendHook returns the before send hook of a denom.
+func (q queryServer) BeforeSendHook(ctx context.Context, req *types.QueryBeforeSendHookRequest) (*types.QueryBeforeSendHookResponse, error) {
+	if _, err := q.k.GetAuthorityMetadata(ctx, req.Denom); err != nil {
+		return nil, err
+	}
+
+	hook, err := q.k.GetBeforeSendHook(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBeforeSendHookResponse{Hook: hook}, nil
+}
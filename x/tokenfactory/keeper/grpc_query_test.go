@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/x/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestGRPCQueries() {
+	denom := s.createDenom("bitcoin")
+
+	params, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DefaultParams(), params.Params)
+
+	metadata, err := s.queryServer.DenomAuthorityMetadata(s.ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Equal(types.DenomAuthorityMetadata{Admin: creator.String()}, metadata.AuthorityMetadata)
+
+	_, err = s.queryServer.DenomAuthorityMetadata(s.ctx, &types.QueryDenomAuthorityMetadataRequest{Denom: "stake"})
+	s.Require().ErrorIs(err, types.ErrDenomNotFound)
+
+	denoms, err := s.queryServer.DenomsFromCreator(s.ctx, &types.QueryDenomsFromCreatorRequest{Creator: creator.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{denom}, denoms.Denoms)
+
+	denoms, err = s.queryServer.DenomsFromCreator(s.ctx, &types.QueryDenomsFromCreatorRequest{Creator: recipient.String()})
+	s.Require().NoError(err)
+	s.Require().Empty(denoms.Denoms)
+
+	hook, err := s.queryServer.BeforeSendHook(s.ctx, &types.QueryBeforeSendHookRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Empty(hook.Hook)
+
+	_, err = s.queryServer.BeforeSendHook(s.ctx, &types.QueryBeforeSendHookRequest{Denom: "stake"})
+	s.Require().ErrorIs(err, types.ErrDenomNotFound)
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ banktypes.BankHooks = Hooks{}
+
+// Hooks wrapper struct for the tokenfactory keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the bank hooks running the before send hooks of the token
+// factory denoms.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BeforeSend runs the before send hook of each token factory denom of the
+// given amount, aborting the transfer if one of them fails.
+func (h Hooks) BeforeSend(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	for _, coin := range amt {
+		if !types.IsFactoryDenom(coin.Denom) {
+			continue
+		}
+
+		hook, err := h.k.GetBeforeSendHook(ctx, coin.Denom)
+		if err != nil {
+			return err
+		}
+
+		if hook == "" {
+			continue
+		}
+
+		restriction, ok := h.k.sendRestrictions[hook]
+		if !ok {
+			return types.ErrInvalidHook.Wrapf("send restriction %s of %s is not registered", hook, coin.Denom)
+		}
+
+		if err := restriction(ctx, fromAddr, toAddr, coin); err != nil {
+			return types.ErrSendRestricted.Wrapf("%s: %s", coin.Denom, err)
+		}
+	}
+
+	return nil
+}
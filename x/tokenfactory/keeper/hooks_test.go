@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// denyAll is a send restriction rejecting all the transfers.
+func denyAll(_ context.Context, _, _ sdk.AccAddress, _ sdk.Coin) error {
+	return errors.New("transfers are disabled")
+}
+
+func (s *KeeperTestSuite) TestBeforeSend() {
+	denom := s.createDenom("bitcoin")
+	hooks := s.tokenFactoryKeeper.Hooks()
+	amount := sdk.NewCoins(sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin("stake", 100))
+
+	var received []sdk.Coin
+	s.tokenFactoryKeeper.RegisterSendRestriction("deny_all", denyAll)
+	s.tokenFactoryKeeper.RegisterSendRestriction("to_recipient", func(_ context.Context, _, toAddr sdk.AccAddress, amount sdk.Coin) error {
+		if !toAddr.Equals(recipient) {
+			return errors.New("only the recipient can receive the denom")
+		}
+
+		received = append(received, amount)
+		return nil
+	})
+
+	// the transfers of denoms without hook are not restricted
+	s.Require().NoError(hooks.BeforeSend(s.ctx, creator, recipient, amount))
+
+	s.Require().NoError(s.tokenFactoryKeeper.SetBeforeSendHook(s.ctx, denom, "to_recipient"))
+	s.Require().NoError(hooks.BeforeSend(s.ctx, creator, recipient, amount))
+	s.Require().Equal([]sdk.Coin{sdk.NewInt64Coin(denom, 100)}, received)
+
+	err := hooks.BeforeSend(s.ctx, recipient, creator, amount)
+	s.Require().ErrorIs(err, types.ErrSendRestricted)
+	s.Require().ErrorContains(err, "only the recipient can receive the denom")
+
+	s.Require().NoError(s.tokenFactoryKeeper.SetBeforeSendHook(s.ctx, denom, "deny_all"))
+	s.Require().ErrorIs(hooks.BeforeSend(s.ctx, creator, recipient, amount), types.ErrSendRestricted)
+
+	// other denoms are not restricted
+	s.Require().NoError(hooks.BeforeSend(s.ctx, creator, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the tokenfactory store
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeService  storetypes.KVStoreService
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	// sendRestrictions are the send restrictions registered by the application,
+	// by name. The map is shared by all the copies of the keeper.
+	sendRestrictions map[string]types.SendRestrictionFn
+
+	Schema          collections.Schema
+	Params          collections.Item[types.Params]
+	DenomAuthority  collections.Map[string, types.DenomAuthorityMetadata]
+	CreatorDenoms   collections.KeySet[collections.Pair[string, string]]
+	BeforeSendHooks collections.Map[string, string]
+}
+
+// NewKeeper creates a new tokenfactory Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	dk types.DistributionKeeper,
+	authority string,
+) Keeper {
+	// ensure tokenfactory module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the x/%s module account has not been set", types.ModuleName))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:              cdc,
+		storeService:     storeService,
+		accountKeeper:    ak,
+		bankKeeper:       bk,
+		distrKeeper:      dk,
+		authority:        authority,
+		sendRestrictions: make(map[string]types.SendRestrictionFn),
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		DenomAuthority:   collections.NewMap(sb, types.DenomAuthorityKey, "denom_authority", collections.StringKey, codec.CollValue[types.DenomAuthorityMetadata](cdc)),
+		CreatorDenoms:    collections.NewKeySet(sb, types.CreatorDenomsKey, "creator_denoms", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		BeforeSendHooks:  collections.NewMap(sb, types.BeforeSendHookKey, "before_send_hooks", collections.StringKey, collections.StringValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/tokenfactory module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// RegisterSendRestriction registers a send restriction under the given name,
+// allowing the denom admins to set it as the before send hook of their denoms.
+// It panics if a send restriction is already registered under the name.
+func (k Keeper) RegisterSendRestriction(name string, fn types.SendRestrictionFn) {
+	if name == "" {
+		panic("send restriction name cannot be empty")
+	}
+
+	if _, ok := k.sendRestrictions[name]; ok {
+		panic(fmt.Sprintf("send restriction %s is already registered", name))
+	}
+
+	k.sendRestrictions[name] = fn
+}
+
+// GetAuthorityMetadata returns the authority metadata of the given denom.
+func (k Keeper) GetAuthorityMetadata(ctx context.Context, denom string) (types.DenomAuthorityMetadata, error) {
+	metadata, err := k.DenomAuthority.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return metadata, types.ErrDenomNotFound.Wrap(denom)
+	}
+
+	return metadata, err
+}
+
+// GetDenomsFromCreator returns the denoms created by the given account.
+func (k Keeper) GetDenomsFromCreator(ctx context.Context, creator string) ([]string, error) {
+	denoms := []string{}
+	err := k.CreatorDenoms.Walk(ctx, collections.NewPrefixedPairRange[string, string](creator), func(key collections.Pair[string, string]) (bool, error) {
+		denoms = append(denoms, key.K2())
+		return false, nil
+	})
+	if err != nil && !errors.Is(err, collections.ErrInvalidIterator) {
+		return nil, err
+	}
+
+	return denoms, nil
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/tokenfactory"
+	"cosmossdk.io/x/tokenfactory/keeper"
+	tokenfactorytestutil "cosmossdk.io/x/tokenfactory/testutil"
+	"cosmossdk.io/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	creator   = sdk.AccAddress([]byte("creator_____________"))
+	recipient = sdk.AccAddress([]byte("recipient___________"))
+	blocked   = sdk.AccAddress([]byte("blocked_____________"))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	tokenFactoryKeeper keeper.Keeper
+	ctx                sdk.Context
+	msgServer          types.MsgServer
+	queryServer        types.QueryServer
+	accountKeeper      *tokenfactorytestutil.MockAccountKeeper
+	bankKeeper         *tokenfactorytestutil.MockBankKeeper
+	distrKeeper        *tokenfactorytestutil.MockDistributionKeeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(tokenfactory.AppModuleBasic{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx
+
+	// gomock initializations
+	ctrl := gomock.NewController(s.T())
+	accountKeeper := tokenfactorytestutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := tokenfactorytestutil.NewMockBankKeeper(ctrl)
+	distrKeeper := tokenfactorytestutil.NewMockDistributionKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(authtypes.NewModuleAddress(types.ModuleName))
+	accountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	bankKeeper.EXPECT().BlockedAddr(gomock.Any()).DoAndReturn(func(addr sdk.AccAddress) bool {
+		return addr.Equals(blocked)
+	}).AnyTimes()
+
+	s.tokenFactoryKeeper = keeper.NewKeeper(
+		encCfg.Codec,
+		storeService,
+		accountKeeper,
+		bankKeeper,
+		distrKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	s.accountKeeper = accountKeeper
+	s.bankKeeper = bankKeeper
+	s.distrKeeper = distrKeeper
+
+	s.Require().Equal(testCtx.Ctx.Logger().With("module", "x/"+types.ModuleName),
+		s.tokenFactoryKeeper.Logger(testCtx.Ctx))
+
+	s.Require().NoError(s.tokenFactoryKeeper.Params.Set(s.ctx, types.DefaultParams()))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.tokenFactoryKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.tokenFactoryKeeper)
+}
+
+// createDenom creates the factory/{creator}/{subdenom} denom.
+func (s *KeeperTestSuite) createDenom(subdenom string) string {
+	s.distrKeeper.EXPECT().FundCommunityPool(s.ctx, types.DefaultParams().DenomCreationFee, creator).Return(nil)
+	s.bankKeeper.EXPECT().HasDenomMetaData(s.ctx, gomock.Any()).Return(false)
+	s.bankKeeper.EXPECT().SetDenomMetaData(s.ctx, gomock.Any())
+
+	res, err := s.msgServer.CreateDenom(s.ctx, types.NewMsgCreateDenom(creator.String(), subdenom))
+	s.Require().NoError(err)
+
+	return res.NewTokenDenom
+}
+
+func (s *KeeperTestSuite) TestRegisterSendRestriction() {
+	restriction := func(_ context.Context, _, _ sdk.AccAddress, _ sdk.Coin) error { return nil }
+
+	s.tokenFactoryKeeper.RegisterSendRestriction("allow_all", restriction)
+	s.Require().Panics(func() { s.tokenFactoryKeeper.RegisterSendRestriction("allow_all", restriction) })
+	s.Require().Panics(func() { s.tokenFactoryKeeper.RegisterSendRestriction("", restriction) })
+}
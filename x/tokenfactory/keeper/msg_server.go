@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/tokenfactory MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// CreateDenom creates a new denom administered by the sender.
+func (ms msgServer) CreateDenom(ctx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	denom, err := ms.Keeper.CreateDenom(ctx, msg.Sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+// Mint mints an amount of a denom administered by the sender.
+func (ms msgServer) Mint(ctx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	if err := validateAmount(msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := ms.validateAdmin(ctx, msg.Sender, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	recipient := msg.MintToAddress
+	if recipient == "" {
+		recipient = msg.Sender
+	}
+
+	toAddr, err := ms.accountKeeper.AddressCodec().StringToBytes(recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.MintTo(ctx, msg.Amount, toAddr); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMint,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgMintResponse{}, nil
+}
+
+// Burn burns an amount of a denom administered by the sender.
+func (ms msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	if err := validateAmount(msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := ms.validateAdmin(ctx, msg.Sender, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	burnFrom := msg.BurnFromAddress
+	if burnFrom == "" {
+		burnFrom = msg.Sender
+	}
+
+	fromAddr, err := ms.accountKeeper.AddressCodec().StringToBytes(burnFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.BurnFrom(ctx, msg.Amount, fromAddr); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurn,
+			sdk.NewAttribute(types.AttributeKeySender, burnFrom),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+// ForceTransfer transfers an amount of a denom administered by the sender
+// between two accounts.
+func (ms msgServer) ForceTransfer(ctx context.Context, msg *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
+	if err := validateAmount(msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := ms.validateAdmin(ctx, msg.Sender, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	fromAddr, err := ms.accountKeeper.AddressCodec().StringToBytes(msg.TransferFromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	toAddr, err := ms.accountKeeper.AddressCodec().StringToBytes(msg.TransferToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.ForceTransfer(ctx, msg.Amount, fromAddr, toAddr); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForceTransfer,
+			sdk.NewAttribute(types.AttributeKeySender, msg.TransferFromAddress),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.TransferToAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgForceTransferResponse{}, nil
+}
+
+// ChangeAdmin changes the admin of a denom administered by the sender.
+func (ms msgServer) ChangeAdmin(ctx context.Context, msg *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
+	if err := ms.validateAdmin(ctx, msg.Sender, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	if msg.NewAdmin != "" {
+		if _, err := ms.accountKeeper.AddressCodec().StringToBytes(msg.NewAdmin); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ms.SetAdmin(ctx, msg.Denom, msg.NewAdmin); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangeAdmin,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyAdmin, msg.NewAdmin),
+		),
+	)
+
+	return &types.MsgChangeAdminResponse{}, nil
+}
+
+// SetBeforeSendHook sets the before send hook of a denom administered by the
+// sender.
+func (ms msgServer) SetBeforeSendHook(ctx context.Context, msg *types.MsgSetBeforeSendHook) (*types.MsgSetBeforeSendHookResponse, error) {
+	if err := ms.validateAdmin(ctx, msg.Sender, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.SetBeforeSendHook(ctx, msg.Denom, msg.Hook); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetBeforeSendHook,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyHook, msg.Hook),
+		),
+	)
+
+	return &types.MsgSetBeforeSendHookResponse{}, nil
+}
+
+// UpdateParams updates the params.
+func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := ms.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// validateAdmin returns an error if the sender is not the admin of the given
+// denom.
+func (ms msgServer) validateAdmin(ctx context.Context, sender, denom string) error {
+	metadata, err := ms.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if metadata.Admin == "" || metadata.Admin != sender {
+		return types.ErrUnauthorized.Wrapf("%s is not the admin of %s", sender, denom)
+	}
+
+	return nil
+}
+
+// validateAmount returns an error if the given amount is not positive.
+func validateAmount(amount sdk.Coin) error {
+	if amount.Amount.IsNil() || !amount.IsValid() || amount.IsZero() {
+		return types.ErrInvalidAmount.Wrapf("amount must be positive, got %s", amount)
+	}
+
+	return nil
+}
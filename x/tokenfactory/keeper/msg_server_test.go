@@ -0,0 +1,298 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *KeeperTestSuite) TestCreateDenom() {
+	fee := types.DefaultParams().DenomCreationFee
+	denom := "factory/" + creator.String() + "/bitcoin"
+
+	s.distrKeeper.EXPECT().FundCommunityPool(s.ctx, fee, creator).Return(nil)
+	s.bankKeeper.EXPECT().HasDenomMetaData(s.ctx, denom).Return(false)
+	s.bankKeeper.EXPECT().SetDenomMetaData(s.ctx, banktypes.Metadata{
+		Description: "token factory denom created by " + creator.String(),
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+		Name:        denom,
+		Symbol:      "bitcoin",
+	})
+
+	res, err := s.msgServer.CreateDenom(s.ctx, types.NewMsgCreateDenom(creator.String(), "bitcoin"))
+	s.Require().NoError(err)
+	s.Require().Equal(denom, res.NewTokenDenom)
+
+	metadata, err := s.tokenFactoryKeeper.GetAuthorityMetadata(s.ctx, denom)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DenomAuthorityMetadata{Admin: creator.String()}, metadata)
+
+	denoms, err := s.tokenFactoryKeeper.GetDenomsFromCreator(s.ctx, creator.String())
+	s.Require().NoError(err)
+	s.Require().Equal([]string{denom}, denoms)
+
+	// a denom cannot be created twice
+	_, err = s.msgServer.CreateDenom(s.ctx, types.NewMsgCreateDenom(creator.String(), "bitcoin"))
+	s.Require().ErrorIs(err, types.ErrDenomExists)
+
+	// a denom whose bank metadata exists cannot be created
+	s.bankKeeper.EXPECT().HasDenomMetaData(s.ctx, gomock.Any()).Return(true)
+	_, err = s.msgServer.CreateDenom(s.ctx, types.NewMsgCreateDenom(creator.String(), "ether"))
+	s.Require().ErrorIs(err, types.ErrDenomExists)
+
+	// the subdenom length is limited
+	_, err = s.msgServer.CreateDenom(s.ctx, types.NewMsgCreateDenom(creator.String(), "a123456789b123456789c123456789d123456789e12345"))
+	s.Require().ErrorIs(err, types.ErrInvalidDenom)
+
+	// the sender must be a valid address
+	_, err = s.msgServer.CreateDenom(s.ctx, types.NewMsgCreateDenom("invalid", "bitcoin"))
+	s.Require().Error(err)
+
+	// no fee is paid when the denom creation fee is empty
+	s.Require().NoError(s.tokenFactoryKeeper.Params.Set(s.ctx, types.NewParams(nil)))
+	s.bankKeeper.EXPECT().HasDenomMetaData(s.ctx, gomock.Any()).Return(false)
+	s.bankKeeper.EXPECT().SetDenomMetaData(s.ctx, gomock.Any())
+	_, err = s.msgServer.CreateDenom(s.ctx, types.NewMsgCreateDenom(creator.String(), "ether"))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestMint() {
+	denom := s.createDenom("bitcoin")
+	amount := sdk.NewInt64Coin(denom, 100)
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgMint
+		malleate  func()
+		expectErr error
+	}{
+		{
+			name:      "sender is not the admin",
+			request:   types.NewMsgMint(recipient.String(), amount, ""),
+			expectErr: types.ErrUnauthorized,
+		},
+		{
+			name:      "denom not found",
+			request:   types.NewMsgMint(creator.String(), sdk.NewInt64Coin("factory/"+creator.String()+"/ether", 100), ""),
+			expectErr: types.ErrDenomNotFound,
+		},
+		{
+			name:      "zero amount",
+			request:   types.NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 0), ""),
+			expectErr: types.ErrInvalidAmount,
+		},
+		{
+			name:      "blocked recipient",
+			request:   types.NewMsgMint(creator.String(), amount, blocked.String()),
+			expectErr: types.ErrBlockedAddress,
+		},
+		{
+			name:    "mint to the sender",
+			request: types.NewMsgMint(creator.String(), amount, ""),
+			malleate: func() {
+				s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, sdk.NewCoins(amount)).Return(nil)
+				s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, types.ModuleName, creator, sdk.NewCoins(amount)).Return(nil)
+			},
+		},
+		{
+			name:    "mint to another account",
+			request: types.NewMsgMint(creator.String(), amount, recipient.String()),
+			malleate: func() {
+				s.bankKeeper.EXPECT().MintCoins(s.ctx, types.ModuleName, sdk.NewCoins(amount)).Return(nil)
+				s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(s.ctx, types.ModuleName, recipient, sdk.NewCoins(amount)).Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			_, err := s.msgServer.Mint(s.ctx, tc.request)
+			if tc.expectErr != nil {
+				s.Require().ErrorIs(err, tc.expectErr)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestBurn() {
+	denom := s.createDenom("bitcoin")
+	amount := sdk.NewInt64Coin(denom, 100)
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgBurn
+		malleate  func()
+		expectErr error
+	}{
+		{
+			name:      "sender is not the admin",
+			request:   types.NewMsgBurn(recipient.String(), amount, ""),
+			expectErr: types.ErrUnauthorized,
+		},
+		{
+			name:      "blocked account",
+			request:   types.NewMsgBurn(creator.String(), amount, blocked.String()),
+			expectErr: types.ErrBlockedAddress,
+		},
+		{
+			name:    "burn from the sender",
+			request: types.NewMsgBurn(creator.String(), amount, ""),
+			malleate: func() {
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, creator, types.ModuleName, sdk.NewCoins(amount)).Return(nil)
+				s.bankKeeper.EXPECT().BurnCoins(s.ctx, types.ModuleName, sdk.NewCoins(amount)).Return(nil)
+			},
+		},
+		{
+			name:    "burn from another account",
+			request: types.NewMsgBurn(creator.String(), amount, recipient.String()),
+			malleate: func() {
+				s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(s.ctx, recipient, types.ModuleName, sdk.NewCoins(amount)).Return(nil)
+				s.bankKeeper.EXPECT().BurnCoins(s.ctx, types.ModuleName, sdk.NewCoins(amount)).Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			if tc.malleate != nil {
+				tc.malleate()
+			}
+
+			_, err := s.msgServer.Burn(s.ctx, tc.request)
+			if tc.expectErr != nil {
+				s.Require().ErrorIs(err, tc.expectErr)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestForceTransfer() {
+	denom := s.createDenom("bitcoin")
+	amount := sdk.NewInt64Coin(denom, 100)
+
+	_, err := s.msgServer.ForceTransfer(s.ctx, types.NewMsgForceTransfer(recipient.String(), amount, creator.String(), recipient.String()))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.ForceTransfer(s.ctx, types.NewMsgForceTransfer(creator.String(), amount, blocked.String(), recipient.String()))
+	s.Require().ErrorIs(err, types.ErrBlockedAddress)
+
+	_, err = s.msgServer.ForceTransfer(s.ctx, types.NewMsgForceTransfer(creator.String(), amount, recipient.String(), blocked.String()))
+	s.Require().ErrorIs(err, types.ErrBlockedAddress)
+
+	s.bankKeeper.EXPECT().SendCoins(s.ctx, recipient, creator, sdk.NewCoins(amount)).Return(nil)
+	_, err = s.msgServer.ForceTransfer(s.ctx, types.NewMsgForceTransfer(creator.String(), amount, recipient.String(), creator.String()))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestChangeAdmin() {
+	denom := s.createDenom("bitcoin")
+
+	_, err := s.msgServer.ChangeAdmin(s.ctx, types.NewMsgChangeAdmin(recipient.String(), denom, recipient.String()))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.ChangeAdmin(s.ctx, types.NewMsgChangeAdmin(creator.String(), denom, "invalid"))
+	s.Require().Error(err)
+
+	_, err = s.msgServer.ChangeAdmin(s.ctx, types.NewMsgChangeAdmin(creator.String(), denom, recipient.String()))
+	s.Require().NoError(err)
+
+	metadata, err := s.tokenFactoryKeeper.GetAuthorityMetadata(s.ctx, denom)
+	s.Require().NoError(err)
+	s.Require().Equal(recipient.String(), metadata.Admin)
+
+	// the previous admin cannot mint anymore
+	_, err = s.msgServer.Mint(s.ctx, types.NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 100), ""))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the admin can be removed
+	_, err = s.msgServer.ChangeAdmin(s.ctx, types.NewMsgChangeAdmin(recipient.String(), denom, ""))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.ChangeAdmin(s.ctx, types.NewMsgChangeAdmin(recipient.String(), denom, recipient.String()))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (s *KeeperTestSuite) TestSetBeforeSendHook() {
+	denom := s.createDenom("bitcoin")
+	s.tokenFactoryKeeper.RegisterSendRestriction("deny_all", denyAll)
+
+	_, err := s.msgServer.SetBeforeSendHook(s.ctx, types.NewMsgSetBeforeSendHook(recipient.String(), denom, "deny_all"))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.SetBeforeSendHook(s.ctx, types.NewMsgSetBeforeSendHook(creator.String(), denom, "unknown"))
+	s.Require().ErrorIs(err, types.ErrInvalidHook)
+
+	_, err = s.msgServer.SetBeforeSendHook(s.ctx, types.NewMsgSetBeforeSendHook(creator.String(), denom, "deny_all"))
+	s.Require().NoError(err)
+
+	res, err := s.queryServer.BeforeSendHook(s.ctx, &types.QueryBeforeSendHookRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Equal("deny_all", res.Hook)
+
+	// the hook is removed when empty
+	_, err = s.msgServer.SetBeforeSendHook(s.ctx, types.NewMsgSetBeforeSendHook(creator.String(), denom, ""))
+	s.Require().NoError(err)
+
+	res, err = s.queryServer.BeforeSendHook(s.ctx, &types.QueryBeforeSendHookRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Hook)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name: "set invalid authority",
+			request: &types.MsgUpdateParams{
+				Authority: "foo",
+			},
+			expectErr: true,
+		},
+		{
+			name: "set invalid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.tokenFactoryKeeper.GetAuthority(),
+				Params:    types.Params{DenomCreationFee: sdk.Coins{{Denom: "stake", Amount: sdk.ZeroInt().SubRaw(1)}}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "set full valid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.tokenFactoryKeeper.GetAuthority(),
+				Params:    types.NewParams(sdk.NewCoins(sdk.NewInt64Coin("stake", 1))),
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(s.ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
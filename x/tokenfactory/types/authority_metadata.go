@@ -0,0 +1,20 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate returns an error if the admin is neither empty nor a valid address.
+func (m DenomAuthorityMetadata) Validate() error {
+	if m.Admin == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return fmt.Errorf("invalid admin %s: %w", m.Admin, err)
+	}
+
+	return nil
+}
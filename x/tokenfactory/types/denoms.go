@@ -0,0 +1,63 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleDenomPrefix is the first part of the token factory denoms.
+	ModuleDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the maximum length of a subdenom.
+	MaxSubdenomLength = 44
+)
+
+// GetTokenDenom returns the factory/{creator}/{subdenom} denom and an error
+// if it is invalid.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", ErrInvalidDenom.Wrapf("subdenom is too long, max length is %d", MaxSubdenomLength)
+	}
+
+	if strings.Contains(creator, "/") {
+		return "", ErrInvalidDenom.Wrapf("invalid creator %s", creator)
+	}
+
+	denom := strings.Join([]string{ModuleDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", ErrInvalidDenom.Wrap(err.Error())
+	}
+
+	return denom, nil
+}
+
+// DeconstructDenom returns the creator and the subdenom of the given token
+// factory denom.
+func DeconstructDenom(denom string) (creator, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", ErrInvalidDenom.Wrap(err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) < 3 || parts[0] != ModuleDenomPrefix {
+		return "", "", ErrInvalidDenom.Wrapf("%s is not a token factory denom, expected %s/{creator}/{subdenom}", denom, ModuleDenomPrefix)
+	}
+
+	creator, subdenom = parts[1], parts[2]
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return "", "", ErrInvalidDenom.Wrapf("invalid creator %s: %s", creator, err)
+	}
+
+	if len(subdenom) > MaxSubdenomLength {
+		return "", "", ErrInvalidDenom.Wrapf("subdenom is too long, max length is %d", MaxSubdenomLength)
+	}
+
+	return creator, subdenom, nil
+}
+
+// IsFactoryDenom returns true if the given denom has the token factory prefix.
+func IsFactoryDenom(denom string) bool {
+	return strings.HasPrefix(denom, ModuleDenomPrefix+"/")
+}
@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGetTokenDenom(t *testing.T) {
+	creator := sdk.AccAddress([]byte("creator_____________")).String()
+
+	testCases := []struct {
+		name     string
+		creator  string
+		subdenom string
+		expDenom string
+		expErr   bool
+	}{
+		{"valid", creator, "bitcoin", "factory/" + creator + "/bitcoin", false},
+		{"subdenom with slashes", creator, "bit/coin", "factory/" + creator + "/bit/coin", false},
+		{"empty subdenom", creator, "", "factory/" + creator + "/", false},
+		{"subdenom too long", creator, "a123456789b123456789c123456789d123456789e12345", "", true},
+		{"creator with slash", "creator/1", "bitcoin", "", true},
+		{"invalid characters", creator, "bit@coin", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			denom, err := types.GetTokenDenom(tc.creator, tc.subdenom)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidDenom)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expDenom, denom)
+		})
+	}
+}
+
+func TestDeconstructDenom(t *testing.T) {
+	creator := sdk.AccAddress([]byte("creator_____________")).String()
+
+	testCases := []struct {
+		name        string
+		denom       string
+		expCreator  string
+		expSubdenom string
+		expErr      bool
+	}{
+		{"valid", "factory/" + creator + "/bitcoin", creator, "bitcoin", false},
+		{"subdenom with slashes", "factory/" + creator + "/bit/coin", creator, "bit/coin", false},
+		{"not a factory denom", "stake", "", "", true},
+		{"wrong prefix", "ibc/" + creator + "/bitcoin", "", "", true},
+		{"invalid creator", "factory/creator/bitcoin", "", "", true},
+		{"missing subdenom", "factory/" + creator, "", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			creator, subdenom, err := types.DeconstructDenom(tc.denom)
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidDenom)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expCreator, creator)
+			require.Equal(t, tc.expSubdenom, subdenom)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/tokenfactory module sentinel errors
+var (
+	ErrInvalidDenom   = errors.Register(ModuleName, 2, "invalid denom")
+	ErrDenomExists    = errors.Register(ModuleName, 3, "denom already exists")
+	ErrDenomNotFound  = errors.Register(ModuleName, 4, "denom not found")
+	ErrUnauthorized   = errors.Register(ModuleName, 5, "unauthorized account")
+	ErrInvalidHook    = errors.Register(ModuleName, 6, "invalid before send hook")
+	ErrSendRestricted = errors.Register(ModuleName, 7, "send restricted")
+	ErrBlockedAddress = errors.Register(ModuleName, 8, "blocked address")
+	ErrInvalidAmount  = errors.Register(ModuleName, 9, "invalid amount")
+)
@@ -0,0 +1,18 @@
+package types
+
+// tokenfactory module event types
+const (
+	EventTypeCreateDenom       = "create_denom"
+	EventTypeMint              = "tf_mint"
+	EventTypeBurn              = "tf_burn"
+	EventTypeForceTransfer     = "force_transfer"
+	EventTypeChangeAdmin       = "change_admin"
+	EventTypeSetBeforeSendHook = "set_before_send_hook"
+
+	AttributeKeyCreator   = "creator"
+	AttributeKeyDenom     = "denom"
+	AttributeKeyAdmin     = "admin"
+	AttributeKeyHook      = "hook"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeySender    = "sender"
+)
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	creator := sdk.AccAddress([]byte("creator_____________")).String()
+	denom := "factory/" + creator + "/bitcoin"
+
+	testCases := []struct {
+		name   string
+		state  types.GenesisState
+		expErr bool
+	}{
+		{"default", *types.DefaultGenesisState(), false},
+		{
+			"valid denoms",
+			*types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+				{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: creator}, BeforeSendHook: "deny_all"},
+				{Denom: denom + "2"},
+			}),
+			false,
+		},
+		{
+			"invalid params",
+			*types.NewGenesisState(types.NewParams(sdk.Coins{{Denom: "stake", Amount: sdk.ZeroInt().SubRaw(1)}}), nil),
+			true,
+		},
+		{
+			"duplicate denoms",
+			*types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{{Denom: denom}, {Denom: denom}}),
+			true,
+		},
+		{
+			"invalid denom",
+			*types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{{Denom: "stake"}}),
+			true,
+		},
+		{
+			"invalid admin",
+			*types.NewGenesisState(types.DefaultParams(), []types.GenesisDenom{
+				{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: "invalid"}},
+			}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateGenesis(tc.state)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package types
+
+import "cosmossdk.io/collections"
+
+var (
+	ParamsKey = collections.NewPrefix(0)
+	// DenomAuthorityKey is the prefix of the authority metadata of the denoms.
+	DenomAuthorityKey = collections.NewPrefix(1)
+	// CreatorDenomsKey is the prefix of the denoms indexed by creator.
+	CreatorDenomsKey = collections.NewPrefix(2)
+	// BeforeSendHookKey is the prefix of the before send hooks of the denoms.
+	BeforeSendHookKey = collections.NewPrefix(3)
+)
+
+const (
+	// module name
+	ModuleName = "tokenfactory"
+
+	// StoreKey is the default store key for tokenfactory
+	StoreKey = ModuleName
+)
@@ -0,0 +1,111 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateDenom{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgForceTransfer{}
+	_ sdk.Msg = &MsgChangeAdmin{}
+	_ sdk.Msg = &MsgSetBeforeSendHook{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgCreateDenom creates a new MsgCreateDenom instance.
+func NewMsgCreateDenom(sender, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{
+		Sender:   sender,
+		Subdenom: subdenom,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgCreateDenom message.
+func (m MsgCreateDenom) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgMint creates a new MsgMint instance.
+func NewMsgMint(sender string, amount sdk.Coin, mintToAddress string) *MsgMint {
+	return &MsgMint{
+		Sender:        sender,
+		Amount:        amount,
+		MintToAddress: mintToAddress,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgMint message.
+func (m MsgMint) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgBurn creates a new MsgBurn instance.
+func NewMsgBurn(sender string, amount sdk.Coin, burnFromAddress string) *MsgBurn {
+	return &MsgBurn{
+		Sender:          sender,
+		Amount:          amount,
+		BurnFromAddress: burnFromAddress,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgBurn message.
+func (m MsgBurn) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgForceTransfer creates a new MsgForceTransfer instance.
+func NewMsgForceTransfer(sender string, amount sdk.Coin, fromAddress, toAddress string) *MsgForceTransfer {
+	return &MsgForceTransfer{
+		Sender:              sender,
+		Amount:              amount,
+		TransferFromAddress: fromAddress,
+		TransferToAddress:   toAddress,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgForceTransfer message.
+func (m MsgForceTransfer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgChangeAdmin creates a new MsgChangeAdmin instance.
+func NewMsgChangeAdmin(sender, denom, newAdmin string) *MsgChangeAdmin {
+	return &MsgChangeAdmin{
+		Sender:   sender,
+		Denom:    denom,
+		NewAdmin: newAdmin,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgChangeAdmin message.
+func (m MsgChangeAdmin) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetBeforeSendHook creates a new MsgSetBeforeSendHook instance.
+func NewMsgSetBeforeSendHook(sender, denom, hook string) *MsgSetBeforeSendHook {
+	return &MsgSetBeforeSendHook{
+		Sender: sender,
+		Denom:  denom,
+		Hook:   hook,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgSetBeforeSendHook message.
+func (m MsgSetBeforeSendHook) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendRestrictionFn defines a restriction on the transfers of a token factory
+// denom. It is registered by the application under a name and run before an
+// amount of a denom whose before send hook is set to this name is sent. The
+// transfer is aborted if it returns an error.
+type SendRestrictionFn func(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amount sdk.Coin) error
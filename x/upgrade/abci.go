@@ -18,6 +18,9 @@ import (
 // If the current height is in the provided set of heights to skip, it will skip and clear the upgrade plan.
 // If it is ready, it will execute it if the handler is installed, and panic/abort otherwise.
 // If the plan is not ready, it will ensure the handler is not registered too early (and abort otherwise).
+// A plan with a signal threshold is ready once its height is reached and enough voting power signalled it.
+// When the binary is pre-downloaded, it will abort past the height of a plan which is not ready
+// if the binary is not verified.
 //
 // The purpose is to ensure the binary is switched EXACTLY at the desired block, and to allow
 // a migration to be executed if needed upon this switch (migration defined in the new binary)
@@ -33,6 +36,16 @@ func BeginBlocker(ctx context.Context, k *keeper.Keeper) error {
 	}
 	found := err == nil
 
+	// A plan with a signal threshold is only ready once enough voting power
+	// signalled its readiness for it, even if its height is reached.
+	ready := false
+	if found {
+		ready, err = k.ShouldExecute(ctx, plan)
+		if err != nil {
+			return err
+		}
+	}
+
 	if !k.DowngradeVerified() {
 		k.SetDowngradeVerified(true)
 		// This check will make sure that we are using a valid binary.
@@ -40,7 +53,7 @@ func BeginBlocker(ctx context.Context, k *keeper.Keeper) error {
 		// 1. If there is no scheduled upgrade.
 		// 2. If the plan is not ready.
 		// 3. If the plan is ready and skip upgrade height is set for current height.
-		if !found || !plan.ShouldExecute(blockHeight) || (plan.ShouldExecute(blockHeight) && k.IsSkipHeight(blockHeight)) {
+		if !found || !ready || (ready && k.IsSkipHeight(blockHeight)) {
 			lastAppliedPlan, _, err := k.GetLastCompletedUpgrade(ctx)
 			if err != nil {
 				return err
@@ -66,7 +79,7 @@ func BeginBlocker(ctx context.Context, k *keeper.Keeper) error {
 	logger := k.Logger(ctx)
 
 	// To make sure clear upgrade is executed at the same block
-	if plan.ShouldExecute(blockHeight) {
+	if ready {
 		// If skip upgrade has been set for current height, we clear the upgrade plan
 		if k.IsSkipHeight(blockHeight) {
 			skipUpgradeMsg := fmt.Sprintf("UPGRADE \"%s\" SKIPPED at %d: %s", plan.Name, plan.Height, plan.Info)
@@ -86,6 +99,9 @@ func BeginBlocker(ctx context.Context, k *keeper.Keeper) error {
 			}
 
 			upgradeMsg := BuildUpgradeNeededMsg(plan)
+			if k.BinaryPreDownloadEnabled() {
+				upgradeMsg = fmt.Sprintf("%s: %s", upgradeMsg, buildBinaryStatusMsg(k, plan))
+			}
 			logger.Error(upgradeMsg)
 
 			// Returning an error will end up in a panic
@@ -108,6 +124,28 @@ func BeginBlocker(ctx context.Context, k *keeper.Keeper) error {
 		return fmt.Errorf(downgradeMsg)
 	}
 
+	// Download the binary of the upgrade ahead of time if configured to do so
+	k.PreDownloadBinary(ctx, plan)
+
+	if plan.ShouldExecute(blockHeight) {
+		// Refuse to process, and so to sign, the blocks past the upgrade height
+		// without a verified binary, which is needed as soon as the signal
+		// threshold is reached. A pending download is waited for, up to the
+		// binary wait timeout, so that a restarted node downloads the binary
+		// again before going on.
+		if k.BinaryPreDownloadEnabled() && !k.IsSkipHeight(plan.Height) {
+			if status, _, _ := k.WaitBinary(ctx, plan); status != types.BinaryStatusVerified {
+				binaryMsg := fmt.Sprintf("UPGRADE \"%s\" PAST HEIGHT %d: %s", plan.Name, plan.Height, buildBinaryStatusMsg(k, plan))
+				logger.Error(binaryMsg)
+
+				// Returning an error will end up in a panic
+				return errors.New(binaryMsg)
+			}
+		}
+
+		logger.Info(fmt.Sprintf("upgrade \"%s\" waiting for the signal threshold %s to be reached", plan.Name, plan.SignalThreshold))
+	}
+
 	return nil
 }
 
@@ -115,3 +153,17 @@ func BeginBlocker(ctx context.Context, k *keeper.Keeper) error {
 func BuildUpgradeNeededMsg(plan types.Plan) string {
 	return fmt.Sprintf("UPGRADE \"%s\" NEEDED at %s: %s", plan.Name, plan.DueAt(), plan.Info)
 }
+
+// buildBinaryStatusMsg prints the status of the pre-downloaded binary of the
+// given plan.
+func buildBinaryStatusMsg(k *keeper.Keeper, plan types.Plan) string {
+	status, path, err := k.GetBinaryStatus(plan)
+	switch {
+	case status == types.BinaryStatusVerified:
+		return fmt.Sprintf("verified upgrade binary at %s", path)
+	case err != nil:
+		return fmt.Sprintf("NO VERIFIED UPGRADE BINARY (%s): %s", status, err)
+	default:
+		return fmt.Sprintf("NO VERIFIED UPGRADE BINARY (%s)", status)
+	}
+}
@@ -2,14 +2,21 @@ package upgrade_test
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -25,6 +32,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"cosmossdk.io/x/upgrade"
 	"cosmossdk.io/x/upgrade/keeper"
@@ -541,3 +549,247 @@ func TestDowngradeVerification(t *testing.T) {
 		}
 	}
 }
+
+func TestPreDownloadBinary(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	s.keeper.SetBinaryPreDownload(5, "simd")
+
+	binary := []byte("#!/bin/sh\necho upgraded\n")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(binary)
+	}))
+	defer server.Close()
+
+	checksum := sha256.Sum256(binary)
+	makeInfo := func(checksum []byte) string {
+		return fmt.Sprintf(`{"binaries":{"any":"%s/simd?checksum=sha256:%x"}}`, server.URL, checksum)
+	}
+
+	waitStatus := func(plan types.Plan, expected types.BinaryStatus) {
+		require.Eventually(t, func() bool {
+			status, _, _ := s.keeper.GetBinaryStatus(plan)
+			return status == expected
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	t.Log("Verify the binary is not downloaded before the configured number of blocks")
+	plan := types.Plan{Name: "test", Height: 20, Info: makeInfo(checksum[:])}
+	require.NoError(t, s.keeper.ScheduleUpgrade(s.ctx, plan))
+
+	require.NoError(t, s.module.BeginBlock(s.ctx.WithHeaderInfo(header.Info{Height: 14})))
+	status, _, _ := s.keeper.GetBinaryStatus(plan)
+	require.Equal(t, types.BinaryStatusUnspecified, status)
+
+	t.Log("Verify the binary is downloaded and verified ahead of the upgrade")
+	require.NoError(t, s.module.BeginBlock(s.ctx.WithHeaderInfo(header.Info{Height: 15})))
+	waitStatus(plan, types.BinaryStatusVerified)
+
+	_, path, err := s.keeper.GetBinaryStatus(plan)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(s.keeper.GetBinaryDir("test"), "bin", "simd"), path)
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, binary, bz)
+
+	res, err := s.keeper.BinaryStatus(s.ctx, &types.QueryBinaryStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryBinaryStatusResponse{
+		Enabled:    true,
+		PlanName:   "test",
+		PlanHeight: 20,
+		Status:     types.BinaryStatusVerified,
+		Path:       path,
+	}, res)
+
+	err = s.module.BeginBlock(s.ctx.WithHeaderInfo(header.Info{Height: 20}))
+	require.ErrorContains(t, err, "UPGRADE \"test\" NEEDED at height: 20: ")
+	require.ErrorContains(t, err, "verified upgrade binary at "+path)
+
+	t.Log("Verify a binary with a wrong checksum is not verified")
+	plan = types.Plan{Name: "bad", Height: 30, Info: makeInfo(make([]byte, sha256.Size))}
+	require.NoError(t, s.keeper.ScheduleUpgrade(s.ctx, plan))
+
+	require.NoError(t, s.module.BeginBlock(s.ctx.WithHeaderInfo(header.Info{Height: 25})))
+	waitStatus(plan, types.BinaryStatusFailed)
+
+	res, err = s.keeper.BinaryStatus(s.ctx, &types.QueryBinaryStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.BinaryStatusFailed, res.Status)
+	require.Empty(t, res.Path)
+	require.Contains(t, res.Error, "Checksums did not match")
+
+	err = s.module.BeginBlock(s.ctx.WithHeaderInfo(header.Info{Height: 30}))
+	require.ErrorContains(t, err, "UPGRADE \"bad\" NEEDED at height: 30: ")
+	require.ErrorContains(t, err, "NO VERIFIED UPGRADE BINARY")
+}
+
+func TestRefuseBlocksWithoutVerifiedBinary(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	s.keeper.SetBinaryPreDownload(5, "simd")
+	s.keeper.SetStakingKeeper(signalStakingKeeper{sdk.ValAddress([]byte("val1________________"))})
+
+	binary := []byte("#!/bin/sh\necho upgraded\n")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(binary)
+	}))
+	defer server.Close()
+
+	checksum := sha256.Sum256(binary)
+	makeInfo := func(checksum []byte) string {
+		return fmt.Sprintf(`{"binaries":{"any":"%s/simd?checksum=sha256:%x"}}`, server.URL, checksum)
+	}
+
+	t.Log("Verify the blocks past the upgrade height are refused without a verified binary")
+	plan := types.Plan{Name: "bad", Height: 20, Info: makeInfo(make([]byte, sha256.Size)), SignalThreshold: "0.5"}
+	require.NoError(t, s.keeper.ScheduleUpgrade(s.ctx, plan))
+
+	require.NoError(t, s.module.BeginBlock(s.ctx.WithHeaderInfo(header.Info{Height: 19})))
+
+	err := s.module.BeginBlock(s.ctx.WithHeaderInfo(header.Info{Height: 20}))
+	require.ErrorContains(t, err, "UPGRADE \"bad\" PAST HEIGHT 20: NO VERIFIED UPGRADE BINARY")
+	require.ErrorContains(t, err, "Checksums did not match")
+
+	t.Log("Verify the blocks past the upgrade height are processed once the binary is verified")
+	plan = types.Plan{Name: "test", Height: 30, Info: makeInfo(checksum[:]), SignalThreshold: "0.5"}
+	require.NoError(t, s.keeper.ScheduleUpgrade(s.ctx, plan))
+
+	// the pending download is waited for
+	require.NoError(t, s.module.BeginBlock(s.ctx.WithHeaderInfo(header.Info{Height: 31})))
+	status, _, _ := s.keeper.GetBinaryStatus(plan)
+	require.Equal(t, types.BinaryStatusVerified, status)
+
+	t.Log("Verify the upgrade heights to skip are not refused")
+	s = setupTest(t, 10, map[int64]bool{40: true})
+	s.keeper.SetBinaryPreDownload(5, "simd")
+	s.keeper.SetStakingKeeper(signalStakingKeeper{sdk.ValAddress([]byte("val1________________"))})
+
+	plan = types.Plan{Name: "skipped", Height: 40, Info: makeInfo(make([]byte, sha256.Size)), SignalThreshold: "0.5"}
+	require.NoError(t, s.keeper.ScheduleUpgrade(s.ctx, plan))
+	require.NoError(t, s.module.BeginBlock(s.ctx.WithHeaderInfo(header.Info{Height: 41})))
+}
+
+func TestPreDownloadBinaryRetryBackoff(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	s.keeper.SetBinaryPreDownload(100, "simd")
+
+	var downloads atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		downloads.Add(1)
+		_, _ = w.Write([]byte("#!/bin/sh\necho upgraded\n"))
+	}))
+	defer server.Close()
+
+	plan := types.Plan{Name: "bad", Height: 1000, Info: fmt.Sprintf(`{"binaries":{"any":"%s/simd?checksum=sha256:%x"}}`, server.URL, make([]byte, sha256.Size))}
+	require.NoError(t, s.keeper.ScheduleUpgrade(s.ctx, plan))
+
+	// preDownload runs the pre-download at the given height, waits for it to
+	// fail, and returns whether the binary was downloaded
+	preDownload := func(height int64) bool {
+		before := downloads.Load()
+		s.keeper.PreDownloadBinary(s.ctx.WithHeaderInfo(header.Info{Height: height}), plan)
+		require.Eventually(t, func() bool {
+			status, _, _ := s.keeper.GetBinaryStatus(plan)
+			return status == types.BinaryStatusFailed
+		}, 5*time.Second, 10*time.Millisecond)
+		return downloads.Load() > before
+	}
+
+	t.Log("Verify a failed download is retried after 1, 2, 4... blocks")
+	require.True(t, preDownload(900))
+	require.False(t, preDownload(901))
+	require.True(t, preDownload(902))
+	require.False(t, preDownload(903))
+	require.False(t, preDownload(904))
+	require.True(t, preDownload(905))
+	for height := int64(906); height < 910; height++ {
+		require.False(t, preDownload(height))
+	}
+	require.True(t, preDownload(910))
+}
+
+func TestBinaryWaitTimeout(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	s.keeper.SetBinaryPreDownload(5, "simd")
+	s.keeper.SetBinaryWaitTimeout(100 * time.Millisecond)
+	s.keeper.SetStakingKeeper(signalStakingKeeper{sdk.ValAddress([]byte("val1________________"))})
+
+	// the server never answers until the end of the test
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	plan := types.Plan{Name: "slow", Height: 20, Info: fmt.Sprintf(`{"binaries":{"any":"%s/simd?checksum=sha256:%x"}}`, server.URL, make([]byte, sha256.Size)), SignalThreshold: "0.5"}
+	require.NoError(t, s.keeper.ScheduleUpgrade(s.ctx, plan))
+
+	t.Log("Verify the block past the upgrade height is refused once the wait times out")
+	start := time.Now()
+	err := s.module.BeginBlock(s.ctx.WithHeaderInfo(header.Info{Height: 21}))
+	require.ErrorContains(t, err, "UPGRADE \"slow\" PAST HEIGHT 20: NO VERIFIED UPGRADE BINARY (BINARY_STATUS_DOWNLOADING)")
+	require.Less(t, time.Since(start), 5*time.Second)
+}
+
+// signalStakingKeeper is a staking keeper whose validators all have a voting
+// power of 10.
+type signalStakingKeeper []sdk.ValAddress
+
+func (sk signalStakingKeeper) Validator(_ sdk.Context, addr sdk.ValAddress) stakingtypes.ValidatorI {
+	for _, val := range sk {
+		if val.Equals(addr) {
+			return stakingtypes.Validator{OperatorAddress: addr.String()}
+		}
+	}
+
+	return nil
+}
+
+func (sk signalStakingKeeper) ValidatorByConsAddr(ctx sdk.Context, addr sdk.ConsAddress) stakingtypes.ValidatorI {
+	return sk.Validator(ctx, sdk.ValAddress(addr))
+}
+
+func (sk signalStakingKeeper) GetLastValidatorPower(ctx sdk.Context, operator sdk.ValAddress) int64 {
+	if sk.Validator(ctx, operator) == nil {
+		return 0
+	}
+
+	return 10
+}
+
+func (sk signalStakingKeeper) GetLastTotalPower(_ sdk.Context) math.Int {
+	return math.NewInt(int64(10 * len(sk)))
+}
+
+func TestSignalThresholdUpgrade(t *testing.T) {
+	s := setupTest(t, 10, map[int64]bool{})
+	vals := signalStakingKeeper{
+		sdk.ValAddress([]byte("val1________________")),
+		sdk.ValAddress([]byte("val2________________")),
+	}
+	s.keeper.SetStakingKeeper(vals)
+
+	plan := types.Plan{Name: "test", Height: 11, SignalThreshold: "0.5"}
+	require.NoError(t, s.keeper.ScheduleUpgrade(s.ctx, plan))
+
+	t.Log("Verify the upgrade is delayed until the signal threshold is exceeded")
+	newCtx := s.ctx.WithHeaderInfo(header.Info{Height: 11, Time: time.Now()})
+	require.NoError(t, s.module.BeginBlock(newCtx))
+
+	require.NoError(t, s.keeper.SignalUpgrade(newCtx, vals[0], "test"))
+	newCtx = newCtx.WithHeaderInfo(header.Info{Height: 12, Time: time.Now()})
+	require.NoError(t, s.module.BeginBlock(newCtx))
+
+	t.Log("Verify the upgrade is applied once the signal threshold is exceeded")
+	require.NoError(t, s.keeper.SignalUpgrade(newCtx, vals[1], "test"))
+	newCtx = newCtx.WithHeaderInfo(header.Info{Height: 13, Time: time.Now()})
+	err := s.module.BeginBlock(newCtx)
+	require.ErrorContains(t, err, "UPGRADE \"test\" NEEDED at height: 11: ")
+
+	s.keeper.SetUpgradeHandler("test", func(ctx context.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+	require.NoError(t, s.module.BeginBlock(newCtx))
+
+	VerifyCleared(t, newCtx)
+}
@@ -0,0 +1,225 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/listenkv"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/upgrade/keeper"
+	"cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DryRunApplication defines an application whose upgrades can be dry run.
+type DryRunApplication interface {
+	servertypes.Application
+
+	// GetUpgradeKeeper returns the upgrade keeper the upgrade handlers are
+	// registered in.
+	GetUpgradeKeeper() *keeper.Keeper
+}
+
+// DryRunResult is the report of an upgrade dry run.
+type DryRunResult struct {
+	Plan           types.Plan           `json:"plan"`
+	GasConsumed    uint64               `json:"gas_consumed"`
+	Duration       string               `json:"duration"`
+	ModuleVersions []DryRunModuleChange `json:"module_versions"`
+	StoreChanges   []DryRunStoreChange  `json:"store_changes"`
+	Error          string               `json:"error,omitempty"`
+}
+
+// DryRunModuleChange is the change of the consensus version of a module.
+type DryRunModuleChange struct {
+	Module      string `json:"module"`
+	FromVersion uint64 `json:"from_version"`
+	ToVersion   uint64 `json:"to_version"`
+}
+
+// DryRunStoreChange is the number of keys of a store changed by an upgrade.
+type DryRunStoreChange struct {
+	Store   string `json:"store"`
+	Updated int    `json:"updated"`
+	Deleted int    `json:"deleted"`
+}
+
+// NewDryRunCmd returns the command dry running an upgrade handler, including
+// the store migrations it runs, against the state of the node. The created
+// application must implement DryRunApplication.
+func NewDryRunCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-dry-run [plan-name]",
+		Short: "Dry run an upgrade handler against the current state of the node",
+		Long: `Dry run the upgrade handler registered for the given plan, including the store migrations it runs,
+against the latest state of the node, and report the consumed gas, the keys changed per store,
+the module version changes, the duration and the error if any. Nothing is committed to the node state.
+
+If the plan is the scheduled one, it is run with its height and info, otherwise at the next height.
+The node must be stopped, as the application database of the node home directory is opened.`,
+		Example: "upgrade-dry-run v2 --home ~/.simapp",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			dataDir := filepath.Join(serverCtx.Config.RootDir, "data")
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), dataDir)
+			if err != nil {
+				return err
+			}
+
+			logger := log.NewLogger(cmd.ErrOrStderr())
+			app := appCreator(logger, db, nil, serverCtx.Viper)
+			defer app.Close()
+
+			upgradeApp, ok := app.(DryRunApplication)
+			if !ok {
+				return errors.New("the application does not support upgrade dry runs")
+			}
+
+			rs, ok := app.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return errors.New("currently only support the dry run of upgrades against rootmulti.Store type")
+			}
+
+			res, err := DryRunUpgrade(rs, upgradeApp.GetUpgradeKeeper(), args[0], logger)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(res, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(bz))
+
+			if res.Error != "" {
+				return fmt.Errorf("upgrade %q failed: %s", args[0], res.Error)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// DryRunUpgrade runs the upgrade handler registered in the given keeper for
+// the given plan against a branch of the latest version of the given store,
+// which is never written to. A failure of the handler is reported in the
+// result rather than returned.
+func DryRunUpgrade(rs *rootmulti.Store, k *keeper.Keeper, planName string, logger log.Logger) (DryRunResult, error) {
+	if !k.HasHandler(planName) {
+		return DryRunResult{}, fmt.Errorf("no upgrade handler registered for %q", planName)
+	}
+
+	// Each store is branched twice, so that the writes of the upgrade are
+	// observed when flushing the outer branch while the inner one is discarded.
+	keysByName := rs.StoreKeysByName()
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(keysByName))
+	listeners := make([]*storetypes.MemoryListener, 0, len(keysByName))
+	for _, key := range keysByName {
+		listener := storetypes.NewMemoryListener()
+		stores[key] = listenkv.NewStore(cachekv.NewStore(rs.GetKVStore(key)), key, listener)
+		listeners = append(listeners, listener)
+	}
+	cms := cachemulti.NewStore(dbm.NewMemDB(), stores, keysByName, nil, nil)
+
+	height := rs.LastCommitID().Version + 1
+	now := time.Now()
+	ctx := sdk.NewContext(cms, cmtproto.Header{Height: height, Time: now}, false, logger).
+		WithHeaderInfo(header.Info{Height: height, Time: now})
+
+	plan, err := k.GetUpgradePlan(ctx)
+	switch {
+	case errors.Is(err, types.ErrNoUpgradePlanFound) || (err == nil && plan.Name != planName):
+		plan = types.Plan{Name: planName, Height: height}
+	case err != nil:
+		return DryRunResult{}, err
+	}
+
+	fromVM, err := k.GetModuleVersionMap(ctx)
+	if err != nil {
+		return DryRunResult{}, err
+	}
+
+	res := DryRunResult{Plan: plan}
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+	start := time.Now()
+	if err := applyUpgrade(ctx, k, plan); err != nil {
+		res.Error = err.Error()
+	}
+	res.Duration = time.Since(start).String()
+	res.GasConsumed = ctx.GasMeter().GasConsumed()
+
+	toVM, err := k.GetModuleVersionMap(ctx)
+	if err != nil {
+		return DryRunResult{}, err
+	}
+
+	for name, to := range toVM {
+		if from, ok := fromVM[name]; !ok || from != to {
+			res.ModuleVersions = append(res.ModuleVersions, DryRunModuleChange{Module: name, FromVersion: from, ToVersion: to})
+		}
+	}
+	sort.Slice(res.ModuleVersions, func(i, j int) bool {
+		return res.ModuleVersions[i].Module < res.ModuleVersions[j].Module
+	})
+
+	// flush the outer branches to the listeners, keeping the last write of each key
+	cms.Write()
+	changes := make(map[string]map[string]bool)
+	for _, listener := range listeners {
+		for _, pair := range listener.PopStateCache() {
+			if changes[pair.StoreKey] == nil {
+				changes[pair.StoreKey] = make(map[string]bool)
+			}
+			changes[pair.StoreKey][string(pair.Key)] = pair.Delete
+		}
+	}
+
+	for name, keys := range changes {
+		change := DryRunStoreChange{Store: name}
+		for _, deleted := range keys {
+			if deleted {
+				change.Deleted++
+			} else {
+				change.Updated++
+			}
+		}
+		res.StoreChanges = append(res.StoreChanges, change)
+	}
+	sort.Slice(res.StoreChanges, func(i, j int) bool {
+		return res.StoreChanges[i].Store < res.StoreChanges[j].Store
+	})
+
+	return res, nil
+}
+
+// applyUpgrade applies the given upgrade plan, turning a panic of the upgrade
+// handler into an error.
+func applyUpgrade(ctx sdk.Context, k *keeper.Keeper, plan types.Plan) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("upgrade handler panicked: %v", r)
+		}
+	}()
+
+	return k.ApplyUpgrade(ctx, plan)
+}
@@ -0,0 +1,107 @@
+package cli_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/upgrade"
+	upgradecli "cosmossdk.io/x/upgrade/client/cli"
+	"cosmossdk.io/x/upgrade/keeper"
+	"cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	testutilmod "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestDryRunUpgrade(t *testing.T) {
+	encCfg := testutilmod.MakeTestEncodingConfig(upgrade.AppModuleBasic{})
+	upgradeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	fooKey := storetypes.NewKVStoreKey("foo")
+
+	rs := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	rs.MountStoreWithDB(upgradeKey, storetypes.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(fooKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	k := keeper.NewKeeper(map[int64]bool{}, runtime.NewKVStoreService(upgradeKey), encCfg.Codec, t.TempDir(), nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+
+	// initial state
+	ctx := sdk.NewContext(rs, cmtproto.Header{}, false, log.NewNopLogger())
+	require.NoError(t, k.SetModuleVersionMap(ctx, module.VersionMap{"foo": 1, "bar": 1}))
+	ctx.KVStore(fooKey).Set([]byte("deleted"), []byte("value"))
+	ctx.KVStore(fooKey).Set([]byte("updated"), []byte("value"))
+	rs.Commit()
+
+	_, err := upgradecli.DryRunUpgrade(rs, k, "test", log.NewNopLogger())
+	require.ErrorContains(t, err, "no upgrade handler registered for \"test\"")
+
+	k.SetUpgradeHandler("test", func(ctx context.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		store := sdk.UnwrapSDKContext(ctx).KVStore(fooKey)
+		store.Delete([]byte("deleted"))
+		store.Set([]byte("updated"), []byte("new value"))
+		store.Set([]byte("updated"), []byte("newer value"))
+		store.Set([]byte("created"), []byte("value"))
+
+		vm["foo"] = 2
+		vm["baz"] = 1
+		return vm, nil
+	})
+
+	res, err := upgradecli.DryRunUpgrade(rs, k, "test", log.NewNopLogger())
+	require.NoError(t, err)
+	require.Empty(t, res.Error)
+	require.Equal(t, types.Plan{Name: "test", Height: 2}, res.Plan)
+	require.Positive(t, res.GasConsumed)
+	require.NotEmpty(t, res.Duration)
+	require.Equal(t, []upgradecli.DryRunModuleChange{
+		{Module: "baz", FromVersion: 0, ToVersion: 1},
+		{Module: "foo", FromVersion: 1, ToVersion: 2},
+	}, res.ModuleVersions)
+	require.Len(t, res.StoreChanges, 2)
+	require.Equal(t, upgradecli.DryRunStoreChange{Store: "foo", Updated: 2, Deleted: 1}, res.StoreChanges[0])
+	require.Equal(t, types.StoreKey, res.StoreChanges[1].Store)
+
+	// nothing is written to the store
+	ctx = sdk.NewContext(rs, cmtproto.Header{}, false, log.NewNopLogger())
+	require.Equal(t, []byte("value"), ctx.KVStore(fooKey).Get([]byte("deleted")))
+	require.Equal(t, []byte("value"), ctx.KVStore(fooKey).Get([]byte("updated")))
+	require.Nil(t, ctx.KVStore(fooKey).Get([]byte("created")))
+	vm, err := k.GetModuleVersionMap(ctx)
+	require.NoError(t, err)
+	require.Equal(t, module.VersionMap{"foo": 1, "bar": 1}, vm)
+	done, err := k.GetDoneHeight(ctx, "test")
+	require.NoError(t, err)
+	require.Zero(t, done)
+
+	t.Log("Verify the failure of the upgrade handler is reported")
+	k.SetUpgradeHandler("fail", func(ctx context.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return nil, errors.New("migration failed")
+	})
+
+	res, err = upgradecli.DryRunUpgrade(rs, k, "fail", log.NewNopLogger())
+	require.NoError(t, err)
+	require.Equal(t, "migration failed", res.Error)
+	require.Empty(t, res.ModuleVersions)
+	require.Empty(t, res.StoreChanges)
+
+	k.SetUpgradeHandler("panic", func(ctx context.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		panic("boom")
+	})
+
+	res, err = upgradecli.DryRunUpgrade(rs, k, "panic", log.NewNopLogger())
+	require.NoError(t, err)
+	require.Equal(t, "upgrade handler panicked: boom", res.Error)
+}
@@ -16,5 +16,10 @@ func parsePlan(fs *pflag.FlagSet, name string) (types.Plan, error) {
 		return types.Plan{}, err
 	}
 
-	return types.Plan{Name: name, Height: height, Info: info}, nil
+	signalThreshold, err := fs.GetString(FlagSignalThreshold)
+	if err != nil {
+		return types.Plan{}, err
+	}
+
+	return types.Plan{Name: name, Height: height, Info: info, SignalThreshold: signalThreshold}, nil
 }
@@ -15,18 +15,21 @@ func TestParsePlan(t *testing.T) {
 
 	proposal := types.MsgSoftwareUpgrade{
 		Plan: types.Plan{
-			Name:   "plan name",
-			Height: 123456,
-			Info:   "plan info",
+			Name:            "plan name",
+			Height:          123456,
+			Info:            "plan info",
+			SignalThreshold: "0.667",
 		},
 	}
 
 	fs.Set(FlagUpgradeHeight, strconv.FormatInt(proposal.Plan.Height, 10))
 	fs.Set(FlagUpgradeInfo, proposal.Plan.Info)
+	fs.Set(FlagSignalThreshold, proposal.Plan.SignalThreshold)
 
 	p, err := parsePlan(fs, proposal.Plan.Name)
 	require.NoError(t, err)
 	require.Equal(t, p.Name, proposal.Plan.Name)
 	require.Equal(t, p.Height, proposal.Plan.Height)
 	require.Equal(t, p.Info, proposal.Plan.Info)
+	require.Equal(t, p.SignalThreshold, proposal.Plan.SignalThreshold)
 }
@@ -23,6 +23,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCurrentPlanCmd(),
 		GetAppliedPlanCmd(),
 		GetModuleVersionsCmd(),
+		GetBinaryStatusCmd(),
+		GetSignalTallyCmd(),
 	)
 
 	return cmd
@@ -155,3 +157,62 @@ func GetModuleVersionsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetBinaryStatusCmd returns the status of the binary of the current upgrade
+// plan pre-downloaded by the queried node.
+func GetBinaryStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "binary-status",
+		Short: "get the status of the pre-downloaded upgrade binary",
+		Long: "Gets the status of the binary of the currently scheduled upgrade plan, pre-downloaded and verified by the queried node.\n" +
+			"The status is local to the queried node, see the --upgrade-pre-download-blocks start flag.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BinaryStatus(cmd.Context(), &types.QueryBinaryStatusRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetSignalTallyCmd returns the tally of the validators which signalled their
+// readiness for the current upgrade plan.
+func GetSignalTallyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signal-tally",
+		Short: "get the signal tally of the current upgrade plan",
+		Long:  "Gets the voting power and the list of the validators which signalled their readiness for the currently scheduled upgrade plan.",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SignalTally(cmd.Context(), &types.QuerySignalTallyRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -175,3 +175,99 @@ func TestGetModuleVersionsCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBinaryStatusCmd(t *testing.T) {
+	encCfg := testutilmod.MakeTestEncodingConfig(upgrade.AppModuleBasic{})
+	kr := keyring.NewInMemory(encCfg.Codec)
+	baseCtx := client.Context{}.
+		WithKeyring(kr).
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec).
+		WithClient(clitestutil.MockCometRPC{Client: rpcclientmock.Client{}}).
+		WithAccountRetriever(client.MockAccountRetriever{}).
+		WithOutput(io.Discard).
+		WithChainID("test-chain")
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			name:         "json output",
+			args:         []string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
+			expCmdOutput: `[--output=json]`,
+		},
+		{
+			name:         "text output",
+			args:         []string{fmt.Sprintf("--%s=text", flags.FlagOutput)},
+			expCmdOutput: `[--output=text]`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd := upgradecli.GetBinaryStatusCmd()
+			cmd.SetOut(io.Discard)
+			require.NotNil(t, cmd)
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(tc.args)
+
+			require.NoError(t, client.SetCmdClientContextHandler(baseCtx, cmd))
+
+			require.Contains(t, fmt.Sprint(cmd), "binary-status [] [] get the status of the pre-downloaded upgrade binary")
+			require.Contains(t, fmt.Sprint(cmd), tc.expCmdOutput)
+		})
+	}
+}
+
+func TestGetSignalTallyCmd(t *testing.T) {
+	encCfg := testutilmod.MakeTestEncodingConfig(upgrade.AppModuleBasic{})
+	kr := keyring.NewInMemory(encCfg.Codec)
+	baseCtx := client.Context{}.
+		WithKeyring(kr).
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec).
+		WithClient(clitestutil.MockCometRPC{Client: rpcclientmock.Client{}}).
+		WithAccountRetriever(client.MockAccountRetriever{}).
+		WithOutput(io.Discard).
+		WithChainID("test-chain")
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			name:         "json output",
+			args:         []string{fmt.Sprintf("--%s=json", flags.FlagOutput)},
+			expCmdOutput: `[--output=json]`,
+		},
+		{
+			name:         "text output",
+			args:         []string{fmt.Sprintf("--%s=text", flags.FlagOutput)},
+			expCmdOutput: `[--output=text]`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd := upgradecli.GetSignalTallyCmd()
+			cmd.SetOut(io.Discard)
+			require.NotNil(t, cmd)
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(tc.args)
+
+			require.NoError(t, client.SetCmdClientContextHandler(baseCtx, cmd))
+
+			require.Contains(t, fmt.Sprint(cmd), "signal-tally [] [] get the signal tally of the current upgrade plan")
+			require.Contains(t, fmt.Sprint(cmd), tc.expCmdOutput)
+		})
+	}
+}
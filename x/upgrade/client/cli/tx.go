@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	"github.com/spf13/cobra"
 
@@ -24,6 +25,8 @@ const (
 	FlagNoValidate    = "no-validate"
 	FlagDaemonName    = "daemon-name"
 	FlagAuthority     = "authority"
+
+	FlagSignalThreshold = "signal-threshold"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -36,6 +39,7 @@ func GetTxCmd(ac addresscodec.Codec) *cobra.Command {
 	cmd.AddCommand(
 		NewCmdSubmitUpgradeProposal(ac),
 		NewCmdSubmitCancelUpgradeProposal(ac),
+		NewCmdSignalUpgrade(),
 	)
 
 	return cmd
@@ -111,6 +115,7 @@ func NewCmdSubmitUpgradeProposal(ac addresscodec.Codec) *cobra.Command {
 
 	cmd.Flags().Int64(FlagUpgradeHeight, 0, "The height at which the upgrade must happen")
 	cmd.Flags().String(FlagUpgradeInfo, "", "Info for the upgrade plan such as new version download urls, etc.")
+	cmd.Flags().String(FlagSignalThreshold, "", "The fraction of the bonded voting power which must signal its readiness before the upgrade happens (e.g. 0.667), the upgrade is not signalled if empty")
 	cmd.Flags().Bool(FlagNoValidate, false, "Skip validation of the upgrade info (dangerous!)")
 	cmd.Flags().String(FlagDaemonName, getDefaultDaemonName(), "The name of the executable being upgraded (for upgrade-info validation). Default is the DAEMON_NAME env var if set, or else this executable")
 	cmd.Flags().String(FlagAuthority, "", "The address of the upgrade module authority (defaults to gov)")
@@ -172,6 +177,36 @@ func NewCmdSubmitCancelUpgradeProposal(ac addresscodec.Codec) *cobra.Command {
 	return cmd
 }
 
+// NewCmdSignalUpgrade implements a command handler for signalling the readiness
+// of a validator for the current upgrade plan.
+func NewCmdSignalUpgrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signal [name] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Signal the readiness of a validator for the current upgrade plan",
+		Long: "Signal the readiness of the validator operated by the --from account for the current upgrade plan with the given name.\n" +
+			"An upgrade plan with a signal threshold is only applied once the voting power of the validators which signalled it exceeds the threshold.",
+		Example: fmt.Sprintf("%s tx upgrade signal v2 --from mykey", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSignalUpgrade{
+				ValidatorAddress: sdk.ValAddress(clientCtx.GetFromAddress()).String(),
+				Name:             args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // getDefaultDaemonName gets the default name to use for the daemon.
 // If a DAEMON_NAME env var is set, that is used.
 // Otherwise, the last part of the currently running executable is used.
@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	neturl "net/url"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	"cosmossdk.io/x/upgrade/plan"
+	"cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultBinaryWaitTimeout is the default duration for which a block past
+	// the upgrade height waits for the pending download of the binary.
+	DefaultBinaryWaitTimeout = 30 * time.Second
+
+	// maxBinaryRetryBlocks caps the number of blocks after which a failed
+	// download is retried.
+	maxBinaryRetryBlocks int64 = 64
+)
+
+// binaryDownloader pre-downloads and verifies the binary of the scheduled
+// upgrade plan. Its state is local to the node and not part of the consensus
+// state.
+type binaryDownloader struct {
+	blocks      int64         // number of blocks before the upgrade height at which to start downloading
+	daemonName  string        // name of the downloaded executable
+	waitTimeout time.Duration // maximum duration to wait for the pending download past the upgrade height
+
+	mu          sync.Mutex
+	planName    string
+	planHeight  int64
+	status      types.BinaryStatus
+	path        string
+	err         error
+	done        chan struct{} // closed once the pending download is over
+	failures    int           // number of consecutive failed downloads of the plan
+	retryHeight int64         // height from which the failed download is retried, 0 if not set yet
+}
+
+// SetBinaryPreDownload enables the pre-download of the binary of the scheduled
+// upgrade plan, the given number of blocks before the upgrade height. The
+// binary listed in the plan info for the node os/architecture is downloaded
+// into the Cosmovisor upgrade directory, i.e.
+// {home}/cosmovisor/upgrades/{plan name}/bin/{daemonName}, and its checksum is
+// verified. A non-positive number of blocks disables the pre-download.
+func (k *Keeper) SetBinaryPreDownload(blocks int64, daemonName string) {
+	if blocks <= 0 {
+		k.binaryDownloader = nil
+		return
+	}
+
+	k.binaryDownloader = &binaryDownloader{blocks: blocks, daemonName: daemonName, waitTimeout: DefaultBinaryWaitTimeout}
+}
+
+// SetBinaryWaitTimeout sets the maximum duration for which a block past the
+// upgrade height waits for the pending download of the binary before the node
+// halts, DefaultBinaryWaitTimeout by default. It must be called after
+// SetBinaryPreDownload.
+func (k *Keeper) SetBinaryWaitTimeout(timeout time.Duration) {
+	if k.binaryDownloader != nil {
+		k.binaryDownloader.waitTimeout = timeout
+	}
+}
+
+// BinaryPreDownloadEnabled returns true if the binary of the scheduled upgrade
+// plan is pre-downloaded.
+func (k Keeper) BinaryPreDownloadEnabled() bool {
+	return k.binaryDownloader != nil
+}
+
+// PreDownloadBinary starts downloading the binary of the given plan in the
+// background if the pre-download is enabled and the upgrade height is close
+// enough. It does nothing while a download is in progress or once the binary
+// is verified. A failed download is retried with an exponential backoff, after
+// 1, 2, 4... blocks, up to maxBinaryRetryBlocks.
+func (k Keeper) PreDownloadBinary(ctx context.Context, p types.Plan) {
+	d := k.binaryDownloader
+	if d == nil || k.IsSkipHeight(p.Height) {
+		return
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).HeaderInfo().Height
+	if height < p.Height-d.blocks {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.isFor(p) {
+		switch d.status {
+		case types.BinaryStatusDownloading, types.BinaryStatusVerified:
+			return
+		case types.BinaryStatusFailed:
+			if d.retryHeight == 0 {
+				d.retryHeight = height + binaryRetryBlocks(d.failures)
+			}
+			if height < d.retryHeight {
+				return
+			}
+		}
+	} else {
+		d.failures = 0
+	}
+
+	d.planName, d.planHeight = p.Name, p.Height
+	d.status, d.path, d.err = types.BinaryStatusDownloading, "", nil
+	d.retryHeight = 0
+	done := make(chan struct{})
+	d.done = done
+
+	logger := k.Logger(ctx)
+	logger.Info("downloading upgrade binary", "plan", p.Name, "height", p.Height)
+
+	dstRoot := k.GetBinaryDir(p.Name)
+	go func() {
+		defer close(done)
+
+		path, err := downloadBinary(p.Info, dstRoot, d.daemonName)
+
+		d.mu.Lock()
+		defer d.mu.Unlock()
+
+		// ignore the result if the plan was replaced in the meantime
+		if !d.isFor(p) {
+			return
+		}
+
+		if err != nil {
+			logger.Error("failed to download upgrade binary", "plan", p.Name, "height", p.Height, "err", err)
+			d.status, d.err = types.BinaryStatusFailed, err
+			d.failures++
+			return
+		}
+
+		logger.Info("upgrade binary downloaded and verified", "plan", p.Name, "height", p.Height, "path", path)
+		d.status, d.path = types.BinaryStatusVerified, path
+	}()
+}
+
+// GetBinaryStatus returns the status of the pre-download of the binary of the
+// given plan, along with the path of the verified binary or the error of the
+// last failed download.
+func (k Keeper) GetBinaryStatus(p types.Plan) (status types.BinaryStatus, path string, err error) {
+	d := k.binaryDownloader
+	if d == nil {
+		return types.BinaryStatusUnspecified, "", nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.isFor(p) {
+		return types.BinaryStatusUnspecified, "", nil
+	}
+
+	return d.status, d.path, d.err
+}
+
+// WaitBinary waits for the pending download of the binary of the given plan,
+// if any, until it is over, the binary wait timeout expires or the context is
+// done, and returns its status like GetBinaryStatus. The status is still
+// downloading if the wait was cut short.
+func (k Keeper) WaitBinary(ctx context.Context, p types.Plan) (status types.BinaryStatus, path string, err error) {
+	if d := k.binaryDownloader; d != nil {
+		d.mu.Lock()
+		done, pending := d.done, d.isFor(p) && d.status == types.BinaryStatusDownloading
+		d.mu.Unlock()
+
+		if pending {
+			ctx, cancel := context.WithTimeout(ctx, d.waitTimeout)
+			defer cancel()
+
+			select {
+			case <-done:
+			case <-ctx.Done():
+			}
+		}
+	}
+
+	return k.GetBinaryStatus(p)
+}
+
+// GetBinaryDir returns the directory the binary of the given plan is
+// downloaded into.
+func (k Keeper) GetBinaryDir(planName string) string {
+	return filepath.Join(k.getHomeDir(), "cosmovisor", "upgrades", neturl.PathEscape(planName))
+}
+
+// binaryRetryBlocks returns the number of blocks after which a download which
+// failed the given number of consecutive times is retried.
+func binaryRetryBlocks(failures int) int64 {
+	blocks := int64(1)
+	for i := 1; i < failures && blocks < maxBinaryRetryBlocks; i++ {
+		blocks *= 2
+	}
+	return blocks
+}
+
+// isFor returns true if the state of the downloader is the one of the given
+// plan. The caller must hold the lock.
+func (d *binaryDownloader) isFor(p types.Plan) bool {
+	return d.planName == p.Name && d.planHeight == p.Height
+}
+
+// downloadBinary downloads the binary listed in the plan info for the node
+// os/architecture, or for any, into dstRoot and returns its path.
+func downloadBinary(planInfo, dstRoot, daemonName string) (string, error) {
+	info, err := plan.ParseInfo(planInfo)
+	if err != nil {
+		return "", err
+	}
+
+	osArch := fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH)
+	url, ok := info.Binaries[osArch]
+	if !ok {
+		url, ok = info.Binaries["any"]
+	}
+	if !ok {
+		return "", fmt.Errorf("cannot find binary for os/arch: neither %s, nor any", osArch)
+	}
+
+	if err := plan.DownloadUpgrade(dstRoot, url, daemonName); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dstRoot, "bin", daemonName), nil
+}
@@ -87,3 +87,69 @@ func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersions
 func (k Keeper) Authority(c context.Context, req *types.QueryAuthorityRequest) (*types.QueryAuthorityResponse, error) {
 	return &types.QueryAuthorityResponse{Address: k.authority}, nil
 }
+
+// BinaryStatus implements the Query/BinaryStatus gRPC method
+func (k Keeper) BinaryStatus(c context.Context, req *types.QueryBinaryStatusRequest) (*types.QueryBinaryStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryBinaryStatusResponse{Enabled: k.BinaryPreDownloadEnabled()}
+
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil {
+		if errors.Is(err, types.ErrNoUpgradePlanFound) {
+			return res, nil
+		}
+
+		return nil, err
+	}
+
+	status, path, err := k.GetBinaryStatus(plan)
+	res.PlanName, res.PlanHeight, res.Status, res.Path = plan.Name, plan.Height, status, path
+	if err != nil {
+		res.Error = err.Error()
+	}
+
+	return res, nil
+}
+
+// SignalTally implements the Query/SignalTally gRPC method
+func (k Keeper) SignalTally(c context.Context, req *types.QuerySignalTallyRequest) (*types.QuerySignalTallyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil {
+		if errors.Is(err, types.ErrNoUpgradePlanFound) {
+			return &types.QuerySignalTallyResponse{}, nil
+		}
+
+		return nil, err
+	}
+
+	validators, err := k.GetSignals(ctx, plan.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	signalled, total, err := k.TallySignals(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	reached, err := k.IsSignalThresholdReached(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QuerySignalTallyResponse{
+		PlanName:         plan.Name,
+		SignalThreshold:  plan.SignalThreshold,
+		SignalledPower:   signalled,
+		TotalPower:       total,
+		ThresholdReached: reached,
+	}
+	for _, valAddr := range validators {
+		res.Validators = append(res.Validators, valAddr.String())
+	}
+
+	return res, nil
+}
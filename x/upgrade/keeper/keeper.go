@@ -45,6 +45,8 @@ type Keeper struct {
 	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
 	authority          string                          // the address capable of executing and canceling an upgrade. Usually the gov module account
 	initVersionMap     module.VersionMap               // the module version map at init genesis
+	binaryDownloader   *binaryDownloader               // pre-downloads the binary of the scheduled upgrade, nil if disabled
+	stakingKeeper      types.StakingKeeper             // tallies the voting power signalling an upgrade, nil if not supported
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -234,6 +236,10 @@ func (k Keeper) ScheduleUpgrade(ctx context.Context, plan types.Plan) error {
 		return err
 	}
 
+	if err := k.validateSignalThreshold(plan); err != nil {
+		return err
+	}
+
 	// NOTE: allow for the possibility of chains to schedule upgrades in begin block of the same block
 	// as a strategy for emergency hard fork recoveries
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -264,6 +270,14 @@ func (k Keeper) ScheduleUpgrade(ctx context.Context, plan types.Plan) error {
 		if err != nil {
 			return err
 		}
+
+		// the signals are only kept if the same upgrade is rescheduled
+		if oldPlan.Name != plan.Name {
+			err = k.clearSignals(ctx)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	bz, err := k.cdc.Marshal(&plan)
@@ -393,7 +407,8 @@ func (k Keeper) ClearIBCState(ctx context.Context, lastHeight int64) error {
 	return store.Delete(types.UpgradedConsStateKey(lastHeight))
 }
 
-// ClearUpgradePlan clears any schedule upgrade and associated IBC states.
+// ClearUpgradePlan clears any schedule upgrade and associated IBC states and
+// signals.
 func (k Keeper) ClearUpgradePlan(ctx context.Context) error {
 	// clear IBC states every time upgrade plan is removed
 	oldPlan, err := k.GetUpgradePlan(ctx)
@@ -410,6 +425,11 @@ func (k Keeper) ClearUpgradePlan(ctx context.Context) error {
 		return err
 	}
 
+	err = k.clearSignals(ctx)
+	if err != nil {
+		return err
+	}
+
 	store := k.storeService.OpenKVStore(ctx)
 	return store.Delete(types.PlanKey())
 }
@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/x/upgrade/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -57,3 +58,17 @@ func (k msgServer) CancelUpgrade(ctx context.Context, msg *types.MsgCancelUpgrad
 
 	return &types.MsgCancelUpgradeResponse{}, nil
 }
+
+// SignalUpgrade implements the Msg/SignalUpgrade Msg service.
+func (k msgServer) SignalUpgrade(ctx context.Context, msg *types.MsgSignalUpgrade) (*types.MsgSignalUpgradeResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if err := k.Keeper.SignalUpgrade(ctx, valAddr, msg.Name); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSignalUpgradeResponse{}, nil
+}
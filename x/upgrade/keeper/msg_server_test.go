@@ -137,3 +137,71 @@ func (s *KeeperTestSuite) TestCancelUpgrade() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestSignalUpgradeMsg() {
+	vals := s.setupSignals()
+	err := s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{
+		Name:            "some name",
+		Height:          123450000,
+		SignalThreshold: "0.667",
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgSignalUpgrade
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			"invalid validator address",
+			&types.MsgSignalUpgrade{
+				ValidatorAddress: s.addrs[0].String(),
+				Name:             "some name",
+			},
+			true,
+			"invalid validator address",
+		},
+		{
+			"unknown validator",
+			&types.MsgSignalUpgrade{
+				ValidatorAddress: sdk.ValAddress(s.addrs[0]).String(),
+				Name:             "some name",
+			},
+			true,
+			"validator does not exist",
+		},
+		{
+			"upgrade not scheduled",
+			&types.MsgSignalUpgrade{
+				ValidatorAddress: vals[0].String(),
+				Name:             "other name",
+			},
+			true,
+			"invalid upgrade signal",
+		},
+		{
+			"upgrade signalled successfully",
+			&types.MsgSignalUpgrade{
+				ValidatorAddress: vals[0].String(),
+				Name:             "some name",
+			},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgSrvr.SignalUpgrade(s.ctx, tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+				validators, err := s.upgradeKeeper.GetSignals(s.ctx, tc.req.Name)
+				s.Require().NoError(err)
+				s.Require().Contains(validators, vals[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetStakingKeeper sets the staking keeper used to tally the voting power of
+// the validators signalling their readiness for an upgrade. Upgrade plans with
+// a signal threshold cannot be scheduled without it.
+func (k *Keeper) SetStakingKeeper(sk types.StakingKeeper) {
+	k.stakingKeeper = sk
+}
+
+// SignalUpgrade records the readiness of the given validator for the scheduled
+// upgrade plan with the given name.
+func (k Keeper) SignalUpgrade(ctx context.Context, valAddr sdk.ValAddress, name string) error {
+	if k.stakingKeeper == nil {
+		return types.ErrInvalidSignal.Wrap("upgrade signalling is not supported")
+	}
+
+	plan, err := k.GetUpgradePlan(ctx)
+	if err != nil {
+		if errors.Is(err, types.ErrNoUpgradePlanFound) {
+			return types.ErrInvalidSignal.Wrapf("upgrade %s is not scheduled", name)
+		}
+
+		return err
+	}
+
+	if plan.Name != name {
+		return types.ErrInvalidSignal.Wrapf("upgrade %s is not scheduled, got %s", plan.Name, name)
+	}
+
+	if k.stakingKeeper.Validator(sdk.UnwrapSDKContext(ctx), valAddr) == nil {
+		return stakingtypes.ErrNoValidatorFound
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.SignalKey(valAddr), []byte(name))
+}
+
+// GetSignals returns the validators which signalled their readiness for the
+// upgrade plan with the given name.
+func (k Keeper) GetSignals(ctx context.Context, name string) ([]sdk.ValAddress, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	prefix := []byte{types.SignalByte}
+	it, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var validators []sdk.ValAddress
+	for ; it.Valid(); it.Next() {
+		if string(it.Value()) != name {
+			continue
+		}
+
+		// the key is made of the prefix, the address length and the address
+		validators = append(validators, sdk.ValAddress(it.Key()[2:]))
+	}
+
+	return validators, nil
+}
+
+// clearSignals removes the signals of all the validators.
+func (k Keeper) clearSignals(ctx context.Context) error {
+	store := k.storeService.OpenKVStore(ctx)
+	prefix := []byte{types.SignalByte}
+	it, err := store.Iterator(prefix, storetypes.PrefixEndBytes(prefix))
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TallySignals returns the voting power of the bonded validators which
+// signalled their readiness for the given plan along with the total bonded
+// voting power.
+func (k Keeper) TallySignals(ctx context.Context, plan types.Plan) (signalled, total int64, err error) {
+	if k.stakingKeeper == nil {
+		return 0, 0, nil
+	}
+
+	validators, err := k.GetSignals(ctx, plan.Name)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, valAddr := range validators {
+		signalled += k.stakingKeeper.GetLastValidatorPower(sdkCtx, valAddr)
+	}
+
+	return signalled, k.stakingKeeper.GetLastTotalPower(sdkCtx).Int64(), nil
+}
+
+// IsSignalThresholdReached returns true if the given plan has no signal
+// threshold or if the voting power of the validators which signalled their
+// readiness for it exceeds the threshold.
+func (k Keeper) IsSignalThresholdReached(ctx context.Context, plan types.Plan) (bool, error) {
+	if plan.SignalThreshold == "" {
+		return true, nil
+	}
+
+	threshold, err := math.LegacyNewDecFromStr(plan.SignalThreshold)
+	if err != nil {
+		return false, err
+	}
+
+	signalled, total, err := k.TallySignals(ctx, plan)
+	if err != nil || total <= 0 {
+		return false, err
+	}
+
+	return math.LegacyNewDec(signalled).QuoInt64(total).GT(threshold), nil
+}
+
+// ShouldExecute returns true if the given plan is ready to execute at the
+// current block height, i.e. its height is reached and, if it has a signal
+// threshold, enough voting power signalled its readiness for it.
+func (k Keeper) ShouldExecute(ctx context.Context, plan types.Plan) (bool, error) {
+	if !plan.ShouldExecute(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height) {
+		return false, nil
+	}
+
+	return k.IsSignalThresholdReached(ctx, plan)
+}
+
+// validateSignalThreshold returns an error if the signal threshold of the given
+// plan cannot be tallied.
+func (k Keeper) validateSignalThreshold(plan types.Plan) error {
+	if plan.SignalThreshold != "" && k.stakingKeeper == nil {
+		return errorsmod.Wrap(types.ErrInvalidSignal, "upgrade signalling is not supported, the signal threshold must be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package keeper_test
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// fakeStakingKeeper is a staking keeper whose validators are the operators
+// with a voting power.
+type fakeStakingKeeper struct {
+	powers    map[string]int64
+	operators map[string]sdk.ValAddress // by consensus address
+}
+
+func (sk fakeStakingKeeper) Validator(_ sdk.Context, addr sdk.ValAddress) stakingtypes.ValidatorI {
+	if _, ok := sk.powers[addr.String()]; !ok {
+		return nil
+	}
+
+	return stakingtypes.Validator{OperatorAddress: addr.String()}
+}
+
+func (sk fakeStakingKeeper) ValidatorByConsAddr(ctx sdk.Context, addr sdk.ConsAddress) stakingtypes.ValidatorI {
+	operator, ok := sk.operators[addr.String()]
+	if !ok {
+		return nil
+	}
+
+	return sk.Validator(ctx, operator)
+}
+
+func (sk fakeStakingKeeper) GetLastValidatorPower(_ sdk.Context, operator sdk.ValAddress) int64 {
+	return sk.powers[operator.String()]
+}
+
+func (sk fakeStakingKeeper) GetLastTotalPower(_ sdk.Context) math.Int {
+	var total int64
+	for _, power := range sk.powers {
+		total += power
+	}
+
+	return math.NewInt(total)
+}
+
+// setupSignals sets a staking keeper with three validators of power 10, 20 and
+// 30 and returns their operator addresses.
+func (s *KeeperTestSuite) setupSignals() []sdk.ValAddress {
+	vals := []sdk.ValAddress{
+		sdk.ValAddress([]byte("val1________________")),
+		sdk.ValAddress([]byte("val2________________")),
+		sdk.ValAddress([]byte("val3________________")),
+	}
+
+	sk := fakeStakingKeeper{powers: map[string]int64{}, operators: map[string]sdk.ValAddress{}}
+	for i, val := range vals {
+		sk.powers[val.String()] = int64(10 * (i + 1))
+		sk.operators[sdk.ConsAddress(val).String()] = val
+	}
+	s.upgradeKeeper.SetStakingKeeper(sk)
+
+	return vals
+}
+
+func (s *KeeperTestSuite) TestScheduleUpgradeSignalThreshold() {
+	plan := types.Plan{Name: "signalled", Height: 123, SignalThreshold: "0.5"}
+
+	// the threshold cannot be tallied without a staking keeper
+	err := s.upgradeKeeper.ScheduleUpgrade(s.ctx, plan)
+	s.Require().ErrorIs(err, types.ErrInvalidSignal)
+
+	s.setupSignals()
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, plan))
+}
+
+func (s *KeeperTestSuite) TestSignalUpgrade() {
+	vals := s.setupSignals()
+
+	// no plan is scheduled
+	err := s.upgradeKeeper.SignalUpgrade(s.ctx, vals[0], "signalled")
+	s.Require().ErrorIs(err, types.ErrInvalidSignal)
+
+	plan := types.Plan{Name: "signalled", Height: 123, SignalThreshold: "0.5"}
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, plan))
+
+	// the plan name must match the scheduled one
+	err = s.upgradeKeeper.SignalUpgrade(s.ctx, vals[0], "other")
+	s.Require().ErrorIs(err, types.ErrInvalidSignal)
+
+	// the signer must be a validator
+	err = s.upgradeKeeper.SignalUpgrade(s.ctx, sdk.ValAddress(s.addrs[0]), "signalled")
+	s.Require().ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+
+	s.Require().NoError(s.upgradeKeeper.SignalUpgrade(s.ctx, vals[0], "signalled"))
+	s.Require().NoError(s.upgradeKeeper.SignalUpgrade(s.ctx, vals[1], "signalled"))
+	// signalling twice is a no-op
+	s.Require().NoError(s.upgradeKeeper.SignalUpgrade(s.ctx, vals[1], "signalled"))
+
+	validators, err := s.upgradeKeeper.GetSignals(s.ctx, "signalled")
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]sdk.ValAddress{vals[0], vals[1]}, validators)
+
+	signalled, total, err := s.upgradeKeeper.TallySignals(s.ctx, plan)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(30), signalled)
+	s.Require().Equal(int64(60), total)
+
+	// the signalled power must exceed the threshold
+	reached, err := s.upgradeKeeper.IsSignalThresholdReached(s.ctx, plan)
+	s.Require().NoError(err)
+	s.Require().False(reached)
+
+	s.Require().NoError(s.upgradeKeeper.SignalUpgrade(s.ctx, vals[2], "signalled"))
+	reached, err = s.upgradeKeeper.IsSignalThresholdReached(s.ctx, plan)
+	s.Require().NoError(err)
+	s.Require().True(reached)
+
+	// the plan is only ready once its height is reached
+	ready, err := s.upgradeKeeper.ShouldExecute(s.ctx, plan)
+	s.Require().NoError(err)
+	s.Require().False(ready)
+
+	ready, err = s.upgradeKeeper.ShouldExecute(s.ctx.WithHeaderInfo(header.Info{Height: 123}), plan)
+	s.Require().NoError(err)
+	s.Require().True(ready)
+}
+
+func (s *KeeperTestSuite) TestClearSignals() {
+	vals := s.setupSignals()
+
+	plan := types.Plan{Name: "signalled", Height: 123, SignalThreshold: "0.5"}
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, plan))
+	s.Require().NoError(s.upgradeKeeper.SignalUpgrade(s.ctx, vals[0], "signalled"))
+
+	// the signals are kept when the same upgrade is rescheduled
+	plan.Height = 456
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, plan))
+	validators, err := s.upgradeKeeper.GetSignals(s.ctx, "signalled")
+	s.Require().NoError(err)
+	s.Require().Len(validators, 1)
+
+	// the signals are cleared when another upgrade is scheduled
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "other", Height: 123}))
+	validators, err = s.upgradeKeeper.GetSignals(s.ctx, "signalled")
+	s.Require().NoError(err)
+	s.Require().Empty(validators)
+
+	// the signals are cleared with the plan
+	s.Require().NoError(s.upgradeKeeper.SignalUpgrade(s.ctx, vals[0], "other"))
+	s.Require().NoError(s.upgradeKeeper.ClearUpgradePlan(s.ctx))
+	validators, err = s.upgradeKeeper.GetSignals(s.ctx, "other")
+	s.Require().NoError(err)
+	s.Require().Empty(validators)
+}
+
+func (s *KeeperTestSuite) TestSubmitVoteExtensionSignals() {
+	vals := s.setupSignals()
+
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "signalled", Height: 123, SignalThreshold: "0.5"}))
+
+	signal := func(name string) []byte {
+		bz, err := s.encCfg.Codec.Marshal(&types.SignalVoteExtension{Name: name})
+		s.Require().NoError(err)
+		return bz
+	}
+
+	extCommit := abci.ExtendedCommitInfo{
+		Votes: []abci.ExtendedVoteInfo{
+			{
+				Validator:     abci.Validator{Address: sdk.ConsAddress(vals[0])},
+				VoteExtension: signal("signalled"),
+				BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+			},
+			{
+				// the signal of another plan is ignored
+				Validator:     abci.Validator{Address: sdk.ConsAddress(vals[1])},
+				VoteExtension: signal("other"),
+				BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+			},
+			{
+				// the vote extensions of absent validators are ignored
+				Validator:     abci.Validator{Address: sdk.ConsAddress(vals[2])},
+				VoteExtension: signal("signalled"),
+				BlockIdFlag:   cmtproto.BlockIDFlagAbsent,
+			},
+			{
+				// invalid vote extensions are ignored
+				Validator:     abci.Validator{Address: sdk.ConsAddress(vals[2])},
+				VoteExtension: []byte("invalid"),
+				BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+			},
+		},
+	}
+
+	s.Require().NoError(s.upgradeKeeper.SubmitVoteExtensionSignals(s.ctx, extCommit))
+
+	validators, err := s.upgradeKeeper.GetSignals(s.ctx, "signalled")
+	s.Require().NoError(err)
+	s.Require().Equal([]sdk.ValAddress{vals[0]}, validators)
+}
+
+func (s *KeeperTestSuite) TestSignalTally() {
+	vals := s.setupSignals()
+
+	// no plan is scheduled
+	res, err := s.upgradeKeeper.SignalTally(s.ctx, &types.QuerySignalTallyRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.QuerySignalTallyResponse{}, res)
+
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "signalled", Height: 123, SignalThreshold: "0.5"}))
+	s.Require().NoError(s.upgradeKeeper.SignalUpgrade(s.ctx, vals[2], "signalled"))
+
+	res, err = s.upgradeKeeper.SignalTally(s.ctx, &types.QuerySignalTallyRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(&types.QuerySignalTallyResponse{
+		PlanName:         "signalled",
+		SignalThreshold:  "0.5",
+		SignalledPower:   30,
+		TotalPower:       60,
+		ThresholdReached: false,
+		Validators:       []string{vals[2].String()},
+	}, res)
+}
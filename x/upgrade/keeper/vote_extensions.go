@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ReadyFunc returns true if the node is ready for the given upgrade plan.
+type ReadyFunc func(ctx sdk.Context, plan types.Plan) bool
+
+// VoteExtensionHandler implements the ABCI handlers allowing validators to
+// signal their readiness for the scheduled upgrade plan in their vote
+// extensions.
+//
+// Validators include the name of the scheduled plan in their vote extensions
+// once they are ready for it, and PreBlock records the signals included in the
+// vote extensions injected in the block. The handler is a
+// baseapp.VoteExtensionModule, registered in the baseapp.VoteExtensionMux of the
+// application which injects the vote extensions in the proposals.
+type VoteExtensionHandler struct {
+	keeper *Keeper
+	ready  ReadyFunc
+}
+
+var _ baseapp.VoteExtensionModule = (*VoteExtensionHandler)(nil)
+
+// NewVoteExtensionHandler creates a new VoteExtensionHandler. If ready is nil,
+// the node is ready for a plan once its binary is pre-downloaded and verified,
+// see Keeper.SetBinaryPreDownload.
+func NewVoteExtensionHandler(keeper *Keeper, ready ReadyFunc) *VoteExtensionHandler {
+	if ready == nil {
+		ready = func(_ sdk.Context, plan types.Plan) bool {
+			status, _, _ := keeper.GetBinaryStatus(plan)
+			return status == types.BinaryStatusVerified
+		}
+	}
+
+	return &VoteExtensionHandler{
+		keeper: keeper,
+		ready:  ready,
+	}
+}
+
+// ExtendVoteHandler returns an ExtendVoteHandler signalling the readiness for
+// the scheduled upgrade plan once the node is ready for it.
+func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, _ *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		plan, err := h.keeper.GetUpgradePlan(ctx)
+		if err != nil {
+			if errors.Is(err, types.ErrNoUpgradePlanFound) {
+				return &abci.ResponseExtendVote{}, nil
+			}
+
+			return nil, err
+		}
+
+		if !h.ready(ctx, plan) {
+			return &abci.ResponseExtendVote{}, nil
+		}
+
+		bz, err := h.keeper.cdc.Marshal(&types.SignalVoteExtension{Name: plan.Name})
+		if err != nil {
+			return nil, err
+		}
+
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns a VerifyVoteExtensionHandler rejecting the
+// vote extensions which cannot be decoded.
+func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if len(req.VoteExtension) == 0 {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+		}
+
+		if _, err := h.keeper.decodeVoteExtension(req.VoteExtension); err != nil {
+			h.keeper.Logger(ctx).Info("rejected vote extension", "validator", fmt.Sprintf("%X", req.ValidatorAddress), "err", err)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+// PreBlock records the signals included in the vote extensions injected in the
+// block.
+func (h *VoteExtensionHandler) PreBlock(ctx sdk.Context, extCommit abci.ExtendedCommitInfo) error {
+	return h.keeper.SubmitVoteExtensionSignals(ctx, extCommit)
+}
+
+// SubmitVoteExtensionSignals records the signals included in the vote
+// extensions of the given extended commit info. Invalid vote extensions and
+// signals, e.g. for a plan which is not scheduled anymore, are ignored.
+func (k Keeper) SubmitVoteExtensionSignals(ctx context.Context, extCommit abci.ExtendedCommitInfo) error {
+	if k.stakingKeeper == nil {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	logger := k.Logger(ctx)
+
+	for _, vote := range extCommit.Votes {
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		ve, err := k.decodeVoteExtension(vote.VoteExtension)
+		if err != nil {
+			logger.Info("ignored vote extension", "validator", fmt.Sprintf("%X", vote.Validator.Address), "err", err)
+			continue
+		}
+
+		val := k.stakingKeeper.ValidatorByConsAddr(sdkCtx, vote.Validator.Address)
+		if val == nil {
+			logger.Info("ignored vote extension of unknown validator", "validator", fmt.Sprintf("%X", vote.Validator.Address))
+			continue
+		}
+
+		if err := k.SignalUpgrade(ctx, val.GetOperator(), ve.Name); err != nil {
+			logger.Info("ignored vote extension signal", "validator", fmt.Sprintf("%X", vote.Validator.Address), "err", err)
+		}
+	}
+
+	return nil
+}
+
+// decodeVoteExtension decodes the given vote extension.
+func (k Keeper) decodeVoteExtension(bz []byte) (types.SignalVoteExtension, error) {
+	var ve types.SignalVoteExtension
+	if err := k.cdc.Unmarshal(bz, &ve); err != nil {
+		return ve, types.ErrInvalidSignal.Wrap(err.Error())
+	}
+
+	if ve.Name == "" {
+		return ve, types.ErrInvalidSignal.Wrap("name cannot be empty")
+	}
+
+	return ve, nil
+}
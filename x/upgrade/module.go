@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -188,7 +190,8 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	AddressCodec address.Codec
 
-	AppOpts servertypes.AppOptions `optional:"true"`
+	AppOpts       servertypes.AppOptions `optional:"true"`
+	StakingKeeper types.StakingKeeper    `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -203,6 +206,7 @@ type ModuleOutputs struct {
 func ProvideModule(in ModuleInputs) ModuleOutputs {
 	var (
 		homePath           string
+		preDownloadBlocks  int64
 		skipUpgradeHeights = make(map[int64]bool)
 	)
 
@@ -212,6 +216,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		}
 
 		homePath = cast.ToString(in.AppOpts.Get(flags.FlagHome))
+		preDownloadBlocks = cast.ToInt64(in.AppOpts.Get(server.FlagUpgradePreDownloadBlocks))
 	}
 
 	// default to governance authority if not provided
@@ -222,6 +227,11 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 
 	// set the governance module account as the authority for conducting upgrades
 	k := keeper.NewKeeper(skipUpgradeHeights, in.StoreService, in.Cdc, homePath, nil, authority.String())
+	// the binary is downloaded under the name of the running executable
+	k.SetBinaryPreDownload(preDownloadBlocks, filepath.Base(os.Args[0]))
+	if in.StakingKeeper != nil {
+		k.SetStakingKeeper(in.StakingKeeper)
+	}
 	baseappOpt := func(app *baseapp.BaseApp) {
 		k.SetVersionSetter(app)
 	}
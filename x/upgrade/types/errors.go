@@ -14,4 +14,6 @@ var (
 	ErrNoUpgradedClientFound = errors.Register(ModuleName, 4, "upgraded client not found")
 	// ErrNoUpgradedConsensusStateFound error if there is no upgraded consensus state for the next version
 	ErrNoUpgradedConsensusStateFound = errors.Register(ModuleName, 5, "upgraded consensus state not found")
+	// ErrInvalidSignal error if a validator signals its readiness for an upgrade which is not scheduled
+	ErrInvalidSignal = errors.Register(ModuleName, 6, "invalid upgrade signal")
 )
@@ -0,0 +1,17 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingKeeper defines the expected staking keeper used to tally the voting
+// power of the validators signalling their readiness for an upgrade.
+type StakingKeeper interface {
+	Validator(ctx sdk.Context, addr sdk.ValAddress) stakingtypes.ValidatorI
+	ValidatorByConsAddr(ctx sdk.Context, addr sdk.ConsAddress) stakingtypes.ValidatorI
+	GetLastValidatorPower(ctx sdk.Context, operator sdk.ValAddress) int64
+	GetLastTotalPower(ctx sdk.Context) math.Int
+}
@@ -1,6 +1,11 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName is the name of this module
@@ -26,6 +31,10 @@ const (
 	// ProtocolVersionByte is a prefix to look up Protocol Version
 	ProtocolVersionByte = 0x3
 
+	// SignalByte is a prefix to look up the validators (key) which signalled
+	// their readiness for an upgrade plan (value)
+	SignalByte = 0x4
+
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
 
@@ -42,6 +51,12 @@ func PlanKey() []byte {
 	return []byte{PlanByte}
 }
 
+// SignalKey is the key under which the name of the upgrade plan the given
+// validator signalled its readiness for is saved
+func SignalKey(valAddr sdk.ValAddress) []byte {
+	return append([]byte{SignalByte}, address.MustLengthPrefix(valAddr)...)
+}
+
 // UpgradedClientKey is the key under which the upgraded client state is saved
 // Connecting IBC chains can verify against the upgraded client in this path before
 // upgrading their clients
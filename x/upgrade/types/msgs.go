@@ -4,7 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _, _ sdk.Msg = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}
+var _, _, _ sdk.Msg = &MsgSoftwareUpgrade{}, &MsgCancelUpgrade{}, &MsgSignalUpgrade{}
 
 // GetSigners returns the expected signers for MsgSoftwareUpgrade.
 func (m *MsgSoftwareUpgrade) GetSigners() []sdk.AccAddress {
@@ -17,3 +17,9 @@ func (m *MsgCancelUpgrade) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// GetSigners returns the expected signers for MsgSignalUpgrade.
+func (m *MsgSignalUpgrade) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(m.ValidatorAddress)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -25,6 +26,15 @@ func (p Plan) ValidateBasic() error {
 	if p.Height <= 0 {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "height must be greater than 0")
 	}
+	if p.SignalThreshold != "" {
+		threshold, err := math.LegacyNewDecFromStr(p.SignalThreshold)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid signal threshold: %s", err)
+		}
+		if !threshold.IsPositive() || threshold.GTE(math.LegacyOneDec()) {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "signal threshold must be positive and less than 1")
+		}
+	}
 
 	return nil
 }
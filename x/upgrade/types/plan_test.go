@@ -90,6 +90,28 @@ func TestPlanValid(t *testing.T) {
 				Height: -12345,
 			},
 		},
+		"proper signal threshold": {
+			p: types.Plan{
+				Name:            "signalled",
+				Height:          123450000,
+				SignalThreshold: "0.667",
+			},
+			valid: true,
+		},
+		"invalid signal threshold": {
+			p: types.Plan{
+				Name:            "signalled",
+				Height:          123450000,
+				SignalThreshold: "two thirds",
+			},
+		},
+		"signal threshold of one": {
+			p: types.Plan{
+				Name:            "signalled",
+				Height:          123450000,
+				SignalThreshold: "1",
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -492,6 +493,268 @@ func (m *QueryAuthorityResponse) GetAddress() string {
 	return ""
 }
 
+// QueryBinaryStatusRequest is the request type for the Query/BinaryStatus RPC
+// method.
+type QueryBinaryStatusRequest struct {
+}
+
+func (m *QueryBinaryStatusRequest) Reset()         { *m = QueryBinaryStatusRequest{} }
+func (m *QueryBinaryStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBinaryStatusRequest) ProtoMessage()    {}
+func (*QueryBinaryStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
+}
+func (m *QueryBinaryStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBinaryStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBinaryStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBinaryStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBinaryStatusRequest.Merge(m, src)
+}
+func (m *QueryBinaryStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBinaryStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBinaryStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBinaryStatusRequest proto.InternalMessageInfo
+
+// QueryBinaryStatusResponse is the response type for the Query/BinaryStatus RPC
+// method.
+type QueryBinaryStatusResponse struct {
+	// enabled is true if the node pre-downloads the upgrade binaries.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// plan_name is the name of the upgrade plan the binary is downloaded for.
+	PlanName string `protobuf:"bytes,2,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	// plan_height is the height of the upgrade plan the binary is downloaded for.
+	PlanHeight int64 `protobuf:"varint,3,opt,name=plan_height,json=planHeight,proto3" json:"plan_height,omitempty"`
+	// status is the status of the download.
+	Status BinaryStatus `protobuf:"varint,4,opt,name=status,proto3,enum=cosmos.upgrade.v1beta1.BinaryStatus" json:"status,omitempty"`
+	// path is the path of the verified binary.
+	Path string `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	// error is the error of the last failed download.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *QueryBinaryStatusResponse) Reset()         { *m = QueryBinaryStatusResponse{} }
+func (m *QueryBinaryStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBinaryStatusResponse) ProtoMessage()    {}
+func (*QueryBinaryStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
+}
+func (m *QueryBinaryStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBinaryStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBinaryStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBinaryStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBinaryStatusResponse.Merge(m, src)
+}
+func (m *QueryBinaryStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBinaryStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBinaryStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBinaryStatusResponse proto.InternalMessageInfo
+
+func (m *QueryBinaryStatusResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *QueryBinaryStatusResponse) GetPlanName() string {
+	if m != nil {
+		return m.PlanName
+	}
+	return ""
+}
+
+func (m *QueryBinaryStatusResponse) GetPlanHeight() int64 {
+	if m != nil {
+		return m.PlanHeight
+	}
+	return 0
+}
+
+func (m *QueryBinaryStatusResponse) GetStatus() BinaryStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BinaryStatusUnspecified
+}
+
+func (m *QueryBinaryStatusResponse) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *QueryBinaryStatusResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// QuerySignalTallyRequest is the request type for the Query/SignalTally RPC
+// method.
+type QuerySignalTallyRequest struct {
+}
+
+func (m *QuerySignalTallyRequest) Reset()         { *m = QuerySignalTallyRequest{} }
+func (m *QuerySignalTallyRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalTallyRequest) ProtoMessage()    {}
+func (*QuerySignalTallyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{12}
+}
+func (m *QuerySignalTallyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignalTallyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignalTallyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignalTallyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalTallyRequest.Merge(m, src)
+}
+func (m *QuerySignalTallyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignalTallyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalTallyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignalTallyRequest proto.InternalMessageInfo
+
+// QuerySignalTallyResponse is the response type for the Query/SignalTally RPC
+// method.
+type QuerySignalTallyResponse struct {
+	// plan_name is the name of the current upgrade plan.
+	PlanName string `protobuf:"bytes,1,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	// signal_threshold is the signal threshold of the current upgrade plan.
+	SignalThreshold string `protobuf:"bytes,2,opt,name=signal_threshold,json=signalThreshold,proto3" json:"signal_threshold,omitempty"`
+	// signalled_power is the voting power of the bonded validators which
+	// signalled their readiness.
+	SignalledPower int64 `protobuf:"varint,3,opt,name=signalled_power,json=signalledPower,proto3" json:"signalled_power,omitempty"`
+	// total_power is the total voting power of the bonded validators.
+	TotalPower int64 `protobuf:"varint,4,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// threshold_reached is true if the signalled voting power exceeds the
+	// signal threshold.
+	ThresholdReached bool `protobuf:"varint,5,opt,name=threshold_reached,json=thresholdReached,proto3" json:"threshold_reached,omitempty"`
+	// validators is the list of the validators which signalled their readiness.
+	Validators []string `protobuf:"bytes,6,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *QuerySignalTallyResponse) Reset()         { *m = QuerySignalTallyResponse{} }
+func (m *QuerySignalTallyResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySignalTallyResponse) ProtoMessage()    {}
+func (*QuerySignalTallyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{13}
+}
+func (m *QuerySignalTallyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySignalTallyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySignalTallyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySignalTallyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySignalTallyResponse.Merge(m, src)
+}
+func (m *QuerySignalTallyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySignalTallyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySignalTallyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySignalTallyResponse proto.InternalMessageInfo
+
+func (m *QuerySignalTallyResponse) GetPlanName() string {
+	if m != nil {
+		return m.PlanName
+	}
+	return ""
+}
+
+func (m *QuerySignalTallyResponse) GetSignalThreshold() string {
+	if m != nil {
+		return m.SignalThreshold
+	}
+	return ""
+}
+
+func (m *QuerySignalTallyResponse) GetSignalledPower() int64 {
+	if m != nil {
+		return m.SignalledPower
+	}
+	return 0
+}
+
+func (m *QuerySignalTallyResponse) GetTotalPower() int64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
+func (m *QuerySignalTallyResponse) GetThresholdReached() bool {
+	if m != nil {
+		return m.ThresholdReached
+	}
+	return false
+}
+
+func (m *QuerySignalTallyResponse) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -503,6 +766,10 @@ func init() {
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
 	proto.RegisterType((*QueryAuthorityRequest)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityRequest")
 	proto.RegisterType((*QueryAuthorityResponse)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityResponse")
+	proto.RegisterType((*QueryBinaryStatusRequest)(nil), "cosmos.upgrade.v1beta1.QueryBinaryStatusRequest")
+	proto.RegisterType((*QueryBinaryStatusResponse)(nil), "cosmos.upgrade.v1beta1.QueryBinaryStatusResponse")
+	proto.RegisterType((*QuerySignalTallyRequest)(nil), "cosmos.upgrade.v1beta1.QuerySignalTallyRequest")
+	proto.RegisterType((*QuerySignalTallyResponse)(nil), "cosmos.upgrade.v1beta1.QuerySignalTallyResponse")
 }
 
 func init() {
@@ -510,47 +777,67 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 632 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x4f, 0xd4, 0x40,
-	0x14, 0x66, 0x16, 0x44, 0x79, 0x6b, 0xd0, 0x4c, 0xe2, 0x52, 0x2b, 0x59, 0x71, 0x40, 0x85, 0x28,
-	0x1d, 0x58, 0x12, 0x63, 0x30, 0x1a, 0x95, 0x8b, 0x18, 0x25, 0xba, 0x46, 0x0f, 0x5e, 0x36, 0x03,
-	0x9d, 0x2c, 0x8d, 0xdd, 0x4e, 0xe9, 0x4c, 0x89, 0x84, 0x70, 0xf1, 0xe4, 0xd1, 0xc4, 0x78, 0xf5,
-	0xe6, 0xc5, 0x5f, 0xe2, 0x91, 0xc4, 0x8b, 0x07, 0x0f, 0x06, 0xfc, 0x09, 0xfe, 0x00, 0xd3, 0xe9,
-	0x94, 0x74, 0xa1, 0x2d, 0xe8, 0x6d, 0x3b, 0xef, 0xfb, 0xbe, 0xf7, 0xbd, 0xce, 0xf7, 0xb6, 0x40,
-	0xd6, 0x84, 0xec, 0x09, 0x49, 0xe3, 0xb0, 0x1b, 0x31, 0x97, 0xd3, 0xcd, 0xf9, 0x55, 0xae, 0xd8,
-	0x3c, 0xdd, 0x88, 0x79, 0xb4, 0xe5, 0x84, 0x91, 0x50, 0x02, 0x37, 0x52, 0x8c, 0x63, 0x30, 0x8e,
-	0xc1, 0xd8, 0xe3, 0x5d, 0x21, 0xba, 0x3e, 0xa7, 0x2c, 0xf4, 0x28, 0x0b, 0x02, 0xa1, 0x98, 0xf2,
-	0x44, 0x20, 0x53, 0x96, 0x3d, 0x55, 0xa2, 0x9c, 0xa9, 0x68, 0x14, 0xb9, 0x08, 0x63, 0xcf, 0x93,
-	0x56, 0x4b, 0x71, 0x14, 0xf1, 0x40, 0x3d, 0xf3, 0x59, 0xd0, 0xe6, 0x1b, 0x31, 0x97, 0x8a, 0x3c,
-	0x01, 0xeb, 0x68, 0x49, 0x86, 0x22, 0x90, 0x1c, 0xcf, 0xc1, 0x50, 0xe8, 0xb3, 0xc0, 0x42, 0x13,
-	0x68, 0xba, 0xde, 0x1a, 0x77, 0x8a, 0x1d, 0x3a, 0x9a, 0xa3, 0x91, 0x64, 0xd6, 0x34, 0x7a, 0x10,
-	0x86, 0xbe, 0xc7, 0xdd, 0x5c, 0x23, 0x8c, 0x61, 0x28, 0x60, 0x3d, 0xae, 0xc5, 0x46, 0xda, 0xfa,
-	0x37, 0x69, 0x99, 0xe6, 0x7d, 0x70, 0xd3, 0xbc, 0x01, 0xc3, 0xeb, 0xdc, 0xeb, 0xae, 0x2b, 0xcd,
-	0x18, 0x6c, 0x9b, 0x27, 0xb2, 0x0c, 0x44, 0x73, 0x5e, 0xa6, 0x2e, 0xdc, 0xa5, 0x04, 0x1d, 0xc8,
-	0x58, 0xbe, 0x50, 0x4c, 0xf1, 0xac, 0xdb, 0x65, 0xa8, 0xfb, 0x4c, 0xaa, 0x4e, 0x9f, 0x04, 0x24,
-	0x47, 0x8f, 0xf4, 0xc9, 0x62, 0xcd, 0x42, 0xc4, 0x83, 0xc9, 0x4a, 0x29, 0xe3, 0xe4, 0x36, 0x58,
-	0x66, 0x64, 0xb7, 0xb3, 0x96, 0x41, 0x3a, 0x32, 0xc1, 0x58, 0xb5, 0x09, 0x34, 0x7d, 0xb6, 0xdd,
-	0x88, 0x0b, 0x15, 0x92, 0x26, 0x8f, 0x87, 0xce, 0xa0, 0xf3, 0x35, 0x72, 0x17, 0x6c, 0xdd, 0xea,
-	0xa9, 0x70, 0x63, 0x9f, 0xbf, 0xe2, 0x91, 0x4c, 0x2e, 0x31, 0xe7, 0xb6, 0xa7, 0x0b, 0x9d, 0xdc,
-	0x2b, 0x82, 0xf4, 0x68, 0x25, 0x79, 0x51, 0x3d, 0xb8, 0x54, 0x48, 0x37, 0x0e, 0x57, 0xe0, 0x9c,
-	0xe1, 0x6f, 0x9a, 0x92, 0x85, 0x26, 0x06, 0xa7, 0xeb, 0xad, 0xab, 0x65, 0x77, 0xd6, 0x27, 0xd4,
-	0x1e, 0xed, 0xf5, 0xe9, 0x92, 0x31, 0xb8, 0x90, 0xde, 0x4b, 0xac, 0xd6, 0x45, 0xe4, 0xa9, 0xad,
-	0x2c, 0x2d, 0x2d, 0x68, 0x1c, 0x2e, 0x18, 0x0b, 0x16, 0x9c, 0x66, 0xae, 0x1b, 0x71, 0x29, 0x8d,
-	0xfd, 0xec, 0xb1, 0xf5, 0x67, 0x18, 0x4e, 0x69, 0x12, 0xfe, 0x8c, 0xa0, 0x9e, 0xcb, 0x19, 0xa6,
-	0x65, 0xee, 0x4a, 0xc2, 0x6a, 0xcf, 0x9d, 0x9c, 0x90, 0xda, 0x22, 0x37, 0xdf, 0x7d, 0xff, 0xfd,
-	0xb1, 0x76, 0x0d, 0x4f, 0xd1, 0x92, 0x45, 0x59, 0x4b, 0x49, 0x9d, 0x24, 0xbe, 0xf8, 0x0b, 0x82,
-	0x7a, 0x2e, 0x8b, 0xc7, 0x18, 0x3c, 0x1a, 0xf2, 0x63, 0x0c, 0x16, 0xc4, 0x9c, 0x2c, 0x68, 0x83,
-	0xb3, 0xf8, 0x46, 0x99, 0x41, 0x96, 0x92, 0xb4, 0x41, 0xba, 0x9d, 0xe4, 0x63, 0x07, 0xff, 0x44,
-	0xd0, 0x28, 0x0e, 0x2d, 0x5e, 0xac, 0x74, 0x50, 0xb9, 0x34, 0xf6, 0x9d, 0xff, 0xe2, 0x9a, 0x41,
-	0x96, 0xf5, 0x20, 0xf7, 0xf1, 0x3d, 0x5a, 0xfd, 0x97, 0x74, 0x64, 0x87, 0xe8, 0x76, 0x6e, 0x53,
-	0x77, 0xde, 0xd7, 0x10, 0xfe, 0x8a, 0x60, 0xb4, 0x3f, 0xe9, 0xb8, 0x55, 0x69, 0xad, 0x70, 0xab,
-	0xec, 0x85, 0x7f, 0xe2, 0x98, 0x31, 0xa8, 0x1e, 0x63, 0x06, 0x5f, 0x2f, 0x1b, 0xe3, 0xd0, 0xa2,
-	0xe1, 0x4f, 0x08, 0x46, 0x0e, 0xd6, 0x01, 0xcf, 0x56, 0x07, 0xe0, 0xd0, 0x3e, 0xd9, 0xce, 0x49,
-	0xe1, 0xc6, 0xdd, 0x8c, 0x76, 0x37, 0x89, 0xaf, 0x94, 0xa6, 0x25, 0xa3, 0x3c, 0xbc, 0xf5, 0x6d,
-	0xaf, 0x89, 0x76, 0xf7, 0x9a, 0xe8, 0xd7, 0x5e, 0x13, 0x7d, 0xd8, 0x6f, 0x0e, 0xec, 0xee, 0x37,
-	0x07, 0x7e, 0xec, 0x37, 0x07, 0x5e, 0x8f, 0xa7, 0x5c, 0xe9, 0xbe, 0x71, 0x3c, 0x41, 0xdf, 0x1e,
-	0x68, 0xa8, 0xad, 0x90, 0xcb, 0xd5, 0x61, 0xfd, 0xc9, 0x58, 0xf8, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x28, 0x77, 0x58, 0xe1, 0xb4, 0x06, 0x00, 0x00,
+	// 949 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4b, 0x6f, 0x1b, 0x55,
+	0x14, 0xce, 0x75, 0x1c, 0x13, 0x1f, 0x57, 0x6e, 0xb8, 0x02, 0x77, 0x32, 0x0d, 0xc6, 0x9d, 0xa6,
+	0xc4, 0x55, 0xb1, 0x27, 0x71, 0x24, 0x04, 0xe5, 0x21, 0x92, 0xb2, 0xa0, 0x08, 0xaa, 0x32, 0x29,
+	0x5d, 0xb0, 0x19, 0xdd, 0x78, 0xae, 0xec, 0x11, 0xe3, 0x99, 0xe9, 0xdc, 0x3b, 0x81, 0xa8, 0xea,
+	0x86, 0x15, 0x4b, 0x24, 0xc4, 0x96, 0x05, 0x12, 0x1b, 0xc4, 0xb2, 0x2b, 0x7e, 0x01, 0xcb, 0xaa,
+	0x6c, 0x90, 0x60, 0x81, 0x12, 0x7e, 0x08, 0xba, 0x8f, 0x31, 0xe3, 0xc7, 0xb8, 0x2e, 0x3b, 0xdf,
+	0x73, 0xce, 0x77, 0xce, 0x77, 0x1e, 0xf3, 0x25, 0x60, 0xf5, 0x23, 0x36, 0x8a, 0x98, 0x9d, 0xc6,
+	0x83, 0x84, 0x78, 0xd4, 0x3e, 0xd9, 0x3b, 0xa6, 0x9c, 0xec, 0xd9, 0x0f, 0x52, 0x9a, 0x9c, 0x76,
+	0xe3, 0x24, 0xe2, 0x11, 0x6e, 0xa8, 0x98, 0xae, 0x8e, 0xe9, 0xea, 0x18, 0x73, 0x6b, 0x10, 0x45,
+	0x83, 0x80, 0xda, 0x24, 0xf6, 0x6d, 0x12, 0x86, 0x11, 0x27, 0xdc, 0x8f, 0x42, 0xa6, 0x50, 0xe6,
+	0x76, 0x41, 0xe6, 0x2c, 0x8b, 0x8a, 0xda, 0x54, 0x51, 0xae, 0x7c, 0xd9, 0xba, 0x90, 0x7c, 0x58,
+	0x9b, 0x70, 0xe9, 0x53, 0xc1, 0xe2, 0x56, 0x9a, 0x24, 0x34, 0xe4, 0x77, 0x03, 0x12, 0x3a, 0xf4,
+	0x41, 0x4a, 0x19, 0xb7, 0x3e, 0x06, 0x63, 0xd6, 0xc5, 0xe2, 0x28, 0x64, 0x14, 0xef, 0x42, 0x39,
+	0x0e, 0x48, 0x68, 0xa0, 0x16, 0x6a, 0xd7, 0x7a, 0x5b, 0xdd, 0xf9, 0xe4, 0xbb, 0x12, 0x23, 0x23,
+	0xad, 0x8e, 0x2e, 0x74, 0x10, 0xc7, 0x81, 0x4f, 0xbd, 0x5c, 0x21, 0x8c, 0xa1, 0x1c, 0x92, 0x11,
+	0x95, 0xc9, 0xaa, 0x8e, 0xfc, 0x6d, 0xf5, 0xc0, 0x98, 0x0d, 0xd7, 0xc5, 0x1b, 0x50, 0x19, 0x52,
+	0x7f, 0x30, 0xe4, 0x12, 0xb1, 0xea, 0xe8, 0x97, 0x75, 0x1b, 0x2c, 0x89, 0xf9, 0x4c, 0xb1, 0xf0,
+	0x6e, 0x89, 0xe8, 0x90, 0xa5, 0xec, 0x88, 0x13, 0x4e, 0xb3, 0x6a, 0xaf, 0x42, 0x2d, 0x20, 0x8c,
+	0xbb, 0x13, 0x29, 0x40, 0x98, 0x3e, 0x94, 0x96, 0x9b, 0x25, 0x03, 0x59, 0x3e, 0x5c, 0x5d, 0x98,
+	0x4a, 0x33, 0x79, 0x13, 0x0c, 0xdd, 0xb2, 0xe7, 0xf6, 0xb3, 0x10, 0x97, 0x89, 0x18, 0xa3, 0xd4,
+	0x42, 0xed, 0x0b, 0x4e, 0x23, 0x9d, 0x9b, 0x41, 0x14, 0xf9, 0xa8, 0xbc, 0x8e, 0x36, 0x4a, 0xd6,
+	0xbb, 0x60, 0xca, 0x52, 0x9f, 0x44, 0x5e, 0x1a, 0xd0, 0xfb, 0x34, 0x61, 0x62, 0xbf, 0x39, 0xb6,
+	0x23, 0xe9, 0x70, 0x73, 0x23, 0x02, 0x65, 0xba, 0x23, 0x06, 0x35, 0x82, 0xcb, 0x73, 0xe1, 0x9a,
+	0xe1, 0x1d, 0xb8, 0xa8, 0xf1, 0x27, 0xda, 0x65, 0xa0, 0xd6, 0x6a, 0xbb, 0xd6, 0xbb, 0x56, 0xb4,
+	0xb3, 0x89, 0x44, 0x4e, 0x7d, 0x34, 0x91, 0xd7, 0xba, 0x04, 0x2f, 0xab, 0xbd, 0xa4, 0x7c, 0x18,
+	0x25, 0x3e, 0x3f, 0xcd, 0xae, 0xa5, 0x07, 0x8d, 0x69, 0x87, 0xa6, 0x60, 0xc0, 0x0b, 0xc4, 0xf3,
+	0x12, 0xca, 0x98, 0xa6, 0x9f, 0x3d, 0x2d, 0x53, 0x2f, 0xf9, 0xd0, 0x0f, 0x49, 0x72, 0x2a, 0x06,
+	0x93, 0x66, 0x8d, 0x5b, 0x7f, 0x22, 0xd8, 0x9c, 0xe3, 0xfc, 0x2f, 0x27, 0x0d, 0xc9, 0x71, 0x40,
+	0x3d, 0x99, 0x73, 0xdd, 0xc9, 0x9e, 0xf8, 0x32, 0x54, 0xc5, 0xbd, 0xa9, 0x71, 0x95, 0x64, 0xbd,
+	0x75, 0x61, 0x10, 0xc3, 0x12, 0xd3, 0x94, 0x4e, 0xbd, 0xfb, 0x55, 0xb5, 0x7b, 0x61, 0x52, 0xbb,
+	0xc7, 0xef, 0x40, 0x85, 0xc9, 0x4a, 0x46, 0xb9, 0x85, 0xda, 0xf5, 0xde, 0x76, 0xd1, 0x94, 0x26,
+	0x58, 0x69, 0x8c, 0x38, 0xe4, 0x98, 0xf0, 0xa1, 0xb1, 0xa6, 0x0e, 0x59, 0xfc, 0xc6, 0x2f, 0xc1,
+	0x1a, 0x4d, 0x92, 0x28, 0x31, 0x2a, 0xd2, 0xa8, 0x1e, 0xe3, 0xcf, 0xee, 0xc8, 0x1f, 0x84, 0x24,
+	0xb8, 0x47, 0x82, 0x60, 0x3c, 0xc8, 0x5f, 0x4a, 0x60, 0xcc, 0xfa, 0x74, 0xdf, 0x13, 0xdd, 0xa1,
+	0xa9, 0xee, 0xde, 0x82, 0x0d, 0x26, 0x31, 0x2e, 0x1f, 0x26, 0x94, 0x0d, 0xa3, 0xc0, 0x53, 0x13,
+	0x38, 0xac, 0x3f, 0x7d, 0xdc, 0x01, 0xdd, 0xc9, 0x07, 0xb4, 0xef, 0x5c, 0x54, 0x71, 0xf7, 0xb2,
+	0x30, 0xbc, 0x03, 0xda, 0x14, 0x50, 0xcf, 0x8d, 0xa3, 0x2f, 0x69, 0xa2, 0x87, 0x53, 0x1f, 0x9b,
+	0xef, 0x0a, 0xab, 0x98, 0x20, 0x8f, 0x38, 0x09, 0x74, 0x50, 0x59, 0x4d, 0x50, 0x9a, 0x54, 0xc0,
+	0x0d, 0x78, 0x71, 0x5c, 0xdd, 0x4d, 0x28, 0xe9, 0x0f, 0xa9, 0x27, 0x07, 0xb2, 0xee, 0x6c, 0x8c,
+	0x1d, 0x8e, 0xb2, 0xe3, 0x03, 0x80, 0x13, 0x12, 0xf8, 0x1e, 0xe1, 0x51, 0xc2, 0x8c, 0x4a, 0x6b,
+	0xb5, 0x5d, 0x3d, 0xbc, 0xf2, 0xf4, 0x71, 0xe7, 0x15, 0xcd, 0xf5, 0x7e, 0xe6, 0x3c, 0x50, 0x17,
+	0x73, 0xc4, 0x13, 0x3f, 0x1c, 0x38, 0x39, 0x50, 0xef, 0xd7, 0x2a, 0xac, 0xc9, 0x71, 0xe1, 0x1f,
+	0x10, 0xd4, 0x72, 0x5a, 0x85, 0xed, 0xa2, 0xdd, 0x15, 0x08, 0x9e, 0xb9, 0xbb, 0x3c, 0x40, 0xad,
+	0xc3, 0x7a, 0xfd, 0xeb, 0xdf, 0xff, 0xf9, 0xae, 0xf4, 0x1a, 0xde, 0xb6, 0x0b, 0x74, 0xb8, 0xaf,
+	0x40, 0xae, 0xd8, 0x11, 0xfe, 0x09, 0x41, 0x2d, 0xa7, 0x67, 0xcf, 0x20, 0x38, 0x2b, 0x94, 0xe6,
+	0xee, 0xf2, 0x00, 0x4d, 0x70, 0x5f, 0x12, 0xec, 0xe0, 0x1b, 0x45, 0x04, 0x89, 0x02, 0x49, 0x82,
+	0xf6, 0x43, 0x71, 0x56, 0x8f, 0xf0, 0x5f, 0x08, 0x1a, 0xf3, 0x85, 0x0f, 0xdf, 0x5c, 0xc8, 0x60,
+	0xa1, 0xf0, 0x9a, 0x6f, 0xff, 0x2f, 0xac, 0x6e, 0xe4, 0xb6, 0x6c, 0xe4, 0x7d, 0xfc, 0x9e, 0xbd,
+	0xf8, 0x2f, 0xde, 0x8c, 0x0e, 0xdb, 0x0f, 0x73, 0x6a, 0xff, 0xe8, 0x9b, 0x12, 0xc2, 0x3f, 0x23,
+	0xa8, 0x4f, 0xaa, 0x25, 0xee, 0x2d, 0xa4, 0x36, 0x57, 0x99, 0xcd, 0xfd, 0xe7, 0xc2, 0xe8, 0x36,
+	0x6c, 0xd9, 0xc6, 0x75, 0xbc, 0x53, 0xd4, 0xc6, 0x94, 0x58, 0xe3, 0xef, 0x11, 0x54, 0xc7, 0x92,
+	0x8a, 0x3b, 0x8b, 0x0f, 0x60, 0x4a, 0x93, 0xcd, 0xee, 0xb2, 0xe1, 0x9a, 0xdd, 0x75, 0xc9, 0xee,
+	0x2a, 0xbe, 0x52, 0x78, 0x2d, 0x63, 0x26, 0x3f, 0x22, 0xb8, 0x90, 0xd7, 0x40, 0xbc, 0xf8, 0x36,
+	0xe7, 0x28, 0xbc, 0xb9, 0xf7, 0x1c, 0x08, 0x4d, 0xb0, 0x23, 0x09, 0xee, 0xe0, 0x6b, 0x45, 0x04,
+	0x8f, 0x25, 0xca, 0xd5, 0x7a, 0x2c, 0x14, 0x21, 0xa7, 0xa2, 0xcf, 0xf8, 0xe0, 0x66, 0xb5, 0xd8,
+	0xdc, 0x5d, 0x1e, 0xb0, 0xac, 0x22, 0x64, 0x0a, 0x2d, 0x50, 0x87, 0x6f, 0xfc, 0x76, 0xd6, 0x44,
+	0x4f, 0xce, 0x9a, 0xe8, 0xef, 0xb3, 0x26, 0xfa, 0xf6, 0xbc, 0xb9, 0xf2, 0xe4, 0xbc, 0xb9, 0xf2,
+	0xc7, 0x79, 0x73, 0xe5, 0xf3, 0x2d, 0x05, 0x67, 0xde, 0x17, 0x5d, 0x3f, 0xb2, 0xbf, 0x1a, 0xa7,
+	0xe1, 0xa7, 0x31, 0x65, 0xc7, 0x15, 0xf9, 0xcf, 0xdb, 0xfe, 0xbf, 0x03, 0x00, 0x16, 0x68, 0x06,
+	0x2b, 0x59, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -584,6 +871,13 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// BinaryStatus queries the status of the binary of the current upgrade plan
+	// pre-downloaded by the queried node. The result is local to the node and
+	// not part of the consensus state.
+	BinaryStatus(ctx context.Context, in *QueryBinaryStatusRequest, opts ...grpc.CallOption) (*QueryBinaryStatusResponse, error)
+	// SignalTally queries the tally of the validators which signalled their
+	// readiness for the current upgrade plan.
+	SignalTally(ctx context.Context, in *QuerySignalTallyRequest, opts ...grpc.CallOption) (*QuerySignalTallyResponse, error)
 }
 
 type queryClient struct {
@@ -640,6 +934,24 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) BinaryStatus(ctx context.Context, in *QueryBinaryStatusRequest, opts ...grpc.CallOption) (*QueryBinaryStatusResponse, error) {
+	out := new(QueryBinaryStatusResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/BinaryStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SignalTally(ctx context.Context, in *QuerySignalTallyRequest, opts ...grpc.CallOption) (*QuerySignalTallyResponse, error) {
+	out := new(QuerySignalTallyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/SignalTally", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -661,6 +973,13 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// BinaryStatus queries the status of the binary of the current upgrade plan
+	// pre-downloaded by the queried node. The result is local to the node and
+	// not part of the consensus state.
+	BinaryStatus(context.Context, *QueryBinaryStatusRequest) (*QueryBinaryStatusResponse, error)
+	// SignalTally queries the tally of the validators which signalled their
+	// readiness for the current upgrade plan.
+	SignalTally(context.Context, *QuerySignalTallyRequest) (*QuerySignalTallyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -682,6 +1001,12 @@ func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryM
 func (*UnimplementedQueryServer) Authority(ctx context.Context, req *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (*UnimplementedQueryServer) BinaryStatus(ctx context.Context, req *QueryBinaryStatusRequest) (*QueryBinaryStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BinaryStatus not implemented")
+}
+func (*UnimplementedQueryServer) SignalTally(ctx context.Context, req *QuerySignalTallyRequest) (*QuerySignalTallyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalTally not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -777,6 +1102,42 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BinaryStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBinaryStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BinaryStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/BinaryStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BinaryStatus(ctx, req.(*QueryBinaryStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SignalTally_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySignalTallyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SignalTally(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/SignalTally",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SignalTally(ctx, req.(*QuerySignalTallyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -801,6 +1162,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "BinaryStatus",
+			Handler:    _Query_BinaryStatus_Handler,
+		},
+		{
+			MethodName: "SignalTally",
+			Handler:    _Query_SignalTally_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -1100,72 +1469,248 @@ func (m *QueryAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBinaryStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryCurrentPlanRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryBinaryStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCurrentPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBinaryStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Plan != nil {
-		l = m.Plan.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAppliedPlanRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBinaryStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAppliedPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	return n
+func (m *QueryBinaryStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUpgradedConsensusStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBinaryStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.LastHeight != 0 {
-		n += 1 + sovQuery(uint64(m.LastHeight))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PlanHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PlanHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PlanName) > 0 {
+		i -= len(m.PlanName)
+		copy(dAtA[i:], m.PlanName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignalTallyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignalTallyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalTallyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySignalTallyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySignalTallyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySignalTallyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ThresholdReached {
+		i--
+		if m.ThresholdReached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SignalledPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignalledPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SignalThreshold) > 0 {
+		i -= len(m.SignalThreshold)
+		copy(dAtA[i:], m.SignalThreshold)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SignalThreshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlanName) > 0 {
+		i -= len(m.PlanName)
+		copy(dAtA[i:], m.PlanName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCurrentPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppliedPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAppliedPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryUpgradedConsensusStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastHeight))
 	}
 	return n
 }
@@ -1233,6 +1778,86 @@ func (m *QueryAuthorityResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBinaryStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBinaryStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.PlanName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PlanHeight != 0 {
+		n += 1 + sovQuery(uint64(m.PlanHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySignalTallyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySignalTallyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SignalThreshold)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SignalledPower != 0 {
+		n += 1 + sovQuery(uint64(m.SignalledPower))
+	}
+	if m.TotalPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPower))
+	}
+	if m.ThresholdReached {
+		n += 2
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1977,6 +2602,514 @@ func (m *QueryAuthorityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBinaryStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBinaryStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBinaryStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBinaryStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBinaryStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBinaryStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanHeight", wireType)
+			}
+			m.PlanHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BinaryStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignalTallyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignalTallyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignalTallyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySignalTallyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySignalTallyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySignalTallyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalledPower", wireType)
+			}
+			m.SignalledPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignalledPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			m.TotalPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdReached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ThresholdReached = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
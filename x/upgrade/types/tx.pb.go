@@ -214,42 +214,143 @@ func (m *MsgCancelUpgradeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelUpgradeResponse proto.InternalMessageInfo
 
+// MsgSignalUpgrade is the Msg/SignalUpgrade request type.
+type MsgSignalUpgrade struct {
+	// validator_address is the operator address of the validator signalling its
+	// readiness.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// name is the name of the scheduled upgrade plan the validator is ready for.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *MsgSignalUpgrade) Reset()         { *m = MsgSignalUpgrade{} }
+func (m *MsgSignalUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalUpgrade) ProtoMessage()    {}
+func (*MsgSignalUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{4}
+}
+func (m *MsgSignalUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignalUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalUpgrade.Merge(m, src)
+}
+func (m *MsgSignalUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalUpgrade proto.InternalMessageInfo
+
+func (m *MsgSignalUpgrade) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgSignalUpgrade) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// MsgSignalUpgradeResponse is the Msg/SignalUpgrade response type.
+type MsgSignalUpgradeResponse struct {
+}
+
+func (m *MsgSignalUpgradeResponse) Reset()         { *m = MsgSignalUpgradeResponse{} }
+func (m *MsgSignalUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSignalUpgradeResponse) ProtoMessage()    {}
+func (*MsgSignalUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2852c16e3ab79fef, []int{5}
+}
+func (m *MsgSignalUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSignalUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSignalUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSignalUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSignalUpgradeResponse.Merge(m, src)
+}
+func (m *MsgSignalUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSignalUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSignalUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSignalUpgradeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSoftwareUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgrade")
 	proto.RegisterType((*MsgSoftwareUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgSoftwareUpgradeResponse")
 	proto.RegisterType((*MsgCancelUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgrade")
 	proto.RegisterType((*MsgCancelUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgCancelUpgradeResponse")
+	proto.RegisterType((*MsgSignalUpgrade)(nil), "cosmos.upgrade.v1beta1.MsgSignalUpgrade")
+	proto.RegisterType((*MsgSignalUpgradeResponse)(nil), "cosmos.upgrade.v1beta1.MsgSignalUpgradeResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/upgrade/v1beta1/tx.proto", fileDescriptor_2852c16e3ab79fef) }
 
 var fileDescriptor_2852c16e3ab79fef = []byte{
-	// 398 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x2d, 0x48, 0x2f, 0x4a, 0x4c, 0x49, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x83, 0x28, 0xd0,
-	0x83, 0x2a, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b, 0xd1, 0x07, 0xb1,
-	0x20, 0xaa, 0xa5, 0x24, 0x21, 0xaa, 0xe3, 0x21, 0x12, 0x50, 0xad, 0x10, 0x29, 0x15, 0x1c, 0x36,
-	0xc1, 0x0c, 0x86, 0xa8, 0x12, 0x87, 0xaa, 0xca, 0x2d, 0x4e, 0xd7, 0x2f, 0x33, 0x04, 0x51, 0x50,
-	0x09, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x11, 0x52, 0xda, 0xcd, 0xc8, 0x25,
-	0xe4, 0x5b, 0x9c, 0x1e, 0x9c, 0x9f, 0x56, 0x52, 0x9e, 0x58, 0x94, 0x1a, 0x0a, 0x31, 0x48, 0xc8,
-	0x8c, 0x8b, 0x33, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0x52, 0x82, 0x51, 0x81, 0x51,
-	0x83, 0xd3, 0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x6b, 0x1c, 0x53, 0x52, 0x8a, 0x52, 0x8b,
-	0x8b, 0x83, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x83, 0x10, 0x4a, 0x85, 0xac, 0xb9, 0x58, 0x0a, 0x72,
-	0x12, 0xf3, 0x24, 0x98, 0x14, 0x18, 0x35, 0xb8, 0x8d, 0x64, 0xf4, 0xb0, 0x7b, 0x5c, 0x2f, 0x20,
-	0x27, 0x31, 0xcf, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x81,
-	0x35, 0x59, 0x19, 0x34, 0x3d, 0xdf, 0xa0, 0x85, 0x30, 0xac, 0xeb, 0xf9, 0x06, 0x2d, 0x59, 0x88,
-	0x01, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x98, 0xce, 0x54, 0x92, 0xe1, 0x92, 0xc2, 0x14, 0x0d, 0x4a,
-	0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x55, 0xaa, 0xe2, 0x12, 0xf0, 0x2d, 0x4e, 0x77, 0x4e, 0xcc,
-	0x4b, 0x4e, 0xcd, 0xa1, 0xd0, 0x63, 0x56, 0x7a, 0x98, 0x6e, 0x93, 0x46, 0x75, 0x1b, 0x8a, 0x3d,
-	0x4a, 0x52, 0x5c, 0x12, 0xe8, 0x62, 0x30, 0x77, 0x19, 0xbd, 0x61, 0xe4, 0x62, 0xf6, 0x2d, 0x4e,
-	0x17, 0x2a, 0xe4, 0xe2, 0x47, 0x0f, 0x77, 0x2d, 0x5c, 0x21, 0x86, 0xe9, 0x4d, 0x29, 0x23, 0xe2,
-	0xd5, 0xc2, 0xac, 0x16, 0xca, 0xe6, 0xe2, 0x45, 0x0d, 0x0f, 0x0d, 0x3c, 0x86, 0xa0, 0xa8, 0x94,
-	0x32, 0x20, 0x56, 0x25, 0xcc, 0x32, 0x29, 0xd6, 0x06, 0x50, 0xe4, 0x3a, 0x99, 0x9d, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x0c, 0xc4, 0xc4, 0xe2, 0x94, 0x6c, 0xbd, 0xcc,
-	0x7c, 0xfd, 0x0a, 0x78, 0xb2, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xa7, 0x50, 0x63,
-	0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82, 0x8c, 0x8c, 0xfe, 0x5f, 0x03, 0x00, 0x00,
+	// 488 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x31, 0x6f, 0x13, 0x31,
+	0x18, 0x86, 0xe3, 0x52, 0x90, 0x62, 0x84, 0x68, 0x4f, 0x15, 0x04, 0x13, 0xae, 0x25, 0x62, 0x88,
+	0x22, 0xc5, 0x4e, 0x82, 0x94, 0x21, 0x4c, 0x84, 0x39, 0x08, 0x25, 0x82, 0x81, 0xa5, 0x72, 0x7b,
+	0xc6, 0x9c, 0x92, 0xd8, 0xc7, 0xd9, 0x0d, 0x2d, 0x13, 0x62, 0x64, 0xe2, 0x67, 0x30, 0xa1, 0x0c,
+	0xdd, 0xf8, 0x03, 0x1d, 0xab, 0x4e, 0xb0, 0x20, 0x94, 0x0c, 0xf9, 0x1b, 0x28, 0x67, 0x5f, 0x8a,
+	0x73, 0x6d, 0x15, 0xa9, 0x4b, 0x62, 0x7d, 0x7e, 0xfc, 0xbd, 0xef, 0x7b, 0xdf, 0xf9, 0xe0, 0xf6,
+	0xbe, 0x54, 0x43, 0xa9, 0xc8, 0x41, 0xc4, 0x63, 0x1a, 0x30, 0x32, 0xaa, 0xef, 0x31, 0x4d, 0xeb,
+	0x44, 0x1f, 0xe2, 0x28, 0x96, 0x5a, 0x7a, 0xf7, 0x0c, 0x80, 0x2d, 0x80, 0x2d, 0x80, 0xb6, 0xb8,
+	0xe4, 0x32, 0x41, 0xc8, 0x7c, 0x65, 0x68, 0xf4, 0xc0, 0xd0, 0xbb, 0x66, 0xc3, 0x1e, 0x35, 0x5b,
+	0x4f, 0x2e, 0x51, 0x4a, 0x1b, 0x1b, 0xea, 0xbe, 0xa5, 0x86, 0x8a, 0x93, 0x51, 0x7d, 0xfe, 0x67,
+	0x37, 0x36, 0xe9, 0x30, 0x14, 0x92, 0x24, 0xbf, 0xa6, 0x54, 0xfa, 0x09, 0xa0, 0xd7, 0x51, 0xbc,
+	0x27, 0xdf, 0xe9, 0x8f, 0x34, 0x66, 0xaf, 0x4d, 0x23, 0xaf, 0x09, 0xf3, 0xf4, 0x40, 0xbf, 0x97,
+	0x71, 0xa8, 0x8f, 0x0a, 0x60, 0x07, 0x94, 0xf3, 0xed, 0xc2, 0xd9, 0x71, 0x75, 0xcb, 0xba, 0x79,
+	0x1e, 0x04, 0x31, 0x53, 0xaa, 0xa7, 0xe3, 0x50, 0xf0, 0xee, 0x39, 0xea, 0x3d, 0x83, 0xeb, 0xd1,
+	0x80, 0x8a, 0xc2, 0xda, 0x0e, 0x28, 0xdf, 0x6e, 0x14, 0xf1, 0xc5, 0xc1, 0xf1, 0xab, 0x01, 0x15,
+	0xed, 0xfc, 0xc9, 0x9f, 0xed, 0xdc, 0xf7, 0xd9, 0xb8, 0x02, 0xba, 0xc9, 0xa1, 0x56, 0xed, 0xcb,
+	0x6c, 0x5c, 0x39, 0x6f, 0xf6, 0x75, 0x36, 0xae, 0x3c, 0x32, 0x0d, 0xaa, 0x2a, 0xe8, 0x93, 0xac,
+	0xcd, 0x52, 0x11, 0xa2, 0x6c, 0xb5, 0xcb, 0x54, 0x24, 0x85, 0x62, 0xa5, 0x4f, 0x70, 0xa3, 0xa3,
+	0xf8, 0x0b, 0x2a, 0xf6, 0xd9, 0xe0, 0x9a, 0xc1, 0x5a, 0x38, 0xeb, 0xed, 0xa1, 0xeb, 0xcd, 0xd1,
+	0x29, 0x21, 0x58, 0x58, 0xae, 0x2d, 0x7c, 0xfd, 0x00, 0x89, 0xb1, 0x5e, 0xc8, 0x05, 0x5d, 0x18,
+	0x7b, 0x09, 0x37, 0x47, 0x74, 0x10, 0x06, 0x54, 0xcb, 0x78, 0x97, 0x1a, 0x1b, 0xd6, 0xe0, 0xe3,
+	0xb3, 0xe3, 0xaa, 0x7d, 0x10, 0xf8, 0x4d, 0xca, 0xb8, 0x4e, 0x37, 0x46, 0x4b, 0x75, 0xcf, 0x83,
+	0xeb, 0x82, 0x0e, 0x59, 0x32, 0x89, 0x7c, 0x37, 0x59, 0xb7, 0x9a, 0xf3, 0x10, 0x59, 0x99, 0x0b,
+	0xc2, 0x38, 0xde, 0x6c, 0x18, 0xa7, 0x96, 0x86, 0x69, 0xfc, 0x5e, 0x83, 0x37, 0x3a, 0x8a, 0x7b,
+	0x1f, 0xe0, 0xdd, 0xe5, 0x97, 0xa8, 0x72, 0xd9, 0xf8, 0xb3, 0x33, 0x43, 0x8d, 0xd5, 0xd9, 0x54,
+	0xda, 0xeb, 0xc3, 0x3b, 0xee, 0x70, 0xcb, 0x57, 0x34, 0x71, 0x48, 0x54, 0x5b, 0x95, 0xfc, 0x5f,
+	0xcc, 0x1d, 0xd8, 0x55, 0x62, 0x0e, 0x89, 0x6a, 0xab, 0x92, 0xa9, 0x18, 0xba, 0xf9, 0x79, 0x7e,
+	0x2d, 0xda, 0xcd, 0x93, 0x89, 0x0f, 0x4e, 0x27, 0x3e, 0xf8, 0x3b, 0xf1, 0xc1, 0xb7, 0xa9, 0x9f,
+	0x3b, 0x9d, 0xfa, 0xb9, 0x5f, 0x53, 0x3f, 0xf7, 0xb6, 0x68, 0x3a, 0xaa, 0xa0, 0x8f, 0x43, 0x49,
+	0x0e, 0x17, 0x1f, 0x04, 0x7d, 0x14, 0x31, 0xb5, 0x77, 0x2b, 0xb9, 0xdb, 0x4f, 0xff, 0x0d, 0x00,
+	0x4c, 0xea, 0x6e, 0x19, 0x99, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -273,6 +374,9 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opts ...grpc.CallOption) (*MsgCancelUpgradeResponse, error)
+	// SignalUpgrade defines an operation for a validator to signal its readiness
+	// for the scheduled upgrade.
+	SignalUpgrade(ctx context.Context, in *MsgSignalUpgrade, opts ...grpc.CallOption) (*MsgSignalUpgradeResponse, error)
 }
 
 type msgClient struct {
@@ -301,6 +405,15 @@ func (c *msgClient) CancelUpgrade(ctx context.Context, in *MsgCancelUpgrade, opt
 	return out, nil
 }
 
+func (c *msgClient) SignalUpgrade(ctx context.Context, in *MsgSignalUpgrade, opts ...grpc.CallOption) (*MsgSignalUpgradeResponse, error) {
+	out := new(MsgSignalUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Msg/SignalUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SoftwareUpgrade is a governance operation for initiating a software upgrade.
@@ -312,6 +425,9 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CancelUpgrade(context.Context, *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error)
+	// SignalUpgrade defines an operation for a validator to signal its readiness
+	// for the scheduled upgrade.
+	SignalUpgrade(context.Context, *MsgSignalUpgrade) (*MsgSignalUpgradeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -324,6 +440,9 @@ func (*UnimplementedMsgServer) SoftwareUpgrade(ctx context.Context, req *MsgSoft
 func (*UnimplementedMsgServer) CancelUpgrade(ctx context.Context, req *MsgCancelUpgrade) (*MsgCancelUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelUpgrade not implemented")
 }
+func (*UnimplementedMsgServer) SignalUpgrade(ctx context.Context, req *MsgSignalUpgrade) (*MsgSignalUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignalUpgrade not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -365,6 +484,24 @@ func _Msg_CancelUpgrade_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SignalUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSignalUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SignalUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Msg/SignalUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SignalUpgrade(ctx, req.(*MsgSignalUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -377,6 +514,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelUpgrade",
 			Handler:    _Msg_CancelUpgrade_Handler,
 		},
+		{
+			MethodName: "SignalUpgrade",
+			Handler:    _Msg_SignalUpgrade_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/tx.proto",
@@ -498,6 +639,66 @@ func (m *MsgCancelUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSignalUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSignalUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSignalUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSignalUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -555,6 +756,32 @@ func (m *MsgCancelUpgradeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSignalUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSignalUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -858,6 +1085,170 @@ func (m *MsgCancelUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSignalUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSignalUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSignalUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSignalUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
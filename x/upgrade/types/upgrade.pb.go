@@ -30,6 +30,46 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BinaryStatus defines the status of the pre-download of the binary of an
+// upgrade plan.
+type BinaryStatus int32
+
+const (
+	// BINARY_STATUS_UNSPECIFIED defines a status where no binary is downloaded,
+	// i.e. no upgrade is scheduled or it is not yet time to download it.
+	BinaryStatusUnspecified BinaryStatus = 0
+	// BINARY_STATUS_DOWNLOADING defines a status where the binary is being
+	// downloaded.
+	BinaryStatusDownloading BinaryStatus = 1
+	// BINARY_STATUS_VERIFIED defines a status where the binary was downloaded and
+	// its checksum verified.
+	BinaryStatusVerified BinaryStatus = 2
+	// BINARY_STATUS_FAILED defines a status where the last download failed.
+	BinaryStatusFailed BinaryStatus = 3
+)
+
+var BinaryStatus_name = map[int32]string{
+	0: "BINARY_STATUS_UNSPECIFIED",
+	1: "BINARY_STATUS_DOWNLOADING",
+	2: "BINARY_STATUS_VERIFIED",
+	3: "BINARY_STATUS_FAILED",
+}
+
+var BinaryStatus_value = map[string]int32{
+	"BINARY_STATUS_UNSPECIFIED": 0,
+	"BINARY_STATUS_DOWNLOADING": 1,
+	"BINARY_STATUS_VERIFIED":    2,
+	"BINARY_STATUS_FAILED":      3,
+}
+
+func (x BinaryStatus) String() string {
+	return proto.EnumName(BinaryStatus_name, int32(x))
+}
+
+func (BinaryStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{0}
+}
+
 // Plan specifies information about a planned upgrade and when it should occur.
 type Plan struct {
 	// Sets the name for the upgrade. This name will be used by the upgraded
@@ -53,6 +93,12 @@ type Plan struct {
 	// moved to the IBC module in the sub module 02-client.
 	// If this field is not empty, an error will be thrown.
 	UpgradedClientState *types.Any `protobuf:"bytes,5,opt,name=upgraded_client_state,json=upgradedClientState,proto3" json:"upgraded_client_state,omitempty"` // Deprecated: Do not use.
+	// signal_threshold is the fraction of the bonded voting power which must
+	// signal its readiness for the upgrade before it is executed. When set, the
+	// upgrade is executed at the first block, at or after the plan height, at
+	// which the signalled voting power exceeds the threshold. When empty, the
+	// upgrade is executed at the plan height.
+	SignalThreshold string `protobuf:"bytes,6,opt,name=signal_threshold,json=signalThreshold,proto3" json:"signal_threshold,omitempty"`
 }
 
 func (m *Plan) Reset()         { *m = Plan{} }
@@ -225,11 +271,53 @@ func (m *ModuleVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
+// SignalVoteExtension defines the vote extension a validator signals its
+// readiness for the scheduled upgrade with.
+type SignalVoteExtension struct {
+	// name is the name of the scheduled upgrade plan the validator is ready for.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *SignalVoteExtension) Reset()         { *m = SignalVoteExtension{} }
+func (m *SignalVoteExtension) String() string { return proto.CompactTextString(m) }
+func (*SignalVoteExtension) ProtoMessage()    {}
+func (*SignalVoteExtension) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
+}
+func (m *SignalVoteExtension) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalVoteExtension) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalVoteExtension.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalVoteExtension) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalVoteExtension.Merge(m, src)
+}
+func (m *SignalVoteExtension) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalVoteExtension) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalVoteExtension.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalVoteExtension proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("cosmos.upgrade.v1beta1.BinaryStatus", BinaryStatus_name, BinaryStatus_value)
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
+	proto.RegisterType((*SignalVoteExtension)(nil), "cosmos.upgrade.v1beta1.SignalVoteExtension")
 }
 
 func init() {
@@ -237,40 +325,52 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 519 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xbf, 0x6e, 0xd3, 0x40,
-	0x18, 0xf7, 0xb5, 0x6e, 0x51, 0x2e, 0x42, 0x15, 0x26, 0x94, 0x6b, 0x54, 0x9c, 0xc8, 0x62, 0x88,
-	0x2a, 0xd5, 0x56, 0xcb, 0x66, 0x06, 0x44, 0x32, 0x02, 0x52, 0x71, 0x81, 0x81, 0x25, 0xba, 0xc4,
-	0x17, 0xf7, 0x54, 0xfb, 0xce, 0xf2, 0x5d, 0x02, 0x79, 0x05, 0xa6, 0x3e, 0x02, 0x23, 0x62, 0xea,
-	0xc0, 0x43, 0x44, 0x4c, 0x1d, 0x91, 0x90, 0xf8, 0x93, 0x0c, 0x65, 0xe3, 0x15, 0xd0, 0xdd, 0xd9,
-	0x55, 0x04, 0x05, 0x31, 0x74, 0xb1, 0xbe, 0xef, 0xbb, 0xef, 0xe7, 0xdf, 0x1f, 0x9f, 0xe1, 0xdd,
-	0x21, 0x17, 0x19, 0x17, 0xc1, 0x38, 0x4f, 0x0a, 0x1c, 0x93, 0x60, 0xb2, 0x37, 0x20, 0x12, 0xef,
-	0x55, 0xbd, 0x9f, 0x17, 0x5c, 0x72, 0x67, 0xd3, 0x6c, 0xf9, 0xd5, 0xb4, 0xdc, 0x6a, 0x6e, 0x25,
-	0x9c, 0x27, 0x29, 0x09, 0xf4, 0xd6, 0x60, 0x3c, 0x0a, 0x30, 0x9b, 0x1a, 0x48, 0xb3, 0x91, 0xf0,
-	0x84, 0xeb, 0x32, 0x50, 0x55, 0x39, 0x6d, 0xfd, 0x0e, 0x90, 0x34, 0x23, 0x42, 0xe2, 0x2c, 0x2f,
-	0x17, 0xb6, 0x0c, 0x53, 0xdf, 0x20, 0x4b, 0x5a, 0x73, 0x74, 0x03, 0x67, 0x94, 0xf1, 0x40, 0x3f,
-	0xcd, 0xc8, 0xfb, 0x09, 0xa0, 0x7d, 0x90, 0x62, 0xe6, 0x38, 0xd0, 0x66, 0x38, 0x23, 0x08, 0xb4,
-	0x41, 0xa7, 0x16, 0xe9, 0xda, 0x79, 0x00, 0x6d, 0xf5, 0x76, 0xb4, 0xd2, 0x06, 0x9d, 0xfa, 0x7e,
-	0xd3, 0x37, 0xd4, 0x7e, 0x45, 0xed, 0x3f, 0xab, 0xa8, 0xbb, 0x1b, 0xb3, 0x2f, 0x2d, 0xeb, 0xe4,
-	0x6b, 0x0b, 0xbc, 0x3b, 0x3f, 0xdd, 0x01, 0x08, 0x44, 0x1a, 0xe8, 0x6c, 0xc2, 0xf5, 0x23, 0x42,
-	0x93, 0x23, 0x89, 0x56, 0xdb, 0xa0, 0xb3, 0x1a, 0x95, 0x9d, 0x22, 0xa3, 0x6c, 0xc4, 0x91, 0x6d,
-	0xc8, 0x54, 0xed, 0x3c, 0x86, 0xb7, 0xca, 0x70, 0xe2, 0xfe, 0x30, 0xa5, 0x84, 0xc9, 0xbe, 0x90,
-	0x58, 0x12, 0xb4, 0xa6, 0xd9, 0x1b, 0x7f, 0xb0, 0x3f, 0x64, 0xd3, 0xee, 0x0a, 0x02, 0xd1, 0xcd,
-	0x0a, 0xd6, 0xd3, 0xa8, 0x43, 0x05, 0x0a, 0xd1, 0x8f, 0xb7, 0x2d, 0xf0, 0xe6, 0xfc, 0x74, 0x67,
-	0xc3, 0x24, 0xb0, 0x2b, 0xe2, 0xe3, 0x40, 0x19, 0xf5, 0x3e, 0x03, 0x78, 0xfb, 0x90, 0x8f, 0xe4,
-	0x2b, 0x5c, 0x90, 0xe7, 0x06, 0x79, 0x50, 0xf0, 0x9c, 0x0b, 0x9c, 0x3a, 0x0d, 0xb8, 0x26, 0xa9,
-	0x4c, 0xab, 0x14, 0x4c, 0xe3, 0xb4, 0x61, 0x3d, 0x26, 0x62, 0x58, 0xd0, 0x5c, 0x52, 0xce, 0x74,
-	0x1a, 0xb5, 0x68, 0x79, 0xe4, 0xdc, 0x87, 0x76, 0x9e, 0x62, 0xa6, 0x5d, 0xd6, 0xf7, 0xb7, 0xfd,
-	0xcb, 0x3f, 0xb6, 0xaf, 0xf8, 0xbb, 0x35, 0x15, 0x95, 0x8e, 0x29, 0xd2, 0xa0, 0xf0, 0x91, 0x92,
-	0xfa, 0xf1, 0xc3, 0x6e, 0xb3, 0x44, 0x25, 0x7c, 0x72, 0x81, 0xe8, 0x71, 0x26, 0x09, 0x93, 0xca,
-	0x88, 0xb7, 0x64, 0xe4, 0x2f, 0xfa, 0x11, 0xf0, 0xde, 0x03, 0x78, 0xa7, 0x87, 0xd9, 0x90, 0xa4,
-	0x57, 0xec, 0x31, 0x7c, 0xfa, 0x7f, 0x32, 0x3b, 0x4b, 0x32, 0xff, 0x29, 0x04, 0x01, 0xaf, 0x07,
-	0xaf, 0x3f, 0xe1, 0xf1, 0x38, 0x25, 0x2f, 0x48, 0x21, 0x54, 0x8e, 0x97, 0x5d, 0x42, 0x04, 0xaf,
-	0x4d, 0xcc, 0xb1, 0x56, 0x65, 0x47, 0x55, 0x1b, 0xda, 0x4a, 0x51, 0x37, 0x9c, 0x7d, 0x77, 0xad,
-	0xd9, 0xdc, 0x05, 0x67, 0x73, 0x17, 0x7c, 0x9b, 0xbb, 0xe0, 0x64, 0xe1, 0x5a, 0x67, 0x0b, 0xd7,
-	0xfa, 0xb4, 0x70, 0xad, 0x97, 0xdb, 0x46, 0x8e, 0x88, 0x8f, 0x7d, 0xca, 0x83, 0xd7, 0x17, 0x7f,
-	0xa9, 0x9c, 0xe6, 0x44, 0x0c, 0xd6, 0xf5, 0x65, 0xba, 0xf7, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x89,
-	0x0e, 0x9c, 0x59, 0xc4, 0x03, 0x00, 0x00,
+	// 719 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4d, 0x4f, 0xe3, 0x46,
+	0x18, 0xc7, 0x33, 0xc1, 0x50, 0x31, 0xb4, 0x25, 0x35, 0x69, 0x30, 0x2e, 0x75, 0xa2, 0xa8, 0x87,
+	0x14, 0x09, 0xbb, 0xd0, 0x5e, 0x9a, 0x1e, 0xaa, 0xbc, 0x51, 0x45, 0xa5, 0x81, 0x3a, 0x2f, 0x55,
+	0x7b, 0x89, 0x86, 0x78, 0xe2, 0x8c, 0x70, 0x66, 0x2c, 0x7b, 0x02, 0xe4, 0x1b, 0x54, 0x39, 0xf1,
+	0x05, 0x90, 0x2a, 0xf5, 0x52, 0x71, 0xe2, 0xc0, 0x87, 0x40, 0x3d, 0xa1, 0x3d, 0xad, 0xb4, 0xd2,
+	0xbe, 0xc0, 0x81, 0xfd, 0x00, 0xfb, 0x01, 0x56, 0xf6, 0xd8, 0x28, 0x2c, 0xb0, 0xda, 0xc3, 0x5e,
+	0xa2, 0xe7, 0x79, 0xe6, 0xf9, 0xcd, 0xff, 0x99, 0xff, 0x4c, 0x0c, 0xbf, 0xe9, 0x31, 0x7f, 0xc8,
+	0x7c, 0x63, 0xe4, 0xda, 0x1e, 0xb2, 0xb0, 0x71, 0xb0, 0xb1, 0x87, 0x39, 0xda, 0x88, 0x73, 0xdd,
+	0xf5, 0x18, 0x67, 0x72, 0x46, 0x74, 0xe9, 0x71, 0x35, 0xea, 0x52, 0x57, 0x6c, 0xc6, 0x6c, 0x07,
+	0x1b, 0x61, 0xd7, 0xde, 0xa8, 0x6f, 0x20, 0x3a, 0x16, 0x88, 0x9a, 0xb6, 0x99, 0xcd, 0xc2, 0xd0,
+	0x08, 0xa2, 0xa8, 0x9a, 0x7d, 0x17, 0xe0, 0x64, 0x88, 0x7d, 0x8e, 0x86, 0x6e, 0xd4, 0xb0, 0x22,
+	0x94, 0xba, 0x82, 0x8c, 0x64, 0xc5, 0xd2, 0x17, 0x68, 0x48, 0x28, 0x33, 0xc2, 0x5f, 0x51, 0xca,
+	0x9f, 0x26, 0xa1, 0xb4, 0xeb, 0x20, 0x2a, 0xcb, 0x50, 0xa2, 0x68, 0x88, 0x15, 0x90, 0x03, 0x85,
+	0x79, 0x33, 0x8c, 0xe5, 0x9f, 0xa1, 0x14, 0xec, 0xae, 0x24, 0x73, 0xa0, 0xb0, 0xb0, 0xa9, 0xea,
+	0x42, 0x5a, 0x8f, 0xa5, 0xf5, 0x56, 0x2c, 0x5d, 0x5e, 0xbc, 0x78, 0x9e, 0x4d, 0x1c, 0xbf, 0xc8,
+	0x82, 0xff, 0x6e, 0xce, 0xd6, 0x80, 0x02, 0xcc, 0x10, 0x94, 0x33, 0x70, 0x6e, 0x80, 0x89, 0x3d,
+	0xe0, 0xca, 0x4c, 0x0e, 0x14, 0x66, 0xcc, 0x28, 0x0b, 0xc4, 0x08, 0xed, 0x33, 0x45, 0x12, 0x62,
+	0x41, 0x2c, 0x6f, 0xc3, 0x2f, 0x23, 0x73, 0xac, 0x6e, 0xcf, 0x21, 0x98, 0xf2, 0xae, 0xcf, 0x11,
+	0xc7, 0xca, 0x6c, 0xa8, 0x9e, 0xbe, 0xa7, 0x5e, 0xa2, 0xe3, 0x72, 0x52, 0x01, 0xe6, 0x52, 0x8c,
+	0x55, 0x42, 0xaa, 0x19, 0x40, 0xf2, 0x8f, 0x30, 0xe5, 0x13, 0x9b, 0x22, 0xa7, 0xcb, 0x07, 0x1e,
+	0xf6, 0x07, 0xcc, 0xb1, 0x94, 0xb9, 0x40, 0xad, 0xfc, 0xf9, 0x93, 0xf3, 0x75, 0x18, 0xd9, 0x52,
+	0xc5, 0x3d, 0x73, 0x51, 0xf4, 0xb5, 0xe2, 0xb6, 0xa2, 0xf2, 0xfa, 0x9f, 0x2c, 0x98, 0xdc, 0x9c,
+	0xad, 0x2d, 0x8a, 0xae, 0x75, 0xdf, 0xda, 0x37, 0x02, 0x8f, 0xf2, 0xcf, 0x00, 0x5c, 0x6e, 0xb2,
+	0x3e, 0x3f, 0x44, 0x1e, 0x6e, 0x0b, 0xd1, 0x5d, 0x8f, 0xb9, 0xcc, 0x47, 0x8e, 0x9c, 0x86, 0xb3,
+	0x9c, 0x70, 0x27, 0x36, 0x50, 0x24, 0x72, 0x0e, 0x2e, 0x58, 0xd8, 0xef, 0x79, 0xc4, 0xe5, 0x84,
+	0xd1, 0xd0, 0xc8, 0x79, 0x73, 0xba, 0x24, 0xff, 0x04, 0x25, 0xd7, 0x41, 0x34, 0x34, 0x68, 0x61,
+	0x73, 0x55, 0x7f, 0xf8, 0x9d, 0xe8, 0x81, 0x7e, 0x79, 0x3e, 0x70, 0x39, 0x74, 0xd8, 0x0c, 0xa1,
+	0xe2, 0xaf, 0xc1, 0xa8, 0xff, 0x9f, 0xaf, 0xab, 0x11, 0x65, 0xb3, 0x83, 0x5b, 0xa2, 0xc2, 0x28,
+	0xc7, 0x94, 0x07, 0x07, 0xc9, 0x4f, 0x1d, 0xe4, 0x91, 0xf9, 0x15, 0x90, 0x3f, 0x05, 0xf0, 0xeb,
+	0x0a, 0xa2, 0x3d, 0xec, 0x7c, 0xe4, 0x33, 0x16, 0x7f, 0xff, 0xb0, 0x31, 0x0b, 0x53, 0x63, 0xbe,
+	0x77, 0x10, 0x05, 0xe4, 0x2b, 0xf0, 0xb3, 0xdf, 0x98, 0x35, 0x72, 0x70, 0x07, 0x7b, 0x3e, 0x61,
+	0x0f, 0xbf, 0x5f, 0x05, 0x7e, 0x72, 0x20, 0x96, 0xc3, 0xa9, 0x24, 0x33, 0x4e, 0x8b, 0x52, 0x30,
+	0x51, 0xfe, 0x5b, 0xb8, 0xd4, 0x0c, 0x2f, 0xbf, 0xc3, 0x38, 0xae, 0x1d, 0x71, 0x4c, 0x1f, 0xdb,
+	0x6a, 0xed, 0x0d, 0x80, 0x9f, 0x96, 0x09, 0x45, 0xde, 0x38, 0x78, 0x5f, 0x23, 0x5f, 0x2e, 0xc2,
+	0x95, 0x72, 0xbd, 0x51, 0x32, 0xff, 0xec, 0x36, 0x5b, 0xa5, 0x56, 0xbb, 0xd9, 0x6d, 0x37, 0x9a,
+	0xbb, 0xb5, 0x4a, 0x7d, 0xab, 0x5e, 0xab, 0xa6, 0x12, 0xea, 0x57, 0x93, 0x93, 0xdc, 0xf2, 0x34,
+	0xd0, 0xa6, 0xbe, 0x8b, 0x7b, 0xa4, 0x4f, 0xb0, 0x75, 0x9f, 0xad, 0xee, 0xfc, 0xd1, 0xd8, 0xde,
+	0x29, 0x55, 0xeb, 0x8d, 0x5f, 0x52, 0xe0, 0x3e, 0x5b, 0x65, 0x87, 0xd4, 0x61, 0xc8, 0x22, 0xd4,
+	0x96, 0x7f, 0x80, 0x99, 0xbb, 0x6c, 0xa7, 0x66, 0x0a, 0xd1, 0xa4, 0xaa, 0x4c, 0x4e, 0x72, 0xe9,
+	0x69, 0xb0, 0x83, 0x3d, 0xa1, 0xf8, 0x1d, 0x4c, 0xdf, 0xa5, 0xb6, 0x4a, 0xf5, 0xed, 0x5a, 0x35,
+	0x35, 0xa3, 0x66, 0x26, 0x27, 0x39, 0x79, 0x9a, 0xd9, 0x42, 0xc4, 0xc1, 0x96, 0x2a, 0xfd, 0xfd,
+	0xaf, 0x96, 0x28, 0x17, 0x2f, 0x5e, 0x69, 0x89, 0x8b, 0x2b, 0x0d, 0x5c, 0x5e, 0x69, 0xe0, 0xe5,
+	0x95, 0x06, 0x8e, 0xaf, 0xb5, 0xc4, 0xe5, 0xb5, 0x96, 0x78, 0x7a, 0xad, 0x25, 0xfe, 0x5a, 0x15,
+	0x17, 0xe6, 0x5b, 0xfb, 0x3a, 0x61, 0xc6, 0xd1, 0xed, 0x27, 0x90, 0x8f, 0x5d, 0xec, 0xef, 0xcd,
+	0x85, 0xff, 0xd4, 0xef, 0xdf, 0x0e, 0x00, 0x1e, 0x67, 0x10, 0xa8, 0x21, 0x05, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -307,6 +407,9 @@ func (this *Plan) Equal(that interface{}) bool {
 	if !this.UpgradedClientState.Equal(that1.UpgradedClientState) {
 		return false
 	}
+	if this.SignalThreshold != that1.SignalThreshold {
+		return false
+	}
 	return true
 }
 func (this *SoftwareUpgradeProposal) Equal(that interface{}) bool {
@@ -413,6 +516,13 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SignalThreshold) > 0 {
+		i -= len(m.SignalThreshold)
+		copy(dAtA[i:], m.SignalThreshold)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.SignalThreshold)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.UpgradedClientState != nil {
 		{
 			size, err := m.UpgradedClientState.MarshalToSizedBuffer(dAtA[:i])
@@ -574,6 +684,36 @@ func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SignalVoteExtension) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalVoteExtension) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalVoteExtension) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -608,6 +748,10 @@ func (m *Plan) Size() (n int) {
 		l = m.UpgradedClientState.Size()
 		n += 1 + l + sovUpgrade(uint64(l))
 	}
+	l = len(m.SignalThreshold)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
 	return n
 }
 
@@ -663,6 +807,19 @@ func (m *ModuleVersion) Size() (n int) {
 	return n
 }
 
+func (m *SignalVoteExtension) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -850,6 +1007,38 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignalThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUpgrade(dAtA[iNdEx:])
@@ -1233,6 +1422,88 @@ func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SignalVoteExtension) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalVoteExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalVoteExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0